@@ -25,7 +25,6 @@ import (
 	"github.com/guacsec/guac/pkg/cli"
 	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
-	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/deps_dev"
@@ -182,7 +181,7 @@ func validateDepsDevFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c)
 		return opts, err
 	}
 
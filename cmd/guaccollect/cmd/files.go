@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
@@ -46,6 +47,12 @@ type filesOptions struct {
 	poll bool
 	// enable/disable message publish to queue
 	publishToQueue bool
+	// watch location instead of polling it
+	watch bool
+	// how long a watched file must not be written to before it is collected
+	watchDebounce time.Duration
+	// file recording the watched files already collected
+	watchCheckpoint string
 }
 
 var filesCmd = &cobra.Command{
@@ -65,7 +72,11 @@ Various blob stores can be used (such as S3, Azure Blob, Google Cloud Bucket) as
 For example: "s3://my-bucket?region=us-west-1"
 
 Specific authentication method vary per cloud provider. Please follow the documentation per implementation to ensure
-you have access to read and write to the respective blob store.`,
+you have access to read and write to the respective blob store.
+
+With --watch, the folder is watched instead of polled and only the files written to it are
+collected, once they have not been written to for --watch-debounce. With --watch-checkpoint,
+the collected files are recorded so that a restart does not collect them again.`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		opts, err := validateFilesFlags(
@@ -73,6 +84,9 @@ you have access to read and write to the respective blob store.`,
 			viper.GetString("blob-addr"),
 			viper.GetBool("service-poll"),
 			viper.GetBool("publish-to-queue"),
+			viper.GetBool("watch"),
+			viper.GetString("watch-debounce"),
+			viper.GetString("watch-checkpoint"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -84,7 +98,11 @@ you have access to read and write to the respective blob store.`,
 		logger := logging.FromContext(ctx)
 
 		// Register collector
-		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second)
+		var fileOpts []file.Opt
+		if opts.watch {
+			fileOpts = append(fileOpts, file.WithWatch(opts.watchDebounce), file.WithCheckpoint(opts.watchCheckpoint))
+		}
+		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second, fileOpts...)
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -94,13 +112,22 @@ you have access to read and write to the respective blob store.`,
 	},
 }
 
-func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, pubToQueue bool, args []string) (filesOptions, error) {
+func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, pubToQueue bool, watch bool, watchDebounce, watchCheckpoint string, args []string) (filesOptions, error) {
 	var opts filesOptions
 
 	opts.pubsubAddr = pubsubAddr
 	opts.blobAddr = blobAddr
 	opts.poll = poll
 	opts.publishToQueue = pubToQueue
+	opts.watch = watch
+	opts.watchCheckpoint = watchCheckpoint
+	if watch {
+		debounce, err := time.ParseDuration(watchDebounce)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse watch debounce: %w", err)
+		}
+		opts.watchDebounce = debounce
+	}
 
 	if len(args) != 1 {
 		return opts, fmt.Errorf("expected positional argument for file_path")
@@ -194,5 +221,16 @@ func initializeNATsandCollector(ctx context.Context, pubsubAddr string, blobAddr
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"watch", "watch-debounce", "watch-checkpoint"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	filesCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(filesCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
 	rootCmd.AddCommand(filesCmd)
 }
@@ -23,7 +23,6 @@ import (
 	"time"
 
 	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
-	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/metrics"
 
@@ -207,7 +206,7 @@ func validateGithubFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c)
 		return opts, err
 	}
 
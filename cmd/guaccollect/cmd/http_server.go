@@ -0,0 +1,211 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/handler/collector/httpserver"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type httpServerOptions struct {
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// enable/disable message publish to queue
+	publishToQueue bool
+	// address to listen on
+	addr string
+	// accepted bearer tokens
+	tokens []string
+	// size limit of a document
+	maxSize     int64
+	tlsCertFile string
+	tlsKeyFile  string
+}
+
+var httpServerCmd = &cobra.Command{
+	Use:   "http-server [flags]",
+	Short: "accepts documents uploaded over HTTP and publishes them utilizing Nats pubsub and blob store",
+	Long: `
+guaccollect http-server accepts the documents pushed to it instead of pulling them.
+
+  POST /documents       uploads a document, or a batch of documents as multipart/form-data.
+                        The source of a document is its file name, or the source query
+                        parameter for a single document.
+  GET  /documents/{id}  returns the status of an uploaded document.
+
+Each upload returns the tracking ID and status of its documents. A document recognized by
+the guesser is stored in the blob store and published to the event stream like the other
+collectors do, it is "processed" until guacingest marks it "ingested" or "failed". A document
+that is not recognized is "quarantined": it is stored under the quarantine/ prefix of the blob
+store and not published.
+
+With --http-server-token-file, uploads must be authenticated with one of the bearer tokens of
+the file.`,
+	Example: `guaccollect http-server --http-server-token-file /secret/tokens
+curl -H "Authorization: Bearer $TOKEN" -H "Content-Type: application/spdx+json" \
+  --data-binary @sbom.spdx.json "http://localhost:8082/documents?source=sbom.spdx.json"`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateHTTPServerFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetBool("publish-to-queue"),
+			viper.GetString("http-server-addr"),
+			viper.GetString("http-server-token-file"),
+			viper.GetInt64("http-server-max-size"),
+			viper.GetString("http-server-tls-cert-file"),
+			viper.GetString("http-server-tls-key-file"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		// initialize blob store
+		blobStore, err := blob.NewBlobStore(ctx, opts.blobAddr)
+		if err != nil {
+			logger.Fatalf("unable to connect to blob store: %v", err)
+		}
+
+		var pubsub *emitter.EmitterPubSub
+		if opts.publishToQueue {
+			if strings.HasPrefix(opts.pubsubAddr, "nats://") {
+				// initialize jetstream
+				// TODO: pass in credentials file for NATS secure login
+				jetStream := emitter.NewJetStream(opts.pubsubAddr, "", "")
+				if err := jetStream.JetStreamInit(ctx); err != nil {
+					logger.Fatalf("jetStream initialization failed with error: %v", err)
+				}
+				defer jetStream.Close()
+			}
+			// initialize pubsub
+			pubsub = emitter.NewEmitterPubSub(ctx, opts.pubsubAddr)
+		}
+
+		if len(opts.tokens) == 0 {
+			logger.Warn("no bearer token set, uploads are not authenticated")
+		}
+		server := http.Server{
+			Addr: opts.addr,
+			Handler: httpserver.NewHandler(ctx, blobStore, pubsub, opts.publishToQueue,
+				httpserver.WithTokens(opts.tokens), httpserver.WithMaxDocumentSize(opts.maxSize)),
+		}
+
+		logger.Infof("accepting documents on %s", opts.addr)
+		go func() {
+			var err error
+			if opts.tlsCertFile != "" {
+				err = server.ListenAndServeTLS(opts.tlsCertFile, opts.tlsKeyFile)
+			} else {
+				err = server.ListenAndServe()
+			}
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Fatalf("server finished with error: %v", err)
+			}
+		}()
+
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+		s := <-sigs
+		logger.Infof("Signal received: %s, shutting down gracefully\n", s.String())
+
+		ctx, cf := context.WithTimeout(ctx, 5*time.Second)
+		defer cf()
+		if err := server.Shutdown(ctx); err != nil {
+			logger.Errorf("forcibly shutting down http-server: %v", err)
+			server.Close()
+		}
+	},
+}
+
+func validateHTTPServerFlags(pubsubAddr, blobAddr string, pubToQueue bool, addr, tokenFile string, maxSize int64,
+	tlsCertFile, tlsKeyFile string) (httpServerOptions, error) {
+	opts := httpServerOptions{
+		pubsubAddr:     pubsubAddr,
+		blobAddr:       blobAddr,
+		publishToQueue: pubToQueue,
+		addr:           addr,
+		maxSize:        maxSize,
+		tlsCertFile:    tlsCertFile,
+		tlsKeyFile:     tlsKeyFile,
+	}
+
+	if maxSize <= 0 {
+		return opts, fmt.Errorf("expected a positive --http-server-max-size")
+	}
+	if (tlsCertFile == "") != (tlsKeyFile == "") {
+		return opts, fmt.Errorf("expected both --http-server-tls-cert-file and --http-server-tls-key-file")
+	}
+
+	if tokenFile != "" {
+		b, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return opts, fmt.Errorf("unable to read token file: %w", err)
+		}
+		for _, line := range strings.Split(string(b), "\n") {
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+				opts.tokens = append(opts.tokens, line)
+			}
+		}
+		if len(opts.tokens) == 0 {
+			return opts, fmt.Errorf("no token in token file: %s", tokenFile)
+		}
+	}
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{
+		"http-server-addr",
+		"http-server-token-file",
+		"http-server-max-size",
+		"http-server-tls-cert-file",
+		"http-server-tls-key-file",
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	httpServerCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(httpServerCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	rootCmd.AddCommand(httpServerCmd)
+}
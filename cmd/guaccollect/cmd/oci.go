@@ -24,7 +24,6 @@ import (
 
 	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
-	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/oci"
@@ -175,7 +174,7 @@ func validateOCIFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c)
 		return opts, err
 	}
 
@@ -231,7 +230,7 @@ func validateOCIRegistryFlags(
 		if err != nil {
 			return opts, err
 		}
-		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		opts.dataSource, err = newCsubDatasource(c)
 		return opts, err
 	}
 
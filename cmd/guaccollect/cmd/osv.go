@@ -69,6 +69,9 @@ type osvOptions struct {
 	lastScan *int
 	// adds metadata to vulnerabities during collection
 	addVulnMetadata bool
+	// offlineDatabase is the downloaded OSV database matched instead of
+	// querying osv.dev
+	offlineDatabase string
 	// enable otel
 	enableOtel bool
 }
@@ -77,7 +80,8 @@ var osvCmd = &cobra.Command{
 	Use:   "osv [flags]",
 	Short: "runs the osv certifier",
 	Long: `
-guaccollect osv runs the osv certifier queries osv.dev for the packages that are collected in guac.
+guaccollect osv runs the osv certifier queries osv.dev, or a downloaded OSV database
+with --osv-offline-db, for the packages that are collected in guac.
 Ingestion to GUAC happens via an event stream (NATS)
 to allow for decoupling of the collectors from the ingestion into GUAC. 
 
@@ -104,6 +108,7 @@ you have access to read and write to the respective blob store.`,
 			viper.GetInt("certifier-batch-size"),
 			viper.GetInt("last-scan"),
 			viper.GetBool("add-vuln-metadata"),
+			viper.GetString("osv-offline-db"),
 			viper.GetBool("enable-otel"),
 		)
 		if err != nil {
@@ -132,6 +137,9 @@ you have access to read and write to the respective blob store.`,
 			if opts.addVulnMetadata {
 				certifierOpts = append(certifierOpts, osv.WithVulnerabilityMetadata())
 			}
+			if opts.offlineDatabase != "" {
+				certifierOpts = append(certifierOpts, osv.WithOfflineDatabase(opts.offlineDatabase))
+			}
 			return osv.NewOSVCertificationParser(certifierOpts...)
 		}, certifier.CertifierOSV); err != nil {
 			logger.Fatalf("unable to register certifier: %v", err)
@@ -162,6 +170,7 @@ func validateOSVFlags(
 	certifierLatencyStr string,
 	batchSize int, lastScan int,
 	addVulnMetadata bool,
+	offlineDatabase string,
 	enableOtel bool,
 ) (osvOptions, error) {
 	var opts osvOptions
@@ -175,6 +184,13 @@ func validateOSVFlags(
 	opts.addVulnMetadata = addVulnMetadata
 	opts.enableOtel = enableOtel
 
+	if offlineDatabase != "" {
+		if _, err := os.Stat(offlineDatabase); err != nil {
+			return opts, fmt.Errorf("invalid OSV database: %w", err)
+		}
+		opts.offlineDatabase = offlineDatabase
+	}
+
 	i, err := time.ParseDuration(interval)
 	if err != nil {
 		return opts, fmt.Errorf("failed to parser duration with error: %w", err)
@@ -291,7 +307,7 @@ func init() {
 		"interval",
 		"header-file", "certifier-latency",
 		"certifier-batch-size", "last-scan",
-		"add-vuln-metadata",
+		"add-vuln-metadata", "osv-offline-db",
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
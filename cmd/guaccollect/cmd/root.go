@@ -19,8 +19,12 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/cli"
+	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
 	"github.com/guacsec/guac/pkg/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -36,6 +40,7 @@ func init() {
 		"csub-tls",
 		"csub-tls-skip-verify",
 		"use-csub",
+		"csub-lease",
 		"service-poll",
 		"enable-prometheus",
 		"publish-to-queue",
@@ -69,3 +74,16 @@ func Execute() {
 		os.Exit(1)
 	}
 }
+
+// newCsubDatasource returns the datasource of the collectsub entries, leased
+// for --csub-lease so that several collectors share them.
+func newCsubDatasource(c csubclient.Client) (datasource.CollectSource, error) {
+	lease, err := time.ParseDuration(viper.GetString("csub-lease"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid csub-lease: %w", err)
+	}
+	if lease == 0 {
+		return csubsource.NewCsubDatasource(c, 10*time.Second)
+	}
+	return csubsource.NewCsubLeasingDatasource(c, 10*time.Second, lease)
+}
@@ -36,6 +36,7 @@ type csubOptions struct {
 	port        int
 	tlsCertFile string
 	tlsKeyFile  string
+	dbPath      string
 }
 
 var rootCmd = &cobra.Command{
@@ -48,6 +49,7 @@ var rootCmd = &cobra.Command{
 			viper.GetInt("csub-listen-port"),
 			viper.GetString("csub-tls-cert-file"),
 			viper.GetString("csub-tls-key-file"),
+			viper.GetString("csub-db-path"),
 		)
 
 		if err != nil {
@@ -60,7 +62,7 @@ var rootCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 
 		// Start csub listening server
-		csubServer, err := server.NewServer(opts.port, opts.tlsCertFile, opts.tlsKeyFile)
+		csubServer, err := server.NewServer(opts.port, opts.tlsCertFile, opts.tlsKeyFile, opts.dbPath)
 		if err != nil {
 			logger.Fatalf("unable to create csub server: %v", err)
 		}
@@ -83,11 +85,12 @@ var rootCmd = &cobra.Command{
 	},
 }
 
-func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string) (csubOptions, error) {
+func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string, dbPath string) (csubOptions, error) {
 	var opts csubOptions
 	opts.port = port
 	opts.tlsCertFile = tlsCertFile
 	opts.tlsKeyFile = tlsKeyFile
+	opts.dbPath = dbPath
 
 	return opts, nil
 }
@@ -95,7 +98,7 @@ func validateCsubFlags(port int, tlsCertFile string, tlsKeyFile string) (csubOpt
 func init() {
 	cobra.OnInitialize(cli.InitConfig)
 
-	set, err := cli.BuildFlags([]string{"csub-listen-port", "csub-tls-cert-file", "csub-tls-key-file"})
+	set, err := cli.BuildFlags([]string{"csub-listen-port", "csub-tls-cert-file", "csub-tls-key-file", "csub-db-path"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
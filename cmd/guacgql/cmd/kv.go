@@ -0,0 +1,88 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/guacsec/guac/pkg/assembler/kv/bolt"
+)
+
+var kvCmd = &cobra.Command{
+	Use:   "kv",
+	Short: "Maintenance of the database file of the bolt keyvalue store",
+	Long: `Maintenance of the database file used with --kv-store=bolt.
+The GraphQL server must be stopped while these commands run.`,
+}
+
+var kvBackupCmd = &cobra.Command{
+	Use:   "backup [flags] output_file",
+	Short: "Write a consistent copy of the bolt database to output_file",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := kvPath(cmd)
+		out, err := os.Create(args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to create backup file: %v\n", err)
+			os.Exit(1)
+		}
+		if err := bolt.Backup(path, out); err != nil {
+			_ = out.Close()
+			_ = os.Remove(args[0])
+			fmt.Fprintf(os.Stderr, "failed to back up %q: %v\n", path, err)
+			os.Exit(1)
+		}
+		if err := out.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write backup file: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("backed up %q to %q\n", path, args[0])
+	},
+}
+
+var kvCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Rewrite the bolt database to give unused space back to the file system",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := kvPath(cmd)
+		before, after, err := bolt.Compact(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to compact %q: %v\n", path, err)
+			os.Exit(1)
+		}
+		fmt.Printf("compacted %q from %d to %d bytes\n", path, before, after)
+	},
+}
+
+// kvPath returns the --kv-path of the command, falling back to the value
+// from guac.yaml or the environment.
+func kvPath(cmd *cobra.Command) string {
+	if f := cmd.Flags().Lookup("kv-path"); f != nil && f.Changed {
+		return f.Value.String()
+	}
+	return viper.GetString("kv-path")
+}
+
+func init() {
+	kvCmd.PersistentFlags().String("kv-path", "guac.db", "Path of the database file of the bolt keyvalue store")
+	kvCmd.AddCommand(kvBackupCmd, kvCompactCmd)
+	rootCmd.AddCommand(kvCmd)
+}
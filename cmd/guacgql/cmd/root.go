@@ -36,6 +36,12 @@ var flags = struct {
 	debug       bool
 	tracegql    bool
 	enableOtel  bool
+	// tenant scoping flags
+	tenantTokensFile string
+	tenantHeader     bool
+	// webhook notification flags
+	notifyConfig   string
+	notifySpoolDir string
 }{}
 
 var rootCmd = &cobra.Command{
@@ -50,6 +56,10 @@ var rootCmd = &cobra.Command{
 		flags.debug = viper.GetBool("gql-debug")
 		flags.tracegql = viper.GetBool("gql-trace")
 		flags.enableOtel = viper.GetBool("enable-otel")
+		flags.tenantTokensFile = viper.GetString("gql-tenant-tokens-file")
+		flags.tenantHeader = viper.GetBool("gql-tenant-header")
+		flags.notifyConfig = viper.GetString("gql-notify-config")
+		flags.notifySpoolDir = viper.GetString("gql-notify-spool-dir")
 
 		startServer(cmd)
 	},
@@ -66,6 +76,10 @@ func init() {
 		"gql-debug",
 		"gql-backend",
 		"gql-trace",
+		"gql-tenant-tokens-file",
+		"gql-tenant-header",
+		"gql-notify-config",
+		"gql-notify-spool-dir",
 		"enable-prometheus",
 		"enable-otel",
 	})
@@ -39,9 +39,12 @@ import (
 	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/neo4j"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/neptune"
+	"github.com/guacsec/guac/pkg/assembler/events"
 	"github.com/guacsec/guac/pkg/assembler/server"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/metrics"
+	"github.com/guacsec/guac/pkg/notify"
+	"github.com/guacsec/guac/pkg/tenant"
 	"github.com/guacsec/guac/pkg/version"
 )
 
@@ -68,7 +71,21 @@ func startServer(cmd *cobra.Command) {
 		os.Exit(1)
 	}
 
-	srv := server.GetGraphqlServer(ctx, backend)
+	bus := events.NewBus()
+	srv := server.GetGraphqlServerWithEvents(ctx, backend, bus)
+
+	if flags.notifyConfig != "" {
+		cfg, err := notify.LoadConfig(flags.notifyConfig)
+		if err != nil {
+			logger.Fatalf("Error loading notify config: %v", err)
+		}
+		notifier, err := notify.New(cfg, backend, flags.notifySpoolDir)
+		if err != nil {
+			logger.Fatalf("Error creating notifier: %v", err)
+		}
+		go notifier.Run(ctx, bus)
+		logger.Infof("delivering notifications for %d rules to %d webhooks", len(cfg.Rules), len(cfg.Webhooks))
+	}
 
 	metric, err := setupPrometheus(ctx, "guacgql")
 	if err != nil {
@@ -96,6 +113,18 @@ func startServer(cmd *cobra.Command) {
 		}()
 	}
 
+	if flags.tenantTokensFile != "" {
+		tokens, err := tenant.LoadTokens(flags.tenantTokensFile)
+		if err != nil {
+			logger.Fatalf("Error loading tenant tokens: %v", err)
+		}
+		srvHandler = tenant.Middleware(tenant.TokenResolver(tokens), srvHandler)
+		logger.Infof("scoping graphql requests to %d tenant tokens", len(tokens))
+	} else if flags.tenantHeader {
+		srvHandler = tenant.Middleware(tenant.HeaderResolver(), srvHandler)
+		logger.Infof("scoping graphql requests to the %s header", tenant.Header)
+	}
+
 	if flags.tracegql {
 		tracer := &debug.Tracer{}
 		srv.Use(tracer)
@@ -163,6 +192,11 @@ func validateFlags() error {
 	if !slices.Contains(backends.List(), flags.backend) {
 		return fmt.Errorf("invalid graphql backend specified: %v", flags.backend)
 	}
+	// only the ent backend scopes the predicates to the tenant of the
+	// request, the others would serve every tenant the predicates of all
+	if (flags.tenantTokensFile != "" || flags.tenantHeader) && flags.backend != "ent" {
+		return fmt.Errorf("tenant scoping is only supported by the ent backend, not %v", flags.backend)
+	}
 	return nil
 }
 
@@ -31,6 +31,8 @@ import (
 	"github.com/guacsec/guac/pkg/cli"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
 	"github.com/guacsec/guac/pkg/ingestor"
@@ -134,6 +136,13 @@ func ingest(cmd *cobra.Command, args []string) {
 				return fmt.Errorf("unable to ingest document due to connection error with graphQL %q : %w", d.SourceInformation.Source, urlErr)
 			}
 			d.ChildLogger.Errorf("unable to ingest document %q : %v", d.SourceInformation.Source, err)
+			if err := collector.UpdateStatus(ctx, blobStore, events.GetKey(d.Blob), collector.StatusFailed, err); err != nil {
+				d.ChildLogger.Errorf("unable to update document status: %v", err)
+			}
+			return nil
+		}
+		if err := collector.UpdateStatus(ctx, blobStore, events.GetKey(d.Blob), collector.StatusIngested, nil); err != nil {
+			d.ChildLogger.Errorf("unable to update document status: %v", err)
 		}
 		return nil
 	}
@@ -0,0 +1,191 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/csaf"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type csafOptions struct {
+	graphqlEndpoint         string
+	headerFile              string
+	csubClientOptions       csub_client.CsubClientOptions
+	dir                     string
+	poll                    bool
+	interval                time.Duration
+	queryVulnOnIngestion    bool
+	queryLicenseOnIngestion bool
+	queryEOLOnIngestion     bool
+	queryDepsDevOnIngestion bool
+}
+
+var csafCmd = &cobra.Command{
+	Use:   "csaf [flags] provider_dir",
+	Short: "takes a local copy of a CSAF provider and injects its CSAF advisories and VEX documents to GUAC graph. This command talks directly to the graphQL endpoint",
+	Long: `The csaf command collects the CSAF documents of a local copy of a CSAF 2.0
+provider, such as a mirror of its /.well-known/csaf directory. The directory
+holds the provider-metadata.json of the provider, and the documents of its
+distributions: the ROLIE feeds it lists and the directories listed by their
+index.txt.`,
+	Example: `guacone collect csaf ./mirror/acme.example/.well-known/csaf
+guacone collect csaf --poll --interval 1h ./mirror/acme.example/.well-known/csaf`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateCSAFFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("csub-addr"),
+			viper.GetString("interval"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("add-vuln-on-ingest"),
+			viper.GetBool("add-license-on-ingest"),
+			viper.GetBool("add-eol-on-ingest"),
+			viper.GetBool("add-depsdev-on-ingest"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		var csafOpts []csaf.Opt
+		if opts.poll {
+			csafOpts = append(csafOpts, csaf.WithPolling(opts.interval))
+		}
+		csafCollector, err := csaf.NewCSAFCollector(opts.dir, csafOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create csaf collector: %v", err)
+		}
+		if err := collector.RegisterDocumentCollector(csafCollector, csaf.CollectorCSAF); err != nil {
+			logger.Fatalf("unable to register csaf collector: %v", err)
+		}
+
+		// initialize collectsub client
+		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+		if err != nil {
+			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+			csubClient = nil
+		} else {
+			defer csubClient.Close()
+		}
+
+		totalNum := 0
+		gotErr := false
+
+		emit := func(d *processor.Document) error {
+			totalNum += 1
+			_, err := ingestor.Ingest(
+				ctx,
+				d,
+				opts.graphqlEndpoint,
+				transport,
+				csubClient,
+				opts.queryVulnOnIngestion,
+				opts.queryLicenseOnIngestion,
+				opts.queryEOLOnIngestion,
+				opts.queryDepsDevOnIngestion,
+			)
+
+			if err != nil {
+				gotErr = true
+				return fmt.Errorf("unable to ingest document: %w", err)
+			}
+			return nil
+		}
+
+		// Collect
+		errHandler := func(err error) bool {
+			if err == nil {
+				logger.Info("collector ended gracefully")
+				return true
+			}
+			logger.Errorf("collector ended with error: %v", err)
+			return false
+		}
+		if err := collector.Collect(ctx, emit, errHandler); err != nil {
+			logger.Fatal(err)
+		}
+
+		if gotErr {
+			logger.Fatalf("completed ingestion with errors")
+		} else {
+			logger.Infof("completed ingesting %v documents", totalNum)
+		}
+	},
+}
+
+func validateCSAFFlags(gqlEndpoint, headerFile, csubAddr, interval string, poll, csubTls, csubTlsSkipVerify bool,
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool, args []string) (csafOptions, error) {
+	var opts csafOptions
+	opts.graphqlEndpoint = gqlEndpoint
+	opts.headerFile = headerFile
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected positional argument for provider_dir")
+	}
+	opts.dir = args[0]
+
+	opts.poll = poll
+	if opts.interval, err = time.ParseDuration(interval); err != nil {
+		return opts, fmt.Errorf("failed to parse interval: %w", err)
+	}
+	opts.queryVulnOnIngestion = queryVulnIngestion
+	opts.queryLicenseOnIngestion = queryLicenseIngestion
+	opts.queryEOLOnIngestion = queryEOLIngestion
+	opts.queryDepsDevOnIngestion = queryDepsDevOnIngestion
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"poll", "interval"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	csafCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(csafCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	collectCmd.AddCommand(csafCmd)
+}
@@ -0,0 +1,121 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/guacanalytics"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type diffOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	from            policy.Subject
+	to              policy.Subject
+	format          guacanalytics.DiffFormat
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [flags] <purl|digest> <purl|digest>",
+	Short: "compare the subgraphs of two packages or artifacts",
+	Long: `The diff command compares the subgraphs of two packages (given by purl) or
+artifacts (given by algorithm:digest), such as two releases of a package: the
+dependencies added, removed or whose version changed, the artifacts the
+package occurs as, the licenses, and the vulnerabilities and their VEX
+status.
+
+The differences are printed as a table, as JSON, or as Markdown to paste in
+a pull request comment.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateDiffFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("format"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		diff, err := guacanalytics.DiffSubjects(ctx, gqlclient, opts.from, opts.to)
+		if err != nil {
+			logger.Fatalf("error comparing %s and %s: %v", opts.from, opts.to, err)
+		}
+		if err := diff.Write(os.Stdout, opts.format); err != nil {
+			logger.Fatalf("error printing diff: %v", err)
+		}
+	},
+}
+
+func validateDiffFlags(graphqlEndpoint, headerFile, format string, args []string) (diffOptions, error) {
+	opts := diffOptions{
+		graphqlEndpoint: graphqlEndpoint,
+		headerFile:      headerFile,
+		format:          guacanalytics.DiffFormat(format),
+	}
+	switch opts.format {
+	case guacanalytics.DiffTable, guacanalytics.DiffJSON, guacanalytics.DiffMarkdown:
+	default:
+		return opts, fmt.Errorf("unknown format %q, expected table, json or markdown", format)
+	}
+	if len(args) != 2 || args[0] == "" || args[1] == "" {
+		return opts, fmt.Errorf("expected two purls or digests")
+	}
+	opts.from, opts.to = diffSubject(args[0]), diffSubject(args[1])
+	return opts, nil
+}
+
+func diffSubject(arg string) policy.Subject {
+	if strings.HasPrefix(arg, "pkg:") {
+		return policy.Subject{Purl: arg}
+	}
+	return policy.Subject{Digest: arg}
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"format"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	diffCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(diffCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	rootCmd.AddCommand(diffCmd)
+}
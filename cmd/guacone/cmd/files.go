@@ -57,6 +57,10 @@ type fileOptions struct {
 	queryEOLOnIngestion     bool
 	queryDepsDevOnIngestion bool
 	enableOtel              bool
+	// watch the path instead of scanning it once
+	watch           bool
+	watchDebounce   time.Duration
+	watchCheckpoint string
 }
 
 var filesCmd = &cobra.Command{
@@ -76,6 +80,9 @@ var filesCmd = &cobra.Command{
 			viper.GetBool("add-eol-on-ingest"),
 			viper.GetBool("add-depsdev-on-ingest"),
 			viper.GetBool("enable-otel"),
+			viper.GetBool("watch"),
+			viper.GetString("watch-debounce"),
+			viper.GetString("watch-checkpoint"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -125,7 +132,11 @@ var filesCmd = &cobra.Command{
 		}
 
 		// Register collector
-		fileCollector := file.NewFileCollector(ctx, opts.path, false, time.Second)
+		var fileOpts []file.Opt
+		if opts.watch {
+			fileOpts = append(fileOpts, file.WithWatch(opts.watchDebounce), file.WithCheckpoint(opts.watchCheckpoint))
+		}
+		fileCollector := file.NewFileCollector(ctx, opts.path, false, time.Second, fileOpts...)
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -197,12 +208,23 @@ func validateFilesFlags(keyPath, keyID, graphqlEndpoint, headerFile, csubAddr st
 	queryEOLIngestion bool,
 	queryDepsDevOnIngestion bool,
 	enableOtel bool,
+	watch bool,
+	watchDebounce, watchCheckpoint string,
 	args []string,
 ) (fileOptions, error) {
 	var opts fileOptions
 	opts.graphqlEndpoint = graphqlEndpoint
 	opts.headerFile = headerFile
 	opts.enableOtel = enableOtel
+	opts.watch = watch
+	opts.watchCheckpoint = watchCheckpoint
+	if watch {
+		debounce, err := time.ParseDuration(watchDebounce)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse watch debounce: %w", err)
+		}
+		opts.watchDebounce = debounce
+	}
 
 	if keyPath != "" {
 		if strings.HasSuffix(keyPath, "pem") {
@@ -237,6 +259,9 @@ func init() {
 		"verifier-key-path",
 		"verifier-key-id",
 		"enable-otel",
+		"watch",
+		"watch-debounce",
+		"watch-checkpoint",
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
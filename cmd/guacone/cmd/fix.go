@@ -0,0 +1,145 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type queryFixOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	purl            string
+}
+
+var (
+	colTitleFixPath         = "Dependency Path"
+	colTitleFixVersionRange = "Version Range"
+	colTitleFixAllowed      = "Allows Upgrade"
+	colTitleFixVersion      = "Allowed Fixed Version"
+	fixRowHeader            = table.Row{colTitleFixPath, colTitleFixVersionRange, colTitleFixAllowed, colTitleFixVersion}
+)
+
+var queryFixCmd = &cobra.Command{
+	Use:   "fix [flags] <purl>",
+	Short: "Query for the minimal upgrade of a package version clearing its known vulnerabilities.",
+	Long: `Query for the minimal upgrade of a package version clearing its known vulnerabilities.
+  <purl> must name a package version in the graph.
+
+The upgrade is the lowest later version of the package in the graph, or fixed
+version of its ingested vulnerability ranges, that no known vulnerability
+affects. Each dependency path leading to the package is listed with whether the
+version range of its parent dependency allows that upgrade.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateQueryFixFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		pkgSpec, err := helpers.PurlToPkgFilter(opts.purl)
+		if err != nil {
+			logger.Fatalf("failed to convert purl to package filter: %v", err)
+		}
+		fixResponse, err := model.FixRecommendation(ctx, gqlclient, pkgSpec)
+		if err != nil {
+			logger.Fatalf("error querying for fix recommendation: %v", err)
+		}
+
+		fix := fixResponse.FixRecommendation
+		if len(fix.Vulnerabilities) == 0 {
+			fmt.Printf("No known vulnerability affects %s\n", opts.purl)
+			return
+		}
+		var vulnIDs []string
+		for _, vuln := range fix.Vulnerabilities {
+			for _, id := range vuln.VulnerabilityIDs {
+				vulnIDs = append(vulnIDs, id.VulnerabilityID)
+			}
+		}
+		fmt.Printf("Known vulnerabilities: %s\n", strings.Join(vulnIDs, ", "))
+		if fix.FixedVersion == "" {
+			fmt.Println("No version clearing all known vulnerabilities found!")
+		} else {
+			fmt.Printf("Recommended upgrade: %s\n", fix.FixedVersion)
+		}
+
+		if len(fix.Paths) == 0 {
+			fmt.Println("No dependency paths to the package found!")
+			return
+		}
+		t := table.NewWriter()
+		t.AppendHeader(fixRowHeader)
+		for _, path := range fix.Paths {
+			var purls []string
+			for _, dep := range path.Dependencies {
+				purls = append(purls, helpers.AllPkgTreeToPurl(&dep.Package.AllPkgTree))
+			}
+			purls = append(purls, opts.purl)
+			versionRange := path.Dependencies[len(path.Dependencies)-1].VersionRange
+			if versionRange == "" {
+				versionRange = "any"
+			}
+			t.AppendRow(table.Row{strings.Join(purls, " -> "), versionRange, strconv.FormatBool(path.Allowed), path.FixedVersion})
+		}
+		fmt.Println(t.Render())
+	},
+}
+
+func validateQueryFixFlags(graphqlEndpoint, headerFile string, args []string) (queryFixOptions, error) {
+	var opts queryFixOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected positional argument for <purl>")
+	}
+	if _, err := helpers.PurlToPkg(args[0]); err != nil {
+		return opts, fmt.Errorf("expected <purl> to be a valid purl: %w", err)
+	}
+	opts.purl = args[0]
+
+	return opts, nil
+}
+
+func init() {
+	queryCmd.AddCommand(queryFixCmd)
+}
@@ -0,0 +1,202 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	git_collector "github.com/guacsec/guac/pkg/handler/collector/git"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type gitOptions struct {
+	graphqlEndpoint         string
+	headerFile              string
+	csubClientOptions       csub_client.CsubClientOptions
+	url                     string
+	dir                     string
+	globs                   []string
+	history                 bool
+	poll                    bool
+	interval                time.Duration
+	queryVulnOnIngestion    bool
+	queryLicenseOnIngestion bool
+	queryEOLOnIngestion     bool
+	queryDepsDevOnIngestion bool
+}
+
+var gitCmd = &cobra.Command{
+	Use:   "git [flags] repository_url",
+	Short: "takes SBOMs, VEX documents and attestations committed to a git repository and injects them to GUAC graph. This command talks directly to the graphQL endpoint",
+	Long: `The git command clones the repository to --git-dir and collects the committed
+files matching --git-globs. The last collected commit is recorded in the clone,
+so that later runs, or polls, only collect the files changed since. With
+--git-history, every commit not collected yet is collected in turn, oldest
+first, so that successive versions of a VEX document are all ingested.`,
+	Example: "guacone collect git https://github.com/org/vex --git-dir /var/lib/guac/vex --git-globs '*.json' --git-history",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateGitFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("csub-addr"),
+			viper.GetString("git-dir"),
+			viper.GetString("git-globs"),
+			viper.GetString("interval"),
+			viper.GetBool("git-history"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("add-vuln-on-ingest"),
+			viper.GetBool("add-license-on-ingest"),
+			viper.GetBool("add-eol-on-ingest"),
+			viper.GetBool("add-depsdev-on-ingest"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		gitCollector := git_collector.NewGitDocumentCollector(ctx, opts.url, opts.dir, opts.poll, opts.interval,
+			git_collector.WithGlobs(opts.globs), git_collector.WithHistory(opts.history))
+		if err := collector.RegisterDocumentCollector(gitCollector, git_collector.CollectorGitDocument); err != nil {
+			logger.Fatalf("unable to register git collector: %v", err)
+		}
+
+		// initialize collectsub client
+		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+		if err != nil {
+			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+			csubClient = nil
+		} else {
+			defer csubClient.Close()
+		}
+
+		totalNum := 0
+		gotErr := false
+
+		emit := func(d *processor.Document) error {
+			totalNum += 1
+			_, err := ingestor.Ingest(
+				ctx,
+				d,
+				opts.graphqlEndpoint,
+				transport,
+				csubClient,
+				opts.queryVulnOnIngestion,
+				opts.queryLicenseOnIngestion,
+				opts.queryEOLOnIngestion,
+				opts.queryDepsDevOnIngestion,
+			)
+
+			if err != nil {
+				gotErr = true
+				return fmt.Errorf("unable to ingest document: %w", err)
+			}
+			return nil
+		}
+
+		// Collect
+		errHandler := func(err error) bool {
+			if err == nil {
+				logger.Info("collector ended gracefully")
+				return true
+			}
+			logger.Errorf("collector ended with error: %v", err)
+			return false
+		}
+		if err := collector.Collect(ctx, emit, errHandler); err != nil {
+			logger.Fatal(err)
+		}
+
+		if gotErr {
+			logger.Fatalf("completed ingestion with errors")
+		} else {
+			logger.Infof("completed ingesting %v documents", totalNum)
+		}
+	},
+}
+
+func validateGitFlags(gqlEndpoint, headerFile, csubAddr, dir, globs, interval string, history, poll, csubTls, csubTlsSkipVerify bool,
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool, args []string) (gitOptions, error) {
+	var opts gitOptions
+	opts.graphqlEndpoint = gqlEndpoint
+	opts.headerFile = headerFile
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	if len(args) < 1 {
+		return opts, fmt.Errorf("expected positional argument: repository_url")
+	}
+	opts.url = args[0]
+
+	if dir == "" {
+		return opts, fmt.Errorf("expected --git-dir flag")
+	}
+	opts.dir = dir
+	for _, glob := range strings.Split(globs, ",") {
+		if glob = strings.TrimSpace(glob); glob != "" {
+			opts.globs = append(opts.globs, glob)
+		}
+	}
+	opts.history = history
+
+	opts.poll = poll
+	if opts.interval, err = time.ParseDuration(interval); err != nil {
+		return opts, fmt.Errorf("failed to parse interval: %w", err)
+	}
+	opts.queryVulnOnIngestion = queryVulnIngestion
+	opts.queryLicenseOnIngestion = queryLicenseIngestion
+	opts.queryEOLOnIngestion = queryEOLIngestion
+	opts.queryDepsDevOnIngestion = queryDepsDevOnIngestion
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"git-dir", "git-globs", "git-history", "poll", "interval"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	gitCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(gitCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	collectCmd.AddCommand(gitCmd)
+}
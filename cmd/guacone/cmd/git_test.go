@@ -0,0 +1,82 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateGitFlags(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		dir       string
+		globs     string
+		interval  string
+		wantGlobs []string
+		errorMsg  string
+	}{
+		{
+			name:     "no args",
+			dir:      "/tmp/repo",
+			interval: "5m",
+			errorMsg: "expected positional argument: repository_url",
+		},
+		{
+			name:     "no dir",
+			args:     []string{"https://github.com/org/vex"},
+			interval: "5m",
+			errorMsg: "expected --git-dir flag",
+		},
+		{
+			name:     "bad interval",
+			args:     []string{"https://github.com/org/vex"},
+			dir:      "/tmp/repo",
+			interval: "often",
+			errorMsg: `failed to parse interval: time: invalid duration "often"`,
+		},
+		{
+			name:      "globs",
+			args:      []string{"https://github.com/org/vex"},
+			dir:       "/tmp/repo",
+			globs:     "*.json, vex/*.json,",
+			interval:  "5m",
+			wantGlobs: []string{"*.json", "vex/*.json"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := validateGitFlags("", "", "", tc.dir, tc.globs, tc.interval, false, false, false, false, false, false, false, false, tc.args)
+			if err != nil {
+				if tc.errorMsg != err.Error() {
+					t.Errorf("expected error message: %s, got: %s", tc.errorMsg, err.Error())
+				}
+				return
+			}
+			if tc.errorMsg != "" {
+				t.Errorf("expected error message: %s, got none", tc.errorMsg)
+			}
+			if o.url != tc.args[0] {
+				t.Errorf("expected url: %s, got: %s", tc.args[0], o.url)
+			}
+			if !slices.Equal(o.globs, tc.wantGlobs) {
+				t.Errorf("expected globs: %v, got: %v", tc.wantGlobs, o.globs)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/guacsec/guac/pkg/assembler/archive"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	// import all known backends
+	_ "github.com/guacsec/guac/pkg/assembler/backends/arangodb"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/neo4j"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/neptune"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Export the graph to an archive, or import an archive into GUAC",
+}
+
+var graphExportCmd = &cobra.Command{
+	Use:   "export [flags] archive_file",
+	Short: "Write every node of a backend to a versioned archive, use - for stdout",
+	Long: `Write every noun and predicate of a backend to a versioned archive.
+The backend is read directly, so the GraphQL server should not be ingesting
+while the export runs.`,
+	Example: `  guacone graph export --gql-backend keyvalue --kv-store bolt --kv-path guac.db guac-archive.ndjson`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		backendName := viper.GetString("gql-backend")
+		backendArgs, err := backends.GetBackendArgs(ctx, backendName)
+		if err != nil {
+			logger.Fatalf("failed to parse backend flags with error: %v", err)
+		}
+		backend, err := backends.Get(backendName, ctx, backendArgs)
+		if err != nil {
+			logger.Fatalf("error creating %v backend: %v", backendName, err)
+		}
+
+		var out io.WriteCloser = os.Stdout
+		if args[0] != "-" {
+			out, err = os.Create(args[0])
+			if err != nil {
+				logger.Fatalf("failed to create archive file: %v", err)
+			}
+		}
+		w, err := archive.NewWriter(out, version.Version)
+		if err != nil {
+			logger.Fatalf("failed to write archive: %v", err)
+		}
+		stats, err := archive.Export(ctx, backend, w, viper.GetInt("page-size"))
+		if err != nil {
+			logger.Fatalf("failed to export graph: %v", err)
+		}
+		if err := out.Close(); err != nil {
+			logger.Fatalf("failed to write archive file: %v", err)
+		}
+		logger.Infof("exported %d nouns and %d predicates", stats.Nouns, stats.Predicates)
+	},
+}
+
+var graphImportCmd = &cobra.Command{
+	Use:   "import [flags] archive_file",
+	Short: "Ingest an archive written by graph export through GraphQL, use - for stdin",
+	Long: `Ingest an archive written by graph export into the GraphQL server at
+--gql-addr. Any backend can be used, origins and timestamps of the archive are kept.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		var in io.ReadCloser = os.Stdin
+		if args[0] != "-" {
+			var err error
+			in, err = os.Open(args[0])
+			if err != nil {
+				logger.Fatalf("failed to open archive file: %v", err)
+			}
+		}
+		defer in.Close()
+
+		r, err := archive.NewReader(in)
+		if err != nil {
+			logger.Fatalf("failed to read archive: %v", err)
+		}
+		logger.Infof("importing archive version %d written by GUAC %s on %s",
+			r.Header.Version, r.Header.GUACVersion, r.Header.Created)
+
+		transport := cli.HTTPHeaderTransport(ctx, viper.GetString("header-file"), http.DefaultTransport)
+		gqlclient := graphql.NewClient(viper.GetString("gql-addr"), &http.Client{Transport: transport})
+
+		stats, err := archive.Import(ctx, logger, r, gqlclient)
+		if err != nil {
+			logger.Fatalf("failed to import graph after %d records: %v", stats.Records, err)
+		}
+		logger.Infof("imported %d nouns and %d predicates", stats.Nouns, stats.Predicates)
+	},
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"gql-backend"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	graphExportCmd.Flags().AddFlagSet(set)
+	graphExportCmd.Flags().Int("page-size", archive.DefaultPageSize, "number of nodes read from the backend at a time")
+	if err := backends.RegisterFlags(graphExportCmd); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to register backend flags: %v", err)
+		os.Exit(1)
+	}
+	if err := viper.BindPFlags(graphExportCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	graphCmd.AddCommand(graphExportCmd, graphImportCmd)
+	rootCmd.AddCommand(graphCmd)
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateOCILayoutFlags(t *testing.T) {
+	dir := t.TempDir()
+	testCases := []struct {
+		name     string
+		args     []string
+		errorMsg string
+	}{
+		{
+			name:     "no args",
+			errorMsg: "expected positional argument: layout_path",
+		},
+		{
+			name:     "missing layout",
+			args:     []string{filepath.Join(dir, "image.tar")},
+			errorMsg: "unable to read layout",
+		},
+		{
+			name: "layout",
+			args: []string{dir},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := validateOCILayoutFlags("", "", "", false, false, false, false, false, false, tc.args)
+			if err != nil {
+				if tc.errorMsg == "" || !strings.HasPrefix(err.Error(), tc.errorMsg) {
+					t.Errorf("expected error message: %s, got: %s", tc.errorMsg, err.Error())
+				}
+				return
+			}
+			if tc.errorMsg != "" {
+				t.Errorf("expected error message: %s, got none", tc.errorMsg)
+			}
+			if o.path != tc.args[0] {
+				t.Errorf("expected path: %s, got: %s", tc.args[0], o.path)
+			}
+		})
+	}
+}
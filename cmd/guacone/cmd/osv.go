@@ -65,6 +65,9 @@ type osvOptions struct {
 	lastScan *int
 	// addVulnMetadata enriches vulnerabilities with metadata during fetch
 	addVulnMetadata bool
+	// offlineDatabase is the downloaded OSV database matched instead of
+	// querying osv.dev
+	offlineDatabase string
 	enableOtel      bool
 }
 
@@ -88,6 +91,7 @@ var osvCmd = &cobra.Command{
 			viper.GetInt("certifier-batch-size"),
 			viper.GetInt("last-scan"),
 			viper.GetBool("add-vuln-metadata"),
+			viper.GetString("osv-offline-db"),
 			viper.GetBool("enable-otel"),
 		)
 		if err != nil {
@@ -117,6 +121,9 @@ var osvCmd = &cobra.Command{
 			if opts.addVulnMetadata {
 				certifierOpts = append(certifierOpts, osv.WithVulnerabilityMetadata())
 			}
+			if opts.offlineDatabase != "" {
+				certifierOpts = append(certifierOpts, osv.WithOfflineDatabase(opts.offlineDatabase))
+			}
 			return osv.NewOSVCertificationParser(certifierOpts...)
 		}, certifier.CertifierOSV); err != nil {
 			logger.Fatalf("unable to register certifier: %v", err)
@@ -306,6 +313,7 @@ func validateOSVFlags(
 	certifierLatencyStr string,
 	batchSize int, lastScan int,
 	addVulnMetadata bool,
+	offlineDatabase string,
 	enableOtel bool,
 ) (osvOptions, error) {
 	var opts osvOptions
@@ -317,8 +325,16 @@ func validateOSVFlags(
 		return opts, err
 	}
 	opts.interval = i
+	opts.addVulnMetadata = addVulnMetadata
 	opts.enableOtel = enableOtel
 
+	if offlineDatabase != "" {
+		if _, err := os.Stat(offlineDatabase); err != nil {
+			return opts, fmt.Errorf("invalid OSV database: %w", err)
+		}
+		opts.offlineDatabase = offlineDatabase
+	}
+
 	if certifierLatencyStr != "" {
 		addedLatency, err := time.ParseDuration(certifierLatencyStr)
 		if err != nil {
@@ -352,7 +368,7 @@ func init() {
 	set, err := cli.BuildFlags([]string{
 		"certifier-latency",
 		"certifier-batch-size", "last-scan",
-		"add-vuln-metadata",
+		"add-vuln-metadata", "osv-offline-db",
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
@@ -0,0 +1,158 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type policyEvalOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	subject         policy.Subject
+	policyDir       string
+	printJSON       bool
+}
+
+var policyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Evaluates policies against the GUAC graph",
+}
+
+var policyEvalCmd = &cobra.Command{
+	Use:   "eval [flags] <purl|digest>",
+	Short: "evaluate policies against the subgraph of a package or artifact",
+	Long: `The eval command evaluates CEL policies against the subgraph of a package
+(given by purl) or of an artifact (given by algorithm:digest): its SBOM
+dependencies, SLSA provenance, scorecards, licenses, vulnerabilities and VEX
+statements.
+
+Policies are YAML files with a name, a description and a CEL expression
+returning the list of violations. Without --policy, the built-in policies
+are evaluated. The command exits with status 1 if any policy fails.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validatePolicyEvalFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("policy"),
+			viper.GetBool("json"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		var policies []*policy.Policy
+		if opts.policyDir != "" {
+			policies, err = policy.LoadDir(opts.policyDir)
+		} else {
+			policies, err = policy.Builtin()
+		}
+		if err != nil {
+			logger.Fatalf("error loading policies: %v", err)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		verdict, err := policy.Eval(ctx, gqlclient, opts.subject, policies)
+		if err != nil {
+			logger.Fatalf("error evaluating policies: %v", err)
+		}
+
+		if opts.printJSON {
+			out, err := json.MarshalIndent(verdict, "", "  ")
+			if err != nil {
+				logger.Fatalf("error encoding verdict: %v", err)
+			}
+			fmt.Println(string(out))
+		} else {
+			printVerdict(verdict)
+		}
+		if !verdict.Pass {
+			os.Exit(1)
+		}
+	},
+}
+
+func printVerdict(verdict *policy.Verdict) {
+	for _, r := range verdict.Results {
+		status := "PASS"
+		if !r.Pass {
+			status = "FAIL"
+		}
+		fmt.Printf("%s %s\n", status, r.Policy)
+		for _, v := range r.Violations {
+			fmt.Printf("    %s\n", v)
+		}
+	}
+	if verdict.Pass {
+		fmt.Printf("%s passes all policies\n", verdict.Subject)
+	} else {
+		fmt.Printf("%s fails policies\n", verdict.Subject)
+	}
+}
+
+func validatePolicyEvalFlags(graphqlEndpoint, headerFile, policyDir string, printJSON bool, args []string) (policyEvalOptions, error) {
+	opts := policyEvalOptions{
+		graphqlEndpoint: graphqlEndpoint,
+		headerFile:      headerFile,
+		policyDir:       policyDir,
+		printJSON:       printJSON,
+	}
+	if len(args) != 1 || args[0] == "" {
+		return opts, fmt.Errorf("expected a purl or a digest")
+	}
+	if strings.HasPrefix(args[0], "pkg:") {
+		opts.subject.Purl = args[0]
+	} else {
+		opts.subject.Digest = args[0]
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"policy", "json"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	policyEvalCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(policyEvalCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	policyCmd.AddCommand(policyEvalCmd)
+	rootCmd.AddCommand(policyCmd)
+}
@@ -0,0 +1,227 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/rekor"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type rekorOptions struct {
+	graphqlEndpoint         string
+	headerFile              string
+	csubClientOptions       csub_client.CsubClientOptions
+	url                     string
+	dump                    string
+	subjects                []string
+	identities              []string
+	poll                    bool
+	interval                time.Duration
+	queryVulnOnIngestion    bool
+	queryLicenseOnIngestion bool
+	queryEOLOnIngestion     bool
+	queryDepsDevOnIngestion bool
+}
+
+var rekorCmd = &cobra.Command{
+	Use:   "rekor [flags]",
+	Short: "takes the SLSA provenance and SBOM attestations recorded in a Rekor transparency log and injects them to GUAC graph. This command talks directly to the graphQL endpoint",
+	Long: `The rekor command collects the intoto and dsse entries of a Rekor transparency
+log as DSSE envelopes, from the Rekor API at --rekor-url, or a mirror of it, or
+from a log dump with --rekor-dump. A dump holds the entries as returned by the
+Rekor API, objects keyed by UUID, one after the other.
+
+The entries are filtered by the digests of the subjects of their attestations,
+--rekor-subjects, and by the emails or URIs of the certificates of their
+signers, --rekor-identities. The Rekor API can only be searched by subject
+digest or email, so one of them is required with --rekor-url. The signatures
+are verified with the certificates before filtering by identity, but neither
+the certificates nor the entries of a dump are verified, so the identity filter
+is only trustworthy against a live Rekor.`,
+	Example: `guacone collect rekor --rekor-subjects sha256:4c1e9b...
+guacone collect rekor --rekor-dump rekor.jsonl --rekor-identities https://github.com/org/app/.github/workflows/release.yml@refs/heads/main`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateRekorFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("csub-addr"),
+			viper.GetString("rekor-url"),
+			viper.GetString("rekor-dump"),
+			viper.GetString("rekor-subjects"),
+			viper.GetString("rekor-identities"),
+			viper.GetString("interval"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("add-vuln-on-ingest"),
+			viper.GetBool("add-license-on-ingest"),
+			viper.GetBool("add-eol-on-ingest"),
+			viper.GetBool("add-depsdev-on-ingest"))
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		rekorOpts := []rekor.Opt{rekor.WithSubjects(opts.subjects), rekor.WithIdentities(opts.identities)}
+		if opts.dump != "" {
+			rekorOpts = append(rekorOpts, rekor.WithDump(opts.dump))
+		} else {
+			rekorOpts = append(rekorOpts, rekor.WithURL(opts.url))
+		}
+		if opts.poll {
+			rekorOpts = append(rekorOpts, rekor.WithPolling(opts.interval))
+		}
+		rekorCollector, err := rekor.NewRekorCollector(rekorOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create rekor collector: %v", err)
+		}
+		if err := collector.RegisterDocumentCollector(rekorCollector, rekor.CollectorRekor); err != nil {
+			logger.Fatalf("unable to register rekor collector: %v", err)
+		}
+
+		// initialize collectsub client
+		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+		if err != nil {
+			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+			csubClient = nil
+		} else {
+			defer csubClient.Close()
+		}
+
+		totalNum := 0
+		gotErr := false
+
+		emit := func(d *processor.Document) error {
+			totalNum += 1
+			_, err := ingestor.Ingest(
+				ctx,
+				d,
+				opts.graphqlEndpoint,
+				transport,
+				csubClient,
+				opts.queryVulnOnIngestion,
+				opts.queryLicenseOnIngestion,
+				opts.queryEOLOnIngestion,
+				opts.queryDepsDevOnIngestion,
+			)
+
+			if err != nil {
+				gotErr = true
+				return fmt.Errorf("unable to ingest document: %w", err)
+			}
+			return nil
+		}
+
+		// Collect
+		errHandler := func(err error) bool {
+			if err == nil {
+				logger.Info("collector ended gracefully")
+				return true
+			}
+			logger.Errorf("collector ended with error: %v", err)
+			return false
+		}
+		if err := collector.Collect(ctx, emit, errHandler); err != nil {
+			logger.Fatal(err)
+		}
+
+		if gotErr {
+			logger.Fatalf("completed ingestion with errors")
+		} else {
+			logger.Infof("completed ingesting %v documents", totalNum)
+		}
+	},
+}
+
+func validateRekorFlags(gqlEndpoint, headerFile, csubAddr, url, dump, subjects, identities, interval string, poll, csubTls, csubTlsSkipVerify bool,
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool) (rekorOptions, error) {
+	var opts rekorOptions
+	opts.graphqlEndpoint = gqlEndpoint
+	opts.headerFile = headerFile
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	opts.url = url
+	opts.dump = dump
+	if opts.url == "" && opts.dump == "" {
+		return opts, fmt.Errorf("expected --rekor-url or --rekor-dump flag")
+	}
+	opts.subjects = splitList(subjects)
+	opts.identities = splitList(identities)
+	if opts.dump == "" && len(opts.subjects) == 0 && len(opts.identities) == 0 {
+		return opts, fmt.Errorf("expected --rekor-subjects or --rekor-identities flag to search the log")
+	}
+
+	opts.poll = poll
+	if opts.interval, err = time.ParseDuration(interval); err != nil {
+		return opts, fmt.Errorf("failed to parse interval: %w", err)
+	}
+	opts.queryVulnOnIngestion = queryVulnIngestion
+	opts.queryLicenseOnIngestion = queryLicenseIngestion
+	opts.queryEOLOnIngestion = queryEOLIngestion
+	opts.queryDepsDevOnIngestion = queryDepsDevOnIngestion
+	return opts, nil
+}
+
+// splitList splits a comma-separated flag.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"rekor-url", "rekor-dump", "rekor-subjects", "rekor-identities", "poll", "interval"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	rekorCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(rekorCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	collectCmd.AddCommand(rekorCmd)
+}
@@ -0,0 +1,73 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValidateRekorFlags(t *testing.T) {
+	testCases := []struct {
+		name         string
+		url          string
+		dump         string
+		subjects     string
+		identities   string
+		wantSubjects []string
+		errorMsg     string
+	}{
+		{
+			name:     "no source",
+			subjects: "sha256:abc",
+			errorMsg: "expected --rekor-url or --rekor-dump flag",
+		},
+		{
+			name:     "unfiltered log",
+			url:      "https://rekor.sigstore.dev",
+			errorMsg: "expected --rekor-subjects or --rekor-identities flag to search the log",
+		},
+		{
+			name: "unfiltered dump",
+			url:  "https://rekor.sigstore.dev",
+			dump: "rekor.jsonl",
+		},
+		{
+			name:         "subjects",
+			url:          "https://rekor.sigstore.dev",
+			subjects:     "sha256:abc, sha256:def,",
+			wantSubjects: []string{"sha256:abc", "sha256:def"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := validateRekorFlags("", "", "", tc.url, tc.dump, tc.subjects, tc.identities, "5m", false, false, false, false, false, false, false)
+			if err != nil {
+				if tc.errorMsg != err.Error() {
+					t.Errorf("expected error message: %s, got: %s", tc.errorMsg, err.Error())
+				}
+				return
+			}
+			if tc.errorMsg != "" {
+				t.Errorf("expected error message: %s, got none", tc.errorMsg)
+			}
+			if !slices.Equal(o.subjects, tc.wantSubjects) {
+				t.Errorf("expected subjects: %v, got: %v", tc.wantSubjects, o.subjects)
+			}
+		})
+	}
+}
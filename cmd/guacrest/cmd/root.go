@@ -38,6 +38,8 @@ var flags = struct {
 	tlsCertFile string
 	tlsKeyFile  string
 
+	policyDir string
+
 	dbDirectConnection bool
 	dbDriver           string
 	dbAddress          string
@@ -58,6 +60,7 @@ var rootCmd = &cobra.Command{
 		flags.headerFile = viper.GetString("header-file")
 		flags.tlsCertFile = viper.GetString("rest-api-tls-cert-file")
 		flags.tlsKeyFile = viper.GetString("rest-api-tls-key-file")
+		flags.policyDir = viper.GetString("rest-api-policy-dir")
 
 		flags.dbDriver = viper.GetString("db-driver")
 		flags.dbAddress = viper.GetString("db-address")
@@ -82,6 +85,7 @@ func init() {
 		"rest-api-server-port",
 		"rest-api-tls-cert-file",
 		"rest-api-tls-key-file",
+		"rest-api-policy-dir",
 
 		// configuration of direct database connection
 		"db-direct-connection",
@@ -33,6 +33,7 @@ import (
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
 	"github.com/guacsec/guac/pkg/guacrest/server"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
 )
 
 func startServer() {
@@ -96,14 +97,25 @@ func startServer() {
 // if an ent address is provided, get the handler backed by ent
 func getRestApiHandlerOrExit(ctx context.Context, gqlClient graphql.Client) gen.StrictServerInterface {
 	logger := logging.FromContext(ctx)
+	var policies []*policy.Policy
+	if flags.policyDir != "" {
+		var err error
+		policies, err = policy.LoadDir(flags.policyDir)
+		if err != nil {
+			logger.Fatalf("error loading policies: %s", err)
+		}
+	}
 	if flags.dbDirectConnection {
 		logger.Infof("directly connecting to the Ent backend for optimized endpoint" +
 			"implementation. This is an experimental feature")
 		ent := getEntClientOrExit(ctx)
 		handler := server.NewEntConnectedServer(ent, gqlClient)
+		handler.SetPolicies(policies)
 		return handler
 	}
-	return server.NewDefaultServer(gqlClient)
+	handler := server.NewDefaultServer(gqlClient)
+	handler.SetPolicies(policies)
+	return handler
 }
 
 func getEntClientOrExit(ctx context.Context) *ent.Client {
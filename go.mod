@@ -56,7 +56,6 @@ require (
 	dario.cat/mergo v1.0.0 // indirect
 	deps.dev/util/maven v0.0.0-20241010035105-b3ba03369df1 // indirect
 	deps.dev/util/resolve v0.0.0-20241010035105-b3ba03369df1 // indirect
-	deps.dev/util/semver v0.0.0-20241010035105-b3ba03369df1 // indirect
 	github.com/Azure/azure-amqp-common-go/v3 v3.2.3 // indirect
 	github.com/Azure/azure-sdk-for-go/sdk/azcore v1.14.0 // indirect
 	github.com/Azure/azure-sdk-for-go/sdk/azidentity v1.7.0 // indirect
@@ -295,6 +294,7 @@ require (
 
 require (
 	deps.dev/api/v3 v3.0.0-20241010035105-b3ba03369df1
+	deps.dev/util/semver v0.0.0-20241010035105-b3ba03369df1
 	entgo.io/contrib v0.6.0
 	entgo.io/ent v0.14.1
 	github.com/99designs/gqlgen v0.17.60
@@ -318,6 +318,7 @@ require (
 	github.com/go-git/go-git/v5 v5.12.0
 	github.com/gobwas/glob v0.2.3
 	github.com/gofrs/uuid v4.4.0+incompatible
+	github.com/google/cel-go v0.22.1
 	github.com/google/go-github/v50 v50.2.0
 	github.com/google/osv-scanner v1.9.1
 	github.com/grpc-ecosystem/go-grpc-middleware v1.4.0
@@ -352,6 +353,7 @@ require (
 	github.com/stretchr/testify v1.10.0
 	github.com/tikv/client-go/v2 v2.0.8-0.20231115083414-7c96dfd783fb
 	github.com/vektah/gqlparser/v2 v2.5.21
+	go.etcd.io/bbolt v1.3.11
 	go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc v1.33.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.33.0
 	go.uber.org/mock v0.5.0
@@ -364,4 +366,8 @@ require (
 	gopkg.in/yaml.v3 v3.0.1
 )
 
-require go.opentelemetry.io/proto/otlp v1.4.0 // indirect
+require (
+	github.com/antlr4-go/antlr/v4 v4.13.0 // indirect
+	github.com/stoewer/go-strcase v1.2.0 // indirect
+	go.opentelemetry.io/proto/otlp v1.4.0 // indirect
+)
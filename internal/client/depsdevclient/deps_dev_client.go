@@ -435,6 +435,9 @@ func (d *DepsClient) getDependenciesForPurl(ctx context.Context, purl string) (*
 			DependencyType: model.DependencyTypeDirect,
 			Justification:  "dependency data collected via deps.dev",
 		}
+		if edge.Requirement != "" {
+			isDep.VersionRange = &edge.Requirement
+		}
 		foundDepPackage := &IsDepPackage{
 			CurrentPackageInput: dependencyNodes[edge.FromNode].CurrentPackage,
 			DepPackageInput:     dependencyNodes[edge.ToNode].CurrentPackage,
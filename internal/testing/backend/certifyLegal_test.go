@@ -582,7 +582,7 @@ func TestLegal(t *testing.T) {
 					test.Query.ID = ptrfrom.String(clID)
 				}
 			}
-			got, err := b.CertifyLegalList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyLegalList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -813,7 +813,7 @@ func TestLegals(t *testing.T) {
 					return
 				}
 			}
-			got, err := b.CertifyLegalList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyLegalList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -0,0 +1,200 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestCertifyPolicy(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	type call struct {
+		Sub    *model.ArtifactInputSpec
+		Policy *model.CertifyPolicyInputSpec
+	}
+	passed := &model.CertifyPolicyInputSpec{
+		Verifier:       "https://verifier.example.com",
+		PolicyURI:      "https://policy.example.com/prod",
+		PolicyDigest:   "sha256:abc",
+		Result:         model.PolicyVerificationResultPassed,
+		VerifiedLevels: []string{"SLSA_BUILD_LEVEL_3", "SLSA_BUILD_LEVEL_2"},
+		TimeVerified:   testdata.T2,
+	}
+	failed := &model.CertifyPolicyInputSpec{
+		Verifier:       "https://other-verifier.example.com",
+		PolicyURI:      "https://policy.example.com/prod",
+		PolicyDigest:   "sha256:def",
+		Result:         model.PolicyVerificationResultFailed,
+		VerifiedLevels: []string{},
+		TimeVerified:   testdata.T3,
+	}
+	passedOut := func(a *model.Artifact) *model.CertifyPolicy {
+		return &model.CertifyPolicy{
+			Subject:        a,
+			Verifier:       "https://verifier.example.com",
+			PolicyURI:      "https://policy.example.com/prod",
+			PolicyDigest:   "sha256:abc",
+			Result:         model.PolicyVerificationResultPassed,
+			VerifiedLevels: []string{"SLSA_BUILD_LEVEL_2", "SLSA_BUILD_LEVEL_3"},
+			TimeVerified:   testdata.T2,
+		}
+	}
+	failedOut := &model.CertifyPolicy{
+		Subject:        testdata.A2out,
+		Verifier:       "https://other-verifier.example.com",
+		PolicyURI:      "https://policy.example.com/prod",
+		PolicyDigest:   "sha256:def",
+		Result:         model.PolicyVerificationResultFailed,
+		VerifiedLevels: []string{},
+		TimeVerified:   testdata.T3,
+	}
+	tests := []struct {
+		Name         string
+		InArt        []*model.ArtifactInputSpec
+		Calls        []call
+		IDInFilter   int
+		Query        *model.CertifyPolicySpec
+		ExpCP        []*model.CertifyPolicy
+		ExpIngestErr bool
+	}{
+		{
+			Name:  "HappyPath",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{{Sub: testdata.A1, Policy: passed}},
+			Query: &model.CertifyPolicySpec{
+				Verifier: ptrfrom.String("https://verifier.example.com"),
+			},
+			ExpCP: []*model.CertifyPolicy{passedOut(testdata.A1out)},
+		},
+		{
+			Name:  "Ingest same twice",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{
+				{Sub: testdata.A1, Policy: passed},
+				{Sub: testdata.A1, Policy: passed},
+			},
+			Query: &model.CertifyPolicySpec{
+				Subject: &model.ArtifactSpec{Digest: ptrfrom.String(testdata.A1.Digest)},
+			},
+			ExpCP: []*model.CertifyPolicy{passedOut(testdata.A1out)},
+		},
+		{
+			Name:  "Query on result",
+			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2},
+			Calls: []call{
+				{Sub: testdata.A1, Policy: passed},
+				{Sub: testdata.A2, Policy: failed},
+			},
+			Query: &model.CertifyPolicySpec{
+				Result: ptrfrom.Any(model.PolicyVerificationResultFailed),
+			},
+			ExpCP: []*model.CertifyPolicy{failedOut},
+		},
+		{
+			Name:  "Query on verified levels",
+			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2},
+			Calls: []call{
+				{Sub: testdata.A1, Policy: passed},
+				{Sub: testdata.A2, Policy: failed},
+			},
+			Query: &model.CertifyPolicySpec{
+				VerifiedLevels: []string{"SLSA_BUILD_LEVEL_3"},
+			},
+			ExpCP: []*model.CertifyPolicy{passedOut(testdata.A1out)},
+		},
+		{
+			Name:  "Query on time verified",
+			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2},
+			Calls: []call{
+				{Sub: testdata.A1, Policy: passed},
+				{Sub: testdata.A2, Policy: failed},
+			},
+			Query: &model.CertifyPolicySpec{
+				TimeVerified: ptrfrom.Time(testdata.T3),
+			},
+			ExpCP: []*model.CertifyPolicy{failedOut},
+		},
+		{
+			Name:  "Query on ID",
+			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2},
+			Calls: []call{
+				{Sub: testdata.A1, Policy: passed},
+				{Sub: testdata.A2, Policy: failed},
+			},
+			IDInFilter: 2,
+			Query:      &model.CertifyPolicySpec{},
+			ExpCP:      []*model.CertifyPolicy{failedOut},
+		},
+		{
+			Name:  "Query none",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{{Sub: testdata.A1, Policy: passed}},
+			Query: &model.CertifyPolicySpec{
+				PolicyDigest: ptrfrom.String("sha256:nope"),
+			},
+			ExpCP: nil,
+		},
+		{
+			Name:         "Ingest without artifact",
+			Calls:        []call{{Sub: testdata.A3, Policy: passed}},
+			ExpIngestErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, a := range test.InArt {
+				if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: a}); err != nil {
+					t.Fatalf("Could not ingest artifact: %v", err)
+				}
+			}
+			for i, o := range test.Calls {
+				cpID, err := b.IngestCertifyPolicy(ctx, model.IDorArtifactInput{ArtifactInput: o.Sub}, *o.Policy)
+				if (err != nil) != test.ExpIngestErr {
+					t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+				}
+				if err != nil {
+					return
+				}
+				if (i + 1) == test.IDInFilter {
+					test.Query.ID = ptrfrom.String(cpID)
+				}
+			}
+			got, err := b.CertifyPolicyList(ctx, *test.Query, nil, nil)
+			if err != nil {
+				t.Fatalf("did not expect query error, got: %v", err)
+			}
+			var returnedObjects []*model.CertifyPolicy
+			if got != nil {
+				for _, obj := range got.Edges {
+					returnedObjects = append(returnedObjects, obj.Node)
+				}
+			}
+			if diff := cmp.Diff(test.ExpCP, returnedObjects, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
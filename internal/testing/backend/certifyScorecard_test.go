@@ -533,7 +533,7 @@ func TestCertifyScorecard(t *testing.T) {
 					}
 				}
 			}
-			got, err := b.ScorecardsList(ctx, *test.Query, nil, nil)
+			got, err := b.ScorecardsList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -763,7 +763,7 @@ func TestIngestScorecards(t *testing.T) {
 					return
 				}
 			}
-			got, err := b.ScorecardsList(ctx, *test.Query, nil, nil)
+			got, err := b.ScorecardsList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
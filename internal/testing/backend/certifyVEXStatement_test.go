@@ -997,7 +997,7 @@ func TestVEX(t *testing.T) {
 					}
 				}
 			}
-			got, err := b.CertifyVEXStatementList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyVEXStatementList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -1458,7 +1458,7 @@ func TestVEXBulkIngest(t *testing.T) {
 					return
 				}
 			}
-			got, err := b.CertifyVEXStatementList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyVEXStatementList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -1015,7 +1015,7 @@ func TestIngestCertifyVulnerability(t *testing.T) {
 					}
 				}
 			}
-			got, err := b.CertifyVulnList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyVulnList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -1496,7 +1496,7 @@ func TestIngestCertifyVulns(t *testing.T) {
 				}
 
 			}
-			got, err := b.CertifyVulnList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyVulnList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -1749,7 +1749,7 @@ func TestDeleteCertifyVuln(t *testing.T) {
 				}
 
 			}
-			got, err := b.CertifyVulnList(ctx, *test.Query, nil, nil)
+			got, err := b.CertifyVulnList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -1770,7 +1770,7 @@ func TestDeleteCertifyVuln(t *testing.T) {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
 			assert.True(t, deleted)
-			secondGot, err := b.CertifyVulnList(ctx, *test.Query, nil, nil)
+			secondGot, err := b.CertifyVulnList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -0,0 +1,150 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// TestConcurrentIngest ingests the same document from several goroutines at
+// once, each in a different order, and checks that every node and edge is
+// stored exactly once.
+func TestConcurrentIngest(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+
+	pkgs := []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P3, testdata.P4}
+	arts := []*model.ArtifactInputSpec{testdata.A1, testdata.A2}
+	dep := model.IsDependencyInputSpec{Justification: "concurrent"}
+	occ := model.IsOccurrenceInputSpec{Justification: "concurrent"}
+
+	// ingestDocument ingests all the nodes and edges of the document, starting
+	// at offset so that the goroutines race on different nodes.
+	ingestDocument := func(offset int) (map[string]string, error) {
+		ids := map[string]string{}
+		for i := range pkgs {
+			p := pkgs[(i+offset)%len(pkgs)]
+			pIDs, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+			if err != nil {
+				return nil, err
+			}
+			ids["pkg "+p.Name+nilStr(p.Version)+nilStr(p.Subpath)] = pIDs.PackageVersionID
+		}
+		for i := range arts {
+			a := arts[(i+offset)%len(arts)]
+			id, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: a})
+			if err != nil {
+				return nil, err
+			}
+			ids["art "+a.Digest] = id
+		}
+		for _, d := range [][2]*model.PkgInputSpec{{testdata.P1, testdata.P2}, {testdata.P1, testdata.P4}} {
+			id, err := b.IngestDependency(ctx, model.IDorPkgInput{PackageInput: d[0]}, model.IDorPkgInput{PackageInput: d[1]}, dep)
+			if err != nil {
+				return nil, err
+			}
+			ids["dep "+d[1].Name+nilStr(d[1].Version)] = id
+		}
+		id, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &model.IDorPkgInput{PackageInput: testdata.P2}}, model.IDorArtifactInput{ArtifactInput: testdata.A1}, occ)
+		if err != nil {
+			return nil, err
+		}
+		ids["occ"] = id
+		return ids, nil
+	}
+
+	const workers = 8
+	results := make([]map[string]string, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			results[w], errs[w] = ingestDocument(w)
+		}(w)
+	}
+	wg.Wait()
+
+	for w := 0; w < workers; w++ {
+		if errs[w] != nil {
+			t.Fatalf("worker %d failed to ingest: %v", w, errs[w])
+		}
+		for k, id := range results[0] {
+			if results[w][k] != id {
+				t.Errorf("worker %d got ID %q for %s, worker 0 got %q", w, results[w][k], k, id)
+			}
+		}
+	}
+
+	gotPkgs, err := b.Packages(ctx, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("Packages: %v", err)
+	}
+	var versions int
+	for _, p := range gotPkgs {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				versions += len(n.Versions)
+			}
+		}
+	}
+	if versions != len(pkgs) {
+		t.Errorf("got %d package versions, want %d", versions, len(pkgs))
+	}
+
+	deps, err := b.IsDependency(ctx, &model.IsDependencySpec{})
+	if err != nil {
+		t.Fatalf("IsDependency: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Errorf("got %d dependencies, want 2", len(deps))
+	}
+
+	// The dependencies must be reachable from the dependent package.
+	p1 := results[0]["pkg tensorflow"]
+	neighbors, err := b.Neighbors(ctx, p1, []model.Edge{model.EdgePackageIsDependency})
+	if err != nil {
+		t.Fatalf("Neighbors: %v", err)
+	}
+	var got []string
+	for _, n := range neighbors {
+		if d, ok := n.(*model.IsDependency); ok {
+			got = append(got, d.ID)
+		}
+	}
+	slices.Sort(got)
+	want := []string{results[0]["dep tensorflow2.11.1"], results[0]["dep openssl3.0.3"]}
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("got dependency neighbors %v, want %v", got, want)
+	}
+}
+
+func nilStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// checkAsOf checks that the queries taking asOf leave out the predicates
+// known after it and the ones superseded by a later predicate about the same
+// subject: a second SBOM of pkgA, a later scan of pkgA that found nothing, and
+// a later VEX statement about pkgA and vulnA.
+func checkAsOf(ctx context.Context, b backends.Backend) error {
+	if _, err := ingestNouns(ctx, b); err != nil {
+		return err
+	}
+	t2 := t1.Add(24 * time.Hour)
+	before, after := t1.Add(-time.Hour), t2.Add(time.Hour)
+
+	sbom := model.HasSBOMInputSpec{URI: "https://example.com/v1.spdx.json", Algorithm: "sha256", Digest: "5b0m1", DownloadLocation: "https://example.com",
+		KnownSince: t1, Origin: "conformance", Collector: "conformance"}
+	newer := sbom
+	newer.URI, newer.Digest, newer.KnownSince = "https://example.com/v2.spdx.json", "5b0m2", t2
+	var c collect
+	c.one(b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, sbom, model.HasSBOMIncludesInputSpec{}))
+	c.one(b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, newer, model.HasSBOMIncludesInputSpec{}))
+	c.one(b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: artIn(artB)}, sbom, model.HasSBOMIncludesInputSpec{}))
+	sboms, err := c.result()
+	if err != nil {
+		return err
+	}
+	hasSBOM := func(asOf time.Time) ([]string, error) {
+		return idsOf(b.HasSBOM(ctx, &model.HasSBOMSpec{}, &asOf))
+	}
+	if err := want("HasSBOM before the first SBOM")(hasSBOM(before)); err != nil {
+		return err
+	}
+	if err := want("HasSBOM as of the first SBOM", sboms[0], sboms[2])(hasSBOM(t1)); err != nil {
+		return err
+	}
+	if err := want("HasSBOM as of the second SBOM", sboms[1], sboms[2])(hasSBOM(after)); err != nil {
+		return err
+	}
+	if err := want("HasSBOM by ID of a superseded SBOM")(idsOf(b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &sboms[0]}, &after))); err != nil {
+		return err
+	}
+	if err := want("HasSBOMList as of the first SBOM", sboms[0], sboms[2])(paginate("HasSBOMList", func(after *string, first *int) (*model.HasSBOMConnection, error) {
+		return b.HasSBOMList(ctx, model.HasSBOMSpec{}, &t1, after, first)
+	})); err != nil {
+		return err
+	}
+
+	scan := model.ScanMetadataInput{TimeScanned: t1, DbURI: "https://osv.dev", DbVersion: "1", ScannerURI: "osv-scanner",
+		ScannerVersion: "1.0.0", Origin: "conformance", Collector: "conformance"}
+	rescan := scan
+	rescan.TimeScanned = t2
+	c = collect{}
+	c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgA), *vulnIn(vulnA), scan))
+	c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgA), *vulnIn(noVuln), rescan))
+	vulns, err := c.result()
+	if err != nil {
+		return err
+	}
+	if err := want("CertifyVuln as of the first scan", vulns[0])(idsOf(b.CertifyVuln(ctx, &model.CertifyVulnSpec{}, &t1))); err != nil {
+		return err
+	}
+	if err := want("CertifyVuln as of the second scan", vulns[1])(idsOf(b.CertifyVuln(ctx, &model.CertifyVulnSpec{}, &after))); err != nil {
+		return err
+	}
+
+	affected := model.VexStatementInputSpec{Status: model.VexStatusAffected, StatusNotes: "upgrade", KnownSince: t1,
+		Origin: "conformance", Collector: "conformance"}
+	fixed := model.VexStatementInputSpec{Status: model.VexStatusFixed, StatusNotes: "upgraded", KnownSince: t2,
+		Origin: "conformance", Collector: "conformance"}
+	c = collect{}
+	c.one(b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, *vulnIn(vulnA), affected))
+	c.one(b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, *vulnIn(vulnA), fixed))
+	vexs, err := c.result()
+	if err != nil {
+		return err
+	}
+	vex := func(asOf time.Time) ([]string, error) {
+		return idsOf(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+			Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: ptrfrom.String(vulnA.VulnerabilityID)}}, &asOf))
+	}
+	if err := want("CertifyVEXStatement as of the first statement", vexs[0])(vex(t1)); err != nil {
+		return err
+	}
+	return want("CertifyVEXStatement as of the second statement", vexs[1])(vex(after))
+}
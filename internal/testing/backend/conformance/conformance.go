@@ -0,0 +1,136 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance checks that a backend implements the behavior of the
+// backends.Backend interface that the GraphQL API relies on.
+//
+// The suite is a list of features. Each feature exercises a group of Backend
+// methods on an empty backend and either passes, fails, or reports that the
+// backend does not implement it. Run checks a backend and returns a Report,
+// and WriteMatrix renders the reports of several backends as a feature
+// coverage matrix.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// Status is the outcome of checking a feature.
+type Status string
+
+const (
+	Pass           Status = "pass"
+	Fail           Status = "fail"
+	NotImplemented Status = "not implemented"
+)
+
+// Constructor returns an empty backend. It is called once for every feature,
+// and can use t to register a cleanup that clears the backend.
+type Constructor func(t *testing.T) backends.Backend
+
+// Feature is a group of Backend methods that are checked together.
+type Feature struct {
+	Name string
+	// Methods are the Backend methods the check calls.
+	Methods []string
+	check   func(ctx context.Context, b backends.Backend) error
+}
+
+// Result is the outcome of checking a feature on a backend.
+type Result struct {
+	Feature string
+	Status  Status
+	Error   string
+}
+
+// Report holds the results of checking every feature on a backend.
+type Report struct {
+	Backend string
+	Results []Result
+}
+
+// Status returns the status of the named feature, or the empty status if it
+// was not checked.
+func (r *Report) Status(feature string) Status {
+	for _, res := range r.Results {
+		if res.Feature == feature {
+			return res.Status
+		}
+	}
+	return ""
+}
+
+// Run checks every feature on a fresh backend from newBackend, each in its own
+// subtest. Features the backend does not implement are skipped. Features that
+// fail fail the subtest, unless they are in expectedFailures, in which case
+// the failure is only logged.
+func Run(t *testing.T, name string, newBackend Constructor, expectedFailures map[string]bool) *Report {
+	report := &Report{Backend: name}
+	for _, f := range Features() {
+		f := f
+		t.Run(f.Name, func(t *testing.T) {
+			res := Result{Feature: f.Name, Status: Pass}
+			if err := runCheck(f, newBackend(t)); err != nil {
+				res.Error = err.Error()
+				if isNotImplemented(err) {
+					res.Status = NotImplemented
+				} else {
+					res.Status = Fail
+				}
+			}
+			report.Results = append(report.Results, res)
+
+			switch {
+			case res.Status == NotImplemented:
+				t.Skipf("%s does not implement %s: %s", name, f.Name, res.Error)
+			case res.Status == Fail && expectedFailures[f.Name]:
+				t.Logf("expected failure: %s", res.Error)
+			case res.Status == Fail:
+				t.Error(res.Error)
+			case expectedFailures[f.Name]:
+				t.Logf("%s passes but is listed as an expected failure", f.Name)
+			}
+		})
+	}
+	return report
+}
+
+// runCheck runs the check of a feature, turning a panic of the backend into a
+// failure.
+func runCheck(f Feature, b backends.Backend) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				// keep the error, some backends panic with ErrNotImplemented
+				err = fmt.Errorf("panic: %w\n%s", e, debug.Stack())
+				return
+			}
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return f.check(context.Background(), b)
+}
+
+// isNotImplemented reports whether the error is how backends signal that a
+// method is not implemented, by wrapping backends.ErrNotImplemented.
+func isNotImplemented(err error) bool {
+	return errors.Is(err, backends.ErrNotImplemented)
+}
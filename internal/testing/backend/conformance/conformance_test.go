@@ -0,0 +1,133 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+)
+
+func TestFeaturesCoverBackend(t *testing.T) {
+	covered := map[string]bool{}
+	names := map[string]bool{}
+	for _, f := range Features() {
+		if names[f.Name] {
+			t.Errorf("feature %s is defined twice", f.Name)
+		}
+		names[f.Name] = true
+		for _, m := range f.Methods {
+			covered[m] = true
+		}
+	}
+	backend := reflect.TypeOf((*backends.Backend)(nil)).Elem()
+	for i := 0; i < backend.NumMethod(); i++ {
+		m := backend.Method(i).Name
+		if !covered[m] {
+			t.Errorf("no feature checks Backend.%s", m)
+		}
+		delete(covered, m)
+	}
+	for m := range covered {
+		t.Errorf("features check %s, which is not a Backend method", m)
+	}
+}
+
+func TestKeyValue(t *testing.T) {
+	report := Run(t, "keyvalue", func(t *testing.T) backends.Backend {
+		b, err := backends.Get("keyvalue", nil, stablememmap.GetStore())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}, nil)
+	for _, r := range report.Results {
+		if r.Status == NotImplemented {
+			t.Errorf("keyvalue does not implement %s", r.Feature)
+		}
+	}
+}
+
+func TestWriteMatrix(t *testing.T) {
+	features := Features()
+	reports := []*Report{
+		{Backend: "b", Results: []Result{{Feature: features[0].Name, Status: Fail}, {Feature: features[1].Name, Status: NotImplemented}}},
+		{Backend: "a", Results: []Result{{Feature: features[0].Name, Status: Pass}}},
+	}
+	var buf bytes.Buffer
+	if err := WriteMatrix(&buf, reports, map[string]string{"c": "no server"}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	if want := "| Feature | Methods | a | b |"; lines[0] != want {
+		t.Errorf("header = %q, want %q", lines[0], want)
+	}
+	if want := "| " + features[0].Name + " | " + strings.Join(features[0].Methods, ", ") + " | ✅ | ❌ |"; lines[2] != want {
+		t.Errorf("first row = %q, want %q", lines[2], want)
+	}
+	if !strings.HasSuffix(lines[3], " |   | ➖ |") {
+		t.Errorf("second row = %q", lines[3])
+	}
+	if !slices.Contains(lines, fmt.Sprintf("| **Passed** | | 1/%d | 0/%d |", len(features), len(features))) {
+		t.Errorf("matrix has no summary row:\n%s", buf.String())
+	}
+	if !slices.Contains(lines, "- c: no server") {
+		t.Errorf("matrix does not list the excluded backend:\n%s", buf.String())
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(ctx context.Context, b backends.Backend) error
+		want  bool
+	}{{
+		name: "wrapped",
+		check: func(context.Context, backends.Backend) error {
+			return fmt.Errorf("%w: Foo", backends.ErrNotImplemented)
+		},
+		want: true,
+	}, {
+		name: "panic",
+		check: func(context.Context, backends.Backend) error {
+			panic(fmt.Errorf("%w: Foo", backends.ErrNotImplemented))
+		},
+		want: true,
+	}, {
+		name: "message only",
+		check: func(context.Context, backends.Backend) error {
+			return errors.New("filter on Foo is not implemented correctly")
+		},
+		want: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runCheck(Feature{check: tt.check}, nil)
+			if got := isNotImplemented(err); got != tt.want {
+				t.Errorf("isNotImplemented(%v) = %v, want %v", err, got, tt.want)
+			}
+		})
+	}
+}
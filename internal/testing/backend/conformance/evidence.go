@@ -0,0 +1,826 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// evidence describes how to check a predicate. Every predicate is checked the
+// same way: ingest it singly and in bulk on top of the nouns of ingestNouns,
+// check that ingesting it again returns the same IDs, then query it, by ID
+// and with a filter, and page through it.
+type evidence struct {
+	name string
+	// methods are the ingestion and query methods of the predicate, list is
+	// its paginated query.
+	methods []string
+	list    string
+	// ingest returns the IDs of the ingested predicates.
+	ingest func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error)
+	// query returns the IDs of the predicates with the ID, or all of them.
+	query func(ctx context.Context, b backends.Backend, id *string) ([]string, error)
+	// filter checks a query that is not by ID.
+	filter func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error
+	// pages pages through the paginated query.
+	pages func(ctx context.Context, b backends.Backend) ([]string, error)
+}
+
+func (e evidence) features() []Feature {
+	return []Feature{
+		{
+			Name:    e.name,
+			Methods: e.methods,
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				ids, err := e.ingest(ctx, b, n)
+				if err != nil {
+					return err
+				}
+				if err := distinct("ingestion of "+e.name, ids, len(ids)); err != nil {
+					return err
+				}
+				again, err := e.ingest(ctx, b, n)
+				if err != nil {
+					return err
+				}
+				if !slices.Equal(ids, again) {
+					return fmt.Errorf("ingesting the same %s twice returned %v and %v", e.name, ids, again)
+				}
+
+				if err := want(e.name, ids...)(e.query(ctx, b, nil)); err != nil {
+					return err
+				}
+				for _, id := range ids {
+					if err := want(e.name+" by ID", id)(e.query(ctx, b, &id)); err != nil {
+						return err
+					}
+				}
+				return e.filter(ctx, b, n, ids)
+			},
+		},
+		{
+			Name:    e.name + "List",
+			Methods: []string{e.list},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				ids, err := e.ingest(ctx, b, n)
+				if err != nil {
+					return err
+				}
+				return want(e.list, ids...)(e.pages(ctx, b))
+			},
+		},
+	}
+}
+
+// idsOf returns the IDs of the nodes returned by a query.
+func idsOf[T any](nodes []T, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, n := range nodes {
+		out = append(out, nodeIDs(n)...)
+	}
+	return out, nil
+}
+
+// want returns a check that a query returned exactly the given IDs. It is
+// called on the results of a query, as in want("X", id)(idsOf(b.X(ctx, spec))).
+func want(what string, wantIDs ...string) func([]string, error) error {
+	return func(got []string, err error) error {
+		if err != nil {
+			return fmt.Errorf("%s: %w", what, err)
+		}
+		return sameIDs(what, got, wantIDs)
+	}
+}
+
+// collect gathers the IDs returned by a sequence of ingestions, keeping the
+// first error.
+type collect struct {
+	ids []string
+	err error
+}
+
+func (c *collect) one(id string, err error) {
+	c.many([]string{id}, err)
+}
+
+func (c *collect) many(ids []string, err error) {
+	if c.err != nil {
+		return
+	}
+	if err != nil {
+		c.err = err
+		return
+	}
+	c.ids = append(c.ids, ids...)
+}
+
+func (c *collect) result() ([]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.ids, nil
+}
+
+func evidenceFeatures() []Feature {
+	var features []Feature
+	for _, e := range []evidence{
+		certifyBad, certifyGood, certifyLegal, certifyPolicy, certifyScorecard,
+		certifyVEXStatement, certifyVuln, hasMetadata, hasSBOM, hasSLSA,
+		hasSourceAt, hashEqual, isDependency, isOccurrence, pkgEqual,
+		pointOfContact, vulnEqual, vulnerabilityMetadata, vulnerabilityRange,
+	} {
+		features = append(features, e.features()...)
+	}
+	return append(features,
+		Feature{
+			Name:    "CertifyVEXStatementEVEX",
+			Methods: []string{"IngestVEXStatement", "CertifyVEXStatement"},
+			check:   checkEVEX,
+		},
+		Feature{
+			Name:    "AsOf",
+			Methods: []string{"HasSBOM", "HasSBOMList", "CertifyVuln", "CertifyVEXStatement"},
+			check:   checkAsOf,
+		},
+	)
+}
+
+var certifyBad = evidence{
+	name:    "CertifyBad",
+	methods: []string{"IngestCertifyBad", "IngestCertifyBads", "CertifyBad"},
+	list:    "CertifyBadList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.CertifyBadInputSpec{Justification: "bad", KnownSince: t1, Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgA)}, specificVersion, spec))
+		c.one(b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgB)}, allVersions, spec))
+		c.one(b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Source: srcIn(srcA)}, specificVersion, spec))
+		c.many(b.IngestCertifyBads(ctx, model.PackageSourceOrArtifactInputs{Artifacts: []*model.IDorArtifactInput{artIn(artA), artIn(artB)}},
+			specificVersion, []*model.CertifyBadInputSpec{&spec, &spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.CertifyBad(ctx, &model.CertifyBadSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("CertifyBad by artifact", ids[3])(idsOf(b.CertifyBad(ctx, &model.CertifyBadSpec{
+			Subject: &model.PackageSourceOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: &artA.Digest}}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("CertifyBadList", func(after *string, first *int) (*model.CertifyBadConnection, error) {
+			return b.CertifyBadList(ctx, model.CertifyBadSpec{}, after, first)
+		})
+	},
+}
+
+var certifyGood = evidence{
+	name:    "CertifyGood",
+	methods: []string{"IngestCertifyGood", "IngestCertifyGoods", "CertifyGood"},
+	list:    "CertifyGoodList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.CertifyGoodInputSpec{Justification: "good", KnownSince: t1, Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgA)}, specificVersion, spec))
+		c.one(b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgB)}, allVersions, spec))
+		c.one(b.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Artifact: artIn(artA)}, specificVersion, spec))
+		c.many(b.IngestCertifyGoods(ctx, model.PackageSourceOrArtifactInputs{Sources: []*model.IDorSourceInput{srcIn(srcA), srcIn(srcB)}},
+			specificVersion, []*model.CertifyGoodInputSpec{&spec, &spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.CertifyGood(ctx, &model.CertifyGoodSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("CertifyGood by source", ids[4])(idsOf(b.CertifyGood(ctx, &model.CertifyGoodSpec{
+			Subject: &model.PackageSourceOrArtifactSpec{Source: &model.SourceSpec{Name: &srcB.Name}}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("CertifyGoodList", func(after *string, first *int) (*model.CertifyGoodConnection, error) {
+			return b.CertifyGoodList(ctx, model.CertifyGoodSpec{}, after, first)
+		})
+	},
+}
+
+var certifyLegal = evidence{
+	name:    "CertifyLegal",
+	methods: []string{"IngestCertifyLegal", "IngestCertifyLegals", "CertifyLegal"},
+	list:    "CertifyLegalList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		apache := &model.CertifyLegalInputSpec{DeclaredLicense: "Apache-2.0", DiscoveredLicense: "Apache-2.0 AND MIT",
+			Justification: "scanned", TimeScanned: t1, Origin: "conformance", Collector: "conformance"}
+		mit := &model.CertifyLegalInputSpec{DeclaredLicense: "MIT", Justification: "scanned", TimeScanned: t1, Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgA)},
+			[]*model.IDorLicenseInput{licIn(licA)}, []*model.IDorLicenseInput{licIn(licA), licIn(licB)}, apache))
+		c.many(b.IngestCertifyLegals(ctx, model.PackageOrSourceInputs{Sources: []*model.IDorSourceInput{srcIn(srcA)}},
+			[][]*model.IDorLicenseInput{{licIn(licB)}}, [][]*model.IDorLicenseInput{{}}, []*model.CertifyLegalInputSpec{mit}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: id}, nil))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("CertifyLegal by declared license", ids[1])(idsOf(b.CertifyLegal(ctx, &model.CertifyLegalSpec{
+			DeclaredLicense: ptrfrom.String("MIT")}, nil)))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("CertifyLegalList", func(after *string, first *int) (*model.CertifyLegalConnection, error) {
+			return b.CertifyLegalList(ctx, model.CertifyLegalSpec{}, nil, after, first)
+		})
+	},
+}
+
+var certifyPolicy = evidence{
+	name:    "CertifyPolicy",
+	methods: []string{"IngestCertifyPolicy", "IngestCertifyPolicies", "CertifyPolicy"},
+	list:    "CertifyPolicyList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		passed := model.CertifyPolicyInputSpec{Verifier: "slsa-verifier", PolicyURI: "https://example.com/policy", Result: model.PolicyVerificationResultPassed,
+			VerifiedLevels: []string{"SLSA_BUILD_LEVEL_3"}, TimeVerified: t1, Origin: "conformance", Collector: "conformance"}
+		failed := passed
+		failed.Verifier = "cosign"
+		failed.Result = model.PolicyVerificationResultFailed
+		failed.VerifiedLevels = []string{}
+		var c collect
+		c.one(b.IngestCertifyPolicy(ctx, *artIn(artA), passed))
+		c.many(b.IngestCertifyPolicies(ctx, []*model.IDorArtifactInput{artIn(artB)}, []*model.CertifyPolicyInputSpec{&failed}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.CertifyPolicy(ctx, &model.CertifyPolicySpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("CertifyPolicy by verifier", ids[1])(idsOf(b.CertifyPolicy(ctx, &model.CertifyPolicySpec{
+			Verifier: ptrfrom.String("cosign")})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("CertifyPolicyList", func(after *string, first *int) (*model.CertifyPolicyConnection, error) {
+			return b.CertifyPolicyList(ctx, model.CertifyPolicySpec{}, after, first)
+		})
+	},
+}
+
+var certifyScorecard = evidence{
+	name:    "CertifyScorecard",
+	methods: []string{"IngestScorecard", "IngestScorecards", "Scorecards"},
+	list:    "ScorecardsList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.ScorecardInputSpec{Checks: []*model.ScorecardCheckInputSpec{{Check: "Binary-Artifacts", Score: 10}, {Check: "Branch-Protection", Score: 5}},
+			AggregateScore: 7.5, TimeScanned: t1, ScorecardVersion: "v4.13.0", ScorecardCommit: "abc123", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestScorecard(ctx, *srcIn(srcA), spec))
+		c.many(b.IngestScorecards(ctx, []*model.IDorSourceInput{srcIn(srcB)}, []*model.ScorecardInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: id}, nil))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("Scorecards by source", ids[1])(idsOf(b.Scorecards(ctx, &model.CertifyScorecardSpec{
+			Source: &model.SourceSpec{Name: &srcB.Name}}, nil)))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("ScorecardsList", func(after *string, first *int) (*model.CertifyScorecardConnection, error) {
+			return b.ScorecardsList(ctx, model.CertifyScorecardSpec{}, nil, after, first)
+		})
+	},
+}
+
+var certifyVEXStatement = evidence{
+	name:    "CertifyVEXStatement",
+	methods: []string{"IngestVEXStatement", "IngestVEXStatements", "CertifyVEXStatement"},
+	list:    "CertifyVEXStatementList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.VexStatementInputSpec{Status: model.VexStatusNotAffected, VexJustification: model.VexJustificationComponentNotPresent,
+			Statement: "not shipped", KnownSince: t1, Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, *vulnIn(vulnA), spec))
+		c.many(b.IngestVEXStatements(ctx, model.PackageOrArtifactInputs{Artifacts: []*model.IDorArtifactInput{artIn(artA)}},
+			[]*model.IDorVulnerabilityInput{vulnIn(vulnB)}, []*model.VexStatementInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: id}, nil))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("CertifyVEXStatement by vulnerability", ids[1])(idsOf(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+			Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: &vulnB.VulnerabilityID}}, nil)))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("CertifyVEXStatementList", func(after *string, first *int) (*model.VEXConnection, error) {
+			return b.CertifyVEXStatementList(ctx, model.CertifyVEXStatementSpec{}, nil, after, first)
+		})
+	},
+}
+
+var certifyVuln = evidence{
+	name:    "CertifyVuln",
+	methods: []string{"IngestCertifyVuln", "IngestCertifyVulns", "CertifyVuln"},
+	list:    "CertifyVulnList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		meta := model.ScanMetadataInput{TimeScanned: t1, DbURI: "https://osv.dev", DbVersion: "1", ScannerURI: "osv-scanner",
+			ScannerVersion: "1.0.0", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgA), *vulnIn(vulnA), meta))
+		// A scan that found nothing links the package to the novuln vulnerability.
+		c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgC), *vulnIn(noVuln), meta))
+		c.many(b.IngestCertifyVulns(ctx, []*model.IDorPkgInput{pkgIn(pkgB)}, []*model.IDorVulnerabilityInput{vulnIn(vulnB)},
+			[]*model.ScanMetadataInput{&meta}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: id}, nil))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		if err := want("CertifyVuln by vulnerability type", ids[2])(idsOf(b.CertifyVuln(ctx, &model.CertifyVulnSpec{
+			Vulnerability: &model.VulnerabilitySpec{Type: &vulnB.Type}}, nil))); err != nil {
+			return err
+		}
+		return want("CertifyVuln without vulnerability", ids[1])(idsOf(b.CertifyVuln(ctx, &model.CertifyVulnSpec{
+			Vulnerability: &model.VulnerabilitySpec{NoVuln: ptrfrom.Bool(true)}}, nil)))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("CertifyVulnList", func(after *string, first *int) (*model.CertifyVulnConnection, error) {
+			return b.CertifyVulnList(ctx, model.CertifyVulnSpec{}, nil, after, first)
+		})
+	},
+}
+
+var hasMetadata = evidence{
+	name:    "HasMetadata",
+	methods: []string{"IngestHasMetadata", "IngestBulkHasMetadata", "HasMetadata"},
+	list:    "HasMetadataList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.HasMetadataInputSpec{Key: "team", Value: "guac", Timestamp: t1, Justification: "owner", Origin: "conformance", Collector: "conformance"}
+		other := spec
+		other.Key = "tier"
+		other.Value = "1"
+		var c collect
+		c.one(b.IngestHasMetadata(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgA)}, specificVersion, spec))
+		c.one(b.IngestHasMetadata(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgB)}, allVersions, spec))
+		c.one(b.IngestHasMetadata(ctx, model.PackageSourceOrArtifactInput{Source: srcIn(srcA)}, specificVersion, spec))
+		c.many(b.IngestBulkHasMetadata(ctx, model.PackageSourceOrArtifactInputs{Artifacts: []*model.IDorArtifactInput{artIn(artA)}},
+			specificVersion, []*model.HasMetadataInputSpec{&other}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.HasMetadata(ctx, &model.HasMetadataSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("HasMetadata by key", ids[3])(idsOf(b.HasMetadata(ctx, &model.HasMetadataSpec{Key: ptrfrom.String("tier")})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("HasMetadataList", func(after *string, first *int) (*model.HasMetadataConnection, error) {
+			return b.HasMetadataList(ctx, model.HasMetadataSpec{}, after, first)
+		})
+	},
+}
+
+var hasSBOM = evidence{
+	name:    "HasSBOM",
+	methods: []string{"IngestHasSbom", "IngestHasSBOMs", "HasSBOM"},
+	list:    "HasSBOMList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		// The SBOM of pkgA includes a dependency and an occurrence.
+		dep, err := b.IngestDependency(ctx, *pkgIn(pkgA), *pkgIn(pkgC),
+			model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Justification: "sbom", Origin: "conformance", Collector: "conformance"})
+		if err != nil {
+			return nil, err
+		}
+		occ, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgA)}, *artIn(artA),
+			model.IsOccurrenceInputSpec{Justification: "sbom", Origin: "conformance", Collector: "conformance"})
+		if err != nil {
+			return nil, err
+		}
+		includes := model.HasSBOMIncludesInputSpec{
+			Packages:     []string{n.pkg(pkgA), n.pkg(pkgC)},
+			Artifacts:    []string{n.arts[artA]},
+			Dependencies: []string{dep},
+			Occurrences:  []string{occ},
+		}
+		spec := model.HasSBOMInputSpec{URI: "https://example.com/sbom.json", Algorithm: "sha256", Digest: "5b0m", DownloadLocation: "https://example.com",
+			KnownSince: t1, Origin: "conformance", Collector: "conformance"}
+		other := spec
+		other.URI = "https://example.com/image.spdx.json"
+		var c collect
+		c.one(b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, spec, includes))
+		c.many(b.IngestHasSBOMs(ctx, model.PackageOrArtifactInputs{Artifacts: []*model.IDorArtifactInput{artIn(artB)}},
+			[]*model.HasSBOMInputSpec{&other}, []*model.HasSBOMIncludesInputSpec{{}}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.HasSBOM(ctx, &model.HasSBOMSpec{ID: id}, nil))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		if err := want("HasSBOM by URI", ids[1])(idsOf(b.HasSBOM(ctx, &model.HasSBOMSpec{
+			URI: ptrfrom.String("https://example.com/image.spdx.json")}, nil))); err != nil {
+			return err
+		}
+		sboms, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &ids[0]}, nil)
+		if err != nil {
+			return err
+		}
+		if len(sboms) != 1 {
+			return fmt.Errorf("HasSBOM by ID returned %d SBOMs", len(sboms))
+		}
+		s := sboms[0]
+		if err := want("HasSBOM included software", n.pkg(pkgA), n.pkg(pkgC), n.arts[artA])(idsOf(s.IncludedSoftware, nil)); err != nil {
+			return err
+		}
+		if len(s.IncludedDependencies) != 1 || len(s.IncludedOccurrences) != 1 {
+			return fmt.Errorf("HasSBOM has %d included dependencies and %d included occurrences, want 1 and 1",
+				len(s.IncludedDependencies), len(s.IncludedOccurrences))
+		}
+		return nil
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("HasSBOMList", func(after *string, first *int) (*model.HasSBOMConnection, error) {
+			return b.HasSBOMList(ctx, model.HasSBOMSpec{}, nil, after, first)
+		})
+	},
+}
+
+var hasSLSA = evidence{
+	name:    "HasSLSA",
+	methods: []string{"IngestSLSA", "IngestSLSAs", "HasSlsa"},
+	list:    "HasSLSAList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.SLSAInputSpec{BuildType: "https://slsa.dev/container-based-build/v0.1", SlsaVersion: "v1",
+			SlsaPredicate: []*model.SLSAPredicateInputSpec{{Key: "buildDefinition.externalParameters.source", Value: "git+https://github.com/guacsec/guac"}},
+			StartedOn:     &t1, FinishedOn: &t1, Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestSLSA(ctx, *artIn(artA), []*model.IDorArtifactInput{artIn(artB)}, *builderIn(builderA), spec))
+		c.many(b.IngestSLSAs(ctx, []*model.IDorArtifactInput{artIn(artC)}, [][]*model.IDorArtifactInput{{artIn(artA), artIn(artB)}},
+			[]*model.IDorBuilderInput{builderIn(builderB)}, []*model.SLSAInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.HasSlsa(ctx, &model.HasSLSASpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("HasSlsa by builder", ids[1])(idsOf(b.HasSlsa(ctx, &model.HasSLSASpec{
+			BuiltBy: &model.BuilderSpec{URI: &builderB.URI}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("HasSLSAList", func(after *string, first *int) (*model.HasSLSAConnection, error) {
+			return b.HasSLSAList(ctx, model.HasSLSASpec{}, after, first)
+		})
+	},
+}
+
+var hasSourceAt = evidence{
+	name:    "HasSourceAt",
+	methods: []string{"IngestHasSourceAt", "IngestHasSourceAts", "HasSourceAt"},
+	list:    "HasSourceAtList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.HasSourceAtInputSpec{KnownSince: t1, Justification: "repository", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestHasSourceAt(ctx, *pkgIn(pkgA), *specificVersion, *srcIn(srcA), spec))
+		c.many(b.IngestHasSourceAts(ctx, []*model.IDorPkgInput{pkgIn(pkgC)}, allVersions, []*model.IDorSourceInput{srcIn(srcC)},
+			[]*model.HasSourceAtInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("HasSourceAt by source", ids[1])(idsOf(b.HasSourceAt(ctx, &model.HasSourceAtSpec{
+			Source: &model.SourceSpec{Type: &srcC.Type}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("HasSourceAtList", func(after *string, first *int) (*model.HasSourceAtConnection, error) {
+			return b.HasSourceAtList(ctx, model.HasSourceAtSpec{}, after, first)
+		})
+	},
+}
+
+var hashEqual = evidence{
+	name:    "HashEqual",
+	methods: []string{"IngestHashEqual", "IngestHashEquals", "HashEqual"},
+	list:    "HashEqualList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.HashEqualInputSpec{Justification: "same file", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestHashEqual(ctx, *artIn(artA), *artIn(artB), spec))
+		c.many(b.IngestHashEquals(ctx, []*model.IDorArtifactInput{artIn(artB)}, []*model.IDorArtifactInput{artIn(artC)},
+			[]*model.HashEqualInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.HashEqual(ctx, &model.HashEqualSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		if err := want("HashEqual by artifact", ids[1])(idsOf(b.HashEqual(ctx, &model.HashEqualSpec{
+			Artifacts: []*model.ArtifactSpec{{Digest: &artC.Digest}}}))); err != nil {
+			return err
+		}
+		// The equality is symmetric, the artifacts can be given in any order.
+		return want("HashEqual by both artifacts", ids[0])(idsOf(b.HashEqual(ctx, &model.HashEqualSpec{
+			Artifacts: []*model.ArtifactSpec{{Digest: &artB.Digest}, {Digest: &artA.Digest}}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("HashEqualList", func(after *string, first *int) (*model.HashEqualConnection, error) {
+			return b.HashEqualList(ctx, model.HashEqualSpec{}, after, first)
+		})
+	},
+}
+
+var isDependency = evidence{
+	name:    "IsDependency",
+	methods: []string{"IngestDependency", "IngestDependencies", "IsDependency"},
+	list:    "IsDependencyList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Justification: "go.mod", Origin: "conformance", Collector: "conformance"}
+		ranged := spec
+		ranged.VersionRange = ptrfrom.String(">=1.0.0,<2.0.0")
+		var c collect
+		c.one(b.IngestDependency(ctx, *pkgIn(pkgA), *pkgIn(pkgC), spec))
+		c.many(b.IngestDependencies(ctx, []*model.IDorPkgInput{pkgIn(pkgB)}, []*model.IDorPkgInput{pkgIn(pkgD)},
+			[]*model.IsDependencyInputSpec{&ranged}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.IsDependency(ctx, &model.IsDependencySpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		if err := want("IsDependency by package", ids[1])(idsOf(b.IsDependency(ctx, &model.IsDependencySpec{
+			Package: &model.PkgSpec{Version: pkgB.Version}}))); err != nil {
+			return err
+		}
+		if err := want("IsDependency by version range", ids[1])(idsOf(b.IsDependency(ctx, &model.IsDependencySpec{
+			VersionRange: ptrfrom.String(">=1.0.0,<2.0.0")}))); err != nil {
+			return err
+		}
+		return want("IsDependency by dependency", ids[0])(idsOf(b.IsDependency(ctx, &model.IsDependencySpec{
+			DependencyPackage: &model.PkgSpec{Name: &pkgC.Name}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("IsDependencyList", func(after *string, first *int) (*model.IsDependencyConnection, error) {
+			return b.IsDependencyList(ctx, model.IsDependencySpec{}, after, first)
+		})
+	},
+}
+
+var isOccurrence = evidence{
+	name:    "IsOccurrence",
+	methods: []string{"IngestOccurrence", "IngestOccurrences", "IsOccurrence"},
+	list:    "IsOccurrenceList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.IsOccurrenceInputSpec{Justification: "built", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgA)}, *artIn(artA), spec))
+		c.many(b.IngestOccurrences(ctx, model.PackageOrSourceInputs{Sources: []*model.IDorSourceInput{srcIn(srcA)}},
+			[]*model.IDorArtifactInput{artIn(artB)}, []*model.IsOccurrenceInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("IsOccurrence by artifact", ids[1])(idsOf(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{
+			Artifact: &model.ArtifactSpec{Digest: &artB.Digest}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("IsOccurrenceList", func(after *string, first *int) (*model.IsOccurrenceConnection, error) {
+			return b.IsOccurrenceList(ctx, model.IsOccurrenceSpec{}, after, first)
+		})
+	},
+}
+
+var pkgEqual = evidence{
+	name:    "PkgEqual",
+	methods: []string{"IngestPkgEqual", "IngestPkgEquals", "PkgEqual"},
+	list:    "PkgEqualList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.PkgEqualInputSpec{Justification: "same code", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestPkgEqual(ctx, *pkgIn(pkgA), *pkgIn(pkgC), spec))
+		c.many(b.IngestPkgEquals(ctx, []*model.IDorPkgInput{pkgIn(pkgB)}, []*model.IDorPkgInput{pkgIn(pkgD)},
+			[]*model.PkgEqualInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.PkgEqual(ctx, &model.PkgEqualSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		if err := want("PkgEqual by package", ids[1])(idsOf(b.PkgEqual(ctx, &model.PkgEqualSpec{
+			Packages: []*model.PkgSpec{{Type: &pkgD.Type}}}))); err != nil {
+			return err
+		}
+		return want("PkgEqual by both packages", ids[0])(idsOf(b.PkgEqual(ctx, &model.PkgEqualSpec{
+			Packages: []*model.PkgSpec{{Type: &pkgC.Type}, {Type: &pkgA.Type, Version: pkgA.Version}}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("PkgEqualList", func(after *string, first *int) (*model.PkgEqualConnection, error) {
+			return b.PkgEqualList(ctx, model.PkgEqualSpec{}, after, first)
+		})
+	},
+}
+
+var pointOfContact = evidence{
+	name:    "PointOfContact",
+	methods: []string{"IngestPointOfContact", "IngestPointOfContacts", "PointOfContact"},
+	list:    "PointOfContactList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.PointOfContactInputSpec{Email: "maintainers@guac.sh", Info: "mailing list", Since: t1, Justification: "README",
+			Origin: "conformance", Collector: "conformance"}
+		other := spec
+		other.Email = "security@guac.sh"
+		var c collect
+		c.one(b.IngestPointOfContact(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgA)}, specificVersion, spec))
+		c.one(b.IngestPointOfContact(ctx, model.PackageSourceOrArtifactInput{Package: pkgIn(pkgB)}, allVersions, spec))
+		c.one(b.IngestPointOfContact(ctx, model.PackageSourceOrArtifactInput{Artifact: artIn(artA)}, specificVersion, spec))
+		c.many(b.IngestPointOfContacts(ctx, model.PackageSourceOrArtifactInputs{Sources: []*model.IDorSourceInput{srcIn(srcA)}},
+			specificVersion, []*model.PointOfContactInputSpec{&other}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.PointOfContact(ctx, &model.PointOfContactSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("PointOfContact by email", ids[3])(idsOf(b.PointOfContact(ctx, &model.PointOfContactSpec{
+			Email: ptrfrom.String("security@guac.sh")})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("PointOfContactList", func(after *string, first *int) (*model.PointOfContactConnection, error) {
+			return b.PointOfContactList(ctx, model.PointOfContactSpec{}, after, first)
+		})
+	},
+}
+
+var vulnEqual = evidence{
+	name:    "VulnEqual",
+	methods: []string{"IngestVulnEqual", "IngestVulnEquals", "VulnEqual"},
+	list:    "VulnEqualList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.VulnEqualInputSpec{Justification: "alias", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestVulnEqual(ctx, *vulnIn(vulnA), *vulnIn(vulnB), spec))
+		c.many(b.IngestVulnEquals(ctx, []*model.IDorVulnerabilityInput{vulnIn(vulnB)}, []*model.IDorVulnerabilityInput{vulnIn(vulnC)},
+			[]*model.VulnEqualInputSpec{&spec}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.VulnEqual(ctx, &model.VulnEqualSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("VulnEqual by vulnerability", ids[0])(idsOf(b.VulnEqual(ctx, &model.VulnEqualSpec{
+			Vulnerabilities: []*model.VulnerabilitySpec{{Type: &vulnA.Type}}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("VulnEqualList", func(after *string, first *int) (*model.VulnEqualConnection, error) {
+			return b.VulnEqualList(ctx, model.VulnEqualSpec{}, after, first)
+		})
+	},
+}
+
+var vulnerabilityMetadata = evidence{
+	name:    "VulnerabilityMetadata",
+	methods: []string{"IngestVulnerabilityMetadata", "IngestBulkVulnerabilityMetadata", "VulnerabilityMetadata"},
+	list:    "VulnerabilityMetadataList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		spec := model.VulnerabilityMetadataInputSpec{ScoreType: model.VulnerabilityScoreTypeCVSSv3, ScoreValue: 7.5, Timestamp: t1,
+			Origin: "conformance", Collector: "conformance"}
+		low := spec
+		low.ScoreValue = 2.1
+		var c collect
+		c.one(b.IngestVulnerabilityMetadata(ctx, *vulnIn(vulnA), spec))
+		c.many(b.IngestBulkVulnerabilityMetadata(ctx, []*model.IDorVulnerabilityInput{vulnIn(vulnB)},
+			[]*model.VulnerabilityMetadataInputSpec{&low}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		greater := model.ComparatorGreater
+		return want("VulnerabilityMetadata by score", ids[0])(idsOf(b.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{
+			ScoreValue: ptrfrom.Float64(5), Comparator: &greater})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("VulnerabilityMetadataList", func(after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+			return b.VulnerabilityMetadataList(ctx, model.VulnerabilityMetadataSpec{}, after, first)
+		})
+	},
+}
+
+var vulnerabilityRange = evidence{
+	name:    "VulnerabilityRange",
+	methods: []string{"IngestVulnerabilityRange", "IngestVulnerabilityRanges", "VulnerabilityRange"},
+	list:    "VulnerabilityRangeList",
+	ingest: func(ctx context.Context, b backends.Backend, n *nouns) ([]string, error) {
+		semver := model.VulnerabilityRangeInputSpec{RangeType: model.VulnerabilityRangeTypeSemver, Fixed: "1.2.0",
+			Origin: "conformance", Collector: "conformance"}
+		ecosystem := model.VulnerabilityRangeInputSpec{RangeType: model.VulnerabilityRangeTypeEcosystem, Introduced: "2.0.0",
+			LastAffected: "2.31.0", Origin: "conformance", Collector: "conformance"}
+		var c collect
+		c.one(b.IngestVulnerabilityRange(ctx, *pkgIn(pkgA), *vulnIn(vulnA), semver))
+		c.many(b.IngestVulnerabilityRanges(ctx, []*model.IDorPkgInput{pkgIn(pkgC)}, []*model.IDorVulnerabilityInput{vulnIn(vulnB)},
+			[]*model.VulnerabilityRangeInputSpec{&ecosystem}))
+		return c.result()
+	},
+	query: func(ctx context.Context, b backends.Backend, id *string) ([]string, error) {
+		return idsOf(b.VulnerabilityRange(ctx, &model.VulnerabilityRangeSpec{ID: id}))
+	},
+	filter: func(ctx context.Context, b backends.Backend, n *nouns, ids []string) error {
+		return want("VulnerabilityRange by package", ids[1])(idsOf(b.VulnerabilityRange(ctx, &model.VulnerabilityRangeSpec{
+			Package: &model.PkgSpec{Name: &pkgC.Name}})))
+	},
+	pages: func(ctx context.Context, b backends.Backend) ([]string, error) {
+		return paginate("VulnerabilityRangeList", func(after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+			return b.VulnerabilityRangeList(ctx, model.VulnerabilityRangeSpec{}, after, first)
+		})
+	},
+}
+
+// checkEVEX checks that the eVEX fields of a VEX statement, the fields beyond
+// the OpenVEX ones, are stored and returned unchanged.
+func checkEVEX(ctx context.Context, b backends.Backend) error {
+	if _, err := ingestNouns(ctx, b); err != nil {
+		return err
+	}
+	spec := model.VexStatementInputSpec{
+		Status:           model.VexStatusAffected,
+		Statement:        "reachable from the public API",
+		StatusNotes:      "upgrade",
+		KnownSince:       t1,
+		Origin:           "conformance",
+		Collector:        "conformance",
+		Description:      ptrfrom.String("heap overflow in the parser"),
+		Cvss:             &model.CVSSInput{VulnImpact: ptrfrom.Float64(8.1), Version: ptrfrom.String("3.1"), AttackString: ptrfrom.String("AV:N/AC:H/PR:N/UI:N/S:U/C:H/I:H/A:H")},
+		Cwe:              []*model.CWEInput{{ID: "CWE-122", Abstraction: "Variant", Name: "Heap-based Buffer Overflow", BackgroundDetail: ptrfrom.String("heap")}},
+		ReachableCode:    []*model.ReachableCodeInputSpec{{PathToFile: ptrfrom.String("pkg/parser/parse.go"), UsedArtifacts: []*model.UsedArtifactInputSpec{{Name: ptrfrom.String("Parse"), UsedInLines: []*int{ptrfrom.Int(42)}}}}},
+		Exploits:         []*model.ExploitsInputSpec{{ID: ptrfrom.String("EDB-1"), Description: ptrfrom.String("crafted input"), Payload: ptrfrom.String("AAAA")}},
+		Priority:         ptrfrom.Float64(0.9),
+		VexJustification: model.VexJustificationNotProvided,
+	}
+	id, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkgIn(pkgA)}, *vulnIn(vulnA), spec)
+	if err != nil {
+		return err
+	}
+	vexs, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &id}, nil)
+	if err != nil {
+		return err
+	}
+	if len(vexs) != 1 {
+		return fmt.Errorf("CertifyVEXStatement by ID returned %d statements", len(vexs))
+	}
+	got := vexs[0]
+	// The input and output types of the eVEX fields marshal to the same JSON.
+	for _, f := range []struct {
+		name      string
+		want, got any
+	}{
+		{"description", spec.Description, got.Description},
+		{"cvss", spec.Cvss, got.Cvss},
+		{"cwe", spec.Cwe, got.Cwe},
+		{"reachableCode", spec.ReachableCode, got.ReachableCode},
+		{"exploits", spec.Exploits, got.Exploits},
+		{"priority", spec.Priority, got.Priority},
+	} {
+		w, err := json.Marshal(f.want)
+		if err != nil {
+			return err
+		}
+		g, err := json.Marshal(f.got)
+		if err != nil {
+			return err
+		}
+		if string(w) != string(g) {
+			return fmt.Errorf("CertifyVEXStatement returned %s %s, want %s", f.name, g, w)
+		}
+	}
+	return want("CertifyVEXStatement by description", id)(idsOf(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+		Description: spec.Description}, nil)))
+}
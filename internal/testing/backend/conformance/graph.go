@@ -0,0 +1,518 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Features returns every feature of the suite, in the order of the coverage
+// matrix.
+func Features() []Feature {
+	features := nounFeatures()
+	features = append(features, evidenceFeatures()...)
+	return append(features, graphFeatures()...)
+}
+
+func graphFeatures() []Feature {
+	return []Feature{
+		{Name: "Node", Methods: []string{"Node", "Nodes"}, check: checkNode},
+		{Name: "Neighbors", Methods: []string{"Neighbors"}, check: checkNeighbors},
+		{Name: "NeighborsList", Methods: []string{"NeighborsList"}, check: checkNeighborsList},
+		{Name: "Path", Methods: []string{"Path"}, check: checkPath},
+		{Name: "AllPaths", Methods: []string{"AllPaths"}, check: checkAllPaths},
+		{Name: "ConstrainedPath", Methods: []string{"ConstrainedPath"}, check: checkConstrainedPath},
+		{Name: "DependencyClosure", Methods: []string{"DependencyClosure"}, check: checkDependencyClosure},
+		{Name: "DependentClosure", Methods: []string{"DependentClosure"}, check: checkDependentClosure},
+		{Name: "Delete", Methods: []string{"Delete"}, check: checkDelete},
+		{Name: "FindSoftware", Methods: []string{"FindSoftware"}, check: checkFindSoftware},
+		{Name: "FindSoftwareList", Methods: []string{"FindSoftwareList"}, check: checkFindSoftwareList},
+		{Name: "PackagesToScan", Methods: []string{"FindPackagesThatNeedScanning", "QueryPackagesListForScan"}, check: checkPackagesToScan},
+		{Name: "BatchQueryPkgIDCertifyLegal", Methods: []string{"BatchQueryPkgIDCertifyLegal"}, check: checkBatchCertifyLegal},
+		{Name: "BatchQueryPkgIDCertifyVuln", Methods: []string{"BatchQueryPkgIDCertifyVuln"}, check: checkBatchCertifyVuln},
+		{Name: "BatchQueryDependency", Methods: []string{"BatchQuerySubjectPkgDependency", "BatchQueryDepPkgDependency"}, check: checkBatchDependency},
+	}
+}
+
+// graph is a small connected graph: pkgA depends on pkgC, which occurs as
+// artA, which is hash equal to artB, which pkgD occurs as. The packages are
+// of different types so that they share no node of their trees.
+type graph struct {
+	*nouns
+	dep, occA, occB, hashEqual string
+}
+
+func ingestGraph(ctx context.Context, b backends.Backend) (*graph, error) {
+	n, err := ingestNouns(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	g := &graph{nouns: n}
+	if g.dep, err = b.IngestDependency(ctx, *pkgIn(pkgA), *pkgIn(pkgC),
+		model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Justification: "graph", Origin: "conformance", Collector: "conformance"}); err != nil {
+		return nil, fmt.Errorf("IngestDependency: %w", err)
+	}
+	occurrence := model.IsOccurrenceInputSpec{Justification: "graph", Origin: "conformance", Collector: "conformance"}
+	if g.occA, err = b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgC)}, *artIn(artA), occurrence); err != nil {
+		return nil, fmt.Errorf("IngestOccurrence: %w", err)
+	}
+	if g.occB, err = b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgD)}, *artIn(artB), occurrence); err != nil {
+		return nil, fmt.Errorf("IngestOccurrence: %w", err)
+	}
+	if g.hashEqual, err = b.IngestHashEqual(ctx, *artIn(artA), *artIn(artB),
+		model.HashEqualInputSpec{Justification: "graph", Origin: "conformance", Collector: "conformance"}); err != nil {
+		return nil, fmt.Errorf("IngestHashEqual: %w", err)
+	}
+	return g, nil
+}
+
+func checkNode(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	want := []string{g.pkg(pkgA), g.src(srcA), g.arts[artA], g.builders[builderA], g.lics[licA], g.vuln(vulnA), g.dep, g.occA, g.hashEqual}
+	for _, id := range want {
+		node, err := b.Node(ctx, id)
+		if err != nil {
+			return fmt.Errorf("Node(%q): %w", id, err)
+		}
+		if !slices.Contains(nodeIDs(node), id) {
+			return fmt.Errorf("Node(%q) returned %v", id, nodeIDs(node))
+		}
+	}
+	nodes, err := b.Nodes(ctx, want)
+	if err != nil {
+		return err
+	}
+	if len(nodes) != len(want) {
+		return fmt.Errorf("Nodes returned %d nodes for %d IDs", len(nodes), len(want))
+	}
+	for i, node := range nodes {
+		if !slices.Contains(nodeIDs(node), want[i]) {
+			return fmt.Errorf("Nodes returned %v for %q", nodeIDs(node), want[i])
+		}
+	}
+	return nil
+}
+
+// neighbors returns the IDs of the neighbors of a node.
+func neighbors(ctx context.Context, b backends.Backend, id string, usingOnly []model.Edge) ([]string, error) {
+	return idsOf(b.Neighbors(ctx, id, usingOnly))
+}
+
+func checkNeighbors(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	// A predicate neighbors the nouns it links.
+	if err := want("Neighbors of an occurrence", g.pkg(pkgC), g.arts[artA])(neighbors(ctx, b, g.occA, nil)); err != nil {
+		return err
+	}
+	got, err := neighbors(ctx, b, g.arts[artA], nil)
+	if err != nil {
+		return err
+	}
+	for _, id := range []string{g.occA, g.hashEqual} {
+		if !slices.Contains(got, id) {
+			return fmt.Errorf("Neighbors of an artifact returned %v, missing %q", got, id)
+		}
+	}
+	return want("Neighbors of an artifact using only occurrences", g.occA)(
+		neighbors(ctx, b, g.arts[artA], []model.Edge{model.EdgeArtifactIsOccurrence}))
+}
+
+func checkNeighborsList(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	// A third occurrence of artA and an SBOM give it enough neighbors to need
+	// several pages.
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Source: srcIn(srcA)}, *artIn(artA),
+		model.IsOccurrenceInputSpec{Justification: "graph", Origin: "conformance", Collector: "conformance"}); err != nil {
+		return err
+	}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: artIn(artA)},
+		model.HasSBOMInputSpec{URI: "https://example.com/sbom.json", KnownSince: t1, Origin: "conformance", Collector: "conformance"},
+		model.HasSBOMIncludesInputSpec{}); err != nil {
+		return err
+	}
+	all, err := neighbors(ctx, b, g.arts[artA], nil)
+	if err != nil {
+		return err
+	}
+	if len(all) < 4 {
+		return fmt.Errorf("Neighbors of an artifact returned %v, want at least 4", all)
+	}
+	return want("NeighborsList", all...)(paginate("NeighborsList", func(after *string, first *int) (*model.NeighborConnection, error) {
+		return b.NeighborsList(ctx, g.arts[artA], nil, after, first)
+	}))
+}
+
+func checkPath(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	// pkgA -> dep -> pkgC -> occA -> artA -> hashEqual -> artB -> occB -> pkgD
+	path, err := idsOf(b.Path(ctx, g.pkg(pkgA), g.pkg(pkgD), 10, nil))
+	if err != nil {
+		return err
+	}
+	for _, id := range []string{g.dep, g.occA, g.arts[artA], g.hashEqual, g.occB} {
+		if !slices.Contains(path, id) {
+			return fmt.Errorf("Path returned %v, missing %q", path, id)
+		}
+	}
+	// Without hash equality edges the packages are not connected.
+	path, err = idsOf(b.Path(ctx, g.pkg(pkgA), g.pkg(pkgD), 10, []model.Edge{
+		model.EdgePackageIsDependency, model.EdgeIsDependencyPackage, model.EdgePackageIsOccurrence,
+		model.EdgeIsOccurrencePackage, model.EdgeArtifactIsOccurrence, model.EdgeIsOccurrenceArtifact,
+	}))
+	if err == nil && len(path) > 0 {
+		return fmt.Errorf("Path without hash equality edges returned %v", path)
+	}
+	return nil
+}
+
+// wantPaths checks that a path query returned paths through exactly the given
+// nodes, in order, with the given weights.
+func wantPaths(what string, paths []*model.Path, err error, weights []float64, nodes ...[]string) error {
+	if err != nil {
+		return fmt.Errorf("%s: %w", what, err)
+	}
+	if len(paths) != len(nodes) {
+		return fmt.Errorf("%s returned %d paths, want %d", what, len(paths), len(nodes))
+	}
+	for i, p := range paths {
+		got, _ := idsOf(p.Nodes, nil)
+		if !slices.Equal(got, nodes[i]) {
+			return fmt.Errorf("%s returned path %d through %v, want %v", what, i, got, nodes[i])
+		}
+		if p.Length != len(nodes[i])-1 || p.Weight != weights[i] {
+			return fmt.Errorf("%s returned path %d of length %d and weight %v, want %d and %v",
+				what, i, p.Length, p.Weight, len(nodes[i])-1, weights[i])
+		}
+	}
+	return nil
+}
+
+func checkAllPaths(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	// A package equality is a shortcut between pkgA and pkgD.
+	pkgEqual, err := b.IngestPkgEqual(ctx, *pkgIn(pkgA), *pkgIn(pkgD),
+		model.PkgEqualInputSpec{Justification: "graph", Origin: "conformance", Collector: "conformance"})
+	if err != nil {
+		return fmt.Errorf("IngestPkgEqual: %w", err)
+	}
+	short := []string{g.pkg(pkgA), pkgEqual, g.pkg(pkgD)}
+	long := []string{g.pkg(pkgA), g.dep, g.pkg(pkgC), g.occA, g.arts[artA], g.hashEqual, g.arts[artB], g.occB, g.pkg(pkgD)}
+
+	paths, err := b.AllPaths(ctx, g.pkg(pkgA), g.pkg(pkgD), 10, nil, nil, nil)
+	if err := wantPaths("AllPaths", paths, err, []float64{2, 8}, short, long); err != nil {
+		return err
+	}
+	paths, err = b.AllPaths(ctx, g.pkg(pkgA), g.pkg(pkgD), 4, nil, nil, nil)
+	if err := wantPaths("AllPaths up to 4 edges", paths, err, []float64{2}, short); err != nil {
+		return err
+	}
+	// Weighing the equality more makes the long path the shortest one.
+	weights := []*model.EdgeWeight{{Edge: model.EdgePackagePkgEqual, Weight: 100}, {Edge: model.EdgeArtifactHashEqual, Weight: 0.5}}
+	paths, err = b.AllPaths(ctx, g.pkg(pkgA), g.pkg(pkgD), 10, nil, weights, ptrfrom.Int(1))
+	if err := wantPaths("AllPaths with weights", paths, err, []float64{7.5}, long); err != nil {
+		return err
+	}
+	paths, err = b.AllPaths(ctx, g.pkg(pkgA), g.pkg(pkgD), 10, []model.Edge{
+		model.EdgePackagePkgEqual, model.EdgePkgEqualPackage,
+	}, nil, nil)
+	return wantPaths("AllPaths using only package equality", paths, err, []float64{2}, short)
+}
+
+func checkConstrainedPath(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	// An artifact, the package it is an occurrence of, then its dependents.
+	segments := []*model.PathSegment{
+		{Edges: []model.Edge{model.EdgeArtifactIsOccurrence, model.EdgeIsOccurrencePackage}},
+		{Edges: []model.Edge{model.EdgePackageIsDependency, model.EdgeIsDependencyPackage}, Repeated: ptrfrom.Bool(true)},
+	}
+	paths, err := b.ConstrainedPath(ctx, g.arts[artA], g.pkg(pkgA), segments, 10, nil)
+	if err := wantPaths("ConstrainedPath", paths, err, []float64{4},
+		[]string{g.arts[artA], g.occA, g.pkg(pkgC), g.dep, g.pkg(pkgA)}); err != nil {
+		return err
+	}
+	paths, err = b.ConstrainedPath(ctx, g.arts[artA], g.pkg(pkgC), segments, 10, nil)
+	if err := wantPaths("ConstrainedPath without repeating", paths, err, []float64{2},
+		[]string{g.arts[artA], g.occA, g.pkg(pkgC)}); err != nil {
+		return err
+	}
+	// artB only reaches pkgA through a hash equality, which the segments do
+	// not allow.
+	paths, err = b.ConstrainedPath(ctx, g.arts[artB], g.pkg(pkgA), segments, 10, nil)
+	return wantPaths("ConstrainedPath through a hash equality", paths, err, nil)
+}
+
+// ingestClosureGraph extends the graph with an SBOM of artB that includes
+// pkgB, so that pkgA depends on pkgC and its equivalents, and through them on
+// pkgB.
+func ingestClosureGraph(ctx context.Context, b backends.Backend) (*graph, error) {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	spec := model.HasSBOMInputSpec{URI: "https://example.com/closure.spdx.json", Algorithm: "sha256", Digest: "c105ure",
+		DownloadLocation: "https://example.com", Origin: "conformance", Collector: "conformance"}
+	if _, err := b.IngestHasSbom(ctx, model.PackageOrArtifactInput{Artifact: artIn(artB)}, spec,
+		model.HasSBOMIncludesInputSpec{Packages: []string{g.pkg(pkgB)}}); err != nil {
+		return nil, fmt.Errorf("IngestHasSbom: %w", err)
+	}
+	return g, nil
+}
+
+// wantClosure checks a closure against the depths of the wanted nodes, in
+// order of depth and then of ID.
+func wantClosure(what string, got []*model.ClosureEntry, err error, depths map[string]int) error {
+	if err != nil {
+		return fmt.Errorf("%s: %w", what, err)
+	}
+	var want []*model.ClosureEntry
+	for id, depth := range depths {
+		want = append(want, &model.ClosureEntry{ID: id, Depth: depth})
+	}
+	slices.SortFunc(want, func(a, b *model.ClosureEntry) int {
+		return cmp.Or(cmp.Compare(a.Depth, b.Depth), cmp.Compare(a.ID, b.ID))
+	})
+	if !slices.EqualFunc(got, want, func(a, b *model.ClosureEntry) bool { return *a == *b }) {
+		return fmt.Errorf("%s returned %s, want %s", what, closureString(got), closureString(want))
+	}
+	return nil
+}
+
+func closureString(entries []*model.ClosureEntry) string {
+	var s []string
+	for _, e := range entries {
+		s = append(s, fmt.Sprintf("%s@%d", e.ID, e.Depth))
+	}
+	return fmt.Sprint(s)
+}
+
+func checkDependencyClosure(ctx context.Context, b backends.Backend) error {
+	g, err := ingestClosureGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	direct := map[string]int{g.pkg(pkgC): 1, g.arts[artA]: 1, g.arts[artB]: 1, g.pkg(pkgD): 1}
+	got, err := b.DependencyClosure(ctx, g.pkg(pkgA), ptrfrom.Int(1))
+	if err := wantClosure("DependencyClosure up to depth 1", got, err, direct); err != nil {
+		return err
+	}
+	all := maps.Clone(direct)
+	all[g.pkg(pkgB)] = 2
+	got, err = b.DependencyClosure(ctx, g.pkg(pkgA), nil)
+	if err := wantClosure("DependencyClosure", got, err, all); err != nil {
+		return err
+	}
+	got, err = b.DependencyClosure(ctx, g.pkg(pkgB), nil)
+	return wantClosure("DependencyClosure of a leaf", got, err, nil)
+}
+
+func checkDependentClosure(ctx context.Context, b backends.Backend) error {
+	g, err := ingestClosureGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	direct := map[string]int{g.arts[artB]: 1, g.arts[artA]: 1, g.pkg(pkgC): 1, g.pkg(pkgD): 1}
+	got, err := b.DependentClosure(ctx, g.pkg(pkgB), ptrfrom.Int(1))
+	if err := wantClosure("DependentClosure up to depth 1", got, err, direct); err != nil {
+		return err
+	}
+	all := maps.Clone(direct)
+	all[g.pkg(pkgA)] = 2
+	got, err = b.DependentClosure(ctx, g.pkg(pkgB), nil)
+	if err := wantClosure("DependentClosure", got, err, all); err != nil {
+		return err
+	}
+	// The equivalents of artA are not its dependents, but what depends on
+	// them is.
+	got, err = b.DependentClosure(ctx, g.arts[artA], nil)
+	return wantClosure("DependentClosure through an equivalence", got, err, map[string]int{g.pkg(pkgA): 1})
+}
+
+func checkDelete(ctx context.Context, b backends.Backend) error {
+	g, err := ingestGraph(ctx, b)
+	if err != nil {
+		return err
+	}
+	deleted, err := b.Delete(ctx, g.occA)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("Delete(%q) returned false", g.occA)
+	}
+	// Querying a deleted node either returns nothing or fails as not found.
+	if got, err := idsOf(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: &g.occA})); err == nil && len(got) > 0 {
+		return fmt.Errorf("IsOccurrence returned deleted node %v", got)
+	}
+	if err := want("IsOccurrence after Delete", g.occB)(idsOf(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{}))); err != nil {
+		return err
+	}
+	// The nouns of a deleted predicate stay.
+	got, err := neighbors(ctx, b, g.arts[artA], nil)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(got, g.occA) {
+		return fmt.Errorf("Neighbors of an artifact still returns deleted node %q", g.occA)
+	}
+	return nil
+}
+
+func checkFindSoftware(ctx context.Context, b backends.Backend) error {
+	n, err := ingestNouns(ctx, b)
+	if err != nil {
+		return err
+	}
+	got, err := idsOf(b.FindSoftware(ctx, "requests"))
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(got, n.pkg(pkgC)) {
+		return fmt.Errorf("FindSoftware(requests) returned %v, missing %q", got, n.pkg(pkgC))
+	}
+	got, err = idsOf(b.FindSoftware(ctx, "guac-visualizer"))
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(got, n.src(srcB)) {
+		return fmt.Errorf("FindSoftware(guac-visualizer) returned %v, missing %q", got, n.src(srcB))
+	}
+	return want("FindSoftware without a match")(idsOf(b.FindSoftware(ctx, "no-such-software")))
+}
+
+func checkFindSoftwareList(ctx context.Context, b backends.Backend) error {
+	if _, err := ingestNouns(ctx, b); err != nil {
+		return err
+	}
+	all, err := idsOf(b.FindSoftware(ctx, "guac"))
+	if err != nil {
+		return err
+	}
+	if len(all) < 2 {
+		return fmt.Errorf("FindSoftware(guac) returned %v, want at least 2 results", all)
+	}
+	return want("FindSoftwareList", all...)(paginate("FindSoftwareList", func(after *string, first *int) (*model.FindSoftwareConnection, error) {
+		return b.FindSoftwareList(ctx, "guac", after, first)
+	}))
+}
+
+func checkPackagesToScan(ctx context.Context, b backends.Backend) error {
+	n, err := ingestNouns(ctx, b)
+	if err != nil {
+		return err
+	}
+	// pkgA was just scanned, pkgB was scanned long ago and the others never.
+	scan := model.ScanMetadataInput{TimeScanned: time.Now().UTC(), DbURI: "https://osv.dev", ScannerURI: "osv-scanner", Origin: "conformance", Collector: "conformance"}
+	if _, err := b.IngestCertifyVuln(ctx, *pkgIn(pkgA), *vulnIn(noVuln), scan); err != nil {
+		return err
+	}
+	scan.TimeScanned = t1.AddDate(-1, 0, 0)
+	if _, err := b.IngestCertifyVuln(ctx, *pkgIn(pkgB), *vulnIn(noVuln), scan); err != nil {
+		return err
+	}
+	toScan := []string{n.pkg(pkgB), n.pkg(pkgC), n.pkg(pkgD)}
+	if err := want("FindPackagesThatNeedScanning", toScan...)(
+		b.FindPackagesThatNeedScanning(ctx, model.QueryTypeVulnerability, ptrfrom.Int(24))); err != nil {
+		return err
+	}
+	return want("QueryPackagesListForScan", toScan...)(paginate("QueryPackagesListForScan", func(after *string, first *int) (*model.PackageConnection, error) {
+		return b.QueryPackagesListForScan(ctx, toScan, after, first)
+	}))
+}
+
+func checkBatchCertifyLegal(ctx context.Context, b backends.Backend) error {
+	n, err := ingestNouns(ctx, b)
+	if err != nil {
+		return err
+	}
+	spec := &model.CertifyLegalInputSpec{DeclaredLicense: "MIT", TimeScanned: t1, Origin: "conformance", Collector: "conformance"}
+	var c collect
+	c.one(b.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgA)}, []*model.IDorLicenseInput{licIn(licB)}, nil, spec))
+	c.one(b.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgC)}, []*model.IDorLicenseInput{licIn(licB)}, nil, spec))
+	c.one(b.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: pkgIn(pkgD)}, []*model.IDorLicenseInput{licIn(licB)}, nil, spec))
+	legals, err := c.result()
+	if err != nil {
+		return err
+	}
+	return want("BatchQueryPkgIDCertifyLegal", legals[0], legals[1])(
+		idsOf(b.BatchQueryPkgIDCertifyLegal(ctx, []string{n.pkg(pkgA), n.pkg(pkgC)})))
+}
+
+func checkBatchCertifyVuln(ctx context.Context, b backends.Backend) error {
+	n, err := ingestNouns(ctx, b)
+	if err != nil {
+		return err
+	}
+	scan := model.ScanMetadataInput{TimeScanned: t1, DbURI: "https://osv.dev", ScannerURI: "osv-scanner", Origin: "conformance", Collector: "conformance"}
+	var c collect
+	c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgA), *vulnIn(vulnA), scan))
+	c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgA), *vulnIn(vulnB), scan))
+	c.one(b.IngestCertifyVuln(ctx, *pkgIn(pkgC), *vulnIn(vulnB), scan))
+	vulns, err := c.result()
+	if err != nil {
+		return err
+	}
+	return want("BatchQueryPkgIDCertifyVuln", vulns[0], vulns[1])(
+		idsOf(b.BatchQueryPkgIDCertifyVuln(ctx, []string{n.pkg(pkgA), n.pkg(pkgB)})))
+}
+
+func checkBatchDependency(ctx context.Context, b backends.Backend) error {
+	n, err := ingestNouns(ctx, b)
+	if err != nil {
+		return err
+	}
+	spec := model.IsDependencyInputSpec{DependencyType: model.DependencyTypeDirect, Origin: "conformance", Collector: "conformance"}
+	var c collect
+	c.one(b.IngestDependency(ctx, *pkgIn(pkgA), *pkgIn(pkgC), spec))
+	c.one(b.IngestDependency(ctx, *pkgIn(pkgB), *pkgIn(pkgC), spec))
+	c.one(b.IngestDependency(ctx, *pkgIn(pkgC), *pkgIn(pkgD), spec))
+	deps, err := c.result()
+	if err != nil {
+		return err
+	}
+	if err := want("BatchQuerySubjectPkgDependency", deps[0], deps[2])(
+		idsOf(b.BatchQuerySubjectPkgDependency(ctx, []string{n.pkg(pkgA), n.pkg(pkgC)}))); err != nil {
+		return err
+	}
+	return want("BatchQueryDepPkgDependency", deps[0], deps[1])(
+		idsOf(b.BatchQueryDepPkgDependency(ctx, []string{n.pkg(pkgC)})))
+}
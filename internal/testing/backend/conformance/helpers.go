@@ -0,0 +1,248 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var (
+	t1 = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	pkgA = &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("github.com/guacsec"), Name: "guac", Version: ptrfrom.String("v1.0.0")}
+	pkgB = &model.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("github.com/guacsec"), Name: "guac", Version: ptrfrom.String("v2.0.0")}
+	pkgC = &model.PkgInputSpec{Type: "pypi", Name: "requests", Version: ptrfrom.String("2.31.0")}
+	pkgD = &model.PkgInputSpec{Type: "npm", Namespace: ptrfrom.String("@guac"), Name: "ui", Version: ptrfrom.String("1.0.0"),
+		Qualifiers: []*model.PackageQualifierInputSpec{{Key: "arch", Value: "x86"}}}
+
+	srcA = &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: ptrfrom.String("v1.0.0")}
+	srcB = &model.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac-visualizer"}
+	srcC = &model.SourceInputSpec{Type: "svn", Namespace: "example.com", Name: "repo", Commit: ptrfrom.String("abcdef")}
+
+	artA = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "aaaa"}
+	artB = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "bbbb"}
+	artC = &model.ArtifactInputSpec{Algorithm: "sha1", Digest: "cccc"}
+
+	builderA = &model.BuilderInputSpec{URI: "https://github.com/actions/runner"}
+	builderB = &model.BuilderInputSpec{URI: "https://tekton.dev/chains/v2"}
+
+	licA = &model.LicenseInputSpec{Name: "Apache-2.0", ListVersion: ptrfrom.String("3.21")}
+	licB = &model.LicenseInputSpec{Name: "MIT", ListVersion: ptrfrom.String("3.21")}
+	licC = &model.LicenseInputSpec{Name: "LicenseRef-guac", Inline: ptrfrom.String("Permission is granted")}
+
+	vulnA = &model.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-xxxx-yyyy-zzzz"}
+	vulnB = &model.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2026-1234"}
+	vulnC = &model.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-xxxx-yyyy-zzzz"}
+)
+
+func pkgIn(p *model.PkgInputSpec) *model.IDorPkgInput {
+	return &model.IDorPkgInput{PackageInput: p}
+}
+
+func srcIn(s *model.SourceInputSpec) *model.IDorSourceInput {
+	return &model.IDorSourceInput{SourceInput: s}
+}
+
+func artIn(a *model.ArtifactInputSpec) *model.IDorArtifactInput {
+	return &model.IDorArtifactInput{ArtifactInput: a}
+}
+
+func vulnIn(v *model.VulnerabilityInputSpec) *model.IDorVulnerabilityInput {
+	return &model.IDorVulnerabilityInput{VulnerabilityInput: v}
+}
+
+func licIn(l *model.LicenseInputSpec) *model.IDorLicenseInput {
+	return &model.IDorLicenseInput{LicenseInput: l}
+}
+
+func builderIn(b *model.BuilderInputSpec) *model.IDorBuilderInput {
+	return &model.IDorBuilderInput{BuilderInput: b}
+}
+
+var (
+	specificVersion = &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+	allVersions     = &model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}
+)
+
+// expectIDs checks that a query returned exactly the nodes with the given
+// IDs, in any order.
+func expectIDs[T any](what string, nodes []T, want ...string) error {
+	got := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		got = append(got, nodeIDs(n)...)
+	}
+	return sameIDs(what, got, want)
+}
+
+func sameIDs(what string, got, want []string) error {
+	got = slices.Clone(got)
+	want = slices.Clone(want)
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		return fmt.Errorf("%s returned %v, want %v", what, got, want)
+	}
+	return nil
+}
+
+// distinct checks that ingestion returned a different ID for every input.
+func distinct(what string, ids []string, n int) error {
+	if len(ids) != n {
+		return fmt.Errorf("%s returned %d IDs for %d inputs", what, len(ids), n)
+	}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if id == "" {
+			return fmt.Errorf("%s returned an empty ID", what)
+		}
+		if seen[id] {
+			return fmt.Errorf("%s returned ID %q twice", what, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// nodeIDs returns the IDs that identify a node. Packages, sources and
+// vulnerabilities are trees that share their root, so the IDs of their deepest
+// levels are used.
+func nodeIDs(node any) []string {
+	var ids []string
+	switch n := node.(type) {
+	case *model.Package:
+		for _, ns := range n.Namespaces {
+			for _, name := range ns.Names {
+				for _, v := range name.Versions {
+					ids = append(ids, v.ID)
+				}
+				if len(name.Versions) == 0 {
+					ids = append(ids, name.ID)
+				}
+			}
+			if len(ns.Names) == 0 {
+				ids = append(ids, ns.ID)
+			}
+		}
+		if len(n.Namespaces) == 0 {
+			ids = append(ids, n.ID)
+		}
+	case *model.Source:
+		for _, ns := range n.Namespaces {
+			for _, name := range ns.Names {
+				ids = append(ids, name.ID)
+			}
+			if len(ns.Names) == 0 {
+				ids = append(ids, ns.ID)
+			}
+		}
+		if len(n.Namespaces) == 0 {
+			ids = append(ids, n.ID)
+		}
+	case *model.Vulnerability:
+		for _, v := range n.VulnerabilityIDs {
+			ids = append(ids, v.ID)
+		}
+		if len(n.VulnerabilityIDs) == 0 {
+			ids = append(ids, n.ID)
+		}
+	default:
+		v := reflect.ValueOf(node)
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			return []string{"<nil>"}
+		}
+		ids = append(ids, v.FieldByName("ID").String())
+	}
+	return ids
+}
+
+// listFunc fetches a page of a paginated query.
+type listFunc[C any] func(after *string, first *int) (*C, error)
+
+// maxPages bounds the pages read by paginate, so that a backend that never
+// ends a list fails instead of hanging.
+const maxPages = 1000
+
+// paginate reads a paginated query one node at a time and returns the IDs of
+// the nodes of every page. It checks that pages have at most one node, that
+// no node is returned twice and that the last page has no next page.
+func paginate[C any](what string, list listFunc[C]) ([]string, error) {
+	var all []string
+	seen := map[string]bool{}
+	var after *string
+	for page := 0; page < maxPages; page++ {
+		conn, err := list(after, ptrfrom.Int(1))
+		if err != nil {
+			return nil, fmt.Errorf("%s page %d: %w", what, page, err)
+		}
+		edges, info := connection(conn)
+		if len(edges) > 1 {
+			return nil, fmt.Errorf("%s page %d has %d nodes, want at most 1", what, page, len(edges))
+		}
+		for _, n := range edges {
+			for _, id := range nodeIDs(n) {
+				if seen[id] {
+					return nil, fmt.Errorf("%s page %d returned %q again", what, page, id)
+				}
+				seen[id] = true
+				all = append(all, id)
+			}
+		}
+		if info == nil || !info.HasNextPage {
+			return all, nil
+		}
+		if info.EndCursor == nil {
+			return nil, fmt.Errorf("%s page %d has a next page but no end cursor", what, page)
+		}
+		after = info.EndCursor
+	}
+	return nil, fmt.Errorf("%s did not end after %d pages", what, maxPages)
+}
+
+// connection returns the nodes and page info of a connection. All
+// connections have the same shape, a PageInfo and Edges with a Node each. A
+// nil connection is an empty list.
+func connection[C any](conn *C) ([]any, *model.PageInfo) {
+	if conn == nil {
+		return nil, nil
+	}
+	v := reflect.ValueOf(conn).Elem()
+	info, _ := v.FieldByName("PageInfo").Interface().(*model.PageInfo)
+	edges := v.FieldByName("Edges")
+	nodes := make([]any, 0, edges.Len())
+	for i := 0; i < edges.Len(); i++ {
+		nodes = append(nodes, edges.Index(i).Elem().FieldByName("Node").Interface())
+	}
+	return nodes, info
+}
+
+// checkList pages through a paginated query and checks that it returns
+// exactly the nodes with the given IDs.
+func checkList[C any](what string, list listFunc[C], want ...string) error {
+	got, err := paginate(what, list)
+	if err != nil {
+		return err
+	}
+	return sameIDs(what, got, want)
+}
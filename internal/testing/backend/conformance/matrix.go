@@ -0,0 +1,84 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+var statusCell = map[Status]string{
+	Pass:           "✅",
+	Fail:           "❌",
+	NotImplemented: "➖",
+	"":             " ",
+}
+
+// WriteMatrix writes the reports as a markdown table with a row per feature and
+// a column per backend, followed by the number of features each backend
+// passes. Backends are sorted by name. Excluded maps the backends that were
+// not checked to the reason why, which is listed below the table.
+func WriteMatrix(w io.Writer, reports []*Report, excluded map[string]string) error {
+	reports = append([]*Report(nil), reports...)
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Backend < reports[j].Backend })
+
+	var b strings.Builder
+	b.WriteString("| Feature | Methods |")
+	for _, r := range reports {
+		fmt.Fprintf(&b, " %s |", r.Backend)
+	}
+	b.WriteString("\n| --- | --- |")
+	for range reports {
+		b.WriteString(" :---: |")
+	}
+	b.WriteString("\n")
+
+	features := Features()
+	passed := make([]int, len(reports))
+	for _, f := range features {
+		fmt.Fprintf(&b, "| %s | %s |", f.Name, strings.Join(f.Methods, ", "))
+		for i, r := range reports {
+			s := r.Status(f.Name)
+			if s == Pass {
+				passed[i]++
+			}
+			fmt.Fprintf(&b, " %s |", statusCell[s])
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("| **Passed** | |")
+	for i := range reports {
+		fmt.Fprintf(&b, " %d/%d |", passed[i], len(features))
+	}
+	b.WriteString("\n\n✅ pass, ❌ fail, ➖ not implemented\n")
+
+	names := make([]string, 0, len(excluded))
+	for name := range excluded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) > 0 {
+		b.WriteString("\nNot checked:\n\n")
+	}
+	for _, name := range names {
+		fmt.Fprintf(&b, "- %s: %s\n", name, excluded[name])
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
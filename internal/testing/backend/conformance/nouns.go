@@ -0,0 +1,482 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// nouns holds the IDs of the nouns ingested by ingestNouns, keyed by their
+// input spec.
+type nouns struct {
+	pkgs     map[*model.PkgInputSpec]*model.PackageIDs
+	srcs     map[*model.SourceInputSpec]*model.SourceIDs
+	arts     map[*model.ArtifactInputSpec]string
+	builders map[*model.BuilderInputSpec]string
+	lics     map[*model.LicenseInputSpec]string
+	vulns    map[*model.VulnerabilityInputSpec]*model.VulnerabilityIDs
+}
+
+var noVuln = &model.VulnerabilityInputSpec{Type: "novuln"}
+
+// ingestNouns ingests every noun the evidence features refer to, one at a
+// time.
+func ingestNouns(ctx context.Context, b backends.Backend) (*nouns, error) {
+	n := &nouns{
+		pkgs:     map[*model.PkgInputSpec]*model.PackageIDs{},
+		srcs:     map[*model.SourceInputSpec]*model.SourceIDs{},
+		arts:     map[*model.ArtifactInputSpec]string{},
+		builders: map[*model.BuilderInputSpec]string{},
+		lics:     map[*model.LicenseInputSpec]string{},
+		vulns:    map[*model.VulnerabilityInputSpec]*model.VulnerabilityIDs{},
+	}
+	var err error
+	for _, p := range []*model.PkgInputSpec{pkgA, pkgB, pkgC, pkgD} {
+		if n.pkgs[p], err = b.IngestPackage(ctx, *pkgIn(p)); err != nil {
+			return nil, fmt.Errorf("IngestPackage: %w", err)
+		}
+	}
+	for _, s := range []*model.SourceInputSpec{srcA, srcB, srcC} {
+		if n.srcs[s], err = b.IngestSource(ctx, *srcIn(s)); err != nil {
+			return nil, fmt.Errorf("IngestSource: %w", err)
+		}
+	}
+	for _, a := range []*model.ArtifactInputSpec{artA, artB, artC} {
+		if n.arts[a], err = b.IngestArtifact(ctx, artIn(a)); err != nil {
+			return nil, fmt.Errorf("IngestArtifact: %w", err)
+		}
+	}
+	for _, bu := range []*model.BuilderInputSpec{builderA, builderB} {
+		if n.builders[bu], err = b.IngestBuilder(ctx, builderIn(bu)); err != nil {
+			return nil, fmt.Errorf("IngestBuilder: %w", err)
+		}
+	}
+	for _, l := range []*model.LicenseInputSpec{licA, licB, licC} {
+		if n.lics[l], err = b.IngestLicense(ctx, licIn(l)); err != nil {
+			return nil, fmt.Errorf("IngestLicense: %w", err)
+		}
+	}
+	for _, v := range []*model.VulnerabilityInputSpec{vulnA, vulnB, vulnC, noVuln} {
+		if n.vulns[v], err = b.IngestVulnerability(ctx, *vulnIn(v)); err != nil {
+			return nil, fmt.Errorf("IngestVulnerability: %w", err)
+		}
+	}
+	return n, nil
+}
+
+func (n *nouns) pkg(p *model.PkgInputSpec) string { return n.pkgs[p].PackageVersionID }
+
+func (n *nouns) src(s *model.SourceInputSpec) string { return n.srcs[s].SourceNameID }
+
+func (n *nouns) vuln(v *model.VulnerabilityInputSpec) string { return n.vulns[v].VulnerabilityNodeID }
+
+func nounFeatures() []Feature {
+	return []Feature{
+		{
+			Name:    "Artifact",
+			Methods: []string{"IngestArtifact", "IngestArtifacts", "Artifacts"},
+			check:   checkArtifacts,
+		},
+		{
+			Name:    "ArtifactList",
+			Methods: []string{"ArtifactsList"},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				return checkList("ArtifactsList", func(after *string, first *int) (*model.ArtifactConnection, error) {
+					return b.ArtifactsList(ctx, model.ArtifactSpec{}, after, first)
+				}, n.arts[artA], n.arts[artB], n.arts[artC])
+			},
+		},
+		{
+			Name:    "Builder",
+			Methods: []string{"IngestBuilder", "IngestBuilders", "Builders"},
+			check:   checkBuilders,
+		},
+		{
+			Name:    "BuilderList",
+			Methods: []string{"BuildersList"},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				return checkList("BuildersList", func(after *string, first *int) (*model.BuilderConnection, error) {
+					return b.BuildersList(ctx, model.BuilderSpec{}, after, first)
+				}, n.builders[builderA], n.builders[builderB])
+			},
+		},
+		{
+			Name:    "License",
+			Methods: []string{"IngestLicense", "IngestLicenses", "Licenses"},
+			check:   checkLicenses,
+		},
+		{
+			Name:    "LicenseList",
+			Methods: []string{"LicenseList"},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				return checkList("LicenseList", func(after *string, first *int) (*model.LicenseConnection, error) {
+					return b.LicenseList(ctx, model.LicenseSpec{}, after, first)
+				}, n.lics[licA], n.lics[licB], n.lics[licC])
+			},
+		},
+		{
+			Name:    "Package",
+			Methods: []string{"IngestPackage", "IngestPackages", "Packages"},
+			check:   checkPackages,
+		},
+		{
+			Name:    "PackageList",
+			Methods: []string{"PackagesList"},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				return checkList("PackagesList", func(after *string, first *int) (*model.PackageConnection, error) {
+					return b.PackagesList(ctx, model.PkgSpec{}, after, first)
+				}, n.pkg(pkgA), n.pkg(pkgB), n.pkg(pkgC), n.pkg(pkgD))
+			},
+		},
+		{
+			Name:    "Source",
+			Methods: []string{"IngestSource", "IngestSources", "Sources"},
+			check:   checkSources,
+		},
+		{
+			Name:    "SourceList",
+			Methods: []string{"SourcesList"},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				return checkList("SourcesList", func(after *string, first *int) (*model.SourceConnection, error) {
+					return b.SourcesList(ctx, model.SourceSpec{}, after, first)
+				}, n.src(srcA), n.src(srcB), n.src(srcC))
+			},
+		},
+		{
+			Name:    "Vulnerability",
+			Methods: []string{"IngestVulnerability", "IngestVulnerabilities", "Vulnerabilities"},
+			check:   checkVulnerabilities,
+		},
+		{
+			Name:    "VulnerabilityList",
+			Methods: []string{"VulnerabilityList"},
+			check: func(ctx context.Context, b backends.Backend) error {
+				n, err := ingestNouns(ctx, b)
+				if err != nil {
+					return err
+				}
+				return checkList("VulnerabilityList", func(after *string, first *int) (*model.VulnerabilityConnection, error) {
+					return b.VulnerabilityList(ctx, model.VulnerabilitySpec{}, after, first)
+				}, n.vuln(vulnA), n.vuln(vulnB), n.vuln(vulnC), n.vuln(noVuln))
+			},
+		},
+	}
+}
+
+func checkArtifacts(ctx context.Context, b backends.Backend) error {
+	a, err := b.IngestArtifact(ctx, artIn(artA))
+	if err != nil {
+		return err
+	}
+	again, err := b.IngestArtifact(ctx, artIn(artA))
+	if err != nil {
+		return err
+	}
+	if again != a {
+		return fmt.Errorf("ingesting the same artifact twice returned %q and %q", a, again)
+	}
+	bulk, err := b.IngestArtifacts(ctx, []*model.IDorArtifactInput{artIn(artB), artIn(artC)})
+	if err != nil {
+		return err
+	}
+	if err := distinct("IngestArtifacts", append(bulk, a), 3); err != nil {
+		return err
+	}
+
+	all, err := b.Artifacts(ctx, &model.ArtifactSpec{})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Artifacts", all, a, bulk[0], bulk[1]); err != nil {
+		return err
+	}
+	byID, err := b.Artifacts(ctx, &model.ArtifactSpec{ID: &bulk[0]})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Artifacts by ID", byID, bulk[0]); err != nil {
+		return err
+	}
+	byAlgorithm, err := b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: ptrfrom.String("sha256")})
+	if err != nil {
+		return err
+	}
+	return expectIDs("Artifacts by algorithm", byAlgorithm, a, bulk[0])
+}
+
+func checkBuilders(ctx context.Context, b backends.Backend) error {
+	a, err := b.IngestBuilder(ctx, builderIn(builderA))
+	if err != nil {
+		return err
+	}
+	again, err := b.IngestBuilder(ctx, builderIn(builderA))
+	if err != nil {
+		return err
+	}
+	if again != a {
+		return fmt.Errorf("ingesting the same builder twice returned %q and %q", a, again)
+	}
+	bulk, err := b.IngestBuilders(ctx, []*model.IDorBuilderInput{builderIn(builderB)})
+	if err != nil {
+		return err
+	}
+	if err := distinct("IngestBuilders", append(bulk, a), 2); err != nil {
+		return err
+	}
+
+	all, err := b.Builders(ctx, &model.BuilderSpec{})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Builders", all, a, bulk[0]); err != nil {
+		return err
+	}
+	byID, err := b.Builders(ctx, &model.BuilderSpec{ID: &a})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Builders by ID", byID, a); err != nil {
+		return err
+	}
+	byURI, err := b.Builders(ctx, &model.BuilderSpec{URI: &builderB.URI})
+	if err != nil {
+		return err
+	}
+	return expectIDs("Builders by URI", byURI, bulk[0])
+}
+
+func checkLicenses(ctx context.Context, b backends.Backend) error {
+	a, err := b.IngestLicense(ctx, licIn(licA))
+	if err != nil {
+		return err
+	}
+	again, err := b.IngestLicense(ctx, licIn(licA))
+	if err != nil {
+		return err
+	}
+	if again != a {
+		return fmt.Errorf("ingesting the same license twice returned %q and %q", a, again)
+	}
+	bulk, err := b.IngestLicenses(ctx, []*model.IDorLicenseInput{licIn(licB), licIn(licC)})
+	if err != nil {
+		return err
+	}
+	if err := distinct("IngestLicenses", append(bulk, a), 3); err != nil {
+		return err
+	}
+
+	all, err := b.Licenses(ctx, &model.LicenseSpec{})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Licenses", all, a, bulk[0], bulk[1]); err != nil {
+		return err
+	}
+	byID, err := b.Licenses(ctx, &model.LicenseSpec{ID: &bulk[1]})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Licenses by ID", byID, bulk[1]); err != nil {
+		return err
+	}
+	byName, err := b.Licenses(ctx, &model.LicenseSpec{Name: &licB.Name})
+	if err != nil {
+		return err
+	}
+	return expectIDs("Licenses by name", byName, bulk[0])
+}
+
+func checkPackages(ctx context.Context, b backends.Backend) error {
+	a, err := b.IngestPackage(ctx, *pkgIn(pkgA))
+	if err != nil {
+		return err
+	}
+	again, err := b.IngestPackage(ctx, *pkgIn(pkgA))
+	if err != nil {
+		return err
+	}
+	if *again != *a {
+		return fmt.Errorf("ingesting the same package twice returned %+v and %+v", a, again)
+	}
+	bulk, err := b.IngestPackages(ctx, []*model.IDorPkgInput{pkgIn(pkgB), pkgIn(pkgC), pkgIn(pkgD)})
+	if err != nil {
+		return err
+	}
+	if len(bulk) != 3 {
+		return fmt.Errorf("IngestPackages returned %d IDs for 3 packages", len(bulk))
+	}
+	ids := []string{a.PackageVersionID}
+	for _, p := range bulk {
+		ids = append(ids, p.PackageVersionID)
+	}
+	if err := distinct("IngestPackages", ids, 4); err != nil {
+		return err
+	}
+	// Two versions of a package share every level above the version.
+	if bulk[0].PackageNameID != a.PackageNameID || bulk[0].PackageTypeID != a.PackageTypeID {
+		return fmt.Errorf("versions of the same package returned %+v and %+v", a, bulk[0])
+	}
+
+	all, err := b.Packages(ctx, &model.PkgSpec{})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Packages", all, ids...); err != nil {
+		return err
+	}
+	byID, err := b.Packages(ctx, &model.PkgSpec{ID: &bulk[1].PackageVersionID})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Packages by ID", byID, bulk[1].PackageVersionID); err != nil {
+		return err
+	}
+	byName, err := b.Packages(ctx, &model.PkgSpec{Type: &pkgA.Type, Name: &pkgA.Name})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Packages by name", byName, a.PackageVersionID, bulk[0].PackageVersionID); err != nil {
+		return err
+	}
+	byQualifier, err := b.Packages(ctx, &model.PkgSpec{Qualifiers: []*model.PackageQualifierSpec{{Key: "arch", Value: ptrfrom.String("x86")}}})
+	if err != nil {
+		return err
+	}
+	return expectIDs("Packages by qualifier", byQualifier, bulk[2].PackageVersionID)
+}
+
+func checkSources(ctx context.Context, b backends.Backend) error {
+	a, err := b.IngestSource(ctx, *srcIn(srcA))
+	if err != nil {
+		return err
+	}
+	again, err := b.IngestSource(ctx, *srcIn(srcA))
+	if err != nil {
+		return err
+	}
+	if *again != *a {
+		return fmt.Errorf("ingesting the same source twice returned %+v and %+v", a, again)
+	}
+	bulk, err := b.IngestSources(ctx, []*model.IDorSourceInput{srcIn(srcB), srcIn(srcC)})
+	if err != nil {
+		return err
+	}
+	if len(bulk) != 2 {
+		return fmt.Errorf("IngestSources returned %d IDs for 2 sources", len(bulk))
+	}
+	ids := []string{a.SourceNameID, bulk[0].SourceNameID, bulk[1].SourceNameID}
+	if err := distinct("IngestSources", ids, 3); err != nil {
+		return err
+	}
+
+	all, err := b.Sources(ctx, &model.SourceSpec{})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Sources", all, ids...); err != nil {
+		return err
+	}
+	byID, err := b.Sources(ctx, &model.SourceSpec{ID: &bulk[1].SourceNameID})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Sources by ID", byID, bulk[1].SourceNameID); err != nil {
+		return err
+	}
+	byNamespace, err := b.Sources(ctx, &model.SourceSpec{Namespace: &srcA.Namespace})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Sources by namespace", byNamespace, a.SourceNameID, bulk[0].SourceNameID); err != nil {
+		return err
+	}
+	byTag, err := b.Sources(ctx, &model.SourceSpec{Tag: srcA.Tag})
+	if err != nil {
+		return err
+	}
+	return expectIDs("Sources by tag", byTag, a.SourceNameID)
+}
+
+func checkVulnerabilities(ctx context.Context, b backends.Backend) error {
+	a, err := b.IngestVulnerability(ctx, *vulnIn(vulnA))
+	if err != nil {
+		return err
+	}
+	again, err := b.IngestVulnerability(ctx, *vulnIn(vulnA))
+	if err != nil {
+		return err
+	}
+	if *again != *a {
+		return fmt.Errorf("ingesting the same vulnerability twice returned %+v and %+v", a, again)
+	}
+	bulk, err := b.IngestVulnerabilities(ctx, []*model.IDorVulnerabilityInput{vulnIn(vulnB), vulnIn(vulnC)})
+	if err != nil {
+		return err
+	}
+	if len(bulk) != 2 {
+		return fmt.Errorf("IngestVulnerabilities returned %d IDs for 2 vulnerabilities", len(bulk))
+	}
+	ids := []string{a.VulnerabilityNodeID, bulk[0].VulnerabilityNodeID, bulk[1].VulnerabilityNodeID}
+	if err := distinct("IngestVulnerabilities", ids, 3); err != nil {
+		return err
+	}
+
+	all, err := b.Vulnerabilities(ctx, &model.VulnerabilitySpec{})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Vulnerabilities", all, ids...); err != nil {
+		return err
+	}
+	byID, err := b.Vulnerabilities(ctx, &model.VulnerabilitySpec{ID: &bulk[0].VulnerabilityNodeID})
+	if err != nil {
+		return err
+	}
+	if err := expectIDs("Vulnerabilities by ID", byID, bulk[0].VulnerabilityNodeID); err != nil {
+		return err
+	}
+	// The same ID in two databases is two vulnerabilities.
+	byVulnID, err := b.Vulnerabilities(ctx, &model.VulnerabilitySpec{VulnerabilityID: &vulnA.VulnerabilityID})
+	if err != nil {
+		return err
+	}
+	return expectIDs("Vulnerabilities by vulnerability ID", byVulnID, a.VulnerabilityNodeID, bulk[1].VulnerabilityNodeID)
+}
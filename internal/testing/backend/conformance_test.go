@@ -0,0 +1,74 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/backend/conformance"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+var conformanceMatrix = flag.String("conformance-matrix", "",
+	"write the feature coverage matrix of the backends that ran TestConformance to this file")
+
+// conformanceKnownFailures lists, per backend, the conformance features that
+// are known to fail. They are logged and shown in the matrix instead of
+// failing TestConformance. Features a backend does not implement at all are
+// skipped and need no entry.
+var conformanceKnownFailures = map[string]map[string]bool{}
+
+// conformanceExcluded lists the backends TestConformance does not run on,
+// with the reason, which is written below the matrix.
+var conformanceExcluded = map[string]string{
+	"neo4j":   "no test harness; most of its methods are not implemented and some of its ingestion methods panic, and the integration tests do not start a Neo4j server",
+	"neptune": "uses the neo4j backend against an AWS Neptune cluster, which the integration tests cannot start",
+}
+
+// conformanceReports collects the report of every backend for the matrix.
+var conformanceReports []*conformance.Report
+
+func TestConformance(t *testing.T) {
+	be := testBackends[currentBackend]
+	report := conformance.Run(t, currentBackend, func(t *testing.T) backends.Backend {
+		t.Cleanup(func() {
+			if err := be.Clear(); err != nil {
+				t.Fatalf("Error clearing backend %q between features: %s", currentBackend, err)
+			}
+		})
+		return be.Get()
+	}, conformanceKnownFailures[currentBackend])
+	conformanceReports = append(conformanceReports, report)
+}
+
+func writeConformanceMatrix() error {
+	if *conformanceMatrix == "" || len(conformanceReports) == 0 {
+		return nil
+	}
+	f, err := os.Create(*conformanceMatrix)
+	if err != nil {
+		return err
+	}
+	if err := conformance.WriteMatrix(f, conformanceReports, conformanceExcluded); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -2834,7 +2834,7 @@ func TestHasSBOM(t *testing.T) {
 					}
 				}
 			}
-			got, err := b.HasSBOMList(ctx, *test.Query, nil, nil)
+			got, err := b.HasSBOMList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -3181,7 +3181,7 @@ func TestIngestHasSBOMs(t *testing.T) {
 					return
 				}
 			}
-			got, err := b.HasSBOMList(ctx, *test.Query, nil, nil)
+			got, err := b.HasSBOMList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -3407,7 +3407,7 @@ func TestDeleteHasSBOM(t *testing.T) {
 					return
 				}
 			}
-			got, err := b.HasSBOMList(ctx, *test.Query, nil, nil)
+			got, err := b.HasSBOMList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -3428,7 +3428,7 @@ func TestDeleteHasSBOM(t *testing.T) {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
 			assert.True(t, deleted)
-			secondGot, err := b.HasSBOMList(ctx, *test.Query, nil, nil)
+			secondGot, err := b.HasSBOMList(ctx, *test.Query, nil, nil, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -585,6 +585,36 @@ func TestIsDependency(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query on VersionRange",
+			InPkg: []*model.PkgInputSpec{testdata.P1, testdata.P2},
+			Calls: []call{
+				{
+					P1: testdata.P1,
+					P2: testdata.P2,
+					ID: &model.IsDependencyInputSpec{
+						VersionRange: ptrfrom.String("^2.11.0"),
+					},
+				},
+				{
+					P1: testdata.P1,
+					P2: testdata.P2,
+					ID: &model.IsDependencyInputSpec{
+						VersionRange: ptrfrom.String("~2.11.1"),
+					},
+				},
+			},
+			Query: &model.IsDependencySpec{
+				VersionRange: ptrfrom.String("^2.11.0"),
+			},
+			ExpID: []*model.IsDependency{
+				{
+					Package:           testdata.P1out,
+					DependencyPackage: testdata.P2out,
+					VersionRange:      "^2.11.0",
+				},
+			},
+		},
 		{
 			Name:  "IsDep from version to version",
 			InPkg: []*model.PkgInputSpec{testdata.P2, testdata.P3},
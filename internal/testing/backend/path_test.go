@@ -19,6 +19,8 @@ package backend_test
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 	"testing"
 	"time"
 
@@ -3359,7 +3361,7 @@ func TestNeighbors(t *testing.T) {
 				if err != nil {
 					return
 				}
-				found, err := b.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: &clID})
+				found, err := b.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: &clID}, nil)
 				if err != nil {
 					t.Fatal()
 				}
@@ -3391,7 +3393,7 @@ func TestNeighbors(t *testing.T) {
 				if err != nil {
 					return
 				}
-				found, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: &sID})
+				found, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: &sID}, nil)
 				if err != nil {
 					t.Fatal()
 				}
@@ -3411,7 +3413,7 @@ func TestNeighbors(t *testing.T) {
 				if err != nil {
 					return
 				}
-				found, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &vexID})
+				found, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &vexID}, nil)
 				if err != nil {
 					t.Fatal()
 				}
@@ -3439,7 +3441,7 @@ func TestNeighbors(t *testing.T) {
 				if err != nil {
 					return
 				}
-				found, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &cvID})
+				found, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &cvID}, nil)
 				if err != nil {
 					t.Fatal()
 				}
@@ -3558,7 +3560,7 @@ func TestNeighbors(t *testing.T) {
 				if err != nil {
 					return
 				}
-				found, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &hsID})
+				found, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &hsID}, nil)
 				if err != nil {
 					t.Fatal()
 				}
@@ -3790,3 +3792,197 @@ func TestNeighbors(t *testing.T) {
 		})
 	}
 }
+
+func TestNeighborsList(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	var nameID string
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P2, testdata.P3} {
+		ids, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p})
+		if err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+		nameID = ids.PackageNameID
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{
+		Package: &model.IDorPkgInput{PackageInput: testdata.P1},
+	}, &model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions}, model.CertifyBadInputSpec{
+		Justification: "test justification",
+	}); err != nil {
+		t.Fatalf("Could not ingest CertifyBad: %v", err)
+	}
+
+	all, err := b.Neighbors(ctx, nameID, nil)
+	if err != nil {
+		t.Fatalf("Neighbors() error = %v", err)
+	}
+	// namespace, three versions and the CertifyBad
+	if len(all) != 5 {
+		t.Fatalf("expected 5 neighbors, got %d", len(all))
+	}
+
+	var got []model.Node
+	seen := map[string]bool{}
+	var after *string
+	for pages := 0; ; pages++ {
+		if pages > len(all) {
+			t.Fatalf("paging does not terminate")
+		}
+		page, err := b.NeighborsList(ctx, nameID, nil, after, ptrfrom.Int(2))
+		if err != nil {
+			t.Fatalf("NeighborsList() error = %v", err)
+		}
+		if page.TotalCount != len(all) {
+			t.Errorf("expected totalCount %d, got %d", len(all), page.TotalCount)
+		}
+		for _, e := range page.Edges {
+			if seen[e.Cursor] {
+				t.Errorf("cursor %q returned twice", e.Cursor)
+			}
+			seen[e.Cursor] = true
+			got = append(got, e.Node)
+		}
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+	if diff := cmp.Diff(nodeStrings(t, all), nodeStrings(t, got)); diff != "" {
+		t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+	}
+
+	only, err := b.NeighborsList(ctx, nameID, []model.Edge{model.EdgePackageCertifyBad}, nil, nil)
+	if err != nil {
+		t.Fatalf("NeighborsList() error = %v", err)
+	}
+	if only.TotalCount != 1 {
+		t.Errorf("expected only the CertifyBad neighbor, got %d", only.TotalCount)
+	}
+	if _, ok := only.Edges[0].Node.(*model.CertifyBad); !ok {
+		t.Errorf("expected a CertifyBad neighbor, got %T", only.Edges[0].Node)
+	}
+}
+
+func TestDeleteCascade(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	pkg, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P2})
+	if err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+	a1, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A1})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	a2, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: testdata.A2})
+	if err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+	if _, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{
+		Package: &model.IDorPkgInput{PackageInput: testdata.P2},
+	}, model.IDorArtifactInput{ArtifactInput: testdata.A1}, model.IsOccurrenceInputSpec{
+		Justification: "test justification",
+	}); err != nil {
+		t.Fatalf("Could not ingest occurrence: %v", err)
+	}
+	if _, err := b.IngestHashEqual(ctx, model.IDorArtifactInput{ArtifactInput: testdata.A1},
+		model.IDorArtifactInput{ArtifactInput: testdata.A2}, model.HashEqualInputSpec{
+			Justification: "test justification",
+		}); err != nil {
+		t.Fatalf("Could not ingest HashEqual: %v", err)
+	}
+	if _, err := b.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{
+		Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1},
+	}, nil, model.CertifyBadInputSpec{
+		Justification: "test justification",
+	}); err != nil {
+		t.Fatalf("Could not ingest CertifyBad: %v", err)
+	}
+
+	deleted, err := b.Delete(ctx, a1)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !deleted {
+		t.Fatalf("expected artifact to be deleted")
+	}
+
+	if _, err := b.Node(ctx, a1); err == nil {
+		t.Errorf("expected deleted artifact to be gone")
+	}
+	occ, err := b.IsOccurrenceList(ctx, model.IsOccurrenceSpec{}, nil, nil)
+	if err != nil {
+		t.Fatalf("IsOccurrenceList() error = %v", err)
+	}
+	if occ != nil && len(occ.Edges) != 0 {
+		t.Errorf("expected occurrence to be deleted with its artifact")
+	}
+	he, err := b.HashEqualList(ctx, model.HashEqualSpec{}, nil, nil)
+	if err != nil {
+		t.Fatalf("HashEqualList() error = %v", err)
+	}
+	if he != nil && len(he.Edges) != 0 {
+		t.Errorf("expected HashEqual to be deleted with its artifact")
+	}
+	cb, err := b.CertifyBadList(ctx, model.CertifyBadSpec{}, nil, nil)
+	if err != nil {
+		t.Fatalf("CertifyBadList() error = %v", err)
+	}
+	if cb != nil && len(cb.Edges) != 0 {
+		t.Errorf("expected CertifyBad to be deleted with its artifact")
+	}
+
+	// Remaining nodes do not point to deleted ones anymore.
+	for _, id := range []string{a2, pkg.PackageVersionID} {
+		neighbors, err := b.Neighbors(ctx, id, nil)
+		if err != nil {
+			t.Fatalf("Neighbors() error = %v", err)
+		}
+		for _, n := range neighbors {
+			switch n.(type) {
+			case *model.HashEqual, *model.IsOccurrence:
+				t.Errorf("node %s still links to deleted %T", id, n)
+			}
+		}
+	}
+
+	// Deleting a package name removes its versions.
+	deleted, err = b.Delete(ctx, pkg.PackageNameID)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if !deleted {
+		t.Fatalf("expected package name to be deleted")
+	}
+	pkgs, err := b.Packages(ctx, &model.PkgSpec{Name: ptrfrom.String(testdata.P2.Name)})
+	if err != nil {
+		t.Fatalf("Packages() error = %v", err)
+	}
+	if len(pkgs) != 0 {
+		t.Errorf("expected no packages, got %v", pkgs)
+	}
+
+	deleted, err = b.Delete(ctx, a1)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if deleted {
+		t.Errorf("expected deleting a missing node to report false")
+	}
+}
+
+// nodeStrings returns the sorted JSON encodings of nodes, to compare
+// heterogeneous lists of nodes regardless of their order.
+func nodeStrings(t *testing.T, nodes []model.Node) []string {
+	t.Helper()
+	out := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		b, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("Could not marshal node: %v", err)
+		}
+		out = append(out, string(b))
+	}
+	slices.Sort(out)
+	return out
+}
@@ -403,3 +403,56 @@ func TestQueryPackagesListForScan(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSoftwareList(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	for _, p := range []*model.PkgInputSpec{testdata.P1, testdata.P2} {
+		if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+			t.Fatalf("Could not ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "tensorflowdigest",
+	}}); err != nil {
+		t.Fatalf("Could not ingest artifact: %v", err)
+	}
+
+	first, err := b.FindSoftwareList(ctx, "tensorflow", nil, ptrfrom.Int(2))
+	if err != nil {
+		t.Fatalf("FindSoftwareList() error = %v", err)
+	}
+	if first.TotalCount != 3 || len(first.Edges) != 2 || !first.PageInfo.HasNextPage {
+		t.Fatalf("unexpected first page: totalCount %d, %d edges, hasNextPage %v",
+			first.TotalCount, len(first.Edges), first.PageInfo.HasNextPage)
+	}
+
+	// Adding software between calls must not shift the remaining pages.
+	if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: testdata.P3}); err != nil {
+		t.Fatalf("Could not ingest package: %v", err)
+	}
+
+	rest, err := b.FindSoftwareList(ctx, "tensorflow", first.PageInfo.EndCursor, nil)
+	if err != nil {
+		t.Fatalf("FindSoftwareList() error = %v", err)
+	}
+	if rest.PageInfo.HasNextPage {
+		t.Errorf("expected last page")
+	}
+	seen := map[string]bool{}
+	for _, e := range append(first.Edges, rest.Edges...) {
+		if seen[e.Cursor] {
+			t.Errorf("cursor %q returned twice", e.Cursor)
+		}
+		seen[e.Cursor] = true
+	}
+	if len(seen) != 4 {
+		t.Errorf("expected 4 distinct results, got %d", len(seen))
+	}
+	for _, e := range rest.Edges {
+		if e.Cursor <= *first.PageInfo.EndCursor {
+			t.Errorf("cursor %q is not after %q", e.Cursor, *first.PageInfo.EndCursor)
+		}
+	}
+}
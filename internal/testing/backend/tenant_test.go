@@ -0,0 +1,105 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	b := setupTest(t)
+	ctx := context.Background()
+	ctxA := tenant.WithTenant(ctx, "team-a")
+	ctxB := tenant.WithTenant(ctx, "team-b")
+
+	// nouns are ingested by both teams and shared
+	for _, c := range []context.Context{ctxA, ctxB} {
+		if _, err := b.IngestPackage(c, model.IDorPkgInput{PackageInput: testdata.P1}); err != nil {
+			t.Fatalf("did not expect error ingesting package: %v", err)
+		}
+		if _, err := b.IngestArtifact(c, &model.IDorArtifactInput{ArtifactInput: testdata.A1}); err != nil {
+			t.Fatalf("did not expect error ingesting artifact: %v", err)
+		}
+		if _, err := b.IngestVulnerability(c, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}); err != nil {
+			t.Fatalf("did not expect error ingesting vulnerability: %v", err)
+		}
+	}
+	pkgs, err := b.Packages(ctxB, &model.PkgSpec{})
+	if err != nil {
+		t.Fatalf("did not expect error querying packages: %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Errorf("packages should be global, got %d", len(pkgs))
+	}
+
+	// team-a's scan results must not be visible to team-b
+	scan := model.ScanMetadataInput{Collector: "c", Origin: "o", TimeScanned: testdata.T1}
+	vulnA, err := b.IngestCertifyVuln(ctxA, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, scan)
+	if err != nil {
+		t.Fatalf("did not expect error ingesting certifyVuln: %v", err)
+	}
+	checkCount := func(name string, c context.Context, want int) {
+		t.Helper()
+		got, err := b.CertifyVuln(c, &model.CertifyVulnSpec{}, nil)
+		if err != nil {
+			t.Fatalf("%s: did not expect error querying certifyVuln: %v", name, err)
+		}
+		if len(got) != want {
+			t.Errorf("%s: expected %d certifyVuln, got %d", name, want, len(got))
+		}
+	}
+	checkCount("team-a", ctxA, 1)
+	checkCount("team-b", ctxB, 0)
+	checkCount("no tenant", ctx, 1)
+
+	// the same document ingested by both teams yields one predicate each
+	sbom := model.HasSBOMInputSpec{URI: "uri", Algorithm: "sha256", Digest: "abc", KnownSince: testdata.T1}
+	subject := model.PackageOrArtifactInput{Artifact: &model.IDorArtifactInput{ArtifactInput: testdata.A1}}
+	for _, c := range []context.Context{ctxA, ctxB} {
+		if _, err := b.IngestHasSbom(c, subject, sbom, model.HasSBOMIncludesInputSpec{}); err != nil {
+			t.Fatalf("did not expect error ingesting hasSBOM: %v", err)
+		}
+	}
+	for name, c := range map[string]context.Context{"team-a": ctxA, "team-b": ctxB} {
+		got, err := b.HasSBOM(c, &model.HasSBOMSpec{}, nil)
+		if err != nil {
+			t.Fatalf("did not expect error querying hasSBOM: %v", err)
+		}
+		if len(got) != 1 {
+			t.Errorf("%s: expected 1 hasSBOM, got %d", name, len(got))
+		}
+	}
+
+	// predicates ingested without a tenant are shared
+	if _, err := b.IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: testdata.P1}, model.IDorVulnerabilityInput{VulnerabilityInput: testdata.C1}, model.ScanMetadataInput{Collector: "osv", Origin: "osv", TimeScanned: testdata.T1}); err != nil {
+		t.Fatalf("did not expect error ingesting global certifyVuln: %v", err)
+	}
+	checkCount("team-a with global", ctxA, 2)
+	checkCount("team-b with global", ctxB, 1)
+
+	// team-b cannot remove team-a's evidence
+	if ok, err := b.Delete(ctxB, vulnA); err == nil && ok {
+		t.Errorf("team-b should not be able to delete team-a's certifyVuln")
+	}
+	checkCount("team-a after delete attempt", ctxA, 2)
+}
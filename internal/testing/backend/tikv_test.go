@@ -23,14 +23,13 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
 	tikvstore "github.com/guacsec/guac/pkg/assembler/kv/tikv"
-	"github.com/tikv/client-go/v2/config"
-	"github.com/tikv/client-go/v2/rawkv"
+	"github.com/tikv/client-go/v2/txnkv"
 )
 
 type tikvBE struct {
 	be      backends.Backend
 	connStr string
-	c       *rawkv.Client
+	c       *txnkv.Client
 }
 
 func newTikv() backend {
@@ -41,12 +40,12 @@ func newTikv() backend {
 
 func (m *tikvBE) Setup() error {
 	ctx := context.Background()
-	c, err := rawkv.NewClient(ctx, []string{m.connStr}, config.Security{})
+	c, err := txnkv.NewClient([]string{m.connStr})
 	if err != nil {
 		return err
 	}
 	m.c = c
-	if err := m.c.DeleteRange(ctx, []byte{0}, []byte{255, 255, 255, 255}); err != nil {
+	if err := m.Clear(); err != nil {
 		return err
 	}
 	store, err := tikvstore.GetStore(ctx, m.connStr)
@@ -63,7 +62,8 @@ func (m *tikvBE) Get() backends.Backend {
 }
 
 func (m *tikvBE) Clear() error {
-	return m.c.DeleteRange(context.Background(), []byte{0}, []byte{255, 255, 255, 255})
+	_, err := m.c.DeleteRange(context.Background(), []byte{0}, []byte{255, 255, 255, 255}, 1)
+	return err
 }
 
 func (m *tikvBE) Cleanup() {
@@ -0,0 +1,235 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestVulnerabilityRange(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	type call struct {
+		Pkg   *model.PkgInputSpec
+		Vuln  *model.VulnerabilityInputSpec
+		Range *model.VulnerabilityRangeInputSpec
+	}
+	semver := &model.VulnerabilityRangeInputSpec{
+		RangeType:  model.VulnerabilityRangeTypeSemver,
+		Introduced: "2.0.0",
+		Fixed:      "2.11.2",
+		Origin:     "test origin",
+		Collector:  "test collector",
+	}
+	ecosystem := &model.VulnerabilityRangeInputSpec{
+		RangeType:    model.VulnerabilityRangeTypeEcosystem,
+		LastAffected: "3.0.3",
+		Origin:       "test origin",
+		Collector:    "test collector",
+	}
+	semverOut := &model.VulnerabilityRange{
+		Package: testdata.P2outName,
+		Vulnerability: &model.Vulnerability{
+			Type:             "cve",
+			VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
+		},
+		RangeType:  model.VulnerabilityRangeTypeSemver,
+		Introduced: "2.0.0",
+		Fixed:      "2.11.2",
+		Origin:     "test origin",
+		Collector:  "test collector",
+	}
+	ecosystemOut := &model.VulnerabilityRange{
+		Package: testdata.P4outName,
+		Vulnerability: &model.Vulnerability{
+			Type:             "ghsa",
+			VulnerabilityIDs: []*model.VulnerabilityID{testdata.G1out},
+		},
+		RangeType:    model.VulnerabilityRangeTypeEcosystem,
+		LastAffected: "3.0.3",
+		Origin:       "test origin",
+		Collector:    "test collector",
+	}
+	tests := []struct {
+		Name         string
+		InPkg        []*model.PkgInputSpec
+		InVuln       []*model.VulnerabilityInputSpec
+		Calls        []call
+		IDInFilter   int
+		Query        *model.VulnerabilityRangeSpec
+		ExpVR        []*model.VulnerabilityRange
+		ExpIngestErr bool
+	}{
+		{
+			Name:   "HappyPath",
+			InPkg:  []*model.PkgInputSpec{testdata.P2},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1},
+			Calls:  []call{{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver}},
+			Query: &model.VulnerabilityRangeSpec{
+				Collector: ptrfrom.String("test collector"),
+			},
+			ExpVR: []*model.VulnerabilityRange{semverOut},
+		},
+		{
+			Name:   "Ingest same twice",
+			InPkg:  []*model.PkgInputSpec{testdata.P2},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1},
+			Calls: []call{
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+			},
+			Query: &model.VulnerabilityRangeSpec{
+				Fixed: ptrfrom.String("2.11.2"),
+			},
+			ExpVR: []*model.VulnerabilityRange{semverOut},
+		},
+		{
+			Name:   "Query on package name",
+			InPkg:  []*model.PkgInputSpec{testdata.P2, testdata.P4},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.G1},
+			Calls: []call{
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+				{Pkg: testdata.P4, Vuln: testdata.G1, Range: ecosystem},
+			},
+			Query: &model.VulnerabilityRangeSpec{
+				Package: &model.PkgSpec{Name: ptrfrom.String("openssl")},
+			},
+			ExpVR: []*model.VulnerabilityRange{ecosystemOut},
+		},
+		{
+			Name:   "Query ignores package version",
+			InPkg:  []*model.PkgInputSpec{testdata.P2, testdata.P4},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.G1},
+			Calls: []call{
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+				{Pkg: testdata.P4, Vuln: testdata.G1, Range: ecosystem},
+			},
+			Query: &model.VulnerabilityRangeSpec{
+				Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow"), Version: ptrfrom.String("1.0.0")},
+			},
+			ExpVR: []*model.VulnerabilityRange{semverOut},
+		},
+		{
+			Name:   "Query on vulnerability",
+			InPkg:  []*model.PkgInputSpec{testdata.P2, testdata.P4},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.G1},
+			Calls: []call{
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+				{Pkg: testdata.P4, Vuln: testdata.G1, Range: ecosystem},
+			},
+			Query: &model.VulnerabilityRangeSpec{
+				Vulnerability: &model.VulnerabilitySpec{Type: ptrfrom.String("cve")},
+			},
+			ExpVR: []*model.VulnerabilityRange{semverOut},
+		},
+		{
+			Name:   "Query on range type",
+			InPkg:  []*model.PkgInputSpec{testdata.P2, testdata.P4},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.G1},
+			Calls: []call{
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+				{Pkg: testdata.P4, Vuln: testdata.G1, Range: ecosystem},
+			},
+			Query: &model.VulnerabilityRangeSpec{
+				RangeType: ptrfrom.Any(model.VulnerabilityRangeTypeEcosystem),
+			},
+			ExpVR: []*model.VulnerabilityRange{ecosystemOut},
+		},
+		{
+			Name:   "Query on ID",
+			InPkg:  []*model.PkgInputSpec{testdata.P2, testdata.P4},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.G1},
+			Calls: []call{
+				{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver},
+				{Pkg: testdata.P4, Vuln: testdata.G1, Range: ecosystem},
+			},
+			IDInFilter: 2,
+			Query:      &model.VulnerabilityRangeSpec{},
+			ExpVR:      []*model.VulnerabilityRange{ecosystemOut},
+		},
+		{
+			Name:   "Query none",
+			InPkg:  []*model.PkgInputSpec{testdata.P2},
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1},
+			Calls:  []call{{Pkg: testdata.P2, Vuln: testdata.C1, Range: semver}},
+			Query: &model.VulnerabilityRangeSpec{
+				Introduced: ptrfrom.String("1.0.0"),
+			},
+			ExpVR: nil,
+		},
+		{
+			Name:         "Ingest without package",
+			InVuln:       []*model.VulnerabilityInputSpec{testdata.C1},
+			Calls:        []call{{Pkg: &model.PkgInputSpec{Type: "pypi", Name: "numpy"}, Vuln: testdata.C1, Range: semver}},
+			ExpIngestErr: true,
+		},
+		{
+			Name:         "Ingest without vulnerability",
+			InPkg:        []*model.PkgInputSpec{testdata.P2},
+			Calls:        []call{{Pkg: testdata.P2, Vuln: testdata.C2, Range: semver}},
+			ExpIngestErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, p := range test.InPkg {
+				if _, err := b.IngestPackage(ctx, model.IDorPkgInput{PackageInput: p}); err != nil {
+					t.Fatalf("Could not ingest package: %v", err)
+				}
+			}
+			for _, v := range test.InVuln {
+				if _, err := b.IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: v}); err != nil {
+					t.Fatalf("Could not ingest vulnerability: %v", err)
+				}
+			}
+			for i, o := range test.Calls {
+				vrID, err := b.IngestVulnerabilityRange(ctx, model.IDorPkgInput{PackageInput: o.Pkg},
+					model.IDorVulnerabilityInput{VulnerabilityInput: o.Vuln}, *o.Range)
+				if (err != nil) != test.ExpIngestErr {
+					t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+				}
+				if err != nil {
+					return
+				}
+				if (i + 1) == test.IDInFilter {
+					test.Query.ID = ptrfrom.String(vrID)
+				}
+			}
+			got, err := b.VulnerabilityRangeList(ctx, *test.Query, nil, nil)
+			if err != nil {
+				t.Fatalf("did not expect query error, got: %v", err)
+			}
+			var returnedObjects []*model.VulnerabilityRange
+			if got != nil {
+				for _, obj := range got.Edges {
+					returnedObjects = append(returnedObjects, obj.Node)
+				}
+			}
+			if diff := cmp.Diff(test.ExpVR, returnedObjects, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
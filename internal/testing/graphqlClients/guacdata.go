@@ -142,7 +142,7 @@ type nounIds struct {
 	VulnerabilityIds map[string]string // map from vulnerability type and ID to IDs of Vulnerability nodes
 }
 
-func Ingest(ctx context.Context, t *testing.T, gqlClient graphql.Client, data GuacData) nounIds {
+func Ingest(ctx context.Context, t testing.TB, gqlClient graphql.Client, data GuacData) nounIds {
 	packageIds := map[string]string{}
 	for _, pkg := range data.Packages {
 		packageIds[pkg] = ingestPackage(ctx, t, gqlClient, pkg)
@@ -203,7 +203,7 @@ func Ingest(ctx context.Context, t *testing.T, gqlClient graphql.Client, data Gu
 	return i
 }
 
-func (i nounIds) ingestHasSlsa(ctx context.Context, t *testing.T, gqlClient graphql.Client, hasSlsa HasSlsa) {
+func (i nounIds) ingestHasSlsa(ctx context.Context, t testing.TB, gqlClient graphql.Client, hasSlsa HasSlsa) {
 	slsaSpec := hasSlsa.Spec
 	if slsaSpec == nil {
 		slsaSpec = &gql.SLSAInputSpec{
@@ -245,7 +245,7 @@ func (i nounIds) ingestHasSlsa(ctx context.Context, t *testing.T, gqlClient grap
 	}
 }
 
-func (i nounIds) ingestHashEqual(ctx context.Context, t *testing.T, gqlClient graphql.Client, hashEqual HashEqual) {
+func (i nounIds) ingestHashEqual(ctx context.Context, t testing.TB, gqlClient graphql.Client, hashEqual HashEqual) {
 	spec := hashEqual.Spec
 	if spec == nil {
 		spec = &gql.HashEqualInputSpec{
@@ -273,7 +273,7 @@ func (i nounIds) ingestHashEqual(ctx context.Context, t *testing.T, gqlClient gr
 }
 
 // Returns the id of the IsDependency node
-func (i nounIds) ingestIsDependency(ctx context.Context, t *testing.T, gqlClient graphql.Client, isDependency IsDependency) string {
+func (i nounIds) ingestIsDependency(ctx context.Context, t testing.TB, gqlClient graphql.Client, isDependency IsDependency) string {
 	spec := isDependency.Spec
 	if spec == nil {
 		spec = &gql.IsDependencyInputSpec{
@@ -305,7 +305,7 @@ func (i nounIds) ingestIsDependency(ctx context.Context, t *testing.T, gqlClient
 }
 
 // Returns the ID of the IsOccurrence node.
-func (i nounIds) ingestIsOccurrence(ctx context.Context, t *testing.T, gqlClient graphql.Client, isOccurrence IsOccurrence) string {
+func (i nounIds) ingestIsOccurrence(ctx context.Context, t testing.TB, gqlClient graphql.Client, isOccurrence IsOccurrence) string {
 	spec := isOccurrence.Spec
 	if spec == nil {
 		spec = &gql.IsOccurrenceInputSpec{
@@ -343,7 +343,7 @@ func (i nounIds) ingestIsOccurrence(ctx context.Context, t *testing.T, gqlClient
 	return ""
 }
 
-func (i nounIds) ingestHasSbom(ctx context.Context, t *testing.T, gqlClient graphql.Client, hasSbom HasSbom) {
+func (i nounIds) ingestHasSbom(ctx context.Context, t testing.TB, gqlClient graphql.Client, hasSbom HasSbom) {
 	isDependencyIds := []string{}
 	for _, dependency := range hasSbom.IncludedIsDependencies {
 		id := i.ingestIsDependency(ctx, t, gqlClient, dependency)
@@ -406,7 +406,7 @@ func (i nounIds) ingestHasSbom(ctx context.Context, t *testing.T, gqlClient grap
 }
 
 // Returns the ID of the version node in the package trie
-func ingestPackage(ctx context.Context, t *testing.T, gqlClient graphql.Client, purl string) string {
+func ingestPackage(ctx context.Context, t testing.TB, gqlClient graphql.Client, purl string) string {
 	spec, err := helpers.PurlToPkg(purl)
 	if err != nil {
 		t.Fatalf("Could not create a package input spec from a purl: %s", err)
@@ -419,7 +419,7 @@ func ingestPackage(ctx context.Context, t *testing.T, gqlClient graphql.Client,
 	return res.IngestPackage.PackageVersionID
 }
 
-func ingestArtifact(ctx context.Context, t *testing.T, gqlClient graphql.Client, digest string) string {
+func ingestArtifact(ctx context.Context, t testing.TB, gqlClient graphql.Client, digest string) string {
 	spec := gql.ArtifactInputSpec{
 		Algorithm: defaultHashAlgorithm,
 		Digest:    digest,
@@ -433,7 +433,7 @@ func ingestArtifact(ctx context.Context, t *testing.T, gqlClient graphql.Client,
 }
 
 // Returns the ID of the SourceName node in the trie.
-func ingestSource(ctx context.Context, t *testing.T, gqlClient graphql.Client, name string) string {
+func ingestSource(ctx context.Context, t testing.TB, gqlClient graphql.Client, name string) string {
 	spec := gql.SourceInputSpec{
 		Type:      defaultSourceType,
 		Namespace: defaultSourceNamespace,
@@ -446,7 +446,7 @@ func ingestSource(ctx context.Context, t *testing.T, gqlClient graphql.Client, n
 	return res.GetIngestSource().SourceNameID
 }
 
-func ingestBuilder(ctx context.Context, t *testing.T, gqlClient graphql.Client, uri string) string {
+func ingestBuilder(ctx context.Context, t testing.TB, gqlClient graphql.Client, uri string) string {
 	spec := gql.BuilderInputSpec{
 		Uri: defaultSourceType,
 	}
@@ -458,7 +458,7 @@ func ingestBuilder(ctx context.Context, t *testing.T, gqlClient graphql.Client,
 	return res.GetIngestBuilder()
 }
 
-func ingestVulnerability(ctx context.Context, t *testing.T, gqlClient graphql.Client, vuln string) string {
+func ingestVulnerability(ctx context.Context, t testing.TB, gqlClient graphql.Client, vuln string) string {
 	parts := strings.SplitN(vuln, "/", 2)
 	if len(parts) != 2 {
 		t.Fatalf("Invalid vulnerability format: %s", vuln)
@@ -477,7 +477,7 @@ func ingestVulnerability(ctx context.Context, t *testing.T, gqlClient graphql.Cl
 	return res.IngestVulnerability.VulnerabilityNodeID
 }
 
-func (i nounIds) ingestCertifyVuln(ctx context.Context, t *testing.T, gqlClient graphql.Client, certifyVuln CertifyVuln) {
+func (i nounIds) ingestCertifyVuln(ctx context.Context, t testing.TB, gqlClient graphql.Client, certifyVuln CertifyVuln) {
 	spec := certifyVuln.Metadata
 	if spec == nil {
 		spec = &gql.ScanMetadataInput{}
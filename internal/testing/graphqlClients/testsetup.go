@@ -35,8 +35,19 @@ import (
 // SetupTest starts the graphql server and returns a client for it. The parameter
 // t is used to register a function to close the server and to fail the test upon
 // any errors.
-func SetupTest(t *testing.T) graphql.Client {
-	gqlHandler := getGraphqlHandler(t)
+func SetupTest(t testing.TB) graphql.Client {
+	ctx := context.Background()
+	backend, err := backends.Get("keyvalue", ctx, struct{}{})
+	if err != nil {
+		t.Fatalf("Error getting the keyvalue backend")
+	}
+	return SetupTestWithBackend(t, backend)
+}
+
+// SetupTestWithBackend is SetupTest with the graphql server resolving from
+// backend rather than from the inmem one.
+func SetupTestWithBackend(t testing.TB, backend backends.Backend) graphql.Client {
+	gqlHandler := getGraphqlHandler(backend)
 	port := startGraphqlServer(t, gqlHandler)
 	serverAddr := fmt.Sprintf("http://localhost:%s", port)
 	client := graphql.NewClient(serverAddr, nil)
@@ -45,7 +56,7 @@ func SetupTest(t *testing.T) graphql.Client {
 
 // startGraphqlServer starts up up the graphql server, registers a function to close it when the test completes,
 // and returns the port it is listening on.
-func startGraphqlServer(t *testing.T, gqlHandler *handler.Server) string {
+func startGraphqlServer(t testing.TB, gqlHandler *handler.Server) string {
 	srv := http.Server{Handler: gqlHandler}
 
 	// Create the listener explicitely in order to find the port it listens on
@@ -86,13 +97,8 @@ func startGraphqlServer(t *testing.T, gqlHandler *handler.Server) string {
 	return port
 }
 
-// Gets the handler for the graphql server with the inmem backend resolver.
-func getGraphqlHandler(t *testing.T) *handler.Server {
-	ctx := context.Background()
-	backend, err := backends.Get("keyvalue", ctx, struct{}{})
-	if err != nil {
-		t.Fatalf("Error getting the keyvalue backend")
-	}
+// Gets the handler for the graphql server with the resolver of backend.
+func getGraphqlHandler(backend backends.Backend) *handler.Server {
 	resolver := resolvers.Resolver{Backend: backend}
 
 	config := assembler.Config{Resolvers: &resolver}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/guacsec/guac/pkg/assembler/graphql/model"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 	return m.recorder
 }
 
+// AllPaths mocks base method.
+func (m *MockBackend) AllPaths(ctx context.Context, subject, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllPaths", ctx, subject, target, maxPathLength, usingOnly, weights, first)
+	ret0, _ := ret[0].([]*model.Path)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllPaths indicates an expected call of AllPaths.
+func (mr *MockBackendMockRecorder) AllPaths(ctx, subject, target, maxPathLength, usingOnly, weights, first any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllPaths", reflect.TypeOf((*MockBackend)(nil).AllPaths), ctx, subject, target, maxPathLength, usingOnly, weights, first)
+}
+
 // Artifacts mocks base method.
 func (m *MockBackend) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -222,93 +238,138 @@ func (mr *MockBackendMockRecorder) CertifyGoodList(ctx, certifyGoodSpec, after,
 }
 
 // CertifyLegal mocks base method.
-func (m *MockBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+func (m *MockBackend) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CertifyLegal", ctx, certifyLegalSpec)
+	ret := m.ctrl.Call(m, "CertifyLegal", ctx, certifyLegalSpec, asOf)
 	ret0, _ := ret[0].([]*model.CertifyLegal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CertifyLegal indicates an expected call of CertifyLegal.
-func (mr *MockBackendMockRecorder) CertifyLegal(ctx, certifyLegalSpec any) *gomock.Call {
+func (mr *MockBackendMockRecorder) CertifyLegal(ctx, certifyLegalSpec, asOf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegal", reflect.TypeOf((*MockBackend)(nil).CertifyLegal), ctx, certifyLegalSpec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegal", reflect.TypeOf((*MockBackend)(nil).CertifyLegal), ctx, certifyLegalSpec, asOf)
 }
 
 // CertifyLegalList mocks base method.
-func (m *MockBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+func (m *MockBackend) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CertifyLegalList", ctx, certifyLegalSpec, after, first)
+	ret := m.ctrl.Call(m, "CertifyLegalList", ctx, certifyLegalSpec, asOf, after, first)
 	ret0, _ := ret[0].(*model.CertifyLegalConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CertifyLegalList indicates an expected call of CertifyLegalList.
-func (mr *MockBackendMockRecorder) CertifyLegalList(ctx, certifyLegalSpec, after, first any) *gomock.Call {
+func (mr *MockBackendMockRecorder) CertifyLegalList(ctx, certifyLegalSpec, asOf, after, first any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegalList", reflect.TypeOf((*MockBackend)(nil).CertifyLegalList), ctx, certifyLegalSpec, after, first)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyLegalList", reflect.TypeOf((*MockBackend)(nil).CertifyLegalList), ctx, certifyLegalSpec, asOf, after, first)
+}
+
+// CertifyPolicy mocks base method.
+func (m *MockBackend) CertifyPolicy(ctx context.Context, certifyPolicySpec *model.CertifyPolicySpec) ([]*model.CertifyPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyPolicy", ctx, certifyPolicySpec)
+	ret0, _ := ret[0].([]*model.CertifyPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyPolicy indicates an expected call of CertifyPolicy.
+func (mr *MockBackendMockRecorder) CertifyPolicy(ctx, certifyPolicySpec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyPolicy", reflect.TypeOf((*MockBackend)(nil).CertifyPolicy), ctx, certifyPolicySpec)
+}
+
+// CertifyPolicyList mocks base method.
+func (m *MockBackend) CertifyPolicyList(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertifyPolicyList", ctx, certifyPolicySpec, after, first)
+	ret0, _ := ret[0].(*model.CertifyPolicyConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertifyPolicyList indicates an expected call of CertifyPolicyList.
+func (mr *MockBackendMockRecorder) CertifyPolicyList(ctx, certifyPolicySpec, after, first any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyPolicyList", reflect.TypeOf((*MockBackend)(nil).CertifyPolicyList), ctx, certifyPolicySpec, after, first)
 }
 
 // CertifyVEXStatement mocks base method.
-func (m *MockBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+func (m *MockBackend) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CertifyVEXStatement", ctx, certifyVEXStatementSpec)
+	ret := m.ctrl.Call(m, "CertifyVEXStatement", ctx, certifyVEXStatementSpec, asOf)
 	ret0, _ := ret[0].([]*model.CertifyVEXStatement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CertifyVEXStatement indicates an expected call of CertifyVEXStatement.
-func (mr *MockBackendMockRecorder) CertifyVEXStatement(ctx, certifyVEXStatementSpec any) *gomock.Call {
+func (mr *MockBackendMockRecorder) CertifyVEXStatement(ctx, certifyVEXStatementSpec, asOf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatement", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatement), ctx, certifyVEXStatementSpec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatement", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatement), ctx, certifyVEXStatementSpec, asOf)
 }
 
 // CertifyVEXStatementList mocks base method.
-func (m *MockBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
+func (m *MockBackend) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CertifyVEXStatementList", ctx, certifyVEXStatementSpec, after, first)
+	ret := m.ctrl.Call(m, "CertifyVEXStatementList", ctx, certifyVEXStatementSpec, asOf, after, first)
 	ret0, _ := ret[0].(*model.VEXConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CertifyVEXStatementList indicates an expected call of CertifyVEXStatementList.
-func (mr *MockBackendMockRecorder) CertifyVEXStatementList(ctx, certifyVEXStatementSpec, after, first any) *gomock.Call {
+func (mr *MockBackendMockRecorder) CertifyVEXStatementList(ctx, certifyVEXStatementSpec, asOf, after, first any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatementList", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatementList), ctx, certifyVEXStatementSpec, after, first)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVEXStatementList", reflect.TypeOf((*MockBackend)(nil).CertifyVEXStatementList), ctx, certifyVEXStatementSpec, asOf, after, first)
 }
 
 // CertifyVuln mocks base method.
-func (m *MockBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+func (m *MockBackend) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CertifyVuln", ctx, certifyVulnSpec)
+	ret := m.ctrl.Call(m, "CertifyVuln", ctx, certifyVulnSpec, asOf)
 	ret0, _ := ret[0].([]*model.CertifyVuln)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CertifyVuln indicates an expected call of CertifyVuln.
-func (mr *MockBackendMockRecorder) CertifyVuln(ctx, certifyVulnSpec any) *gomock.Call {
+func (mr *MockBackendMockRecorder) CertifyVuln(ctx, certifyVulnSpec, asOf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVuln", reflect.TypeOf((*MockBackend)(nil).CertifyVuln), ctx, certifyVulnSpec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVuln", reflect.TypeOf((*MockBackend)(nil).CertifyVuln), ctx, certifyVulnSpec, asOf)
 }
 
 // CertifyVulnList mocks base method.
-func (m *MockBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+func (m *MockBackend) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CertifyVulnList", ctx, certifyVulnSpec, after, first)
+	ret := m.ctrl.Call(m, "CertifyVulnList", ctx, certifyVulnSpec, asOf, after, first)
 	ret0, _ := ret[0].(*model.CertifyVulnConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CertifyVulnList indicates an expected call of CertifyVulnList.
-func (mr *MockBackendMockRecorder) CertifyVulnList(ctx, certifyVulnSpec, after, first any) *gomock.Call {
+func (mr *MockBackendMockRecorder) CertifyVulnList(ctx, certifyVulnSpec, asOf, after, first any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnList", reflect.TypeOf((*MockBackend)(nil).CertifyVulnList), ctx, certifyVulnSpec, asOf, after, first)
+}
+
+// ConstrainedPath mocks base method.
+func (m *MockBackend) ConstrainedPath(ctx context.Context, subject, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConstrainedPath", ctx, subject, target, segments, maxPathLength, first)
+	ret0, _ := ret[0].([]*model.Path)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConstrainedPath indicates an expected call of ConstrainedPath.
+func (mr *MockBackendMockRecorder) ConstrainedPath(ctx, subject, target, segments, maxPathLength, first any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertifyVulnList", reflect.TypeOf((*MockBackend)(nil).CertifyVulnList), ctx, certifyVulnSpec, after, first)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConstrainedPath", reflect.TypeOf((*MockBackend)(nil).ConstrainedPath), ctx, subject, target, segments, maxPathLength, first)
 }
 
 // Delete mocks base method.
@@ -326,6 +387,36 @@ func (mr *MockBackendMockRecorder) Delete(ctx, node any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBackend)(nil).Delete), ctx, node)
 }
 
+// DependencyClosure mocks base method.
+func (m *MockBackend) DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DependencyClosure", ctx, subject, maxDepth)
+	ret0, _ := ret[0].([]*model.ClosureEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DependencyClosure indicates an expected call of DependencyClosure.
+func (mr *MockBackendMockRecorder) DependencyClosure(ctx, subject, maxDepth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DependencyClosure", reflect.TypeOf((*MockBackend)(nil).DependencyClosure), ctx, subject, maxDepth)
+}
+
+// DependentClosure mocks base method.
+func (m *MockBackend) DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DependentClosure", ctx, subject, maxDepth)
+	ret0, _ := ret[0].([]*model.ClosureEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DependentClosure indicates an expected call of DependentClosure.
+func (mr *MockBackendMockRecorder) DependentClosure(ctx, subject, maxDepth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DependentClosure", reflect.TypeOf((*MockBackend)(nil).DependentClosure), ctx, subject, maxDepth)
+}
+
 // FindPackagesThatNeedScanning mocks base method.
 func (m *MockBackend) FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -402,33 +493,33 @@ func (mr *MockBackendMockRecorder) HasMetadataList(ctx, hasMetadataSpec, after,
 }
 
 // HasSBOM mocks base method.
-func (m *MockBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func (m *MockBackend) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasSBOM", ctx, hasSBOMSpec)
+	ret := m.ctrl.Call(m, "HasSBOM", ctx, hasSBOMSpec, asOf)
 	ret0, _ := ret[0].([]*model.HasSbom)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HasSBOM indicates an expected call of HasSBOM.
-func (mr *MockBackendMockRecorder) HasSBOM(ctx, hasSBOMSpec any) *gomock.Call {
+func (mr *MockBackendMockRecorder) HasSBOM(ctx, hasSBOMSpec, asOf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOM", reflect.TypeOf((*MockBackend)(nil).HasSBOM), ctx, hasSBOMSpec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOM", reflect.TypeOf((*MockBackend)(nil).HasSBOM), ctx, hasSBOMSpec, asOf)
 }
 
 // HasSBOMList mocks base method.
-func (m *MockBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+func (m *MockBackend) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasSBOMList", ctx, hasSBOMSpec, after, first)
+	ret := m.ctrl.Call(m, "HasSBOMList", ctx, hasSBOMSpec, asOf, after, first)
 	ret0, _ := ret[0].(*model.HasSBOMConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HasSBOMList indicates an expected call of HasSBOMList.
-func (mr *MockBackendMockRecorder) HasSBOMList(ctx, hasSBOMSpec, after, first any) *gomock.Call {
+func (mr *MockBackendMockRecorder) HasSBOMList(ctx, hasSBOMSpec, asOf, after, first any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOMList", reflect.TypeOf((*MockBackend)(nil).HasSBOMList), ctx, hasSBOMSpec, after, first)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSBOMList", reflect.TypeOf((*MockBackend)(nil).HasSBOMList), ctx, hasSBOMSpec, asOf, after, first)
 }
 
 // HasSLSAList mocks base method.
@@ -701,6 +792,36 @@ func (mr *MockBackendMockRecorder) IngestCertifyLegals(ctx, subjects, declaredLi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestCertifyLegals", reflect.TypeOf((*MockBackend)(nil).IngestCertifyLegals), ctx, subjects, declaredLicensesList, discoveredLicensesList, certifyLegals)
 }
 
+// IngestCertifyPolicies mocks base method.
+func (m *MockBackend) IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestCertifyPolicies", ctx, subjects, certifyPolicies)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestCertifyPolicies indicates an expected call of IngestCertifyPolicies.
+func (mr *MockBackendMockRecorder) IngestCertifyPolicies(ctx, subjects, certifyPolicies any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestCertifyPolicies", reflect.TypeOf((*MockBackend)(nil).IngestCertifyPolicies), ctx, subjects, certifyPolicies)
+}
+
+// IngestCertifyPolicy mocks base method.
+func (m *MockBackend) IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestCertifyPolicy", ctx, subject, certifyPolicy)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestCertifyPolicy indicates an expected call of IngestCertifyPolicy.
+func (mr *MockBackendMockRecorder) IngestCertifyPolicy(ctx, subject, certifyPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestCertifyPolicy", reflect.TypeOf((*MockBackend)(nil).IngestCertifyPolicy), ctx, subject, certifyPolicy)
+}
+
 // IngestCertifyVuln mocks base method.
 func (m *MockBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
 	m.ctrl.T.Helper()
@@ -1211,6 +1332,36 @@ func (mr *MockBackendMockRecorder) IngestVulnerabilityMetadata(ctx, vulnerabilit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestVulnerabilityMetadata", reflect.TypeOf((*MockBackend)(nil).IngestVulnerabilityMetadata), ctx, vulnerability, vulnerabilityMetadata)
 }
 
+// IngestVulnerabilityRange mocks base method.
+func (m *MockBackend) IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestVulnerabilityRange", ctx, pkg, vulnerability, vulnerabilityRange)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestVulnerabilityRange indicates an expected call of IngestVulnerabilityRange.
+func (mr *MockBackendMockRecorder) IngestVulnerabilityRange(ctx, pkg, vulnerability, vulnerabilityRange any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestVulnerabilityRange", reflect.TypeOf((*MockBackend)(nil).IngestVulnerabilityRange), ctx, pkg, vulnerability, vulnerabilityRange)
+}
+
+// IngestVulnerabilityRanges mocks base method.
+func (m *MockBackend) IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestVulnerabilityRanges", ctx, pkgs, vulnerabilities, vulnerabilityRanges)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestVulnerabilityRanges indicates an expected call of IngestVulnerabilityRanges.
+func (mr *MockBackendMockRecorder) IngestVulnerabilityRanges(ctx, pkgs, vulnerabilities, vulnerabilityRanges any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestVulnerabilityRanges", reflect.TypeOf((*MockBackend)(nil).IngestVulnerabilityRanges), ctx, pkgs, vulnerabilities, vulnerabilityRanges)
+}
+
 // IsDependency mocks base method.
 func (m *MockBackend) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
 	m.ctrl.T.Helper()
@@ -1482,33 +1633,33 @@ func (mr *MockBackendMockRecorder) QueryPackagesListForScan(ctx, pkgIDs, after,
 }
 
 // Scorecards mocks base method.
-func (m *MockBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+func (m *MockBackend) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Scorecards", ctx, certifyScorecardSpec)
+	ret := m.ctrl.Call(m, "Scorecards", ctx, certifyScorecardSpec, asOf)
 	ret0, _ := ret[0].([]*model.CertifyScorecard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Scorecards indicates an expected call of Scorecards.
-func (mr *MockBackendMockRecorder) Scorecards(ctx, certifyScorecardSpec any) *gomock.Call {
+func (mr *MockBackendMockRecorder) Scorecards(ctx, certifyScorecardSpec, asOf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scorecards", reflect.TypeOf((*MockBackend)(nil).Scorecards), ctx, certifyScorecardSpec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scorecards", reflect.TypeOf((*MockBackend)(nil).Scorecards), ctx, certifyScorecardSpec, asOf)
 }
 
 // ScorecardsList mocks base method.
-func (m *MockBackend) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+func (m *MockBackend) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScorecardsList", ctx, scorecardSpec, after, first)
+	ret := m.ctrl.Call(m, "ScorecardsList", ctx, scorecardSpec, asOf, after, first)
 	ret0, _ := ret[0].(*model.CertifyScorecardConnection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ScorecardsList indicates an expected call of ScorecardsList.
-func (mr *MockBackendMockRecorder) ScorecardsList(ctx, scorecardSpec, after, first any) *gomock.Call {
+func (mr *MockBackendMockRecorder) ScorecardsList(ctx, scorecardSpec, asOf, after, first any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorecardsList", reflect.TypeOf((*MockBackend)(nil).ScorecardsList), ctx, scorecardSpec, after, first)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorecardsList", reflect.TypeOf((*MockBackend)(nil).ScorecardsList), ctx, scorecardSpec, asOf, after, first)
 }
 
 // Sources mocks base method.
@@ -1631,6 +1782,36 @@ func (mr *MockBackendMockRecorder) VulnerabilityMetadataList(ctx, vulnerabilityM
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityMetadataList", reflect.TypeOf((*MockBackend)(nil).VulnerabilityMetadataList), ctx, vulnerabilityMetadataSpec, after, first)
 }
 
+// VulnerabilityRange mocks base method.
+func (m *MockBackend) VulnerabilityRange(ctx context.Context, vulnerabilityRangeSpec *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnerabilityRange", ctx, vulnerabilityRangeSpec)
+	ret0, _ := ret[0].([]*model.VulnerabilityRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnerabilityRange indicates an expected call of VulnerabilityRange.
+func (mr *MockBackendMockRecorder) VulnerabilityRange(ctx, vulnerabilityRangeSpec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityRange", reflect.TypeOf((*MockBackend)(nil).VulnerabilityRange), ctx, vulnerabilityRangeSpec)
+}
+
+// VulnerabilityRangeList mocks base method.
+func (m *MockBackend) VulnerabilityRangeList(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VulnerabilityRangeList", ctx, vulnerabilityRangeSpec, after, first)
+	ret0, _ := ret[0].(*model.VulnerabilityRangeConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VulnerabilityRangeList indicates an expected call of VulnerabilityRangeList.
+func (mr *MockBackendMockRecorder) VulnerabilityRangeList(ctx, vulnerabilityRangeSpec, after, first any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VulnerabilityRangeList", reflect.TypeOf((*MockBackend)(nil).VulnerabilityRangeList), ctx, vulnerabilityRangeSpec, after, first)
+}
+
 // MockBackendArgs is a mock of BackendArgs interface.
 type MockBackendArgs struct {
 	ctrl     *gomock.Controller
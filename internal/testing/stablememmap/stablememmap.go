@@ -42,6 +42,14 @@ func (s *store) Set(ctx context.Context, c, k string, v any) error {
 	return s.mm.Set(ctx, c, k, v)
 }
 
+func (s *store) Delete(ctx context.Context, c, k string) error {
+	return s.mm.Delete(ctx, c, k)
+}
+
+func (s *store) Write(ctx context.Context, b *kv.Batch) error {
+	return s.mm.Write(ctx, b)
+}
+
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{mms: s.mm.Keys(c)}
 }
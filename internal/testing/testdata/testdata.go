@@ -150,6 +150,9 @@ var (
 	//go:embed exampledata/certify-novuln.json
 	ITE6NoVulnExample []byte
 
+	//go:embed exampledata/slsa-vsa.json
+	ITE6VSAExample []byte
+
 	//go:embed exampledata/oci-kubectl-linux-amd64-in-toto.json
 	OCIKubectlLinuxAMD64ITE6 []byte
 
@@ -1079,12 +1082,88 @@ var (
 		},
 	}
 
+	spdxCpe0Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine-baselayout/alpine-baselayout@3.2.0-r22")
+
+	spdxCpe1Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine-baselayout/alpine_baselayout@3.2.0-r22")
+
+	spdxCpe2Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine-baselayout-data/alpine-baselayout-data@3.2.0-r22")
+
+	spdxCpe3Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine-baselayout-data/alpine_baselayout_data@3.2.0-r22")
+
+	spdxCpe4Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine-keys/alpine-keys@2.4-r1")
+
+	spdxCpe5Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine-keys/alpine_keys@2.4-r1")
+
+	spdxCpe6Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine/alpine-keys@2.4-r1")
+
+	spdxCpe7Pack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/alpine/alpine_keys@2.4-r1")
+
+	SpdxPkgEqual = []assembler.PkgEqualIngest{
+		{
+			Pkg:      baselayoutPack,
+			EqualPkg: spdxCpe0Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      baselayoutPack,
+			EqualPkg: spdxCpe1Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      baselayoutdataPack,
+			EqualPkg: spdxCpe2Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      baselayoutdataPack,
+			EqualPkg: spdxCpe3Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      keysPack,
+			EqualPkg: spdxCpe4Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      keysPack,
+			EqualPkg: spdxCpe5Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      keysPack,
+			EqualPkg: spdxCpe6Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+		{
+			Pkg:      keysPack,
+			EqualPkg: spdxCpe7Pack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "spdx cpe external reference",
+			},
+		},
+	}
+
 	SpdxIngestionPredicates = assembler.IngestPredicates{
 		IsDependency: SpdxDeps,
 		IsOccurrence: SpdxOccurences,
 		HasSBOM:      SpdxHasSBOM,
 		HasMetadata:  SpdxHasMetadata,
 		CertifyLegal: SpdxCertifyLegal,
+		PkgEqual:     SpdxPkgEqual,
 	}
 
 	// CycloneDX Testdata
@@ -1145,6 +1224,69 @@ var (
 		},
 	}
 
+	cdxBasefilesCpePack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/base-files/base-files@11.1+deb11u5")
+
+	cdxNetbaseCpePack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/netbase/netbase@6.3")
+
+	cdxTzdataCpePack, _ = asmhelpers.PurlToPkg("pkg:guac/cpe/tzdata/tzdata@2021a-1+deb11u6")
+
+	CdxHasMetadata = []assembler.HasMetadataIngest{
+		{
+			Pkg:          cdxBasefilesPack,
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			HasMetadata: &model.HasMetadataInputSpec{
+				Key:           "cpe",
+				Value:         "cpe:2.3:a:base-files:base-files:11.1\\+deb11u5:*:*:*:*:*:*:*",
+				Timestamp:     cdxTime,
+				Justification: "cdx component cpe",
+			},
+		},
+		{
+			Pkg:          cdxNetbasePack,
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			HasMetadata: &model.HasMetadataInputSpec{
+				Key:           "cpe",
+				Value:         "cpe:2.3:a:netbase:netbase:6.3:*:*:*:*:*:*:*",
+				Timestamp:     cdxTime,
+				Justification: "cdx component cpe",
+			},
+		},
+		{
+			Pkg:          cdxTzdataPack,
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			HasMetadata: &model.HasMetadataInputSpec{
+				Key:           "cpe",
+				Value:         "cpe:2.3:a:tzdata:tzdata:2021a-1\\+deb11u6:*:*:*:*:*:*:*",
+				Timestamp:     cdxTime,
+				Justification: "cdx component cpe",
+			},
+		},
+	}
+
+	CdxPkgEqual = []assembler.PkgEqualIngest{
+		{
+			Pkg:      cdxBasefilesPack,
+			EqualPkg: cdxBasefilesCpePack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "cdx component cpe",
+			},
+		},
+		{
+			Pkg:      cdxNetbasePack,
+			EqualPkg: cdxNetbaseCpePack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "cdx component cpe",
+			},
+		},
+		{
+			Pkg:      cdxTzdataPack,
+			EqualPkg: cdxTzdataCpePack,
+			PkgEqual: &model.PkgEqualInputSpec{
+				Justification: "cdx component cpe",
+			},
+		},
+	}
+
 	CdxIngestionPredicates = assembler.IngestPredicates{
 		IsOccurrence: []assembler.IsOccurrenceIngest{
 			{
@@ -1158,6 +1300,8 @@ var (
 		},
 		IsDependency: CdxDeps,
 		HasSBOM:      CdxHasSBOM,
+		HasMetadata:  CdxHasMetadata,
+		PkgEqual:     CdxPkgEqual,
 	}
 
 	CdxHasSBOMInvalidVersion = []assembler.HasSBOMIngest{
@@ -1203,6 +1347,8 @@ var (
 	CdxIngestionInvalidVersionPredicates = assembler.IngestPredicates{
 		IsDependency: CdxInvalidVersionDeps,
 		HasSBOM:      CdxHasSBOMInvalidVersion,
+		HasMetadata:  CdxHasMetadata,
+		PkgEqual:     CdxPkgEqual,
 	}
 
 	cdxTopQuarkusPack, _ = asmhelpers.PurlToPkg("pkg:maven/org.acme/getting-started@1.0.0-SNAPSHOT?type=jar")
@@ -3013,6 +3159,11 @@ var (
 		"UpdateTime":"2022-11-21T17:45:50.52Z"
 	 }`
 
+	// OSV
+
+	//go:embed exampledata/osv-ghsa-text4shell.json
+	OSVText4ShellExample []byte
+
 	// OpenVEX
 
 	//go:embed exampledata/open-vex-not-affected.json
@@ -3207,6 +3358,9 @@ var (
 	//go:embed exampledata/rhsa-csaf.json
 	CsafExampleRedHat []byte
 
+	//go:embed exampledata/csaf-security-advisory.json
+	CsafSecurityAdvisory []byte
+
 	CsafVexIngest = []assembler.VexIngest{
 		{
 			Pkg: &model.PkgInputSpec{
@@ -3821,6 +3975,7 @@ var IngestPredicatesCmpOpts = []cmp.Option{
 	cmpopts.SortSlices(hasMetadataLess),
 	cmpopts.SortSlices(vexLess),
 	cmpopts.SortSlices(certifyVulnLess),
+	cmpopts.SortSlices(pkgEqualLess),
 }
 
 func certifyScorecardLess(e1, e2 assembler.CertifyScorecardIngest) bool {
@@ -3869,6 +4024,10 @@ func vexLess(e1, e2 assembler.VexIngest) bool {
 	return gLess(e1, e2)
 }
 
+func pkgEqualLess(e1, e2 assembler.PkgEqualIngest) bool {
+	return gLess(e1, e2)
+}
+
 func certifyVulnLess(e1, e2 assembler.CertifyVulnIngest) bool {
 	return gLess(e1, e2)
 }
@@ -0,0 +1,159 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive dumps a GUAC graph to, and restores it from, a backend
+// independent archive.
+//
+// An archive is newline delimited JSON. The first line is a Header, every
+// other line is a Record. Records hold either nouns, or predicates in the
+// same IngestPredicates structure the parsers produce, so restoring an
+// archive goes through the regular ingestion path. Archives contain no node
+// IDs, so they can be loaded into any backend.
+//
+// Archives do not record tenants: only graphs whose predicates are all global
+// are exported, and they are only imported as global predicates.
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+const (
+	// Format identifies GUAC graph archives.
+	Format = "guac-graph-archive"
+	// Version is the version of the archive format written by Export. Import
+	// reads archives up to this version.
+	Version = 1
+)
+
+// ErrTenantScoped is returned when a graph cannot be exported or imported
+// without losing the tenants its predicates belong to.
+var ErrTenantScoped = errors.New("archives only hold global predicates")
+
+// Header is the first line of an archive.
+type Header struct {
+	Format      string    `json:"format"`
+	Version     int       `json:"version"`
+	Created     time.Time `json:"created"`
+	GUACVersion string    `json:"guacVersion,omitempty"`
+}
+
+// Record is a line of an archive after the header. Exactly one of the fields
+// is set.
+type Record struct {
+	Nouns      *Nouns                      `json:"nouns,omitempty"`
+	Predicates *assembler.IngestPredicates `json:"predicates,omitempty"`
+}
+
+// Nouns holds nodes that are stored on their own, so that nouns without any
+// predicate are kept too.
+type Nouns struct {
+	Packages        []*generated.PkgInputSpec           `json:"packages,omitempty"`
+	Sources         []*generated.SourceInputSpec        `json:"sources,omitempty"`
+	Artifacts       []*generated.ArtifactInputSpec      `json:"artifacts,omitempty"`
+	Builders        []*generated.BuilderInputSpec       `json:"builders,omitempty"`
+	Vulnerabilities []*generated.VulnerabilityInputSpec `json:"vulnerabilities,omitempty"`
+	Licenses        []*generated.LicenseInputSpec       `json:"licenses,omitempty"`
+}
+
+// Writer writes an archive.
+type Writer struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewWriter writes the header to w and returns a Writer for the records.
+func NewWriter(w io.Writer, guacVersion string) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	aw := &Writer{w: bw, enc: json.NewEncoder(bw)}
+	if err := aw.enc.Encode(Header{
+		Format:      Format,
+		Version:     Version,
+		Created:     time.Now().UTC(),
+		GUACVersion: guacVersion,
+	}); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// Write writes a record.
+func (w *Writer) Write(r *Record) error {
+	return w.enc.Encode(r)
+}
+
+// Flush writes any buffered records to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// maxLine is the largest record that can be read, large SBOMs end up in a
+// single record.
+const maxLine = 256 * 1024 * 1024
+
+// Reader reads an archive.
+type Reader struct {
+	s      *bufio.Scanner
+	Header Header
+}
+
+// NewReader reads and checks the header of the archive in r.
+func NewReader(r io.Reader) (*Reader, error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLine)
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("archive is empty")
+	}
+	ar := &Reader{s: s}
+	if err := json.Unmarshal(s.Bytes(), &ar.Header); err != nil {
+		return nil, fmt.Errorf("failed to read archive header: %w", err)
+	}
+	if ar.Header.Format != Format {
+		return nil, fmt.Errorf("not a GUAC graph archive, format is %q", ar.Header.Format)
+	}
+	if ar.Header.Version < 1 || ar.Header.Version > Version {
+		return nil, fmt.Errorf("unsupported archive version %d, supported up to %d", ar.Header.Version, Version)
+	}
+	return ar, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the archive.
+func (r *Reader) Next() (*Record, error) {
+	for r.s.Scan() {
+		if len(r.s.Bytes()) == 0 {
+			continue
+		}
+		rec := &Record{}
+		if err := json.Unmarshal(r.s.Bytes(), rec); err != nil {
+			return nil, fmt.Errorf("failed to read archive record: %w", err)
+		}
+		return rec, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
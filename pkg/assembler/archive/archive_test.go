@@ -0,0 +1,267 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+	"github.com/guacsec/guac/pkg/assembler/server"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+func newServer(ctx context.Context, t *testing.T) (backends.Backend, graphql.Client) {
+	t.Helper()
+	b, err := backends.Get("keyvalue", ctx, struct{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(server.GetGraphqlServer(ctx, b))
+	t.Cleanup(srv.Close)
+	return b, graphql.NewClient(srv.URL, srv.Client())
+}
+
+func export(ctx context.Context, t *testing.T, b backends.Backend, pageSize int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Export(ctx, b, w, pageSize); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// contents flattens the records of an archive into a sorted list, so that
+// archives written by backends that list nodes in a different order compare
+// equal.
+func contents(t *testing.T, archive []byte) []string {
+	t.Helper()
+	r, err := NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	add := func(prefix string, v any) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, prefix+string(b))
+	}
+	addFields := func(prefix string, s any) {
+		v := reflect.ValueOf(s).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Field(i)
+			for j := 0; j < f.Len(); j++ {
+				add(prefix+v.Type().Field(i).Name+":", f.Index(j).Interface())
+			}
+		}
+	}
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rec.Nouns != nil {
+			addFields("", rec.Nouns)
+		}
+		if p := rec.Predicates; p != nil {
+			prefix := ""
+			if len(p.HasSBOM) > 0 {
+				b, _ := json.Marshal(p.HasSBOM)
+				prefix = string(b) + "/"
+			}
+			addFields(prefix, p)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	// Some predicates of the example have no timestamp, which ingestion
+	// rejects.
+	example := testdata.IngestPredicatesExamplePredicates
+	example.HasSBOM = nil
+	example.CertifyBad = nil
+	example.CertifyGood = nil
+
+	known := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	pkg := &generated.PkgInputSpec{Type: "golang", Namespace: ptrfrom.String("github.com/guacsec"), Name: "guac", Version: ptrfrom.String("v1.0.0")}
+	art := &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "1234"}
+	extra := assembler.IngestPredicates{
+		CertifyBad: []assembler.CertifyBadIngest{{
+			Artifact:   art,
+			CertifyBad: &generated.CertifyBadInputSpec{Justification: "bad", KnownSince: known, Origin: "test", Collector: "test", DocumentRef: "doc1"},
+		}},
+		CertifyGood: []assembler.CertifyGoodIngest{{
+			Pkg:          pkg,
+			PkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions},
+			CertifyGood:  &generated.CertifyGoodInputSpec{Justification: "good", KnownSince: known, Origin: "test", Collector: "test", DocumentRef: "doc2"},
+		}},
+		PointOfContact: []assembler.PointOfContactIngest{{
+			Pkg:            pkg,
+			PkgMatchFlag:   generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+			PointOfContact: &generated.PointOfContactInputSpec{Email: "a@b.c", Since: known, Origin: "test", Collector: "test"},
+		}},
+		CertifyPolicy: []assembler.CertifyPolicyIngest{{
+			Artifact:      art,
+			CertifyPolicy: &generated.CertifyPolicyInputSpec{Verifier: "test", PolicyUri: "policy", Result: generated.PolicyVerificationResultPassed, VerifiedLevels: []string{"SLSA_BUILD_LEVEL_3"}, TimeVerified: known, Origin: "test", Collector: "test"},
+		}},
+		VulnerabilityRange: []assembler.VulnerabilityRangeIngest{{
+			Pkg:                pkg,
+			Vulnerability:      &generated.VulnerabilityInputSpec{Type: "osv", VulnerabilityID: "ghsa-1234"},
+			VulnerabilityRange: &generated.VulnerabilityRangeInputSpec{RangeType: generated.VulnerabilityRangeTypeSemver, Introduced: "1.0.0", Fixed: "1.2.0", Origin: "test", Collector: "test"},
+		}},
+	}
+
+	src, srcClient := newServer(ctx, t)
+	preds := []assembler.IngestPredicates{
+		example,
+		testdata.SpdxIngestionPredicates,
+		testdata.SlsaPreds,
+		testdata.CycloneDXUnAffectedPredicates,
+		extra,
+	}
+	if _, err := helpers.GetBulkAssembler(ctx, logger, srcClient)(preds); err != nil {
+		t.Fatal(err)
+	}
+	// A noun without any predicate is kept too.
+	if _, err := generated.IngestArtifact(ctx, srcClient, generated.IDorArtifactInput{
+		ArtifactInput: &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "lonely"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := export(ctx, t, src, DefaultPageSize)
+	want := contents(t, archive)
+	// Paging through every list one node at a time gives the same nodes.
+	if diff := cmp.Diff(want, contents(t, export(ctx, t, src, 1))); diff != "" {
+		t.Errorf("archive written one node per page differs (-want +got):\n%s", diff)
+	}
+
+	dst, dstClient := newServer(ctx, t)
+	r, err := NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := Import(ctx, logger, r, dstClient)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if stats.Nouns == 0 || stats.Predicates == 0 {
+		t.Errorf("Import stats = %+v", stats)
+	}
+
+	if !slices.ContainsFunc(want, func(s string) bool { return strings.Contains(s, "lonely") }) {
+		t.Error("exported archive is missing the artifact without predicates")
+	}
+	if !slices.ContainsFunc(want, func(s string) bool { return strings.HasPrefix(s, "VulnerabilityRange:") }) {
+		t.Error("exported archive is missing the vulnerability range")
+	}
+	if diff := cmp.Diff(want, contents(t, export(ctx, t, dst, DefaultPageSize))); diff != "" {
+		t.Errorf("archive of the imported graph differs (-want +got):\n%s", diff)
+	}
+}
+
+func TestReaderRejectsUnknownArchives(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: "empty"},
+		{name: "not json", input: "hello\n", want: "header"},
+		{name: "other format", input: `{"format":"sbom","version":1}` + "\n", want: "not a GUAC graph archive"},
+		{name: "newer version", input: `{"format":"guac-graph-archive","version":99}` + "\n", want: "unsupported archive version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewReader(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("NewReader() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// tenantBackend is a backend with predicates of tenants, which archives
+// cannot record.
+type tenantBackend struct {
+	backends.Backend
+}
+
+func (tenantBackend) PredicateTenants(ctx context.Context) ([]string, error) {
+	return []string{"acme"}, nil
+}
+
+func TestTenantScopedGraphs(t *testing.T) {
+	ctx := context.Background()
+	b, err := backends.Get("keyvalue", ctx, struct{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewWriter(io.Discard, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Export(ctx, tenantBackend{b}, w, 0); !errors.Is(err, ErrTenantScoped) {
+		t.Errorf("Export() error = %v, want %v", err, ErrTenantScoped)
+	}
+
+	resolve := func(*http.Request) (string, error) { return "acme", nil }
+	srv := httptest.NewServer(tenant.Middleware(resolve, server.GetGraphqlServer(ctx, b)))
+	t.Cleanup(srv.Close)
+	r, err := NewReader(bytes.NewReader(export(ctx, t, b, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Import(ctx, zap.NewNop().Sugar(), r, graphql.NewClient(srv.URL, srv.Client()))
+	if !errors.Is(err, ErrTenantScoped) {
+		t.Errorf("Import() error = %v, want %v", err, ErrTenantScoped)
+	}
+}
@@ -0,0 +1,1220 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// DefaultPageSize is the number of nodes requested from the backend at a time.
+const DefaultPageSize = 1000
+
+// ExportStats counts what was written to an archive.
+type ExportStats struct {
+	Nouns      int
+	Predicates int
+}
+
+type exporter struct {
+	ctx      context.Context
+	b        backends.Backend
+	w        *Writer
+	pageSize int
+	stats    ExportStats
+	// versions caches the version used for predicates on all versions of
+	// a package name.
+	versions map[string]*generated.PkgInputSpec
+}
+
+// tenantLister is implemented by backends that partition predicates by
+// tenant.
+type tenantLister interface {
+	PredicateTenants(ctx context.Context) ([]string, error)
+}
+
+// Export writes every noun and predicate of the backend to w, reading them
+// with the paginated List APIs of the backend. Lists that backends return in
+// no particular order, such as package qualifiers, are sorted so that the
+// same graph always gives the same records.
+//
+// HasSBOM predicates are written as one record each, together with the
+// dependencies and occurrences the SBOM includes, since ingestion links an
+// SBOM to everything ingested along with it. Included packages and artifacts
+// are restored through those dependencies and occurrences.
+//
+// Export fails with ErrTenantScoped if any predicate belongs to a tenant.
+func Export(ctx context.Context, b backends.Backend, w *Writer, pageSize int) (*ExportStats, error) {
+	if tl, ok := b.(tenantLister); ok {
+		tenants, err := tl.PredicateTenants(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(tenants) > 0 {
+			return nil, fmt.Errorf("%w, the backend has predicates of tenants %s", ErrTenantScoped, strings.Join(tenants, ", "))
+		}
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	e := &exporter{ctx: ctx, b: b, w: w, pageSize: pageSize, versions: map[string]*generated.PkgInputSpec{}}
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{"packages", e.packages},
+		{"sources", e.sources},
+		{"artifacts", e.artifacts},
+		{"builders", e.builders},
+		{"vulnerabilities", e.vulnerabilities},
+		{"licenses", e.licenses},
+		{"IsDependency", e.isDependencies},
+		{"IsOccurrence", e.isOccurrences},
+		{"HasSLSA", e.hasSLSAs},
+		{"CertifyVuln", e.certifyVulns},
+		{"VulnEqual", e.vulnEquals},
+		{"HasSourceAt", e.hasSourceAts},
+		{"CertifyBad", e.certifyBads},
+		{"CertifyGood", e.certifyGoods},
+		{"HashEqual", e.hashEquals},
+		{"PkgEqual", e.pkgEquals},
+		{"CertifyVEXStatement", e.vexStatements},
+		{"PointOfContact", e.pointOfContacts},
+		{"VulnerabilityMetadata", e.vulnMetadata},
+		{"HasMetadata", e.hasMetadata},
+		{"CertifyLegal", e.certifyLegals},
+		{"CertifyScorecard", e.scorecards},
+		{"CertifyPolicy", e.certifyPolicies},
+		{"VulnerabilityRange", e.vulnerabilityRanges},
+		{"HasSBOM", e.hasSBOMs},
+	}
+	for _, s := range steps {
+		if err := s.fn(); err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", s.name, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	return &e.stats, nil
+}
+
+// listAll calls list for every page and passes the nodes of each page to emit.
+func listAll[C any, N any](pageSize int,
+	list func(after *string, first *int) (*C, error),
+	page func(*C) (*model.PageInfo, []N),
+	emit func([]N) error,
+) error {
+	var after *string
+	for {
+		conn, err := list(after, &pageSize)
+		if err != nil {
+			return err
+		}
+		if conn == nil {
+			return nil
+		}
+		info, nodes := page(conn)
+		if len(nodes) > 0 {
+			if err := emit(nodes); err != nil {
+				return err
+			}
+		}
+		if info == nil || !info.HasNextPage || info.EndCursor == nil {
+			return nil
+		}
+		after = info.EndCursor
+	}
+}
+
+func (e *exporter) writeNouns(n *Nouns, count int) error {
+	e.stats.Nouns += count
+	return e.w.Write(&Record{Nouns: n})
+}
+
+func (e *exporter) writePredicates(p *assembler.IngestPredicates, count int) error {
+	e.stats.Predicates += count
+	return e.w.Write(&Record{Predicates: p})
+}
+
+// convert copies a node into the input type that has the same GraphQL field
+// names. Fields that only exist on the node, such as its ID and the nodes it
+// links to, are dropped.
+func convert[T any](from any) (*T, error) {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return nil, err
+	}
+	var to T
+	if err := json.Unmarshal(b, &to); err != nil {
+		return nil, err
+	}
+	return &to, nil
+}
+
+// pkgInputs returns an input for every leaf of a package trie.
+func pkgInputs(p *model.Package) []*generated.PkgInputSpec {
+	var out []*generated.PkgInputSpec
+	for _, ns := range p.Namespaces {
+		for _, n := range ns.Names {
+			if len(n.Versions) == 0 {
+				out = append(out, &generated.PkgInputSpec{
+					Type:      p.Type,
+					Namespace: ptr(ns.Namespace),
+					Name:      n.Name,
+				})
+				continue
+			}
+			for _, v := range n.Versions {
+				quals := make([]generated.PackageQualifierInputSpec, 0, len(v.Qualifiers))
+				for _, q := range v.Qualifiers {
+					quals = append(quals, generated.PackageQualifierInputSpec{Key: q.Key, Value: q.Value})
+				}
+				slices.SortFunc(quals, func(a, b generated.PackageQualifierInputSpec) int {
+					return cmp.Compare(a.Key, b.Key)
+				})
+				out = append(out, &generated.PkgInputSpec{
+					Type:       p.Type,
+					Namespace:  ptr(ns.Namespace),
+					Name:       n.Name,
+					Version:    ptr(v.Version),
+					Qualifiers: quals,
+					Subpath:    ptr(v.Subpath),
+				})
+			}
+		}
+	}
+	return out
+}
+
+// pkgSubject returns the input for a package that is the subject of a
+// predicate, and whether the predicate is on the package version or on all
+// versions of the package name. Ingestion always adds a package version, so
+// predicates on all versions get one of the versions of the name.
+func (e *exporter) pkgSubject(p *model.Package) (*generated.PkgInputSpec, generated.MatchFlags, error) {
+	pkg, err := pkgVersion(p)
+	if err != nil {
+		return nil, generated.MatchFlags{}, err
+	}
+	if pkg.Version != nil {
+		return pkg, generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion}, nil
+	}
+	pkg, err = e.anyVersion(pkg)
+	if err != nil {
+		return nil, generated.MatchFlags{}, err
+	}
+	return pkg, generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions}, nil
+}
+
+// anyVersion returns the first version of the package name, or the name
+// itself if it has no version.
+func (e *exporter) anyVersion(name *generated.PkgInputSpec) (*generated.PkgInputSpec, error) {
+	key := pkgKey(name)
+	if v, ok := e.versions[key]; ok {
+		return v, nil
+	}
+	pkgs, err := e.b.Packages(e.ctx, &model.PkgSpec{Type: &name.Type, Namespace: name.Namespace, Name: &name.Name})
+	if err != nil {
+		return nil, err
+	}
+	v := name
+	for _, p := range pkgs {
+		for _, in := range pkgInputs(p) {
+			if in.Version != nil && (v.Version == nil || pkgKey(in) < pkgKey(v)) {
+				v = in
+			}
+		}
+	}
+	e.versions[key] = v
+	return v, nil
+}
+
+// pkgKey orders the packages of symmetric predicates.
+func pkgKey(p *generated.PkgInputSpec) string {
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// pkgVersion returns the input for a package that is a single version, or a
+// single name.
+func pkgVersion(p *model.Package) (*generated.PkgInputSpec, error) {
+	pkgs := pkgInputs(p)
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package, got %d", len(pkgs))
+	}
+	return pkgs[0], nil
+}
+
+func srcInputs(s *model.Source) []*generated.SourceInputSpec {
+	var out []*generated.SourceInputSpec
+	for _, ns := range s.Namespaces {
+		for _, n := range ns.Names {
+			out = append(out, &generated.SourceInputSpec{
+				Type:      s.Type,
+				Namespace: ns.Namespace,
+				Name:      n.Name,
+				Tag:       n.Tag,
+				Commit:    n.Commit,
+			})
+		}
+	}
+	return out
+}
+
+func srcSubject(s *model.Source) (*generated.SourceInputSpec, error) {
+	srcs := srcInputs(s)
+	if len(srcs) != 1 {
+		return nil, fmt.Errorf("expected a single source, got %d", len(srcs))
+	}
+	return srcs[0], nil
+}
+
+func artInput(a *model.Artifact) *generated.ArtifactInputSpec {
+	return &generated.ArtifactInputSpec{Algorithm: a.Algorithm, Digest: a.Digest}
+}
+
+func vulnInputs(v *model.Vulnerability) []*generated.VulnerabilityInputSpec {
+	var out []*generated.VulnerabilityInputSpec
+	for _, id := range v.VulnerabilityIDs {
+		out = append(out, &generated.VulnerabilityInputSpec{Type: v.Type, VulnerabilityID: id.VulnerabilityID})
+	}
+	return out
+}
+
+func vulnSubject(v *model.Vulnerability) (*generated.VulnerabilityInputSpec, error) {
+	vulns := vulnInputs(v)
+	if len(vulns) != 1 {
+		return nil, fmt.Errorf("expected a single vulnerability, got %d", len(vulns))
+	}
+	return vulns[0], nil
+}
+
+func licenseInput(l *model.License) generated.LicenseInputSpec {
+	return generated.LicenseInputSpec{Name: l.Name, Inline: l.Inline, ListVersion: l.ListVersion}
+}
+
+func compareLicenses(a, b generated.LicenseInputSpec) int {
+	return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(ptrOr(a.ListVersion), ptrOr(b.ListVersion)),
+		cmp.Compare(ptrOr(a.Inline), ptrOr(b.Inline)))
+}
+
+func ptrOr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// subject holds the converted subject of a predicate.
+type subject struct {
+	pkg      *generated.PkgInputSpec
+	pkgMatch generated.MatchFlags
+	src      *generated.SourceInputSpec
+	art      *generated.ArtifactInputSpec
+}
+
+func (e *exporter) toSubject(s any) (subject, error) {
+	var out subject
+	var err error
+	switch s := s.(type) {
+	case *model.Package:
+		out.pkg, out.pkgMatch, err = e.pkgSubject(s)
+	case *model.Source:
+		out.src, err = srcSubject(s)
+	case *model.Artifact:
+		out.art = artInput(s)
+	default:
+		err = fmt.Errorf("unexpected subject type %T", s)
+	}
+	return out, err
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// Nouns
+
+func (e *exporter) packages() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.PackageConnection, error) {
+			return e.b.PackagesList(e.ctx, model.PkgSpec{}, after, first)
+		},
+		func(c *model.PackageConnection) (*model.PageInfo, []*model.Package) {
+			var out []*model.Package
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.Package) error {
+			n := &Nouns{}
+			for _, p := range nodes {
+				for _, pkg := range pkgInputs(p) {
+					// Names without a version only exist as the subject of
+					// predicates on all versions, which add them again.
+					if pkg.Version != nil {
+						n.Packages = append(n.Packages, pkg)
+					}
+				}
+			}
+			return e.writeNouns(n, len(n.Packages))
+		})
+}
+
+func (e *exporter) sources() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.SourceConnection, error) {
+			return e.b.SourcesList(e.ctx, model.SourceSpec{}, after, first)
+		},
+		func(c *model.SourceConnection) (*model.PageInfo, []*model.Source) {
+			var out []*model.Source
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.Source) error {
+			n := &Nouns{}
+			for _, s := range nodes {
+				n.Sources = append(n.Sources, srcInputs(s)...)
+			}
+			return e.writeNouns(n, len(n.Sources))
+		})
+}
+
+func (e *exporter) artifacts() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.ArtifactConnection, error) {
+			return e.b.ArtifactsList(e.ctx, model.ArtifactSpec{}, after, first)
+		},
+		func(c *model.ArtifactConnection) (*model.PageInfo, []*model.Artifact) {
+			var out []*model.Artifact
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.Artifact) error {
+			n := &Nouns{}
+			for _, a := range nodes {
+				n.Artifacts = append(n.Artifacts, artInput(a))
+			}
+			return e.writeNouns(n, len(n.Artifacts))
+		})
+}
+
+func (e *exporter) builders() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.BuilderConnection, error) {
+			return e.b.BuildersList(e.ctx, model.BuilderSpec{}, after, first)
+		},
+		func(c *model.BuilderConnection) (*model.PageInfo, []*model.Builder) {
+			var out []*model.Builder
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.Builder) error {
+			n := &Nouns{}
+			for _, b := range nodes {
+				n.Builders = append(n.Builders, &generated.BuilderInputSpec{Uri: b.URI})
+			}
+			return e.writeNouns(n, len(n.Builders))
+		})
+}
+
+func (e *exporter) vulnerabilities() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.VulnerabilityConnection, error) {
+			return e.b.VulnerabilityList(e.ctx, model.VulnerabilitySpec{}, after, first)
+		},
+		func(c *model.VulnerabilityConnection) (*model.PageInfo, []*model.Vulnerability) {
+			var out []*model.Vulnerability
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.Vulnerability) error {
+			n := &Nouns{}
+			for _, v := range nodes {
+				n.Vulnerabilities = append(n.Vulnerabilities, vulnInputs(v)...)
+			}
+			return e.writeNouns(n, len(n.Vulnerabilities))
+		})
+}
+
+func (e *exporter) licenses() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.LicenseConnection, error) {
+			return e.b.LicenseList(e.ctx, model.LicenseSpec{}, after, first)
+		},
+		func(c *model.LicenseConnection) (*model.PageInfo, []*model.License) {
+			var out []*model.License
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.License) error {
+			n := &Nouns{}
+			for _, l := range nodes {
+				li := licenseInput(l)
+				n.Licenses = append(n.Licenses, &li)
+			}
+			return e.writeNouns(n, len(n.Licenses))
+		})
+}
+
+// Predicates
+
+func isDependencyIngest(d *model.IsDependency) (assembler.IsDependencyIngest, error) {
+	pkg, err := pkgVersion(d.Package)
+	if err != nil {
+		return assembler.IsDependencyIngest{}, err
+	}
+	depPkg, err := pkgVersion(d.DependencyPackage)
+	if err != nil {
+		return assembler.IsDependencyIngest{}, err
+	}
+	spec, err := convert[generated.IsDependencyInputSpec](d)
+	if err != nil {
+		return assembler.IsDependencyIngest{}, err
+	}
+	return assembler.IsDependencyIngest{Pkg: pkg, DepPkg: depPkg, IsDependency: spec}, nil
+}
+
+func (e *exporter) isDependencies() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.IsDependencyConnection, error) {
+			return e.b.IsDependencyList(e.ctx, model.IsDependencySpec{}, after, first)
+		},
+		func(c *model.IsDependencyConnection) (*model.PageInfo, []*model.IsDependency) {
+			var out []*model.IsDependency
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.IsDependency) error {
+			p := &assembler.IngestPredicates{}
+			for _, d := range nodes {
+				in, err := isDependencyIngest(d)
+				if err != nil {
+					return err
+				}
+				p.IsDependency = append(p.IsDependency, in)
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) isOccurrenceIngest(o *model.IsOccurrence) (assembler.IsOccurrenceIngest, error) {
+	sub, err := e.toSubject(o.Subject)
+	if err != nil {
+		return assembler.IsOccurrenceIngest{}, err
+	}
+	spec, err := convert[generated.IsOccurrenceInputSpec](o)
+	if err != nil {
+		return assembler.IsOccurrenceIngest{}, err
+	}
+	return assembler.IsOccurrenceIngest{
+		Pkg:          sub.pkg,
+		Src:          sub.src,
+		Artifact:     artInput(o.Artifact),
+		IsOccurrence: spec,
+	}, nil
+}
+
+func (e *exporter) isOccurrences() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.IsOccurrenceConnection, error) {
+			return e.b.IsOccurrenceList(e.ctx, model.IsOccurrenceSpec{}, after, first)
+		},
+		func(c *model.IsOccurrenceConnection) (*model.PageInfo, []*model.IsOccurrence) {
+			var out []*model.IsOccurrence
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.IsOccurrence) error {
+			p := &assembler.IngestPredicates{}
+			for _, o := range nodes {
+				in, err := e.isOccurrenceIngest(o)
+				if err != nil {
+					return err
+				}
+				p.IsOccurrence = append(p.IsOccurrence, in)
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) hasSLSAs() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.HasSLSAConnection, error) {
+			return e.b.HasSLSAList(e.ctx, model.HasSLSASpec{}, after, first)
+		},
+		func(c *model.HasSLSAConnection) (*model.PageInfo, []*model.HasSlsa) {
+			var out []*model.HasSlsa
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.HasSlsa) error {
+			p := &assembler.IngestPredicates{}
+			for _, s := range nodes {
+				spec, err := convert[generated.SLSAInputSpec](s.Slsa)
+				if err != nil {
+					return err
+				}
+				slices.SortFunc(spec.SlsaPredicate, func(a, b generated.SLSAPredicateInputSpec) int {
+					return cmp.Compare(a.Key, b.Key)
+				})
+				var materials []generated.ArtifactInputSpec
+				for _, a := range s.Slsa.BuiltFrom {
+					materials = append(materials, *artInput(a))
+				}
+				slices.SortFunc(materials, func(a, b generated.ArtifactInputSpec) int {
+					return cmp.Or(cmp.Compare(a.Algorithm, b.Algorithm), cmp.Compare(a.Digest, b.Digest))
+				})
+				p.HasSlsa = append(p.HasSlsa, assembler.HasSlsaIngest{
+					Artifact:  artInput(s.Subject),
+					HasSlsa:   spec,
+					Materials: materials,
+					Builder:   &generated.BuilderInputSpec{Uri: s.Slsa.BuiltBy.URI},
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) certifyVulns() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.CertifyVulnConnection, error) {
+			return e.b.CertifyVulnList(e.ctx, model.CertifyVulnSpec{}, nil, after, first)
+		},
+		func(c *model.CertifyVulnConnection) (*model.PageInfo, []*model.CertifyVuln) {
+			var out []*model.CertifyVuln
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyVuln) error {
+			p := &assembler.IngestPredicates{}
+			for _, cv := range nodes {
+				pkg, err := pkgVersion(cv.Package)
+				if err != nil {
+					return err
+				}
+				vuln, err := vulnSubject(cv.Vulnerability)
+				if err != nil {
+					return err
+				}
+				meta, err := convert[generated.ScanMetadataInput](cv.Metadata)
+				if err != nil {
+					return err
+				}
+				p.CertifyVuln = append(p.CertifyVuln, assembler.CertifyVulnIngest{
+					Pkg:           pkg,
+					Vulnerability: vuln,
+					VulnData:      meta,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) vulnEquals() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.VulnEqualConnection, error) {
+			return e.b.VulnEqualList(e.ctx, model.VulnEqualSpec{}, after, first)
+		},
+		func(c *model.VulnEqualConnection) (*model.PageInfo, []*model.VulnEqual) {
+			var out []*model.VulnEqual
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.VulnEqual) error {
+			p := &assembler.IngestPredicates{}
+			for _, ve := range nodes {
+				if len(ve.Vulnerabilities) != 2 {
+					return fmt.Errorf("VulnEqual %s has %d vulnerabilities", ve.ID, len(ve.Vulnerabilities))
+				}
+				v1, err := vulnSubject(ve.Vulnerabilities[0])
+				if err != nil {
+					return err
+				}
+				v2, err := vulnSubject(ve.Vulnerabilities[1])
+				if err != nil {
+					return err
+				}
+				if v1.Type+v1.VulnerabilityID > v2.Type+v2.VulnerabilityID {
+					v1, v2 = v2, v1
+				}
+				spec, err := convert[generated.VulnEqualInputSpec](ve)
+				if err != nil {
+					return err
+				}
+				p.VulnEqual = append(p.VulnEqual, assembler.VulnEqualIngest{
+					Vulnerability:      v1,
+					EqualVulnerability: v2,
+					VulnEqual:          spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) hasSourceAts() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.HasSourceAtConnection, error) {
+			return e.b.HasSourceAtList(e.ctx, model.HasSourceAtSpec{}, after, first)
+		},
+		func(c *model.HasSourceAtConnection) (*model.PageInfo, []*model.HasSourceAt) {
+			var out []*model.HasSourceAt
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.HasSourceAt) error {
+			p := &assembler.IngestPredicates{}
+			for _, hs := range nodes {
+				pkg, match, err := e.pkgSubject(hs.Package)
+				if err != nil {
+					return err
+				}
+				src, err := srcSubject(hs.Source)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.HasSourceAtInputSpec](hs)
+				if err != nil {
+					return err
+				}
+				p.HasSourceAt = append(p.HasSourceAt, assembler.HasSourceAtIngest{
+					Pkg:          pkg,
+					PkgMatchFlag: match,
+					Src:          src,
+					HasSourceAt:  spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) certifyBads() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.CertifyBadConnection, error) {
+			return e.b.CertifyBadList(e.ctx, model.CertifyBadSpec{}, after, first)
+		},
+		func(c *model.CertifyBadConnection) (*model.PageInfo, []*model.CertifyBad) {
+			var out []*model.CertifyBad
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyBad) error {
+			p := &assembler.IngestPredicates{}
+			for _, cb := range nodes {
+				sub, err := e.toSubject(cb.Subject)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.CertifyBadInputSpec](cb)
+				if err != nil {
+					return err
+				}
+				p.CertifyBad = append(p.CertifyBad, assembler.CertifyBadIngest{
+					Pkg:          sub.pkg,
+					PkgMatchFlag: sub.pkgMatch,
+					Src:          sub.src,
+					Artifact:     sub.art,
+					CertifyBad:   spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) certifyGoods() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.CertifyGoodConnection, error) {
+			return e.b.CertifyGoodList(e.ctx, model.CertifyGoodSpec{}, after, first)
+		},
+		func(c *model.CertifyGoodConnection) (*model.PageInfo, []*model.CertifyGood) {
+			var out []*model.CertifyGood
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyGood) error {
+			p := &assembler.IngestPredicates{}
+			for _, cg := range nodes {
+				sub, err := e.toSubject(cg.Subject)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.CertifyGoodInputSpec](cg)
+				if err != nil {
+					return err
+				}
+				p.CertifyGood = append(p.CertifyGood, assembler.CertifyGoodIngest{
+					Pkg:          sub.pkg,
+					PkgMatchFlag: sub.pkgMatch,
+					Src:          sub.src,
+					Artifact:     sub.art,
+					CertifyGood:  spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) hashEquals() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.HashEqualConnection, error) {
+			return e.b.HashEqualList(e.ctx, model.HashEqualSpec{}, after, first)
+		},
+		func(c *model.HashEqualConnection) (*model.PageInfo, []*model.HashEqual) {
+			var out []*model.HashEqual
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.HashEqual) error {
+			p := &assembler.IngestPredicates{}
+			for _, he := range nodes {
+				if len(he.Artifacts) != 2 {
+					return fmt.Errorf("HashEqual %s has %d artifacts", he.ID, len(he.Artifacts))
+				}
+				spec, err := convert[generated.HashEqualInputSpec](he)
+				if err != nil {
+					return err
+				}
+				a1, a2 := artInput(he.Artifacts[0]), artInput(he.Artifacts[1])
+				if a1.Algorithm+a1.Digest > a2.Algorithm+a2.Digest {
+					a1, a2 = a2, a1
+				}
+				p.HashEqual = append(p.HashEqual, assembler.HashEqualIngest{
+					Artifact:      a1,
+					EqualArtifact: a2,
+					HashEqual:     spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) pkgEquals() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.PkgEqualConnection, error) {
+			return e.b.PkgEqualList(e.ctx, model.PkgEqualSpec{}, after, first)
+		},
+		func(c *model.PkgEqualConnection) (*model.PageInfo, []*model.PkgEqual) {
+			var out []*model.PkgEqual
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.PkgEqual) error {
+			p := &assembler.IngestPredicates{}
+			for _, pe := range nodes {
+				if len(pe.Packages) != 2 {
+					return fmt.Errorf("PkgEqual %s has %d packages", pe.ID, len(pe.Packages))
+				}
+				p1, err := pkgVersion(pe.Packages[0])
+				if err != nil {
+					return err
+				}
+				p2, err := pkgVersion(pe.Packages[1])
+				if err != nil {
+					return err
+				}
+				if pkgKey(p1) > pkgKey(p2) {
+					p1, p2 = p2, p1
+				}
+				spec, err := convert[generated.PkgEqualInputSpec](pe)
+				if err != nil {
+					return err
+				}
+				p.PkgEqual = append(p.PkgEqual, assembler.PkgEqualIngest{
+					Pkg:      p1,
+					EqualPkg: p2,
+					PkgEqual: spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) vexStatements() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.VEXConnection, error) {
+			return e.b.CertifyVEXStatementList(e.ctx, model.CertifyVEXStatementSpec{}, nil, after, first)
+		},
+		func(c *model.VEXConnection) (*model.PageInfo, []*model.CertifyVEXStatement) {
+			var out []*model.CertifyVEXStatement
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyVEXStatement) error {
+			p := &assembler.IngestPredicates{}
+			for _, vex := range nodes {
+				sub, err := e.toSubject(vex.Subject)
+				if err != nil {
+					return err
+				}
+				vuln, err := vulnSubject(vex.Vulnerability)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.VexStatementInputSpec](vex)
+				if err != nil {
+					return err
+				}
+				p.Vex = append(p.Vex, assembler.VexIngest{
+					Pkg:           sub.pkg,
+					Artifact:      sub.art,
+					Vulnerability: vuln,
+					VexData:       spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) pointOfContacts() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.PointOfContactConnection, error) {
+			return e.b.PointOfContactList(e.ctx, model.PointOfContactSpec{}, after, first)
+		},
+		func(c *model.PointOfContactConnection) (*model.PageInfo, []*model.PointOfContact) {
+			var out []*model.PointOfContact
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.PointOfContact) error {
+			p := &assembler.IngestPredicates{}
+			for _, poc := range nodes {
+				sub, err := e.toSubject(poc.Subject)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.PointOfContactInputSpec](poc)
+				if err != nil {
+					return err
+				}
+				p.PointOfContact = append(p.PointOfContact, assembler.PointOfContactIngest{
+					Pkg:            sub.pkg,
+					PkgMatchFlag:   sub.pkgMatch,
+					Src:            sub.src,
+					Artifact:       sub.art,
+					PointOfContact: spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) vulnMetadata() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
+			return e.b.VulnerabilityMetadataList(e.ctx, model.VulnerabilityMetadataSpec{}, after, first)
+		},
+		func(c *model.VulnerabilityMetadataConnection) (*model.PageInfo, []*model.VulnerabilityMetadata) {
+			var out []*model.VulnerabilityMetadata
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.VulnerabilityMetadata) error {
+			p := &assembler.IngestPredicates{}
+			for _, vm := range nodes {
+				vuln, err := vulnSubject(vm.Vulnerability)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.VulnerabilityMetadataInputSpec](vm)
+				if err != nil {
+					return err
+				}
+				p.VulnMetadata = append(p.VulnMetadata, assembler.VulnMetadataIngest{
+					Vulnerability: vuln,
+					VulnMetadata:  spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) hasMetadata() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.HasMetadataConnection, error) {
+			return e.b.HasMetadataList(e.ctx, model.HasMetadataSpec{}, after, first)
+		},
+		func(c *model.HasMetadataConnection) (*model.PageInfo, []*model.HasMetadata) {
+			var out []*model.HasMetadata
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.HasMetadata) error {
+			p := &assembler.IngestPredicates{}
+			for _, hm := range nodes {
+				sub, err := e.toSubject(hm.Subject)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.HasMetadataInputSpec](hm)
+				if err != nil {
+					return err
+				}
+				p.HasMetadata = append(p.HasMetadata, assembler.HasMetadataIngest{
+					Pkg:          sub.pkg,
+					PkgMatchFlag: sub.pkgMatch,
+					Src:          sub.src,
+					Artifact:     sub.art,
+					HasMetadata:  spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) certifyLegals() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.CertifyLegalConnection, error) {
+			return e.b.CertifyLegalList(e.ctx, model.CertifyLegalSpec{}, nil, after, first)
+		},
+		func(c *model.CertifyLegalConnection) (*model.PageInfo, []*model.CertifyLegal) {
+			var out []*model.CertifyLegal
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyLegal) error {
+			p := &assembler.IngestPredicates{}
+			for _, cl := range nodes {
+				sub, err := e.toSubject(cl.Subject)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.CertifyLegalInputSpec](cl)
+				if err != nil {
+					return err
+				}
+				in := assembler.CertifyLegalIngest{
+					Pkg:          sub.pkg,
+					Src:          sub.src,
+					CertifyLegal: spec,
+				}
+				for _, l := range cl.DeclaredLicenses {
+					in.Declared = append(in.Declared, licenseInput(l))
+				}
+				for _, l := range cl.DiscoveredLicenses {
+					in.Discovered = append(in.Discovered, licenseInput(l))
+				}
+				slices.SortFunc(in.Declared, compareLicenses)
+				slices.SortFunc(in.Discovered, compareLicenses)
+				p.CertifyLegal = append(p.CertifyLegal, in)
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) scorecards() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.CertifyScorecardConnection, error) {
+			return e.b.ScorecardsList(e.ctx, model.CertifyScorecardSpec{}, nil, after, first)
+		},
+		func(c *model.CertifyScorecardConnection) (*model.PageInfo, []*model.CertifyScorecard) {
+			var out []*model.CertifyScorecard
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyScorecard) error {
+			p := &assembler.IngestPredicates{}
+			for _, sc := range nodes {
+				src, err := srcSubject(sc.Source)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.ScorecardInputSpec](sc.Scorecard)
+				if err != nil {
+					return err
+				}
+				slices.SortFunc(spec.Checks, func(a, b generated.ScorecardCheckInputSpec) int {
+					return cmp.Compare(a.Check, b.Check)
+				})
+				p.CertifyScorecard = append(p.CertifyScorecard, assembler.CertifyScorecardIngest{
+					Source:    src,
+					Scorecard: spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) certifyPolicies() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.CertifyPolicyConnection, error) {
+			return e.b.CertifyPolicyList(e.ctx, model.CertifyPolicySpec{}, after, first)
+		},
+		func(c *model.CertifyPolicyConnection) (*model.PageInfo, []*model.CertifyPolicy) {
+			var out []*model.CertifyPolicy
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.CertifyPolicy) error {
+			p := &assembler.IngestPredicates{}
+			for _, cp := range nodes {
+				spec, err := convert[generated.CertifyPolicyInputSpec](cp)
+				if err != nil {
+					return err
+				}
+				p.CertifyPolicy = append(p.CertifyPolicy, assembler.CertifyPolicyIngest{
+					Artifact:      artInput(cp.Subject),
+					CertifyPolicy: spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) vulnerabilityRanges() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+			return e.b.VulnerabilityRangeList(e.ctx, model.VulnerabilityRangeSpec{}, after, first)
+		},
+		func(c *model.VulnerabilityRangeConnection) (*model.PageInfo, []*model.VulnerabilityRange) {
+			var out []*model.VulnerabilityRange
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.VulnerabilityRange) error {
+			p := &assembler.IngestPredicates{}
+			for _, vr := range nodes {
+				name, err := pkgVersion(vr.Package)
+				if err != nil {
+					return err
+				}
+				pkg, err := e.anyVersion(name)
+				if err != nil {
+					return err
+				}
+				vuln, err := vulnSubject(vr.Vulnerability)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.VulnerabilityRangeInputSpec](vr)
+				if err != nil {
+					return err
+				}
+				p.VulnerabilityRange = append(p.VulnerabilityRange, assembler.VulnerabilityRangeIngest{
+					Pkg:                pkg,
+					Vulnerability:      vuln,
+					VulnerabilityRange: spec,
+				})
+			}
+			return e.writePredicates(p, len(nodes))
+		})
+}
+
+func (e *exporter) hasSBOMs() error {
+	return listAll(e.pageSize,
+		func(after *string, first *int) (*model.HasSBOMConnection, error) {
+			return e.b.HasSBOMList(e.ctx, model.HasSBOMSpec{}, nil, after, first)
+		},
+		func(c *model.HasSBOMConnection) (*model.PageInfo, []*model.HasSbom) {
+			var out []*model.HasSbom
+			for _, edge := range c.Edges {
+				out = append(out, edge.Node)
+			}
+			return c.PageInfo, out
+		},
+		func(nodes []*model.HasSbom) error {
+			for _, sbom := range nodes {
+				sub, err := e.toSubject(sbom.Subject)
+				if err != nil {
+					return err
+				}
+				spec, err := convert[generated.HasSBOMInputSpec](sbom)
+				if err != nil {
+					return err
+				}
+				p := &assembler.IngestPredicates{
+					HasSBOM: []assembler.HasSBOMIngest{{
+						Pkg:      sub.pkg,
+						Artifact: sub.art,
+						HasSBOM:  spec,
+					}},
+				}
+				for _, d := range sbom.IncludedDependencies {
+					in, err := isDependencyIngest(d)
+					if err != nil {
+						return err
+					}
+					p.IsDependency = append(p.IsDependency, in)
+				}
+				for _, o := range sbom.IncludedOccurrences {
+					in, err := e.isOccurrenceIngest(o)
+					if err != nil {
+						return err
+					}
+					p.IsOccurrence = append(p.IsOccurrence, in)
+				}
+				if err := e.writePredicates(p, 1); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
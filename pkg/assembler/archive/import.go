@@ -0,0 +1,153 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Khan/genqlient/graphql"
+	"go.uber.org/zap"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
+)
+
+// ImportStats counts what was read from an archive.
+type ImportStats struct {
+	Records    int
+	Nouns      int
+	Predicates int
+}
+
+// Import loads the archive read by r into the GraphQL server behind
+// gqlclient. Predicates go through the same bulk ingestion as collected
+// documents, so their origins, collectors, document references and
+// timestamps are kept as they are in the archive.
+//
+// Import fails with ErrTenantScoped if the server ingests for the caller on
+// behalf of a tenant, as the global predicates of the archive would then
+// belong to it.
+func Import(ctx context.Context, logger *zap.SugaredLogger, r *Reader, gqlclient graphql.Client) (*ImportStats, error) {
+	caller, err := generated.CallerTenant(ctx, gqlclient)
+	if err != nil {
+		return &ImportStats{}, fmt.Errorf("CallerTenant failed: %w", err)
+	}
+	if caller.CallerTenant != "" {
+		return &ImportStats{}, fmt.Errorf("%w, the server ingests as tenant %q", ErrTenantScoped, caller.CallerTenant)
+	}
+	assemble := helpers.GetBulkAssembler(ctx, logger, gqlclient)
+	stats := &ImportStats{}
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, err
+		}
+		stats.Records++
+		if rec.Nouns != nil {
+			n, err := ingestNouns(ctx, gqlclient, rec.Nouns)
+			if err != nil {
+				return stats, fmt.Errorf("failed to import record %d: %w", stats.Records, err)
+			}
+			stats.Nouns += n
+		}
+		if rec.Predicates != nil {
+			if _, err := assemble([]assembler.IngestPredicates{*rec.Predicates}); err != nil {
+				return stats, fmt.Errorf("failed to import record %d: %w", stats.Records, err)
+			}
+			stats.Predicates += countPredicates(rec.Predicates)
+		}
+	}
+}
+
+func ingestNouns(ctx context.Context, gqlclient graphql.Client, n *Nouns) (int, error) {
+	if len(n.Packages) > 0 {
+		in := make([]generated.IDorPkgInput, 0, len(n.Packages))
+		for _, p := range n.Packages {
+			in = append(in, generated.IDorPkgInput{PackageInput: p})
+		}
+		if _, err := generated.IngestPackages(ctx, gqlclient, in); err != nil {
+			return 0, fmt.Errorf("IngestPackages failed: %w", err)
+		}
+	}
+	if len(n.Sources) > 0 {
+		in := make([]generated.IDorSourceInput, 0, len(n.Sources))
+		for _, s := range n.Sources {
+			in = append(in, generated.IDorSourceInput{SourceInput: s})
+		}
+		if _, err := generated.IngestSources(ctx, gqlclient, in); err != nil {
+			return 0, fmt.Errorf("IngestSources failed: %w", err)
+		}
+	}
+	if len(n.Artifacts) > 0 {
+		in := make([]generated.IDorArtifactInput, 0, len(n.Artifacts))
+		for _, a := range n.Artifacts {
+			in = append(in, generated.IDorArtifactInput{ArtifactInput: a})
+		}
+		if _, err := generated.IngestArtifacts(ctx, gqlclient, in); err != nil {
+			return 0, fmt.Errorf("IngestArtifacts failed: %w", err)
+		}
+	}
+	if len(n.Builders) > 0 {
+		in := make([]generated.IDorBuilderInput, 0, len(n.Builders))
+		for _, b := range n.Builders {
+			in = append(in, generated.IDorBuilderInput{BuilderInput: b})
+		}
+		if _, err := generated.IngestBuilders(ctx, gqlclient, in); err != nil {
+			return 0, fmt.Errorf("IngestBuilders failed: %w", err)
+		}
+	}
+	if len(n.Vulnerabilities) > 0 {
+		in := make([]generated.IDorVulnerabilityInput, 0, len(n.Vulnerabilities))
+		for _, v := range n.Vulnerabilities {
+			in = append(in, generated.IDorVulnerabilityInput{VulnerabilityInput: v})
+		}
+		if _, err := generated.IngestVulnerabilities(ctx, gqlclient, in); err != nil {
+			return 0, fmt.Errorf("IngestVulnerabilities failed: %w", err)
+		}
+	}
+	if len(n.Licenses) > 0 {
+		in := make([]generated.IDorLicenseInput, 0, len(n.Licenses))
+		for _, l := range n.Licenses {
+			in = append(in, generated.IDorLicenseInput{LicenseInput: l})
+		}
+		if _, err := generated.IngestLicenses(ctx, gqlclient, in); err != nil {
+			return 0, fmt.Errorf("IngestLicenses failed: %w", err)
+		}
+	}
+	return len(n.Packages) + len(n.Sources) + len(n.Artifacts) + len(n.Builders) +
+		len(n.Vulnerabilities) + len(n.Licenses), nil
+}
+
+// countPredicates counts the predicates of a record. The dependencies and
+// occurrences that come with an SBOM are counted as part of the SBOM, as
+// they are in Export.
+func countPredicates(p *assembler.IngestPredicates) int {
+	if len(p.HasSBOM) > 0 {
+		return len(p.HasSBOM)
+	}
+	return len(p.CertifyScorecard) + len(p.IsDependency) + len(p.IsOccurrence) +
+		len(p.HasSlsa) + len(p.CertifyVuln) + len(p.VulnEqual) + len(p.HasSourceAt) +
+		len(p.CertifyBad) + len(p.CertifyGood) + len(p.HashEqual) + len(p.PkgEqual) +
+		len(p.Vex) + len(p.PointOfContact) + len(p.VulnMetadata) + len(p.HasMetadata) +
+		len(p.CertifyLegal) + len(p.CertifyPolicy) + len(p.VulnerabilityRange)
+}
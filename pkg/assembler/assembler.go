@@ -30,23 +30,25 @@ type assembler struct{} //nolint: unused
 // client library.
 // TODO: fix typo in isDepedency
 type IngestPredicates struct {
-	CertifyScorecard []CertifyScorecardIngest `json:"certifyScorecard,omitempty"`
-	IsDependency     []IsDependencyIngest     `json:"isDependency,omitempty"`
-	IsOccurrence     []IsOccurrenceIngest     `json:"isOccurrence,omitempty"`
-	HasSlsa          []HasSlsaIngest          `json:"hasSlsa,omitempty"`
-	CertifyVuln      []CertifyVulnIngest      `json:"certifyVuln,omitempty"`
-	VulnEqual        []VulnEqualIngest        `json:"vulnEqual,omitempty"`
-	HasSourceAt      []HasSourceAtIngest      `json:"hasSourceAt,omitempty"`
-	CertifyBad       []CertifyBadIngest       `json:"certifyBad,omitempty"`
-	CertifyGood      []CertifyGoodIngest      `json:"certifyGood,omitempty"`
-	HasSBOM          []HasSBOMIngest          `json:"hasSBOM,omitempty"`
-	HashEqual        []HashEqualIngest        `json:"hashEqual,omitempty"`
-	PkgEqual         []PkgEqualIngest         `json:"pkgEqual,omitempty"`
-	Vex              []VexIngest              `json:"vex,omitempty"`
-	PointOfContact   []PointOfContactIngest   `json:"contact,omitempty"`
-	VulnMetadata     []VulnMetadataIngest     `json:"vulnMetadata,omitempty"`
-	HasMetadata      []HasMetadataIngest      `json:"hasMetadata,omitempty"`
-	CertifyLegal     []CertifyLegalIngest     `json:"certifyLegal,omitempty"`
+	CertifyScorecard   []CertifyScorecardIngest   `json:"certifyScorecard,omitempty"`
+	IsDependency       []IsDependencyIngest       `json:"isDependency,omitempty"`
+	IsOccurrence       []IsOccurrenceIngest       `json:"isOccurrence,omitempty"`
+	HasSlsa            []HasSlsaIngest            `json:"hasSlsa,omitempty"`
+	CertifyVuln        []CertifyVulnIngest        `json:"certifyVuln,omitempty"`
+	VulnEqual          []VulnEqualIngest          `json:"vulnEqual,omitempty"`
+	HasSourceAt        []HasSourceAtIngest        `json:"hasSourceAt,omitempty"`
+	CertifyBad         []CertifyBadIngest         `json:"certifyBad,omitempty"`
+	CertifyGood        []CertifyGoodIngest        `json:"certifyGood,omitempty"`
+	HasSBOM            []HasSBOMIngest            `json:"hasSBOM,omitempty"`
+	HashEqual          []HashEqualIngest          `json:"hashEqual,omitempty"`
+	PkgEqual           []PkgEqualIngest           `json:"pkgEqual,omitempty"`
+	Vex                []VexIngest                `json:"vex,omitempty"`
+	PointOfContact     []PointOfContactIngest     `json:"contact,omitempty"`
+	VulnMetadata       []VulnMetadataIngest       `json:"vulnMetadata,omitempty"`
+	HasMetadata        []HasMetadataIngest        `json:"hasMetadata,omitempty"`
+	CertifyLegal       []CertifyLegalIngest       `json:"certifyLegal,omitempty"`
+	CertifyPolicy      []CertifyPolicyIngest      `json:"certifyPolicy,omitempty"`
+	VulnerabilityRange []VulnerabilityRangeIngest `json:"vulnerabilityRange,omitempty"`
 }
 
 type CertifyScorecardIngest struct {
@@ -194,6 +196,18 @@ type CertifyLegalIngest struct {
 	CertifyLegal *generated.CertifyLegalInputSpec `json:"certifyLegal,omitempty"`
 }
 
+type CertifyPolicyIngest struct {
+	Artifact      *generated.ArtifactInputSpec      `json:"artifact,omitempty"`
+	CertifyPolicy *generated.CertifyPolicyInputSpec `json:"certifyPolicy,omitempty"`
+}
+
+type VulnerabilityRangeIngest struct {
+	// Pkg is the affected package; only its name is used
+	Pkg                *generated.PkgInputSpec                `json:"pkg,omitempty"`
+	Vulnerability      *generated.VulnerabilityInputSpec      `json:"vulnerability,omitempty"`
+	VulnerabilityRange *generated.VulnerabilityRangeInputSpec `json:"vulnerabilityRange,omitempty"`
+}
+
 func (i IngestPredicates) GetPackages(ctx context.Context) map[string]*generated.IDorPkgInput {
 	packageMap := make(map[string]*generated.IDorPkgInput)
 	for _, dep := range i.IsDependency {
@@ -304,6 +318,14 @@ func (i IngestPredicates) GetPackages(ctx context.Context) map[string]*generated
 			}
 		}
 	}
+	for _, vr := range i.VulnerabilityRange {
+		if vr.Pkg != nil {
+			pkgPurl := helpers.GetKey[*generated.PkgInputSpec, helpers.PkgIds](vr.Pkg, helpers.PkgClientKey).VersionId
+			if _, ok := packageMap[pkgPurl]; !ok {
+				packageMap[pkgPurl] = &generated.IDorPkgInput{PackageInput: vr.Pkg}
+			}
+		}
+	}
 
 	return packageMap
 }
@@ -444,6 +466,14 @@ func (i IngestPredicates) GetArtifacts(ctx context.Context) map[string]*generate
 			}
 		}
 	}
+	for _, cp := range i.CertifyPolicy {
+		if cp.Artifact != nil {
+			artifactString := helpers.GetKey[*generated.ArtifactInputSpec, string](cp.Artifact, helpers.ArtifactClientKey)
+			if _, ok := artifactMap[artifactString]; !ok {
+				artifactMap[artifactString] = &generated.IDorArtifactInput{ArtifactInput: cp.Artifact}
+			}
+		}
+	}
 	for _, equal := range i.HashEqual {
 		if equal.Artifact != nil {
 			artifactString := helpers.GetKey[*generated.ArtifactInputSpec, string](equal.Artifact, helpers.ArtifactClientKey)
@@ -524,6 +554,14 @@ func (i IngestPredicates) GetVulnerabilities(ctx context.Context) map[string]*ge
 			vulnMap[equalVURI] = &generated.IDorVulnerabilityInput{VulnerabilityInput: v.Vulnerability}
 		}
 	}
+	for _, v := range i.VulnerabilityRange {
+		if v.Vulnerability != nil {
+			equalVURI := helpers.GetKey[*generated.VulnerabilityInputSpec, helpers.VulnIds](v.Vulnerability, helpers.VulnClientKey).VulnerabilityID
+			if _, ok := vulnMap[equalVURI]; !ok {
+				vulnMap[equalVURI] = &generated.IDorVulnerabilityInput{VulnerabilityInput: v.Vulnerability}
+			}
+		}
+	}
 
 	return vulnMap
 }
@@ -23,15 +23,19 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
 
-func (c *arangoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyLegalList")
+func (c *arangoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	return nil, fmt.Errorf("%w: CertifyLegalList", backends.ErrNotImplemented)
 }
 
-func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: CertifyLegal asOf", backends.ErrNotImplemented)
+	}
 
 	if certifyLegalSpec != nil && certifyLegalSpec.ID != nil {
 		cl, err := c.buildCertifyLegalByID(ctx, *certifyLegalSpec.ID, certifyLegalSpec)
@@ -0,0 +1,40 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *arangoClient) CertifyPolicyList(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error) {
+	return nil, fmt.Errorf("%w: CertifyPolicyList", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) CertifyPolicy(ctx context.Context, certifyPolicySpec *model.CertifyPolicySpec) ([]*model.CertifyPolicy, error) {
+	return nil, fmt.Errorf("%w: CertifyPolicy", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error) {
+	return "", fmt.Errorf("%w: IngestCertifyPolicy", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error) {
+	return nil, fmt.Errorf("%w: IngestCertifyPolicies", backends.ErrNotImplemented)
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -39,11 +40,14 @@ const (
 
 // Query Scorecards
 
-func (c *arangoClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
-	return nil, fmt.Errorf("not implemented: ScorecardsList")
+func (c *arangoClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	return nil, fmt.Errorf("%w: ScorecardsList", backends.ErrNotImplemented)
 }
 
-func (c *arangoClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+func (c *arangoClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: Scorecards asOf", backends.ErrNotImplemented)
+	}
 
 	if certifyScorecardSpec != nil && certifyScorecardSpec.ID != nil {
 		sc, err := c.buildCertifyScorecardByID(ctx, *certifyScorecardSpec.ID, certifyScorecardSpec)
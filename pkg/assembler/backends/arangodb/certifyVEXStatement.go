@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
@@ -42,11 +43,14 @@ const (
 	cvssStr             string = "cvss"
 )
 
-func (c *arangoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyVEXStatementList")
+func (c *arangoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error) {
+	return nil, fmt.Errorf("%w: CertifyVEXStatementList", backends.ErrNotImplemented)
 }
 
-func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: CertifyVEXStatement asOf", backends.ErrNotImplemented)
+	}
 
 	if certifyVEXStatementSpec != nil && certifyVEXStatementSpec.ID != nil {
 		vex, err := c.buildCertifyVexByID(ctx, *certifyVEXStatementSpec.ID, certifyVEXStatementSpec)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
@@ -34,11 +35,14 @@ const (
 	scannerVersionStr string = "scannerVersion"
 )
 
-func (c *arangoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyVulnList")
+func (c *arangoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	return nil, fmt.Errorf("%w: CertifyVulnList", backends.ErrNotImplemented)
 }
 
-func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: CertifyVuln asOf", backends.ErrNotImplemented)
+	}
 
 	if certifyVulnSpec != nil && certifyVulnSpec.ID != nil {
 		cv, err := c.buildCertifyVulnByID(ctx, *certifyVulnSpec.ID, certifyVulnSpec)
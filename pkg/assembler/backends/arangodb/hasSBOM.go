@@ -24,16 +24,20 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 )
 
-func (c *arangoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSBOMList")
+func (c *arangoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error) {
+	return nil, fmt.Errorf("%w: HasSBOMList", backends.ErrNotImplemented)
 }
 
-func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: HasSBOM asOf", backends.ErrNotImplemented)
+	}
 
 	if hasSBOMSpec != nil && hasSBOMSpec.ID != nil {
 		sbom, err := c.buildHasSbomByID(ctx, *hasSBOMSpec.ID, hasSBOMSpec)
@@ -22,6 +22,7 @@ import (
 
 	"github.com/arangodb/go-driver"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
@@ -29,6 +30,7 @@ import (
 
 const (
 	dependencyTypeStr string = "dependencyType"
+	versionRangeStr   string = "versionRange"
 )
 
 var dependencyTypeToEnum = map[string]model.DependencyType{
@@ -41,7 +43,7 @@ var dependencyTypeToEnum = map[string]model.DependencyType{
 // Query IsDependency
 
 func (c *arangoClient) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
-	return nil, fmt.Errorf("not implemented: IsDependencyList")
+	return nil, fmt.Errorf("%w: IsDependencyList", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
@@ -125,6 +127,7 @@ func getDependencyForQuery(ctx context.Context, c *arangoClient, arangoQueryBuil
 			},
 			'isDependency_id': isDependency._id,
 			'dependencyType': isDependency.dependencyType,
+			'versionRange': isDependency.versionRange,
 			'justification': isDependency.justification,
 			'collector': isDependency.collector,
 			'origin': isDependency.origin,
@@ -149,6 +152,10 @@ func queryIsDependencyBasedOnFilter(arangoQueryBuilder *arangoQueryBuilder, isDe
 		arangoQueryBuilder.filter("isDependency", dependencyTypeStr, "==", "@"+dependencyTypeStr)
 		queryValues[dependencyTypeStr] = *isDependencySpec.DependencyType
 	}
+	if isDependencySpec.VersionRange != nil {
+		arangoQueryBuilder.filter("isDependency", versionRangeStr, "==", "@"+versionRangeStr)
+		queryValues[versionRangeStr] = *isDependencySpec.VersionRange
+	}
 	if isDependencySpec.Justification != nil {
 		arangoQueryBuilder.filter("isDependency", justification, "==", "@"+justification)
 		queryValues[justification] = *isDependencySpec.Justification
@@ -235,6 +242,7 @@ func getDependencyQueryValues(pkg *model.PkgInputSpec, depPkg *model.PkgInputSpe
 	// isDependency
 
 	values[dependencyTypeStr] = dependency.DependencyType.String()
+	values[versionRangeStr] = nilToEmpty(dependency.VersionRange)
 	values[justification] = dependency.Justification
 	values[origin] = dependency.Origin
 	values[collector] = dependency.Collector
@@ -294,8 +302,8 @@ func (c *arangoClient) IngestDependencies(ctx context.Context, pkgs []*model.IDo
     )
 		
 	LET isDependency = FIRST(
-		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:doc.dependencyType, justification:doc.justification, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
-			INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:doc.dependencyType, justification:doc.justification, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef }
+		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:doc.dependencyType, versionRange:doc.versionRange, justification:doc.justification, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
+			INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:doc.dependencyType, versionRange:doc.versionRange, justification:doc.justification, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef }
 			UPDATE {} IN isDependencies
 			RETURN {
 				'_id': NEW._id,
@@ -351,8 +359,8 @@ func (c *arangoClient) IngestDependency(ctx context.Context, pkg model.IDorPkgIn
 
 	  
 	  LET isDependency = FIRST(
-		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:@dependencyType, justification:@justification, collector:@collector, origin:@origin, documentRef:@documentRef } 
-			  INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:@dependencyType, justification:@justification, collector:@collector, origin:@origin, documentRef:@documentRef } 
+		  UPSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:@dependencyType, versionRange:@versionRange, justification:@justification, collector:@collector, origin:@origin, documentRef:@documentRef } 
+			  INSERT { packageID:firstPkg.version_id, depPackageID:secondPkg.version_id, dependencyType:@dependencyType, versionRange:@versionRange, justification:@justification, collector:@collector, origin:@origin, documentRef:@documentRef } 
 			  UPDATE {} IN isDependencies
 			  RETURN {
 				'_id': NEW._id,
@@ -389,6 +397,7 @@ func getIsDependencyFromCursor(ctx context.Context, cursor driver.Cursor, ingest
 		DepPkg         *dbPkgVersion `json:"depPkg"`
 		IsDependencyID string        `json:"isDependency_id"`
 		DependencyType string        `json:"dependencyType"`
+		VersionRange   string        `json:"versionRange"`
 		Justification  string        `json:"justification"`
 		Collector      string        `json:"collector"`
 		Origin         string        `json:"origin"`
@@ -424,6 +433,7 @@ func getIsDependencyFromCursor(ctx context.Context, cursor driver.Cursor, ingest
 				ID:                createdValue.IsDependencyID,
 				Package:           pkg,
 				DependencyPackage: depPkg,
+				VersionRange:      createdValue.VersionRange,
 				Justification:     createdValue.Justification,
 				Origin:            createdValue.Origin,
 				Collector:         createdValue.Collector,
@@ -488,6 +498,7 @@ func (c *arangoClient) queryIsDependencyNodeByID(ctx context.Context, filter *mo
 		PackageID      string `json:"packageID"`
 		DepPackageID   string `json:"depPackageID"`
 		DependencyType string `json:"dependencyType"`
+		VersionRange   string `json:"versionRange"`
 		Justification  string `json:"justification"`
 		Collector      string `json:"collector"`
 		Origin         string `json:"origin"`
@@ -535,6 +546,7 @@ func (c *arangoClient) queryIsDependencyNodeByID(ctx context.Context, filter *mo
 		Package:           builtPackage,
 		DependencyPackage: builtDepPackage,
 		DependencyType:    depType,
+		VersionRange:      collectedValues[0].VersionRange,
 		Justification:     collectedValues[0].Justification,
 		Origin:            collectedValues[0].Origin,
 		Collector:         collectedValues[0].Collector,
@@ -589,7 +601,8 @@ func (c *arangoClient) isDependencyNeighbors(ctx context.Context, nodeID string,
 
 func noMatchIsDep(filter *model.IsDependencySpec, link *model.IsDependency) bool {
 	if filter != nil {
-		return noMatch(filter.Justification, link.Justification) ||
+		return noMatch(filter.VersionRange, link.VersionRange) ||
+			noMatch(filter.Justification, link.Justification) ||
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.Collector, link.Collector) ||
 			(filter.DependencyType != nil && *filter.DependencyType != link.DependencyType)
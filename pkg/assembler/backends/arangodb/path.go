@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -39,6 +40,22 @@ func processUsingOnly(usingOnly []model.Edge) edgeMap {
 	return m
 }
 
+func (c *arangoClient) AllPaths(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error) {
+	return nil, fmt.Errorf("%w: AllPaths", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) ConstrainedPath(ctx context.Context, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error) {
+	return nil, fmt.Errorf("%w: ConstrainedPath", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	return nil, fmt.Errorf("%w: DependencyClosure", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	return nil, fmt.Errorf("%w: DependentClosure", backends.ErrNotImplemented)
+}
+
 func (c *arangoClient) Path(ctx context.Context, startNodeID string, targetNodeID string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
 	values := map[string]any{}
 	values["startVertex"] = startNodeID
@@ -125,7 +142,7 @@ IN 1..@maxLength ANY K_PATHS
 }
 
 func (c *arangoClient) NeighborsList(ctx context.Context, node string, usingOnly []model.Edge, after *string, first *int) (*model.NeighborConnection, error) {
-	return nil, fmt.Errorf("not implemented: NeighborsList")
+	return nil, fmt.Errorf("%w: NeighborsList", backends.ErrNotImplemented)
 }
 
 // TODO (pxp928): investigate if the individual neighbor queries (within nouns and verbs) can be done co-currently
@@ -361,5 +378,5 @@ func (c *arangoClient) Nodes(ctx context.Context, nodeIDs []string) ([]model.Nod
 // Delete node and all associated relationships. This functionality is only implemented for
 // certifyVuln, HasSBOM and HasSLSA.
 func (c *arangoClient) Delete(ctx context.Context, node string) (bool, error) {
-	panic(fmt.Errorf("not implemented: Delete"))
+	panic(fmt.Errorf("%w: Delete", backends.ErrNotImplemented))
 }
@@ -20,35 +20,36 @@ import (
 	"fmt"
 
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *arangoClient) FindSoftwareList(ctx context.Context, searchText string, after *string, first *int) (*model.FindSoftwareConnection, error) {
-	return nil, fmt.Errorf("not implemented: FindSoftwareList")
+	return nil, fmt.Errorf("%w: FindSoftwareList", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []string, after *string, first *int) (*model.PackageConnection, error) {
-	return nil, fmt.Errorf("not implemented: QueryPackagesListForScan")
+	return nil, fmt.Errorf("%w: QueryPackagesListForScan", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error) {
-	return nil, fmt.Errorf("not implemented: FindPackagesThatNeedScanning")
+	return nil, fmt.Errorf("%w: FindPackagesThatNeedScanning", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryPkgIDCertifyVuln")
+	return nil, fmt.Errorf("%w: BatchQueryPkgIDCertifyVuln", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []string) ([]*model.CertifyLegal, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryPkgIDCertifyLegal")
+	return nil, fmt.Errorf("%w: BatchQueryPkgIDCertifyLegal", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) BatchQuerySubjectPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
-	return nil, fmt.Errorf("not implemented: BatchQuerySubjectPkgDependency")
+	return nil, fmt.Errorf("%w: BatchQuerySubjectPkgDependency", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) BatchQueryDepPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryDepPkgDependency")
+	return nil, fmt.Errorf("%w: BatchQueryDepPkgDependency", backends.ErrNotImplemented)
 }
 
 // TODO(lumjjb): add source when it is implemented in arango backend
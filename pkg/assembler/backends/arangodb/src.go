@@ -23,6 +23,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
@@ -271,7 +272,7 @@ func setSrcMatchValues(srcSpec *model.SourceSpec, queryValues map[string]any) *a
 }
 
 func (c *arangoClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
-	return nil, fmt.Errorf("not implemented: SourcesList")
+	return nil, fmt.Errorf("%w: SourcesList", backends.ErrNotImplemented)
 }
 
 func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
@@ -0,0 +1,40 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *arangoClient) VulnerabilityRangeList(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+	return nil, fmt.Errorf("%w: VulnerabilityRangeList", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) VulnerabilityRange(ctx context.Context, vulnerabilityRangeSpec *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error) {
+	return nil, fmt.Errorf("%w: VulnerabilityRange", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error) {
+	return "", fmt.Errorf("%w: IngestVulnerabilityRange", backends.ErrNotImplemented)
+}
+
+func (c *arangoClient) IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error) {
+	return nil, fmt.Errorf("%w: IngestVulnerabilityRanges", backends.ErrNotImplemented)
+}
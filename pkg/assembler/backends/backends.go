@@ -17,10 +17,16 @@ package backends
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
+// ErrNotImplemented is wrapped by the errors of Backend methods, or of
+// options of a method, that a backend does not implement.
+var ErrNotImplemented = errors.New("not implemented")
+
 // Backend interface allows having multiple database backends for the same
 // GraphQL interface. All backends must implement all queries specified by the
 // GraphQL interface and this is enforced by this interface.
@@ -43,13 +49,14 @@ type Backend interface {
 
 	CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error)
 	CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error)
-	CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error)
-	ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error)
-	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error)
-	CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error)
+	CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error)
+	CertifyPolicyList(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error)
+	ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error)
+	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error)
+	CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error)
 	PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error)
 	HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error)
-	HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error)
+	HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error)
 	HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error)
 	HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error)
 	IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error)
@@ -58,14 +65,21 @@ type Backend interface {
 	PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error)
 	VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error)
 	VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error)
+	VulnerabilityRangeList(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error)
 
 	// Retrieval read-only queries for evidence trees
+	//
+	// The queries taking asOf return, when it is set, the predicates known at
+	// that time: those whose knownSince or timeScanned is after it are left
+	// out, as are those superseded by a later predicate about the same
+	// subject that is known at that time.
 	CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error)
 	CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error)
-	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error)
-	CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
-	CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error)
-	HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error)
+	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error)
+	CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error)
+	CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error)
+	CertifyPolicy(ctx context.Context, certifyPolicySpec *model.CertifyPolicySpec) ([]*model.CertifyPolicy, error)
+	HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error)
 	HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error)
 	HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error)
 	HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error)
@@ -74,9 +88,10 @@ type Backend interface {
 	IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error)
 	PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error)
 	PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error)
-	Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error)
+	Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error)
 	VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error)
 	VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error)
+	VulnerabilityRange(ctx context.Context, vulnerabilityRangeSpec *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error)
 
 	// Mutations for software trees (read-write queries)
 	IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error)
@@ -101,6 +116,8 @@ type Backend interface {
 	IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error)
 	IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal *model.CertifyLegalInputSpec) (string, error)
 	IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error)
+	IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error)
+	IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error)
 	IngestDependency(ctx context.Context, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, dependency model.IsDependencyInputSpec) (string, error)
 	IngestDependencies(ctx context.Context, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, dependencies []*model.IsDependencyInputSpec) ([]string, error)
 	IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error)
@@ -127,6 +144,8 @@ type Backend interface {
 	IngestVulnEquals(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, otherVulnerabilities []*model.IDorVulnerabilityInput, vulnEquals []*model.VulnEqualInputSpec) ([]string, error)
 	IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error)
 	IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error)
+	IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error)
+	IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error)
 
 	// Delete Node and all relationships attached to it
 	Delete(ctx context.Context, node string) (bool, error)
@@ -137,6 +156,10 @@ type Backend interface {
 	Node(ctx context.Context, node string) (model.Node, error)
 	Nodes(ctx context.Context, nodes []string) ([]model.Node, error)
 	Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error)
+	AllPaths(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error)
+	ConstrainedPath(ctx context.Context, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error)
+	DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error)
+	DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error)
 
 	// Batch Query
 	BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []string) ([]*model.CertifyLegal, error)
@@ -49,13 +49,15 @@ type ArtifactEdges struct {
 	Metadata []*HasMetadata `json:"metadata,omitempty"`
 	// Poc holds the value of the poc edge.
 	Poc []*PointOfContact `json:"poc,omitempty"`
+	// Policies holds the value of the policies edge.
+	Policies []*CertifyPolicy `json:"policies,omitempty"`
 	// IncludedInSboms holds the value of the included_in_sboms edge.
 	IncludedInSboms []*BillOfMaterials `json:"included_in_sboms,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [11]bool
+	loadedTypes [12]bool
 	// totalCount holds the count of the edges above.
-	totalCount [11]map[string]int
+	totalCount [12]map[string]int
 
 	namedOccurrences         map[string][]*Occurrence
 	namedSbom                map[string][]*BillOfMaterials
@@ -67,6 +69,7 @@ type ArtifactEdges struct {
 	namedCertification       map[string][]*Certification
 	namedMetadata            map[string][]*HasMetadata
 	namedPoc                 map[string][]*PointOfContact
+	namedPolicies            map[string][]*CertifyPolicy
 	namedIncludedInSboms     map[string][]*BillOfMaterials
 }
 
@@ -160,10 +163,19 @@ func (e ArtifactEdges) PocOrErr() ([]*PointOfContact, error) {
 	return nil, &NotLoadedError{edge: "poc"}
 }
 
+// PoliciesOrErr returns the Policies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArtifactEdges) PoliciesOrErr() ([]*CertifyPolicy, error) {
+	if e.loadedTypes[10] {
+		return e.Policies, nil
+	}
+	return nil, &NotLoadedError{edge: "policies"}
+}
+
 // IncludedInSbomsOrErr returns the IncludedInSboms value or an error if the edge
 // was not loaded in eager-loading.
 func (e ArtifactEdges) IncludedInSbomsOrErr() ([]*BillOfMaterials, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[11] {
 		return e.IncludedInSboms, nil
 	}
 	return nil, &NotLoadedError{edge: "included_in_sboms"}
@@ -274,6 +286,11 @@ func (a *Artifact) QueryPoc() *PointOfContactQuery {
 	return NewArtifactClient(a.config).QueryPoc(a)
 }
 
+// QueryPolicies queries the "policies" edge of the Artifact entity.
+func (a *Artifact) QueryPolicies() *CertifyPolicyQuery {
+	return NewArtifactClient(a.config).QueryPolicies(a)
+}
+
 // QueryIncludedInSboms queries the "included_in_sboms" edge of the Artifact entity.
 func (a *Artifact) QueryIncludedInSboms() *BillOfMaterialsQuery {
 	return NewArtifactClient(a.config).QueryIncludedInSboms(a)
@@ -551,6 +568,30 @@ func (a *Artifact) appendNamedPoc(name string, edges ...*PointOfContact) {
 	}
 }
 
+// NamedPolicies returns the Policies named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (a *Artifact) NamedPolicies(name string) ([]*CertifyPolicy, error) {
+	if a.Edges.namedPolicies == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := a.Edges.namedPolicies[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (a *Artifact) appendNamedPolicies(name string, edges ...*CertifyPolicy) {
+	if a.Edges.namedPolicies == nil {
+		a.Edges.namedPolicies = make(map[string][]*CertifyPolicy)
+	}
+	if len(edges) == 0 {
+		a.Edges.namedPolicies[name] = []*CertifyPolicy{}
+	} else {
+		a.Edges.namedPolicies[name] = append(a.Edges.namedPolicies[name], edges...)
+	}
+}
+
 // NamedIncludedInSboms returns the IncludedInSboms named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (a *Artifact) NamedIncludedInSboms(name string) ([]*BillOfMaterials, error) {
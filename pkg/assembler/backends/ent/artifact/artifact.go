@@ -37,6 +37,8 @@ const (
 	EdgeMetadata = "metadata"
 	// EdgePoc holds the string denoting the poc edge name in mutations.
 	EdgePoc = "poc"
+	// EdgePolicies holds the string denoting the policies edge name in mutations.
+	EdgePolicies = "policies"
 	// EdgeIncludedInSboms holds the string denoting the included_in_sboms edge name in mutations.
 	EdgeIncludedInSboms = "included_in_sboms"
 	// Table holds the table name of the artifact in the database.
@@ -109,6 +111,13 @@ const (
 	PocInverseTable = "point_of_contacts"
 	// PocColumn is the table column denoting the poc relation/edge.
 	PocColumn = "artifact_id"
+	// PoliciesTable is the table that holds the policies relation/edge.
+	PoliciesTable = "certify_policies"
+	// PoliciesInverseTable is the table name for the CertifyPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "certifypolicy" package.
+	PoliciesInverseTable = "certify_policies"
+	// PoliciesColumn is the table column denoting the policies relation/edge.
+	PoliciesColumn = "artifact_id"
 	// IncludedInSbomsTable is the table that holds the included_in_sboms relation/edge. The primary key declared below.
 	IncludedInSbomsTable = "bill_of_materials_included_software_artifacts"
 	// IncludedInSbomsInverseTable is the table name for the BillOfMaterials entity.
@@ -305,6 +314,20 @@ func ByPoc(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByPoliciesCount orders the results by policies count.
+func ByPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPoliciesStep(), opts...)
+	}
+}
+
+// ByPolicies orders the results by policies terms.
+func ByPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByIncludedInSbomsCount orders the results by included_in_sboms count.
 func ByIncludedInSbomsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -388,6 +411,13 @@ func newPocStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, PocTable, PocColumn),
 	)
 }
+func newPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PoliciesTable, PoliciesColumn),
+	)
+}
 func newIncludedInSbomsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
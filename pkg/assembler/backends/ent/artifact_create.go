@@ -15,6 +15,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
@@ -207,6 +208,21 @@ func (ac *ArtifactCreate) AddPoc(p ...*PointOfContact) *ArtifactCreate {
 	return ac.AddPocIDs(ids...)
 }
 
+// AddPolicyIDs adds the "policies" edge to the CertifyPolicy entity by IDs.
+func (ac *ArtifactCreate) AddPolicyIDs(ids ...uuid.UUID) *ArtifactCreate {
+	ac.mutation.AddPolicyIDs(ids...)
+	return ac
+}
+
+// AddPolicies adds the "policies" edges to the CertifyPolicy entity.
+func (ac *ArtifactCreate) AddPolicies(c ...*CertifyPolicy) *ArtifactCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ac.AddPolicyIDs(ids...)
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
 func (ac *ArtifactCreate) AddIncludedInSbomIDs(ids ...uuid.UUID) *ArtifactCreate {
 	ac.mutation.AddIncludedInSbomIDs(ids...)
@@ -475,6 +491,22 @@ func (ac *ArtifactCreate) createSpec() (*Artifact, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ac.mutation.IncludedInSbomsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
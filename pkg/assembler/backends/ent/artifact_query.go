@@ -16,6 +16,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
@@ -42,6 +43,7 @@ type ArtifactQuery struct {
 	withCertification            *CertificationQuery
 	withMetadata                 *HasMetadataQuery
 	withPoc                      *PointOfContactQuery
+	withPolicies                 *CertifyPolicyQuery
 	withIncludedInSboms          *BillOfMaterialsQuery
 	modifiers                    []func(*sql.Selector)
 	loadTotal                    []func(context.Context, []*Artifact) error
@@ -55,6 +57,7 @@ type ArtifactQuery struct {
 	withNamedCertification       map[string]*CertificationQuery
 	withNamedMetadata            map[string]*HasMetadataQuery
 	withNamedPoc                 map[string]*PointOfContactQuery
+	withNamedPolicies            map[string]*CertifyPolicyQuery
 	withNamedIncludedInSboms     map[string]*BillOfMaterialsQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -312,6 +315,28 @@ func (aq *ArtifactQuery) QueryPoc() *PointOfContactQuery {
 	return query
 }
 
+// QueryPolicies chains the current query on the "policies" edge.
+func (aq *ArtifactQuery) QueryPolicies() *CertifyPolicyQuery {
+	query := (&CertifyPolicyClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifact.Table, artifact.FieldID, selector),
+			sqlgraph.To(certifypolicy.Table, certifypolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, artifact.PoliciesTable, artifact.PoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryIncludedInSboms chains the current query on the "included_in_sboms" edge.
 func (aq *ArtifactQuery) QueryIncludedInSboms() *BillOfMaterialsQuery {
 	query := (&BillOfMaterialsClient{config: aq.config}).Query()
@@ -536,6 +561,7 @@ func (aq *ArtifactQuery) Clone() *ArtifactQuery {
 		withCertification:       aq.withCertification.Clone(),
 		withMetadata:            aq.withMetadata.Clone(),
 		withPoc:                 aq.withPoc.Clone(),
+		withPolicies:            aq.withPolicies.Clone(),
 		withIncludedInSboms:     aq.withIncludedInSboms.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
@@ -653,6 +679,17 @@ func (aq *ArtifactQuery) WithPoc(opts ...func(*PointOfContactQuery)) *ArtifactQu
 	return aq
 }
 
+// WithPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArtifactQuery) WithPolicies(opts ...func(*CertifyPolicyQuery)) *ArtifactQuery {
+	query := (&CertifyPolicyClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withPolicies = query
+	return aq
+}
+
 // WithIncludedInSboms tells the query-builder to eager-load the nodes that are connected to
 // the "included_in_sboms" edge. The optional arguments are used to configure the query builder of the edge.
 func (aq *ArtifactQuery) WithIncludedInSboms(opts ...func(*BillOfMaterialsQuery)) *ArtifactQuery {
@@ -742,7 +779,7 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 	var (
 		nodes       = []*Artifact{}
 		_spec       = aq.querySpec()
-		loadedTypes = [11]bool{
+		loadedTypes = [12]bool{
 			aq.withOccurrences != nil,
 			aq.withSbom != nil,
 			aq.withAttestations != nil,
@@ -753,6 +790,7 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 			aq.withCertification != nil,
 			aq.withMetadata != nil,
 			aq.withPoc != nil,
+			aq.withPolicies != nil,
 			aq.withIncludedInSboms != nil,
 		}
 	)
@@ -849,6 +887,13 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 			return nil, err
 		}
 	}
+	if query := aq.withPolicies; query != nil {
+		if err := aq.loadPolicies(ctx, query, nodes,
+			func(n *Artifact) { n.Edges.Policies = []*CertifyPolicy{} },
+			func(n *Artifact, e *CertifyPolicy) { n.Edges.Policies = append(n.Edges.Policies, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := aq.withIncludedInSboms; query != nil {
 		if err := aq.loadIncludedInSboms(ctx, query, nodes,
 			func(n *Artifact) { n.Edges.IncludedInSboms = []*BillOfMaterials{} },
@@ -926,6 +971,13 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 			return nil, err
 		}
 	}
+	for name, query := range aq.withNamedPolicies {
+		if err := aq.loadPolicies(ctx, query, nodes,
+			func(n *Artifact) { n.appendNamedPolicies(name) },
+			func(n *Artifact, e *CertifyPolicy) { n.appendNamedPolicies(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range aq.withNamedIncludedInSboms {
 		if err := aq.loadIncludedInSboms(ctx, query, nodes,
 			func(n *Artifact) { n.appendNamedIncludedInSboms(name) },
@@ -1288,6 +1340,36 @@ func (aq *ArtifactQuery) loadPoc(ctx context.Context, query *PointOfContactQuery
 	}
 	return nil
 }
+func (aq *ArtifactQuery) loadPolicies(ctx context.Context, query *CertifyPolicyQuery, nodes []*Artifact, init func(*Artifact), assign func(*Artifact, *CertifyPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Artifact)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(certifypolicy.FieldArtifactID)
+	}
+	query.Where(predicate.CertifyPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(artifact.PoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArtifactID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "artifact_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (aq *ArtifactQuery) loadIncludedInSboms(ctx context.Context, query *BillOfMaterialsQuery, nodes []*Artifact, init func(*Artifact), assign func(*Artifact, *BillOfMaterials)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*Artifact)
@@ -1574,6 +1656,20 @@ func (aq *ArtifactQuery) WithNamedPoc(name string, opts ...func(*PointOfContactQ
 	return aq
 }
 
+// WithNamedPolicies tells the query-builder to eager-load the nodes that are connected to the "policies"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArtifactQuery) WithNamedPolicies(name string, opts ...func(*CertifyPolicyQuery)) *ArtifactQuery {
+	query := (&CertifyPolicyClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aq.withNamedPolicies == nil {
+		aq.withNamedPolicies = make(map[string]*CertifyPolicyQuery)
+	}
+	aq.withNamedPolicies[name] = query
+	return aq
+}
+
 // WithNamedIncludedInSboms tells the query-builder to eager-load the nodes that are connected to the "included_in_sboms"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (aq *ArtifactQuery) WithNamedIncludedInSboms(name string, opts ...func(*BillOfMaterialsQuery)) *ArtifactQuery {
@@ -14,6 +14,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
@@ -214,6 +215,21 @@ func (au *ArtifactUpdate) AddPoc(p ...*PointOfContact) *ArtifactUpdate {
 	return au.AddPocIDs(ids...)
 }
 
+// AddPolicyIDs adds the "policies" edge to the CertifyPolicy entity by IDs.
+func (au *ArtifactUpdate) AddPolicyIDs(ids ...uuid.UUID) *ArtifactUpdate {
+	au.mutation.AddPolicyIDs(ids...)
+	return au
+}
+
+// AddPolicies adds the "policies" edges to the CertifyPolicy entity.
+func (au *ArtifactUpdate) AddPolicies(c ...*CertifyPolicy) *ArtifactUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return au.AddPolicyIDs(ids...)
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
 func (au *ArtifactUpdate) AddIncludedInSbomIDs(ids ...uuid.UUID) *ArtifactUpdate {
 	au.mutation.AddIncludedInSbomIDs(ids...)
@@ -444,6 +460,27 @@ func (au *ArtifactUpdate) RemovePoc(p ...*PointOfContact) *ArtifactUpdate {
 	return au.RemovePocIDs(ids...)
 }
 
+// ClearPolicies clears all "policies" edges to the CertifyPolicy entity.
+func (au *ArtifactUpdate) ClearPolicies() *ArtifactUpdate {
+	au.mutation.ClearPolicies()
+	return au
+}
+
+// RemovePolicyIDs removes the "policies" edge to CertifyPolicy entities by IDs.
+func (au *ArtifactUpdate) RemovePolicyIDs(ids ...uuid.UUID) *ArtifactUpdate {
+	au.mutation.RemovePolicyIDs(ids...)
+	return au
+}
+
+// RemovePolicies removes "policies" edges to CertifyPolicy entities.
+func (au *ArtifactUpdate) RemovePolicies(c ...*CertifyPolicy) *ArtifactUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return au.RemovePolicyIDs(ids...)
+}
+
 // ClearIncludedInSboms clears all "included_in_sboms" edges to the BillOfMaterials entity.
 func (au *ArtifactUpdate) ClearIncludedInSboms() *ArtifactUpdate {
 	au.mutation.ClearIncludedInSboms()
@@ -957,6 +994,51 @@ func (au *ArtifactUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedPoliciesIDs(); len(nodes) > 0 && !au.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if au.mutation.IncludedInSbomsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1200,6 +1282,21 @@ func (auo *ArtifactUpdateOne) AddPoc(p ...*PointOfContact) *ArtifactUpdateOne {
 	return auo.AddPocIDs(ids...)
 }
 
+// AddPolicyIDs adds the "policies" edge to the CertifyPolicy entity by IDs.
+func (auo *ArtifactUpdateOne) AddPolicyIDs(ids ...uuid.UUID) *ArtifactUpdateOne {
+	auo.mutation.AddPolicyIDs(ids...)
+	return auo
+}
+
+// AddPolicies adds the "policies" edges to the CertifyPolicy entity.
+func (auo *ArtifactUpdateOne) AddPolicies(c ...*CertifyPolicy) *ArtifactUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return auo.AddPolicyIDs(ids...)
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
 func (auo *ArtifactUpdateOne) AddIncludedInSbomIDs(ids ...uuid.UUID) *ArtifactUpdateOne {
 	auo.mutation.AddIncludedInSbomIDs(ids...)
@@ -1430,6 +1527,27 @@ func (auo *ArtifactUpdateOne) RemovePoc(p ...*PointOfContact) *ArtifactUpdateOne
 	return auo.RemovePocIDs(ids...)
 }
 
+// ClearPolicies clears all "policies" edges to the CertifyPolicy entity.
+func (auo *ArtifactUpdateOne) ClearPolicies() *ArtifactUpdateOne {
+	auo.mutation.ClearPolicies()
+	return auo
+}
+
+// RemovePolicyIDs removes the "policies" edge to CertifyPolicy entities by IDs.
+func (auo *ArtifactUpdateOne) RemovePolicyIDs(ids ...uuid.UUID) *ArtifactUpdateOne {
+	auo.mutation.RemovePolicyIDs(ids...)
+	return auo
+}
+
+// RemovePolicies removes "policies" edges to CertifyPolicy entities.
+func (auo *ArtifactUpdateOne) RemovePolicies(c ...*CertifyPolicy) *ArtifactUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return auo.RemovePolicyIDs(ids...)
+}
+
 // ClearIncludedInSboms clears all "included_in_sboms" edges to the BillOfMaterials entity.
 func (auo *ArtifactUpdateOne) ClearIncludedInSboms() *ArtifactUpdateOne {
 	auo.mutation.ClearIncludedInSboms()
@@ -1973,6 +2091,51 @@ func (auo *ArtifactUpdateOne) sqlSave(ctx context.Context) (_node *Artifact, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedPoliciesIDs(); len(nodes) > 0 && !auo.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.PoliciesTable,
+			Columns: []string{artifact.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if auo.mutation.IncludedInSbomsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
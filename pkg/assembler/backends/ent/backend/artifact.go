@@ -277,6 +277,12 @@ func (b *EntBackend) artifactNeighbors(ctx context.Context, nodeID string, allow
 				getPointOfContactObject(q)
 			})
 	}
+	if allowedEdges[model.EdgeArtifactCertifyPolicy] {
+		query.
+			WithPolicies(func(q *ent.CertifyPolicyQuery) {
+				getCertifyPolicyObject(q)
+			})
+	}
 
 	artifacts, err := query.All(ctx)
 	if err != nil {
@@ -319,6 +325,9 @@ func (b *EntBackend) artifactNeighbors(ctx context.Context, nodeID string, allow
 		for _, foundPOC := range foundArt.Edges.Poc {
 			out = append(out, toModelPointOfContact(foundPOC))
 		}
+		for _, foundCP := range foundArt.Edges.Policies {
+			out = append(out, toModelCertifyPolicy(foundCP))
+		}
 	}
 
 	return out, nil
@@ -0,0 +1,92 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// knownAsOf keeps the rows of table known at asOf: those whose timeColumn is
+// not after asOf and that no later row with the same keys, also known at
+// asOf, supersedes. A nil asOf keeps every row.
+func knownAsOf(ctx context.Context, asOf *time.Time, table, timeColumn string, keys ...string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if asOf == nil {
+			return
+		}
+		s.Where(sql.LTE(s.C(timeColumn), *asOf))
+
+		later := sql.Table(table).As("later")
+		preds := []*sql.Predicate{
+			sql.ColumnsGT(later.C(timeColumn), s.C(timeColumn)),
+			sql.LTE(later.C(timeColumn), *asOf),
+		}
+		for _, k := range keys {
+			preds = append(preds, sql.P(func(b *sql.Builder) {
+				b.Ident(later.C(k)).WriteString(" IS NOT DISTINCT FROM ").Ident(s.C(k))
+			}))
+		}
+		// the subquery is not intercepted, so scope it like the outer query
+		if t := tenant.FromContext(ctx); t != "" {
+			preds = append(preds, sql.In(later.C(tenantField), "", t))
+		}
+		s.Where(sql.NotExists(sql.Select(later.C("id")).From(later).Where(sql.And(preds...))))
+	}
+}
+
+// hasSBOMKnownAsOf supersedes an SBOM by a later SBOM of the same subject.
+func hasSBOMKnownAsOf(ctx context.Context, asOf *time.Time) predicate.BillOfMaterials {
+	return knownAsOf(ctx, asOf, billofmaterials.Table, billofmaterials.FieldKnownSince,
+		billofmaterials.FieldPackageID, billofmaterials.FieldArtifactID)
+}
+
+// certifyVulnKnownAsOf supersedes the results of a scan by a later scan of
+// the same package by the same scanner.
+func certifyVulnKnownAsOf(ctx context.Context, asOf *time.Time) predicate.CertifyVuln {
+	return knownAsOf(ctx, asOf, certifyvuln.Table, certifyvuln.FieldTimeScanned,
+		certifyvuln.FieldPackageID, certifyvuln.FieldScannerURI)
+}
+
+// certifyVexKnownAsOf supersedes a VEX statement by a later statement about
+// the same subject and vulnerability.
+func certifyVexKnownAsOf(ctx context.Context, asOf *time.Time) predicate.CertifyVex {
+	return knownAsOf(ctx, asOf, certifyvex.Table, certifyvex.FieldKnownSince,
+		certifyvex.FieldPackageID, certifyvex.FieldArtifactID, certifyvex.FieldVulnerabilityID)
+}
+
+// certifyLegalKnownAsOf supersedes a legal certification by a later one of
+// the same subject.
+func certifyLegalKnownAsOf(ctx context.Context, asOf *time.Time) predicate.CertifyLegal {
+	return knownAsOf(ctx, asOf, certifylegal.Table, certifylegal.FieldTimeScanned,
+		certifylegal.FieldPackageID, certifylegal.FieldSourceID)
+}
+
+// scorecardKnownAsOf supersedes a scorecard by a later scorecard of the same
+// source.
+func scorecardKnownAsOf(ctx context.Context, asOf *time.Time) predicate.CertifyScorecard {
+	return knownAsOf(ctx, asOf, certifyscorecard.Table, certifyscorecard.FieldTimeScanned,
+		certifyscorecard.FieldSourceID)
+}
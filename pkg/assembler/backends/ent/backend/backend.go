@@ -115,6 +115,7 @@ func GetBackend(client *ent.Client) (backends.Backend, error) {
 		return nil, fmt.Errorf("failed to ping db: %w", err)
 	}
 
+	useTenantScoping(client)
 	be.client = client
 
 	return be, nil
@@ -311,6 +311,7 @@ func (b *EntBackend) IngestCertifyGoods(ctx context.Context, subjects model.Pack
 
 func certifyConflictColumns() []string {
 	return []string{
+		certification.FieldTenant,
 		certification.FieldType,
 		certification.FieldCollector,
 		certification.FieldOrigin,
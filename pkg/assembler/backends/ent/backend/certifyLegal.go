@@ -18,6 +18,7 @@ package backend
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
@@ -40,7 +41,7 @@ func bulkCertifyLegalGlobalID(ids []string) []string {
 	return toGlobalIDs(certifylegal.Table, ids)
 }
 
-func (b *EntBackend) CertifyLegalList(ctx context.Context, spec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+func (b *EntBackend) CertifyLegalList(ctx context.Context, spec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error) {
 	var afterCursor *entgql.Cursor[uuid.UUID]
 
 	if after != nil {
@@ -58,7 +59,7 @@ func (b *EntBackend) CertifyLegalList(ctx context.Context, spec model.CertifyLeg
 	}
 
 	certLegalQuery := b.client.CertifyLegal.Query().
-		Where(certifyLegalQuery(spec))
+		Where(certifyLegalQuery(spec), certifyLegalKnownAsOf(ctx, asOf))
 
 	certLegalConn, err := getCertifyLegalObject(certLegalQuery).
 		Paginate(ctx, afterCursor, first, nil, nil)
@@ -95,12 +96,12 @@ func (b *EntBackend) CertifyLegalList(ctx context.Context, spec model.CertifyLeg
 	}
 }
 
-func (b *EntBackend) CertifyLegal(ctx context.Context, spec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+func (b *EntBackend) CertifyLegal(ctx context.Context, spec *model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error) {
 	if spec == nil {
 		spec = &model.CertifyLegalSpec{}
 	}
 	certLegalQuery := b.client.CertifyLegal.Query().
-		Where(certifyLegalQuery(*spec))
+		Where(certifyLegalQuery(*spec), certifyLegalKnownAsOf(ctx, asOf))
 
 	records, err := getCertifyLegalObject(certLegalQuery).
 		All(ctx)
@@ -141,6 +142,7 @@ func (b *EntBackend) IngestCertifyLegals(ctx context.Context, subjects model.Pac
 
 func certifyLegalConflictColumns() []string {
 	return []string{
+		certifylegal.FieldTenant,
 		certifylegal.FieldDeclaredLicense,
 		certifylegal.FieldJustification,
 		certifylegal.FieldTimeScanned,
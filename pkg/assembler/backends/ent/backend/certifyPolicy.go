@@ -0,0 +1,346 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func certifyPolicyGlobalID(id string) string {
+	return toGlobalID(certifypolicy.Table, id)
+}
+
+func bulkCertifyPolicyGlobalID(ids []string) []string {
+	return toGlobalIDs(certifypolicy.Table, ids)
+}
+
+func (b *EntBackend) CertifyPolicyList(ctx context.Context, spec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error) {
+	var afterCursor *entgql.Cursor[uuid.UUID]
+
+	if after != nil {
+		globalID := fromGlobalID(*after)
+		if globalID.nodeType != certifypolicy.Table {
+			return nil, fmt.Errorf("after cursor is not type certifyPolicy but type: %s", globalID.nodeType)
+		}
+		afterUUID, err := uuid.Parse(globalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse global ID with error: %w", err)
+		}
+		afterCursor = &ent.Cursor{ID: afterUUID}
+	} else {
+		afterCursor = nil
+	}
+
+	cpQuery := b.client.CertifyPolicy.Query().
+		Where(certifyPolicyQuery(spec))
+
+	cpConn, err := getCertifyPolicyObject(cpQuery).
+		Paginate(ctx, afterCursor, first, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed certifyPolicy query with error: %w", err)
+	}
+
+	// if not found return nil
+	if cpConn == nil {
+		return nil, nil
+	}
+
+	var edges []*model.CertifyPolicyEdge
+	for _, edge := range cpConn.Edges {
+		edges = append(edges, &model.CertifyPolicyEdge{
+			Cursor: certifyPolicyGlobalID(edge.Cursor.ID.String()),
+			Node:   toModelCertifyPolicy(edge.Node),
+		})
+	}
+
+	if cpConn.PageInfo.StartCursor != nil {
+		return &model.CertifyPolicyConnection{
+			TotalCount: cpConn.TotalCount,
+			PageInfo: &model.PageInfo{
+				HasNextPage: cpConn.PageInfo.HasNextPage,
+				StartCursor: ptrfrom.String(certifyPolicyGlobalID(cpConn.PageInfo.StartCursor.ID.String())),
+				EndCursor:   ptrfrom.String(certifyPolicyGlobalID(cpConn.PageInfo.EndCursor.ID.String())),
+			},
+			Edges: edges,
+		}, nil
+	} else {
+		// if not found return nil
+		return nil, nil
+	}
+}
+
+func (b *EntBackend) CertifyPolicy(ctx context.Context, spec *model.CertifyPolicySpec) ([]*model.CertifyPolicy, error) {
+	if spec == nil {
+		spec = &model.CertifyPolicySpec{}
+	}
+
+	cpQuery := b.client.CertifyPolicy.Query().
+		Where(certifyPolicyQuery(*spec))
+
+	records, err := getCertifyPolicyObject(cpQuery).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed certifyPolicy query with error: %w", err)
+	}
+
+	return collect(records, toModelCertifyPolicy), nil
+}
+
+func certifyPolicyQuery(spec model.CertifyPolicySpec) predicate.CertifyPolicy {
+	predicates := []predicate.CertifyPolicy{
+		optionalPredicate(spec.ID, IDEQ),
+		optionalPredicate(spec.Verifier, certifypolicy.VerifierEQ),
+		optionalPredicate(spec.PolicyURI, certifypolicy.PolicyURIEQ),
+		optionalPredicate(spec.PolicyDigest, certifypolicy.PolicyDigestEQ),
+		optionalPredicate(spec.TimeVerified, certifypolicy.TimeVerifiedGTE),
+		optionalPredicate(spec.Origin, certifypolicy.OriginEQ),
+		optionalPredicate(spec.Collector, certifypolicy.CollectorEQ),
+		optionalPredicate(spec.DocumentRef, certifypolicy.DocumentRefEQ),
+	}
+
+	if spec.Result != nil {
+		predicates = append(predicates, certifypolicy.ResultEQ(certifypolicy.Result(*spec.Result)))
+	}
+
+	for _, level := range spec.VerifiedLevels {
+		predicates = append(predicates, func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(certifypolicy.FieldVerifiedLevels, level))
+		})
+	}
+
+	if spec.Subject != nil {
+		if spec.Subject.ID != nil {
+			predicates = append(predicates,
+				optionalPredicate(spec.Subject.ID, artifactIDEQ))
+		} else {
+			predicates = append(predicates,
+				certifypolicy.HasArtifactWith(artifactQueryPredicates(spec.Subject)))
+		}
+	}
+
+	return certifypolicy.And(predicates...)
+}
+
+// getCertifyPolicyObject is used recreate the certifyPolicy object be eager loading the edges
+func getCertifyPolicyObject(q *ent.CertifyPolicyQuery) *ent.CertifyPolicyQuery {
+	return q.
+		WithArtifact()
+}
+
+func (b *EntBackend) IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error) {
+	id, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		return upsertCertifyPolicy(ctx, ent.TxFromContext(ctx), subject, certifyPolicy)
+	})
+	if txErr != nil {
+		return "", txErr
+	}
+
+	return certifyPolicyGlobalID(*id), nil
+}
+
+func (b *EntBackend) IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error) {
+	funcName := "IngestCertifyPolicies"
+	ids, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*[]string, error) {
+		client := ent.TxFromContext(ctx)
+		slc, err := upsertBulkCertifyPolicy(ctx, client, subjects, certifyPolicies)
+		if err != nil {
+			return nil, err
+		}
+		return slc, nil
+	})
+	if txErr != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+	}
+
+	return bulkCertifyPolicyGlobalID(*ids), nil
+}
+
+func certifyPolicyConflictColumns() []string {
+	return []string{
+		certifypolicy.FieldTenant,
+		certifypolicy.FieldArtifactID,
+		certifypolicy.FieldVerifier,
+		certifypolicy.FieldPolicyURI,
+		certifypolicy.FieldPolicyDigest,
+		certifypolicy.FieldResult,
+		certifypolicy.FieldTimeVerified,
+		certifypolicy.FieldOrigin,
+		certifypolicy.FieldCollector,
+		certifypolicy.FieldDocumentRef,
+	}
+}
+
+func upsertBulkCertifyPolicy(ctx context.Context, tx *ent.Tx, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) (*[]string, error) {
+	ids := make([]string, 0)
+
+	batches := chunk(certifyPolicies, MaxBatchSize)
+
+	index := 0
+	for _, cps := range batches {
+		creates := make([]*ent.CertifyPolicyCreate, len(cps))
+		for i, cp := range cps {
+			cp := cp
+			var err error
+			var cpID *uuid.UUID
+			creates[i], cpID, err = generateCertifyPolicyCreate(ctx, tx, subjects[index], cp)
+			if err != nil {
+				return nil, gqlerror.Errorf("generateCertifyPolicyCreate :: %s", err)
+			}
+			ids = append(ids, cpID.String())
+			index++
+		}
+
+		err := tx.CertifyPolicy.CreateBulk(creates...).
+			OnConflict(
+				sql.ConflictColumns(certifyPolicyConflictColumns()...),
+			).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "bulk upsert certifyPolicy node")
+		}
+	}
+
+	return &ids, nil
+}
+
+func generateCertifyPolicyCreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArtifactInput, certifyPolicy *model.CertifyPolicyInputSpec) (*ent.CertifyPolicyCreate, *uuid.UUID, error) {
+	cpCreate := tx.CertifyPolicy.Create()
+
+	levels := helper.SortAndRemoveDups(certifyPolicy.VerifiedLevels)
+	cpCreate.
+		SetVerifier(certifyPolicy.Verifier).
+		SetPolicyURI(certifyPolicy.PolicyURI).
+		SetPolicyDigest(certifyPolicy.PolicyDigest).
+		SetResult(certifypolicy.Result(certifyPolicy.Result)).
+		SetVerifiedLevels(levels).
+		SetTimeVerified(certifyPolicy.TimeVerified.UTC()).
+		SetOrigin(certifyPolicy.Origin).
+		SetCollector(certifyPolicy.Collector).
+		SetDocumentRef(certifyPolicy.DocumentRef)
+
+	var artifactID uuid.UUID
+	if subject.ArtifactID != nil {
+		var err error
+		artGlobalID := fromGlobalID(*subject.ArtifactID)
+		artifactID, err = uuid.Parse(artGlobalID.id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+		}
+	} else {
+		foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*subject.ArtifactInput)).Only(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query for artifact")
+		}
+		artifactID = foundArt.ID
+	}
+	cpCreate.SetArtifactID(artifactID)
+
+	cpID := guacCertifyPolicyKey(artifactID.String(), levels, certifyPolicy)
+	cpCreate.SetID(cpID)
+
+	return cpCreate, &cpID, nil
+}
+
+func upsertCertifyPolicy(ctx context.Context, tx *ent.Tx, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (*string, error) {
+	cpCreate, _, err := generateCertifyPolicyCreate(ctx, tx, &subject, &certifyPolicy)
+	if err != nil {
+		return nil, gqlerror.Errorf("generateCertifyPolicyCreate :: %s", err)
+	}
+
+	if id, err := cpCreate.
+		OnConflict(
+			sql.ConflictColumns(certifyPolicyConflictColumns()...),
+		).
+		Ignore().
+		ID(ctx); err != nil {
+
+		return nil, errors.Wrap(err, "upsert certifyPolicy node")
+	} else {
+		return ptrfrom.String(id.String()), nil
+	}
+}
+
+// guacCertifyPolicyKey generates an uuid based on the hash of the inputspec and inputs. certifyPolicy ID has to be set for bulk ingestion
+// when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
+// a new ID for certifyPolicy node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
+// "Default" func to generate a new UUID
+func guacCertifyPolicyKey(artifactID string, sortedLevels []string, cp *model.CertifyPolicyInputSpec) uuid.UUID {
+	cpIDString := fmt.Sprintf("%s::%s::%s::%s::%s::%s::%s::%s::%s::%s?", artifactID, cp.Verifier, cp.PolicyURI, cp.PolicyDigest,
+		cp.Result, strings.Join(sortedLevels, ","), cp.TimeVerified.UTC(), cp.Origin, cp.Collector, cp.DocumentRef)
+
+	return generateUUIDKey([]byte(cpIDString))
+}
+
+func toModelCertifyPolicy(cp *ent.CertifyPolicy) *model.CertifyPolicy {
+	levels := cp.VerifiedLevels
+	if levels == nil {
+		levels = []string{}
+	}
+	return &model.CertifyPolicy{
+		ID:             certifyPolicyGlobalID(cp.ID.String()),
+		Subject:        toModelArtifact(cp.Edges.Artifact),
+		Verifier:       cp.Verifier,
+		PolicyURI:      cp.PolicyURI,
+		PolicyDigest:   cp.PolicyDigest,
+		Result:         model.PolicyVerificationResult(cp.Result),
+		VerifiedLevels: levels,
+		TimeVerified:   cp.TimeVerified,
+		Origin:         cp.Origin,
+		Collector:      cp.Collector,
+		DocumentRef:    cp.DocumentRef,
+	}
+}
+
+func (b *EntBackend) certifyPolicyNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
+	var out []model.Node
+
+	query := b.client.CertifyPolicy.Query().
+		Where(certifyPolicyQuery(model.CertifyPolicySpec{ID: &nodeID}))
+
+	if allowedEdges[model.EdgeCertifyPolicyArtifact] {
+		query.
+			WithArtifact()
+	}
+
+	cps, err := query.All(ctx)
+	if err != nil {
+		return []model.Node{}, fmt.Errorf("failed to query for certifyPolicy with node ID: %s with error: %w", nodeID, err)
+	}
+
+	for _, foundCP := range cps {
+		if foundCP.Edges.Artifact != nil {
+			out = append(out, toModelArtifact(foundCP.Edges.Artifact))
+		}
+	}
+
+	return out, nil
+}
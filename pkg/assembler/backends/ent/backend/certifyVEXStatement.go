@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
@@ -44,6 +45,7 @@ func bulkCertifyVEXGlobalID(ids []string) []string {
 
 func certifyVexConflictColumns() []string {
 	return []string{
+		certifyvex.FieldTenant,
 		certifyvex.FieldKnownSince,
 		certifyvex.FieldStatus,
 		certifyvex.FieldJustification,
@@ -468,7 +470,7 @@ func upsertBulkVEX(ctx context.Context, tx *ent.Tx, subjects model.PackageOrArti
 	return &ids, nil
 }
 
-func (b *EntBackend) CertifyVEXStatementList(ctx context.Context, spec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
+func (b *EntBackend) CertifyVEXStatementList(ctx context.Context, spec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error) {
 	var afterCursor *entgql.Cursor[uuid.UUID]
 
 	if after != nil {
@@ -486,7 +488,7 @@ func (b *EntBackend) CertifyVEXStatementList(ctx context.Context, spec model.Cer
 	}
 
 	vexQuery := b.client.CertifyVex.Query().
-		Where(certifyVexPredicate(spec))
+		Where(certifyVexPredicate(spec), certifyVexKnownAsOf(ctx, asOf))
 
 	certVEXConn, err := getVEXObject(vexQuery).
 		Paginate(ctx, afterCursor, first, nil, nil)
@@ -523,13 +525,13 @@ func (b *EntBackend) CertifyVEXStatementList(ctx context.Context, spec model.Cer
 	}
 }
 
-func (b *EntBackend) CertifyVEXStatement(ctx context.Context, spec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+func (b *EntBackend) CertifyVEXStatement(ctx context.Context, spec *model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error) {
 	if spec == nil {
 		spec = &model.CertifyVEXStatementSpec{}
 	}
 
 	vexQuery := b.client.CertifyVex.Query().
-		Where(certifyVexPredicate(*spec))
+		Where(certifyVexPredicate(*spec), certifyVexKnownAsOf(ctx, asOf))
 
 	records, err := getVEXObject(vexQuery).
 		All(ctx)
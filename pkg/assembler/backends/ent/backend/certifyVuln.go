@@ -18,6 +18,7 @@ package backend
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
@@ -57,6 +58,7 @@ func (b *EntBackend) deleteCertifyVuln(ctx context.Context, certifyVulnID uuid.U
 
 func certifyVulnConflictColumns() []string {
 	return []string{
+		certifyvuln.FieldTenant,
 		certifyvuln.FieldPackageID,
 		certifyvuln.FieldVulnerabilityID,
 		certifyvuln.FieldCollector,
@@ -215,7 +217,7 @@ func upsertBulkCertifyVuln(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPk
 	return &ids, nil
 }
 
-func (b *EntBackend) CertifyVulnList(ctx context.Context, spec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+func (b *EntBackend) CertifyVulnList(ctx context.Context, spec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error) {
 	var afterCursor *entgql.Cursor[uuid.UUID]
 
 	if after != nil {
@@ -233,7 +235,7 @@ func (b *EntBackend) CertifyVulnList(ctx context.Context, spec model.CertifyVuln
 	}
 
 	certVulnQuery := b.client.CertifyVuln.Query().
-		Where(certifyVulnPredicate(spec))
+		Where(certifyVulnPredicate(spec), certifyVulnKnownAsOf(ctx, asOf))
 
 	certVulnConn, err := getCertVulnObject(certVulnQuery).
 		Paginate(ctx, afterCursor, first, nil, nil)
@@ -270,12 +272,12 @@ func (b *EntBackend) CertifyVulnList(ctx context.Context, spec model.CertifyVuln
 	}
 }
 
-func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+func (b *EntBackend) CertifyVuln(ctx context.Context, spec *model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error) {
 	if spec == nil {
 		spec = &model.CertifyVulnSpec{}
 	}
 	certVulnQuery := b.client.CertifyVuln.Query().
-		Where(certifyVulnPredicate(*spec))
+		Where(certifyVulnPredicate(*spec), certifyVulnKnownAsOf(ctx, asOf))
 
 	records, err := getCertVulnObject(certVulnQuery).
 		All(ctx)
@@ -0,0 +1,238 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// closureLink is a link followed by DependencyClosure, from the package
+// version or artifact src depends on to the one it depends on, or between
+// equivalent ones.
+type closureLink struct {
+	// from is the FROM clause of the rows making the links, aliased as t
+	from string
+	// src and dst are both ends of the links
+	src, dst pathEnd
+	// where filters the rows, if not empty
+	where string
+	// tenant is the SQL expression of the tenant of the rows, or empty for
+	// rows that are not partitioned by tenant
+	tenant     string
+	equivalent bool
+}
+
+// closureLinks returns the links of IsDependency, of the software included in
+// HasSBOM, of the materials of HasSLSA, and the equivalences of IsOccurrence
+// and HashEqual, which are followed both ways.
+func closureLinks() []closureLink {
+	bomFrom := func(joinTable string, primaryKey []string) string {
+		return fmt.Sprintf("%s t JOIN %s b ON b.id = t.%s", joinTable, billofmaterials.Table, primaryKey[0])
+	}
+	// the subject of an SBOM is either a package version or an artifact,
+	// whose partial indexes hold the rows where the other one is NULL
+	bomSubjects := []struct {
+		end   pathEnd
+		where string
+	}{
+		{uuidEnd(packageversion.Table, "b."+billofmaterials.FieldPackageID),
+			"b." + billofmaterials.FieldPackageID + " IS NOT NULL AND b." + billofmaterials.FieldArtifactID + " IS NULL"},
+		{uuidEnd(artifact.Table, "b."+billofmaterials.FieldArtifactID),
+			"b." + billofmaterials.FieldPackageID + " IS NULL AND b." + billofmaterials.FieldArtifactID + " IS NOT NULL"},
+	}
+	links := []closureLink{{
+		from:   dependency.Table + " t",
+		src:    rowEnd(packageversion.Table, dependency.FieldPackageID),
+		dst:    rowEnd(packageversion.Table, dependency.FieldDependentPackageVersionID),
+		tenant: tenantColumn(dependency.Table, "t"),
+	}, {
+		from: fmt.Sprintf("%s t JOIN %s s ON s.id = t.%s",
+			slsaattestation.BuiltFromTable, slsaattestation.Table, slsaattestation.BuiltFromPrimaryKey[0]),
+		src:    uuidEnd(artifact.Table, "s."+slsaattestation.FieldSubjectID),
+		dst:    rowEnd(artifact.Table, slsaattestation.BuiltFromPrimaryKey[1]),
+		tenant: tenantColumn(slsaattestation.Table, "s"),
+	}}
+	for _, subject := range bomSubjects {
+		links = append(links, closureLink{
+			from:   bomFrom(billofmaterials.IncludedSoftwarePackagesTable, billofmaterials.IncludedSoftwarePackagesPrimaryKey),
+			src:    subject.end,
+			dst:    rowEnd(packageversion.Table, billofmaterials.IncludedSoftwarePackagesPrimaryKey[1]),
+			where:  subject.where,
+			tenant: tenantColumn(billofmaterials.Table, "b"),
+		}, closureLink{
+			from:   bomFrom(billofmaterials.IncludedSoftwareArtifactsTable, billofmaterials.IncludedSoftwareArtifactsPrimaryKey),
+			src:    subject.end,
+			dst:    rowEnd(artifact.Table, billofmaterials.IncludedSoftwareArtifactsPrimaryKey[1]),
+			where:  subject.where,
+			tenant: tenantColumn(billofmaterials.Table, "b"),
+		})
+	}
+
+	equivalences := []closureLink{{
+		from:   occurrence.Table + " t",
+		src:    rowEnd(packageversion.Table, occurrence.FieldPackageID),
+		dst:    rowEnd(artifact.Table, occurrence.FieldArtifactID),
+		where:  "t." + occurrence.FieldPackageID + " IS NOT NULL AND t." + occurrence.FieldSourceID + " IS NULL",
+		tenant: tenantColumn(occurrence.Table, "t"),
+	}, {
+		from:   hashequal.Table + " t",
+		src:    rowEnd(artifact.Table, hashequal.FieldArtID),
+		dst:    rowEnd(artifact.Table, hashequal.FieldEqualArtID),
+		tenant: tenantColumn(hashequal.Table, "t"),
+	}}
+	for _, l := range equivalences {
+		l.equivalent = true
+		reverse := l
+		reverse.src, reverse.dst = l.dst, l.src
+		links = append(links, l, reverse)
+	}
+	return links
+}
+
+func (b *EntBackend) DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	out, err := b.closure(ctx, subject, maxDepth, false)
+	if err != nil {
+		return nil, fmt.Errorf("DependencyClosure :: %w", err)
+	}
+	return out, nil
+}
+
+func (b *EntBackend) DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	out, err := b.closure(ctx, subject, maxDepth, true)
+	if err != nil {
+		return nil, fmt.Errorf("DependentClosure :: %w", err)
+	}
+	return out, nil
+}
+
+// closure reads every link reachable from subject with a recursive query,
+// then computes the depth of each node from them. The query is written for
+// PostgreSQL, on other databases the closure is not implemented so that the
+// clients walk the graph instead.
+func (b *EntBackend) closure(ctx context.Context, subject string, maxDepth *int, dependents bool) ([]*model.ClosureEntry, error) {
+	if d := b.client.Dialect(); d != dialect.Postgres {
+		return nil, fmt.Errorf("%w: closure query on %s", backends.ErrNotImplemented, d)
+	}
+	gID := fromGlobalID(subject)
+	switch gID.nodeType {
+	case packageversion.Table, artifact.Table:
+	default:
+		return nil, fmt.Errorf("subject %s is not a package version or an artifact", subject)
+	}
+	id, err := uuid.Parse(gID.id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject ID %s: %w", subject, err)
+	}
+
+	args := []any{gID.nodeType, id.String(), nil}
+	if maxDepth != nil {
+		args[2] = *maxDepth
+	}
+	tenantID := tenant.FromContext(ctx)
+	if tenantID != "" {
+		// a tenant sees its own predicates and the global ones
+		args = append(args, tenantID)
+	}
+
+	rows, err := b.client.QueryContext(ctx, closureQuery(dependents, tenantID != ""), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query closure of %s: %w", subject, err)
+	}
+	defer rows.Close()
+
+	links := map[string][]helper.ClosureLink{}
+	for rows.Next() {
+		var src string
+		var l helper.ClosureLink
+		if err := rows.Scan(&src, &l.To, &l.Equivalent); err != nil {
+			return nil, fmt.Errorf("failed to read closure link: %w", err)
+		}
+		links[src] = append(links[src], l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query closure of %s: %w", subject, err)
+	}
+
+	return helper.Closure(toGlobalID(gID.nodeType, id.String()), maxDepth, func(id string) ([]helper.ClosureLink, error) {
+		return links[id], nil
+	})
+}
+
+// closureQuery returns the recursive query of the links reachable from the
+// subject, which takes the type and uuid of the subject, the maximum depth or
+// NULL and, when tenantScoped is set, the tenant as parameters. The links to
+// dependents are the links to dependencies reversed.
+//
+// Each step looks up the links of the nodes it reached by their uuid, so that
+// the foreign key indexes are used, and stops past the maximum depth. The
+// query uses LATERAL joins and PostgreSQL casts, it only runs on PostgreSQL.
+func closureQuery(dependents bool, tenantScoped bool) string {
+	var selects []string
+	for _, l := range closureLinks() {
+		src, dst := l.src, l.dst
+		if dependents && !l.equivalent {
+			src, dst = dst, src
+		}
+		conds := []string{"w.type = " + quoteSQL(src.nodeType), src.match}
+		if l.where != "" {
+			conds = append(conds, l.where)
+		}
+		if l.tenant != "" && tenantScoped {
+			conds = append(conds, l.tenant+" IN ('', $4)")
+		}
+		selects = append(selects, fmt.Sprintf("SELECT %s::text, (%s)::uuid, %t FROM %s WHERE %s",
+			quoteSQL(dst.nodeType), dst.id, l.equivalent, l.from, strings.Join(conds, " AND ")))
+	}
+	links := func(indent string) string {
+		return strings.Join(selects, "\n"+indent+"UNION ALL ")
+	}
+
+	// The depth is only counted with a maximum depth, as equivalences cost
+	// nothing. UNION rather than UNION ALL visits each node once per depth,
+	// which ends the recursion on cycles.
+	return `WITH RECURSIVE reach(type, id, d) AS (
+	SELECT $1::text, $2::uuid, 0
+	UNION
+	SELECT n.type, n.id, CASE WHEN $3::int IS NULL THEN 0 ELSE w.d + (NOT n.equivalent)::int END
+	FROM reach w
+	CROSS JOIN LATERAL (
+		` + links("\t\t") + `
+	) n(type, id, equivalent)
+	WHERE $3::int IS NULL OR w.d + (NOT n.equivalent)::int <= $3::int
+)
+SELECT w.type || ':' || w.id::text, n.type || ':' || n.id::text, n.equivalent
+FROM (SELECT DISTINCT type, id FROM reach) w
+CROSS JOIN LATERAL (
+	` + links("\t") + `
+) n(type, id, equivalent)
+ORDER BY 1, 2`
+}
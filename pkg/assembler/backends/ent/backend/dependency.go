@@ -155,9 +155,11 @@ func (b *EntBackend) IngestDependencies(ctx context.Context, pkgs []*model.IDorP
 
 func dependencyConflictColumns() []string {
 	return []string{
+		dependency.FieldTenant,
 		dependency.FieldPackageID,
 		dependency.FieldDependentPackageVersionID,
 		dependency.FieldDependencyType,
+		dependency.FieldVersionRange,
 		dependency.FieldJustification,
 		dependency.FieldOrigin,
 		dependency.FieldCollector,
@@ -232,6 +234,7 @@ func generateDependencyCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPk
 	dependencyCreate.
 		SetPackageID(pkgVersionID).
 		SetDependencyType(dependencyTypeToEnum(dep.DependencyType)).
+		SetVersionRange(stringOrEmpty(dep.VersionRange)).
 		SetJustification(dep.Justification).
 		SetOrigin(dep.Origin).
 		SetCollector(dep.Collector).
@@ -312,6 +315,7 @@ func isDependencyQuery(filter *model.IsDependencySpec) predicate.Dependency {
 
 	predicates := []predicate.Dependency{
 		optionalPredicate(filter.ID, IDEQ),
+		optionalPredicate(filter.VersionRange, dependency.VersionRange),
 		optionalPredicate(filter.Justification, dependency.Justification),
 		optionalPredicate(filter.Origin, dependency.Origin),
 		optionalPredicate(filter.Collector, dependency.Collector),
@@ -342,7 +346,13 @@ func isDependencyQuery(filter *model.IsDependencySpec) predicate.Dependency {
 }
 
 func canonicalDependencyString(dep model.IsDependencyInputSpec) string {
-	return fmt.Sprintf("%s::%s::%s::%s:%s", dep.DependencyType.String(), dep.Justification, dep.Origin, dep.Collector, dep.DocumentRef)
+	s := fmt.Sprintf("%s::%s::%s::%s:%s", dep.DependencyType.String(), dep.Justification, dep.Origin, dep.Collector, dep.DocumentRef)
+	// the version range is only added when known to keep the keys of
+	// dependencies recorded without one unchanged
+	if dep.VersionRange != nil && *dep.VersionRange != "" {
+		s += "::" + *dep.VersionRange
+	}
+	return s
 }
 
 func guacDependencyKey(pkgVersionID *string, depPkgVersionID *string, dep model.IsDependencyInputSpec) (*uuid.UUID, error) {
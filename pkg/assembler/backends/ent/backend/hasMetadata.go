@@ -197,6 +197,7 @@ func hasMetadataPredicate(filter *model.HasMetadataSpec) predicate.HasMetadata {
 
 func hasMetadataConflictColumns() []string {
 	return []string{
+		hasmetadata.FieldTenant,
 		hasmetadata.FieldKey,
 		hasmetadata.FieldValue,
 		hasmetadata.FieldJustification,
@@ -178,6 +178,7 @@ func (b *EntBackend) IngestHashEquals(ctx context.Context, artifacts []*model.ID
 
 func hasEqualConflictColumns() []string {
 	return []string{
+		hashequal.FieldTenant,
 		hashequal.FieldArtID,
 		hashequal.FieldEqualArtID,
 		hashequal.FieldArtifactsHash,
@@ -243,6 +243,8 @@ func getIDfromNode(node model.Node) (string, error) {
 		return v.ID, nil
 	case *model.CertifyVuln:
 		return v.ID, nil
+	case *model.CertifyPolicy:
+		return v.ID, nil
 	case *model.HashEqual:
 		return v.ID, nil
 	case *model.HasMetadata:
@@ -265,6 +267,8 @@ func getIDfromNode(node model.Node) (string, error) {
 		return v.ID, nil
 	case *model.VulnerabilityMetadata:
 		return v.ID, nil
+	case *model.VulnerabilityRange:
+		return v.ID, nil
 	default:
 		return "", fmt.Errorf("unknown type: %v", v)
 	}
@@ -46,6 +46,7 @@ func GetReadOnlyClient(ctx context.Context, options *BackendOptions) (*ent.Clien
 	if err != nil {
 		return nil, err
 	}
+	useTenantScoping(client)
 	// https://entgo.io/docs/hooks/#mutation
 	client.Use(hook.Reject(
 		ent.OpCreate | ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne,
@@ -22,10 +22,12 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -44,6 +46,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -167,7 +170,7 @@ func (b *EntBackend) bfs(ctx context.Context, from, to string, maxLength int, us
 }
 
 func (b *EntBackend) NeighborsList(ctx context.Context, node string, usingOnly []model.Edge, after *string, first *int) (*model.NeighborConnection, error) {
-	return nil, fmt.Errorf("not implemented: NeighborsList")
+	return nil, fmt.Errorf("%w: NeighborsList", backends.ErrNotImplemented)
 }
 
 func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []model.Edge) ([]model.Node, error) {
@@ -254,6 +257,11 @@ func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []m
 		if err != nil {
 			return []model.Node{}, fmt.Errorf("failed to get certifyLegal neighbors with id: %s with error: %w", nodeID, err)
 		}
+	case certifypolicy.Table:
+		neighbors, err = b.certifyPolicyNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return []model.Node{}, fmt.Errorf("failed to get certifyPolicy neighbors with id: %s with error: %w", nodeID, err)
+		}
 	case certifyscorecard.Table:
 		neighbors, err = b.certifyScorecardNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
@@ -324,6 +332,11 @@ func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []m
 		if err != nil {
 			return []model.Node{}, fmt.Errorf("failed to get neighbors with id: %s with error: %w", nodeID, err)
 		}
+	case vulnerabilityrange.Table:
+		neighbors, err = b.vulnerabilityRangeNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return []model.Node{}, fmt.Errorf("failed to get vulnerabilityRange neighbors with id: %s with error: %w", nodeID, err)
+		}
 	default:
 		return nil, fmt.Errorf("unknown ID for neighbors query: %s", nodeID)
 	}
@@ -458,7 +471,7 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		}
 		return certs[0], nil
 	case certifylegal.Table:
-		legals, err := b.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		legals, err := b.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: ptrfrom.String(foundGlobalID.id)}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for CertifyLegal via ID: %s, with error: %w", foundGlobalID.id, err)
 		}
@@ -466,8 +479,17 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 			return nil, fmt.Errorf("ID returned multiple CertifyLegal nodes %s", foundGlobalID.id)
 		}
 		return legals[0], nil
+	case certifypolicy.Table:
+		policies, err := b.CertifyPolicy(ctx, &model.CertifyPolicySpec{ID: ptrfrom.String(foundGlobalID.id)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query for CertifyPolicy via ID: %s, with error: %w", foundGlobalID.id, err)
+		}
+		if len(policies) != 1 {
+			return nil, fmt.Errorf("ID returned multiple CertifyPolicy nodes %s", foundGlobalID.id)
+		}
+		return policies[0], nil
 	case certifyscorecard.Table:
-		scores, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		scores, err := b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: ptrfrom.String(foundGlobalID.id)}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for scorecard via ID: %s, with error: %w", foundGlobalID.id, err)
 		}
@@ -476,7 +498,7 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		}
 		return scores[0], nil
 	case certifyvex.Table:
-		vexs, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		vexs, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: ptrfrom.String(foundGlobalID.id)}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for CertifyVEXStatement via ID: %s, with error: %w", foundGlobalID.id, err)
 		}
@@ -485,7 +507,7 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		}
 		return vexs[0], nil
 	case certifyvuln.Table:
-		vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		vulns, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: ptrfrom.String(foundGlobalID.id)}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for CertifyVuln via ID: %s, with error: %w", foundGlobalID.id, err)
 		}
@@ -512,7 +534,7 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 		}
 		return hms[0], nil
 	case billofmaterials.Table:
-		hbs, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		hbs, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: ptrfrom.String(foundGlobalID.id)}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query for HasSBOM via ID: %s, with error: %w", foundGlobalID.id, err)
 		}
@@ -592,6 +614,15 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 			return nil, fmt.Errorf("ID returned multiple VulnerabilityMetadata nodes %s", foundGlobalID.id)
 		}
 		return vms[0], nil
+	case vulnerabilityrange.Table:
+		vrs, err := b.VulnerabilityRange(ctx, &model.VulnerabilityRangeSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query for VulnerabilityRange via ID: %s, with error: %w", foundGlobalID.id, err)
+		}
+		if len(vrs) != 1 {
+			return nil, fmt.Errorf("ID returned multiple VulnerabilityRange nodes %s", foundGlobalID.id)
+		}
+		return vrs[0], nil
 	default:
 		log.Printf("Unknown node type: %s", foundGlobalID.nodeType)
 	}
@@ -158,6 +158,7 @@ func (b *EntBackend) IngestOccurrences(ctx context.Context, subjects model.Packa
 
 func occurrenceConflictColumns() []string {
 	return []string{
+		occurrence.FieldTenant,
 		occurrence.FieldArtifactID,
 		occurrence.FieldJustification,
 		occurrence.FieldOrigin,
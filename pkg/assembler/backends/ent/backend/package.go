@@ -654,6 +654,12 @@ func (b *EntBackend) packageNameNeighbors(ctx context.Context, nodeID string, al
 				getPointOfContactObject(q)
 			})
 	}
+	if allowedEdges[model.EdgePackageVulnerabilityRange] {
+		query.
+			WithVulnerabilityRanges(func(q *ent.VulnerabilityRangeQuery) {
+				getVulnerabilityRangeObject(q)
+			})
+	}
 
 	pkgNames, err := query.All(ctx)
 	if err != nil {
@@ -704,6 +710,9 @@ func (b *EntBackend) packageNameNeighbors(ctx context.Context, nodeID string, al
 		for _, foundPOC := range foundPkgName.Edges.Poc {
 			out = append(out, toModelPointOfContact(foundPOC))
 		}
+		for _, foundVR := range foundPkgName.Edges.VulnerabilityRanges {
+			out = append(out, toModelVulnerabilityRange(foundVR))
+		}
 	}
 
 	return out, nil
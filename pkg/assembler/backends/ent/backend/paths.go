@@ -0,0 +1,482 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/license"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/migrate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// pathEnd is one end of the links of a pathEdge. The nodes stored as rows are
+// walked by their uuid, which the foreign keys index. The namespaces and types
+// of packages and sources and the types of vulnerabilities only exist as
+// columns, and are walked by the rest of their global ID, their key.
+type pathEnd struct {
+	nodeType string
+	// id and key are the SQL expressions of the uuid and of the key of the
+	// node at this end of the row aliased as t, one of them being NULL
+	id, key string
+	// match is the condition for the row aliased as t to be linked to the
+	// node aliased as w at this end
+	match string
+}
+
+// rowEnd is the end of the node of nodeType whose uuid is in column.
+func rowEnd(nodeType, column string) pathEnd {
+	return uuidEnd(nodeType, "t."+column)
+}
+
+// uuidEnd is the end of the node of nodeType whose uuid is expr.
+func uuidEnd(nodeType, expr string) pathEnd {
+	return pathEnd{
+		nodeType: nodeType,
+		id:       expr,
+		key:      "NULL",
+		match:    expr + " = w.id",
+	}
+}
+
+// pathEdge is a link between the rows of a table and the nodes they point to,
+// followed by AllPaths and ConstrainedPath as the edge out from the row and
+// as the edge in back to it.
+type pathEdge struct {
+	// from is the FROM clause of the rows, aliased as t
+	from string
+	// node and key are the row and the node it points to
+	node, key pathEnd
+	// where filters the rows, if not empty
+	where string
+	// tenant is the SQL expression of the tenant of the row, or empty for
+	// rows that are not partitioned by tenant
+	tenant string
+	// in is empty when the link can only be followed from the row
+	out, in model.Edge
+}
+
+func quoteSQL(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// tenantColumn returns the SQL expression of the tenant of the rows of table
+// aliased as alias, or an empty string when table is not partitioned by tenant.
+func tenantColumn(table string, alias string) string {
+	for _, t := range migrate.Tables {
+		if t.Name != table {
+			continue
+		}
+		for _, c := range t.Columns {
+			if c.Name == tenantField {
+				return alias + "." + tenantField
+			}
+		}
+	}
+	return ""
+}
+
+// foreignKeyEdge links the rows of table to the nodes of keyType in column.
+func foreignKeyEdge(table, nodeType, column, keyType string, out, in model.Edge) pathEdge {
+	return pathEdge{
+		from:   table + " t",
+		node:   rowEnd(nodeType, "id"),
+		key:    rowEnd(keyType, column),
+		where:  "t." + column + " IS NOT NULL",
+		tenant: tenantColumn(table, "t"),
+		out:    out,
+		in:     in,
+	}
+}
+
+// joinTableEdge links the rows of owner to the nodes of keyType through the
+// join table of an edge with many nodes, whose primary key holds the IDs of
+// the owner and of the node.
+func joinTableEdge(joinTable string, primaryKey []string, owner, keyType string, out, in model.Edge) pathEdge {
+	e := pathEdge{
+		from: joinTable + " t",
+		node: rowEnd(owner, primaryKey[0]),
+		key:  rowEnd(keyType, primaryKey[1]),
+		out:  out,
+		in:   in,
+	}
+	if col := tenantColumn(owner, "o"); col != "" {
+		e.tenant = fmt.Sprintf("(SELECT %s FROM %s o WHERE o.id = t.%s)", col, owner, primaryKey[0])
+	}
+	return e
+}
+
+// onlySubject restricts e to the rows whose other subject column is NULL,
+// which they always are, so that the partial indexes on the subject of e
+// apply.
+func onlySubject(e pathEdge, otherColumn string) pathEdge {
+	e.where += " AND t." + otherColumn + " IS NULL"
+	return e
+}
+
+// typeEnd is the end of the type of nodeType in the type column of the rows.
+func typeEnd(nodeType string) pathEnd {
+	return pathEnd{
+		nodeType: nodeType,
+		id:       "NULL",
+		key:      "t.type",
+		match:    "t.type = w.key",
+	}
+}
+
+// treeEdges links the names, namespaces and types of packages or sources,
+// where the namespaces and types only exist as columns of the names.
+func treeEdges(nameTable, namespaceType, typeType string, nameToNamespace, namespaceToName, namespaceToType, typeToNamespace model.Edge) []pathEdge {
+	split := quoteSQL(guacIDSplit)
+	namespace := pathEnd{
+		nodeType: namespaceType,
+		id:       "NULL",
+		key:      "t.type || " + split + " || t.namespace",
+		// the type is matched on its own first for the indexes on the names
+		match: "t.type = split_part(w.key, " + split + ", 1) AND t.type || " + split + " || t.namespace = w.key",
+	}
+	return []pathEdge{{
+		from: nameTable + " t",
+		node: rowEnd(nameTable, "id"),
+		key:  namespace,
+		out:  nameToNamespace,
+		in:   namespaceToName,
+	}, {
+		from: "(SELECT DISTINCT type, namespace FROM " + nameTable + ") t",
+		node: namespace,
+		key:  typeEnd(typeType),
+		out:  namespaceToType,
+		in:   typeToNamespace,
+	}}
+}
+
+// pathEdges returns every link between ent nodes, named as in Neighbors.
+func pathEdges() []pathEdge {
+	edges := []pathEdge{
+		foreignKeyEdge(packageversion.Table, packageversion.Table, packageversion.FieldNameID, packagename.Table, model.EdgePackageVersionPackageName, model.EdgePackageNamePackageVersion),
+		{
+			from: vulnerabilityid.Table + " t",
+			node: rowEnd(vulnerabilityid.Table, "id"),
+			key:  typeEnd(vulnTypeString),
+			out:  model.EdgeVulnerabilityIDVulnerabilityType,
+			in:   model.EdgeVulnerabilityTypeVulnerabilityID,
+		},
+
+		onlySubject(foreignKeyEdge(billofmaterials.Table, billofmaterials.Table, billofmaterials.FieldPackageID, packageversion.Table, model.EdgeHasSbomPackage, model.EdgePackageHasSbom), billofmaterials.FieldArtifactID),
+		onlySubject(foreignKeyEdge(billofmaterials.Table, billofmaterials.Table, billofmaterials.FieldArtifactID, artifact.Table, model.EdgeHasSbomArtifact, model.EdgeArtifactHasSbom), billofmaterials.FieldPackageID),
+		joinTableEdge(billofmaterials.IncludedSoftwarePackagesTable, billofmaterials.IncludedSoftwarePackagesPrimaryKey, billofmaterials.Table, packageversion.Table, model.EdgeHasSbomIncludedSoftware, ""),
+		joinTableEdge(billofmaterials.IncludedSoftwareArtifactsTable, billofmaterials.IncludedSoftwareArtifactsPrimaryKey, billofmaterials.Table, artifact.Table, model.EdgeHasSbomIncludedSoftware, ""),
+		joinTableEdge(billofmaterials.IncludedDependenciesTable, billofmaterials.IncludedDependenciesPrimaryKey, billofmaterials.Table, dependency.Table, model.EdgeHasSbomIncludedDependencies, ""),
+		joinTableEdge(billofmaterials.IncludedOccurrencesTable, billofmaterials.IncludedOccurrencesPrimaryKey, billofmaterials.Table, occurrence.Table, model.EdgeHasSbomIncludedOccurrences, ""),
+
+		onlySubject(foreignKeyEdge(certifylegal.Table, certifylegal.Table, certifylegal.FieldPackageID, packageversion.Table, model.EdgeCertifyLegalPackage, model.EdgePackageCertifyLegal), certifylegal.FieldSourceID),
+		foreignKeyEdge(certifylegal.Table, certifylegal.Table, certifylegal.FieldSourceID, sourcename.Table, model.EdgeCertifyLegalSource, model.EdgeSourceCertifyLegal),
+		joinTableEdge(certifylegal.DeclaredLicensesTable, certifylegal.DeclaredLicensesPrimaryKey, certifylegal.Table, license.Table, model.EdgeCertifyLegalLicense, model.EdgeLicenseCertifyLegal),
+		joinTableEdge(certifylegal.DiscoveredLicensesTable, certifylegal.DiscoveredLicensesPrimaryKey, certifylegal.Table, license.Table, model.EdgeCertifyLegalLicense, model.EdgeLicenseCertifyLegal),
+
+		foreignKeyEdge(certifypolicy.Table, certifypolicy.Table, certifypolicy.FieldArtifactID, artifact.Table, model.EdgeCertifyPolicyArtifact, model.EdgeArtifactCertifyPolicy),
+		foreignKeyEdge(certifyscorecard.Table, certifyscorecard.Table, certifyscorecard.FieldSourceID, sourcename.Table, model.EdgeCertifyScorecardSource, model.EdgeSourceCertifyScorecard),
+
+		foreignKeyEdge(certifyvex.Table, certifyvex.Table, certifyvex.FieldPackageID, packageversion.Table, model.EdgeCertifyVexStatementPackage, model.EdgePackageCertifyVexStatement),
+		foreignKeyEdge(certifyvex.Table, certifyvex.Table, certifyvex.FieldArtifactID, artifact.Table, model.EdgeCertifyVexStatementArtifact, model.EdgeArtifactCertifyVexStatement),
+		foreignKeyEdge(certifyvex.Table, certifyvex.Table, certifyvex.FieldVulnerabilityID, vulnerabilityid.Table, model.EdgeCertifyVexStatementVulnerability, model.EdgeVulnerabilityCertifyVexStatement),
+
+		foreignKeyEdge(certifyvuln.Table, certifyvuln.Table, certifyvuln.FieldPackageID, packageversion.Table, model.EdgeCertifyVulnPackage, model.EdgePackageCertifyVuln),
+		foreignKeyEdge(certifyvuln.Table, certifyvuln.Table, certifyvuln.FieldVulnerabilityID, vulnerabilityid.Table, model.EdgeCertifyVulnVulnerability, model.EdgeVulnerabilityCertifyVuln),
+
+		foreignKeyEdge(dependency.Table, dependency.Table, dependency.FieldPackageID, packageversion.Table, model.EdgeIsDependencyPackage, model.EdgePackageIsDependency),
+		foreignKeyEdge(dependency.Table, dependency.Table, dependency.FieldDependentPackageVersionID, packageversion.Table, model.EdgeIsDependencyPackage, model.EdgePackageIsDependency),
+
+		foreignKeyEdge(hashequal.Table, hashequal.Table, hashequal.FieldArtID, artifact.Table, model.EdgeHashEqualArtifact, model.EdgeArtifactHashEqual),
+		foreignKeyEdge(hashequal.Table, hashequal.Table, hashequal.FieldEqualArtID, artifact.Table, model.EdgeHashEqualArtifact, model.EdgeArtifactHashEqual),
+
+		foreignKeyEdge(hasmetadata.Table, hasmetadata.Table, hasmetadata.FieldPackageVersionID, packageversion.Table, model.EdgeHasMetadataPackage, model.EdgePackageHasMetadata),
+		foreignKeyEdge(hasmetadata.Table, hasmetadata.Table, hasmetadata.FieldPackageNameID, packagename.Table, model.EdgeHasMetadataPackage, model.EdgePackageHasMetadata),
+		foreignKeyEdge(hasmetadata.Table, hasmetadata.Table, hasmetadata.FieldSourceID, sourcename.Table, model.EdgeHasMetadataSource, model.EdgeSourceHasMetadata),
+		foreignKeyEdge(hasmetadata.Table, hasmetadata.Table, hasmetadata.FieldArtifactID, artifact.Table, model.EdgeHasMetadataArtifact, model.EdgeArtifactHasMetadata),
+
+		foreignKeyEdge(hassourceat.Table, hassourceat.Table, hassourceat.FieldPackageVersionID, packageversion.Table, model.EdgeHasSourceAtPackage, model.EdgePackageHasSourceAt),
+		foreignKeyEdge(hassourceat.Table, hassourceat.Table, hassourceat.FieldPackageNameID, packagename.Table, model.EdgeHasSourceAtPackage, model.EdgePackageHasSourceAt),
+		foreignKeyEdge(hassourceat.Table, hassourceat.Table, hassourceat.FieldSourceID, sourcename.Table, model.EdgeHasSourceAtSource, model.EdgeSourceHasSourceAt),
+
+		onlySubject(foreignKeyEdge(occurrence.Table, occurrence.Table, occurrence.FieldPackageID, packageversion.Table, model.EdgeIsOccurrencePackage, model.EdgePackageIsOccurrence), occurrence.FieldSourceID),
+		foreignKeyEdge(occurrence.Table, occurrence.Table, occurrence.FieldSourceID, sourcename.Table, model.EdgeIsOccurrenceSource, model.EdgeSourceIsOccurrence),
+		foreignKeyEdge(occurrence.Table, occurrence.Table, occurrence.FieldArtifactID, artifact.Table, model.EdgeIsOccurrenceArtifact, model.EdgeArtifactIsOccurrence),
+
+		foreignKeyEdge(pkgequal.Table, pkgequal.Table, pkgequal.FieldPkgID, packageversion.Table, model.EdgePkgEqualPackage, model.EdgePackagePkgEqual),
+		foreignKeyEdge(pkgequal.Table, pkgequal.Table, pkgequal.FieldEqualPkgID, packageversion.Table, model.EdgePkgEqualPackage, model.EdgePackagePkgEqual),
+
+		foreignKeyEdge(pointofcontact.Table, pointofcontact.Table, pointofcontact.FieldPackageVersionID, packageversion.Table, model.EdgePointOfContactPackage, model.EdgePackagePointOfContact),
+		foreignKeyEdge(pointofcontact.Table, pointofcontact.Table, pointofcontact.FieldPackageNameID, packagename.Table, model.EdgePointOfContactPackage, model.EdgePackagePointOfContact),
+		foreignKeyEdge(pointofcontact.Table, pointofcontact.Table, pointofcontact.FieldSourceID, sourcename.Table, model.EdgePointOfContactSource, model.EdgeSourcePointOfContact),
+		foreignKeyEdge(pointofcontact.Table, pointofcontact.Table, pointofcontact.FieldArtifactID, artifact.Table, model.EdgePointOfContactArtifact, model.EdgeArtifactPointOfContact),
+
+		foreignKeyEdge(slsaattestation.Table, slsaattestation.Table, slsaattestation.FieldSubjectID, artifact.Table, model.EdgeHasSlsaSubject, model.EdgeArtifactHasSlsa),
+		foreignKeyEdge(slsaattestation.Table, slsaattestation.Table, slsaattestation.FieldBuiltByID, builder.Table, model.EdgeHasSlsaBuiltBy, model.EdgeBuilderHasSlsa),
+		joinTableEdge(slsaattestation.BuiltFromTable, slsaattestation.BuiltFromPrimaryKey, slsaattestation.Table, artifact.Table, model.EdgeHasSlsaMaterials, model.EdgeArtifactHasSlsa),
+
+		foreignKeyEdge(vulnequal.Table, vulnequal.Table, vulnequal.FieldVulnID, vulnerabilityid.Table, model.EdgeVulnEqualVulnerability, model.EdgeVulnerabilityVulnEqual),
+		foreignKeyEdge(vulnequal.Table, vulnequal.Table, vulnequal.FieldEqualVulnID, vulnerabilityid.Table, model.EdgeVulnEqualVulnerability, model.EdgeVulnerabilityVulnEqual),
+
+		foreignKeyEdge(vulnerabilitymetadata.Table, vulnerabilitymetadata.Table, vulnerabilitymetadata.FieldVulnerabilityIDID, vulnerabilityid.Table, model.EdgeVulnMetadataVulnerability, model.EdgeVulnerabilityVulnMetadata),
+
+		foreignKeyEdge(vulnerabilityrange.Table, vulnerabilityrange.Table, vulnerabilityrange.FieldVulnerabilityID, vulnerabilityid.Table, model.EdgeVulnerabilityRangeVulnerability, model.EdgeVulnerabilityVulnerabilityRange),
+		foreignKeyEdge(vulnerabilityrange.Table, vulnerabilityrange.Table, vulnerabilityrange.FieldPackageNameID, packagename.Table, model.EdgeVulnerabilityRangePackage, model.EdgePackageVulnerabilityRange),
+	}
+	edges = append(edges, treeEdges(packagename.Table, pkgNamespaceString, pkgTypeString,
+		model.EdgePackageNamePackageNamespace, model.EdgePackageNamespacePackageName,
+		model.EdgePackageNamespacePackageType, model.EdgePackageTypePackageNamespace)...)
+	edges = append(edges, treeEdges(sourcename.Table, srcNamespaceString, srcTypeString,
+		model.EdgeSourceNameSourceNamespace, model.EdgeSourceNamespaceSourceName,
+		model.EdgeSourceNamespaceSourceType, model.EdgeSourceTypeSourceNamespace)...)
+
+	// CertifyBad and CertifyGood share the certifications table and are told
+	// apart by its type column
+	certifications := []struct {
+		certType            certification.Type
+		nodeType            string
+		pkg, src, art       model.Edge
+		inPkg, inSrc, inArt model.Edge
+	}{
+		{certification.TypeBAD, certifyBadString,
+			model.EdgeCertifyBadPackage, model.EdgeCertifyBadSource, model.EdgeCertifyBadArtifact,
+			model.EdgePackageCertifyBad, model.EdgeSourceCertifyBad, model.EdgeArtifactCertifyBad},
+		{certification.TypeGOOD, certifyGoodString,
+			model.EdgeCertifyGoodPackage, model.EdgeCertifyGoodSource, model.EdgeCertifyGoodArtifact,
+			model.EdgePackageCertifyGood, model.EdgeSourceCertifyGood, model.EdgeArtifactCertifyGood},
+	}
+	for _, c := range certifications {
+		for _, e := range []pathEdge{
+			foreignKeyEdge(certification.Table, c.nodeType, certification.FieldPackageVersionID, packageversion.Table, c.pkg, c.inPkg),
+			foreignKeyEdge(certification.Table, c.nodeType, certification.FieldPackageNameID, packagename.Table, c.pkg, c.inPkg),
+			foreignKeyEdge(certification.Table, c.nodeType, certification.FieldSourceID, sourcename.Table, c.src, c.inSrc),
+			foreignKeyEdge(certification.Table, c.nodeType, certification.FieldArtifactID, artifact.Table, c.art, c.inArt),
+		} {
+			e.where += " AND t." + certification.FieldType + " = " + quoteSQL(c.certType.String())
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+func (b *EntBackend) AllPaths(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error) {
+	pattern, err := helper.AllPathsPattern(usingOnly, weights)
+	if err != nil {
+		return nil, fmt.Errorf("AllPaths :: %w", err)
+	}
+	return b.paths(ctx, subject, target, maxPathLength, pattern, first)
+}
+
+func (b *EntBackend) ConstrainedPath(ctx context.Context, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error) {
+	pattern, err := helper.ConstrainedPathPattern(segments)
+	if err != nil {
+		return nil, fmt.Errorf("ConstrainedPath :: %w", err)
+	}
+	return b.paths(ctx, subject, target, maxPathLength, pattern, first)
+}
+
+// paths finds the paths that follow pattern with a recursive query, which
+// walks the links of pathEdges from subject, one edge per step, until it
+// reaches target or maxPathLength edges.
+func (b *EntBackend) paths(ctx context.Context, subject string, target string, maxPathLength int, pattern *helper.PathPattern, first *int) ([]*model.Path, error) {
+	subjectType, subjectID, subjectKey, ok := walkNode(subject)
+	if !ok {
+		return nil, nil
+	}
+	targetType, targetID, targetKey, ok := walkNode(target)
+	if !ok {
+		return nil, nil
+	}
+	args := []any{subject, target, maxPathLength, nil,
+		subjectType, subjectID, subjectKey, targetType, targetID, targetKey}
+	if first != nil {
+		args[3] = *first
+	}
+	tenantID := tenant.FromContext(ctx)
+	if tenantID != "" {
+		// a tenant sees its own predicates and the global ones
+		args = append(args, tenantID)
+	}
+
+	query := pathsQuery(pattern, tenantID != "")
+	if query == "" {
+		return nil, nil
+	}
+
+	rows, err := b.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query paths from %s to %s: %w", subject, target, err)
+	}
+	defer rows.Close()
+
+	var found []helper.FoundPath
+	for rows.Next() {
+		var ids string
+		var p helper.FoundPath
+		if err := rows.Scan(&ids, &p.Weight); err != nil {
+			return nil, fmt.Errorf("failed to read path: %w", err)
+		}
+		if err := json.Unmarshal([]byte(ids), &p.IDs); err != nil {
+			return nil, fmt.Errorf("failed to read path: %w", err)
+		}
+		found = append(found, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query paths from %s to %s: %w", subject, target, err)
+	}
+
+	return helper.BuildPaths(found, func(ids []string) ([]model.Node, error) {
+		return b.Nodes(ctx, ids)
+	})
+}
+
+// walkNode splits the global ID of a node into its type and its uuid or key,
+// as pathEnd walks them. It returns false when gID cannot be the ID of a node.
+func walkNode(gID string) (nodeType string, id, key any, ok bool) {
+	nodeType, rest, found := strings.Cut(gID, ":")
+	if !found {
+		return "", nil, nil, false
+	}
+	switch nodeType {
+	case pkgTypeString, pkgNamespaceString, srcTypeString, srcNamespaceString, vulnTypeString:
+		return nodeType, nil, rest, true
+	}
+	u, err := uuid.Parse(rest)
+	if err != nil {
+		return "", nil, nil, false
+	}
+	return nodeType, u.String(), nil, true
+}
+
+// pathsQuery returns the recursive query of paths, which takes the global IDs
+// of the subject and target, the maximum length and number of paths, the
+// type, uuid and key of the subject and of the target as parameters, and the
+// tenant when tenantScoped is set. It is empty if no path can follow pattern.
+//
+// The walk looks up the next nodes of each step through the links of the
+// edges the pattern allows from the type of the node, so that the foreign key
+// indexes are used. It is pruned by the distance to the target, found by a
+// walk back from the target over the same links, so that it only follows the
+// nodes from which the target can still be reached in maxPathLength edges.
+func pathsQuery(pattern *helper.PathPattern, tenantScoped bool) string {
+	var steps, accepting []string
+	used := map[model.Edge]bool{}
+	for state := 0; state < pattern.States(); state++ {
+		for _, e := range pattern.Edges(state) {
+			next, _ := pattern.Next(state, e)
+			steps = append(steps, fmt.Sprintf("(%d, %s, %d, %s::float8)",
+				state, quoteSQL(e.String()), next, strconv.FormatFloat(pattern.Weight(e), 'g', -1, 64)))
+			used[e] = true
+		}
+		if pattern.Accepting(state) {
+			accepting = append(accepting, strconv.Itoa(state))
+		}
+	}
+	if len(accepting) == 0 {
+		return ""
+	}
+
+	none := "SELECT NULL::text, NULL::uuid, NULL::text WHERE false"
+	forward, backward := []string{none}, []string{none}
+	// link selects the nodes at the end to of the rows linked to the node w
+	// at the end from
+	link := func(e pathEdge, from, to pathEnd, conds ...string) string {
+		conds = append(conds, "w.type = "+quoteSQL(from.nodeType), from.match)
+		if e.where != "" {
+			conds = append(conds, e.where)
+		}
+		if e.tenant != "" && tenantScoped {
+			conds = append(conds, e.tenant+" IN ('', $11)")
+		}
+		return fmt.Sprintf("SELECT %s::text, (%s)::uuid, (%s)::text FROM %s WHERE %s",
+			quoteSQL(to.nodeType), to.id, to.key, e.from, strings.Join(conds, " AND "))
+	}
+	follow := func(e pathEdge, from, to pathEnd, edge model.Edge) {
+		if edge == "" || !used[edge] {
+			return
+		}
+		forward = append(forward, link(e, from, to, "s.edge = "+quoteSQL(edge.String())))
+		backward = append(backward, link(e, to, from))
+	}
+	for _, e := range pathEdges() {
+		follow(e, e.node, e.key, e.out)
+		follow(e, e.key, e.node, e.in)
+	}
+
+	return `WITH RECURSIVE steps(state, edge, next, weight) AS (
+	VALUES ` + strings.Join(steps, ", ") + `
+), dist(type, id, key, node, d) AS (
+	SELECT $8::text, $9::uuid, $10::text, $2::text, 0
+	UNION
+	SELECT n.type, n.id, n.key, n.type || ':' || coalesce(n.id::text, n.key), w.d + 1
+	FROM dist w
+	CROSS JOIN LATERAL (
+		` + strings.Join(backward, "\n\t\tUNION ALL ") + `
+	) n(type, id, key)
+	WHERE w.d < $3::int - 1
+), reach(node, d) AS (
+	SELECT node, min(d) FROM dist GROUP BY node
+), walk(type, id, key, node, state, path, weight) AS (
+	SELECT $5::text, $6::uuid, $7::text, $1::text, 0, ARRAY[$1::text], 0::float8
+	UNION ALL
+	SELECT n.type, n.id, n.key, n.node, s.next, w.path || n.node, w.weight + s.weight
+	FROM walk w
+	JOIN steps s ON s.state = w.state
+	CROSS JOIN LATERAL (
+		SELECT l.type, l.id, l.key, l.type || ':' || coalesce(l.id::text, l.key)
+		FROM (
+			` + strings.Join(forward, "\n\t\t\tUNION ALL ") + `
+		) l(type, id, key)
+	) n(type, id, key, node)
+	JOIN reach r ON r.node = n.node
+	WHERE w.node <> $2 AND cardinality(w.path) + r.d <= $3::int AND NOT n.node = ANY(w.path)
+)
+SELECT array_to_json(path)::text, weight FROM walk
+WHERE node = $2 AND state IN (` + strings.Join(accepting, ", ") + `)
+ORDER BY weight, cardinality(path), path
+LIMIT $4`
+}
@@ -154,6 +154,7 @@ func (b *EntBackend) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgI
 
 func pkgEqualConflictColumns() []string {
 	return []string{
+		pkgequal.FieldTenant,
 		pkgequal.FieldPkgID,
 		pkgequal.FieldEqualPkgID,
 		pkgequal.FieldPackagesHash,
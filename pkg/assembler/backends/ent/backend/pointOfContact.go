@@ -193,6 +193,7 @@ func pointOfContactPredicate(filter *model.PointOfContactSpec) predicate.PointOf
 
 func pocConflictColumns() []string {
 	return []string{
+		pointofcontact.FieldTenant,
 		pointofcontact.FieldEmail,
 		pointofcontact.FieldInfo,
 		pointofcontact.FieldSince,
@@ -20,6 +20,7 @@ import (
 	stdsql "database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
@@ -52,7 +53,7 @@ func bulkHasSBOMGlobalID(ids []string) []string {
 	return toGlobalIDs(billofmaterials.Table, ids)
 }
 
-func (b *EntBackend) HasSBOMList(ctx context.Context, spec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+func (b *EntBackend) HasSBOMList(ctx context.Context, spec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error) {
 
 	var outputIncludeSoftware bool
 	var outputIncludeDependencies bool
@@ -92,7 +93,7 @@ func (b *EntBackend) HasSBOMList(ctx context.Context, spec model.HasSBOMSpec, af
 	}
 
 	sbomQuery := b.client.BillOfMaterials.Query().
-		Where(hasSBOMQuery(spec))
+		Where(hasSBOMQuery(spec), hasSBOMKnownAsOf(ctx, asOf))
 
 	hasSBOMConnection, err := getSBOMObjectWithOutIncludes(sbomQuery).Paginate(ctx, afterCursor, first, nil, nil)
 	if err != nil {
@@ -358,14 +359,14 @@ func (b *EntBackend) HasSBOMList(ctx context.Context, spec model.HasSBOMSpec, af
 	}
 }
 
-func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func (b *EntBackend) HasSBOM(ctx context.Context, spec *model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error) {
 	funcName := "HasSBOM"
 	if spec == nil {
 		spec = &model.HasSBOMSpec{}
 	}
 
 	sbomQuery := b.client.BillOfMaterials.Query().
-		Where(hasSBOMQuery(*spec))
+		Where(hasSBOMQuery(*spec), hasSBOMKnownAsOf(ctx, asOf))
 
 	records, err := getSBOMObjectWithIncludes(sbomQuery).
 		All(ctx)
@@ -519,6 +520,7 @@ func (b *EntBackend) IngestHasSBOMs(ctx context.Context, subjects model.PackageO
 
 func sbomConflictColumns() []string {
 	return []string{
+		billofmaterials.FieldTenant,
 		billofmaterials.FieldURI,
 		billofmaterials.FieldAlgorithm,
 		billofmaterials.FieldDigest,
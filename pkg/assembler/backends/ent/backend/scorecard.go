@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
@@ -43,7 +44,7 @@ func bulkScorecardGlobalID(ids []string) []string {
 	return toGlobalIDs(certifyscorecard.Table, ids)
 }
 
-func (b *EntBackend) ScorecardsList(ctx context.Context, spec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+func (b *EntBackend) ScorecardsList(ctx context.Context, spec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error) {
 	var afterCursor *entgql.Cursor[uuid.UUID]
 
 	if after != nil {
@@ -61,7 +62,7 @@ func (b *EntBackend) ScorecardsList(ctx context.Context, spec model.CertifyScore
 	}
 
 	scorecardQuery := b.client.CertifyScorecard.Query().
-		Where(certifyScorecardQuery(&spec))
+		Where(certifyScorecardQuery(&spec), scorecardKnownAsOf(ctx, asOf))
 
 	scorecardConn, err := getScorecardObject(scorecardQuery).
 		Paginate(ctx, afterCursor, first, nil, nil)
@@ -98,13 +99,13 @@ func (b *EntBackend) ScorecardsList(ctx context.Context, spec model.CertifyScore
 	}
 }
 
-func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error) {
 	if filter == nil {
 		filter = &model.CertifyScorecardSpec{}
 	}
 
 	scorecardQuery := b.client.CertifyScorecard.Query().
-		Where(certifyScorecardQuery(filter))
+		Where(certifyScorecardQuery(filter), scorecardKnownAsOf(ctx, asOf))
 
 	records, err := getScorecardObject(scorecardQuery).
 		All(ctx)
@@ -240,6 +241,7 @@ func generateScorecardCreate(ctx context.Context, tx *ent.Tx, src *model.IDorSou
 
 func scorecardConflictColumns() []string {
 	return []string{
+		certifyscorecard.FieldTenant,
 		certifyscorecard.FieldSourceID,
 		certifyscorecard.FieldOrigin,
 		certifyscorecard.FieldCollector,
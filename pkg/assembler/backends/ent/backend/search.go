@@ -25,6 +25,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
@@ -113,7 +114,7 @@ func (b *EntBackend) FindSoftware(ctx context.Context, searchText string) ([]mod
 }
 
 func (b *EntBackend) FindSoftwareList(ctx context.Context, searchText string, after *string, first *int) (*model.FindSoftwareConnection, error) {
-	return nil, fmt.Errorf("not implemented: FindSoftwareList")
+	return nil, fmt.Errorf("%w: FindSoftwareList", backends.ErrNotImplemented)
 }
 
 func notGUACTypePackagePredicates() predicate.PackageVersion {
@@ -207,6 +207,7 @@ func (b *EntBackend) IngestSLSAs(ctx context.Context, subjects []*model.IDorArti
 
 func slsaConflictColumns() []string {
 	return []string{
+		slsaattestation.FieldTenant,
 		slsaattestation.FieldSubjectID,
 		slsaattestation.FieldOrigin,
 		slsaattestation.FieldCollector,
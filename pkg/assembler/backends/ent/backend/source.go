@@ -207,6 +207,7 @@ func (b *EntBackend) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorP
 
 func hasSourceAtConflictColumns() []string {
 	return []string{
+		hassourceat.FieldTenant,
 		hassourceat.FieldSourceID,
 		hassourceat.FieldJustification,
 		hassourceat.FieldKnownSince,
@@ -0,0 +1,151 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/intercept"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// tenantField is the column added by schema.TenantMixin.
+const tenantField = "tenant"
+
+// tenantScoped maps the predicates that are partitioned by tenant to their
+// tables. Nouns, VulnEqual and VulnerabilityMetadata describe public data and
+// stay global.
+var tenantScoped = map[string]string{
+	ent.TypeBillOfMaterials:    billofmaterials.Table,
+	ent.TypeCertification:      certification.Table,
+	ent.TypeCertifyLegal:       certifylegal.Table,
+	ent.TypeCertifyPolicy:      certifypolicy.Table,
+	ent.TypeCertifyScorecard:   certifyscorecard.Table,
+	ent.TypeCertifyVex:         certifyvex.Table,
+	ent.TypeCertifyVuln:        certifyvuln.Table,
+	ent.TypeDependency:         dependency.Table,
+	ent.TypeHashEqual:          hashequal.Table,
+	ent.TypeHasMetadata:        hasmetadata.Table,
+	ent.TypeHasSourceAt:        hassourceat.Table,
+	ent.TypeOccurrence:         occurrence.Table,
+	ent.TypePkgEqual:           pkgequal.Table,
+	ent.TypePointOfContact:     pointofcontact.Table,
+	ent.TypeSLSAAttestation:    slsaattestation.Table,
+	ent.TypeVulnerabilityRange: vulnerabilityrange.Table,
+}
+
+type tenantSetter interface {
+	SetTenant(string)
+}
+
+type wherePMutation interface {
+	WhereP(...func(*sql.Selector))
+}
+
+// useTenantScoping registers the hooks and interceptors that partition
+// predicates by the tenant found in the request context. Callers without a
+// tenant are not filtered and write global predicates.
+func useTenantScoping(client *ent.Client) {
+	client.Intercept(intercept.Func(func(ctx context.Context, q intercept.Query) error {
+		t := tenant.FromContext(ctx)
+		if t == "" || tenantScoped[q.Type()] == "" {
+			return nil
+		}
+		// a tenant sees its own predicates and the global ones
+		q.WhereP(sql.FieldIn(tenantField, "", t))
+		return nil
+	}))
+
+	client.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			t := tenant.FromContext(ctx)
+			if t == "" || tenantScoped[m.Type()] == "" {
+				return next.Mutate(ctx, m)
+			}
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				if s, ok := m.(tenantSetter); ok {
+					s.SetTenant(t)
+				}
+			case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne):
+				// a tenant can only change or remove its own predicates
+				if w, ok := m.(wherePMutation); ok {
+					w.WhereP(sql.FieldEQ(tenantField, t))
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+}
+
+// PredicateTenants returns the tenants that own predicates visible to the
+// caller, sorted. It is empty when all of them are global.
+func (b *EntBackend) PredicateTenants(ctx context.Context) ([]string, error) {
+	var args []any
+	cond := tenantField + " <> ''"
+	if t := tenant.FromContext(ctx); t != "" {
+		args = append(args, t)
+		cond = tenantField + " = $1"
+	}
+	tables := make([]string, 0, len(tenantScoped))
+	for _, table := range tenantScoped {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	selects := make([]string, 0, len(tables))
+	for _, table := range tables {
+		selects = append(selects, fmt.Sprintf("SELECT %s FROM %s WHERE %s", tenantField, table, cond))
+	}
+
+	rows, err := b.client.QueryContext(ctx, strings.Join(selects, " UNION ")+" ORDER BY 1", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query predicate tenants: %w", err)
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var t string
+		if err := rows.Scan(&t); err != nil {
+			return nil, fmt.Errorf("failed to read predicate tenant: %w", err)
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query predicate tenants: %w", err)
+	}
+	return out, nil
+}
@@ -331,6 +331,7 @@ func toModelIsDependencyWithBackrefs(id *ent.Dependency) *model.IsDependency {
 		Package:           toModelPackage(backReferencePackageVersion(id.Edges.Package)),
 		DependencyPackage: toModelPackage(backReferencePackageVersion(id.Edges.DependentPackageVersion)),
 		DependencyType:    dependencyTypeFromEnum(id.DependencyType),
+		VersionRange:      id.VersionRange,
 		Justification:     id.Justification,
 		Origin:            id.Origin,
 		Collector:         id.Collector,
@@ -371,6 +371,12 @@ func (b *EntBackend) vulnIdNeighbors(ctx context.Context, nodeID string, allowed
 				getVulnMetadataObject(q)
 			})
 	}
+	if allowedEdges[model.EdgeVulnerabilityVulnerabilityRange] {
+		query.
+			WithVulnerabilityRanges(func(q *ent.VulnerabilityRangeQuery) {
+				getVulnerabilityRangeObject(q)
+			})
+	}
 
 	vulnIDs, err := query.All(ctx)
 	if err != nil {
@@ -400,6 +406,9 @@ func (b *EntBackend) vulnIdNeighbors(ctx context.Context, nodeID string, allowed
 		for _, meta := range foundVulnID.Edges.Metadata {
 			out = append(out, toModelVulnerabilityMetadata(meta))
 		}
+		for _, vr := range foundVulnID.Edges.VulnerabilityRanges {
+			out = append(out, toModelVulnerabilityRange(vr))
+		}
 	}
 
 	return out, nil
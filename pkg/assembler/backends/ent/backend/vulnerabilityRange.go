@@ -0,0 +1,372 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func vulnerabilityRangeGlobalID(id string) string {
+	return toGlobalID(vulnerabilityrange.Table, id)
+}
+
+func bulkVulnerabilityRangeGlobalID(ids []string) []string {
+	return toGlobalIDs(vulnerabilityrange.Table, ids)
+}
+
+func (b *EntBackend) VulnerabilityRangeList(ctx context.Context, spec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+	var afterCursor *entgql.Cursor[uuid.UUID]
+
+	if after != nil {
+		globalID := fromGlobalID(*after)
+		if globalID.nodeType != vulnerabilityrange.Table {
+			return nil, fmt.Errorf("after cursor is not type vulnerabilityRange but type: %s", globalID.nodeType)
+		}
+		afterUUID, err := uuid.Parse(globalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse global ID with error: %w", err)
+		}
+		afterCursor = &ent.Cursor{ID: afterUUID}
+	} else {
+		afterCursor = nil
+	}
+
+	vrQuery := b.client.VulnerabilityRange.Query().
+		Where(vulnerabilityRangeQuery(spec))
+
+	vrConn, err := getVulnerabilityRangeObject(vrQuery).
+		Paginate(ctx, afterCursor, first, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed vulnerabilityRange query with error: %w", err)
+	}
+
+	// if not found return nil
+	if vrConn == nil {
+		return nil, nil
+	}
+
+	var edges []*model.VulnerabilityRangeEdge
+	for _, edge := range vrConn.Edges {
+		edges = append(edges, &model.VulnerabilityRangeEdge{
+			Cursor: vulnerabilityRangeGlobalID(edge.Cursor.ID.String()),
+			Node:   toModelVulnerabilityRange(edge.Node),
+		})
+	}
+
+	if vrConn.PageInfo.StartCursor != nil {
+		return &model.VulnerabilityRangeConnection{
+			TotalCount: vrConn.TotalCount,
+			PageInfo: &model.PageInfo{
+				HasNextPage: vrConn.PageInfo.HasNextPage,
+				StartCursor: ptrfrom.String(vulnerabilityRangeGlobalID(vrConn.PageInfo.StartCursor.ID.String())),
+				EndCursor:   ptrfrom.String(vulnerabilityRangeGlobalID(vrConn.PageInfo.EndCursor.ID.String())),
+			},
+			Edges: edges,
+		}, nil
+	} else {
+		// if not found return nil
+		return nil, nil
+	}
+}
+
+func (b *EntBackend) VulnerabilityRange(ctx context.Context, spec *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error) {
+	if spec == nil {
+		spec = &model.VulnerabilityRangeSpec{}
+	}
+
+	vrQuery := b.client.VulnerabilityRange.Query().
+		Where(vulnerabilityRangeQuery(*spec))
+
+	records, err := getVulnerabilityRangeObject(vrQuery).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed vulnerabilityRange query with error: %w", err)
+	}
+
+	return collect(records, toModelVulnerabilityRange), nil
+}
+
+func vulnerabilityRangeQuery(spec model.VulnerabilityRangeSpec) predicate.VulnerabilityRange {
+	predicates := []predicate.VulnerabilityRange{
+		optionalPredicate(spec.ID, IDEQ),
+		optionalPredicate(spec.Introduced, vulnerabilityrange.IntroducedEQ),
+		optionalPredicate(spec.Fixed, vulnerabilityrange.FixedEQ),
+		optionalPredicate(spec.LastAffected, vulnerabilityrange.LastAffectedEQ),
+		optionalPredicate(spec.Origin, vulnerabilityrange.OriginEQ),
+		optionalPredicate(spec.Collector, vulnerabilityrange.CollectorEQ),
+		optionalPredicate(spec.DocumentRef, vulnerabilityrange.DocumentRefEQ),
+	}
+
+	if spec.RangeType != nil {
+		predicates = append(predicates, vulnerabilityrange.RangeTypeEQ(vulnerabilityrange.RangeType(*spec.RangeType)))
+	}
+
+	if spec.Package != nil {
+		predicates = append(predicates,
+			vulnerabilityrange.HasPackageWith(packageNameQuery(pkgNameQueryFromPkgSpec(spec.Package))))
+	}
+
+	if spec.Vulnerability != nil {
+		if spec.Vulnerability.ID != nil {
+			predicates = append(predicates, optionalPredicate(spec.Vulnerability.ID, vulnerabilityIDEQ))
+		} else {
+			predicates = append(predicates,
+				vulnerabilityrange.HasVulnerabilityWith(
+					vulnerabilityQueryPredicates(*spec.Vulnerability)...,
+				),
+			)
+		}
+	}
+
+	return vulnerabilityrange.And(predicates...)
+}
+
+// getVulnerabilityRangeObject is used recreate the vulnerabilityRange object be eager loading the edges
+func getVulnerabilityRangeObject(q *ent.VulnerabilityRangeQuery) *ent.VulnerabilityRangeQuery {
+	return q.
+		WithVulnerability().
+		WithPackage(withPackageNameTree())
+}
+
+func (b *EntBackend) IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error) {
+	id, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		return upsertVulnerabilityRange(ctx, ent.TxFromContext(ctx), pkg, vulnerability, vulnerabilityRange)
+	})
+	if txErr != nil {
+		return "", txErr
+	}
+
+	return vulnerabilityRangeGlobalID(*id), nil
+}
+
+func (b *EntBackend) IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error) {
+	funcName := "IngestVulnerabilityRanges"
+	ids, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*[]string, error) {
+		client := ent.TxFromContext(ctx)
+		slc, err := upsertBulkVulnerabilityRange(ctx, client, pkgs, vulnerabilities, vulnerabilityRanges)
+		if err != nil {
+			return nil, err
+		}
+		return slc, nil
+	})
+	if txErr != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+	}
+
+	return bulkVulnerabilityRangeGlobalID(*ids), nil
+}
+
+func vulnerabilityRangeConflictColumns() []string {
+	return []string{
+		vulnerabilityrange.FieldTenant,
+		vulnerabilityrange.FieldVulnerabilityID,
+		vulnerabilityrange.FieldPackageNameID,
+		vulnerabilityrange.FieldRangeType,
+		vulnerabilityrange.FieldIntroduced,
+		vulnerabilityrange.FieldFixed,
+		vulnerabilityrange.FieldLastAffected,
+		vulnerabilityrange.FieldOrigin,
+		vulnerabilityrange.FieldCollector,
+		vulnerabilityrange.FieldDocumentRef,
+	}
+}
+
+func upsertBulkVulnerabilityRange(ctx context.Context, tx *ent.Tx, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) (*[]string, error) {
+	ids := make([]string, 0)
+
+	batches := chunk(vulnerabilityRanges, MaxBatchSize)
+
+	index := 0
+	for _, vrs := range batches {
+		creates := make([]*ent.VulnerabilityRangeCreate, len(vrs))
+		for i, vr := range vrs {
+			vr := vr
+			var err error
+			var vrID *uuid.UUID
+			creates[i], vrID, err = generateVulnerabilityRangeCreate(ctx, tx, pkgs[index], vulnerabilities[index], vr)
+			if err != nil {
+				return nil, gqlerror.Errorf("generateVulnerabilityRangeCreate :: %s", err)
+			}
+			ids = append(ids, vrID.String())
+			index++
+		}
+
+		err := tx.VulnerabilityRange.CreateBulk(creates...).
+			OnConflict(
+				sql.ConflictColumns(vulnerabilityRangeConflictColumns()...),
+			).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "bulk upsert vulnerabilityRange node")
+		}
+	}
+
+	return &ids, nil
+}
+
+func generateVulnerabilityRangeCreate(ctx context.Context, tx *ent.Tx, pkg *model.IDorPkgInput, vuln *model.IDorVulnerabilityInput, vulnerabilityRange *model.VulnerabilityRangeInputSpec) (*ent.VulnerabilityRangeCreate, *uuid.UUID, error) {
+	vrCreate := tx.VulnerabilityRange.Create()
+
+	vrCreate.
+		SetRangeType(vulnerabilityrange.RangeType(vulnerabilityRange.RangeType)).
+		SetIntroduced(vulnerabilityRange.Introduced).
+		SetFixed(vulnerabilityRange.Fixed).
+		SetLastAffected(vulnerabilityRange.LastAffected).
+		SetOrigin(vulnerabilityRange.Origin).
+		SetCollector(vulnerabilityRange.Collector).
+		SetDocumentRef(vulnerabilityRange.DocumentRef)
+
+	if vuln == nil {
+		return nil, nil, fmt.Errorf("vulnerability must be specified for vulnerabilityRange ingestion")
+	}
+	var vulnID uuid.UUID
+	if vuln.VulnerabilityNodeID != nil {
+		var err error
+		vulnGlobalID := fromGlobalID(*vuln.VulnerabilityNodeID)
+		vulnID, err = uuid.Parse(vulnGlobalID.id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("uuid conversion from VulnerabilityNodeID failed with error: %w", err)
+		}
+	} else {
+		foundVulnID, err := tx.VulnerabilityID.Query().
+			Where(
+				vulnerabilityid.VulnerabilityIDEqualFold(vuln.VulnerabilityInput.VulnerabilityID),
+				vulnerabilityid.TypeEqualFold(vuln.VulnerabilityInput.Type),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query for vulnerability: %w", err)
+		}
+		vulnID = foundVulnID
+	}
+	vrCreate.SetVulnerabilityID(vulnID)
+
+	if pkg == nil {
+		return nil, nil, fmt.Errorf("package must be specified for vulnerabilityRange ingestion")
+	}
+	var pkgNameID uuid.UUID
+	if pkg.PackageNameID != nil {
+		var err error
+		pkgNameGlobalID := fromGlobalID(*pkg.PackageNameID)
+		pkgNameID, err = uuid.Parse(pkgNameGlobalID.id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("uuid conversion from PackageNameID failed with error: %w", err)
+		}
+	} else {
+		pn, err := getPkgName(ctx, tx.Client(), *pkg.PackageInput)
+		if err != nil {
+			return nil, nil, err
+		}
+		pkgNameID = pn.ID
+	}
+	vrCreate.SetPackageNameID(pkgNameID)
+
+	vrID := guacVulnerabilityRangeKey(vulnID.String(), pkgNameID.String(), vulnerabilityRange)
+	vrCreate.SetID(vrID)
+
+	return vrCreate, &vrID, nil
+}
+
+func upsertVulnerabilityRange(ctx context.Context, tx *ent.Tx, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (*string, error) {
+	vrCreate, _, err := generateVulnerabilityRangeCreate(ctx, tx, &pkg, &vulnerability, &vulnerabilityRange)
+	if err != nil {
+		return nil, gqlerror.Errorf("generateVulnerabilityRangeCreate :: %s", err)
+	}
+
+	if id, err := vrCreate.
+		OnConflict(
+			sql.ConflictColumns(vulnerabilityRangeConflictColumns()...),
+		).
+		Ignore().
+		ID(ctx); err != nil {
+
+		return nil, errors.Wrap(err, "upsert vulnerabilityRange node")
+	} else {
+		return ptrfrom.String(id.String()), nil
+	}
+}
+
+// guacVulnerabilityRangeKey generates an uuid based on the hash of the inputspec and inputs. vulnerabilityRange ID has to be set for bulk ingestion
+// when ingesting multiple edges otherwise you get "violates foreign key constraint" as it creates
+// a new ID for vulnerabilityRange node (even when already ingested) that it maps to the edge and fails the look up. This only occurs when using UUID with
+// "Default" func to generate a new UUID
+func guacVulnerabilityRangeKey(vulnID string, pkgNameID string, vr *model.VulnerabilityRangeInputSpec) uuid.UUID {
+	vrIDString := fmt.Sprintf("%s::%s::%s::%s::%s::%s::%s::%s::%s?", vulnID, pkgNameID, vr.RangeType,
+		vr.Introduced, vr.Fixed, vr.LastAffected, vr.Origin, vr.Collector, vr.DocumentRef)
+
+	return generateUUIDKey([]byte(vrIDString))
+}
+
+func toModelVulnerabilityRange(vr *ent.VulnerabilityRange) *model.VulnerabilityRange {
+	return &model.VulnerabilityRange{
+		ID:            vulnerabilityRangeGlobalID(vr.ID.String()),
+		Vulnerability: toModelVulnerabilityFromVulnerabilityID(vr.Edges.Vulnerability),
+		Package:       toModelPackage(backReferencePackageName(vr.Edges.Package)),
+		RangeType:     model.VulnerabilityRangeType(vr.RangeType),
+		Introduced:    vr.Introduced,
+		Fixed:         vr.Fixed,
+		LastAffected:  vr.LastAffected,
+		Origin:        vr.Origin,
+		Collector:     vr.Collector,
+		DocumentRef:   vr.DocumentRef,
+	}
+}
+
+func (b *EntBackend) vulnerabilityRangeNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
+	var out []model.Node
+
+	query := b.client.VulnerabilityRange.Query().
+		Where(vulnerabilityRangeQuery(model.VulnerabilityRangeSpec{ID: &nodeID}))
+
+	if allowedEdges[model.EdgeVulnerabilityRangePackage] {
+		query.
+			WithPackage(withPackageNameTree())
+	}
+	if allowedEdges[model.EdgeVulnerabilityRangeVulnerability] {
+		query.
+			WithVulnerability()
+	}
+
+	vrs, err := query.All(ctx)
+	if err != nil {
+		return []model.Node{}, fmt.Errorf("failed to query for vulnerabilityRange with node ID: %s with error: %w", nodeID, err)
+	}
+
+	for _, foundVR := range vrs {
+		if foundVR.Edges.Package != nil {
+			out = append(out, toModelPackage(backReferencePackageName(foundVR.Edges.Package)))
+		}
+		if foundVR.Edges.Vulnerability != nil {
+			out = append(out, toModelVulnerabilityFromVulnerabilityID(foundVR.Edges.Vulnerability))
+		}
+	}
+
+	return out, nil
+}
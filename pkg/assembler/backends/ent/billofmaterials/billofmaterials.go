@@ -13,6 +13,8 @@ const (
 	Label = "bill_of_materials"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldPackageID holds the string denoting the package_id field in the database.
 	FieldPackageID = "package_id"
 	// FieldArtifactID holds the string denoting the artifact_id field in the database.
@@ -94,6 +96,7 @@ const (
 // Columns holds all SQL columns for billofmaterials fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldPackageID,
 	FieldArtifactID,
 	FieldURI,
@@ -136,6 +139,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -148,6 +153,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByPackageID orders the results by the package_id field.
 func ByPackageID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPackageID, opts...).ToFunc()
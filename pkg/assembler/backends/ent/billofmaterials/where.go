@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEQ(FieldTenant, v))
+}
+
 // PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
 func PackageID(v uuid.UUID) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldEQ(FieldPackageID, v))
@@ -126,6 +131,71 @@ func IncludedOccurrencesHash(v string) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldEQ(FieldIncludedOccurrencesHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.BillOfMaterials {
+	return predicate.BillOfMaterials(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // PackageIDEQ applies the EQ predicate on the "package_id" field.
 func PackageIDEQ(v uuid.UUID) predicate.BillOfMaterials {
 	return predicate.BillOfMaterials(sql.FieldEQ(FieldPackageID, v))
@@ -28,6 +28,20 @@ type BillOfMaterialsCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (bomc *BillOfMaterialsCreate) SetTenant(s string) *BillOfMaterialsCreate {
+	bomc.mutation.SetTenant(s)
+	return bomc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (bomc *BillOfMaterialsCreate) SetNillableTenant(s *string) *BillOfMaterialsCreate {
+	if s != nil {
+		bomc.SetTenant(*s)
+	}
+	return bomc
+}
+
 // SetPackageID sets the "package_id" field.
 func (bomc *BillOfMaterialsCreate) SetPackageID(u uuid.UUID) *BillOfMaterialsCreate {
 	bomc.mutation.SetPackageID(u)
@@ -247,6 +261,10 @@ func (bomc *BillOfMaterialsCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (bomc *BillOfMaterialsCreate) defaults() {
+	if _, ok := bomc.mutation.Tenant(); !ok {
+		v := billofmaterials.DefaultTenant
+		bomc.mutation.SetTenant(v)
+	}
 	if _, ok := bomc.mutation.ID(); !ok {
 		v := billofmaterials.DefaultID()
 		bomc.mutation.SetID(v)
@@ -255,6 +273,9 @@ func (bomc *BillOfMaterialsCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (bomc *BillOfMaterialsCreate) check() error {
+	if _, ok := bomc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "BillOfMaterials.tenant"`)}
+	}
 	if _, ok := bomc.mutation.URI(); !ok {
 		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "BillOfMaterials.uri"`)}
 	}
@@ -327,6 +348,10 @@ func (bomc *BillOfMaterialsCreate) createSpec() (*BillOfMaterials, *sqlgraph.Cre
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := bomc.mutation.Tenant(); ok {
+		_spec.SetField(billofmaterials.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := bomc.mutation.URI(); ok {
 		_spec.SetField(billofmaterials.FieldURI, field.TypeString, value)
 		_node.URI = value
@@ -480,7 +505,7 @@ func (bomc *BillOfMaterialsCreate) createSpec() (*BillOfMaterials, *sqlgraph.Cre
 // of the `INSERT` statement. For example:
 //
 //	client.BillOfMaterials.Create().
-//		SetPackageID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -489,7 +514,7 @@ func (bomc *BillOfMaterialsCreate) createSpec() (*BillOfMaterials, *sqlgraph.Cre
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.BillOfMaterialsUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (bomc *BillOfMaterialsCreate) OnConflict(opts ...sql.ConflictOption) *BillOfMaterialsUpsertOne {
@@ -722,6 +747,9 @@ func (u *BillOfMaterialsUpsertOne) UpdateNewValues() *BillOfMaterialsUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(billofmaterials.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(billofmaterials.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -1099,7 +1127,7 @@ func (bomcb *BillOfMaterialsCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.BillOfMaterialsUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (bomcb *BillOfMaterialsCreateBulk) OnConflict(opts ...sql.ConflictOption) *BillOfMaterialsUpsertBulk {
@@ -1146,6 +1174,9 @@ func (u *BillOfMaterialsUpsertBulk) UpdateNewValues() *BillOfMaterialsUpsertBulk
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(billofmaterials.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(billofmaterials.FieldTenant)
+			}
 		}
 	}))
 	return u
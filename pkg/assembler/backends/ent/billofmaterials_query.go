@@ -484,12 +484,12 @@ func (bomq *BillOfMaterialsQuery) WithIncludedOccurrences(opts ...func(*Occurren
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.BillOfMaterials.Query().
-//		GroupBy(billofmaterials.FieldPackageID).
+//		GroupBy(billofmaterials.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (bomq *BillOfMaterialsQuery) GroupBy(field string, fields ...string) *BillOfMaterialsGroupBy {
@@ -507,11 +507,11 @@ func (bomq *BillOfMaterialsQuery) GroupBy(field string, fields ...string) *BillO
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.BillOfMaterials.Query().
-//		Select(billofmaterials.FieldPackageID).
+//		Select(billofmaterials.FieldTenant).
 //		Scan(ctx, &v)
 func (bomq *BillOfMaterialsQuery) Select(fields ...string) *BillOfMaterialsSelect {
 	bomq.ctx.Fields = append(bomq.ctx.Fields, fields...)
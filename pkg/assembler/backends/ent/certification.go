@@ -22,6 +22,8 @@ type Certification struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// SourceID holds the value of the "source_id" field.
 	SourceID *uuid.UUID `json:"source_id,omitempty"`
 	// PackageVersionID holds the value of the "package_version_id" field.
@@ -116,7 +118,7 @@ func (*Certification) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case certification.FieldSourceID, certification.FieldPackageVersionID, certification.FieldPackageNameID, certification.FieldArtifactID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case certification.FieldType, certification.FieldJustification, certification.FieldOrigin, certification.FieldCollector, certification.FieldDocumentRef:
+		case certification.FieldTenant, certification.FieldType, certification.FieldJustification, certification.FieldOrigin, certification.FieldCollector, certification.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case certification.FieldKnownSince:
 			values[i] = new(sql.NullTime)
@@ -143,6 +145,12 @@ func (c *Certification) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				c.ID = *value
 			}
+		case certification.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				c.Tenant = value.String
+			}
 		case certification.FieldSourceID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field source_id", values[i])
@@ -263,6 +271,9 @@ func (c *Certification) String() string {
 	var builder strings.Builder
 	builder.WriteString("Certification(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(c.Tenant)
+	builder.WriteString(", ")
 	if v := c.SourceID; v != nil {
 		builder.WriteString("source_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
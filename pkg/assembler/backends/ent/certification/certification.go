@@ -17,6 +17,8 @@ const (
 	Label = "certification"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldSourceID holds the string denoting the source_id field in the database.
 	FieldSourceID = "source_id"
 	// FieldPackageVersionID holds the string denoting the package_version_id field in the database.
@@ -80,6 +82,7 @@ const (
 // Columns holds all SQL columns for certification fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldSourceID,
 	FieldPackageVersionID,
 	FieldPackageNameID,
@@ -103,6 +106,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -141,6 +146,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // BySourceID orders the results by the source_id field.
 func BySourceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
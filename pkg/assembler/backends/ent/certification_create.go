@@ -28,6 +28,20 @@ type CertificationCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (cc *CertificationCreate) SetTenant(s string) *CertificationCreate {
+	cc.mutation.SetTenant(s)
+	return cc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (cc *CertificationCreate) SetNillableTenant(s *string) *CertificationCreate {
+	if s != nil {
+		cc.SetTenant(*s)
+	}
+	return cc
+}
+
 // SetSourceID sets the "source_id" field.
 func (cc *CertificationCreate) SetSourceID(u uuid.UUID) *CertificationCreate {
 	cc.mutation.SetSourceID(u)
@@ -211,6 +225,10 @@ func (cc *CertificationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *CertificationCreate) defaults() {
+	if _, ok := cc.mutation.Tenant(); !ok {
+		v := certification.DefaultTenant
+		cc.mutation.SetTenant(v)
+	}
 	if _, ok := cc.mutation.GetType(); !ok {
 		v := certification.DefaultType
 		cc.mutation.SetType(v)
@@ -223,6 +241,9 @@ func (cc *CertificationCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cc *CertificationCreate) check() error {
+	if _, ok := cc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Certification.tenant"`)}
+	}
 	if _, ok := cc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Certification.type"`)}
 	}
@@ -282,6 +303,10 @@ func (cc *CertificationCreate) createSpec() (*Certification, *sqlgraph.CreateSpe
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := cc.mutation.Tenant(); ok {
+		_spec.SetField(certification.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := cc.mutation.GetType(); ok {
 		_spec.SetField(certification.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -381,7 +406,7 @@ func (cc *CertificationCreate) createSpec() (*Certification, *sqlgraph.CreateSpe
 // of the `INSERT` statement. For example:
 //
 //	client.Certification.Create().
-//		SetSourceID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -390,7 +415,7 @@ func (cc *CertificationCreate) createSpec() (*Certification, *sqlgraph.CreateSpe
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertificationUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (cc *CertificationCreate) OnConflict(opts ...sql.ConflictOption) *CertificationUpsertOne {
@@ -587,6 +612,9 @@ func (u *CertificationUpsertOne) UpdateNewValues() *CertificationUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(certification.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(certification.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -922,7 +950,7 @@ func (ccb *CertificationCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertificationUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (ccb *CertificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertificationUpsertBulk {
@@ -969,6 +997,9 @@ func (u *CertificationUpsertBulk) UpdateNewValues() *CertificationUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(certification.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(certification.FieldTenant)
+			}
 		}
 	}))
 	return u
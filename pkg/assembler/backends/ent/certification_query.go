@@ -409,12 +409,12 @@ func (cq *CertificationQuery) WithArtifact(opts ...func(*ArtifactQuery)) *Certif
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Certification.Query().
-//		GroupBy(certification.FieldSourceID).
+//		GroupBy(certification.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (cq *CertificationQuery) GroupBy(field string, fields ...string) *CertificationGroupBy {
@@ -432,11 +432,11 @@ func (cq *CertificationQuery) GroupBy(field string, fields ...string) *Certifica
 // Example:
 //
 //	var v []struct {
-//		SourceID uuid.UUID `json:"source_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Certification.Query().
-//		Select(certification.FieldSourceID).
+//		Select(certification.FieldTenant).
 //		Scan(ctx, &v)
 func (cq *CertificationQuery) Select(fields ...string) *CertificationSelect {
 	cq.ctx.Fields = append(cq.ctx.Fields, fields...)
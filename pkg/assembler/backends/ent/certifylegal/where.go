@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEQ(FieldTenant, v))
+}
+
 // PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
 func PackageID(v uuid.UUID) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldEQ(FieldPackageID, v))
@@ -116,6 +121,71 @@ func DiscoveredLicensesHash(v string) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldEQ(FieldDiscoveredLicensesHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.CertifyLegal {
+	return predicate.CertifyLegal(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // PackageIDEQ applies the EQ predicate on the "package_id" field.
 func PackageIDEQ(v uuid.UUID) predicate.CertifyLegal {
 	return predicate.CertifyLegal(sql.FieldEQ(FieldPackageID, v))
@@ -27,6 +27,20 @@ type CertifyLegalCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (clc *CertifyLegalCreate) SetTenant(s string) *CertifyLegalCreate {
+	clc.mutation.SetTenant(s)
+	return clc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (clc *CertifyLegalCreate) SetNillableTenant(s *string) *CertifyLegalCreate {
+	if s != nil {
+		clc.SetTenant(*s)
+	}
+	return clc
+}
+
 // SetPackageID sets the "package_id" field.
 func (clc *CertifyLegalCreate) SetPackageID(u uuid.UUID) *CertifyLegalCreate {
 	clc.mutation.SetPackageID(u)
@@ -204,6 +218,10 @@ func (clc *CertifyLegalCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (clc *CertifyLegalCreate) defaults() {
+	if _, ok := clc.mutation.Tenant(); !ok {
+		v := certifylegal.DefaultTenant
+		clc.mutation.SetTenant(v)
+	}
 	if _, ok := clc.mutation.ID(); !ok {
 		v := certifylegal.DefaultID()
 		clc.mutation.SetID(v)
@@ -212,6 +230,9 @@ func (clc *CertifyLegalCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (clc *CertifyLegalCreate) check() error {
+	if _, ok := clc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "CertifyLegal.tenant"`)}
+	}
 	if _, ok := clc.mutation.DeclaredLicense(); !ok {
 		return &ValidationError{Name: "declared_license", err: errors.New(`ent: missing required field "CertifyLegal.declared_license"`)}
 	}
@@ -278,6 +299,10 @@ func (clc *CertifyLegalCreate) createSpec() (*CertifyLegal, *sqlgraph.CreateSpec
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := clc.mutation.Tenant(); ok {
+		_spec.SetField(certifylegal.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := clc.mutation.DeclaredLicense(); ok {
 		_spec.SetField(certifylegal.FieldDeclaredLicense, field.TypeString, value)
 		_node.DeclaredLicense = value
@@ -391,7 +416,7 @@ func (clc *CertifyLegalCreate) createSpec() (*CertifyLegal, *sqlgraph.CreateSpec
 // of the `INSERT` statement. For example:
 //
 //	client.CertifyLegal.Create().
-//		SetPackageID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -400,7 +425,7 @@ func (clc *CertifyLegalCreate) createSpec() (*CertifyLegal, *sqlgraph.CreateSpec
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyLegalUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (clc *CertifyLegalCreate) OnConflict(opts ...sql.ConflictOption) *CertifyLegalUpsertOne {
@@ -609,6 +634,9 @@ func (u *CertifyLegalUpsertOne) UpdateNewValues() *CertifyLegalUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(certifylegal.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(certifylegal.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -958,7 +986,7 @@ func (clcb *CertifyLegalCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyLegalUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (clcb *CertifyLegalCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyLegalUpsertBulk {
@@ -1005,6 +1033,9 @@ func (u *CertifyLegalUpsertBulk) UpdateNewValues() *CertifyLegalUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(certifylegal.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(certifylegal.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+)
+
+// CertifyPolicy is the model entity for the CertifyPolicy schema.
+type CertifyPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// ID of the verified artifact
+	ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
+	// URI identifying the verifier
+	Verifier string `json:"verifier,omitempty"`
+	// URI of the policy the artifact was verified against
+	PolicyURI string `json:"policy_uri,omitempty"`
+	// Digest of the policy
+	PolicyDigest string `json:"policy_digest,omitempty"`
+	// Result holds the value of the "result" field.
+	Result certifypolicy.Result `json:"result,omitempty"`
+	// SLSA levels the artifact was verified at
+	VerifiedLevels []string `json:"verified_levels,omitempty"`
+	// TimeVerified holds the value of the "time_verified" field.
+	TimeVerified time.Time `json:"time_verified,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// DocumentRef holds the value of the "document_ref" field.
+	DocumentRef string `json:"document_ref,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CertifyPolicyQuery when eager-loading is set.
+	Edges        CertifyPolicyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CertifyPolicyEdges holds the relations/edges for other nodes in the graph.
+type CertifyPolicyEdges struct {
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CertifyPolicyEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.Artifact != nil {
+		return e.Artifact, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: artifact.Label}
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CertifyPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case certifypolicy.FieldVerifiedLevels:
+			values[i] = new([]byte)
+		case certifypolicy.FieldTenant, certifypolicy.FieldVerifier, certifypolicy.FieldPolicyURI, certifypolicy.FieldPolicyDigest, certifypolicy.FieldResult, certifypolicy.FieldOrigin, certifypolicy.FieldCollector, certifypolicy.FieldDocumentRef:
+			values[i] = new(sql.NullString)
+		case certifypolicy.FieldTimeVerified:
+			values[i] = new(sql.NullTime)
+		case certifypolicy.FieldID, certifypolicy.FieldArtifactID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CertifyPolicy fields.
+func (cp *CertifyPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case certifypolicy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cp.ID = *value
+			}
+		case certifypolicy.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				cp.Tenant = value.String
+			}
+		case certifypolicy.FieldArtifactID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value != nil {
+				cp.ArtifactID = *value
+			}
+		case certifypolicy.FieldVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verifier", values[i])
+			} else if value.Valid {
+				cp.Verifier = value.String
+			}
+		case certifypolicy.FieldPolicyURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_uri", values[i])
+			} else if value.Valid {
+				cp.PolicyURI = value.String
+			}
+		case certifypolicy.FieldPolicyDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_digest", values[i])
+			} else if value.Valid {
+				cp.PolicyDigest = value.String
+			}
+		case certifypolicy.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				cp.Result = certifypolicy.Result(value.String)
+			}
+		case certifypolicy.FieldVerifiedLevels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_levels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cp.VerifiedLevels); err != nil {
+					return fmt.Errorf("unmarshal field verified_levels: %w", err)
+				}
+			}
+		case certifypolicy.FieldTimeVerified:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field time_verified", values[i])
+			} else if value.Valid {
+				cp.TimeVerified = value.Time
+			}
+		case certifypolicy.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				cp.Origin = value.String
+			}
+		case certifypolicy.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				cp.Collector = value.String
+			}
+		case certifypolicy.FieldDocumentRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_ref", values[i])
+			} else if value.Valid {
+				cp.DocumentRef = value.String
+			}
+		default:
+			cp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CertifyPolicy.
+// This includes values selected through modifiers, order, etc.
+func (cp *CertifyPolicy) Value(name string) (ent.Value, error) {
+	return cp.selectValues.Get(name)
+}
+
+// QueryArtifact queries the "artifact" edge of the CertifyPolicy entity.
+func (cp *CertifyPolicy) QueryArtifact() *ArtifactQuery {
+	return NewCertifyPolicyClient(cp.config).QueryArtifact(cp)
+}
+
+// Update returns a builder for updating this CertifyPolicy.
+// Note that you need to call CertifyPolicy.Unwrap() before calling this method if this CertifyPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cp *CertifyPolicy) Update() *CertifyPolicyUpdateOne {
+	return NewCertifyPolicyClient(cp.config).UpdateOne(cp)
+}
+
+// Unwrap unwraps the CertifyPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cp *CertifyPolicy) Unwrap() *CertifyPolicy {
+	_tx, ok := cp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CertifyPolicy is not a transactional entity")
+	}
+	cp.config.driver = _tx.drv
+	return cp
+}
+
+// String implements the fmt.Stringer.
+func (cp *CertifyPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("CertifyPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cp.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(cp.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("artifact_id=")
+	builder.WriteString(fmt.Sprintf("%v", cp.ArtifactID))
+	builder.WriteString(", ")
+	builder.WriteString("verifier=")
+	builder.WriteString(cp.Verifier)
+	builder.WriteString(", ")
+	builder.WriteString("policy_uri=")
+	builder.WriteString(cp.PolicyURI)
+	builder.WriteString(", ")
+	builder.WriteString("policy_digest=")
+	builder.WriteString(cp.PolicyDigest)
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(fmt.Sprintf("%v", cp.Result))
+	builder.WriteString(", ")
+	builder.WriteString("verified_levels=")
+	builder.WriteString(fmt.Sprintf("%v", cp.VerifiedLevels))
+	builder.WriteString(", ")
+	builder.WriteString("time_verified=")
+	builder.WriteString(cp.TimeVerified.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(cp.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(cp.Collector)
+	builder.WriteString(", ")
+	builder.WriteString("document_ref=")
+	builder.WriteString(cp.DocumentRef)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CertifyPolicies is a parsable slice of CertifyPolicy.
+type CertifyPolicies []*CertifyPolicy
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifypolicy
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the certifypolicy type in the database.
+	Label = "certify_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldArtifactID holds the string denoting the artifact_id field in the database.
+	FieldArtifactID = "artifact_id"
+	// FieldVerifier holds the string denoting the verifier field in the database.
+	FieldVerifier = "verifier"
+	// FieldPolicyURI holds the string denoting the policy_uri field in the database.
+	FieldPolicyURI = "policy_uri"
+	// FieldPolicyDigest holds the string denoting the policy_digest field in the database.
+	FieldPolicyDigest = "policy_digest"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
+	// FieldVerifiedLevels holds the string denoting the verified_levels field in the database.
+	FieldVerifiedLevels = "verified_levels"
+	// FieldTimeVerified holds the string denoting the time_verified field in the database.
+	FieldTimeVerified = "time_verified"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// FieldDocumentRef holds the string denoting the document_ref field in the database.
+	FieldDocumentRef = "document_ref"
+	// EdgeArtifact holds the string denoting the artifact edge name in mutations.
+	EdgeArtifact = "artifact"
+	// Table holds the table name of the certifypolicy in the database.
+	Table = "certify_policies"
+	// ArtifactTable is the table that holds the artifact relation/edge.
+	ArtifactTable = "certify_policies"
+	// ArtifactInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactInverseTable = "artifacts"
+	// ArtifactColumn is the table column denoting the artifact relation/edge.
+	ArtifactColumn = "artifact_id"
+)
+
+// Columns holds all SQL columns for certifypolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldArtifactID,
+	FieldVerifier,
+	FieldPolicyURI,
+	FieldPolicyDigest,
+	FieldResult,
+	FieldVerifiedLevels,
+	FieldTimeVerified,
+	FieldOrigin,
+	FieldCollector,
+	FieldDocumentRef,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Result defines the type for the "result" enum field.
+type Result string
+
+// Result values.
+const (
+	ResultPASSED Result = "PASSED"
+	ResultFAILED Result = "FAILED"
+)
+
+func (r Result) String() string {
+	return string(r)
+}
+
+// ResultValidator is a validator for the "result" field enum values. It is called by the builders before save.
+func ResultValidator(r Result) error {
+	switch r {
+	case ResultPASSED, ResultFAILED:
+		return nil
+	default:
+		return fmt.Errorf("certifypolicy: invalid enum value for result field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the CertifyPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByArtifactID orders the results by the artifact_id field.
+func ByArtifactID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArtifactID, opts...).ToFunc()
+}
+
+// ByVerifier orders the results by the verifier field.
+func ByVerifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifier, opts...).ToFunc()
+}
+
+// ByPolicyURI orders the results by the policy_uri field.
+func ByPolicyURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyURI, opts...).ToFunc()
+}
+
+// ByPolicyDigest orders the results by the policy_digest field.
+func ByPolicyDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyDigest, opts...).ToFunc()
+}
+
+// ByResult orders the results by the result field.
+func ByResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResult, opts...).ToFunc()
+}
+
+// ByTimeVerified orders the results by the time_verified field.
+func ByTimeVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeVerified, opts...).ToFunc()
+}
+
+// ByOrigin orders the results by the origin field.
+func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
+}
+
+// ByCollector orders the results by the collector field.
+func ByCollector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollector, opts...).ToFunc()
+}
+
+// ByDocumentRef orders the results by the document_ref field.
+func ByDocumentRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentRef, opts...).ToFunc()
+}
+
+// ByArtifactField orders the results by artifact field.
+func ByArtifactField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArtifactStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newArtifactStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArtifactInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Result) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Result) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Result(str)
+	if err := ResultValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Result", str)
+	}
+	return nil
+}
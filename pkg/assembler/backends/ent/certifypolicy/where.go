@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package certifypolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldTenant, v))
+}
+
+// ArtifactID applies equality check predicate on the "artifact_id" field. It's identical to ArtifactIDEQ.
+func ArtifactID(v uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// Verifier applies equality check predicate on the "verifier" field. It's identical to VerifierEQ.
+func Verifier(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldVerifier, v))
+}
+
+// PolicyURI applies equality check predicate on the "policy_uri" field. It's identical to PolicyURIEQ.
+func PolicyURI(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldPolicyURI, v))
+}
+
+// PolicyDigest applies equality check predicate on the "policy_digest" field. It's identical to PolicyDigestEQ.
+func PolicyDigest(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldPolicyDigest, v))
+}
+
+// TimeVerified applies equality check predicate on the "time_verified" field. It's identical to TimeVerifiedEQ.
+func TimeVerified(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldTimeVerified, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldCollector, v))
+}
+
+// DocumentRef applies equality check predicate on the "document_ref" field. It's identical to DocumentRefEQ.
+func DocumentRef(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// ArtifactIDEQ applies the EQ predicate on the "artifact_id" field.
+func ArtifactIDEQ(v uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDNEQ applies the NEQ predicate on the "artifact_id" field.
+func ArtifactIDNEQ(v uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDIn applies the In predicate on the "artifact_id" field.
+func ArtifactIDIn(vs ...uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldArtifactID, vs...))
+}
+
+// ArtifactIDNotIn applies the NotIn predicate on the "artifact_id" field.
+func ArtifactIDNotIn(vs ...uuid.UUID) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldArtifactID, vs...))
+}
+
+// VerifierEQ applies the EQ predicate on the "verifier" field.
+func VerifierEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldVerifier, v))
+}
+
+// VerifierNEQ applies the NEQ predicate on the "verifier" field.
+func VerifierNEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldVerifier, v))
+}
+
+// VerifierIn applies the In predicate on the "verifier" field.
+func VerifierIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldVerifier, vs...))
+}
+
+// VerifierNotIn applies the NotIn predicate on the "verifier" field.
+func VerifierNotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldVerifier, vs...))
+}
+
+// VerifierGT applies the GT predicate on the "verifier" field.
+func VerifierGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldVerifier, v))
+}
+
+// VerifierGTE applies the GTE predicate on the "verifier" field.
+func VerifierGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldVerifier, v))
+}
+
+// VerifierLT applies the LT predicate on the "verifier" field.
+func VerifierLT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldVerifier, v))
+}
+
+// VerifierLTE applies the LTE predicate on the "verifier" field.
+func VerifierLTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldVerifier, v))
+}
+
+// VerifierContains applies the Contains predicate on the "verifier" field.
+func VerifierContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldVerifier, v))
+}
+
+// VerifierHasPrefix applies the HasPrefix predicate on the "verifier" field.
+func VerifierHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldVerifier, v))
+}
+
+// VerifierHasSuffix applies the HasSuffix predicate on the "verifier" field.
+func VerifierHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldVerifier, v))
+}
+
+// VerifierEqualFold applies the EqualFold predicate on the "verifier" field.
+func VerifierEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldVerifier, v))
+}
+
+// VerifierContainsFold applies the ContainsFold predicate on the "verifier" field.
+func VerifierContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldVerifier, v))
+}
+
+// PolicyURIEQ applies the EQ predicate on the "policy_uri" field.
+func PolicyURIEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldPolicyURI, v))
+}
+
+// PolicyURINEQ applies the NEQ predicate on the "policy_uri" field.
+func PolicyURINEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldPolicyURI, v))
+}
+
+// PolicyURIIn applies the In predicate on the "policy_uri" field.
+func PolicyURIIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldPolicyURI, vs...))
+}
+
+// PolicyURINotIn applies the NotIn predicate on the "policy_uri" field.
+func PolicyURINotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldPolicyURI, vs...))
+}
+
+// PolicyURIGT applies the GT predicate on the "policy_uri" field.
+func PolicyURIGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldPolicyURI, v))
+}
+
+// PolicyURIGTE applies the GTE predicate on the "policy_uri" field.
+func PolicyURIGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldPolicyURI, v))
+}
+
+// PolicyURILT applies the LT predicate on the "policy_uri" field.
+func PolicyURILT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldPolicyURI, v))
+}
+
+// PolicyURILTE applies the LTE predicate on the "policy_uri" field.
+func PolicyURILTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldPolicyURI, v))
+}
+
+// PolicyURIContains applies the Contains predicate on the "policy_uri" field.
+func PolicyURIContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldPolicyURI, v))
+}
+
+// PolicyURIHasPrefix applies the HasPrefix predicate on the "policy_uri" field.
+func PolicyURIHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldPolicyURI, v))
+}
+
+// PolicyURIHasSuffix applies the HasSuffix predicate on the "policy_uri" field.
+func PolicyURIHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldPolicyURI, v))
+}
+
+// PolicyURIEqualFold applies the EqualFold predicate on the "policy_uri" field.
+func PolicyURIEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldPolicyURI, v))
+}
+
+// PolicyURIContainsFold applies the ContainsFold predicate on the "policy_uri" field.
+func PolicyURIContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldPolicyURI, v))
+}
+
+// PolicyDigestEQ applies the EQ predicate on the "policy_digest" field.
+func PolicyDigestEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldPolicyDigest, v))
+}
+
+// PolicyDigestNEQ applies the NEQ predicate on the "policy_digest" field.
+func PolicyDigestNEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldPolicyDigest, v))
+}
+
+// PolicyDigestIn applies the In predicate on the "policy_digest" field.
+func PolicyDigestIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldPolicyDigest, vs...))
+}
+
+// PolicyDigestNotIn applies the NotIn predicate on the "policy_digest" field.
+func PolicyDigestNotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldPolicyDigest, vs...))
+}
+
+// PolicyDigestGT applies the GT predicate on the "policy_digest" field.
+func PolicyDigestGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldPolicyDigest, v))
+}
+
+// PolicyDigestGTE applies the GTE predicate on the "policy_digest" field.
+func PolicyDigestGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldPolicyDigest, v))
+}
+
+// PolicyDigestLT applies the LT predicate on the "policy_digest" field.
+func PolicyDigestLT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldPolicyDigest, v))
+}
+
+// PolicyDigestLTE applies the LTE predicate on the "policy_digest" field.
+func PolicyDigestLTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldPolicyDigest, v))
+}
+
+// PolicyDigestContains applies the Contains predicate on the "policy_digest" field.
+func PolicyDigestContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldPolicyDigest, v))
+}
+
+// PolicyDigestHasPrefix applies the HasPrefix predicate on the "policy_digest" field.
+func PolicyDigestHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldPolicyDigest, v))
+}
+
+// PolicyDigestHasSuffix applies the HasSuffix predicate on the "policy_digest" field.
+func PolicyDigestHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldPolicyDigest, v))
+}
+
+// PolicyDigestEqualFold applies the EqualFold predicate on the "policy_digest" field.
+func PolicyDigestEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldPolicyDigest, v))
+}
+
+// PolicyDigestContainsFold applies the ContainsFold predicate on the "policy_digest" field.
+func PolicyDigestContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldPolicyDigest, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v Result) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v Result) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...Result) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...Result) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// VerifiedLevelsIsNil applies the IsNil predicate on the "verified_levels" field.
+func VerifiedLevelsIsNil() predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIsNull(FieldVerifiedLevels))
+}
+
+// VerifiedLevelsNotNil applies the NotNil predicate on the "verified_levels" field.
+func VerifiedLevelsNotNil() predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotNull(FieldVerifiedLevels))
+}
+
+// TimeVerifiedEQ applies the EQ predicate on the "time_verified" field.
+func TimeVerifiedEQ(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldTimeVerified, v))
+}
+
+// TimeVerifiedNEQ applies the NEQ predicate on the "time_verified" field.
+func TimeVerifiedNEQ(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldTimeVerified, v))
+}
+
+// TimeVerifiedIn applies the In predicate on the "time_verified" field.
+func TimeVerifiedIn(vs ...time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldTimeVerified, vs...))
+}
+
+// TimeVerifiedNotIn applies the NotIn predicate on the "time_verified" field.
+func TimeVerifiedNotIn(vs ...time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldTimeVerified, vs...))
+}
+
+// TimeVerifiedGT applies the GT predicate on the "time_verified" field.
+func TimeVerifiedGT(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldTimeVerified, v))
+}
+
+// TimeVerifiedGTE applies the GTE predicate on the "time_verified" field.
+func TimeVerifiedGTE(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldTimeVerified, v))
+}
+
+// TimeVerifiedLT applies the LT predicate on the "time_verified" field.
+func TimeVerifiedLT(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldTimeVerified, v))
+}
+
+// TimeVerifiedLTE applies the LTE predicate on the "time_verified" field.
+func TimeVerifiedLTE(v time.Time) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldTimeVerified, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// DocumentRefEQ applies the EQ predicate on the "document_ref" field.
+func DocumentRefEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefNEQ applies the NEQ predicate on the "document_ref" field.
+func DocumentRefNEQ(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefIn applies the In predicate on the "document_ref" field.
+func DocumentRefIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefNotIn applies the NotIn predicate on the "document_ref" field.
+func DocumentRefNotIn(vs ...string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldNotIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefGT applies the GT predicate on the "document_ref" field.
+func DocumentRefGT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGT(FieldDocumentRef, v))
+}
+
+// DocumentRefGTE applies the GTE predicate on the "document_ref" field.
+func DocumentRefGTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldGTE(FieldDocumentRef, v))
+}
+
+// DocumentRefLT applies the LT predicate on the "document_ref" field.
+func DocumentRefLT(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLT(FieldDocumentRef, v))
+}
+
+// DocumentRefLTE applies the LTE predicate on the "document_ref" field.
+func DocumentRefLTE(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldLTE(FieldDocumentRef, v))
+}
+
+// DocumentRefContains applies the Contains predicate on the "document_ref" field.
+func DocumentRefContains(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContains(FieldDocumentRef, v))
+}
+
+// DocumentRefHasPrefix applies the HasPrefix predicate on the "document_ref" field.
+func DocumentRefHasPrefix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasPrefix(FieldDocumentRef, v))
+}
+
+// DocumentRefHasSuffix applies the HasSuffix predicate on the "document_ref" field.
+func DocumentRefHasSuffix(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldHasSuffix(FieldDocumentRef, v))
+}
+
+// DocumentRefEqualFold applies the EqualFold predicate on the "document_ref" field.
+func DocumentRefEqualFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldEqualFold(FieldDocumentRef, v))
+}
+
+// DocumentRefContainsFold applies the ContainsFold predicate on the "document_ref" field.
+func DocumentRefContainsFold(v string) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.FieldContainsFold(FieldDocumentRef, v))
+}
+
+// HasArtifact applies the HasEdge predicate on the "artifact" edge.
+func HasArtifact() predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtifactWith applies the HasEdge predicate on the "artifact" edge with a given conditions (other predicates).
+func HasArtifactWith(preds ...predicate.Artifact) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(func(s *sql.Selector) {
+		step := newArtifactStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CertifyPolicy) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CertifyPolicy) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CertifyPolicy) predicate.CertifyPolicy {
+	return predicate.CertifyPolicy(sql.NotPredicates(p))
+}
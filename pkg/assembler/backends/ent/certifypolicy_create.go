@@ -0,0 +1,1061 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+)
+
+// CertifyPolicyCreate is the builder for creating a CertifyPolicy entity.
+type CertifyPolicyCreate struct {
+	config
+	mutation *CertifyPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenant sets the "tenant" field.
+func (cpc *CertifyPolicyCreate) SetTenant(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetTenant(s)
+	return cpc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (cpc *CertifyPolicyCreate) SetNillableTenant(s *string) *CertifyPolicyCreate {
+	if s != nil {
+		cpc.SetTenant(*s)
+	}
+	return cpc
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cpc *CertifyPolicyCreate) SetArtifactID(u uuid.UUID) *CertifyPolicyCreate {
+	cpc.mutation.SetArtifactID(u)
+	return cpc
+}
+
+// SetVerifier sets the "verifier" field.
+func (cpc *CertifyPolicyCreate) SetVerifier(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetVerifier(s)
+	return cpc
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (cpc *CertifyPolicyCreate) SetPolicyURI(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetPolicyURI(s)
+	return cpc
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (cpc *CertifyPolicyCreate) SetPolicyDigest(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetPolicyDigest(s)
+	return cpc
+}
+
+// SetResult sets the "result" field.
+func (cpc *CertifyPolicyCreate) SetResult(c certifypolicy.Result) *CertifyPolicyCreate {
+	cpc.mutation.SetResult(c)
+	return cpc
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (cpc *CertifyPolicyCreate) SetVerifiedLevels(s []string) *CertifyPolicyCreate {
+	cpc.mutation.SetVerifiedLevels(s)
+	return cpc
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (cpc *CertifyPolicyCreate) SetTimeVerified(t time.Time) *CertifyPolicyCreate {
+	cpc.mutation.SetTimeVerified(t)
+	return cpc
+}
+
+// SetOrigin sets the "origin" field.
+func (cpc *CertifyPolicyCreate) SetOrigin(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetOrigin(s)
+	return cpc
+}
+
+// SetCollector sets the "collector" field.
+func (cpc *CertifyPolicyCreate) SetCollector(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetCollector(s)
+	return cpc
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (cpc *CertifyPolicyCreate) SetDocumentRef(s string) *CertifyPolicyCreate {
+	cpc.mutation.SetDocumentRef(s)
+	return cpc
+}
+
+// SetID sets the "id" field.
+func (cpc *CertifyPolicyCreate) SetID(u uuid.UUID) *CertifyPolicyCreate {
+	cpc.mutation.SetID(u)
+	return cpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cpc *CertifyPolicyCreate) SetNillableID(u *uuid.UUID) *CertifyPolicyCreate {
+	if u != nil {
+		cpc.SetID(*u)
+	}
+	return cpc
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cpc *CertifyPolicyCreate) SetArtifact(a *Artifact) *CertifyPolicyCreate {
+	return cpc.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyPolicyMutation object of the builder.
+func (cpc *CertifyPolicyCreate) Mutation() *CertifyPolicyMutation {
+	return cpc.mutation
+}
+
+// Save creates the CertifyPolicy in the database.
+func (cpc *CertifyPolicyCreate) Save(ctx context.Context) (*CertifyPolicy, error) {
+	cpc.defaults()
+	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cpc *CertifyPolicyCreate) SaveX(ctx context.Context) *CertifyPolicy {
+	v, err := cpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpc *CertifyPolicyCreate) Exec(ctx context.Context) error {
+	_, err := cpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpc *CertifyPolicyCreate) ExecX(ctx context.Context) {
+	if err := cpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpc *CertifyPolicyCreate) defaults() {
+	if _, ok := cpc.mutation.Tenant(); !ok {
+		v := certifypolicy.DefaultTenant
+		cpc.mutation.SetTenant(v)
+	}
+	if _, ok := cpc.mutation.ID(); !ok {
+		v := certifypolicy.DefaultID()
+		cpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpc *CertifyPolicyCreate) check() error {
+	if _, ok := cpc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "CertifyPolicy.tenant"`)}
+	}
+	if _, ok := cpc.mutation.ArtifactID(); !ok {
+		return &ValidationError{Name: "artifact_id", err: errors.New(`ent: missing required field "CertifyPolicy.artifact_id"`)}
+	}
+	if _, ok := cpc.mutation.Verifier(); !ok {
+		return &ValidationError{Name: "verifier", err: errors.New(`ent: missing required field "CertifyPolicy.verifier"`)}
+	}
+	if _, ok := cpc.mutation.PolicyURI(); !ok {
+		return &ValidationError{Name: "policy_uri", err: errors.New(`ent: missing required field "CertifyPolicy.policy_uri"`)}
+	}
+	if _, ok := cpc.mutation.PolicyDigest(); !ok {
+		return &ValidationError{Name: "policy_digest", err: errors.New(`ent: missing required field "CertifyPolicy.policy_digest"`)}
+	}
+	if _, ok := cpc.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`ent: missing required field "CertifyPolicy.result"`)}
+	}
+	if v, ok := cpc.mutation.Result(); ok {
+		if err := certifypolicy.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "CertifyPolicy.result": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.TimeVerified(); !ok {
+		return &ValidationError{Name: "time_verified", err: errors.New(`ent: missing required field "CertifyPolicy.time_verified"`)}
+	}
+	if _, ok := cpc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`ent: missing required field "CertifyPolicy.origin"`)}
+	}
+	if _, ok := cpc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`ent: missing required field "CertifyPolicy.collector"`)}
+	}
+	if _, ok := cpc.mutation.DocumentRef(); !ok {
+		return &ValidationError{Name: "document_ref", err: errors.New(`ent: missing required field "CertifyPolicy.document_ref"`)}
+	}
+	if len(cpc.mutation.ArtifactIDs()) == 0 {
+		return &ValidationError{Name: "artifact", err: errors.New(`ent: missing required edge "CertifyPolicy.artifact"`)}
+	}
+	return nil
+}
+
+func (cpc *CertifyPolicyCreate) sqlSave(ctx context.Context) (*CertifyPolicy, error) {
+	if err := cpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cpc.mutation.id = &_node.ID
+	cpc.mutation.done = true
+	return _node, nil
+}
+
+func (cpc *CertifyPolicyCreate) createSpec() (*CertifyPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CertifyPolicy{config: cpc.config}
+		_spec = sqlgraph.NewCreateSpec(certifypolicy.Table, sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cpc.conflict
+	if id, ok := cpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cpc.mutation.Tenant(); ok {
+		_spec.SetField(certifypolicy.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := cpc.mutation.Verifier(); ok {
+		_spec.SetField(certifypolicy.FieldVerifier, field.TypeString, value)
+		_node.Verifier = value
+	}
+	if value, ok := cpc.mutation.PolicyURI(); ok {
+		_spec.SetField(certifypolicy.FieldPolicyURI, field.TypeString, value)
+		_node.PolicyURI = value
+	}
+	if value, ok := cpc.mutation.PolicyDigest(); ok {
+		_spec.SetField(certifypolicy.FieldPolicyDigest, field.TypeString, value)
+		_node.PolicyDigest = value
+	}
+	if value, ok := cpc.mutation.Result(); ok {
+		_spec.SetField(certifypolicy.FieldResult, field.TypeEnum, value)
+		_node.Result = value
+	}
+	if value, ok := cpc.mutation.VerifiedLevels(); ok {
+		_spec.SetField(certifypolicy.FieldVerifiedLevels, field.TypeJSON, value)
+		_node.VerifiedLevels = value
+	}
+	if value, ok := cpc.mutation.TimeVerified(); ok {
+		_spec.SetField(certifypolicy.FieldTimeVerified, field.TypeTime, value)
+		_node.TimeVerified = value
+	}
+	if value, ok := cpc.mutation.Origin(); ok {
+		_spec.SetField(certifypolicy.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := cpc.mutation.Collector(); ok {
+		_spec.SetField(certifypolicy.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := cpc.mutation.DocumentRef(); ok {
+		_spec.SetField(certifypolicy.FieldDocumentRef, field.TypeString, value)
+		_node.DocumentRef = value
+	}
+	if nodes := cpc.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifypolicy.ArtifactTable,
+			Columns: []string{certifypolicy.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtifactID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyPolicy.Create().
+//		SetTenant(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyPolicyUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+func (cpc *CertifyPolicyCreate) OnConflict(opts ...sql.ConflictOption) *CertifyPolicyUpsertOne {
+	cpc.conflict = opts
+	return &CertifyPolicyUpsertOne{
+		create: cpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpc *CertifyPolicyCreate) OnConflictColumns(columns ...string) *CertifyPolicyUpsertOne {
+	cpc.conflict = append(cpc.conflict, sql.ConflictColumns(columns...))
+	return &CertifyPolicyUpsertOne{
+		create: cpc,
+	}
+}
+
+type (
+	// CertifyPolicyUpsertOne is the builder for "upsert"-ing
+	//  one CertifyPolicy node.
+	CertifyPolicyUpsertOne struct {
+		create *CertifyPolicyCreate
+	}
+
+	// CertifyPolicyUpsert is the "OnConflict" setter.
+	CertifyPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyPolicyUpsert) SetArtifactID(v uuid.UUID) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldArtifactID, v)
+	return u
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateArtifactID() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldArtifactID)
+	return u
+}
+
+// SetVerifier sets the "verifier" field.
+func (u *CertifyPolicyUpsert) SetVerifier(v string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldVerifier, v)
+	return u
+}
+
+// UpdateVerifier sets the "verifier" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateVerifier() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldVerifier)
+	return u
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (u *CertifyPolicyUpsert) SetPolicyURI(v string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldPolicyURI, v)
+	return u
+}
+
+// UpdatePolicyURI sets the "policy_uri" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdatePolicyURI() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldPolicyURI)
+	return u
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (u *CertifyPolicyUpsert) SetPolicyDigest(v string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldPolicyDigest, v)
+	return u
+}
+
+// UpdatePolicyDigest sets the "policy_digest" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdatePolicyDigest() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldPolicyDigest)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *CertifyPolicyUpsert) SetResult(v certifypolicy.Result) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateResult() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldResult)
+	return u
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (u *CertifyPolicyUpsert) SetVerifiedLevels(v []string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldVerifiedLevels, v)
+	return u
+}
+
+// UpdateVerifiedLevels sets the "verified_levels" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateVerifiedLevels() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldVerifiedLevels)
+	return u
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (u *CertifyPolicyUpsert) ClearVerifiedLevels() *CertifyPolicyUpsert {
+	u.SetNull(certifypolicy.FieldVerifiedLevels)
+	return u
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (u *CertifyPolicyUpsert) SetTimeVerified(v time.Time) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldTimeVerified, v)
+	return u
+}
+
+// UpdateTimeVerified sets the "time_verified" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateTimeVerified() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldTimeVerified)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyPolicyUpsert) SetOrigin(v string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateOrigin() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyPolicyUpsert) SetCollector(v string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateCollector() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldCollector)
+	return u
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *CertifyPolicyUpsert) SetDocumentRef(v string) *CertifyPolicyUpsert {
+	u.Set(certifypolicy.FieldDocumentRef, v)
+	return u
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *CertifyPolicyUpsert) UpdateDocumentRef() *CertifyPolicyUpsert {
+	u.SetExcluded(certifypolicy.FieldDocumentRef)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CertifyPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(certifypolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CertifyPolicyUpsertOne) UpdateNewValues() *CertifyPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(certifypolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(certifypolicy.FieldTenant)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CertifyPolicyUpsertOne) Ignore() *CertifyPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyPolicyUpsertOne) DoNothing() *CertifyPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyPolicyCreate.OnConflict
+// documentation for more info.
+func (u *CertifyPolicyUpsertOne) Update(set func(*CertifyPolicyUpsert)) *CertifyPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyPolicyUpsertOne) SetArtifactID(v uuid.UUID) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateArtifactID() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// SetVerifier sets the "verifier" field.
+func (u *CertifyPolicyUpsertOne) SetVerifier(v string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetVerifier(v)
+	})
+}
+
+// UpdateVerifier sets the "verifier" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateVerifier() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateVerifier()
+	})
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (u *CertifyPolicyUpsertOne) SetPolicyURI(v string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetPolicyURI(v)
+	})
+}
+
+// UpdatePolicyURI sets the "policy_uri" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdatePolicyURI() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdatePolicyURI()
+	})
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (u *CertifyPolicyUpsertOne) SetPolicyDigest(v string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetPolicyDigest(v)
+	})
+}
+
+// UpdatePolicyDigest sets the "policy_digest" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdatePolicyDigest() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdatePolicyDigest()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *CertifyPolicyUpsertOne) SetResult(v certifypolicy.Result) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateResult() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (u *CertifyPolicyUpsertOne) SetVerifiedLevels(v []string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetVerifiedLevels(v)
+	})
+}
+
+// UpdateVerifiedLevels sets the "verified_levels" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateVerifiedLevels() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateVerifiedLevels()
+	})
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (u *CertifyPolicyUpsertOne) ClearVerifiedLevels() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.ClearVerifiedLevels()
+	})
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (u *CertifyPolicyUpsertOne) SetTimeVerified(v time.Time) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetTimeVerified(v)
+	})
+}
+
+// UpdateTimeVerified sets the "time_verified" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateTimeVerified() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateTimeVerified()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyPolicyUpsertOne) SetOrigin(v string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateOrigin() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyPolicyUpsertOne) SetCollector(v string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateCollector() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *CertifyPolicyUpsertOne) SetDocumentRef(v string) *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertOne) UpdateDocumentRef() *CertifyPolicyUpsertOne {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CertifyPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CertifyPolicyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CertifyPolicyUpsertOne.ID is not supported by MySQL driver. Use CertifyPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CertifyPolicyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CertifyPolicyCreateBulk is the builder for creating many CertifyPolicy entities in bulk.
+type CertifyPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*CertifyPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CertifyPolicy entities in the database.
+func (cpcb *CertifyPolicyCreateBulk) Save(ctx context.Context) ([]*CertifyPolicy, error) {
+	if cpcb.err != nil {
+		return nil, cpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cpcb.builders))
+	nodes := make([]*CertifyPolicy, len(cpcb.builders))
+	mutators := make([]Mutator, len(cpcb.builders))
+	for i := range cpcb.builders {
+		func(i int, root context.Context) {
+			builder := cpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CertifyPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpcb *CertifyPolicyCreateBulk) SaveX(ctx context.Context) []*CertifyPolicy {
+	v, err := cpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpcb *CertifyPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := cpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpcb *CertifyPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := cpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CertifyPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CertifyPolicyUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+func (cpcb *CertifyPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyPolicyUpsertBulk {
+	cpcb.conflict = opts
+	return &CertifyPolicyUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CertifyPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpcb *CertifyPolicyCreateBulk) OnConflictColumns(columns ...string) *CertifyPolicyUpsertBulk {
+	cpcb.conflict = append(cpcb.conflict, sql.ConflictColumns(columns...))
+	return &CertifyPolicyUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// CertifyPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of CertifyPolicy nodes.
+type CertifyPolicyUpsertBulk struct {
+	create *CertifyPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CertifyPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(certifypolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CertifyPolicyUpsertBulk) UpdateNewValues() *CertifyPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(certifypolicy.FieldID)
+			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(certifypolicy.FieldTenant)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CertifyPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CertifyPolicyUpsertBulk) Ignore() *CertifyPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CertifyPolicyUpsertBulk) DoNothing() *CertifyPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CertifyPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *CertifyPolicyUpsertBulk) Update(set func(*CertifyPolicyUpsert)) *CertifyPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CertifyPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *CertifyPolicyUpsertBulk) SetArtifactID(v uuid.UUID) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateArtifactID() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// SetVerifier sets the "verifier" field.
+func (u *CertifyPolicyUpsertBulk) SetVerifier(v string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetVerifier(v)
+	})
+}
+
+// UpdateVerifier sets the "verifier" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateVerifier() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateVerifier()
+	})
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (u *CertifyPolicyUpsertBulk) SetPolicyURI(v string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetPolicyURI(v)
+	})
+}
+
+// UpdatePolicyURI sets the "policy_uri" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdatePolicyURI() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdatePolicyURI()
+	})
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (u *CertifyPolicyUpsertBulk) SetPolicyDigest(v string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetPolicyDigest(v)
+	})
+}
+
+// UpdatePolicyDigest sets the "policy_digest" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdatePolicyDigest() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdatePolicyDigest()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *CertifyPolicyUpsertBulk) SetResult(v certifypolicy.Result) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateResult() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (u *CertifyPolicyUpsertBulk) SetVerifiedLevels(v []string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetVerifiedLevels(v)
+	})
+}
+
+// UpdateVerifiedLevels sets the "verified_levels" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateVerifiedLevels() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateVerifiedLevels()
+	})
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (u *CertifyPolicyUpsertBulk) ClearVerifiedLevels() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.ClearVerifiedLevels()
+	})
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (u *CertifyPolicyUpsertBulk) SetTimeVerified(v time.Time) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetTimeVerified(v)
+	})
+}
+
+// UpdateTimeVerified sets the "time_verified" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateTimeVerified() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateTimeVerified()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *CertifyPolicyUpsertBulk) SetOrigin(v string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateOrigin() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *CertifyPolicyUpsertBulk) SetCollector(v string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateCollector() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *CertifyPolicyUpsertBulk) SetDocumentRef(v string) *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *CertifyPolicyUpsertBulk) UpdateDocumentRef() *CertifyPolicyUpsertBulk {
+	return u.Update(func(s *CertifyPolicyUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *CertifyPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CertifyPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CertifyPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CertifyPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
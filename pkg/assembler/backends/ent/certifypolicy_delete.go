@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// CertifyPolicyDelete is the builder for deleting a CertifyPolicy entity.
+type CertifyPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *CertifyPolicyMutation
+}
+
+// Where appends a list predicates to the CertifyPolicyDelete builder.
+func (cpd *CertifyPolicyDelete) Where(ps ...predicate.CertifyPolicy) *CertifyPolicyDelete {
+	cpd.mutation.Where(ps...)
+	return cpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cpd *CertifyPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpd.sqlExec, cpd.mutation, cpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpd *CertifyPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := cpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cpd *CertifyPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(certifypolicy.Table, sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID))
+	if ps := cpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cpd.mutation.done = true
+	return affected, err
+}
+
+// CertifyPolicyDeleteOne is the builder for deleting a single CertifyPolicy entity.
+type CertifyPolicyDeleteOne struct {
+	cpd *CertifyPolicyDelete
+}
+
+// Where appends a list predicates to the CertifyPolicyDelete builder.
+func (cpdo *CertifyPolicyDeleteOne) Where(ps ...predicate.CertifyPolicy) *CertifyPolicyDeleteOne {
+	cpdo.cpd.mutation.Where(ps...)
+	return cpdo
+}
+
+// Exec executes the deletion query.
+func (cpdo *CertifyPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := cpdo.cpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{certifypolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpdo *CertifyPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := cpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// CertifyPolicyQuery is the builder for querying CertifyPolicy entities.
+type CertifyPolicyQuery struct {
+	config
+	ctx          *QueryContext
+	order        []certifypolicy.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.CertifyPolicy
+	withArtifact *ArtifactQuery
+	modifiers    []func(*sql.Selector)
+	loadTotal    []func(context.Context, []*CertifyPolicy) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CertifyPolicyQuery builder.
+func (cpq *CertifyPolicyQuery) Where(ps ...predicate.CertifyPolicy) *CertifyPolicyQuery {
+	cpq.predicates = append(cpq.predicates, ps...)
+	return cpq
+}
+
+// Limit the number of records to be returned by this query.
+func (cpq *CertifyPolicyQuery) Limit(limit int) *CertifyPolicyQuery {
+	cpq.ctx.Limit = &limit
+	return cpq
+}
+
+// Offset to start from.
+func (cpq *CertifyPolicyQuery) Offset(offset int) *CertifyPolicyQuery {
+	cpq.ctx.Offset = &offset
+	return cpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cpq *CertifyPolicyQuery) Unique(unique bool) *CertifyPolicyQuery {
+	cpq.ctx.Unique = &unique
+	return cpq
+}
+
+// Order specifies how the records should be ordered.
+func (cpq *CertifyPolicyQuery) Order(o ...certifypolicy.OrderOption) *CertifyPolicyQuery {
+	cpq.order = append(cpq.order, o...)
+	return cpq
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (cpq *CertifyPolicyQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: cpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifypolicy.Table, certifypolicy.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifypolicy.ArtifactTable, certifypolicy.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CertifyPolicy entity from the query.
+// Returns a *NotFoundError when no CertifyPolicy was found.
+func (cpq *CertifyPolicyQuery) First(ctx context.Context) (*CertifyPolicy, error) {
+	nodes, err := cpq.Limit(1).All(setContextOp(ctx, cpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{certifypolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) FirstX(ctx context.Context) *CertifyPolicy {
+	node, err := cpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CertifyPolicy ID from the query.
+// Returns a *NotFoundError when no CertifyPolicy ID was found.
+func (cpq *CertifyPolicyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cpq.Limit(1).IDs(setContextOp(ctx, cpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{certifypolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CertifyPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CertifyPolicy entity is found.
+// Returns a *NotFoundError when no CertifyPolicy entities are found.
+func (cpq *CertifyPolicyQuery) Only(ctx context.Context) (*CertifyPolicy, error) {
+	nodes, err := cpq.Limit(2).All(setContextOp(ctx, cpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{certifypolicy.Label}
+	default:
+		return nil, &NotSingularError{certifypolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) OnlyX(ctx context.Context) *CertifyPolicy {
+	node, err := cpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CertifyPolicy ID in the query.
+// Returns a *NotSingularError when more than one CertifyPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cpq *CertifyPolicyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cpq.Limit(2).IDs(setContextOp(ctx, cpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{certifypolicy.Label}
+	default:
+		err = &NotSingularError{certifypolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CertifyPolicies.
+func (cpq *CertifyPolicyQuery) All(ctx context.Context) ([]*CertifyPolicy, error) {
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryAll)
+	if err := cpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CertifyPolicy, *CertifyPolicyQuery]()
+	return withInterceptors[[]*CertifyPolicy](ctx, cpq, qr, cpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) AllX(ctx context.Context) []*CertifyPolicy {
+	nodes, err := cpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CertifyPolicy IDs.
+func (cpq *CertifyPolicyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cpq.ctx.Unique == nil && cpq.path != nil {
+		cpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryIDs)
+	if err = cpq.Select(certifypolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cpq *CertifyPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryCount)
+	if err := cpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cpq, querierCount[*CertifyPolicyQuery](), cpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) CountX(ctx context.Context) int {
+	count, err := cpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cpq *CertifyPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cpq.ctx, ent.OpQueryExist)
+	switch _, err := cpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cpq *CertifyPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := cpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CertifyPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cpq *CertifyPolicyQuery) Clone() *CertifyPolicyQuery {
+	if cpq == nil {
+		return nil
+	}
+	return &CertifyPolicyQuery{
+		config:       cpq.config,
+		ctx:          cpq.ctx.Clone(),
+		order:        append([]certifypolicy.OrderOption{}, cpq.order...),
+		inters:       append([]Interceptor{}, cpq.inters...),
+		predicates:   append([]predicate.CertifyPolicy{}, cpq.predicates...),
+		withArtifact: cpq.withArtifact.Clone(),
+		// clone intermediate query.
+		sql:  cpq.sql.Clone(),
+		path: cpq.path,
+	}
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (cpq *CertifyPolicyQuery) WithArtifact(opts ...func(*ArtifactQuery)) *CertifyPolicyQuery {
+	query := (&ArtifactClient{config: cpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cpq.withArtifact = query
+	return cpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CertifyPolicy.Query().
+//		GroupBy(certifypolicy.FieldTenant).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cpq *CertifyPolicyQuery) GroupBy(field string, fields ...string) *CertifyPolicyGroupBy {
+	cpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CertifyPolicyGroupBy{build: cpq}
+	grbuild.flds = &cpq.ctx.Fields
+	grbuild.label = certifypolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//	}
+//
+//	client.CertifyPolicy.Query().
+//		Select(certifypolicy.FieldTenant).
+//		Scan(ctx, &v)
+func (cpq *CertifyPolicyQuery) Select(fields ...string) *CertifyPolicySelect {
+	cpq.ctx.Fields = append(cpq.ctx.Fields, fields...)
+	sbuild := &CertifyPolicySelect{CertifyPolicyQuery: cpq}
+	sbuild.label = certifypolicy.Label
+	sbuild.flds, sbuild.scan = &cpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CertifyPolicySelect configured with the given aggregations.
+func (cpq *CertifyPolicyQuery) Aggregate(fns ...AggregateFunc) *CertifyPolicySelect {
+	return cpq.Select().Aggregate(fns...)
+}
+
+func (cpq *CertifyPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cpq.ctx.Fields {
+		if !certifypolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cpq.path != nil {
+		prev, err := cpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cpq.sql = prev
+	}
+	return nil
+}
+
+func (cpq *CertifyPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CertifyPolicy, error) {
+	var (
+		nodes       = []*CertifyPolicy{}
+		_spec       = cpq.querySpec()
+		loadedTypes = [1]bool{
+			cpq.withArtifact != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CertifyPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CertifyPolicy{config: cpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(cpq.modifiers) > 0 {
+		_spec.Modifiers = cpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cpq.withArtifact; query != nil {
+		if err := cpq.loadArtifact(ctx, query, nodes, nil,
+			func(n *CertifyPolicy, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range cpq.loadTotal {
+		if err := cpq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cpq *CertifyPolicyQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*CertifyPolicy, init func(*CertifyPolicy), assign func(*CertifyPolicy, *Artifact)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CertifyPolicy)
+	for i := range nodes {
+		fk := nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cpq *CertifyPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cpq.querySpec()
+	if len(cpq.modifiers) > 0 {
+		_spec.Modifiers = cpq.modifiers
+	}
+	_spec.Node.Columns = cpq.ctx.Fields
+	if len(cpq.ctx.Fields) > 0 {
+		_spec.Unique = cpq.ctx.Unique != nil && *cpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cpq.driver, _spec)
+}
+
+func (cpq *CertifyPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(certifypolicy.Table, certifypolicy.Columns, sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID))
+	_spec.From = cpq.sql
+	if unique := cpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifypolicy.FieldID)
+		for i := range fields {
+			if fields[i] != certifypolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cpq.withArtifact != nil {
+			_spec.Node.AddColumnOnce(certifypolicy.FieldArtifactID)
+		}
+	}
+	if ps := cpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cpq *CertifyPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cpq.driver.Dialect())
+	t1 := builder.Table(certifypolicy.Table)
+	columns := cpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = certifypolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cpq.sql != nil {
+		selector = cpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cpq.ctx.Unique != nil && *cpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cpq.predicates {
+		p(selector)
+	}
+	for _, p := range cpq.order {
+		p(selector)
+	}
+	if offset := cpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CertifyPolicyGroupBy is the group-by builder for CertifyPolicy entities.
+type CertifyPolicyGroupBy struct {
+	selector
+	build *CertifyPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cpgb *CertifyPolicyGroupBy) Aggregate(fns ...AggregateFunc) *CertifyPolicyGroupBy {
+	cpgb.fns = append(cpgb.fns, fns...)
+	return cpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cpgb *CertifyPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyPolicyQuery, *CertifyPolicyGroupBy](ctx, cpgb.build, cpgb, cpgb.build.inters, v)
+}
+
+func (cpgb *CertifyPolicyGroupBy) sqlScan(ctx context.Context, root *CertifyPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cpgb.fns))
+	for _, fn := range cpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cpgb.flds)+len(cpgb.fns))
+		for _, f := range *cpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CertifyPolicySelect is the builder for selecting fields of CertifyPolicy entities.
+type CertifyPolicySelect struct {
+	*CertifyPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cps *CertifyPolicySelect) Aggregate(fns ...AggregateFunc) *CertifyPolicySelect {
+	cps.fns = append(cps.fns, fns...)
+	return cps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cps *CertifyPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cps.ctx, ent.OpQuerySelect)
+	if err := cps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CertifyPolicyQuery, *CertifyPolicySelect](ctx, cps.CertifyPolicyQuery, cps, cps.inters, v)
+}
+
+func (cps *CertifyPolicySelect) sqlScan(ctx context.Context, root *CertifyPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cps.fns))
+	for _, fn := range cps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
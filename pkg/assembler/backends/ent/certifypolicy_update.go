@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// CertifyPolicyUpdate is the builder for updating CertifyPolicy entities.
+type CertifyPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CertifyPolicyMutation
+}
+
+// Where appends a list predicates to the CertifyPolicyUpdate builder.
+func (cpu *CertifyPolicyUpdate) Where(ps ...predicate.CertifyPolicy) *CertifyPolicyUpdate {
+	cpu.mutation.Where(ps...)
+	return cpu
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cpu *CertifyPolicyUpdate) SetArtifactID(u uuid.UUID) *CertifyPolicyUpdate {
+	cpu.mutation.SetArtifactID(u)
+	return cpu
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableArtifactID(u *uuid.UUID) *CertifyPolicyUpdate {
+	if u != nil {
+		cpu.SetArtifactID(*u)
+	}
+	return cpu
+}
+
+// SetVerifier sets the "verifier" field.
+func (cpu *CertifyPolicyUpdate) SetVerifier(s string) *CertifyPolicyUpdate {
+	cpu.mutation.SetVerifier(s)
+	return cpu
+}
+
+// SetNillableVerifier sets the "verifier" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableVerifier(s *string) *CertifyPolicyUpdate {
+	if s != nil {
+		cpu.SetVerifier(*s)
+	}
+	return cpu
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (cpu *CertifyPolicyUpdate) SetPolicyURI(s string) *CertifyPolicyUpdate {
+	cpu.mutation.SetPolicyURI(s)
+	return cpu
+}
+
+// SetNillablePolicyURI sets the "policy_uri" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillablePolicyURI(s *string) *CertifyPolicyUpdate {
+	if s != nil {
+		cpu.SetPolicyURI(*s)
+	}
+	return cpu
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (cpu *CertifyPolicyUpdate) SetPolicyDigest(s string) *CertifyPolicyUpdate {
+	cpu.mutation.SetPolicyDigest(s)
+	return cpu
+}
+
+// SetNillablePolicyDigest sets the "policy_digest" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillablePolicyDigest(s *string) *CertifyPolicyUpdate {
+	if s != nil {
+		cpu.SetPolicyDigest(*s)
+	}
+	return cpu
+}
+
+// SetResult sets the "result" field.
+func (cpu *CertifyPolicyUpdate) SetResult(c certifypolicy.Result) *CertifyPolicyUpdate {
+	cpu.mutation.SetResult(c)
+	return cpu
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableResult(c *certifypolicy.Result) *CertifyPolicyUpdate {
+	if c != nil {
+		cpu.SetResult(*c)
+	}
+	return cpu
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (cpu *CertifyPolicyUpdate) SetVerifiedLevels(s []string) *CertifyPolicyUpdate {
+	cpu.mutation.SetVerifiedLevels(s)
+	return cpu
+}
+
+// AppendVerifiedLevels appends s to the "verified_levels" field.
+func (cpu *CertifyPolicyUpdate) AppendVerifiedLevels(s []string) *CertifyPolicyUpdate {
+	cpu.mutation.AppendVerifiedLevels(s)
+	return cpu
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (cpu *CertifyPolicyUpdate) ClearVerifiedLevels() *CertifyPolicyUpdate {
+	cpu.mutation.ClearVerifiedLevels()
+	return cpu
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (cpu *CertifyPolicyUpdate) SetTimeVerified(t time.Time) *CertifyPolicyUpdate {
+	cpu.mutation.SetTimeVerified(t)
+	return cpu
+}
+
+// SetNillableTimeVerified sets the "time_verified" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableTimeVerified(t *time.Time) *CertifyPolicyUpdate {
+	if t != nil {
+		cpu.SetTimeVerified(*t)
+	}
+	return cpu
+}
+
+// SetOrigin sets the "origin" field.
+func (cpu *CertifyPolicyUpdate) SetOrigin(s string) *CertifyPolicyUpdate {
+	cpu.mutation.SetOrigin(s)
+	return cpu
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableOrigin(s *string) *CertifyPolicyUpdate {
+	if s != nil {
+		cpu.SetOrigin(*s)
+	}
+	return cpu
+}
+
+// SetCollector sets the "collector" field.
+func (cpu *CertifyPolicyUpdate) SetCollector(s string) *CertifyPolicyUpdate {
+	cpu.mutation.SetCollector(s)
+	return cpu
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableCollector(s *string) *CertifyPolicyUpdate {
+	if s != nil {
+		cpu.SetCollector(*s)
+	}
+	return cpu
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (cpu *CertifyPolicyUpdate) SetDocumentRef(s string) *CertifyPolicyUpdate {
+	cpu.mutation.SetDocumentRef(s)
+	return cpu
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (cpu *CertifyPolicyUpdate) SetNillableDocumentRef(s *string) *CertifyPolicyUpdate {
+	if s != nil {
+		cpu.SetDocumentRef(*s)
+	}
+	return cpu
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cpu *CertifyPolicyUpdate) SetArtifact(a *Artifact) *CertifyPolicyUpdate {
+	return cpu.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyPolicyMutation object of the builder.
+func (cpu *CertifyPolicyUpdate) Mutation() *CertifyPolicyMutation {
+	return cpu.mutation
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cpu *CertifyPolicyUpdate) ClearArtifact() *CertifyPolicyUpdate {
+	cpu.mutation.ClearArtifact()
+	return cpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cpu *CertifyPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpu.sqlSave, cpu.mutation, cpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpu *CertifyPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := cpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cpu *CertifyPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := cpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpu *CertifyPolicyUpdate) ExecX(ctx context.Context) {
+	if err := cpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpu *CertifyPolicyUpdate) check() error {
+	if v, ok := cpu.mutation.Result(); ok {
+		if err := certifypolicy.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "CertifyPolicy.result": %w`, err)}
+		}
+	}
+	if cpu.mutation.ArtifactCleared() && len(cpu.mutation.ArtifactIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CertifyPolicy.artifact"`)
+	}
+	return nil
+}
+
+func (cpu *CertifyPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certifypolicy.Table, certifypolicy.Columns, sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID))
+	if ps := cpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpu.mutation.Verifier(); ok {
+		_spec.SetField(certifypolicy.FieldVerifier, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.PolicyURI(); ok {
+		_spec.SetField(certifypolicy.FieldPolicyURI, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.PolicyDigest(); ok {
+		_spec.SetField(certifypolicy.FieldPolicyDigest, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.Result(); ok {
+		_spec.SetField(certifypolicy.FieldResult, field.TypeEnum, value)
+	}
+	if value, ok := cpu.mutation.VerifiedLevels(); ok {
+		_spec.SetField(certifypolicy.FieldVerifiedLevels, field.TypeJSON, value)
+	}
+	if value, ok := cpu.mutation.AppendedVerifiedLevels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, certifypolicy.FieldVerifiedLevels, value)
+		})
+	}
+	if cpu.mutation.VerifiedLevelsCleared() {
+		_spec.ClearField(certifypolicy.FieldVerifiedLevels, field.TypeJSON)
+	}
+	if value, ok := cpu.mutation.TimeVerified(); ok {
+		_spec.SetField(certifypolicy.FieldTimeVerified, field.TypeTime, value)
+	}
+	if value, ok := cpu.mutation.Origin(); ok {
+		_spec.SetField(certifypolicy.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.Collector(); ok {
+		_spec.SetField(certifypolicy.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := cpu.mutation.DocumentRef(); ok {
+		_spec.SetField(certifypolicy.FieldDocumentRef, field.TypeString, value)
+	}
+	if cpu.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifypolicy.ArtifactTable,
+			Columns: []string{certifypolicy.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpu.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifypolicy.ArtifactTable,
+			Columns: []string{certifypolicy.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifypolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cpu.mutation.done = true
+	return n, nil
+}
+
+// CertifyPolicyUpdateOne is the builder for updating a single CertifyPolicy entity.
+type CertifyPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CertifyPolicyMutation
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (cpuo *CertifyPolicyUpdateOne) SetArtifactID(u uuid.UUID) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetArtifactID(u)
+	return cpuo
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableArtifactID(u *uuid.UUID) *CertifyPolicyUpdateOne {
+	if u != nil {
+		cpuo.SetArtifactID(*u)
+	}
+	return cpuo
+}
+
+// SetVerifier sets the "verifier" field.
+func (cpuo *CertifyPolicyUpdateOne) SetVerifier(s string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetVerifier(s)
+	return cpuo
+}
+
+// SetNillableVerifier sets the "verifier" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableVerifier(s *string) *CertifyPolicyUpdateOne {
+	if s != nil {
+		cpuo.SetVerifier(*s)
+	}
+	return cpuo
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (cpuo *CertifyPolicyUpdateOne) SetPolicyURI(s string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetPolicyURI(s)
+	return cpuo
+}
+
+// SetNillablePolicyURI sets the "policy_uri" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillablePolicyURI(s *string) *CertifyPolicyUpdateOne {
+	if s != nil {
+		cpuo.SetPolicyURI(*s)
+	}
+	return cpuo
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (cpuo *CertifyPolicyUpdateOne) SetPolicyDigest(s string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetPolicyDigest(s)
+	return cpuo
+}
+
+// SetNillablePolicyDigest sets the "policy_digest" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillablePolicyDigest(s *string) *CertifyPolicyUpdateOne {
+	if s != nil {
+		cpuo.SetPolicyDigest(*s)
+	}
+	return cpuo
+}
+
+// SetResult sets the "result" field.
+func (cpuo *CertifyPolicyUpdateOne) SetResult(c certifypolicy.Result) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetResult(c)
+	return cpuo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableResult(c *certifypolicy.Result) *CertifyPolicyUpdateOne {
+	if c != nil {
+		cpuo.SetResult(*c)
+	}
+	return cpuo
+}
+
+// SetVerifiedLevels sets the "verified_levels" field.
+func (cpuo *CertifyPolicyUpdateOne) SetVerifiedLevels(s []string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetVerifiedLevels(s)
+	return cpuo
+}
+
+// AppendVerifiedLevels appends s to the "verified_levels" field.
+func (cpuo *CertifyPolicyUpdateOne) AppendVerifiedLevels(s []string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.AppendVerifiedLevels(s)
+	return cpuo
+}
+
+// ClearVerifiedLevels clears the value of the "verified_levels" field.
+func (cpuo *CertifyPolicyUpdateOne) ClearVerifiedLevels() *CertifyPolicyUpdateOne {
+	cpuo.mutation.ClearVerifiedLevels()
+	return cpuo
+}
+
+// SetTimeVerified sets the "time_verified" field.
+func (cpuo *CertifyPolicyUpdateOne) SetTimeVerified(t time.Time) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetTimeVerified(t)
+	return cpuo
+}
+
+// SetNillableTimeVerified sets the "time_verified" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableTimeVerified(t *time.Time) *CertifyPolicyUpdateOne {
+	if t != nil {
+		cpuo.SetTimeVerified(*t)
+	}
+	return cpuo
+}
+
+// SetOrigin sets the "origin" field.
+func (cpuo *CertifyPolicyUpdateOne) SetOrigin(s string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetOrigin(s)
+	return cpuo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableOrigin(s *string) *CertifyPolicyUpdateOne {
+	if s != nil {
+		cpuo.SetOrigin(*s)
+	}
+	return cpuo
+}
+
+// SetCollector sets the "collector" field.
+func (cpuo *CertifyPolicyUpdateOne) SetCollector(s string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetCollector(s)
+	return cpuo
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableCollector(s *string) *CertifyPolicyUpdateOne {
+	if s != nil {
+		cpuo.SetCollector(*s)
+	}
+	return cpuo
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (cpuo *CertifyPolicyUpdateOne) SetDocumentRef(s string) *CertifyPolicyUpdateOne {
+	cpuo.mutation.SetDocumentRef(s)
+	return cpuo
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (cpuo *CertifyPolicyUpdateOne) SetNillableDocumentRef(s *string) *CertifyPolicyUpdateOne {
+	if s != nil {
+		cpuo.SetDocumentRef(*s)
+	}
+	return cpuo
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (cpuo *CertifyPolicyUpdateOne) SetArtifact(a *Artifact) *CertifyPolicyUpdateOne {
+	return cpuo.SetArtifactID(a.ID)
+}
+
+// Mutation returns the CertifyPolicyMutation object of the builder.
+func (cpuo *CertifyPolicyUpdateOne) Mutation() *CertifyPolicyMutation {
+	return cpuo.mutation
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (cpuo *CertifyPolicyUpdateOne) ClearArtifact() *CertifyPolicyUpdateOne {
+	cpuo.mutation.ClearArtifact()
+	return cpuo
+}
+
+// Where appends a list predicates to the CertifyPolicyUpdate builder.
+func (cpuo *CertifyPolicyUpdateOne) Where(ps ...predicate.CertifyPolicy) *CertifyPolicyUpdateOne {
+	cpuo.mutation.Where(ps...)
+	return cpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cpuo *CertifyPolicyUpdateOne) Select(field string, fields ...string) *CertifyPolicyUpdateOne {
+	cpuo.fields = append([]string{field}, fields...)
+	return cpuo
+}
+
+// Save executes the query and returns the updated CertifyPolicy entity.
+func (cpuo *CertifyPolicyUpdateOne) Save(ctx context.Context) (*CertifyPolicy, error) {
+	return withHooks(ctx, cpuo.sqlSave, cpuo.mutation, cpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpuo *CertifyPolicyUpdateOne) SaveX(ctx context.Context) *CertifyPolicy {
+	node, err := cpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cpuo *CertifyPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := cpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpuo *CertifyPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := cpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpuo *CertifyPolicyUpdateOne) check() error {
+	if v, ok := cpuo.mutation.Result(); ok {
+		if err := certifypolicy.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`ent: validator failed for field "CertifyPolicy.result": %w`, err)}
+		}
+	}
+	if cpuo.mutation.ArtifactCleared() && len(cpuo.mutation.ArtifactIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CertifyPolicy.artifact"`)
+	}
+	return nil
+}
+
+func (cpuo *CertifyPolicyUpdateOne) sqlSave(ctx context.Context) (_node *CertifyPolicy, err error) {
+	if err := cpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(certifypolicy.Table, certifypolicy.Columns, sqlgraph.NewFieldSpec(certifypolicy.FieldID, field.TypeUUID))
+	id, ok := cpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CertifyPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, certifypolicy.FieldID)
+		for _, f := range fields {
+			if !certifypolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != certifypolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpuo.mutation.Verifier(); ok {
+		_spec.SetField(certifypolicy.FieldVerifier, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.PolicyURI(); ok {
+		_spec.SetField(certifypolicy.FieldPolicyURI, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.PolicyDigest(); ok {
+		_spec.SetField(certifypolicy.FieldPolicyDigest, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.Result(); ok {
+		_spec.SetField(certifypolicy.FieldResult, field.TypeEnum, value)
+	}
+	if value, ok := cpuo.mutation.VerifiedLevels(); ok {
+		_spec.SetField(certifypolicy.FieldVerifiedLevels, field.TypeJSON, value)
+	}
+	if value, ok := cpuo.mutation.AppendedVerifiedLevels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, certifypolicy.FieldVerifiedLevels, value)
+		})
+	}
+	if cpuo.mutation.VerifiedLevelsCleared() {
+		_spec.ClearField(certifypolicy.FieldVerifiedLevels, field.TypeJSON)
+	}
+	if value, ok := cpuo.mutation.TimeVerified(); ok {
+		_spec.SetField(certifypolicy.FieldTimeVerified, field.TypeTime, value)
+	}
+	if value, ok := cpuo.mutation.Origin(); ok {
+		_spec.SetField(certifypolicy.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.Collector(); ok {
+		_spec.SetField(certifypolicy.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := cpuo.mutation.DocumentRef(); ok {
+		_spec.SetField(certifypolicy.FieldDocumentRef, field.TypeString, value)
+	}
+	if cpuo.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifypolicy.ArtifactTable,
+			Columns: []string{certifypolicy.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpuo.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   certifypolicy.ArtifactTable,
+			Columns: []string{certifypolicy.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CertifyPolicy{config: cpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{certifypolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cpuo.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,8 @@ type CertifyScorecard struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// SourceID holds the value of the "source_id" field.
 	SourceID uuid.UUID `json:"source_id,omitempty"`
 	// Checks holds the value of the "checks" field.
@@ -78,7 +80,7 @@ func (*CertifyScorecard) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case certifyscorecard.FieldAggregateScore:
 			values[i] = new(sql.NullFloat64)
-		case certifyscorecard.FieldScorecardVersion, certifyscorecard.FieldScorecardCommit, certifyscorecard.FieldOrigin, certifyscorecard.FieldCollector, certifyscorecard.FieldDocumentRef, certifyscorecard.FieldChecksHash:
+		case certifyscorecard.FieldTenant, certifyscorecard.FieldScorecardVersion, certifyscorecard.FieldScorecardCommit, certifyscorecard.FieldOrigin, certifyscorecard.FieldCollector, certifyscorecard.FieldDocumentRef, certifyscorecard.FieldChecksHash:
 			values[i] = new(sql.NullString)
 		case certifyscorecard.FieldTimeScanned:
 			values[i] = new(sql.NullTime)
@@ -105,6 +107,12 @@ func (cs *CertifyScorecard) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				cs.ID = *value
 			}
+		case certifyscorecard.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				cs.Tenant = value.String
+			}
 		case certifyscorecard.FieldSourceID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field source_id", values[i])
@@ -208,6 +216,9 @@ func (cs *CertifyScorecard) String() string {
 	var builder strings.Builder
 	builder.WriteString("CertifyScorecard(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(cs.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("source_id=")
 	builder.WriteString(fmt.Sprintf("%v", cs.SourceID))
 	builder.WriteString(", ")
@@ -15,6 +15,8 @@ const (
 	Label = "certify_scorecard"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldSourceID holds the string denoting the source_id field in the database.
 	FieldSourceID = "source_id"
 	// FieldChecks holds the string denoting the checks field in the database.
@@ -51,6 +53,7 @@ const (
 // Columns holds all SQL columns for certifyscorecard fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldSourceID,
 	FieldChecks,
 	FieldAggregateScore,
@@ -74,6 +77,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultAggregateScore holds the default value on creation for the "aggregate_score" field.
 	DefaultAggregateScore float64
 	// DefaultTimeScanned holds the default value on creation for the "time_scanned" field.
@@ -90,6 +95,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // BySourceID orders the results by the source_id field.
 func BySourceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
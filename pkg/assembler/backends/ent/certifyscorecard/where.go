@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.CertifyScorecard {
 	return predicate.CertifyScorecard(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldEQ(FieldTenant, v))
+}
+
 // SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
 func SourceID(v uuid.UUID) predicate.CertifyScorecard {
 	return predicate.CertifyScorecard(sql.FieldEQ(FieldSourceID, v))
@@ -101,6 +106,71 @@ func ChecksHash(v string) predicate.CertifyScorecard {
 	return predicate.CertifyScorecard(sql.FieldEQ(FieldChecksHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.CertifyScorecard {
+	return predicate.CertifyScorecard(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // SourceIDEQ applies the EQ predicate on the "source_id" field.
 func SourceIDEQ(v uuid.UUID) predicate.CertifyScorecard {
 	return predicate.CertifyScorecard(sql.FieldEQ(FieldSourceID, v))
@@ -26,6 +26,20 @@ type CertifyScorecardCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (csc *CertifyScorecardCreate) SetTenant(s string) *CertifyScorecardCreate {
+	csc.mutation.SetTenant(s)
+	return csc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (csc *CertifyScorecardCreate) SetNillableTenant(s *string) *CertifyScorecardCreate {
+	if s != nil {
+		csc.SetTenant(*s)
+	}
+	return csc
+}
+
 // SetSourceID sets the "source_id" field.
 func (csc *CertifyScorecardCreate) SetSourceID(u uuid.UUID) *CertifyScorecardCreate {
 	csc.mutation.SetSourceID(u)
@@ -156,6 +170,10 @@ func (csc *CertifyScorecardCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (csc *CertifyScorecardCreate) defaults() {
+	if _, ok := csc.mutation.Tenant(); !ok {
+		v := certifyscorecard.DefaultTenant
+		csc.mutation.SetTenant(v)
+	}
 	if _, ok := csc.mutation.AggregateScore(); !ok {
 		v := certifyscorecard.DefaultAggregateScore
 		csc.mutation.SetAggregateScore(v)
@@ -172,6 +190,9 @@ func (csc *CertifyScorecardCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (csc *CertifyScorecardCreate) check() error {
+	if _, ok := csc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "CertifyScorecard.tenant"`)}
+	}
 	if _, ok := csc.mutation.SourceID(); !ok {
 		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "CertifyScorecard.source_id"`)}
 	}
@@ -241,6 +262,10 @@ func (csc *CertifyScorecardCreate) createSpec() (*CertifyScorecard, *sqlgraph.Cr
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := csc.mutation.Tenant(); ok {
+		_spec.SetField(certifyscorecard.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := csc.mutation.Checks(); ok {
 		_spec.SetField(certifyscorecard.FieldChecks, field.TypeJSON, value)
 		_node.Checks = value
@@ -301,7 +326,7 @@ func (csc *CertifyScorecardCreate) createSpec() (*CertifyScorecard, *sqlgraph.Cr
 // of the `INSERT` statement. For example:
 //
 //	client.CertifyScorecard.Create().
-//		SetSourceID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -310,7 +335,7 @@ func (csc *CertifyScorecardCreate) createSpec() (*CertifyScorecard, *sqlgraph.Cr
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyScorecardUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (csc *CertifyScorecardCreate) OnConflict(opts ...sql.ConflictOption) *CertifyScorecardUpsertOne {
@@ -489,6 +514,9 @@ func (u *CertifyScorecardUpsertOne) UpdateNewValues() *CertifyScorecardUpsertOne
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(certifyscorecard.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(certifyscorecard.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -803,7 +831,7 @@ func (cscb *CertifyScorecardCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyScorecardUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (cscb *CertifyScorecardCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyScorecardUpsertBulk {
@@ -850,6 +878,9 @@ func (u *CertifyScorecardUpsertBulk) UpdateNewValues() *CertifyScorecardUpsertBu
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(certifyscorecard.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(certifyscorecard.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -31,6 +31,20 @@ type CertifyVexCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (cvc *CertifyVexCreate) SetTenant(s string) *CertifyVexCreate {
+	cvc.mutation.SetTenant(s)
+	return cvc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (cvc *CertifyVexCreate) SetNillableTenant(s *string) *CertifyVexCreate {
+	if s != nil {
+		cvc.SetTenant(*s)
+	}
+	return cvc
+}
+
 // SetPackageID sets the "package_id" field.
 func (cvc *CertifyVexCreate) SetPackageID(u uuid.UUID) *CertifyVexCreate {
 	cvc.mutation.SetPackageID(u)
@@ -269,6 +283,10 @@ func (cvc *CertifyVexCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cvc *CertifyVexCreate) defaults() {
+	if _, ok := cvc.mutation.Tenant(); !ok {
+		v := certifyvex.DefaultTenant
+		cvc.mutation.SetTenant(v)
+	}
 	if _, ok := cvc.mutation.ID(); !ok {
 		v := certifyvex.DefaultID()
 		cvc.mutation.SetID(v)
@@ -277,6 +295,9 @@ func (cvc *CertifyVexCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (cvc *CertifyVexCreate) check() error {
+	if _, ok := cvc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "CertifyVex.tenant"`)}
+	}
 	if _, ok := cvc.mutation.VulnerabilityID(); !ok {
 		return &ValidationError{Name: "vulnerability_id", err: errors.New(`ent: missing required field "CertifyVex.vulnerability_id"`)}
 	}
@@ -343,6 +364,10 @@ func (cvc *CertifyVexCreate) createSpec() (*CertifyVex, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := cvc.mutation.Tenant(); ok {
+		_spec.SetField(certifyvex.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := cvc.mutation.KnownSince(); ok {
 		_spec.SetField(certifyvex.FieldKnownSince, field.TypeTime, value)
 		_node.KnownSince = value
@@ -506,7 +531,7 @@ func (cvc *CertifyVexCreate) createSpec() (*CertifyVex, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.CertifyVex.Create().
-//		SetPackageID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -515,7 +540,7 @@ func (cvc *CertifyVexCreate) createSpec() (*CertifyVex, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyVexUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (cvc *CertifyVexCreate) OnConflict(opts ...sql.ConflictOption) *CertifyVexUpsertOne {
@@ -754,6 +779,9 @@ func (u *CertifyVexUpsertOne) UpdateNewValues() *CertifyVexUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(certifyvex.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(certifyvex.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -1138,7 +1166,7 @@ func (cvcb *CertifyVexCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.CertifyVexUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (cvcb *CertifyVexCreateBulk) OnConflict(opts ...sql.ConflictOption) *CertifyVexUpsertBulk {
@@ -1185,6 +1213,9 @@ func (u *CertifyVexUpsertBulk) UpdateNewValues() *CertifyVexUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(certifyvex.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(certifyvex.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldTenant, v))
+}
+
 // VulnerabilityID applies equality check predicate on the "vulnerability_id" field. It's identical to VulnerabilityIDEQ.
 func VulnerabilityID(v uuid.UUID) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldVulnerabilityID, v))
@@ -106,6 +111,71 @@ func DocumentRef(v string) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldDocumentRef, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.CertifyVuln {
+	return predicate.CertifyVuln(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
 func VulnerabilityIDEQ(v uuid.UUID) predicate.CertifyVuln {
 	return predicate.CertifyVuln(sql.FieldEQ(FieldVulnerabilityID, v))
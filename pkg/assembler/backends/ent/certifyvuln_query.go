@@ -337,12 +337,12 @@ func (cvq *CertifyVulnQuery) WithPackage(opts ...func(*PackageVersionQuery)) *Ce
 // Example:
 //
 //	var v []struct {
-//		VulnerabilityID uuid.UUID `json:"vulnerability_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CertifyVuln.Query().
-//		GroupBy(certifyvuln.FieldVulnerabilityID).
+//		GroupBy(certifyvuln.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (cvq *CertifyVulnQuery) GroupBy(field string, fields ...string) *CertifyVulnGroupBy {
@@ -360,11 +360,11 @@ func (cvq *CertifyVulnQuery) GroupBy(field string, fields ...string) *CertifyVul
 // Example:
 //
 //	var v []struct {
-//		VulnerabilityID uuid.UUID `json:"vulnerability_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.CertifyVuln.Query().
-//		Select(certifyvuln.FieldVulnerabilityID).
+//		Select(certifyvuln.FieldTenant).
 //		Scan(ctx, &v)
 func (cvq *CertifyVulnQuery) Select(fields ...string) *CertifyVulnSelect {
 	cvq.ctx.Fields = append(cvq.ctx.Fields, fields...)
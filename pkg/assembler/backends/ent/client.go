@@ -21,6 +21,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -50,6 +51,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -71,6 +75,8 @@ type Client struct {
 	Certification *CertificationClient
 	// CertifyLegal is the client for interacting with the CertifyLegal builders.
 	CertifyLegal *CertifyLegalClient
+	// CertifyPolicy is the client for interacting with the CertifyPolicy builders.
+	CertifyPolicy *CertifyPolicyClient
 	// CertifyScorecard is the client for interacting with the CertifyScorecard builders.
 	CertifyScorecard *CertifyScorecardClient
 	// CertifyVex is the client for interacting with the CertifyVex builders.
@@ -125,6 +131,8 @@ type Client struct {
 	VulnerabilityID *VulnerabilityIDClient
 	// VulnerabilityMetadata is the client for interacting with the VulnerabilityMetadata builders.
 	VulnerabilityMetadata *VulnerabilityMetadataClient
+	// VulnerabilityRange is the client for interacting with the VulnerabilityRange builders.
+	VulnerabilityRange *VulnerabilityRangeClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -143,6 +151,7 @@ func (c *Client) init() {
 	c.CWE = NewCWEClient(c.config)
 	c.Certification = NewCertificationClient(c.config)
 	c.CertifyLegal = NewCertifyLegalClient(c.config)
+	c.CertifyPolicy = NewCertifyPolicyClient(c.config)
 	c.CertifyScorecard = NewCertifyScorecardClient(c.config)
 	c.CertifyVex = NewCertifyVexClient(c.config)
 	c.CertifyVuln = NewCertifyVulnClient(c.config)
@@ -170,6 +179,7 @@ func (c *Client) init() {
 	c.VulnEqual = NewVulnEqualClient(c.config)
 	c.VulnerabilityID = NewVulnerabilityIDClient(c.config)
 	c.VulnerabilityMetadata = NewVulnerabilityMetadataClient(c.config)
+	c.VulnerabilityRange = NewVulnerabilityRangeClient(c.config)
 }
 
 type (
@@ -269,6 +279,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		CWE:                   NewCWEClient(cfg),
 		Certification:         NewCertificationClient(cfg),
 		CertifyLegal:          NewCertifyLegalClient(cfg),
+		CertifyPolicy:         NewCertifyPolicyClient(cfg),
 		CertifyScorecard:      NewCertifyScorecardClient(cfg),
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
@@ -296,6 +307,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		VulnEqual:             NewVulnEqualClient(cfg),
 		VulnerabilityID:       NewVulnerabilityIDClient(cfg),
 		VulnerabilityMetadata: NewVulnerabilityMetadataClient(cfg),
+		VulnerabilityRange:    NewVulnerabilityRangeClient(cfg),
 	}, nil
 }
 
@@ -322,6 +334,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		CWE:                   NewCWEClient(cfg),
 		Certification:         NewCertificationClient(cfg),
 		CertifyLegal:          NewCertifyLegalClient(cfg),
+		CertifyPolicy:         NewCertifyPolicyClient(cfg),
 		CertifyScorecard:      NewCertifyScorecardClient(cfg),
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
@@ -349,6 +362,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		VulnEqual:             NewVulnEqualClient(cfg),
 		VulnerabilityID:       NewVulnerabilityIDClient(cfg),
 		VulnerabilityMetadata: NewVulnerabilityMetadataClient(cfg),
+		VulnerabilityRange:    NewVulnerabilityRangeClient(cfg),
 	}, nil
 }
 
@@ -379,13 +393,14 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.CVSS, c.CWE, c.Certification,
-		c.CertifyLegal, c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Consequence,
-		c.Consequence_Impact, c.Consequence_Scope, c.DemonstrativeExample,
-		c.Dependency, c.DetectionMethod, c.Exploit, c.HasMetadata, c.HasSourceAt,
-		c.HashEqual, c.License, c.Occurrence, c.PackageName, c.PackageVersion,
-		c.PkgEqual, c.PointOfContact, c.PotentialMitigation, c.ReachableCode,
-		c.ReachableCodeArtifact, c.SLSAAttestation, c.SourceName, c.VulnEqual,
-		c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyLegal, c.CertifyPolicy, c.CertifyScorecard, c.CertifyVex,
+		c.CertifyVuln, c.Consequence, c.Consequence_Impact, c.Consequence_Scope,
+		c.DemonstrativeExample, c.Dependency, c.DetectionMethod, c.Exploit,
+		c.HasMetadata, c.HasSourceAt, c.HashEqual, c.License, c.Occurrence,
+		c.PackageName, c.PackageVersion, c.PkgEqual, c.PointOfContact,
+		c.PotentialMitigation, c.ReachableCode, c.ReachableCodeArtifact,
+		c.SLSAAttestation, c.SourceName, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityMetadata, c.VulnerabilityRange,
 	} {
 		n.Use(hooks...)
 	}
@@ -396,13 +411,14 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.CVSS, c.CWE, c.Certification,
-		c.CertifyLegal, c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Consequence,
-		c.Consequence_Impact, c.Consequence_Scope, c.DemonstrativeExample,
-		c.Dependency, c.DetectionMethod, c.Exploit, c.HasMetadata, c.HasSourceAt,
-		c.HashEqual, c.License, c.Occurrence, c.PackageName, c.PackageVersion,
-		c.PkgEqual, c.PointOfContact, c.PotentialMitigation, c.ReachableCode,
-		c.ReachableCodeArtifact, c.SLSAAttestation, c.SourceName, c.VulnEqual,
-		c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyLegal, c.CertifyPolicy, c.CertifyScorecard, c.CertifyVex,
+		c.CertifyVuln, c.Consequence, c.Consequence_Impact, c.Consequence_Scope,
+		c.DemonstrativeExample, c.Dependency, c.DetectionMethod, c.Exploit,
+		c.HasMetadata, c.HasSourceAt, c.HashEqual, c.License, c.Occurrence,
+		c.PackageName, c.PackageVersion, c.PkgEqual, c.PointOfContact,
+		c.PotentialMitigation, c.ReachableCode, c.ReachableCodeArtifact,
+		c.SLSAAttestation, c.SourceName, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityMetadata, c.VulnerabilityRange,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -425,6 +441,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Certification.mutate(ctx, m)
 	case *CertifyLegalMutation:
 		return c.CertifyLegal.mutate(ctx, m)
+	case *CertifyPolicyMutation:
+		return c.CertifyPolicy.mutate(ctx, m)
 	case *CertifyScorecardMutation:
 		return c.CertifyScorecard.mutate(ctx, m)
 	case *CertifyVexMutation:
@@ -479,6 +497,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.VulnerabilityID.mutate(ctx, m)
 	case *VulnerabilityMetadataMutation:
 		return c.VulnerabilityMetadata.mutate(ctx, m)
+	case *VulnerabilityRangeMutation:
+		return c.VulnerabilityRange.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -752,6 +772,22 @@ func (c *ArtifactClient) QueryPoc(a *Artifact) *PointOfContactQuery {
 	return query
 }
 
+// QueryPolicies queries the policies edge of a Artifact.
+func (c *ArtifactClient) QueryPolicies(a *Artifact) *CertifyPolicyQuery {
+	query := (&CertifyPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifact.Table, artifact.FieldID, id),
+			sqlgraph.To(certifypolicy.Table, certifypolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, artifact.PoliciesTable, artifact.PoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryIncludedInSboms queries the included_in_sboms edge of a Artifact.
 func (c *ArtifactClient) QueryIncludedInSboms(a *Artifact) *BillOfMaterialsQuery {
 	query := (&BillOfMaterialsClient{config: c.config}).Query()
@@ -1927,6 +1963,155 @@ func (c *CertifyLegalClient) mutate(ctx context.Context, m *CertifyLegalMutation
 	}
 }
 
+// CertifyPolicyClient is a client for the CertifyPolicy schema.
+type CertifyPolicyClient struct {
+	config
+}
+
+// NewCertifyPolicyClient returns a client for the CertifyPolicy from the given config.
+func NewCertifyPolicyClient(c config) *CertifyPolicyClient {
+	return &CertifyPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `certifypolicy.Hooks(f(g(h())))`.
+func (c *CertifyPolicyClient) Use(hooks ...Hook) {
+	c.hooks.CertifyPolicy = append(c.hooks.CertifyPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `certifypolicy.Intercept(f(g(h())))`.
+func (c *CertifyPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CertifyPolicy = append(c.inters.CertifyPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a CertifyPolicy entity.
+func (c *CertifyPolicyClient) Create() *CertifyPolicyCreate {
+	mutation := newCertifyPolicyMutation(c.config, OpCreate)
+	return &CertifyPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CertifyPolicy entities.
+func (c *CertifyPolicyClient) CreateBulk(builders ...*CertifyPolicyCreate) *CertifyPolicyCreateBulk {
+	return &CertifyPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CertifyPolicyClient) MapCreateBulk(slice any, setFunc func(*CertifyPolicyCreate, int)) *CertifyPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CertifyPolicyCreateBulk{err: fmt.Errorf("calling to CertifyPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CertifyPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CertifyPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CertifyPolicy.
+func (c *CertifyPolicyClient) Update() *CertifyPolicyUpdate {
+	mutation := newCertifyPolicyMutation(c.config, OpUpdate)
+	return &CertifyPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CertifyPolicyClient) UpdateOne(cp *CertifyPolicy) *CertifyPolicyUpdateOne {
+	mutation := newCertifyPolicyMutation(c.config, OpUpdateOne, withCertifyPolicy(cp))
+	return &CertifyPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CertifyPolicyClient) UpdateOneID(id uuid.UUID) *CertifyPolicyUpdateOne {
+	mutation := newCertifyPolicyMutation(c.config, OpUpdateOne, withCertifyPolicyID(id))
+	return &CertifyPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CertifyPolicy.
+func (c *CertifyPolicyClient) Delete() *CertifyPolicyDelete {
+	mutation := newCertifyPolicyMutation(c.config, OpDelete)
+	return &CertifyPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CertifyPolicyClient) DeleteOne(cp *CertifyPolicy) *CertifyPolicyDeleteOne {
+	return c.DeleteOneID(cp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CertifyPolicyClient) DeleteOneID(id uuid.UUID) *CertifyPolicyDeleteOne {
+	builder := c.Delete().Where(certifypolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CertifyPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for CertifyPolicy.
+func (c *CertifyPolicyClient) Query() *CertifyPolicyQuery {
+	return &CertifyPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCertifyPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CertifyPolicy entity by its id.
+func (c *CertifyPolicyClient) Get(ctx context.Context, id uuid.UUID) (*CertifyPolicy, error) {
+	return c.Query().Where(certifypolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CertifyPolicyClient) GetX(ctx context.Context, id uuid.UUID) *CertifyPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArtifact queries the artifact edge of a CertifyPolicy.
+func (c *CertifyPolicyClient) QueryArtifact(cp *CertifyPolicy) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(certifypolicy.Table, certifypolicy.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, certifypolicy.ArtifactTable, certifypolicy.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CertifyPolicyClient) Hooks() []Hook {
+	return c.hooks.CertifyPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *CertifyPolicyClient) Interceptors() []Interceptor {
+	return c.inters.CertifyPolicy
+}
+
+func (c *CertifyPolicyClient) mutate(ctx context.Context, m *CertifyPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CertifyPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CertifyPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CertifyPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CertifyPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CertifyPolicy mutation op: %q", m.Op())
+	}
+}
+
 // CertifyScorecardClient is a client for the CertifyScorecard schema.
 type CertifyScorecardClient struct {
 	config
@@ -4686,6 +4871,22 @@ func (c *PackageNameClient) QueryPoc(pn *PackageName) *PointOfContactQuery {
 	return query
 }
 
+// QueryVulnerabilityRanges queries the vulnerability_ranges edge of a PackageName.
+func (c *PackageNameClient) QueryVulnerabilityRanges(pn *PackageName) *VulnerabilityRangeQuery {
+	query := (&VulnerabilityRangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pn.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagename.Table, packagename.FieldID, id),
+			sqlgraph.To(vulnerabilityrange.Table, vulnerabilityrange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, packagename.VulnerabilityRangesTable, packagename.VulnerabilityRangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pn.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PackageNameClient) Hooks() []Hook {
 	return c.hooks.PackageName
@@ -6688,6 +6889,22 @@ func (c *VulnerabilityIDClient) QueryVex(vi *VulnerabilityID) *CertifyVexQuery {
 	return query
 }
 
+// QueryVulnerabilityRanges queries the vulnerability_ranges edge of a VulnerabilityID.
+func (c *VulnerabilityIDClient) QueryVulnerabilityRanges(vi *VulnerabilityID) *VulnerabilityRangeQuery {
+	query := (&VulnerabilityRangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityid.Table, vulnerabilityid.FieldID, id),
+			sqlgraph.To(vulnerabilityrange.Table, vulnerabilityrange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, vulnerabilityid.VulnerabilityRangesTable, vulnerabilityid.VulnerabilityRangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(vi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VulnerabilityIDClient) Hooks() []Hook {
 	return c.hooks.VulnerabilityID
@@ -6862,24 +7079,215 @@ func (c *VulnerabilityMetadataClient) mutate(ctx context.Context, m *Vulnerabili
 	}
 }
 
+// VulnerabilityRangeClient is a client for the VulnerabilityRange schema.
+type VulnerabilityRangeClient struct {
+	config
+}
+
+// NewVulnerabilityRangeClient returns a client for the VulnerabilityRange from the given config.
+func NewVulnerabilityRangeClient(c config) *VulnerabilityRangeClient {
+	return &VulnerabilityRangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vulnerabilityrange.Hooks(f(g(h())))`.
+func (c *VulnerabilityRangeClient) Use(hooks ...Hook) {
+	c.hooks.VulnerabilityRange = append(c.hooks.VulnerabilityRange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vulnerabilityrange.Intercept(f(g(h())))`.
+func (c *VulnerabilityRangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VulnerabilityRange = append(c.inters.VulnerabilityRange, interceptors...)
+}
+
+// Create returns a builder for creating a VulnerabilityRange entity.
+func (c *VulnerabilityRangeClient) Create() *VulnerabilityRangeCreate {
+	mutation := newVulnerabilityRangeMutation(c.config, OpCreate)
+	return &VulnerabilityRangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VulnerabilityRange entities.
+func (c *VulnerabilityRangeClient) CreateBulk(builders ...*VulnerabilityRangeCreate) *VulnerabilityRangeCreateBulk {
+	return &VulnerabilityRangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VulnerabilityRangeClient) MapCreateBulk(slice any, setFunc func(*VulnerabilityRangeCreate, int)) *VulnerabilityRangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VulnerabilityRangeCreateBulk{err: fmt.Errorf("calling to VulnerabilityRangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VulnerabilityRangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VulnerabilityRangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VulnerabilityRange.
+func (c *VulnerabilityRangeClient) Update() *VulnerabilityRangeUpdate {
+	mutation := newVulnerabilityRangeMutation(c.config, OpUpdate)
+	return &VulnerabilityRangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VulnerabilityRangeClient) UpdateOne(vr *VulnerabilityRange) *VulnerabilityRangeUpdateOne {
+	mutation := newVulnerabilityRangeMutation(c.config, OpUpdateOne, withVulnerabilityRange(vr))
+	return &VulnerabilityRangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VulnerabilityRangeClient) UpdateOneID(id uuid.UUID) *VulnerabilityRangeUpdateOne {
+	mutation := newVulnerabilityRangeMutation(c.config, OpUpdateOne, withVulnerabilityRangeID(id))
+	return &VulnerabilityRangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VulnerabilityRange.
+func (c *VulnerabilityRangeClient) Delete() *VulnerabilityRangeDelete {
+	mutation := newVulnerabilityRangeMutation(c.config, OpDelete)
+	return &VulnerabilityRangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VulnerabilityRangeClient) DeleteOne(vr *VulnerabilityRange) *VulnerabilityRangeDeleteOne {
+	return c.DeleteOneID(vr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VulnerabilityRangeClient) DeleteOneID(id uuid.UUID) *VulnerabilityRangeDeleteOne {
+	builder := c.Delete().Where(vulnerabilityrange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VulnerabilityRangeDeleteOne{builder}
+}
+
+// Query returns a query builder for VulnerabilityRange.
+func (c *VulnerabilityRangeClient) Query() *VulnerabilityRangeQuery {
+	return &VulnerabilityRangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVulnerabilityRange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VulnerabilityRange entity by its id.
+func (c *VulnerabilityRangeClient) Get(ctx context.Context, id uuid.UUID) (*VulnerabilityRange, error) {
+	return c.Query().Where(vulnerabilityrange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VulnerabilityRangeClient) GetX(ctx context.Context, id uuid.UUID) *VulnerabilityRange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVulnerability queries the vulnerability edge of a VulnerabilityRange.
+func (c *VulnerabilityRangeClient) QueryVulnerability(vr *VulnerabilityRange) *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityrange.Table, vulnerabilityrange.FieldID, id),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnerabilityrange.VulnerabilityTable, vulnerabilityrange.VulnerabilityColumn),
+		)
+		fromV = sqlgraph.Neighbors(vr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPackage queries the package edge of a VulnerabilityRange.
+func (c *VulnerabilityRangeClient) QueryPackage(vr *VulnerabilityRange) *PackageNameQuery {
+	query := (&PackageNameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := vr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityrange.Table, vulnerabilityrange.FieldID, id),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnerabilityrange.PackageTable, vulnerabilityrange.PackageColumn),
+		)
+		fromV = sqlgraph.Neighbors(vr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *VulnerabilityRangeClient) Hooks() []Hook {
+	return c.hooks.VulnerabilityRange
+}
+
+// Interceptors returns the client interceptors.
+func (c *VulnerabilityRangeClient) Interceptors() []Interceptor {
+	return c.inters.VulnerabilityRange
+}
+
+func (c *VulnerabilityRangeClient) mutate(ctx context.Context, m *VulnerabilityRangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VulnerabilityRangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VulnerabilityRangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VulnerabilityRangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VulnerabilityRangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VulnerabilityRange mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
 		Artifact, BillOfMaterials, Builder, CVSS, CWE, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Consequence, Consequence_Impact,
-		Consequence_Scope, DemonstrativeExample, Dependency, DetectionMethod, Exploit,
-		HasMetadata, HasSourceAt, HashEqual, License, Occurrence, PackageName,
-		PackageVersion, PkgEqual, PointOfContact, PotentialMitigation, ReachableCode,
-		ReachableCodeArtifact, SLSAAttestation, SourceName, VulnEqual, VulnerabilityID,
-		VulnerabilityMetadata []ent.Hook
+		CertifyPolicy, CertifyScorecard, CertifyVex, CertifyVuln, Consequence,
+		Consequence_Impact, Consequence_Scope, DemonstrativeExample, Dependency,
+		DetectionMethod, Exploit, HasMetadata, HasSourceAt, HashEqual, License,
+		Occurrence, PackageName, PackageVersion, PkgEqual, PointOfContact,
+		PotentialMitigation, ReachableCode, ReachableCodeArtifact, SLSAAttestation,
+		SourceName, VulnEqual, VulnerabilityID, VulnerabilityMetadata,
+		VulnerabilityRange []ent.Hook
 	}
 	inters struct {
 		Artifact, BillOfMaterials, Builder, CVSS, CWE, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Consequence, Consequence_Impact,
-		Consequence_Scope, DemonstrativeExample, Dependency, DetectionMethod, Exploit,
-		HasMetadata, HasSourceAt, HashEqual, License, Occurrence, PackageName,
-		PackageVersion, PkgEqual, PointOfContact, PotentialMitigation, ReachableCode,
-		ReachableCodeArtifact, SLSAAttestation, SourceName, VulnEqual, VulnerabilityID,
-		VulnerabilityMetadata []ent.Interceptor
+		CertifyPolicy, CertifyScorecard, CertifyVex, CertifyVuln, Consequence,
+		Consequence_Impact, Consequence_Scope, DemonstrativeExample, Dependency,
+		DetectionMethod, Exploit, HasMetadata, HasSourceAt, HashEqual, License,
+		Occurrence, PackageName, PackageVersion, PkgEqual, PointOfContact,
+		PotentialMitigation, ReachableCode, ReachableCodeArtifact, SLSAAttestation,
+		SourceName, VulnEqual, VulnerabilityID, VulnerabilityMetadata,
+		VulnerabilityRange []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -18,12 +18,16 @@ type Dependency struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// PackageID holds the value of the "package_id" field.
 	PackageID uuid.UUID `json:"package_id,omitempty"`
 	// DependentPackageVersionID holds the value of the "dependent_package_version_id" field.
 	DependentPackageVersionID uuid.UUID `json:"dependent_package_version_id,omitempty"`
 	// DependencyType holds the value of the "dependency_type" field.
 	DependencyType dependency.DependencyType `json:"dependency_type,omitempty"`
+	// VersionRange holds the value of the "version_range" field.
+	VersionRange string `json:"version_range,omitempty"`
 	// Justification holds the value of the "justification" field.
 	Justification string `json:"justification,omitempty"`
 	// Origin holds the value of the "origin" field.
@@ -91,7 +95,7 @@ func (*Dependency) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case dependency.FieldDependencyType, dependency.FieldJustification, dependency.FieldOrigin, dependency.FieldCollector, dependency.FieldDocumentRef:
+		case dependency.FieldTenant, dependency.FieldDependencyType, dependency.FieldVersionRange, dependency.FieldJustification, dependency.FieldOrigin, dependency.FieldCollector, dependency.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case dependency.FieldID, dependency.FieldPackageID, dependency.FieldDependentPackageVersionID:
 			values[i] = new(uuid.UUID)
@@ -116,6 +120,12 @@ func (d *Dependency) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				d.ID = *value
 			}
+		case dependency.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				d.Tenant = value.String
+			}
 		case dependency.FieldPackageID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field package_id", values[i])
@@ -134,6 +144,12 @@ func (d *Dependency) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.DependencyType = dependency.DependencyType(value.String)
 			}
+		case dependency.FieldVersionRange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_range", values[i])
+			} else if value.Valid {
+				d.VersionRange = value.String
+			}
 		case dependency.FieldJustification:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field justification", values[i])
@@ -209,6 +225,9 @@ func (d *Dependency) String() string {
 	var builder strings.Builder
 	builder.WriteString("Dependency(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(d.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("package_id=")
 	builder.WriteString(fmt.Sprintf("%v", d.PackageID))
 	builder.WriteString(", ")
@@ -218,6 +237,9 @@ func (d *Dependency) String() string {
 	builder.WriteString("dependency_type=")
 	builder.WriteString(fmt.Sprintf("%v", d.DependencyType))
 	builder.WriteString(", ")
+	builder.WriteString("version_range=")
+	builder.WriteString(d.VersionRange)
+	builder.WriteString(", ")
 	builder.WriteString("justification=")
 	builder.WriteString(d.Justification)
 	builder.WriteString(", ")
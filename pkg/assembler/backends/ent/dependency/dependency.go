@@ -17,12 +17,16 @@ const (
 	Label = "dependency"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldPackageID holds the string denoting the package_id field in the database.
 	FieldPackageID = "package_id"
 	// FieldDependentPackageVersionID holds the string denoting the dependent_package_version_id field in the database.
 	FieldDependentPackageVersionID = "dependent_package_version_id"
 	// FieldDependencyType holds the string denoting the dependency_type field in the database.
 	FieldDependencyType = "dependency_type"
+	// FieldVersionRange holds the string denoting the version_range field in the database.
+	FieldVersionRange = "version_range"
 	// FieldJustification holds the string denoting the justification field in the database.
 	FieldJustification = "justification"
 	// FieldOrigin holds the string denoting the origin field in the database.
@@ -63,9 +67,11 @@ const (
 // Columns holds all SQL columns for dependency fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldPackageID,
 	FieldDependentPackageVersionID,
 	FieldDependencyType,
+	FieldVersionRange,
 	FieldJustification,
 	FieldOrigin,
 	FieldCollector,
@@ -89,6 +95,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// DefaultVersionRange holds the default value on creation for the "version_range" field.
+	DefaultVersionRange string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -125,6 +135,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByPackageID orders the results by the package_id field.
 func ByPackageID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPackageID, opts...).ToFunc()
@@ -140,6 +155,11 @@ func ByDependencyType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDependencyType, opts...).ToFunc()
 }
 
+// ByVersionRange orders the results by the version_range field.
+func ByVersionRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionRange, opts...).ToFunc()
+}
+
 // ByJustification orders the results by the justification field.
 func ByJustification(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldJustification, opts...).ToFunc()
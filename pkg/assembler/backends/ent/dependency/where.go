@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.Dependency {
 	return predicate.Dependency(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldTenant, v))
+}
+
 // PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
 func PackageID(v uuid.UUID) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldPackageID, v))
@@ -64,6 +69,11 @@ func DependentPackageVersionID(v uuid.UUID) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldDependentPackageVersionID, v))
 }
 
+// VersionRange applies equality check predicate on the "version_range" field. It's identical to VersionRangeEQ.
+func VersionRange(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldVersionRange, v))
+}
+
 // Justification applies equality check predicate on the "justification" field. It's identical to JustificationEQ.
 func Justification(v string) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldJustification, v))
@@ -84,6 +94,71 @@ func DocumentRef(v string) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldDocumentRef, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // PackageIDEQ applies the EQ predicate on the "package_id" field.
 func PackageIDEQ(v uuid.UUID) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldPackageID, v))
@@ -144,6 +219,71 @@ func DependencyTypeNotIn(vs ...DependencyType) predicate.Dependency {
 	return predicate.Dependency(sql.FieldNotIn(FieldDependencyType, vs...))
 }
 
+// VersionRangeEQ applies the EQ predicate on the "version_range" field.
+func VersionRangeEQ(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEQ(FieldVersionRange, v))
+}
+
+// VersionRangeNEQ applies the NEQ predicate on the "version_range" field.
+func VersionRangeNEQ(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNEQ(FieldVersionRange, v))
+}
+
+// VersionRangeIn applies the In predicate on the "version_range" field.
+func VersionRangeIn(vs ...string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldIn(FieldVersionRange, vs...))
+}
+
+// VersionRangeNotIn applies the NotIn predicate on the "version_range" field.
+func VersionRangeNotIn(vs ...string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldNotIn(FieldVersionRange, vs...))
+}
+
+// VersionRangeGT applies the GT predicate on the "version_range" field.
+func VersionRangeGT(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldGT(FieldVersionRange, v))
+}
+
+// VersionRangeGTE applies the GTE predicate on the "version_range" field.
+func VersionRangeGTE(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldGTE(FieldVersionRange, v))
+}
+
+// VersionRangeLT applies the LT predicate on the "version_range" field.
+func VersionRangeLT(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldLT(FieldVersionRange, v))
+}
+
+// VersionRangeLTE applies the LTE predicate on the "version_range" field.
+func VersionRangeLTE(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldLTE(FieldVersionRange, v))
+}
+
+// VersionRangeContains applies the Contains predicate on the "version_range" field.
+func VersionRangeContains(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldContains(FieldVersionRange, v))
+}
+
+// VersionRangeHasPrefix applies the HasPrefix predicate on the "version_range" field.
+func VersionRangeHasPrefix(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldHasPrefix(FieldVersionRange, v))
+}
+
+// VersionRangeHasSuffix applies the HasSuffix predicate on the "version_range" field.
+func VersionRangeHasSuffix(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldHasSuffix(FieldVersionRange, v))
+}
+
+// VersionRangeEqualFold applies the EqualFold predicate on the "version_range" field.
+func VersionRangeEqualFold(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldEqualFold(FieldVersionRange, v))
+}
+
+// VersionRangeContainsFold applies the ContainsFold predicate on the "version_range" field.
+func VersionRangeContainsFold(v string) predicate.Dependency {
+	return predicate.Dependency(sql.FieldContainsFold(FieldVersionRange, v))
+}
+
 // JustificationEQ applies the EQ predicate on the "justification" field.
 func JustificationEQ(v string) predicate.Dependency {
 	return predicate.Dependency(sql.FieldEQ(FieldJustification, v))
@@ -25,6 +25,20 @@ type DependencyCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (dc *DependencyCreate) SetTenant(s string) *DependencyCreate {
+	dc.mutation.SetTenant(s)
+	return dc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (dc *DependencyCreate) SetNillableTenant(s *string) *DependencyCreate {
+	if s != nil {
+		dc.SetTenant(*s)
+	}
+	return dc
+}
+
 // SetPackageID sets the "package_id" field.
 func (dc *DependencyCreate) SetPackageID(u uuid.UUID) *DependencyCreate {
 	dc.mutation.SetPackageID(u)
@@ -43,6 +57,20 @@ func (dc *DependencyCreate) SetDependencyType(dt dependency.DependencyType) *Dep
 	return dc
 }
 
+// SetVersionRange sets the "version_range" field.
+func (dc *DependencyCreate) SetVersionRange(s string) *DependencyCreate {
+	dc.mutation.SetVersionRange(s)
+	return dc
+}
+
+// SetNillableVersionRange sets the "version_range" field if the given value is not nil.
+func (dc *DependencyCreate) SetNillableVersionRange(s *string) *DependencyCreate {
+	if s != nil {
+		dc.SetVersionRange(*s)
+	}
+	return dc
+}
+
 // SetJustification sets the "justification" field.
 func (dc *DependencyCreate) SetJustification(s string) *DependencyCreate {
 	dc.mutation.SetJustification(s)
@@ -141,6 +169,14 @@ func (dc *DependencyCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (dc *DependencyCreate) defaults() {
+	if _, ok := dc.mutation.Tenant(); !ok {
+		v := dependency.DefaultTenant
+		dc.mutation.SetTenant(v)
+	}
+	if _, ok := dc.mutation.VersionRange(); !ok {
+		v := dependency.DefaultVersionRange
+		dc.mutation.SetVersionRange(v)
+	}
 	if _, ok := dc.mutation.ID(); !ok {
 		v := dependency.DefaultID()
 		dc.mutation.SetID(v)
@@ -149,6 +185,9 @@ func (dc *DependencyCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (dc *DependencyCreate) check() error {
+	if _, ok := dc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "Dependency.tenant"`)}
+	}
 	if _, ok := dc.mutation.PackageID(); !ok {
 		return &ValidationError{Name: "package_id", err: errors.New(`ent: missing required field "Dependency.package_id"`)}
 	}
@@ -163,6 +202,9 @@ func (dc *DependencyCreate) check() error {
 			return &ValidationError{Name: "dependency_type", err: fmt.Errorf(`ent: validator failed for field "Dependency.dependency_type": %w`, err)}
 		}
 	}
+	if _, ok := dc.mutation.VersionRange(); !ok {
+		return &ValidationError{Name: "version_range", err: errors.New(`ent: missing required field "Dependency.version_range"`)}
+	}
 	if _, ok := dc.mutation.Justification(); !ok {
 		return &ValidationError{Name: "justification", err: errors.New(`ent: missing required field "Dependency.justification"`)}
 	}
@@ -217,10 +259,18 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := dc.mutation.Tenant(); ok {
+		_spec.SetField(dependency.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := dc.mutation.DependencyType(); ok {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 		_node.DependencyType = value
 	}
+	if value, ok := dc.mutation.VersionRange(); ok {
+		_spec.SetField(dependency.FieldVersionRange, field.TypeString, value)
+		_node.VersionRange = value
+	}
 	if value, ok := dc.mutation.Justification(); ok {
 		_spec.SetField(dependency.FieldJustification, field.TypeString, value)
 		_node.Justification = value
@@ -294,7 +344,7 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Dependency.Create().
-//		SetPackageID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -303,7 +353,7 @@ func (dc *DependencyCreate) createSpec() (*Dependency, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.DependencyUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (dc *DependencyCreate) OnConflict(opts ...sql.ConflictOption) *DependencyUpsertOne {
@@ -375,6 +425,18 @@ func (u *DependencyUpsert) UpdateDependencyType() *DependencyUpsert {
 	return u
 }
 
+// SetVersionRange sets the "version_range" field.
+func (u *DependencyUpsert) SetVersionRange(v string) *DependencyUpsert {
+	u.Set(dependency.FieldVersionRange, v)
+	return u
+}
+
+// UpdateVersionRange sets the "version_range" field to the value that was provided on create.
+func (u *DependencyUpsert) UpdateVersionRange() *DependencyUpsert {
+	u.SetExcluded(dependency.FieldVersionRange)
+	return u
+}
+
 // SetJustification sets the "justification" field.
 func (u *DependencyUpsert) SetJustification(v string) *DependencyUpsert {
 	u.Set(dependency.FieldJustification, v)
@@ -440,6 +502,9 @@ func (u *DependencyUpsertOne) UpdateNewValues() *DependencyUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(dependency.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(dependency.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -513,6 +578,20 @@ func (u *DependencyUpsertOne) UpdateDependencyType() *DependencyUpsertOne {
 	})
 }
 
+// SetVersionRange sets the "version_range" field.
+func (u *DependencyUpsertOne) SetVersionRange(v string) *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetVersionRange(v)
+	})
+}
+
+// UpdateVersionRange sets the "version_range" field to the value that was provided on create.
+func (u *DependencyUpsertOne) UpdateVersionRange() *DependencyUpsertOne {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateVersionRange()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *DependencyUpsertOne) SetJustification(v string) *DependencyUpsertOne {
 	return u.Update(func(s *DependencyUpsert) {
@@ -705,7 +784,7 @@ func (dcb *DependencyCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.DependencyUpsert) {
-//			SetPackageID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (dcb *DependencyCreateBulk) OnConflict(opts ...sql.ConflictOption) *DependencyUpsertBulk {
@@ -752,6 +831,9 @@ func (u *DependencyUpsertBulk) UpdateNewValues() *DependencyUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(dependency.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(dependency.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -826,6 +908,20 @@ func (u *DependencyUpsertBulk) UpdateDependencyType() *DependencyUpsertBulk {
 	})
 }
 
+// SetVersionRange sets the "version_range" field.
+func (u *DependencyUpsertBulk) SetVersionRange(v string) *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.SetVersionRange(v)
+	})
+}
+
+// UpdateVersionRange sets the "version_range" field to the value that was provided on create.
+func (u *DependencyUpsertBulk) UpdateVersionRange() *DependencyUpsertBulk {
+	return u.Update(func(s *DependencyUpsert) {
+		s.UpdateVersionRange()
+	})
+}
+
 // SetJustification sets the "justification" field.
 func (u *DependencyUpsertBulk) SetJustification(v string) *DependencyUpsertBulk {
 	return u.Update(func(s *DependencyUpsert) {
@@ -374,12 +374,12 @@ func (dq *DependencyQuery) WithIncludedInSboms(opts ...func(*BillOfMaterialsQuer
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Dependency.Query().
-//		GroupBy(dependency.FieldPackageID).
+//		GroupBy(dependency.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (dq *DependencyQuery) GroupBy(field string, fields ...string) *DependencyGroupBy {
@@ -397,11 +397,11 @@ func (dq *DependencyQuery) GroupBy(field string, fields ...string) *DependencyGr
 // Example:
 //
 //	var v []struct {
-//		PackageID uuid.UUID `json:"package_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Dependency.Query().
-//		Select(dependency.FieldPackageID).
+//		Select(dependency.FieldTenant).
 //		Scan(ctx, &v)
 func (dq *DependencyQuery) Select(fields ...string) *DependencySelect {
 	dq.ctx.Fields = append(dq.ctx.Fields, fields...)
@@ -72,6 +72,20 @@ func (du *DependencyUpdate) SetNillableDependencyType(dt *dependency.DependencyT
 	return du
 }
 
+// SetVersionRange sets the "version_range" field.
+func (du *DependencyUpdate) SetVersionRange(s string) *DependencyUpdate {
+	du.mutation.SetVersionRange(s)
+	return du
+}
+
+// SetNillableVersionRange sets the "version_range" field if the given value is not nil.
+func (du *DependencyUpdate) SetNillableVersionRange(s *string) *DependencyUpdate {
+	if s != nil {
+		du.SetVersionRange(*s)
+	}
+	return du
+}
+
 // SetJustification sets the "justification" field.
 func (du *DependencyUpdate) SetJustification(s string) *DependencyUpdate {
 	du.mutation.SetJustification(s)
@@ -249,6 +263,9 @@ func (du *DependencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.DependencyType(); ok {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 	}
+	if value, ok := du.mutation.VersionRange(); ok {
+		_spec.SetField(dependency.FieldVersionRange, field.TypeString, value)
+	}
 	if value, ok := du.mutation.Justification(); ok {
 		_spec.SetField(dependency.FieldJustification, field.TypeString, value)
 	}
@@ -426,6 +443,20 @@ func (duo *DependencyUpdateOne) SetNillableDependencyType(dt *dependency.Depende
 	return duo
 }
 
+// SetVersionRange sets the "version_range" field.
+func (duo *DependencyUpdateOne) SetVersionRange(s string) *DependencyUpdateOne {
+	duo.mutation.SetVersionRange(s)
+	return duo
+}
+
+// SetNillableVersionRange sets the "version_range" field if the given value is not nil.
+func (duo *DependencyUpdateOne) SetNillableVersionRange(s *string) *DependencyUpdateOne {
+	if s != nil {
+		duo.SetVersionRange(*s)
+	}
+	return duo
+}
+
 // SetJustification sets the "justification" field.
 func (duo *DependencyUpdateOne) SetJustification(s string) *DependencyUpdateOne {
 	duo.mutation.SetJustification(s)
@@ -633,6 +664,9 @@ func (duo *DependencyUpdateOne) sqlSave(ctx context.Context) (_node *Dependency,
 	if value, ok := duo.mutation.DependencyType(); ok {
 		_spec.SetField(dependency.FieldDependencyType, field.TypeEnum, value)
 	}
+	if value, ok := duo.mutation.VersionRange(); ok {
+		_spec.SetField(dependency.FieldVersionRange, field.TypeString, value)
+	}
 	if value, ok := duo.mutation.Justification(); ok {
 		_spec.SetField(dependency.FieldJustification, field.TypeString, value)
 	}
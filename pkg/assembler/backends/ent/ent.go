@@ -17,6 +17,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -46,6 +47,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -113,6 +115,7 @@ func checkColumn(table, column string) error {
 			cwe.Table:                   cwe.ValidColumn,
 			certification.Table:         certification.ValidColumn,
 			certifylegal.Table:          certifylegal.ValidColumn,
+			certifypolicy.Table:         certifypolicy.ValidColumn,
 			certifyscorecard.Table:      certifyscorecard.ValidColumn,
 			certifyvex.Table:            certifyvex.ValidColumn,
 			certifyvuln.Table:           certifyvuln.ValidColumn,
@@ -140,6 +143,7 @@ func checkColumn(table, column string) error {
 			vulnequal.Table:             vulnequal.ValidColumn,
 			vulnerabilityid.Table:       vulnerabilityid.ValidColumn,
 			vulnerabilitymetadata.Table: vulnerabilitymetadata.ValidColumn,
+			vulnerabilityrange.Table:    vulnerabilityrange.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -11,6 +11,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -40,6 +41,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
@@ -194,6 +196,19 @@ func (a *ArtifactQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				*wq = *query
 			})
 
+		case "policies":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CertifyPolicyClient{config: a.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, certifypolicyImplementors)...); err != nil {
+				return err
+			}
+			a.WithNamedPolicies(alias, func(wq *CertifyPolicyQuery) {
+				*wq = *query
+			})
+
 		case "includedInSboms":
 			var (
 				alias = field.Alias
@@ -357,6 +372,11 @@ func (bom *BillOfMaterialsQuery) collectField(ctx context.Context, oneNode bool,
 			bom.WithNamedIncludedOccurrences(alias, func(wq *OccurrenceQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[billofmaterials.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, billofmaterials.FieldTenant)
+				fieldSeen[billofmaterials.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[billofmaterials.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, billofmaterials.FieldPackageID)
@@ -854,6 +874,11 @@ func (c *CertificationQuery) collectField(ctx context.Context, oneNode bool, opC
 				selectedFields = append(selectedFields, certification.FieldArtifactID)
 				fieldSeen[certification.FieldArtifactID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certification.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certification.FieldTenant)
+				fieldSeen[certification.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[certification.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, certification.FieldSourceID)
@@ -1019,6 +1044,11 @@ func (cl *CertifyLegalQuery) collectField(ctx context.Context, oneNode bool, opC
 			cl.WithNamedDiscoveredLicenses(alias, func(wq *LicenseQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[certifylegal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifylegal.FieldTenant)
+				fieldSeen[certifylegal.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[certifylegal.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, certifylegal.FieldPackageID)
@@ -1117,6 +1147,135 @@ func newCertifyLegalPaginateArgs(rv map[string]any) *certifylegalPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (cp *CertifyPolicyQuery) CollectFields(ctx context.Context, satisfies ...string) (*CertifyPolicyQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return cp, nil
+	}
+	if err := cp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (cp *CertifyPolicyQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(certifypolicy.Columns))
+		selectedFields = []string{certifypolicy.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "artifact":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ArtifactClient{config: cp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, artifactImplementors)...); err != nil {
+				return err
+			}
+			cp.withArtifact = query
+			if _, ok := fieldSeen[certifypolicy.FieldArtifactID]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldArtifactID)
+				fieldSeen[certifypolicy.FieldArtifactID] = struct{}{}
+			}
+		case "tenant":
+			if _, ok := fieldSeen[certifypolicy.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldTenant)
+				fieldSeen[certifypolicy.FieldTenant] = struct{}{}
+			}
+		case "artifactID":
+			if _, ok := fieldSeen[certifypolicy.FieldArtifactID]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldArtifactID)
+				fieldSeen[certifypolicy.FieldArtifactID] = struct{}{}
+			}
+		case "verifier":
+			if _, ok := fieldSeen[certifypolicy.FieldVerifier]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldVerifier)
+				fieldSeen[certifypolicy.FieldVerifier] = struct{}{}
+			}
+		case "policyURI":
+			if _, ok := fieldSeen[certifypolicy.FieldPolicyURI]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldPolicyURI)
+				fieldSeen[certifypolicy.FieldPolicyURI] = struct{}{}
+			}
+		case "policyDigest":
+			if _, ok := fieldSeen[certifypolicy.FieldPolicyDigest]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldPolicyDigest)
+				fieldSeen[certifypolicy.FieldPolicyDigest] = struct{}{}
+			}
+		case "result":
+			if _, ok := fieldSeen[certifypolicy.FieldResult]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldResult)
+				fieldSeen[certifypolicy.FieldResult] = struct{}{}
+			}
+		case "verifiedLevels":
+			if _, ok := fieldSeen[certifypolicy.FieldVerifiedLevels]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldVerifiedLevels)
+				fieldSeen[certifypolicy.FieldVerifiedLevels] = struct{}{}
+			}
+		case "timeVerified":
+			if _, ok := fieldSeen[certifypolicy.FieldTimeVerified]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldTimeVerified)
+				fieldSeen[certifypolicy.FieldTimeVerified] = struct{}{}
+			}
+		case "origin":
+			if _, ok := fieldSeen[certifypolicy.FieldOrigin]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldOrigin)
+				fieldSeen[certifypolicy.FieldOrigin] = struct{}{}
+			}
+		case "collector":
+			if _, ok := fieldSeen[certifypolicy.FieldCollector]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldCollector)
+				fieldSeen[certifypolicy.FieldCollector] = struct{}{}
+			}
+		case "documentRef":
+			if _, ok := fieldSeen[certifypolicy.FieldDocumentRef]; !ok {
+				selectedFields = append(selectedFields, certifypolicy.FieldDocumentRef)
+				fieldSeen[certifypolicy.FieldDocumentRef] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		cp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type certifypolicyPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CertifyPolicyPaginateOption
+}
+
+func newCertifyPolicyPaginateArgs(rv map[string]any) *certifypolicyPaginateArgs {
+	args := &certifypolicyPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (cs *CertifyScorecardQuery) CollectFields(ctx context.Context, satisfies ...string) (*CertifyScorecardQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1153,6 +1312,11 @@ func (cs *CertifyScorecardQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, certifyscorecard.FieldSourceID)
 				fieldSeen[certifyscorecard.FieldSourceID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certifyscorecard.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifyscorecard.FieldTenant)
+				fieldSeen[certifyscorecard.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[certifyscorecard.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, certifyscorecard.FieldSourceID)
@@ -1357,6 +1521,11 @@ func (cv *CertifyVexQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			cv.WithNamedReachableCode(alias, func(wq *ReachableCodeQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[certifyvex.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifyvex.FieldTenant)
+				fieldSeen[certifyvex.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[certifyvex.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, certifyvex.FieldPackageID)
@@ -1511,6 +1680,11 @@ func (cv *CertifyVulnQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, certifyvuln.FieldPackageID)
 				fieldSeen[certifyvuln.FieldPackageID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[certifyvuln.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, certifyvuln.FieldTenant)
+				fieldSeen[certifyvuln.FieldTenant] = struct{}{}
+			}
 		case "vulnerabilityID":
 			if _, ok := fieldSeen[certifyvuln.FieldVulnerabilityID]; !ok {
 				selectedFields = append(selectedFields, certifyvuln.FieldVulnerabilityID)
@@ -2002,6 +2176,11 @@ func (d *DependencyQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			d.WithNamedIncludedInSboms(alias, func(wq *BillOfMaterialsQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[dependency.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, dependency.FieldTenant)
+				fieldSeen[dependency.FieldTenant] = struct{}{}
+			}
 		case "packageID":
 			if _, ok := fieldSeen[dependency.FieldPackageID]; !ok {
 				selectedFields = append(selectedFields, dependency.FieldPackageID)
@@ -2017,6 +2196,11 @@ func (d *DependencyQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, dependency.FieldDependencyType)
 				fieldSeen[dependency.FieldDependencyType] = struct{}{}
 			}
+		case "versionRange":
+			if _, ok := fieldSeen[dependency.FieldVersionRange]; !ok {
+				selectedFields = append(selectedFields, dependency.FieldVersionRange)
+				fieldSeen[dependency.FieldVersionRange] = struct{}{}
+			}
 		case "justification":
 			if _, ok := fieldSeen[dependency.FieldJustification]; !ok {
 				selectedFields = append(selectedFields, dependency.FieldJustification)
@@ -2335,6 +2519,11 @@ func (hm *HasMetadataQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, hasmetadata.FieldArtifactID)
 				fieldSeen[hasmetadata.FieldArtifactID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[hasmetadata.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, hasmetadata.FieldTenant)
+				fieldSeen[hasmetadata.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[hasmetadata.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, hasmetadata.FieldSourceID)
@@ -2494,6 +2683,11 @@ func (hsa *HasSourceAtQuery) collectField(ctx context.Context, oneNode bool, opC
 				selectedFields = append(selectedFields, hassourceat.FieldSourceID)
 				fieldSeen[hassourceat.FieldSourceID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[hassourceat.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, hassourceat.FieldTenant)
+				fieldSeen[hassourceat.FieldTenant] = struct{}{}
+			}
 		case "packageVersionID":
 			if _, ok := fieldSeen[hassourceat.FieldPackageVersionID]; !ok {
 				selectedFields = append(selectedFields, hassourceat.FieldPackageVersionID)
@@ -2623,6 +2817,11 @@ func (he *HashEqualQuery) collectField(ctx context.Context, oneNode bool, opCtx
 				selectedFields = append(selectedFields, hashequal.FieldEqualArtID)
 				fieldSeen[hashequal.FieldEqualArtID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[hashequal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, hashequal.FieldTenant)
+				fieldSeen[hashequal.FieldTenant] = struct{}{}
+			}
 		case "artID":
 			if _, ok := fieldSeen[hashequal.FieldArtID]; !ok {
 				selectedFields = append(selectedFields, hashequal.FieldArtID)
@@ -2875,6 +3074,11 @@ func (o *OccurrenceQuery) collectField(ctx context.Context, oneNode bool, opCtx
 			o.WithNamedIncludedInSboms(alias, func(wq *BillOfMaterialsQuery) {
 				*wq = *query
 			})
+		case "tenant":
+			if _, ok := fieldSeen[occurrence.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, occurrence.FieldTenant)
+				fieldSeen[occurrence.FieldTenant] = struct{}{}
+			}
 		case "artifactID":
 			if _, ok := fieldSeen[occurrence.FieldArtifactID]; !ok {
 				selectedFields = append(selectedFields, occurrence.FieldArtifactID)
@@ -3034,6 +3238,19 @@ func (pn *PackageNameQuery) collectField(ctx context.Context, oneNode bool, opCt
 			pn.WithNamedPoc(alias, func(wq *PointOfContactQuery) {
 				*wq = *query
 			})
+
+		case "vulnerabilityRanges":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&VulnerabilityRangeClient{config: pn.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, vulnerabilityrangeImplementors)...); err != nil {
+				return err
+			}
+			pn.WithNamedVulnerabilityRanges(alias, func(wq *VulnerabilityRangeQuery) {
+				*wq = *query
+			})
 		case "type":
 			if _, ok := fieldSeen[packagename.FieldType]; !ok {
 				selectedFields = append(selectedFields, packagename.FieldType)
@@ -3419,6 +3636,11 @@ func (pe *PkgEqualQuery) collectField(ctx context.Context, oneNode bool, opCtx *
 				selectedFields = append(selectedFields, pkgequal.FieldEqualPkgID)
 				fieldSeen[pkgequal.FieldEqualPkgID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[pkgequal.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, pkgequal.FieldTenant)
+				fieldSeen[pkgequal.FieldTenant] = struct{}{}
+			}
 		case "pkgID":
 			if _, ok := fieldSeen[pkgequal.FieldPkgID]; !ok {
 				selectedFields = append(selectedFields, pkgequal.FieldPkgID)
@@ -3573,6 +3795,11 @@ func (poc *PointOfContactQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, pointofcontact.FieldArtifactID)
 				fieldSeen[pointofcontact.FieldArtifactID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[pointofcontact.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, pointofcontact.FieldTenant)
+				fieldSeen[pointofcontact.FieldTenant] = struct{}{}
+			}
 		case "sourceID":
 			if _, ok := fieldSeen[pointofcontact.FieldSourceID]; !ok {
 				selectedFields = append(selectedFields, pointofcontact.FieldSourceID)
@@ -3994,6 +4221,11 @@ func (sa *SLSAAttestationQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, slsaattestation.FieldSubjectID)
 				fieldSeen[slsaattestation.FieldSubjectID] = struct{}{}
 			}
+		case "tenant":
+			if _, ok := fieldSeen[slsaattestation.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, slsaattestation.FieldTenant)
+				fieldSeen[slsaattestation.FieldTenant] = struct{}{}
+			}
 		case "buildType":
 			if _, ok := fieldSeen[slsaattestation.FieldBuildType]; !ok {
 				selectedFields = append(selectedFields, slsaattestation.FieldBuildType)
@@ -4472,6 +4704,19 @@ func (vi *VulnerabilityIDQuery) collectField(ctx context.Context, oneNode bool,
 			vi.WithNamedVex(alias, func(wq *CertifyVexQuery) {
 				*wq = *query
 			})
+
+		case "vulnerabilityRanges":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&VulnerabilityRangeClient{config: vi.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, vulnerabilityrangeImplementors)...); err != nil {
+				return err
+			}
+			vi.WithNamedVulnerabilityRanges(alias, func(wq *VulnerabilityRangeQuery) {
+				*wq = *query
+			})
 		case "vulnerabilityID":
 			if _, ok := fieldSeen[vulnerabilityid.FieldVulnerabilityID]; !ok {
 				selectedFields = append(selectedFields, vulnerabilityid.FieldVulnerabilityID)
@@ -4629,6 +4874,145 @@ func newVulnerabilityMetadataPaginateArgs(rv map[string]any) *vulnerabilitymetad
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (vr *VulnerabilityRangeQuery) CollectFields(ctx context.Context, satisfies ...string) (*VulnerabilityRangeQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return vr, nil
+	}
+	if err := vr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return vr, nil
+}
+
+func (vr *VulnerabilityRangeQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(vulnerabilityrange.Columns))
+		selectedFields = []string{vulnerabilityrange.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "vulnerability":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&VulnerabilityIDClient{config: vr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, vulnerabilityidImplementors)...); err != nil {
+				return err
+			}
+			vr.withVulnerability = query
+			if _, ok := fieldSeen[vulnerabilityrange.FieldVulnerabilityID]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldVulnerabilityID)
+				fieldSeen[vulnerabilityrange.FieldVulnerabilityID] = struct{}{}
+			}
+
+		case "package":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&PackageNameClient{config: vr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, packagenameImplementors)...); err != nil {
+				return err
+			}
+			vr.withPackage = query
+			if _, ok := fieldSeen[vulnerabilityrange.FieldPackageNameID]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldPackageNameID)
+				fieldSeen[vulnerabilityrange.FieldPackageNameID] = struct{}{}
+			}
+		case "tenant":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldTenant]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldTenant)
+				fieldSeen[vulnerabilityrange.FieldTenant] = struct{}{}
+			}
+		case "vulnerabilityID":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldVulnerabilityID]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldVulnerabilityID)
+				fieldSeen[vulnerabilityrange.FieldVulnerabilityID] = struct{}{}
+			}
+		case "packageNameID":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldPackageNameID]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldPackageNameID)
+				fieldSeen[vulnerabilityrange.FieldPackageNameID] = struct{}{}
+			}
+		case "rangeType":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldRangeType]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldRangeType)
+				fieldSeen[vulnerabilityrange.FieldRangeType] = struct{}{}
+			}
+		case "introduced":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldIntroduced]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldIntroduced)
+				fieldSeen[vulnerabilityrange.FieldIntroduced] = struct{}{}
+			}
+		case "fixed":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldFixed]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldFixed)
+				fieldSeen[vulnerabilityrange.FieldFixed] = struct{}{}
+			}
+		case "lastAffected":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldLastAffected]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldLastAffected)
+				fieldSeen[vulnerabilityrange.FieldLastAffected] = struct{}{}
+			}
+		case "origin":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldOrigin]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldOrigin)
+				fieldSeen[vulnerabilityrange.FieldOrigin] = struct{}{}
+			}
+		case "collector":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldCollector]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldCollector)
+				fieldSeen[vulnerabilityrange.FieldCollector] = struct{}{}
+			}
+		case "documentRef":
+			if _, ok := fieldSeen[vulnerabilityrange.FieldDocumentRef]; !ok {
+				selectedFields = append(selectedFields, vulnerabilityrange.FieldDocumentRef)
+				fieldSeen[vulnerabilityrange.FieldDocumentRef] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		vr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type vulnerabilityrangePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []VulnerabilityRangePaginateOption
+}
+
+func newVulnerabilityRangePaginateArgs(rv map[string]any) *vulnerabilityrangePaginateArgs {
+	args := &vulnerabilityrangePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
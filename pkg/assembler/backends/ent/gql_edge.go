@@ -128,6 +128,18 @@ func (a *Artifact) Poc(ctx context.Context) (result []*PointOfContact, err error
 	return result, err
 }
 
+func (a *Artifact) Policies(ctx context.Context) (result []*CertifyPolicy, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = a.NamedPolicies(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = a.Edges.PoliciesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = a.QueryPolicies().All(ctx)
+	}
+	return result, err
+}
+
 func (a *Artifact) IncludedInSboms(ctx context.Context) (result []*BillOfMaterials, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = a.NamedIncludedInSboms(graphql.GetFieldContext(ctx).Field.Alias)
@@ -360,6 +372,14 @@ func (cl *CertifyLegal) DiscoveredLicenses(ctx context.Context) (result []*Licen
 	return result, err
 }
 
+func (cp *CertifyPolicy) Artifact(ctx context.Context) (*Artifact, error) {
+	result, err := cp.Edges.ArtifactOrErr()
+	if IsNotLoaded(err) {
+		result, err = cp.QueryArtifact().Only(ctx)
+	}
+	return result, err
+}
+
 func (cs *CertifyScorecard) Source(ctx context.Context) (*SourceName, error) {
 	result, err := cs.Edges.SourceOrErr()
 	if IsNotLoaded(err) {
@@ -768,6 +788,18 @@ func (pn *PackageName) Poc(ctx context.Context) (result []*PointOfContact, err e
 	return result, err
 }
 
+func (pn *PackageName) VulnerabilityRanges(ctx context.Context) (result []*VulnerabilityRange, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = pn.NamedVulnerabilityRanges(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = pn.Edges.VulnerabilityRangesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = pn.QueryVulnerabilityRanges().All(ctx)
+	}
+	return result, err
+}
+
 func (pv *PackageVersion) Name(ctx context.Context) (*PackageName, error) {
 	result, err := pv.Edges.NameOrErr()
 	if IsNotLoaded(err) {
@@ -1228,6 +1260,18 @@ func (vi *VulnerabilityID) Vex(ctx context.Context) (result []*CertifyVex, err e
 	return result, err
 }
 
+func (vi *VulnerabilityID) VulnerabilityRanges(ctx context.Context) (result []*VulnerabilityRange, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = vi.NamedVulnerabilityRanges(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = vi.Edges.VulnerabilityRangesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = vi.QueryVulnerabilityRanges().All(ctx)
+	}
+	return result, err
+}
+
 func (vm *VulnerabilityMetadata) VulnerabilityID(ctx context.Context) (*VulnerabilityID, error) {
 	result, err := vm.Edges.VulnerabilityIDOrErr()
 	if IsNotLoaded(err) {
@@ -1235,3 +1279,19 @@ func (vm *VulnerabilityMetadata) VulnerabilityID(ctx context.Context) (*Vulnerab
 	}
 	return result, err
 }
+
+func (vr *VulnerabilityRange) Vulnerability(ctx context.Context) (*VulnerabilityID, error) {
+	result, err := vr.Edges.VulnerabilityOrErr()
+	if IsNotLoaded(err) {
+		result, err = vr.QueryVulnerability().Only(ctx)
+	}
+	return result, err
+}
+
+func (vr *VulnerabilityRange) Package(ctx context.Context) (*PackageName, error) {
+	result, err := vr.Edges.PackageOrErr()
+	if IsNotLoaded(err) {
+		result, err = vr.QueryPackage().Only(ctx)
+	}
+	return result, err
+}
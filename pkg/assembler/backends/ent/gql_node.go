@@ -14,6 +14,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -43,6 +44,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -86,6 +88,11 @@ var certifylegalImplementors = []string{"CertifyLegal", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*CertifyLegal) IsNode() {}
 
+var certifypolicyImplementors = []string{"CertifyPolicy", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*CertifyPolicy) IsNode() {}
+
 var certifyscorecardImplementors = []string{"CertifyScorecard", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -221,6 +228,11 @@ var vulnerabilitymetadataImplementors = []string{"VulnerabilityMetadata", "Node"
 // IsNode implements the Node interface check for GQLGen.
 func (*VulnerabilityMetadata) IsNode() {}
 
+var vulnerabilityrangeImplementors = []string{"VulnerabilityRange", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*VulnerabilityRange) IsNode() {}
+
 var errNodeInvalidID = &NotFoundError{"node"}
 
 // NodeOption allows configuring the Noder execution using functional options.
@@ -342,6 +354,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case certifypolicy.Table:
+		query := c.CertifyPolicy.Query().
+			Where(certifypolicy.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, certifypolicyImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case certifyscorecard.Table:
 		query := c.CertifyScorecard.Query().
 			Where(certifyscorecard.ID(id))
@@ -585,6 +606,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case vulnerabilityrange.Table:
+		query := c.VulnerabilityRange.Query().
+			Where(vulnerabilityrange.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, vulnerabilityrangeImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -770,6 +800,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case certifypolicy.Table:
+		query := c.CertifyPolicy.Query().
+			Where(certifypolicy.IDIn(ids...))
+		query, err := query.CollectFields(ctx, certifypolicyImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case certifyscorecard.Table:
 		query := c.CertifyScorecard.Query().
 			Where(certifyscorecard.IDIn(ids...))
@@ -1202,6 +1248,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case vulnerabilityrange.Table:
+		query := c.VulnerabilityRange.Query().
+			Where(vulnerabilityrange.IDIn(ids...))
+		query, err := query.CollectFields(ctx, vulnerabilityrangeImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -46,6 +47,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -1872,6 +1874,255 @@ func (cl *CertifyLegal) ToEdge(order *CertifyLegalOrder) *CertifyLegalEdge {
 	}
 }
 
+// CertifyPolicyEdge is the edge representation of CertifyPolicy.
+type CertifyPolicyEdge struct {
+	Node   *CertifyPolicy `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// CertifyPolicyConnection is the connection containing edges to CertifyPolicy.
+type CertifyPolicyConnection struct {
+	Edges      []*CertifyPolicyEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *CertifyPolicyConnection) build(nodes []*CertifyPolicy, pager *certifypolicyPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *CertifyPolicy
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *CertifyPolicy {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *CertifyPolicy {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*CertifyPolicyEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &CertifyPolicyEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// CertifyPolicyPaginateOption enables pagination customization.
+type CertifyPolicyPaginateOption func(*certifypolicyPager) error
+
+// WithCertifyPolicyOrder configures pagination ordering.
+func WithCertifyPolicyOrder(order *CertifyPolicyOrder) CertifyPolicyPaginateOption {
+	if order == nil {
+		order = DefaultCertifyPolicyOrder
+	}
+	o := *order
+	return func(pager *certifypolicyPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultCertifyPolicyOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithCertifyPolicyFilter configures pagination filter.
+func WithCertifyPolicyFilter(filter func(*CertifyPolicyQuery) (*CertifyPolicyQuery, error)) CertifyPolicyPaginateOption {
+	return func(pager *certifypolicyPager) error {
+		if filter == nil {
+			return errors.New("CertifyPolicyQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type certifypolicyPager struct {
+	reverse bool
+	order   *CertifyPolicyOrder
+	filter  func(*CertifyPolicyQuery) (*CertifyPolicyQuery, error)
+}
+
+func newCertifyPolicyPager(opts []CertifyPolicyPaginateOption, reverse bool) (*certifypolicyPager, error) {
+	pager := &certifypolicyPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultCertifyPolicyOrder
+	}
+	return pager, nil
+}
+
+func (p *certifypolicyPager) applyFilter(query *CertifyPolicyQuery) (*CertifyPolicyQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *certifypolicyPager) toCursor(cp *CertifyPolicy) Cursor {
+	return p.order.Field.toCursor(cp)
+}
+
+func (p *certifypolicyPager) applyCursors(query *CertifyPolicyQuery, after, before *Cursor) (*CertifyPolicyQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCertifyPolicyOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *certifypolicyPager) applyOrder(query *CertifyPolicyQuery) *CertifyPolicyQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultCertifyPolicyOrder.Field {
+		query = query.Order(DefaultCertifyPolicyOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *certifypolicyPager) orderExpr(query *CertifyPolicyQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultCertifyPolicyOrder.Field {
+			b.Comma().Ident(DefaultCertifyPolicyOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to CertifyPolicy.
+func (cp *CertifyPolicyQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...CertifyPolicyPaginateOption,
+) (*CertifyPolicyConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newCertifyPolicyPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if cp, err = pager.applyFilter(cp); err != nil {
+		return nil, err
+	}
+	conn := &CertifyPolicyConnection{Edges: []*CertifyPolicyEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := cp.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if cp, err = pager.applyCursors(cp, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		cp.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := cp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	cp = pager.applyOrder(cp)
+	nodes, err := cp.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// CertifyPolicyOrderField defines the ordering field of CertifyPolicy.
+type CertifyPolicyOrderField struct {
+	// Value extracts the ordering value from the given CertifyPolicy.
+	Value    func(*CertifyPolicy) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) certifypolicy.OrderOption
+	toCursor func(*CertifyPolicy) Cursor
+}
+
+// CertifyPolicyOrder defines the ordering of CertifyPolicy.
+type CertifyPolicyOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *CertifyPolicyOrderField `json:"field"`
+}
+
+// DefaultCertifyPolicyOrder is the default ordering of CertifyPolicy.
+var DefaultCertifyPolicyOrder = &CertifyPolicyOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &CertifyPolicyOrderField{
+		Value: func(cp *CertifyPolicy) (ent.Value, error) {
+			return cp.ID, nil
+		},
+		column: certifypolicy.FieldID,
+		toTerm: certifypolicy.ByID,
+		toCursor: func(cp *CertifyPolicy) Cursor {
+			return Cursor{ID: cp.ID}
+		},
+	},
+}
+
+// ToEdge converts CertifyPolicy into CertifyPolicyEdge.
+func (cp *CertifyPolicy) ToEdge(order *CertifyPolicyOrder) *CertifyPolicyEdge {
+	if order == nil {
+		order = DefaultCertifyPolicyOrder
+	}
+	return &CertifyPolicyEdge{
+		Node:   cp,
+		Cursor: order.Field.toCursor(cp),
+	}
+}
+
 // CertifyScorecardEdge is the edge representation of CertifyScorecard.
 type CertifyScorecardEdge struct {
 	Node   *CertifyScorecard `json:"node"`
@@ -8594,3 +8845,252 @@ func (vm *VulnerabilityMetadata) ToEdge(order *VulnerabilityMetadataOrder) *Vuln
 		Cursor: order.Field.toCursor(vm),
 	}
 }
+
+// VulnerabilityRangeEdge is the edge representation of VulnerabilityRange.
+type VulnerabilityRangeEdge struct {
+	Node   *VulnerabilityRange `json:"node"`
+	Cursor Cursor              `json:"cursor"`
+}
+
+// VulnerabilityRangeConnection is the connection containing edges to VulnerabilityRange.
+type VulnerabilityRangeConnection struct {
+	Edges      []*VulnerabilityRangeEdge `json:"edges"`
+	PageInfo   PageInfo                  `json:"pageInfo"`
+	TotalCount int                       `json:"totalCount"`
+}
+
+func (c *VulnerabilityRangeConnection) build(nodes []*VulnerabilityRange, pager *vulnerabilityrangePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *VulnerabilityRange
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *VulnerabilityRange {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *VulnerabilityRange {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*VulnerabilityRangeEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &VulnerabilityRangeEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// VulnerabilityRangePaginateOption enables pagination customization.
+type VulnerabilityRangePaginateOption func(*vulnerabilityrangePager) error
+
+// WithVulnerabilityRangeOrder configures pagination ordering.
+func WithVulnerabilityRangeOrder(order *VulnerabilityRangeOrder) VulnerabilityRangePaginateOption {
+	if order == nil {
+		order = DefaultVulnerabilityRangeOrder
+	}
+	o := *order
+	return func(pager *vulnerabilityrangePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultVulnerabilityRangeOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithVulnerabilityRangeFilter configures pagination filter.
+func WithVulnerabilityRangeFilter(filter func(*VulnerabilityRangeQuery) (*VulnerabilityRangeQuery, error)) VulnerabilityRangePaginateOption {
+	return func(pager *vulnerabilityrangePager) error {
+		if filter == nil {
+			return errors.New("VulnerabilityRangeQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type vulnerabilityrangePager struct {
+	reverse bool
+	order   *VulnerabilityRangeOrder
+	filter  func(*VulnerabilityRangeQuery) (*VulnerabilityRangeQuery, error)
+}
+
+func newVulnerabilityRangePager(opts []VulnerabilityRangePaginateOption, reverse bool) (*vulnerabilityrangePager, error) {
+	pager := &vulnerabilityrangePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultVulnerabilityRangeOrder
+	}
+	return pager, nil
+}
+
+func (p *vulnerabilityrangePager) applyFilter(query *VulnerabilityRangeQuery) (*VulnerabilityRangeQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *vulnerabilityrangePager) toCursor(vr *VulnerabilityRange) Cursor {
+	return p.order.Field.toCursor(vr)
+}
+
+func (p *vulnerabilityrangePager) applyCursors(query *VulnerabilityRangeQuery, after, before *Cursor) (*VulnerabilityRangeQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultVulnerabilityRangeOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *vulnerabilityrangePager) applyOrder(query *VulnerabilityRangeQuery) *VulnerabilityRangeQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultVulnerabilityRangeOrder.Field {
+		query = query.Order(DefaultVulnerabilityRangeOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *vulnerabilityrangePager) orderExpr(query *VulnerabilityRangeQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultVulnerabilityRangeOrder.Field {
+			b.Comma().Ident(DefaultVulnerabilityRangeOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to VulnerabilityRange.
+func (vr *VulnerabilityRangeQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...VulnerabilityRangePaginateOption,
+) (*VulnerabilityRangeConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newVulnerabilityRangePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if vr, err = pager.applyFilter(vr); err != nil {
+		return nil, err
+	}
+	conn := &VulnerabilityRangeConnection{Edges: []*VulnerabilityRangeEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := vr.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if vr, err = pager.applyCursors(vr, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		vr.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := vr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	vr = pager.applyOrder(vr)
+	nodes, err := vr.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// VulnerabilityRangeOrderField defines the ordering field of VulnerabilityRange.
+type VulnerabilityRangeOrderField struct {
+	// Value extracts the ordering value from the given VulnerabilityRange.
+	Value    func(*VulnerabilityRange) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) vulnerabilityrange.OrderOption
+	toCursor func(*VulnerabilityRange) Cursor
+}
+
+// VulnerabilityRangeOrder defines the ordering of VulnerabilityRange.
+type VulnerabilityRangeOrder struct {
+	Direction OrderDirection                `json:"direction"`
+	Field     *VulnerabilityRangeOrderField `json:"field"`
+}
+
+// DefaultVulnerabilityRangeOrder is the default ordering of VulnerabilityRange.
+var DefaultVulnerabilityRangeOrder = &VulnerabilityRangeOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &VulnerabilityRangeOrderField{
+		Value: func(vr *VulnerabilityRange) (ent.Value, error) {
+			return vr.ID, nil
+		},
+		column: vulnerabilityrange.FieldID,
+		toTerm: vulnerabilityrange.ByID,
+		toCursor: func(vr *VulnerabilityRange) Cursor {
+			return Cursor{ID: vr.ID}
+		},
+	},
+}
+
+// ToEdge converts VulnerabilityRange into VulnerabilityRangeEdge.
+func (vr *VulnerabilityRange) ToEdge(order *VulnerabilityRangeOrder) *VulnerabilityRangeEdge {
+	if order == nil {
+		order = DefaultVulnerabilityRangeOrder
+	}
+	return &VulnerabilityRangeEdge{
+		Node:   vr,
+		Cursor: order.Field.toCursor(vr),
+	}
+}
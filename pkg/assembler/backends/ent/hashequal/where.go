@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.HashEqual {
 	return predicate.HashEqual(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldTenant, v))
+}
+
 // ArtID applies equality check predicate on the "art_id" field. It's identical to ArtIDEQ.
 func ArtID(v uuid.UUID) predicate.HashEqual {
 	return predicate.HashEqual(sql.FieldEQ(FieldArtID, v))
@@ -89,6 +94,71 @@ func ArtifactsHash(v string) predicate.HashEqual {
 	return predicate.HashEqual(sql.FieldEQ(FieldArtifactsHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.HashEqual {
+	return predicate.HashEqual(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // ArtIDEQ applies the EQ predicate on the "art_id" field.
 func ArtIDEQ(v uuid.UUID) predicate.HashEqual {
 	return predicate.HashEqual(sql.FieldEQ(FieldArtID, v))
@@ -22,6 +22,8 @@ type HasMetadata struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// SourceID holds the value of the "source_id" field.
 	SourceID *uuid.UUID `json:"source_id,omitempty"`
 	// PackageVersionID holds the value of the "package_version_id" field.
@@ -118,7 +120,7 @@ func (*HasMetadata) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case hasmetadata.FieldSourceID, hasmetadata.FieldPackageVersionID, hasmetadata.FieldPackageNameID, hasmetadata.FieldArtifactID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case hasmetadata.FieldKey, hasmetadata.FieldValue, hasmetadata.FieldJustification, hasmetadata.FieldOrigin, hasmetadata.FieldCollector, hasmetadata.FieldDocumentRef:
+		case hasmetadata.FieldTenant, hasmetadata.FieldKey, hasmetadata.FieldValue, hasmetadata.FieldJustification, hasmetadata.FieldOrigin, hasmetadata.FieldCollector, hasmetadata.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case hasmetadata.FieldTimestamp:
 			values[i] = new(sql.NullTime)
@@ -145,6 +147,12 @@ func (hm *HasMetadata) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				hm.ID = *value
 			}
+		case hasmetadata.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				hm.Tenant = value.String
+			}
 		case hasmetadata.FieldSourceID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field source_id", values[i])
@@ -271,6 +279,9 @@ func (hm *HasMetadata) String() string {
 	var builder strings.Builder
 	builder.WriteString("HasMetadata(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", hm.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(hm.Tenant)
+	builder.WriteString(", ")
 	if v := hm.SourceID; v != nil {
 		builder.WriteString("source_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -28,6 +28,20 @@ type HasMetadataCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (hmc *HasMetadataCreate) SetTenant(s string) *HasMetadataCreate {
+	hmc.mutation.SetTenant(s)
+	return hmc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (hmc *HasMetadataCreate) SetNillableTenant(s *string) *HasMetadataCreate {
+	if s != nil {
+		hmc.SetTenant(*s)
+	}
+	return hmc
+}
+
 // SetSourceID sets the "source_id" field.
 func (hmc *HasMetadataCreate) SetSourceID(u uuid.UUID) *HasMetadataCreate {
 	hmc.mutation.SetSourceID(u)
@@ -209,6 +223,10 @@ func (hmc *HasMetadataCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (hmc *HasMetadataCreate) defaults() {
+	if _, ok := hmc.mutation.Tenant(); !ok {
+		v := hasmetadata.DefaultTenant
+		hmc.mutation.SetTenant(v)
+	}
 	if _, ok := hmc.mutation.ID(); !ok {
 		v := hasmetadata.DefaultID()
 		hmc.mutation.SetID(v)
@@ -217,6 +235,9 @@ func (hmc *HasMetadataCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (hmc *HasMetadataCreate) check() error {
+	if _, ok := hmc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "HasMetadata.tenant"`)}
+	}
 	if _, ok := hmc.mutation.Timestamp(); !ok {
 		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "HasMetadata.timestamp"`)}
 	}
@@ -274,6 +295,10 @@ func (hmc *HasMetadataCreate) createSpec() (*HasMetadata, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := hmc.mutation.Tenant(); ok {
+		_spec.SetField(hasmetadata.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := hmc.mutation.Timestamp(); ok {
 		_spec.SetField(hasmetadata.FieldTimestamp, field.TypeTime, value)
 		_node.Timestamp = value
@@ -377,7 +402,7 @@ func (hmc *HasMetadataCreate) createSpec() (*HasMetadata, *sqlgraph.CreateSpec)
 // of the `INSERT` statement. For example:
 //
 //	client.HasMetadata.Create().
-//		SetSourceID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -386,7 +411,7 @@ func (hmc *HasMetadataCreate) createSpec() (*HasMetadata, *sqlgraph.CreateSpec)
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HasMetadataUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (hmc *HasMetadataCreate) OnConflict(opts ...sql.ConflictOption) *HasMetadataUpsertOne {
@@ -595,6 +620,9 @@ func (u *HasMetadataUpsertOne) UpdateNewValues() *HasMetadataUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(hasmetadata.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(hasmetadata.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -944,7 +972,7 @@ func (hmcb *HasMetadataCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HasMetadataUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (hmcb *HasMetadataCreateBulk) OnConflict(opts ...sql.ConflictOption) *HasMetadataUpsertBulk {
@@ -991,6 +1019,9 @@ func (u *HasMetadataUpsertBulk) UpdateNewValues() *HasMetadataUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(hasmetadata.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(hasmetadata.FieldTenant)
+			}
 		}
 	}))
 	return u
@@ -13,6 +13,8 @@ const (
 	Label = "has_source_at"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldPackageVersionID holds the string denoting the package_version_id field in the database.
 	FieldPackageVersionID = "package_version_id"
 	// FieldPackageNameID holds the string denoting the package_name_id field in the database.
@@ -63,6 +65,7 @@ const (
 // Columns holds all SQL columns for hassourceat fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldPackageVersionID,
 	FieldPackageNameID,
 	FieldSourceID,
@@ -84,6 +87,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -96,6 +101,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByPackageVersionID orders the results by the package_version_id field.
 func ByPackageVersionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPackageVersionID, opts...).ToFunc()
@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEQ(FieldTenant, v))
+}
+
 // PackageVersionID applies equality check predicate on the "package_version_id" field. It's identical to PackageVersionIDEQ.
 func PackageVersionID(v uuid.UUID) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldEQ(FieldPackageVersionID, v))
@@ -96,6 +101,71 @@ func DocumentRef(v string) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldEQ(FieldDocumentRef, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.HasSourceAt {
+	return predicate.HasSourceAt(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // PackageVersionIDEQ applies the EQ predicate on the "package_version_id" field.
 func PackageVersionIDEQ(v uuid.UUID) predicate.HasSourceAt {
 	return predicate.HasSourceAt(sql.FieldEQ(FieldPackageVersionID, v))
@@ -27,6 +27,20 @@ type HasSourceAtCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (hsac *HasSourceAtCreate) SetTenant(s string) *HasSourceAtCreate {
+	hsac.mutation.SetTenant(s)
+	return hsac
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (hsac *HasSourceAtCreate) SetNillableTenant(s *string) *HasSourceAtCreate {
+	if s != nil {
+		hsac.SetTenant(*s)
+	}
+	return hsac
+}
+
 // SetPackageVersionID sets the "package_version_id" field.
 func (hsac *HasSourceAtCreate) SetPackageVersionID(u uuid.UUID) *HasSourceAtCreate {
 	hsac.mutation.SetPackageVersionID(u)
@@ -169,6 +183,10 @@ func (hsac *HasSourceAtCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (hsac *HasSourceAtCreate) defaults() {
+	if _, ok := hsac.mutation.Tenant(); !ok {
+		v := hassourceat.DefaultTenant
+		hsac.mutation.SetTenant(v)
+	}
 	if _, ok := hsac.mutation.ID(); !ok {
 		v := hassourceat.DefaultID()
 		hsac.mutation.SetID(v)
@@ -177,6 +195,9 @@ func (hsac *HasSourceAtCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (hsac *HasSourceAtCreate) check() error {
+	if _, ok := hsac.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "HasSourceAt.tenant"`)}
+	}
 	if _, ok := hsac.mutation.SourceID(); !ok {
 		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "HasSourceAt.source_id"`)}
 	}
@@ -234,6 +255,10 @@ func (hsac *HasSourceAtCreate) createSpec() (*HasSourceAt, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := hsac.mutation.Tenant(); ok {
+		_spec.SetField(hassourceat.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := hsac.mutation.KnownSince(); ok {
 		_spec.SetField(hassourceat.FieldKnownSince, field.TypeTime, value)
 		_node.KnownSince = value
@@ -312,7 +337,7 @@ func (hsac *HasSourceAtCreate) createSpec() (*HasSourceAt, *sqlgraph.CreateSpec)
 // of the `INSERT` statement. For example:
 //
 //	client.HasSourceAt.Create().
-//		SetPackageVersionID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -321,7 +346,7 @@ func (hsac *HasSourceAtCreate) createSpec() (*HasSourceAt, *sqlgraph.CreateSpec)
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HasSourceAtUpsert) {
-//			SetPackageVersionID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (hsac *HasSourceAtCreate) OnConflict(opts ...sql.ConflictOption) *HasSourceAtUpsertOne {
@@ -482,6 +507,9 @@ func (u *HasSourceAtUpsertOne) UpdateNewValues() *HasSourceAtUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(hassourceat.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(hassourceat.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -775,7 +803,7 @@ func (hsacb *HasSourceAtCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.HasSourceAtUpsert) {
-//			SetPackageVersionID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (hsacb *HasSourceAtCreateBulk) OnConflict(opts ...sql.ConflictOption) *HasSourceAtUpsertBulk {
@@ -822,6 +850,9 @@ func (u *HasSourceAtUpsertBulk) UpdateNewValues() *HasSourceAtUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(hassourceat.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(hassourceat.FieldTenant)
+			}
 		}
 	}))
 	return u
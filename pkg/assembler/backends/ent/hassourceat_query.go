@@ -373,12 +373,12 @@ func (hsaq *HasSourceAtQuery) WithSource(opts ...func(*SourceNameQuery)) *HasSou
 // Example:
 //
 //	var v []struct {
-//		PackageVersionID uuid.UUID `json:"package_version_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.HasSourceAt.Query().
-//		GroupBy(hassourceat.FieldPackageVersionID).
+//		GroupBy(hassourceat.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (hsaq *HasSourceAtQuery) GroupBy(field string, fields ...string) *HasSourceAtGroupBy {
@@ -396,11 +396,11 @@ func (hsaq *HasSourceAtQuery) GroupBy(field string, fields ...string) *HasSource
 // Example:
 //
 //	var v []struct {
-//		PackageVersionID uuid.UUID `json:"package_version_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.HasSourceAt.Query().
-//		Select(hassourceat.FieldPackageVersionID).
+//		Select(hassourceat.FieldTenant).
 //		Scan(ctx, &v)
 func (hsaq *HasSourceAtQuery) Select(fields ...string) *HasSourceAtSelect {
 	hsaq.ctx.Fields = append(hsaq.ctx.Fields, fields...)
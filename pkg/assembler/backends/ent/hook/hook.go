@@ -93,6 +93,18 @@ func (f CertifyLegalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertifyLegalMutation", m)
 }
 
+// The CertifyPolicyFunc type is an adapter to allow the use of ordinary
+// function as CertifyPolicy mutator.
+type CertifyPolicyFunc func(context.Context, *ent.CertifyPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CertifyPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CertifyPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CertifyPolicyMutation", m)
+}
+
 // The CertifyScorecardFunc type is an adapter to allow the use of ordinary
 // function as CertifyScorecard mutator.
 type CertifyScorecardFunc func(context.Context, *ent.CertifyScorecardMutation) (ent.Value, error)
@@ -417,6 +429,18 @@ func (f VulnerabilityMetadataFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VulnerabilityMetadataMutation", m)
 }
 
+// The VulnerabilityRangeFunc type is an adapter to allow the use of ordinary
+// function as VulnerabilityRange mutator.
+type VulnerabilityRangeFunc func(context.Context, *ent.VulnerabilityRangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VulnerabilityRangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VulnerabilityRangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VulnerabilityRangeMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,1199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/consequence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/consequence_impact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/consequence_scope"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/cvss"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/cwe"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/demonstrativeexample"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/detectionmethod"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/exploit"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/license"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pkgequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/potentialmitigation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/reachablecode"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/reachablecodeartifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/slsaattestation"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/sourcename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The ArtifactFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ArtifactFunc func(context.Context, *ent.ArtifactQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ArtifactFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ArtifactQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ArtifactQuery", q)
+}
+
+// The TraverseArtifact type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseArtifact func(context.Context, *ent.ArtifactQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseArtifact) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseArtifact) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArtifactQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ArtifactQuery", q)
+}
+
+// The BillOfMaterialsFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BillOfMaterialsFunc func(context.Context, *ent.BillOfMaterialsQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BillOfMaterialsFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BillOfMaterialsQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BillOfMaterialsQuery", q)
+}
+
+// The TraverseBillOfMaterials type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBillOfMaterials func(context.Context, *ent.BillOfMaterialsQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBillOfMaterials) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBillOfMaterials) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BillOfMaterialsQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BillOfMaterialsQuery", q)
+}
+
+// The BuilderFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BuilderFunc func(context.Context, *ent.BuilderQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BuilderFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BuilderQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BuilderQuery", q)
+}
+
+// The TraverseBuilder type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBuilder func(context.Context, *ent.BuilderQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBuilder) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBuilder) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BuilderQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BuilderQuery", q)
+}
+
+// The CVSSFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CVSSFunc func(context.Context, *ent.CVSSQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CVSSFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CVSSQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CVSSQuery", q)
+}
+
+// The TraverseCVSS type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCVSS func(context.Context, *ent.CVSSQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCVSS) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCVSS) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CVSSQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CVSSQuery", q)
+}
+
+// The CWEFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CWEFunc func(context.Context, *ent.CWEQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CWEFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CWEQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CWEQuery", q)
+}
+
+// The TraverseCWE type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCWE func(context.Context, *ent.CWEQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCWE) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCWE) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CWEQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CWEQuery", q)
+}
+
+// The CertificationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertificationFunc func(context.Context, *ent.CertificationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertificationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertificationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertificationQuery", q)
+}
+
+// The TraverseCertification type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertification func(context.Context, *ent.CertificationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertification) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertification) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertificationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertificationQuery", q)
+}
+
+// The CertifyLegalFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertifyLegalFunc func(context.Context, *ent.CertifyLegalQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertifyLegalFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertifyLegalQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertifyLegalQuery", q)
+}
+
+// The TraverseCertifyLegal type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertifyLegal func(context.Context, *ent.CertifyLegalQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertifyLegal) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertifyLegal) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertifyLegalQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertifyLegalQuery", q)
+}
+
+// The CertifyPolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertifyPolicyFunc func(context.Context, *ent.CertifyPolicyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertifyPolicyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertifyPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertifyPolicyQuery", q)
+}
+
+// The TraverseCertifyPolicy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertifyPolicy func(context.Context, *ent.CertifyPolicyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertifyPolicy) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertifyPolicy) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertifyPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertifyPolicyQuery", q)
+}
+
+// The CertifyScorecardFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertifyScorecardFunc func(context.Context, *ent.CertifyScorecardQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertifyScorecardFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertifyScorecardQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertifyScorecardQuery", q)
+}
+
+// The TraverseCertifyScorecard type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertifyScorecard func(context.Context, *ent.CertifyScorecardQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertifyScorecard) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertifyScorecard) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertifyScorecardQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertifyScorecardQuery", q)
+}
+
+// The CertifyVexFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertifyVexFunc func(context.Context, *ent.CertifyVexQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertifyVexFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertifyVexQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertifyVexQuery", q)
+}
+
+// The TraverseCertifyVex type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertifyVex func(context.Context, *ent.CertifyVexQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertifyVex) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertifyVex) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertifyVexQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertifyVexQuery", q)
+}
+
+// The CertifyVulnFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CertifyVulnFunc func(context.Context, *ent.CertifyVulnQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CertifyVulnFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CertifyVulnQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CertifyVulnQuery", q)
+}
+
+// The TraverseCertifyVuln type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCertifyVuln func(context.Context, *ent.CertifyVulnQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCertifyVuln) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCertifyVuln) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CertifyVulnQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CertifyVulnQuery", q)
+}
+
+// The ConsequenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ConsequenceFunc func(context.Context, *ent.ConsequenceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ConsequenceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ConsequenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ConsequenceQuery", q)
+}
+
+// The TraverseConsequence type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseConsequence func(context.Context, *ent.ConsequenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseConsequence) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseConsequence) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConsequenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ConsequenceQuery", q)
+}
+
+// The Consequence_ImpactFunc type is an adapter to allow the use of ordinary function as a Querier.
+type Consequence_ImpactFunc func(context.Context, *ent.ConsequenceImpactQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f Consequence_ImpactFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ConsequenceImpactQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ConsequenceImpactQuery", q)
+}
+
+// The TraverseConsequence_Impact type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseConsequence_Impact func(context.Context, *ent.ConsequenceImpactQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseConsequence_Impact) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseConsequence_Impact) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConsequenceImpactQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ConsequenceImpactQuery", q)
+}
+
+// The Consequence_ScopeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type Consequence_ScopeFunc func(context.Context, *ent.ConsequenceScopeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f Consequence_ScopeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ConsequenceScopeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ConsequenceScopeQuery", q)
+}
+
+// The TraverseConsequence_Scope type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseConsequence_Scope func(context.Context, *ent.ConsequenceScopeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseConsequence_Scope) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseConsequence_Scope) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConsequenceScopeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ConsequenceScopeQuery", q)
+}
+
+// The DemonstrativeExampleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DemonstrativeExampleFunc func(context.Context, *ent.DemonstrativeExampleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DemonstrativeExampleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DemonstrativeExampleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DemonstrativeExampleQuery", q)
+}
+
+// The TraverseDemonstrativeExample type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDemonstrativeExample func(context.Context, *ent.DemonstrativeExampleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDemonstrativeExample) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDemonstrativeExample) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DemonstrativeExampleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DemonstrativeExampleQuery", q)
+}
+
+// The DependencyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DependencyFunc func(context.Context, *ent.DependencyQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DependencyFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DependencyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DependencyQuery", q)
+}
+
+// The TraverseDependency type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDependency func(context.Context, *ent.DependencyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDependency) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDependency) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DependencyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DependencyQuery", q)
+}
+
+// The DetectionMethodFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DetectionMethodFunc func(context.Context, *ent.DetectionMethodQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DetectionMethodFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DetectionMethodQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DetectionMethodQuery", q)
+}
+
+// The TraverseDetectionMethod type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDetectionMethod func(context.Context, *ent.DetectionMethodQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDetectionMethod) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDetectionMethod) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DetectionMethodQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DetectionMethodQuery", q)
+}
+
+// The ExploitFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ExploitFunc func(context.Context, *ent.ExploitQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ExploitFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ExploitQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ExploitQuery", q)
+}
+
+// The TraverseExploit type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseExploit func(context.Context, *ent.ExploitQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseExploit) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseExploit) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ExploitQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ExploitQuery", q)
+}
+
+// The HasMetadataFunc type is an adapter to allow the use of ordinary function as a Querier.
+type HasMetadataFunc func(context.Context, *ent.HasMetadataQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f HasMetadataFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.HasMetadataQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.HasMetadataQuery", q)
+}
+
+// The TraverseHasMetadata type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseHasMetadata func(context.Context, *ent.HasMetadataQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseHasMetadata) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseHasMetadata) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.HasMetadataQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.HasMetadataQuery", q)
+}
+
+// The HasSourceAtFunc type is an adapter to allow the use of ordinary function as a Querier.
+type HasSourceAtFunc func(context.Context, *ent.HasSourceAtQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f HasSourceAtFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.HasSourceAtQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.HasSourceAtQuery", q)
+}
+
+// The TraverseHasSourceAt type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseHasSourceAt func(context.Context, *ent.HasSourceAtQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseHasSourceAt) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseHasSourceAt) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.HasSourceAtQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.HasSourceAtQuery", q)
+}
+
+// The HashEqualFunc type is an adapter to allow the use of ordinary function as a Querier.
+type HashEqualFunc func(context.Context, *ent.HashEqualQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f HashEqualFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.HashEqualQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.HashEqualQuery", q)
+}
+
+// The TraverseHashEqual type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseHashEqual func(context.Context, *ent.HashEqualQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseHashEqual) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseHashEqual) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.HashEqualQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.HashEqualQuery", q)
+}
+
+// The LicenseFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LicenseFunc func(context.Context, *ent.LicenseQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LicenseFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LicenseQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LicenseQuery", q)
+}
+
+// The TraverseLicense type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLicense func(context.Context, *ent.LicenseQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLicense) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLicense) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LicenseQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LicenseQuery", q)
+}
+
+// The OccurrenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OccurrenceFunc func(context.Context, *ent.OccurrenceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OccurrenceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OccurrenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OccurrenceQuery", q)
+}
+
+// The TraverseOccurrence type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOccurrence func(context.Context, *ent.OccurrenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOccurrence) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOccurrence) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OccurrenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OccurrenceQuery", q)
+}
+
+// The PackageNameFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PackageNameFunc func(context.Context, *ent.PackageNameQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PackageNameFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PackageNameQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PackageNameQuery", q)
+}
+
+// The TraversePackageName type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePackageName func(context.Context, *ent.PackageNameQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePackageName) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePackageName) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PackageNameQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PackageNameQuery", q)
+}
+
+// The PackageVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PackageVersionFunc func(context.Context, *ent.PackageVersionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PackageVersionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PackageVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PackageVersionQuery", q)
+}
+
+// The TraversePackageVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePackageVersion func(context.Context, *ent.PackageVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePackageVersion) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePackageVersion) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PackageVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PackageVersionQuery", q)
+}
+
+// The PkgEqualFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PkgEqualFunc func(context.Context, *ent.PkgEqualQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PkgEqualFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PkgEqualQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PkgEqualQuery", q)
+}
+
+// The TraversePkgEqual type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePkgEqual func(context.Context, *ent.PkgEqualQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePkgEqual) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePkgEqual) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PkgEqualQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PkgEqualQuery", q)
+}
+
+// The PointOfContactFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PointOfContactFunc func(context.Context, *ent.PointOfContactQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PointOfContactFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PointOfContactQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PointOfContactQuery", q)
+}
+
+// The TraversePointOfContact type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePointOfContact func(context.Context, *ent.PointOfContactQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePointOfContact) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePointOfContact) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PointOfContactQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PointOfContactQuery", q)
+}
+
+// The PotentialMitigationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PotentialMitigationFunc func(context.Context, *ent.PotentialMitigationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PotentialMitigationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PotentialMitigationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PotentialMitigationQuery", q)
+}
+
+// The TraversePotentialMitigation type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePotentialMitigation func(context.Context, *ent.PotentialMitigationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePotentialMitigation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePotentialMitigation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PotentialMitigationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PotentialMitigationQuery", q)
+}
+
+// The ReachableCodeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReachableCodeFunc func(context.Context, *ent.ReachableCodeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReachableCodeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReachableCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReachableCodeQuery", q)
+}
+
+// The TraverseReachableCode type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReachableCode func(context.Context, *ent.ReachableCodeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReachableCode) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReachableCode) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReachableCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReachableCodeQuery", q)
+}
+
+// The ReachableCodeArtifactFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReachableCodeArtifactFunc func(context.Context, *ent.ReachableCodeArtifactQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReachableCodeArtifactFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReachableCodeArtifactQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReachableCodeArtifactQuery", q)
+}
+
+// The TraverseReachableCodeArtifact type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReachableCodeArtifact func(context.Context, *ent.ReachableCodeArtifactQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReachableCodeArtifact) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReachableCodeArtifact) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReachableCodeArtifactQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReachableCodeArtifactQuery", q)
+}
+
+// The SLSAAttestationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SLSAAttestationFunc func(context.Context, *ent.SLSAAttestationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SLSAAttestationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SLSAAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SLSAAttestationQuery", q)
+}
+
+// The TraverseSLSAAttestation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSLSAAttestation func(context.Context, *ent.SLSAAttestationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSLSAAttestation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSLSAAttestation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SLSAAttestationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SLSAAttestationQuery", q)
+}
+
+// The SourceNameFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SourceNameFunc func(context.Context, *ent.SourceNameQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SourceNameFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SourceNameQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SourceNameQuery", q)
+}
+
+// The TraverseSourceName type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSourceName func(context.Context, *ent.SourceNameQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSourceName) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSourceName) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SourceNameQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SourceNameQuery", q)
+}
+
+// The VulnEqualFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VulnEqualFunc func(context.Context, *ent.VulnEqualQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VulnEqualFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VulnEqualQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VulnEqualQuery", q)
+}
+
+// The TraverseVulnEqual type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVulnEqual func(context.Context, *ent.VulnEqualQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVulnEqual) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVulnEqual) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VulnEqualQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VulnEqualQuery", q)
+}
+
+// The VulnerabilityIDFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VulnerabilityIDFunc func(context.Context, *ent.VulnerabilityIDQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VulnerabilityIDFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VulnerabilityIDQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VulnerabilityIDQuery", q)
+}
+
+// The TraverseVulnerabilityID type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVulnerabilityID func(context.Context, *ent.VulnerabilityIDQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVulnerabilityID) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVulnerabilityID) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VulnerabilityIDQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VulnerabilityIDQuery", q)
+}
+
+// The VulnerabilityMetadataFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VulnerabilityMetadataFunc func(context.Context, *ent.VulnerabilityMetadataQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VulnerabilityMetadataFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VulnerabilityMetadataQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VulnerabilityMetadataQuery", q)
+}
+
+// The TraverseVulnerabilityMetadata type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVulnerabilityMetadata func(context.Context, *ent.VulnerabilityMetadataQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVulnerabilityMetadata) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVulnerabilityMetadata) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VulnerabilityMetadataQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VulnerabilityMetadataQuery", q)
+}
+
+// The VulnerabilityRangeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VulnerabilityRangeFunc func(context.Context, *ent.VulnerabilityRangeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VulnerabilityRangeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VulnerabilityRangeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VulnerabilityRangeQuery", q)
+}
+
+// The TraverseVulnerabilityRange type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVulnerabilityRange func(context.Context, *ent.VulnerabilityRangeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVulnerabilityRange) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVulnerabilityRange) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VulnerabilityRangeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VulnerabilityRangeQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.ArtifactQuery:
+		return &query[*ent.ArtifactQuery, predicate.Artifact, artifact.OrderOption]{typ: ent.TypeArtifact, tq: q}, nil
+	case *ent.BillOfMaterialsQuery:
+		return &query[*ent.BillOfMaterialsQuery, predicate.BillOfMaterials, billofmaterials.OrderOption]{typ: ent.TypeBillOfMaterials, tq: q}, nil
+	case *ent.BuilderQuery:
+		return &query[*ent.BuilderQuery, predicate.Builder, builder.OrderOption]{typ: ent.TypeBuilder, tq: q}, nil
+	case *ent.CVSSQuery:
+		return &query[*ent.CVSSQuery, predicate.CVSS, cvss.OrderOption]{typ: ent.TypeCVSS, tq: q}, nil
+	case *ent.CWEQuery:
+		return &query[*ent.CWEQuery, predicate.CWE, cwe.OrderOption]{typ: ent.TypeCWE, tq: q}, nil
+	case *ent.CertificationQuery:
+		return &query[*ent.CertificationQuery, predicate.Certification, certification.OrderOption]{typ: ent.TypeCertification, tq: q}, nil
+	case *ent.CertifyLegalQuery:
+		return &query[*ent.CertifyLegalQuery, predicate.CertifyLegal, certifylegal.OrderOption]{typ: ent.TypeCertifyLegal, tq: q}, nil
+	case *ent.CertifyPolicyQuery:
+		return &query[*ent.CertifyPolicyQuery, predicate.CertifyPolicy, certifypolicy.OrderOption]{typ: ent.TypeCertifyPolicy, tq: q}, nil
+	case *ent.CertifyScorecardQuery:
+		return &query[*ent.CertifyScorecardQuery, predicate.CertifyScorecard, certifyscorecard.OrderOption]{typ: ent.TypeCertifyScorecard, tq: q}, nil
+	case *ent.CertifyVexQuery:
+		return &query[*ent.CertifyVexQuery, predicate.CertifyVex, certifyvex.OrderOption]{typ: ent.TypeCertifyVex, tq: q}, nil
+	case *ent.CertifyVulnQuery:
+		return &query[*ent.CertifyVulnQuery, predicate.CertifyVuln, certifyvuln.OrderOption]{typ: ent.TypeCertifyVuln, tq: q}, nil
+	case *ent.ConsequenceQuery:
+		return &query[*ent.ConsequenceQuery, predicate.Consequence, consequence.OrderOption]{typ: ent.TypeConsequence, tq: q}, nil
+	case *ent.ConsequenceImpactQuery:
+		return &query[*ent.ConsequenceImpactQuery, predicate.Consequence_Impact, consequence_impact.OrderOption]{typ: ent.TypeConsequenceImpact, tq: q}, nil
+	case *ent.ConsequenceScopeQuery:
+		return &query[*ent.ConsequenceScopeQuery, predicate.Consequence_Scope, consequence_scope.OrderOption]{typ: ent.TypeConsequenceScope, tq: q}, nil
+	case *ent.DemonstrativeExampleQuery:
+		return &query[*ent.DemonstrativeExampleQuery, predicate.DemonstrativeExample, demonstrativeexample.OrderOption]{typ: ent.TypeDemonstrativeExample, tq: q}, nil
+	case *ent.DependencyQuery:
+		return &query[*ent.DependencyQuery, predicate.Dependency, dependency.OrderOption]{typ: ent.TypeDependency, tq: q}, nil
+	case *ent.DetectionMethodQuery:
+		return &query[*ent.DetectionMethodQuery, predicate.DetectionMethod, detectionmethod.OrderOption]{typ: ent.TypeDetectionMethod, tq: q}, nil
+	case *ent.ExploitQuery:
+		return &query[*ent.ExploitQuery, predicate.Exploit, exploit.OrderOption]{typ: ent.TypeExploit, tq: q}, nil
+	case *ent.HasMetadataQuery:
+		return &query[*ent.HasMetadataQuery, predicate.HasMetadata, hasmetadata.OrderOption]{typ: ent.TypeHasMetadata, tq: q}, nil
+	case *ent.HasSourceAtQuery:
+		return &query[*ent.HasSourceAtQuery, predicate.HasSourceAt, hassourceat.OrderOption]{typ: ent.TypeHasSourceAt, tq: q}, nil
+	case *ent.HashEqualQuery:
+		return &query[*ent.HashEqualQuery, predicate.HashEqual, hashequal.OrderOption]{typ: ent.TypeHashEqual, tq: q}, nil
+	case *ent.LicenseQuery:
+		return &query[*ent.LicenseQuery, predicate.License, license.OrderOption]{typ: ent.TypeLicense, tq: q}, nil
+	case *ent.OccurrenceQuery:
+		return &query[*ent.OccurrenceQuery, predicate.Occurrence, occurrence.OrderOption]{typ: ent.TypeOccurrence, tq: q}, nil
+	case *ent.PackageNameQuery:
+		return &query[*ent.PackageNameQuery, predicate.PackageName, packagename.OrderOption]{typ: ent.TypePackageName, tq: q}, nil
+	case *ent.PackageVersionQuery:
+		return &query[*ent.PackageVersionQuery, predicate.PackageVersion, packageversion.OrderOption]{typ: ent.TypePackageVersion, tq: q}, nil
+	case *ent.PkgEqualQuery:
+		return &query[*ent.PkgEqualQuery, predicate.PkgEqual, pkgequal.OrderOption]{typ: ent.TypePkgEqual, tq: q}, nil
+	case *ent.PointOfContactQuery:
+		return &query[*ent.PointOfContactQuery, predicate.PointOfContact, pointofcontact.OrderOption]{typ: ent.TypePointOfContact, tq: q}, nil
+	case *ent.PotentialMitigationQuery:
+		return &query[*ent.PotentialMitigationQuery, predicate.PotentialMitigation, potentialmitigation.OrderOption]{typ: ent.TypePotentialMitigation, tq: q}, nil
+	case *ent.ReachableCodeQuery:
+		return &query[*ent.ReachableCodeQuery, predicate.ReachableCode, reachablecode.OrderOption]{typ: ent.TypeReachableCode, tq: q}, nil
+	case *ent.ReachableCodeArtifactQuery:
+		return &query[*ent.ReachableCodeArtifactQuery, predicate.ReachableCodeArtifact, reachablecodeartifact.OrderOption]{typ: ent.TypeReachableCodeArtifact, tq: q}, nil
+	case *ent.SLSAAttestationQuery:
+		return &query[*ent.SLSAAttestationQuery, predicate.SLSAAttestation, slsaattestation.OrderOption]{typ: ent.TypeSLSAAttestation, tq: q}, nil
+	case *ent.SourceNameQuery:
+		return &query[*ent.SourceNameQuery, predicate.SourceName, sourcename.OrderOption]{typ: ent.TypeSourceName, tq: q}, nil
+	case *ent.VulnEqualQuery:
+		return &query[*ent.VulnEqualQuery, predicate.VulnEqual, vulnequal.OrderOption]{typ: ent.TypeVulnEqual, tq: q}, nil
+	case *ent.VulnerabilityIDQuery:
+		return &query[*ent.VulnerabilityIDQuery, predicate.VulnerabilityID, vulnerabilityid.OrderOption]{typ: ent.TypeVulnerabilityID, tq: q}, nil
+	case *ent.VulnerabilityMetadataQuery:
+		return &query[*ent.VulnerabilityMetadataQuery, predicate.VulnerabilityMetadata, vulnerabilitymetadata.OrderOption]{typ: ent.TypeVulnerabilityMetadata, tq: q}, nil
+	case *ent.VulnerabilityRangeQuery:
+		return &query[*ent.VulnerabilityRangeQuery, predicate.VulnerabilityRange, vulnerabilityrange.OrderOption]{typ: ent.TypeVulnerabilityRange, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
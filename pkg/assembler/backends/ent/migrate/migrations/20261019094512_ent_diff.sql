@@ -0,0 +1,168 @@
+-- Drop index "sbom_package_id" from table: "bill_of_materials"
+DROP INDEX "sbom_package_id";
+-- Drop index "sbom_artifact_id" from table: "bill_of_materials"
+DROP INDEX "sbom_artifact_id";
+-- Modify "bill_of_materials" table
+ALTER TABLE "bill_of_materials" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "billofmaterials_tenant" to table: "bill_of_materials"
+CREATE INDEX "billofmaterials_tenant" ON "bill_of_materials" ("tenant");
+-- Create index "sbom_package_id" to table: "bill_of_materials"
+CREATE UNIQUE INDEX "sbom_package_id" ON "bill_of_materials" ("tenant", "algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash", "included_artifacts_hash", "included_dependencies_hash", "included_occurrences_hash", "origin", "collector", "document_ref", "package_id") WHERE (package_id IS NOT NULL AND artifact_id IS NULL);
+-- Create index "sbom_artifact_id" to table: "bill_of_materials"
+CREATE UNIQUE INDEX "sbom_artifact_id" ON "bill_of_materials" ("tenant", "algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash", "included_artifacts_hash", "included_dependencies_hash", "included_occurrences_hash", "origin", "collector", "document_ref", "artifact_id") WHERE (package_id IS NULL AND artifact_id IS NOT NULL);
+-- Drop index "certification_type_justification_origin_collector_source_id_kno" from table: "certifications"
+DROP INDEX "certification_type_justification_origin_collector_source_id_kno";
+-- Drop index "certification_type_justification_origin_collector_package_versi" from table: "certifications"
+DROP INDEX "certification_type_justification_origin_collector_package_versi";
+-- Drop index "certification_type_justification_origin_collector_package_name_" from table: "certifications"
+DROP INDEX "certification_type_justification_origin_collector_package_name_";
+-- Drop index "certification_type_justification_origin_collector_artifact_id_k" from table: "certifications"
+DROP INDEX "certification_type_justification_origin_collector_artifact_id_k";
+-- Modify "certifications" table
+ALTER TABLE "certifications" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "certification_tenant" to table: "certifications"
+CREATE INDEX "certification_tenant" ON "certifications" ("tenant");
+-- Create index "certification_tenant_source_id" to table: "certifications"
+CREATE UNIQUE INDEX "certification_tenant_source_id" ON "certifications" ("tenant", "type", "justification", "origin", "collector", "source_id", "known_since", "document_ref") WHERE (source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL);
+-- Create index "certification_tenant_package_version_id" to table: "certifications"
+CREATE UNIQUE INDEX "certification_tenant_package_version_id" ON "certifications" ("tenant", "type", "justification", "origin", "collector", "package_version_id", "known_since", "document_ref") WHERE (source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL);
+-- Create index "certification_tenant_package_name_id" to table: "certifications"
+CREATE UNIQUE INDEX "certification_tenant_package_name_id" ON "certifications" ("tenant", "type", "justification", "origin", "collector", "package_name_id", "known_since", "document_ref") WHERE (source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL);
+-- Create index "certification_tenant_artifact_id" to table: "certifications"
+CREATE UNIQUE INDEX "certification_tenant_artifact_id" ON "certifications" ("tenant", "type", "justification", "origin", "collector", "artifact_id", "known_since", "document_ref") WHERE (source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL);
+-- Drop index "certifylegal_source_id_declared_license_justification_time_scan" from table: "certify_legals"
+DROP INDEX "certifylegal_source_id_declared_license_justification_time_scan";
+-- Drop index "certifylegal_package_id_declared_license_justification_time_sca" from table: "certify_legals"
+DROP INDEX "certifylegal_package_id_declared_license_justification_time_sca";
+-- Modify "certify_legals" table
+ALTER TABLE "certify_legals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "certifylegal_tenant" to table: "certify_legals"
+CREATE INDEX "certifylegal_tenant" ON "certify_legals" ("tenant");
+-- Create index "certifylegal_tenant_source_id" to table: "certify_legals"
+CREATE UNIQUE INDEX "certifylegal_tenant_source_id" ON "certify_legals" ("tenant", "source_id", "declared_license", "justification", "time_scanned", "origin", "collector", "document_ref", "declared_licenses_hash", "discovered_licenses_hash") WHERE (package_id IS NULL AND source_id IS NOT NULL);
+-- Create index "certifylegal_tenant_package_id" to table: "certify_legals"
+CREATE UNIQUE INDEX "certifylegal_tenant_package_id" ON "certify_legals" ("tenant", "package_id", "declared_license", "justification", "time_scanned", "origin", "collector", "document_ref", "declared_licenses_hash", "discovered_licenses_hash") WHERE (package_id IS NOT NULL AND source_id IS NULL);
+-- Drop index "certifyscorecard_source_id_origin_collector_scorecard_version_s" from table: "certify_scorecards"
+DROP INDEX "certifyscorecard_source_id_origin_collector_scorecard_version_s";
+-- Modify "certify_scorecards" table
+ALTER TABLE "certify_scorecards" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "certifyscorecard_tenant" to table: "certify_scorecards"
+CREATE INDEX "certifyscorecard_tenant" ON "certify_scorecards" ("tenant");
+-- Create index "certifyscorecard_tenant_source_id" to table: "certify_scorecards"
+CREATE UNIQUE INDEX "certifyscorecard_tenant_source_id" ON "certify_scorecards" ("tenant", "source_id", "origin", "collector", "scorecard_version", "scorecard_commit", "aggregate_score", "time_scanned", "checks_hash", "document_ref");
+-- Drop index "vex_artifact_id" from table: "certify_vexes"
+DROP INDEX "vex_artifact_id";
+-- Drop index "vex_package_id" from table: "certify_vexes"
+DROP INDEX "vex_package_id";
+-- Modify "certify_vexes" table
+ALTER TABLE "certify_vexes" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "certifyvex_tenant" to table: "certify_vexes"
+CREATE INDEX "certifyvex_tenant" ON "certify_vexes" ("tenant");
+-- Create index "vex_artifact_id" to table: "certify_vexes"
+CREATE UNIQUE INDEX "vex_artifact_id" ON "certify_vexes" ("tenant", "known_since", "justification", "status", "origin", "collector", "document_ref", "vulnerability_id", "package_id") WHERE (artifact_id IS NULL);
+-- Create index "vex_package_id" to table: "certify_vexes"
+CREATE UNIQUE INDEX "vex_package_id" ON "certify_vexes" ("tenant", "known_since", "justification", "status", "origin", "collector", "document_ref", "vulnerability_id", "artifact_id") WHERE (package_id IS NULL);
+-- Drop index "certifyvuln_db_uri_db_version_scanner_uri_scanner_version_origi" from table: "certify_vulns"
+DROP INDEX "certifyvuln_db_uri_db_version_scanner_uri_scanner_version_origi";
+-- Modify "certify_vulns" table
+ALTER TABLE "certify_vulns" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "certifyvuln_tenant" to table: "certify_vulns"
+CREATE INDEX "certifyvuln_tenant" ON "certify_vulns" ("tenant");
+-- Create index "certifyvuln_tenant_package_id_vulnerability_id" to table: "certify_vulns"
+CREATE UNIQUE INDEX "certifyvuln_tenant_package_id_vulnerability_id" ON "certify_vulns" ("tenant", "db_uri", "db_version", "scanner_uri", "scanner_version", "origin", "collector", "time_scanned", "document_ref", "vulnerability_id", "package_id");
+-- Drop index "dependency_dependency_type_justification_origin_collector_docum" from table: "dependencies"
+DROP INDEX "dependency_dependency_type_justification_origin_collector_docum";
+-- Modify "dependencies" table
+ALTER TABLE "dependencies" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "dependency_tenant" to table: "dependencies"
+CREATE INDEX "dependency_tenant" ON "dependencies" ("tenant");
+-- Create index "dependency_tenant_package_id_dependent_package_version_id" to table: "dependencies"
+CREATE UNIQUE INDEX "dependency_tenant_package_id_dependent_package_version_id" ON "dependencies" ("tenant", "dependency_type", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id");
+-- Drop index "has_metadata_source_id" from table: "has_metadata"
+DROP INDEX "has_metadata_source_id";
+-- Drop index "has_metadata_package_version_id" from table: "has_metadata"
+DROP INDEX "has_metadata_package_version_id";
+-- Drop index "has_metadata_package_name_id" from table: "has_metadata"
+DROP INDEX "has_metadata_package_name_id";
+-- Drop index "has_metadata_artifact_id" from table: "has_metadata"
+DROP INDEX "has_metadata_artifact_id";
+-- Modify "has_metadata" table
+ALTER TABLE "has_metadata" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "hasmetadata_tenant" to table: "has_metadata"
+CREATE INDEX "hasmetadata_tenant" ON "has_metadata" ("tenant");
+-- Create index "has_metadata_source_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_source_id" ON "has_metadata" ("tenant", "key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "source_id") WHERE (source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL);
+-- Create index "has_metadata_package_version_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_package_version_id" ON "has_metadata" ("tenant", "key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_version_id") WHERE (source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL);
+-- Create index "has_metadata_package_name_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_package_name_id" ON "has_metadata" ("tenant", "key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "package_name_id") WHERE (source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL);
+-- Create index "has_metadata_artifact_id" to table: "has_metadata"
+CREATE UNIQUE INDEX "has_metadata_artifact_id" ON "has_metadata" ("tenant", "key", "value", "justification", "origin", "collector", "timestamp", "document_ref", "artifact_id") WHERE (source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL);
+-- Drop index "hassourceat_source_id_package_version_id_justification_origin_c" from table: "has_source_ats"
+DROP INDEX "hassourceat_source_id_package_version_id_justification_origin_c";
+-- Drop index "hassourceat_source_id_package_name_id_justification_origin_coll" from table: "has_source_ats"
+DROP INDEX "hassourceat_source_id_package_name_id_justification_origin_coll";
+-- Modify "has_source_ats" table
+ALTER TABLE "has_source_ats" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "hassourceat_tenant" to table: "has_source_ats"
+CREATE INDEX "hassourceat_tenant" ON "has_source_ats" ("tenant");
+-- Create index "hassourceat_tenant_package_version_id" to table: "has_source_ats"
+CREATE UNIQUE INDEX "hassourceat_tenant_package_version_id" ON "has_source_ats" ("tenant", "source_id", "package_version_id", "justification", "origin", "collector", "known_since", "document_ref") WHERE (package_version_id IS NOT NULL AND package_name_id IS NULL);
+-- Create index "hassourceat_tenant_package_name_id" to table: "has_source_ats"
+CREATE UNIQUE INDEX "hassourceat_tenant_package_name_id" ON "has_source_ats" ("tenant", "source_id", "package_name_id", "justification", "origin", "collector", "known_since", "document_ref") WHERE (package_name_id IS NOT NULL AND package_version_id IS NULL);
+-- Drop index "hashequal_art_id_equal_art_id_artifacts_hash_origin_justificati" from table: "hash_equals"
+DROP INDEX "hashequal_art_id_equal_art_id_artifacts_hash_origin_justificati";
+-- Modify "hash_equals" table
+ALTER TABLE "hash_equals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "hashequal_tenant" to table: "hash_equals"
+CREATE INDEX "hashequal_tenant" ON "hash_equals" ("tenant");
+-- Create index "hashequal_tenant_art_id_equal_art_id" to table: "hash_equals"
+CREATE UNIQUE INDEX "hashequal_tenant_art_id_equal_art_id" ON "hash_equals" ("tenant", "art_id", "equal_art_id", "artifacts_hash", "origin", "justification", "collector", "document_ref");
+-- Drop index "occurrence_package_id" from table: "occurrences"
+DROP INDEX "occurrence_package_id";
+-- Drop index "occurrence_source_id" from table: "occurrences"
+DROP INDEX "occurrence_source_id";
+-- Modify "occurrences" table
+ALTER TABLE "occurrences" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "occurrence_tenant" to table: "occurrences"
+CREATE INDEX "occurrence_tenant" ON "occurrences" ("tenant");
+-- Create index "occurrence_package_id" to table: "occurrences"
+CREATE UNIQUE INDEX "occurrence_package_id" ON "occurrences" ("tenant", "justification", "origin", "collector", "document_ref", "artifact_id", "package_id") WHERE (package_id IS NOT NULL AND source_id IS NULL);
+-- Create index "occurrence_source_id" to table: "occurrences"
+CREATE UNIQUE INDEX "occurrence_source_id" ON "occurrences" ("tenant", "justification", "origin", "collector", "document_ref", "artifact_id", "source_id") WHERE (package_id IS NULL AND source_id IS NOT NULL);
+-- Drop index "pkgequal_pkg_id_equal_pkg_id_packages_hash_origin_justification" from table: "pkg_equals"
+DROP INDEX "pkgequal_pkg_id_equal_pkg_id_packages_hash_origin_justification";
+-- Modify "pkg_equals" table
+ALTER TABLE "pkg_equals" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "pkgequal_tenant" to table: "pkg_equals"
+CREATE INDEX "pkgequal_tenant" ON "pkg_equals" ("tenant");
+-- Create index "pkgequal_tenant_pkg_id_equal_pkg_id" to table: "pkg_equals"
+CREATE UNIQUE INDEX "pkgequal_tenant_pkg_id_equal_pkg_id" ON "pkg_equals" ("tenant", "pkg_id", "equal_pkg_id", "packages_hash", "origin", "justification", "collector", "document_ref");
+-- Drop index "poc_source_id" from table: "point_of_contacts"
+DROP INDEX "poc_source_id";
+-- Drop index "poc_package_version_id" from table: "point_of_contacts"
+DROP INDEX "poc_package_version_id";
+-- Drop index "poc_package_name_id" from table: "point_of_contacts"
+DROP INDEX "poc_package_name_id";
+-- Drop index "poc_artifact_id" from table: "point_of_contacts"
+DROP INDEX "poc_artifact_id";
+-- Modify "point_of_contacts" table
+ALTER TABLE "point_of_contacts" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "pointofcontact_tenant" to table: "point_of_contacts"
+CREATE INDEX "pointofcontact_tenant" ON "point_of_contacts" ("tenant");
+-- Create index "poc_source_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_source_id" ON "point_of_contacts" ("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "source_id") WHERE (source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL);
+-- Create index "poc_package_version_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_package_version_id" ON "point_of_contacts" ("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "package_version_id") WHERE (source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL);
+-- Create index "poc_package_name_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_package_name_id" ON "point_of_contacts" ("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "package_name_id") WHERE (source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL);
+-- Create index "poc_artifact_id" to table: "point_of_contacts"
+CREATE UNIQUE INDEX "poc_artifact_id" ON "point_of_contacts" ("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "artifact_id") WHERE (source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL);
+-- Drop index "slsaattestation_subject_id_origin_collector_document_ref_build_" from table: "slsa_attestations"
+DROP INDEX "slsaattestation_subject_id_origin_collector_document_ref_build_";
+-- Modify "slsa_attestations" table
+ALTER TABLE "slsa_attestations" ADD COLUMN "tenant" character varying NOT NULL DEFAULT '';
+-- Create index "slsaattestation_tenant" to table: "slsa_attestations"
+CREATE INDEX "slsaattestation_tenant" ON "slsa_attestations" ("tenant");
+-- Create index "slsaattestation_tenant_subject_id" to table: "slsa_attestations"
+CREATE UNIQUE INDEX "slsaattestation_tenant_subject_id" ON "slsa_attestations" ("tenant", "subject_id", "origin", "collector", "document_ref", "build_type", "slsa_version", "built_by_id", "built_from_hash", "started_on", "finished_on");
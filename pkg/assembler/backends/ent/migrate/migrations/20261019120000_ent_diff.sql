@@ -0,0 +1,6 @@
+-- Create "certify_policies" table
+CREATE TABLE "certify_policies" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT '', "verifier" character varying NOT NULL, "policy_uri" character varying NOT NULL, "policy_digest" character varying NOT NULL, "result" character varying NOT NULL, "verified_levels" jsonb NULL, "time_verified" timestamptz NOT NULL, "origin" character varying NOT NULL, "collector" character varying NOT NULL, "document_ref" character varying NOT NULL, "artifact_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "certify_policies_artifacts_artifact" FOREIGN KEY ("artifact_id") REFERENCES "artifacts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "certifypolicy_tenant" to table: "certify_policies"
+CREATE INDEX "certifypolicy_tenant" ON "certify_policies" ("tenant");
+-- Create index "certifypolicy_tenant_artifact_id" to table: "certify_policies"
+CREATE UNIQUE INDEX "certifypolicy_tenant_artifact_id" ON "certify_policies" ("tenant", "artifact_id", "verifier", "policy_uri", "policy_digest", "result", "time_verified", "origin", "collector", "document_ref");
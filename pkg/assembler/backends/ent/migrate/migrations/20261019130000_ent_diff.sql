@@ -0,0 +1,6 @@
+-- Create "vulnerability_ranges" table
+CREATE TABLE "vulnerability_ranges" ("id" uuid NOT NULL, "tenant" character varying NOT NULL DEFAULT '', "range_type" character varying NOT NULL, "introduced" character varying NOT NULL, "fixed" character varying NOT NULL, "last_affected" character varying NOT NULL, "origin" character varying NOT NULL, "collector" character varying NOT NULL, "document_ref" character varying NOT NULL, "vulnerability_id" uuid NOT NULL, "package_name_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "vulnerability_ranges_package_names_package" FOREIGN KEY ("package_name_id") REFERENCES "package_names" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "vulnerability_ranges_vulnerability_ids_vulnerability" FOREIGN KEY ("vulnerability_id") REFERENCES "vulnerability_ids" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "vulnerabilityrange_tenant" to table: "vulnerability_ranges"
+CREATE INDEX "vulnerabilityrange_tenant" ON "vulnerability_ranges" ("tenant");
+-- Create index "vulnerabilityrange_tenant_vulnerability_id_package_name_id" to table: "vulnerability_ranges"
+CREATE UNIQUE INDEX "vulnerabilityrange_tenant_vulnerability_id_package_name_id" ON "vulnerability_ranges" ("tenant", "vulnerability_id", "package_name_id", "range_type", "introduced", "fixed", "last_affected", "origin", "collector", "document_ref");
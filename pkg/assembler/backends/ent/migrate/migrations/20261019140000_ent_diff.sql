@@ -0,0 +1,6 @@
+-- Drop index "dependency_tenant_package_id_dependent_package_version_id" from table: "dependencies"
+DROP INDEX "dependency_tenant_package_id_dependent_package_version_id";
+-- Modify "dependencies" table
+ALTER TABLE "dependencies" ADD COLUMN "version_range" character varying NOT NULL DEFAULT '';
+-- Create index "dependency_tenant_package_id_dependent_package_version_id" to table: "dependencies"
+CREATE UNIQUE INDEX "dependency_tenant_package_id_dependent_package_version_id" ON "dependencies" ("tenant", "dependency_type", "version_range", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id");
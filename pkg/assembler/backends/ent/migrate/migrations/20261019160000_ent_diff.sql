@@ -0,0 +1,64 @@
+-- Create index "certification_package_version_id" to table: "certifications"
+CREATE INDEX "certification_package_version_id" ON "certifications" ("package_version_id");
+-- Create index "certification_package_name_id" to table: "certifications"
+CREATE INDEX "certification_package_name_id" ON "certifications" ("package_name_id");
+-- Create index "certification_source_id" to table: "certifications"
+CREATE INDEX "certification_source_id" ON "certifications" ("source_id");
+-- Create index "certification_artifact_id" to table: "certifications"
+CREATE INDEX "certification_artifact_id" ON "certifications" ("artifact_id");
+-- Create index "certifylegal_source_id" to table: "certify_legals"
+CREATE INDEX "certifylegal_source_id" ON "certify_legals" ("source_id");
+-- Create index "certifypolicy_artifact_id" to table: "certify_policies"
+CREATE INDEX "certifypolicy_artifact_id" ON "certify_policies" ("artifact_id");
+-- Create index "certifyscorecard_source_id" to table: "certify_scorecards"
+CREATE INDEX "certifyscorecard_source_id" ON "certify_scorecards" ("source_id");
+-- Create index "certifyvex_package_id" to table: "certify_vexes"
+CREATE INDEX "certifyvex_package_id" ON "certify_vexes" ("package_id");
+-- Create index "certifyvex_artifact_id" to table: "certify_vexes"
+CREATE INDEX "certifyvex_artifact_id" ON "certify_vexes" ("artifact_id");
+-- Create index "certifyvex_vulnerability_id" to table: "certify_vexes"
+CREATE INDEX "certifyvex_vulnerability_id" ON "certify_vexes" ("vulnerability_id");
+-- Create index "hasmetadata_package_version_id" to table: "has_metadata"
+CREATE INDEX "hasmetadata_package_version_id" ON "has_metadata" ("package_version_id");
+-- Create index "hasmetadata_package_name_id" to table: "has_metadata"
+CREATE INDEX "hasmetadata_package_name_id" ON "has_metadata" ("package_name_id");
+-- Create index "hasmetadata_source_id" to table: "has_metadata"
+CREATE INDEX "hasmetadata_source_id" ON "has_metadata" ("source_id");
+-- Create index "hasmetadata_artifact_id" to table: "has_metadata"
+CREATE INDEX "hasmetadata_artifact_id" ON "has_metadata" ("artifact_id");
+-- Create index "hassourceat_package_version_id" to table: "has_source_ats"
+CREATE INDEX "hassourceat_package_version_id" ON "has_source_ats" ("package_version_id");
+-- Create index "hassourceat_package_name_id" to table: "has_source_ats"
+CREATE INDEX "hassourceat_package_name_id" ON "has_source_ats" ("package_name_id");
+-- Create index "hassourceat_source_id" to table: "has_source_ats"
+CREATE INDEX "hassourceat_source_id" ON "has_source_ats" ("source_id");
+-- Create index "hashequal_art_id" to table: "hash_equals"
+CREATE INDEX "hashequal_art_id" ON "hash_equals" ("art_id");
+-- Create index "hashequal_equal_art_id" to table: "hash_equals"
+CREATE INDEX "hashequal_equal_art_id" ON "hash_equals" ("equal_art_id");
+-- Create index "query_occurrence_source_id" to table: "occurrences"
+CREATE INDEX "query_occurrence_source_id" ON "occurrences" ("source_id");
+-- Create index "packageversion_name_id" to table: "package_versions"
+CREATE INDEX "packageversion_name_id" ON "package_versions" ("name_id");
+-- Create index "pkgequal_pkg_id" to table: "pkg_equals"
+CREATE INDEX "pkgequal_pkg_id" ON "pkg_equals" ("pkg_id");
+-- Create index "pkgequal_equal_pkg_id" to table: "pkg_equals"
+CREATE INDEX "pkgequal_equal_pkg_id" ON "pkg_equals" ("equal_pkg_id");
+-- Create index "pointofcontact_package_version_id" to table: "point_of_contacts"
+CREATE INDEX "pointofcontact_package_version_id" ON "point_of_contacts" ("package_version_id");
+-- Create index "pointofcontact_package_name_id" to table: "point_of_contacts"
+CREATE INDEX "pointofcontact_package_name_id" ON "point_of_contacts" ("package_name_id");
+-- Create index "pointofcontact_source_id" to table: "point_of_contacts"
+CREATE INDEX "pointofcontact_source_id" ON "point_of_contacts" ("source_id");
+-- Create index "pointofcontact_artifact_id" to table: "point_of_contacts"
+CREATE INDEX "pointofcontact_artifact_id" ON "point_of_contacts" ("artifact_id");
+-- Create index "slsaattestation_subject_id" to table: "slsa_attestations"
+CREATE INDEX "slsaattestation_subject_id" ON "slsa_attestations" ("subject_id");
+-- Create index "slsaattestation_built_by_id" to table: "slsa_attestations"
+CREATE INDEX "slsaattestation_built_by_id" ON "slsa_attestations" ("built_by_id");
+-- Create index "vulnequal_equal_vuln_id" to table: "vuln_equals"
+CREATE INDEX "vulnequal_equal_vuln_id" ON "vuln_equals" ("equal_vuln_id");
+-- Create index "vulnerabilityrange_vulnerability_id" to table: "vulnerability_ranges"
+CREATE INDEX "vulnerabilityrange_vulnerability_id" ON "vulnerability_ranges" ("vulnerability_id");
+-- Create index "vulnerabilityrange_package_name_id" to table: "vulnerability_ranges"
+CREATE INDEX "vulnerabilityrange_package_name_id" ON "vulnerability_ranges" ("package_name_id");
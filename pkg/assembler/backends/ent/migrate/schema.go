@@ -31,6 +31,7 @@ var (
 	// BillOfMaterialsColumns holds the columns for the "bill_of_materials" table.
 	BillOfMaterialsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "uri", Type: field.TypeString},
 		{Name: "algorithm", Type: field.TypeString},
 		{Name: "digest", Type: field.TypeString},
@@ -54,22 +55,27 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bill_of_materials_package_versions_package",
-				Columns:    []*schema.Column{BillOfMaterialsColumns[13]},
+				Columns:    []*schema.Column{BillOfMaterialsColumns[14]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "bill_of_materials_artifacts_artifact",
-				Columns:    []*schema.Column{BillOfMaterialsColumns[14]},
+				Columns:    []*schema.Column{BillOfMaterialsColumns[15]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "billofmaterials_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{BillOfMaterialsColumns[1]},
+			},
 			{
 				Name:    "sbom_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[8], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[5], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[13]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[1], BillOfMaterialsColumns[3], BillOfMaterialsColumns[4], BillOfMaterialsColumns[2], BillOfMaterialsColumns[5], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[13], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[8], BillOfMaterialsColumns[14]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -77,7 +83,7 @@ var (
 			{
 				Name:    "sbom_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{BillOfMaterialsColumns[2], BillOfMaterialsColumns[3], BillOfMaterialsColumns[1], BillOfMaterialsColumns[4], BillOfMaterialsColumns[8], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[5], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[14]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[1], BillOfMaterialsColumns[3], BillOfMaterialsColumns[4], BillOfMaterialsColumns[2], BillOfMaterialsColumns[5], BillOfMaterialsColumns[9], BillOfMaterialsColumns[10], BillOfMaterialsColumns[11], BillOfMaterialsColumns[12], BillOfMaterialsColumns[13], BillOfMaterialsColumns[6], BillOfMaterialsColumns[7], BillOfMaterialsColumns[8], BillOfMaterialsColumns[15]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -85,7 +91,7 @@ var (
 			{
 				Name:    "billofmaterials_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{BillOfMaterialsColumns[13]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[14]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -93,7 +99,7 @@ var (
 			{
 				Name:    "billofmaterials_artifact_id",
 				Unique:  false,
-				Columns: []*schema.Column{BillOfMaterialsColumns[14]},
+				Columns: []*schema.Column{BillOfMaterialsColumns[15]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND artifact_id IS NOT NULL",
 				},
@@ -148,6 +154,7 @@ var (
 	// CertificationsColumns holds the columns for the "certifications" table.
 	CertificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"GOOD", "BAD"}, Default: "GOOD"},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "known_since", Type: field.TypeTime},
@@ -167,67 +174,93 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certifications_source_names_source",
-				Columns:    []*schema.Column{CertificationsColumns[7]},
+				Columns:    []*schema.Column{CertificationsColumns[8]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_package_versions_package_version",
-				Columns:    []*schema.Column{CertificationsColumns[8]},
+				Columns:    []*schema.Column{CertificationsColumns[9]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_package_names_all_versions",
-				Columns:    []*schema.Column{CertificationsColumns[9]},
+				Columns:    []*schema.Column{CertificationsColumns[10]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certifications_artifacts_artifact",
-				Columns:    []*schema.Column{CertificationsColumns[10]},
+				Columns:    []*schema.Column{CertificationsColumns[11]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certification_type_justification_origin_collector_source_id_known_since_document_ref",
+				Name:    "certification_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertificationsColumns[1]},
+			},
+			{
+				Name:    "certification_tenant_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[7], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[8], CertificationsColumns[4], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
 			},
 			{
-				Name:    "certification_type_justification_origin_collector_package_version_id_known_since_document_ref",
+				Name:    "certification_tenant_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[8], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[9], CertificationsColumns[4], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
 			},
 			{
-				Name:    "certification_type_justification_origin_collector_package_name_id_known_since_document_ref",
+				Name:    "certification_tenant_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[9], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[10], CertificationsColumns[4], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
 			},
 			{
-				Name:    "certification_type_justification_origin_collector_artifact_id_known_since_document_ref",
+				Name:    "certification_tenant_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[4], CertificationsColumns[5], CertificationsColumns[10], CertificationsColumns[3], CertificationsColumns[6]},
+				Columns: []*schema.Column{CertificationsColumns[1], CertificationsColumns[2], CertificationsColumns[3], CertificationsColumns[5], CertificationsColumns[6], CertificationsColumns[11], CertificationsColumns[4], CertificationsColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
 			},
+			{
+				Name:    "certification_package_version_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificationsColumns[9]},
+			},
+			{
+				Name:    "certification_package_name_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificationsColumns[10]},
+			},
+			{
+				Name:    "certification_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificationsColumns[8]},
+			},
+			{
+				Name:    "certification_artifact_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertificationsColumns[11]},
+			},
 		},
 	}
 	// CertifyLegalsColumns holds the columns for the "certify_legals" table.
 	CertifyLegalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "declared_license", Type: field.TypeString},
 		{Name: "discovered_license", Type: field.TypeString},
 		{Name: "attribution", Type: field.TypeString},
@@ -249,30 +282,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_legals_package_versions_package",
-				Columns:    []*schema.Column{CertifyLegalsColumns[11]},
+				Columns:    []*schema.Column{CertifyLegalsColumns[12]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_legals_source_names_source",
-				Columns:    []*schema.Column{CertifyLegalsColumns[12]},
+				Columns:    []*schema.Column{CertifyLegalsColumns[13]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certifylegal_source_id_declared_license_justification_time_scanned_origin_collector_document_ref_declared_licenses_hash_discovered_licenses_hash",
+				Name:    "certifylegal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyLegalsColumns[1]},
+			},
+			{
+				Name:    "certifylegal_tenant_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyLegalsColumns[12], CertifyLegalsColumns[1], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[9], CertifyLegalsColumns[10]},
+				Columns: []*schema.Column{CertifyLegalsColumns[1], CertifyLegalsColumns[13], CertifyLegalsColumns[2], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[9], CertifyLegalsColumns[10], CertifyLegalsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND source_id IS NOT NULL",
 				},
 			},
 			{
-				Name:    "certifylegal_package_id_declared_license_justification_time_scanned_origin_collector_document_ref_declared_licenses_hash_discovered_licenses_hash",
+				Name:    "certifylegal_tenant_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyLegalsColumns[11], CertifyLegalsColumns[1], CertifyLegalsColumns[4], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[9], CertifyLegalsColumns[10]},
+				Columns: []*schema.Column{CertifyLegalsColumns[1], CertifyLegalsColumns[12], CertifyLegalsColumns[2], CertifyLegalsColumns[5], CertifyLegalsColumns[6], CertifyLegalsColumns[7], CertifyLegalsColumns[8], CertifyLegalsColumns[9], CertifyLegalsColumns[10], CertifyLegalsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -280,7 +318,7 @@ var (
 			{
 				Name:    "certifylegal_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyLegalsColumns[11]},
+				Columns: []*schema.Column{CertifyLegalsColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -288,16 +326,68 @@ var (
 			{
 				Name:    "certifylegal_package_id_declared_licenses_hash_discovered_licenses_hash_time_scanned",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyLegalsColumns[11], CertifyLegalsColumns[9], CertifyLegalsColumns[10], CertifyLegalsColumns[5]},
+				Columns: []*schema.Column{CertifyLegalsColumns[12], CertifyLegalsColumns[10], CertifyLegalsColumns[11], CertifyLegalsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
 			},
+			{
+				Name:    "certifylegal_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyLegalsColumns[13]},
+			},
+		},
+	}
+	// CertifyPoliciesColumns holds the columns for the "certify_policies" table.
+	CertifyPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
+		{Name: "verifier", Type: field.TypeString},
+		{Name: "policy_uri", Type: field.TypeString},
+		{Name: "policy_digest", Type: field.TypeString},
+		{Name: "result", Type: field.TypeEnum, Enums: []string{"PASSED", "FAILED"}},
+		{Name: "verified_levels", Type: field.TypeJSON, Nullable: true},
+		{Name: "time_verified", Type: field.TypeTime},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "document_ref", Type: field.TypeString},
+		{Name: "artifact_id", Type: field.TypeUUID},
+	}
+	// CertifyPoliciesTable holds the schema information for the "certify_policies" table.
+	CertifyPoliciesTable = &schema.Table{
+		Name:       "certify_policies",
+		Columns:    CertifyPoliciesColumns,
+		PrimaryKey: []*schema.Column{CertifyPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "certify_policies_artifacts_artifact",
+				Columns:    []*schema.Column{CertifyPoliciesColumns[11]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "certifypolicy_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyPoliciesColumns[1]},
+			},
+			{
+				Name:    "certifypolicy_tenant_artifact_id",
+				Unique:  true,
+				Columns: []*schema.Column{CertifyPoliciesColumns[1], CertifyPoliciesColumns[11], CertifyPoliciesColumns[2], CertifyPoliciesColumns[3], CertifyPoliciesColumns[4], CertifyPoliciesColumns[5], CertifyPoliciesColumns[7], CertifyPoliciesColumns[8], CertifyPoliciesColumns[9], CertifyPoliciesColumns[10]},
+			},
+			{
+				Name:    "certifypolicy_artifact_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyPoliciesColumns[11]},
+			},
 		},
 	}
 	// CertifyScorecardsColumns holds the columns for the "certify_scorecards" table.
 	CertifyScorecardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "checks", Type: field.TypeJSON},
 		{Name: "aggregate_score", Type: field.TypeFloat64, Default: 0},
 		{Name: "time_scanned", Type: field.TypeTime},
@@ -317,22 +407,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_scorecards_source_names_source",
-				Columns:    []*schema.Column{CertifyScorecardsColumns[10]},
+				Columns:    []*schema.Column{CertifyScorecardsColumns[11]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certifyscorecard_source_id_origin_collector_scorecard_version_scorecard_commit_aggregate_score_time_scanned_checks_hash_document_ref",
+				Name:    "certifyscorecard_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyScorecardsColumns[1]},
+			},
+			{
+				Name:    "certifyscorecard_tenant_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyScorecardsColumns[10], CertifyScorecardsColumns[6], CertifyScorecardsColumns[7], CertifyScorecardsColumns[4], CertifyScorecardsColumns[5], CertifyScorecardsColumns[2], CertifyScorecardsColumns[3], CertifyScorecardsColumns[9], CertifyScorecardsColumns[8]},
+				Columns: []*schema.Column{CertifyScorecardsColumns[1], CertifyScorecardsColumns[11], CertifyScorecardsColumns[7], CertifyScorecardsColumns[8], CertifyScorecardsColumns[5], CertifyScorecardsColumns[6], CertifyScorecardsColumns[3], CertifyScorecardsColumns[4], CertifyScorecardsColumns[10], CertifyScorecardsColumns[9]},
+			},
+			{
+				Name:    "certifyscorecard_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyScorecardsColumns[11]},
 			},
 		},
 	}
 	// CertifyVexesColumns holds the columns for the "certify_vexes" table.
 	CertifyVexesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "known_since", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeString},
 		{Name: "statement", Type: field.TypeString},
@@ -356,34 +457,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vexes_package_versions_package",
-				Columns:    []*schema.Column{CertifyVexesColumns[11]},
+				Columns:    []*schema.Column{CertifyVexesColumns[12]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_vexes_artifacts_artifact",
-				Columns:    []*schema.Column{CertifyVexesColumns[12]},
+				Columns:    []*schema.Column{CertifyVexesColumns[13]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "certify_vexes_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVexesColumns[13]},
+				Columns:    []*schema.Column{CertifyVexesColumns[14]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "certify_vexes_cvs_ss_cvss",
-				Columns:    []*schema.Column{CertifyVexesColumns[14]},
+				Columns:    []*schema.Column{CertifyVexesColumns[15]},
 				RefColumns: []*schema.Column{CvsSsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "certifyvex_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVexesColumns[1]},
+			},
 			{
 				Name:    "vex_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[13], CertifyVexesColumns[11]},
+				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[3], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[9], CertifyVexesColumns[14], CertifyVexesColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "artifact_id IS NULL",
 				},
@@ -391,16 +497,32 @@ var (
 			{
 				Name:    "vex_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[5], CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[13], CertifyVexesColumns[12]},
+				Columns: []*schema.Column{CertifyVexesColumns[1], CertifyVexesColumns[2], CertifyVexesColumns[6], CertifyVexesColumns[3], CertifyVexesColumns[7], CertifyVexesColumns[8], CertifyVexesColumns[9], CertifyVexesColumns[14], CertifyVexesColumns[13]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL",
 				},
 			},
+			{
+				Name:    "certifyvex_package_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVexesColumns[12]},
+			},
+			{
+				Name:    "certifyvex_artifact_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVexesColumns[13]},
+			},
+			{
+				Name:    "certifyvex_vulnerability_id",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVexesColumns[14]},
+			},
 		},
 	}
 	// CertifyVulnsColumns holds the columns for the "certify_vulns" table.
 	CertifyVulnsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "time_scanned", Type: field.TypeTime},
 		{Name: "db_uri", Type: field.TypeString},
 		{Name: "db_version", Type: field.TypeString},
@@ -420,37 +542,42 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "certify_vulns_vulnerability_ids_vulnerability",
-				Columns:    []*schema.Column{CertifyVulnsColumns[9]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[10]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "certify_vulns_package_versions_package",
-				Columns:    []*schema.Column{CertifyVulnsColumns[10]},
+				Columns:    []*schema.Column{CertifyVulnsColumns[11]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "certifyvuln_db_uri_db_version_scanner_uri_scanner_version_origin_collector_time_scanned_document_ref_vulnerability_id_package_id",
+				Name:    "certifyvuln_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{CertifyVulnsColumns[1]},
+			},
+			{
+				Name:    "certifyvuln_tenant_package_id_vulnerability_id",
 				Unique:  true,
-				Columns: []*schema.Column{CertifyVulnsColumns[2], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[1], CertifyVulnsColumns[8], CertifyVulnsColumns[9], CertifyVulnsColumns[10]},
+				Columns: []*schema.Column{CertifyVulnsColumns[1], CertifyVulnsColumns[3], CertifyVulnsColumns[4], CertifyVulnsColumns[5], CertifyVulnsColumns[6], CertifyVulnsColumns[7], CertifyVulnsColumns[8], CertifyVulnsColumns[2], CertifyVulnsColumns[9], CertifyVulnsColumns[10], CertifyVulnsColumns[11]},
 			},
 			{
 				Name:    "certifyvuln_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyVulnsColumns[10]},
+				Columns: []*schema.Column{CertifyVulnsColumns[11]},
 			},
 			{
 				Name:    "certifyvuln_vulnerability_id",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyVulnsColumns[9]},
+				Columns: []*schema.Column{CertifyVulnsColumns[10]},
 			},
 			{
 				Name:    "certifyvuln_vulnerability_id_package_id_time_scanned",
 				Unique:  false,
-				Columns: []*schema.Column{CertifyVulnsColumns[9], CertifyVulnsColumns[10], CertifyVulnsColumns[1]},
+				Columns: []*schema.Column{CertifyVulnsColumns[10], CertifyVulnsColumns[11], CertifyVulnsColumns[2]},
 			},
 		},
 	}
@@ -502,7 +629,9 @@ var (
 	// DependenciesColumns holds the columns for the "dependencies" table.
 	DependenciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "dependency_type", Type: field.TypeEnum, Enums: []string{"DIRECT", "INDIRECT", "UNKNOWN"}},
+		{Name: "version_range", Type: field.TypeString, Default: ""},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
@@ -518,32 +647,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "dependencies_package_versions_package",
-				Columns:    []*schema.Column{DependenciesColumns[6]},
+				Columns:    []*schema.Column{DependenciesColumns[8]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "dependencies_package_versions_dependent_package_version",
-				Columns:    []*schema.Column{DependenciesColumns[7]},
+				Columns:    []*schema.Column{DependenciesColumns[9]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "dependency_dependency_type_justification_origin_collector_document_ref_package_id_dependent_package_version_id",
+				Name:    "dependency_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{DependenciesColumns[1]},
+			},
+			{
+				Name:    "dependency_tenant_package_id_dependent_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[7]},
+				Columns: []*schema.Column{DependenciesColumns[1], DependenciesColumns[2], DependenciesColumns[3], DependenciesColumns[4], DependenciesColumns[5], DependenciesColumns[6], DependenciesColumns[7], DependenciesColumns[8], DependenciesColumns[9]},
 			},
 			{
 				Name:    "dependency_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{DependenciesColumns[6]},
+				Columns: []*schema.Column{DependenciesColumns[8]},
 			},
 			{
 				Name:    "dependency_dependent_package_version_id",
 				Unique:  false,
-				Columns: []*schema.Column{DependenciesColumns[7]},
+				Columns: []*schema.Column{DependenciesColumns[9]},
 			},
 		},
 	}
@@ -577,6 +711,7 @@ var (
 	// HasMetadataColumns holds the columns for the "has_metadata" table.
 	HasMetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "key", Type: field.TypeString},
 		{Name: "value", Type: field.TypeString},
@@ -597,34 +732,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "has_metadata_source_names_source",
-				Columns:    []*schema.Column{HasMetadataColumns[8]},
+				Columns:    []*schema.Column{HasMetadataColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_package_versions_package_version",
-				Columns:    []*schema.Column{HasMetadataColumns[9]},
+				Columns:    []*schema.Column{HasMetadataColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_package_names_all_versions",
-				Columns:    []*schema.Column{HasMetadataColumns[10]},
+				Columns:    []*schema.Column{HasMetadataColumns[11]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_metadata_artifacts_artifact",
-				Columns:    []*schema.Column{HasMetadataColumns[11]},
+				Columns:    []*schema.Column{HasMetadataColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "hasmetadata_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HasMetadataColumns[1]},
+			},
 			{
 				Name:    "has_metadata_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[8]},
+				Columns: []*schema.Column{HasMetadataColumns[1], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[9]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -632,7 +772,7 @@ var (
 			{
 				Name:    "has_metadata_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[9]},
+				Columns: []*schema.Column{HasMetadataColumns[1], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -640,7 +780,7 @@ var (
 			{
 				Name:    "has_metadata_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[10]},
+				Columns: []*schema.Column{HasMetadataColumns[1], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -648,16 +788,37 @@ var (
 			{
 				Name:    "has_metadata_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasMetadataColumns[2], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[1], HasMetadataColumns[7], HasMetadataColumns[11]},
+				Columns: []*schema.Column{HasMetadataColumns[1], HasMetadataColumns[3], HasMetadataColumns[4], HasMetadataColumns[5], HasMetadataColumns[6], HasMetadataColumns[7], HasMetadataColumns[2], HasMetadataColumns[8], HasMetadataColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
 			},
+			{
+				Name:    "hasmetadata_package_version_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasMetadataColumns[10]},
+			},
+			{
+				Name:    "hasmetadata_package_name_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasMetadataColumns[11]},
+			},
+			{
+				Name:    "hasmetadata_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasMetadataColumns[9]},
+			},
+			{
+				Name:    "hasmetadata_artifact_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasMetadataColumns[12]},
+			},
 		},
 	}
 	// HasSourceAtsColumns holds the columns for the "has_source_ats" table.
 	HasSourceAtsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "known_since", Type: field.TypeTime},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
@@ -675,45 +836,66 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "has_source_ats_package_versions_package_version",
-				Columns:    []*schema.Column{HasSourceAtsColumns[6]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_source_ats_package_names_all_versions",
-				Columns:    []*schema.Column{HasSourceAtsColumns[7]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[8]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "has_source_ats_source_names_source",
-				Columns:    []*schema.Column{HasSourceAtsColumns[8]},
+				Columns:    []*schema.Column{HasSourceAtsColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "hassourceat_source_id_package_version_id_justification_origin_collector_known_since_document_ref",
+				Name:    "hassourceat_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HasSourceAtsColumns[1]},
+			},
+			{
+				Name:    "hassourceat_tenant_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasSourceAtsColumns[8], HasSourceAtsColumns[6], HasSourceAtsColumns[2], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[1], HasSourceAtsColumns[5]},
+				Columns: []*schema.Column{HasSourceAtsColumns[1], HasSourceAtsColumns[9], HasSourceAtsColumns[7], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[5], HasSourceAtsColumns[2], HasSourceAtsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_version_id IS NOT NULL AND package_name_id IS NULL",
 				},
 			},
 			{
-				Name:    "hassourceat_source_id_package_name_id_justification_origin_collector_known_since_document_ref",
+				Name:    "hassourceat_tenant_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{HasSourceAtsColumns[8], HasSourceAtsColumns[7], HasSourceAtsColumns[2], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[1], HasSourceAtsColumns[5]},
+				Columns: []*schema.Column{HasSourceAtsColumns[1], HasSourceAtsColumns[9], HasSourceAtsColumns[8], HasSourceAtsColumns[3], HasSourceAtsColumns[4], HasSourceAtsColumns[5], HasSourceAtsColumns[2], HasSourceAtsColumns[6]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_name_id IS NOT NULL AND package_version_id IS NULL",
 				},
 			},
+			{
+				Name:    "hassourceat_package_version_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasSourceAtsColumns[7]},
+			},
+			{
+				Name:    "hassourceat_package_name_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasSourceAtsColumns[8]},
+			},
+			{
+				Name:    "hassourceat_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{HasSourceAtsColumns[9]},
+			},
 		},
 	}
 	// HashEqualsColumns holds the columns for the "hash_equals" table.
 	HashEqualsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
 		{Name: "justification", Type: field.TypeString},
@@ -730,22 +912,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hash_equals_artifacts_artifact_a",
-				Columns:    []*schema.Column{HashEqualsColumns[6]},
+				Columns:    []*schema.Column{HashEqualsColumns[7]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "hash_equals_artifacts_artifact_b",
-				Columns:    []*schema.Column{HashEqualsColumns[7]},
+				Columns:    []*schema.Column{HashEqualsColumns[8]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "hashequal_art_id_equal_art_id_artifacts_hash_origin_justification_collector_document_ref",
+				Name:    "hashequal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HashEqualsColumns[1]},
+			},
+			{
+				Name:    "hashequal_tenant_art_id_equal_art_id",
 				Unique:  true,
-				Columns: []*schema.Column{HashEqualsColumns[6], HashEqualsColumns[7], HashEqualsColumns[5], HashEqualsColumns[1], HashEqualsColumns[3], HashEqualsColumns[2], HashEqualsColumns[4]},
+				Columns: []*schema.Column{HashEqualsColumns[1], HashEqualsColumns[7], HashEqualsColumns[8], HashEqualsColumns[6], HashEqualsColumns[2], HashEqualsColumns[4], HashEqualsColumns[3], HashEqualsColumns[5]},
+			},
+			{
+				Name:    "hashequal_art_id",
+				Unique:  false,
+				Columns: []*schema.Column{HashEqualsColumns[7]},
+			},
+			{
+				Name:    "hashequal_equal_art_id",
+				Unique:  false,
+				Columns: []*schema.Column{HashEqualsColumns[8]},
 			},
 		},
 	}
@@ -772,6 +969,7 @@ var (
 	// OccurrencesColumns holds the columns for the "occurrences" table.
 	OccurrencesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "justification", Type: field.TypeString},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
@@ -788,28 +986,33 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "occurrences_artifacts_artifact",
-				Columns:    []*schema.Column{OccurrencesColumns[5]},
+				Columns:    []*schema.Column{OccurrencesColumns[6]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "occurrences_package_versions_package",
-				Columns:    []*schema.Column{OccurrencesColumns[6]},
+				Columns:    []*schema.Column{OccurrencesColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "occurrences_source_names_source",
-				Columns:    []*schema.Column{OccurrencesColumns[7]},
+				Columns:    []*schema.Column{OccurrencesColumns[8]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "occurrence_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{OccurrencesColumns[1]},
+			},
 			{
 				Name:    "occurrence_package_id",
 				Unique:  true,
-				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[6]},
+				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[6], OccurrencesColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -817,7 +1020,7 @@ var (
 			{
 				Name:    "occurrence_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[7]},
+				Columns: []*schema.Column{OccurrencesColumns[1], OccurrencesColumns[2], OccurrencesColumns[3], OccurrencesColumns[4], OccurrencesColumns[5], OccurrencesColumns[6], OccurrencesColumns[8]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NULL AND source_id IS NOT NULL",
 				},
@@ -825,7 +1028,7 @@ var (
 			{
 				Name:    "query_occurrence_package_id",
 				Unique:  false,
-				Columns: []*schema.Column{OccurrencesColumns[6]},
+				Columns: []*schema.Column{OccurrencesColumns[7]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "package_id IS NOT NULL AND source_id IS NULL",
 				},
@@ -833,7 +1036,12 @@ var (
 			{
 				Name:    "occurrence_artifact_id",
 				Unique:  false,
-				Columns: []*schema.Column{OccurrencesColumns[5]},
+				Columns: []*schema.Column{OccurrencesColumns[6]},
+			},
+			{
+				Name:    "query_occurrence_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{OccurrencesColumns[8]},
 			},
 		},
 	}
@@ -900,11 +1108,17 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{PackageVersionsColumns[1], PackageVersionsColumns[2], PackageVersionsColumns[3], PackageVersionsColumns[5]},
 			},
+			{
+				Name:    "packageversion_name_id",
+				Unique:  false,
+				Columns: []*schema.Column{PackageVersionsColumns[5]},
+			},
 		},
 	}
 	// PkgEqualsColumns holds the columns for the "pkg_equals" table.
 	PkgEqualsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
 		{Name: "document_ref", Type: field.TypeString},
@@ -921,28 +1135,44 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pkg_equals_package_versions_package_a",
-				Columns:    []*schema.Column{PkgEqualsColumns[6]},
+				Columns:    []*schema.Column{PkgEqualsColumns[7]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "pkg_equals_package_versions_package_b",
-				Columns:    []*schema.Column{PkgEqualsColumns[7]},
+				Columns:    []*schema.Column{PkgEqualsColumns[8]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "pkgequal_pkg_id_equal_pkg_id_packages_hash_origin_justification_collector_document_ref",
+				Name:    "pkgequal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PkgEqualsColumns[1]},
+			},
+			{
+				Name:    "pkgequal_tenant_pkg_id_equal_pkg_id",
 				Unique:  true,
-				Columns: []*schema.Column{PkgEqualsColumns[6], PkgEqualsColumns[7], PkgEqualsColumns[5], PkgEqualsColumns[1], PkgEqualsColumns[4], PkgEqualsColumns[2], PkgEqualsColumns[3]},
+				Columns: []*schema.Column{PkgEqualsColumns[1], PkgEqualsColumns[7], PkgEqualsColumns[8], PkgEqualsColumns[6], PkgEqualsColumns[2], PkgEqualsColumns[5], PkgEqualsColumns[3], PkgEqualsColumns[4]},
+			},
+			{
+				Name:    "pkgequal_pkg_id",
+				Unique:  false,
+				Columns: []*schema.Column{PkgEqualsColumns[7]},
+			},
+			{
+				Name:    "pkgequal_equal_pkg_id",
+				Unique:  false,
+				Columns: []*schema.Column{PkgEqualsColumns[8]},
 			},
 		},
 	}
 	// PointOfContactsColumns holds the columns for the "point_of_contacts" table.
 	PointOfContactsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "email", Type: field.TypeString},
 		{Name: "info", Type: field.TypeString},
 		{Name: "since", Type: field.TypeTime},
@@ -963,34 +1193,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "point_of_contacts_source_names_source",
-				Columns:    []*schema.Column{PointOfContactsColumns[8]},
+				Columns:    []*schema.Column{PointOfContactsColumns[9]},
 				RefColumns: []*schema.Column{SourceNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_package_versions_package_version",
-				Columns:    []*schema.Column{PointOfContactsColumns[9]},
+				Columns:    []*schema.Column{PointOfContactsColumns[10]},
 				RefColumns: []*schema.Column{PackageVersionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_package_names_all_versions",
-				Columns:    []*schema.Column{PointOfContactsColumns[10]},
+				Columns:    []*schema.Column{PointOfContactsColumns[11]},
 				RefColumns: []*schema.Column{PackageNamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "point_of_contacts_artifacts_artifact",
-				Columns:    []*schema.Column{PointOfContactsColumns[11]},
+				Columns:    []*schema.Column{PointOfContactsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "pointofcontact_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfContactsColumns[1]},
+			},
 			{
 				Name:    "poc_source_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8]},
+				Columns: []*schema.Column{PointOfContactsColumns[1], PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[9]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -998,7 +1233,7 @@ var (
 			{
 				Name:    "poc_package_version_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[9]},
+				Columns: []*schema.Column{PointOfContactsColumns[1], PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL",
 				},
@@ -1006,7 +1241,7 @@ var (
 			{
 				Name:    "poc_package_name_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[10]},
+				Columns: []*schema.Column{PointOfContactsColumns[1], PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL",
 				},
@@ -1014,11 +1249,31 @@ var (
 			{
 				Name:    "poc_artifact_id",
 				Unique:  true,
-				Columns: []*schema.Column{PointOfContactsColumns[3], PointOfContactsColumns[1], PointOfContactsColumns[2], PointOfContactsColumns[4], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[11]},
+				Columns: []*schema.Column{PointOfContactsColumns[1], PointOfContactsColumns[4], PointOfContactsColumns[2], PointOfContactsColumns[3], PointOfContactsColumns[5], PointOfContactsColumns[6], PointOfContactsColumns[7], PointOfContactsColumns[8], PointOfContactsColumns[12]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL",
 				},
 			},
+			{
+				Name:    "pointofcontact_package_version_id",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfContactsColumns[10]},
+			},
+			{
+				Name:    "pointofcontact_package_name_id",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfContactsColumns[11]},
+			},
+			{
+				Name:    "pointofcontact_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfContactsColumns[9]},
+			},
+			{
+				Name:    "pointofcontact_artifact_id",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfContactsColumns[12]},
+			},
 		},
 	}
 	// PotentialMitigationsColumns holds the columns for the "potential_mitigations" table.
@@ -1061,6 +1316,7 @@ var (
 	// SlsaAttestationsColumns holds the columns for the "slsa_attestations" table.
 	SlsaAttestationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
 		{Name: "build_type", Type: field.TypeString},
 		{Name: "slsa_predicate", Type: field.TypeJSON, Nullable: true},
 		{Name: "slsa_version", Type: field.TypeString},
@@ -1081,22 +1337,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "slsa_attestations_builders_built_by",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[10]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
 				RefColumns: []*schema.Column{BuildersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "slsa_attestations_artifacts_subject",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "slsaattestation_subject_id_origin_collector_document_ref_build_type_slsa_version_built_by_id_built_from_hash_started_on_finished_on",
+				Name:    "slsaattestation_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaAttestationsColumns[1]},
+			},
+			{
+				Name:    "slsaattestation_tenant_subject_id",
 				Unique:  true,
-				Columns: []*schema.Column{SlsaAttestationsColumns[11], SlsaAttestationsColumns[6], SlsaAttestationsColumns[7], SlsaAttestationsColumns[8], SlsaAttestationsColumns[1], SlsaAttestationsColumns[3], SlsaAttestationsColumns[10], SlsaAttestationsColumns[9], SlsaAttestationsColumns[4], SlsaAttestationsColumns[5]},
+				Columns: []*schema.Column{SlsaAttestationsColumns[1], SlsaAttestationsColumns[12], SlsaAttestationsColumns[7], SlsaAttestationsColumns[8], SlsaAttestationsColumns[9], SlsaAttestationsColumns[2], SlsaAttestationsColumns[4], SlsaAttestationsColumns[11], SlsaAttestationsColumns[10], SlsaAttestationsColumns[5], SlsaAttestationsColumns[6]},
+			},
+			{
+				Name:    "slsaattestation_subject_id",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaAttestationsColumns[12]},
+			},
+			{
+				Name:    "slsaattestation_built_by_id",
+				Unique:  false,
+				Columns: []*schema.Column{SlsaAttestationsColumns[11]},
 			},
 		},
 	}
@@ -1158,6 +1429,11 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{VulnEqualsColumns[6], VulnEqualsColumns[7], VulnEqualsColumns[5], VulnEqualsColumns[1], VulnEqualsColumns[2], VulnEqualsColumns[3], VulnEqualsColumns[4]},
 			},
+			{
+				Name:    "vulnequal_equal_vuln_id",
+				Unique:  false,
+				Columns: []*schema.Column{VulnEqualsColumns[7]},
+			},
 		},
 	}
 	// VulnerabilityIdsColumns holds the columns for the "vulnerability_ids" table.
@@ -1216,6 +1492,62 @@ var (
 			},
 		},
 	}
+	// VulnerabilityRangesColumns holds the columns for the "vulnerability_ranges" table.
+	VulnerabilityRangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant", Type: field.TypeString, Default: ""},
+		{Name: "range_type", Type: field.TypeEnum, Enums: []string{"SEMVER", "ECOSYSTEM", "GIT"}},
+		{Name: "introduced", Type: field.TypeString},
+		{Name: "fixed", Type: field.TypeString},
+		{Name: "last_affected", Type: field.TypeString},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "document_ref", Type: field.TypeString},
+		{Name: "vulnerability_id", Type: field.TypeUUID},
+		{Name: "package_name_id", Type: field.TypeUUID},
+	}
+	// VulnerabilityRangesTable holds the schema information for the "vulnerability_ranges" table.
+	VulnerabilityRangesTable = &schema.Table{
+		Name:       "vulnerability_ranges",
+		Columns:    VulnerabilityRangesColumns,
+		PrimaryKey: []*schema.Column{VulnerabilityRangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vulnerability_ranges_vulnerability_ids_vulnerability",
+				Columns:    []*schema.Column{VulnerabilityRangesColumns[9]},
+				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "vulnerability_ranges_package_names_package",
+				Columns:    []*schema.Column{VulnerabilityRangesColumns[10]},
+				RefColumns: []*schema.Column{PackageNamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vulnerabilityrange_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityRangesColumns[1]},
+			},
+			{
+				Name:    "vulnerabilityrange_tenant_vulnerability_id_package_name_id",
+				Unique:  true,
+				Columns: []*schema.Column{VulnerabilityRangesColumns[1], VulnerabilityRangesColumns[9], VulnerabilityRangesColumns[10], VulnerabilityRangesColumns[2], VulnerabilityRangesColumns[3], VulnerabilityRangesColumns[4], VulnerabilityRangesColumns[5], VulnerabilityRangesColumns[6], VulnerabilityRangesColumns[7], VulnerabilityRangesColumns[8]},
+			},
+			{
+				Name:    "vulnerabilityrange_vulnerability_id",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityRangesColumns[9]},
+			},
+			{
+				Name:    "vulnerabilityrange_package_name_id",
+				Unique:  false,
+				Columns: []*schema.Column{VulnerabilityRangesColumns[10]},
+			},
+		},
+	}
 	// BillOfMaterialsIncludedSoftwarePackagesColumns holds the columns for the "bill_of_materials_included_software_packages" table.
 	BillOfMaterialsIncludedSoftwarePackagesColumns = []*schema.Column{
 		{Name: "bill_of_materials_id", Type: field.TypeUUID},
@@ -1650,6 +1982,7 @@ var (
 		CwEsTable,
 		CertificationsTable,
 		CertifyLegalsTable,
+		CertifyPoliciesTable,
 		CertifyScorecardsTable,
 		CertifyVexesTable,
 		CertifyVulnsTable,
@@ -1677,6 +2010,7 @@ var (
 		VulnEqualsTable,
 		VulnerabilityIdsTable,
 		VulnerabilityMetadataTable,
+		VulnerabilityRangesTable,
 		BillOfMaterialsIncludedSoftwarePackagesTable,
 		BillOfMaterialsIncludedSoftwareArtifactsTable,
 		BillOfMaterialsIncludedDependenciesTable,
@@ -1706,6 +2040,10 @@ func init() {
 	CertificationsTable.ForeignKeys[3].RefTable = ArtifactsTable
 	CertifyLegalsTable.ForeignKeys[0].RefTable = PackageVersionsTable
 	CertifyLegalsTable.ForeignKeys[1].RefTable = SourceNamesTable
+	CertifyPoliciesTable.ForeignKeys[0].RefTable = ArtifactsTable
+	CertifyPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "certify_policies",
+	}
 	CertifyScorecardsTable.ForeignKeys[0].RefTable = SourceNamesTable
 	CertifyVexesTable.ForeignKeys[0].RefTable = PackageVersionsTable
 	CertifyVexesTable.ForeignKeys[1].RefTable = ArtifactsTable
@@ -1742,6 +2080,11 @@ func init() {
 	VulnEqualsTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
 	VulnEqualsTable.ForeignKeys[1].RefTable = VulnerabilityIdsTable
 	VulnerabilityMetadataTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
+	VulnerabilityRangesTable.ForeignKeys[0].RefTable = VulnerabilityIdsTable
+	VulnerabilityRangesTable.ForeignKeys[1].RefTable = PackageNamesTable
+	VulnerabilityRangesTable.Annotation = &entsql.Annotation{
+		Table: "vulnerability_ranges",
+	}
 	BillOfMaterialsIncludedSoftwarePackagesTable.ForeignKeys[0].RefTable = BillOfMaterialsTable
 	BillOfMaterialsIncludedSoftwarePackagesTable.ForeignKeys[1].RefTable = PackageVersionsTable
 	BillOfMaterialsIncludedSoftwarePackagesTable.Annotation = &entsql.Annotation{}
@@ -20,6 +20,8 @@ type Occurrence struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// The artifact in the relationship
 	ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
 	// Justification for the attested relationship
@@ -108,7 +110,7 @@ func (*Occurrence) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case occurrence.FieldSourceID, occurrence.FieldPackageID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case occurrence.FieldJustification, occurrence.FieldOrigin, occurrence.FieldCollector, occurrence.FieldDocumentRef:
+		case occurrence.FieldTenant, occurrence.FieldJustification, occurrence.FieldOrigin, occurrence.FieldCollector, occurrence.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case occurrence.FieldID, occurrence.FieldArtifactID:
 			values[i] = new(uuid.UUID)
@@ -133,6 +135,12 @@ func (o *Occurrence) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				o.ID = *value
 			}
+		case occurrence.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				o.Tenant = value.String
+			}
 		case occurrence.FieldArtifactID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
@@ -233,6 +241,9 @@ func (o *Occurrence) String() string {
 	var builder strings.Builder
 	builder.WriteString("Occurrence(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(o.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("artifact_id=")
 	builder.WriteString(fmt.Sprintf("%v", o.ArtifactID))
 	builder.WriteString(", ")
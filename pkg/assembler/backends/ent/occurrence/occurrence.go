@@ -13,6 +13,8 @@ const (
 	Label = "occurrence"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldArtifactID holds the string denoting the artifact_id field in the database.
 	FieldArtifactID = "artifact_id"
 	// FieldJustification holds the string denoting the justification field in the database.
@@ -68,6 +70,7 @@ const (
 // Columns holds all SQL columns for occurrence fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldArtifactID,
 	FieldJustification,
 	FieldOrigin,
@@ -94,6 +97,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -106,6 +111,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByArtifactID orders the results by the artifact_id field.
 func ByArtifactID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldArtifactID, opts...).ToFunc()
@@ -411,12 +411,12 @@ func (oq *OccurrenceQuery) WithIncludedInSboms(opts ...func(*BillOfMaterialsQuer
 // Example:
 //
 //	var v []struct {
-//		ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Occurrence.Query().
-//		GroupBy(occurrence.FieldArtifactID).
+//		GroupBy(occurrence.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (oq *OccurrenceQuery) GroupBy(field string, fields ...string) *OccurrenceGroupBy {
@@ -434,11 +434,11 @@ func (oq *OccurrenceQuery) GroupBy(field string, fields ...string) *OccurrenceGr
 // Example:
 //
 //	var v []struct {
-//		ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.Occurrence.Query().
-//		Select(occurrence.FieldArtifactID).
+//		Select(occurrence.FieldTenant).
 //		Scan(ctx, &v)
 func (oq *OccurrenceQuery) Select(fields ...string) *OccurrenceSelect {
 	oq.ctx.Fields = append(oq.ctx.Fields, fields...)
@@ -41,17 +41,20 @@ type PackageNameEdges struct {
 	Metadata []*HasMetadata `json:"metadata,omitempty"`
 	// Poc holds the value of the poc edge.
 	Poc []*PointOfContact `json:"poc,omitempty"`
+	// VulnerabilityRanges holds the value of the vulnerability_ranges edge.
+	VulnerabilityRanges []*VulnerabilityRange `json:"vulnerability_ranges,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 	// totalCount holds the count of the edges above.
-	totalCount [5]map[string]int
-
-	namedVersions      map[string][]*PackageVersion
-	namedHasSourceAt   map[string][]*HasSourceAt
-	namedCertification map[string][]*Certification
-	namedMetadata      map[string][]*HasMetadata
-	namedPoc           map[string][]*PointOfContact
+	totalCount [6]map[string]int
+
+	namedVersions            map[string][]*PackageVersion
+	namedHasSourceAt         map[string][]*HasSourceAt
+	namedCertification       map[string][]*Certification
+	namedMetadata            map[string][]*HasMetadata
+	namedPoc                 map[string][]*PointOfContact
+	namedVulnerabilityRanges map[string][]*VulnerabilityRange
 }
 
 // VersionsOrErr returns the Versions value or an error if the edge
@@ -99,6 +102,15 @@ func (e PackageNameEdges) PocOrErr() ([]*PointOfContact, error) {
 	return nil, &NotLoadedError{edge: "poc"}
 }
 
+// VulnerabilityRangesOrErr returns the VulnerabilityRanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e PackageNameEdges) VulnerabilityRangesOrErr() ([]*VulnerabilityRange, error) {
+	if e.loadedTypes[5] {
+		return e.VulnerabilityRanges, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability_ranges"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PackageName) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -185,6 +197,11 @@ func (pn *PackageName) QueryPoc() *PointOfContactQuery {
 	return NewPackageNameClient(pn.config).QueryPoc(pn)
 }
 
+// QueryVulnerabilityRanges queries the "vulnerability_ranges" edge of the PackageName entity.
+func (pn *PackageName) QueryVulnerabilityRanges() *VulnerabilityRangeQuery {
+	return NewPackageNameClient(pn.config).QueryVulnerabilityRanges(pn)
+}
+
 // Update returns a builder for updating this PackageName.
 // Note that you need to call PackageName.Unwrap() before calling this method if this PackageName
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -340,5 +357,29 @@ func (pn *PackageName) appendNamedPoc(name string, edges ...*PointOfContact) {
 	}
 }
 
+// NamedVulnerabilityRanges returns the VulnerabilityRanges named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (pn *PackageName) NamedVulnerabilityRanges(name string) ([]*VulnerabilityRange, error) {
+	if pn.Edges.namedVulnerabilityRanges == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := pn.Edges.namedVulnerabilityRanges[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (pn *PackageName) appendNamedVulnerabilityRanges(name string, edges ...*VulnerabilityRange) {
+	if pn.Edges.namedVulnerabilityRanges == nil {
+		pn.Edges.namedVulnerabilityRanges = make(map[string][]*VulnerabilityRange)
+	}
+	if len(edges) == 0 {
+		pn.Edges.namedVulnerabilityRanges[name] = []*VulnerabilityRange{}
+	} else {
+		pn.Edges.namedVulnerabilityRanges[name] = append(pn.Edges.namedVulnerabilityRanges[name], edges...)
+	}
+}
+
 // PackageNames is a parsable slice of PackageName.
 type PackageNames []*PackageName
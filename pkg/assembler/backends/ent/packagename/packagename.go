@@ -29,6 +29,8 @@ const (
 	EdgeMetadata = "metadata"
 	// EdgePoc holds the string denoting the poc edge name in mutations.
 	EdgePoc = "poc"
+	// EdgeVulnerabilityRanges holds the string denoting the vulnerability_ranges edge name in mutations.
+	EdgeVulnerabilityRanges = "vulnerability_ranges"
 	// Table holds the table name of the packagename in the database.
 	Table = "package_names"
 	// VersionsTable is the table that holds the versions relation/edge.
@@ -66,6 +68,13 @@ const (
 	PocInverseTable = "point_of_contacts"
 	// PocColumn is the table column denoting the poc relation/edge.
 	PocColumn = "package_name_id"
+	// VulnerabilityRangesTable is the table that holds the vulnerability_ranges relation/edge.
+	VulnerabilityRangesTable = "vulnerability_ranges"
+	// VulnerabilityRangesInverseTable is the table name for the VulnerabilityRange entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityrange" package.
+	VulnerabilityRangesInverseTable = "vulnerability_ranges"
+	// VulnerabilityRangesColumn is the table column denoting the vulnerability_ranges relation/edge.
+	VulnerabilityRangesColumn = "package_name_id"
 )
 
 // Columns holds all SQL columns for packagename fields.
@@ -187,6 +196,20 @@ func ByPoc(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPocStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVulnerabilityRangesCount orders the results by vulnerability_ranges count.
+func ByVulnerabilityRangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVulnerabilityRangesStep(), opts...)
+	}
+}
+
+// ByVulnerabilityRanges orders the results by vulnerability_ranges terms.
+func ByVulnerabilityRanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVulnerabilityRangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVersionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -222,3 +245,10 @@ func newPocStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, PocTable, PocColumn),
 	)
 }
+func newVulnerabilityRangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VulnerabilityRangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, VulnerabilityRangesTable, VulnerabilityRangesColumn),
+	)
+}
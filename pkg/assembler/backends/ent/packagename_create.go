@@ -18,6 +18,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // PackageNameCreate is the builder for creating a PackageName entity.
@@ -135,6 +136,21 @@ func (pnc *PackageNameCreate) AddPoc(p ...*PointOfContact) *PackageNameCreate {
 	return pnc.AddPocIDs(ids...)
 }
 
+// AddVulnerabilityRangeIDs adds the "vulnerability_ranges" edge to the VulnerabilityRange entity by IDs.
+func (pnc *PackageNameCreate) AddVulnerabilityRangeIDs(ids ...uuid.UUID) *PackageNameCreate {
+	pnc.mutation.AddVulnerabilityRangeIDs(ids...)
+	return pnc
+}
+
+// AddVulnerabilityRanges adds the "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (pnc *PackageNameCreate) AddVulnerabilityRanges(v ...*VulnerabilityRange) *PackageNameCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return pnc.AddVulnerabilityRangeIDs(ids...)
+}
+
 // Mutation returns the PackageNameMutation object of the builder.
 func (pnc *PackageNameCreate) Mutation() *PackageNameMutation {
 	return pnc.mutation
@@ -325,6 +341,22 @@ func (pnc *PackageNameCreate) createSpec() (*PackageName, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pnc.mutation.VulnerabilityRangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
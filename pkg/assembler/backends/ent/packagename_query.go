@@ -20,27 +20,30 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // PackageNameQuery is the builder for querying PackageName entities.
 type PackageNameQuery struct {
 	config
-	ctx                    *QueryContext
-	order                  []packagename.OrderOption
-	inters                 []Interceptor
-	predicates             []predicate.PackageName
-	withVersions           *PackageVersionQuery
-	withHasSourceAt        *HasSourceAtQuery
-	withCertification      *CertificationQuery
-	withMetadata           *HasMetadataQuery
-	withPoc                *PointOfContactQuery
-	modifiers              []func(*sql.Selector)
-	loadTotal              []func(context.Context, []*PackageName) error
-	withNamedVersions      map[string]*PackageVersionQuery
-	withNamedHasSourceAt   map[string]*HasSourceAtQuery
-	withNamedCertification map[string]*CertificationQuery
-	withNamedMetadata      map[string]*HasMetadataQuery
-	withNamedPoc           map[string]*PointOfContactQuery
+	ctx                          *QueryContext
+	order                        []packagename.OrderOption
+	inters                       []Interceptor
+	predicates                   []predicate.PackageName
+	withVersions                 *PackageVersionQuery
+	withHasSourceAt              *HasSourceAtQuery
+	withCertification            *CertificationQuery
+	withMetadata                 *HasMetadataQuery
+	withPoc                      *PointOfContactQuery
+	withVulnerabilityRanges      *VulnerabilityRangeQuery
+	modifiers                    []func(*sql.Selector)
+	loadTotal                    []func(context.Context, []*PackageName) error
+	withNamedVersions            map[string]*PackageVersionQuery
+	withNamedHasSourceAt         map[string]*HasSourceAtQuery
+	withNamedCertification       map[string]*CertificationQuery
+	withNamedMetadata            map[string]*HasMetadataQuery
+	withNamedPoc                 map[string]*PointOfContactQuery
+	withNamedVulnerabilityRanges map[string]*VulnerabilityRangeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -187,6 +190,28 @@ func (pnq *PackageNameQuery) QueryPoc() *PointOfContactQuery {
 	return query
 }
 
+// QueryVulnerabilityRanges chains the current query on the "vulnerability_ranges" edge.
+func (pnq *PackageNameQuery) QueryVulnerabilityRanges() *VulnerabilityRangeQuery {
+	query := (&VulnerabilityRangeClient{config: pnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(packagename.Table, packagename.FieldID, selector),
+			sqlgraph.To(vulnerabilityrange.Table, vulnerabilityrange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, packagename.VulnerabilityRangesTable, packagename.VulnerabilityRangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PackageName entity from the query.
 // Returns a *NotFoundError when no PackageName was found.
 func (pnq *PackageNameQuery) First(ctx context.Context) (*PackageName, error) {
@@ -374,16 +399,17 @@ func (pnq *PackageNameQuery) Clone() *PackageNameQuery {
 		return nil
 	}
 	return &PackageNameQuery{
-		config:            pnq.config,
-		ctx:               pnq.ctx.Clone(),
-		order:             append([]packagename.OrderOption{}, pnq.order...),
-		inters:            append([]Interceptor{}, pnq.inters...),
-		predicates:        append([]predicate.PackageName{}, pnq.predicates...),
-		withVersions:      pnq.withVersions.Clone(),
-		withHasSourceAt:   pnq.withHasSourceAt.Clone(),
-		withCertification: pnq.withCertification.Clone(),
-		withMetadata:      pnq.withMetadata.Clone(),
-		withPoc:           pnq.withPoc.Clone(),
+		config:                  pnq.config,
+		ctx:                     pnq.ctx.Clone(),
+		order:                   append([]packagename.OrderOption{}, pnq.order...),
+		inters:                  append([]Interceptor{}, pnq.inters...),
+		predicates:              append([]predicate.PackageName{}, pnq.predicates...),
+		withVersions:            pnq.withVersions.Clone(),
+		withHasSourceAt:         pnq.withHasSourceAt.Clone(),
+		withCertification:       pnq.withCertification.Clone(),
+		withMetadata:            pnq.withMetadata.Clone(),
+		withPoc:                 pnq.withPoc.Clone(),
+		withVulnerabilityRanges: pnq.withVulnerabilityRanges.Clone(),
 		// clone intermediate query.
 		sql:  pnq.sql.Clone(),
 		path: pnq.path,
@@ -445,6 +471,17 @@ func (pnq *PackageNameQuery) WithPoc(opts ...func(*PointOfContactQuery)) *Packag
 	return pnq
 }
 
+// WithVulnerabilityRanges tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability_ranges" edge. The optional arguments are used to configure the query builder of the edge.
+func (pnq *PackageNameQuery) WithVulnerabilityRanges(opts ...func(*VulnerabilityRangeQuery)) *PackageNameQuery {
+	query := (&VulnerabilityRangeClient{config: pnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pnq.withVulnerabilityRanges = query
+	return pnq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -523,12 +560,13 @@ func (pnq *PackageNameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*PackageName{}
 		_spec       = pnq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			pnq.withVersions != nil,
 			pnq.withHasSourceAt != nil,
 			pnq.withCertification != nil,
 			pnq.withMetadata != nil,
 			pnq.withPoc != nil,
+			pnq.withVulnerabilityRanges != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -587,6 +625,15 @@ func (pnq *PackageNameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := pnq.withVulnerabilityRanges; query != nil {
+		if err := pnq.loadVulnerabilityRanges(ctx, query, nodes,
+			func(n *PackageName) { n.Edges.VulnerabilityRanges = []*VulnerabilityRange{} },
+			func(n *PackageName, e *VulnerabilityRange) {
+				n.Edges.VulnerabilityRanges = append(n.Edges.VulnerabilityRanges, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range pnq.withNamedVersions {
 		if err := pnq.loadVersions(ctx, query, nodes,
 			func(n *PackageName) { n.appendNamedVersions(name) },
@@ -622,6 +669,13 @@ func (pnq *PackageNameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	for name, query := range pnq.withNamedVulnerabilityRanges {
+		if err := pnq.loadVulnerabilityRanges(ctx, query, nodes,
+			func(n *PackageName) { n.appendNamedVulnerabilityRanges(name) },
+			func(n *PackageName, e *VulnerabilityRange) { n.appendNamedVulnerabilityRanges(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range pnq.loadTotal {
 		if err := pnq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -792,6 +846,36 @@ func (pnq *PackageNameQuery) loadPoc(ctx context.Context, query *PointOfContactQ
 	}
 	return nil
 }
+func (pnq *PackageNameQuery) loadVulnerabilityRanges(ctx context.Context, query *VulnerabilityRangeQuery, nodes []*PackageName, init func(*PackageName), assign func(*PackageName, *VulnerabilityRange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PackageName)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(vulnerabilityrange.FieldPackageNameID)
+	}
+	query.Where(predicate.VulnerabilityRange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(packagename.VulnerabilityRangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PackageNameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "package_name_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pnq *PackageNameQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pnq.querySpec()
@@ -947,6 +1031,20 @@ func (pnq *PackageNameQuery) WithNamedPoc(name string, opts ...func(*PointOfCont
 	return pnq
 }
 
+// WithNamedVulnerabilityRanges tells the query-builder to eager-load the nodes that are connected to the "vulnerability_ranges"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (pnq *PackageNameQuery) WithNamedVulnerabilityRanges(name string, opts ...func(*VulnerabilityRangeQuery)) *PackageNameQuery {
+	query := (&VulnerabilityRangeClient{config: pnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if pnq.withNamedVulnerabilityRanges == nil {
+		pnq.withNamedVulnerabilityRanges = make(map[string]*VulnerabilityRangeQuery)
+	}
+	pnq.withNamedVulnerabilityRanges[name] = query
+	return pnq
+}
+
 // PackageNameGroupBy is the group-by builder for PackageName entities.
 type PackageNameGroupBy struct {
 	selector
@@ -18,6 +18,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/packageversion"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/pointofcontact"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // PackageNameUpdate is the builder for updating PackageName entities.
@@ -150,6 +151,21 @@ func (pnu *PackageNameUpdate) AddPoc(p ...*PointOfContact) *PackageNameUpdate {
 	return pnu.AddPocIDs(ids...)
 }
 
+// AddVulnerabilityRangeIDs adds the "vulnerability_ranges" edge to the VulnerabilityRange entity by IDs.
+func (pnu *PackageNameUpdate) AddVulnerabilityRangeIDs(ids ...uuid.UUID) *PackageNameUpdate {
+	pnu.mutation.AddVulnerabilityRangeIDs(ids...)
+	return pnu
+}
+
+// AddVulnerabilityRanges adds the "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (pnu *PackageNameUpdate) AddVulnerabilityRanges(v ...*VulnerabilityRange) *PackageNameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return pnu.AddVulnerabilityRangeIDs(ids...)
+}
+
 // Mutation returns the PackageNameMutation object of the builder.
 func (pnu *PackageNameUpdate) Mutation() *PackageNameMutation {
 	return pnu.mutation
@@ -260,6 +276,27 @@ func (pnu *PackageNameUpdate) RemovePoc(p ...*PointOfContact) *PackageNameUpdate
 	return pnu.RemovePocIDs(ids...)
 }
 
+// ClearVulnerabilityRanges clears all "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (pnu *PackageNameUpdate) ClearVulnerabilityRanges() *PackageNameUpdate {
+	pnu.mutation.ClearVulnerabilityRanges()
+	return pnu
+}
+
+// RemoveVulnerabilityRangeIDs removes the "vulnerability_ranges" edge to VulnerabilityRange entities by IDs.
+func (pnu *PackageNameUpdate) RemoveVulnerabilityRangeIDs(ids ...uuid.UUID) *PackageNameUpdate {
+	pnu.mutation.RemoveVulnerabilityRangeIDs(ids...)
+	return pnu
+}
+
+// RemoveVulnerabilityRanges removes "vulnerability_ranges" edges to VulnerabilityRange entities.
+func (pnu *PackageNameUpdate) RemoveVulnerabilityRanges(v ...*VulnerabilityRange) *PackageNameUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return pnu.RemoveVulnerabilityRangeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pnu *PackageNameUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pnu.sqlSave, pnu.mutation, pnu.hooks)
@@ -548,6 +585,51 @@ func (pnu *PackageNameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pnu.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pnu.mutation.RemovedVulnerabilityRangesIDs(); len(nodes) > 0 && !pnu.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pnu.mutation.VulnerabilityRangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pnu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{packagename.Label}
@@ -685,6 +767,21 @@ func (pnuo *PackageNameUpdateOne) AddPoc(p ...*PointOfContact) *PackageNameUpdat
 	return pnuo.AddPocIDs(ids...)
 }
 
+// AddVulnerabilityRangeIDs adds the "vulnerability_ranges" edge to the VulnerabilityRange entity by IDs.
+func (pnuo *PackageNameUpdateOne) AddVulnerabilityRangeIDs(ids ...uuid.UUID) *PackageNameUpdateOne {
+	pnuo.mutation.AddVulnerabilityRangeIDs(ids...)
+	return pnuo
+}
+
+// AddVulnerabilityRanges adds the "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (pnuo *PackageNameUpdateOne) AddVulnerabilityRanges(v ...*VulnerabilityRange) *PackageNameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return pnuo.AddVulnerabilityRangeIDs(ids...)
+}
+
 // Mutation returns the PackageNameMutation object of the builder.
 func (pnuo *PackageNameUpdateOne) Mutation() *PackageNameMutation {
 	return pnuo.mutation
@@ -795,6 +892,27 @@ func (pnuo *PackageNameUpdateOne) RemovePoc(p ...*PointOfContact) *PackageNameUp
 	return pnuo.RemovePocIDs(ids...)
 }
 
+// ClearVulnerabilityRanges clears all "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (pnuo *PackageNameUpdateOne) ClearVulnerabilityRanges() *PackageNameUpdateOne {
+	pnuo.mutation.ClearVulnerabilityRanges()
+	return pnuo
+}
+
+// RemoveVulnerabilityRangeIDs removes the "vulnerability_ranges" edge to VulnerabilityRange entities by IDs.
+func (pnuo *PackageNameUpdateOne) RemoveVulnerabilityRangeIDs(ids ...uuid.UUID) *PackageNameUpdateOne {
+	pnuo.mutation.RemoveVulnerabilityRangeIDs(ids...)
+	return pnuo
+}
+
+// RemoveVulnerabilityRanges removes "vulnerability_ranges" edges to VulnerabilityRange entities.
+func (pnuo *PackageNameUpdateOne) RemoveVulnerabilityRanges(v ...*VulnerabilityRange) *PackageNameUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return pnuo.RemoveVulnerabilityRangeIDs(ids...)
+}
+
 // Where appends a list predicates to the PackageNameUpdate builder.
 func (pnuo *PackageNameUpdateOne) Where(ps ...predicate.PackageName) *PackageNameUpdateOne {
 	pnuo.mutation.Where(ps...)
@@ -1113,6 +1231,51 @@ func (pnuo *PackageNameUpdateOne) sqlSave(ctx context.Context) (_node *PackageNa
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pnuo.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pnuo.mutation.RemovedVulnerabilityRangesIDs(); len(nodes) > 0 && !pnuo.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pnuo.mutation.VulnerabilityRangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   packagename.VulnerabilityRangesTable,
+			Columns: []string{packagename.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PackageName{config: pnuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -30,3 +30,9 @@ func (c *Client) Ping(ctx context.Context) error {
 
 	return fmt.Errorf("connection does not support Ping")
 }
+
+// Dialect returns the SQL dialect of the database of the client, such as
+// dialect.Postgres.
+func (c *Client) Dialect() string {
+	return c.driver.Dialect()
+}
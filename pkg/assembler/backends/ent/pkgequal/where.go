@@ -54,6 +54,11 @@ func IDLTE(id uuid.UUID) predicate.PkgEqual {
 	return predicate.PkgEqual(sql.FieldLTE(FieldID, id))
 }
 
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldEQ(FieldTenant, v))
+}
+
 // PkgID applies equality check predicate on the "pkg_id" field. It's identical to PkgIDEQ.
 func PkgID(v uuid.UUID) predicate.PkgEqual {
 	return predicate.PkgEqual(sql.FieldEQ(FieldPkgID, v))
@@ -89,6 +94,71 @@ func PackagesHash(v string) predicate.PkgEqual {
 	return predicate.PkgEqual(sql.FieldEQ(FieldPackagesHash, v))
 }
 
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.PkgEqual {
+	return predicate.PkgEqual(sql.FieldContainsFold(FieldTenant, v))
+}
+
 // PkgIDEQ applies the EQ predicate on the "pkg_id" field.
 func PkgIDEQ(v uuid.UUID) predicate.PkgEqual {
 	return predicate.PkgEqual(sql.FieldEQ(FieldPkgID, v))
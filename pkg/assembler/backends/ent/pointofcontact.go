@@ -22,6 +22,8 @@ type PointOfContact struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// SourceID holds the value of the "source_id" field.
 	SourceID *uuid.UUID `json:"source_id,omitempty"`
 	// PackageVersionID holds the value of the "package_version_id" field.
@@ -118,7 +120,7 @@ func (*PointOfContact) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case pointofcontact.FieldSourceID, pointofcontact.FieldPackageVersionID, pointofcontact.FieldPackageNameID, pointofcontact.FieldArtifactID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case pointofcontact.FieldEmail, pointofcontact.FieldInfo, pointofcontact.FieldJustification, pointofcontact.FieldOrigin, pointofcontact.FieldCollector, pointofcontact.FieldDocumentRef:
+		case pointofcontact.FieldTenant, pointofcontact.FieldEmail, pointofcontact.FieldInfo, pointofcontact.FieldJustification, pointofcontact.FieldOrigin, pointofcontact.FieldCollector, pointofcontact.FieldDocumentRef:
 			values[i] = new(sql.NullString)
 		case pointofcontact.FieldSince:
 			values[i] = new(sql.NullTime)
@@ -145,6 +147,12 @@ func (poc *PointOfContact) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				poc.ID = *value
 			}
+		case pointofcontact.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				poc.Tenant = value.String
+			}
 		case pointofcontact.FieldSourceID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field source_id", values[i])
@@ -271,6 +279,9 @@ func (poc *PointOfContact) String() string {
 	var builder strings.Builder
 	builder.WriteString("PointOfContact(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", poc.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(poc.Tenant)
+	builder.WriteString(", ")
 	if v := poc.SourceID; v != nil {
 		builder.WriteString("source_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -28,6 +28,20 @@ type PointOfContactCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetTenant sets the "tenant" field.
+func (pocc *PointOfContactCreate) SetTenant(s string) *PointOfContactCreate {
+	pocc.mutation.SetTenant(s)
+	return pocc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (pocc *PointOfContactCreate) SetNillableTenant(s *string) *PointOfContactCreate {
+	if s != nil {
+		pocc.SetTenant(*s)
+	}
+	return pocc
+}
+
 // SetSourceID sets the "source_id" field.
 func (pocc *PointOfContactCreate) SetSourceID(u uuid.UUID) *PointOfContactCreate {
 	pocc.mutation.SetSourceID(u)
@@ -209,6 +223,10 @@ func (pocc *PointOfContactCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pocc *PointOfContactCreate) defaults() {
+	if _, ok := pocc.mutation.Tenant(); !ok {
+		v := pointofcontact.DefaultTenant
+		pocc.mutation.SetTenant(v)
+	}
 	if _, ok := pocc.mutation.ID(); !ok {
 		v := pointofcontact.DefaultID()
 		pocc.mutation.SetID(v)
@@ -217,6 +235,9 @@ func (pocc *PointOfContactCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (pocc *PointOfContactCreate) check() error {
+	if _, ok := pocc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "PointOfContact.tenant"`)}
+	}
 	if _, ok := pocc.mutation.Email(); !ok {
 		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "PointOfContact.email"`)}
 	}
@@ -274,6 +295,10 @@ func (pocc *PointOfContactCreate) createSpec() (*PointOfContact, *sqlgraph.Creat
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := pocc.mutation.Tenant(); ok {
+		_spec.SetField(pointofcontact.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
 	if value, ok := pocc.mutation.Email(); ok {
 		_spec.SetField(pointofcontact.FieldEmail, field.TypeString, value)
 		_node.Email = value
@@ -377,7 +402,7 @@ func (pocc *PointOfContactCreate) createSpec() (*PointOfContact, *sqlgraph.Creat
 // of the `INSERT` statement. For example:
 //
 //	client.PointOfContact.Create().
-//		SetSourceID(v).
+//		SetTenant(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -386,7 +411,7 @@ func (pocc *PointOfContactCreate) createSpec() (*PointOfContact, *sqlgraph.Creat
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PointOfContactUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (pocc *PointOfContactCreate) OnConflict(opts ...sql.ConflictOption) *PointOfContactUpsertOne {
@@ -595,6 +620,9 @@ func (u *PointOfContactUpsertOne) UpdateNewValues() *PointOfContactUpsertOne {
 		if _, exists := u.create.mutation.ID(); exists {
 			s.SetIgnore(pointofcontact.FieldID)
 		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(pointofcontact.FieldTenant)
+		}
 	}))
 	return u
 }
@@ -944,7 +972,7 @@ func (poccb *PointOfContactCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.PointOfContactUpsert) {
-//			SetSourceID(v+v).
+//			SetTenant(v+v).
 //		}).
 //		Exec(ctx)
 func (poccb *PointOfContactCreateBulk) OnConflict(opts ...sql.ConflictOption) *PointOfContactUpsertBulk {
@@ -991,6 +1019,9 @@ func (u *PointOfContactUpsertBulk) UpdateNewValues() *PointOfContactUpsertBulk {
 			if _, exists := b.mutation.ID(); exists {
 				s.SetIgnore(pointofcontact.FieldID)
 			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(pointofcontact.FieldTenant)
+			}
 		}
 	}))
 	return u
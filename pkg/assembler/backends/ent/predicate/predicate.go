@@ -27,6 +27,9 @@ type Certification func(*sql.Selector)
 // CertifyLegal is the predicate function for certifylegal builders.
 type CertifyLegal func(*sql.Selector)
 
+// CertifyPolicy is the predicate function for certifypolicy builders.
+type CertifyPolicy func(*sql.Selector)
+
 // CertifyScorecard is the predicate function for certifyscorecard builders.
 type CertifyScorecard func(*sql.Selector)
 
@@ -107,3 +110,6 @@ type VulnerabilityID func(*sql.Selector)
 
 // VulnerabilityMetadata is the predicate function for vulnerabilitymetadata builders.
 type VulnerabilityMetadata func(*sql.Selector)
+
+// VulnerabilityRange is the predicate function for vulnerabilityrange builders.
+type VulnerabilityRange func(*sql.Selector)
@@ -11,6 +11,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/builder"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifylegal"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifypolicy"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyscorecard"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
@@ -41,6 +42,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -53,8 +55,15 @@ func init() {
 	artifactDescID := artifactFields[0].Descriptor()
 	// artifact.DefaultID holds the default value on creation for the id field.
 	artifact.DefaultID = artifactDescID.Default.(func() uuid.UUID)
+	billofmaterialsMixin := schema.BillOfMaterials{}.Mixin()
+	billofmaterialsMixinFields0 := billofmaterialsMixin[0].Fields()
+	_ = billofmaterialsMixinFields0
 	billofmaterialsFields := schema.BillOfMaterials{}.Fields()
 	_ = billofmaterialsFields
+	// billofmaterialsDescTenant is the schema descriptor for tenant field.
+	billofmaterialsDescTenant := billofmaterialsMixinFields0[0].Descriptor()
+	// billofmaterials.DefaultTenant holds the default value on creation for the tenant field.
+	billofmaterials.DefaultTenant = billofmaterialsDescTenant.Default.(string)
 	// billofmaterialsDescID is the schema descriptor for id field.
 	billofmaterialsDescID := billofmaterialsFields[0].Descriptor()
 	// billofmaterials.DefaultID holds the default value on creation for the id field.
@@ -77,20 +86,54 @@ func init() {
 	cweDescID := cweFields[0].Descriptor()
 	// cwe.DefaultID holds the default value on creation for the id field.
 	cwe.DefaultID = cweDescID.Default.(func() uuid.UUID)
+	certificationMixin := schema.Certification{}.Mixin()
+	certificationMixinFields0 := certificationMixin[0].Fields()
+	_ = certificationMixinFields0
 	certificationFields := schema.Certification{}.Fields()
 	_ = certificationFields
+	// certificationDescTenant is the schema descriptor for tenant field.
+	certificationDescTenant := certificationMixinFields0[0].Descriptor()
+	// certification.DefaultTenant holds the default value on creation for the tenant field.
+	certification.DefaultTenant = certificationDescTenant.Default.(string)
 	// certificationDescID is the schema descriptor for id field.
 	certificationDescID := certificationFields[0].Descriptor()
 	// certification.DefaultID holds the default value on creation for the id field.
 	certification.DefaultID = certificationDescID.Default.(func() uuid.UUID)
+	certifylegalMixin := schema.CertifyLegal{}.Mixin()
+	certifylegalMixinFields0 := certifylegalMixin[0].Fields()
+	_ = certifylegalMixinFields0
 	certifylegalFields := schema.CertifyLegal{}.Fields()
 	_ = certifylegalFields
+	// certifylegalDescTenant is the schema descriptor for tenant field.
+	certifylegalDescTenant := certifylegalMixinFields0[0].Descriptor()
+	// certifylegal.DefaultTenant holds the default value on creation for the tenant field.
+	certifylegal.DefaultTenant = certifylegalDescTenant.Default.(string)
 	// certifylegalDescID is the schema descriptor for id field.
 	certifylegalDescID := certifylegalFields[0].Descriptor()
 	// certifylegal.DefaultID holds the default value on creation for the id field.
 	certifylegal.DefaultID = certifylegalDescID.Default.(func() uuid.UUID)
+	certifypolicyMixin := schema.CertifyPolicy{}.Mixin()
+	certifypolicyMixinFields0 := certifypolicyMixin[0].Fields()
+	_ = certifypolicyMixinFields0
+	certifypolicyFields := schema.CertifyPolicy{}.Fields()
+	_ = certifypolicyFields
+	// certifypolicyDescTenant is the schema descriptor for tenant field.
+	certifypolicyDescTenant := certifypolicyMixinFields0[0].Descriptor()
+	// certifypolicy.DefaultTenant holds the default value on creation for the tenant field.
+	certifypolicy.DefaultTenant = certifypolicyDescTenant.Default.(string)
+	// certifypolicyDescID is the schema descriptor for id field.
+	certifypolicyDescID := certifypolicyFields[0].Descriptor()
+	// certifypolicy.DefaultID holds the default value on creation for the id field.
+	certifypolicy.DefaultID = certifypolicyDescID.Default.(func() uuid.UUID)
+	certifyscorecardMixin := schema.CertifyScorecard{}.Mixin()
+	certifyscorecardMixinFields0 := certifyscorecardMixin[0].Fields()
+	_ = certifyscorecardMixinFields0
 	certifyscorecardFields := schema.CertifyScorecard{}.Fields()
 	_ = certifyscorecardFields
+	// certifyscorecardDescTenant is the schema descriptor for tenant field.
+	certifyscorecardDescTenant := certifyscorecardMixinFields0[0].Descriptor()
+	// certifyscorecard.DefaultTenant holds the default value on creation for the tenant field.
+	certifyscorecard.DefaultTenant = certifyscorecardDescTenant.Default.(string)
 	// certifyscorecardDescAggregateScore is the schema descriptor for aggregate_score field.
 	certifyscorecardDescAggregateScore := certifyscorecardFields[3].Descriptor()
 	// certifyscorecard.DefaultAggregateScore holds the default value on creation for the aggregate_score field.
@@ -103,14 +146,28 @@ func init() {
 	certifyscorecardDescID := certifyscorecardFields[0].Descriptor()
 	// certifyscorecard.DefaultID holds the default value on creation for the id field.
 	certifyscorecard.DefaultID = certifyscorecardDescID.Default.(func() uuid.UUID)
+	certifyvexMixin := schema.CertifyVex{}.Mixin()
+	certifyvexMixinFields0 := certifyvexMixin[0].Fields()
+	_ = certifyvexMixinFields0
 	certifyvexFields := schema.CertifyVex{}.Fields()
 	_ = certifyvexFields
+	// certifyvexDescTenant is the schema descriptor for tenant field.
+	certifyvexDescTenant := certifyvexMixinFields0[0].Descriptor()
+	// certifyvex.DefaultTenant holds the default value on creation for the tenant field.
+	certifyvex.DefaultTenant = certifyvexDescTenant.Default.(string)
 	// certifyvexDescID is the schema descriptor for id field.
 	certifyvexDescID := certifyvexFields[0].Descriptor()
 	// certifyvex.DefaultID holds the default value on creation for the id field.
 	certifyvex.DefaultID = certifyvexDescID.Default.(func() uuid.UUID)
+	certifyvulnMixin := schema.CertifyVuln{}.Mixin()
+	certifyvulnMixinFields0 := certifyvulnMixin[0].Fields()
+	_ = certifyvulnMixinFields0
 	certifyvulnFields := schema.CertifyVuln{}.Fields()
 	_ = certifyvulnFields
+	// certifyvulnDescTenant is the schema descriptor for tenant field.
+	certifyvulnDescTenant := certifyvulnMixinFields0[0].Descriptor()
+	// certifyvuln.DefaultTenant holds the default value on creation for the tenant field.
+	certifyvuln.DefaultTenant = certifyvulnDescTenant.Default.(string)
 	// certifyvulnDescID is the schema descriptor for id field.
 	certifyvulnDescID := certifyvulnFields[0].Descriptor()
 	// certifyvuln.DefaultID holds the default value on creation for the id field.
@@ -139,8 +196,19 @@ func init() {
 	demonstrativeexampleDescID := demonstrativeexampleFields[0].Descriptor()
 	// demonstrativeexample.DefaultID holds the default value on creation for the id field.
 	demonstrativeexample.DefaultID = demonstrativeexampleDescID.Default.(func() uuid.UUID)
+	dependencyMixin := schema.Dependency{}.Mixin()
+	dependencyMixinFields0 := dependencyMixin[0].Fields()
+	_ = dependencyMixinFields0
 	dependencyFields := schema.Dependency{}.Fields()
 	_ = dependencyFields
+	// dependencyDescTenant is the schema descriptor for tenant field.
+	dependencyDescTenant := dependencyMixinFields0[0].Descriptor()
+	// dependency.DefaultTenant holds the default value on creation for the tenant field.
+	dependency.DefaultTenant = dependencyDescTenant.Default.(string)
+	// dependencyDescVersionRange is the schema descriptor for version_range field.
+	dependencyDescVersionRange := dependencyFields[4].Descriptor()
+	// dependency.DefaultVersionRange holds the default value on creation for the version_range field.
+	dependency.DefaultVersionRange = dependencyDescVersionRange.Default.(string)
 	// dependencyDescID is the schema descriptor for id field.
 	dependencyDescID := dependencyFields[0].Descriptor()
 	// dependency.DefaultID holds the default value on creation for the id field.
@@ -157,20 +225,41 @@ func init() {
 	exploitDescID := exploitFields[0].Descriptor()
 	// exploit.DefaultID holds the default value on creation for the id field.
 	exploit.DefaultID = exploitDescID.Default.(func() uuid.UUID)
+	hasmetadataMixin := schema.HasMetadata{}.Mixin()
+	hasmetadataMixinFields0 := hasmetadataMixin[0].Fields()
+	_ = hasmetadataMixinFields0
 	hasmetadataFields := schema.HasMetadata{}.Fields()
 	_ = hasmetadataFields
+	// hasmetadataDescTenant is the schema descriptor for tenant field.
+	hasmetadataDescTenant := hasmetadataMixinFields0[0].Descriptor()
+	// hasmetadata.DefaultTenant holds the default value on creation for the tenant field.
+	hasmetadata.DefaultTenant = hasmetadataDescTenant.Default.(string)
 	// hasmetadataDescID is the schema descriptor for id field.
 	hasmetadataDescID := hasmetadataFields[0].Descriptor()
 	// hasmetadata.DefaultID holds the default value on creation for the id field.
 	hasmetadata.DefaultID = hasmetadataDescID.Default.(func() uuid.UUID)
+	hassourceatMixin := schema.HasSourceAt{}.Mixin()
+	hassourceatMixinFields0 := hassourceatMixin[0].Fields()
+	_ = hassourceatMixinFields0
 	hassourceatFields := schema.HasSourceAt{}.Fields()
 	_ = hassourceatFields
+	// hassourceatDescTenant is the schema descriptor for tenant field.
+	hassourceatDescTenant := hassourceatMixinFields0[0].Descriptor()
+	// hassourceat.DefaultTenant holds the default value on creation for the tenant field.
+	hassourceat.DefaultTenant = hassourceatDescTenant.Default.(string)
 	// hassourceatDescID is the schema descriptor for id field.
 	hassourceatDescID := hassourceatFields[0].Descriptor()
 	// hassourceat.DefaultID holds the default value on creation for the id field.
 	hassourceat.DefaultID = hassourceatDescID.Default.(func() uuid.UUID)
+	hashequalMixin := schema.HashEqual{}.Mixin()
+	hashequalMixinFields0 := hashequalMixin[0].Fields()
+	_ = hashequalMixinFields0
 	hashequalFields := schema.HashEqual{}.Fields()
 	_ = hashequalFields
+	// hashequalDescTenant is the schema descriptor for tenant field.
+	hashequalDescTenant := hashequalMixinFields0[0].Descriptor()
+	// hashequal.DefaultTenant holds the default value on creation for the tenant field.
+	hashequal.DefaultTenant = hashequalDescTenant.Default.(string)
 	// hashequalDescID is the schema descriptor for id field.
 	hashequalDescID := hashequalFields[0].Descriptor()
 	// hashequal.DefaultID holds the default value on creation for the id field.
@@ -185,8 +274,15 @@ func init() {
 	licenseDescID := licenseFields[0].Descriptor()
 	// license.DefaultID holds the default value on creation for the id field.
 	license.DefaultID = licenseDescID.Default.(func() uuid.UUID)
+	occurrenceMixin := schema.Occurrence{}.Mixin()
+	occurrenceMixinFields0 := occurrenceMixin[0].Fields()
+	_ = occurrenceMixinFields0
 	occurrenceFields := schema.Occurrence{}.Fields()
 	_ = occurrenceFields
+	// occurrenceDescTenant is the schema descriptor for tenant field.
+	occurrenceDescTenant := occurrenceMixinFields0[0].Descriptor()
+	// occurrence.DefaultTenant holds the default value on creation for the tenant field.
+	occurrence.DefaultTenant = occurrenceDescTenant.Default.(string)
 	// occurrenceDescID is the schema descriptor for id field.
 	occurrenceDescID := occurrenceFields[0].Descriptor()
 	// occurrence.DefaultID holds the default value on creation for the id field.
@@ -219,14 +315,28 @@ func init() {
 	packageversionDescID := packageversionFields[0].Descriptor()
 	// packageversion.DefaultID holds the default value on creation for the id field.
 	packageversion.DefaultID = packageversionDescID.Default.(func() uuid.UUID)
+	pkgequalMixin := schema.PkgEqual{}.Mixin()
+	pkgequalMixinFields0 := pkgequalMixin[0].Fields()
+	_ = pkgequalMixinFields0
 	pkgequalFields := schema.PkgEqual{}.Fields()
 	_ = pkgequalFields
+	// pkgequalDescTenant is the schema descriptor for tenant field.
+	pkgequalDescTenant := pkgequalMixinFields0[0].Descriptor()
+	// pkgequal.DefaultTenant holds the default value on creation for the tenant field.
+	pkgequal.DefaultTenant = pkgequalDescTenant.Default.(string)
 	// pkgequalDescID is the schema descriptor for id field.
 	pkgequalDescID := pkgequalFields[0].Descriptor()
 	// pkgequal.DefaultID holds the default value on creation for the id field.
 	pkgequal.DefaultID = pkgequalDescID.Default.(func() uuid.UUID)
+	pointofcontactMixin := schema.PointOfContact{}.Mixin()
+	pointofcontactMixinFields0 := pointofcontactMixin[0].Fields()
+	_ = pointofcontactMixinFields0
 	pointofcontactFields := schema.PointOfContact{}.Fields()
 	_ = pointofcontactFields
+	// pointofcontactDescTenant is the schema descriptor for tenant field.
+	pointofcontactDescTenant := pointofcontactMixinFields0[0].Descriptor()
+	// pointofcontact.DefaultTenant holds the default value on creation for the tenant field.
+	pointofcontact.DefaultTenant = pointofcontactDescTenant.Default.(string)
 	// pointofcontactDescID is the schema descriptor for id field.
 	pointofcontactDescID := pointofcontactFields[0].Descriptor()
 	// pointofcontact.DefaultID holds the default value on creation for the id field.
@@ -249,8 +359,15 @@ func init() {
 	reachablecodeartifactDescID := reachablecodeartifactFields[0].Descriptor()
 	// reachablecodeartifact.DefaultID holds the default value on creation for the id field.
 	reachablecodeartifact.DefaultID = reachablecodeartifactDescID.Default.(func() uuid.UUID)
+	slsaattestationMixin := schema.SLSAAttestation{}.Mixin()
+	slsaattestationMixinFields0 := slsaattestationMixin[0].Fields()
+	_ = slsaattestationMixinFields0
 	slsaattestationFields := schema.SLSAAttestation{}.Fields()
 	_ = slsaattestationFields
+	// slsaattestationDescTenant is the schema descriptor for tenant field.
+	slsaattestationDescTenant := slsaattestationMixinFields0[0].Descriptor()
+	// slsaattestation.DefaultTenant holds the default value on creation for the tenant field.
+	slsaattestation.DefaultTenant = slsaattestationDescTenant.Default.(string)
 	// slsaattestationDescID is the schema descriptor for id field.
 	slsaattestationDescID := slsaattestationFields[0].Descriptor()
 	// slsaattestation.DefaultID holds the default value on creation for the id field.
@@ -283,4 +400,17 @@ func init() {
 	vulnerabilitymetadataDescID := vulnerabilitymetadataFields[0].Descriptor()
 	// vulnerabilitymetadata.DefaultID holds the default value on creation for the id field.
 	vulnerabilitymetadata.DefaultID = vulnerabilitymetadataDescID.Default.(func() uuid.UUID)
+	vulnerabilityrangeMixin := schema.VulnerabilityRange{}.Mixin()
+	vulnerabilityrangeMixinFields0 := vulnerabilityrangeMixin[0].Fields()
+	_ = vulnerabilityrangeMixinFields0
+	vulnerabilityrangeFields := schema.VulnerabilityRange{}.Fields()
+	_ = vulnerabilityrangeFields
+	// vulnerabilityrangeDescTenant is the schema descriptor for tenant field.
+	vulnerabilityrangeDescTenant := vulnerabilityrangeMixinFields0[0].Descriptor()
+	// vulnerabilityrange.DefaultTenant holds the default value on creation for the tenant field.
+	vulnerabilityrange.DefaultTenant = vulnerabilityrangeDescTenant.Default.(string)
+	// vulnerabilityrangeDescID is the schema descriptor for id field.
+	vulnerabilityrangeDescID := vulnerabilityrangeFields[0].Descriptor()
+	// vulnerabilityrange.DefaultID holds the default value on creation for the id field.
+	vulnerabilityrange.DefaultID = vulnerabilityrangeDescID.Default.(func() uuid.UUID)
 }
@@ -61,6 +61,7 @@ func (Artifact) Edges() []ent.Edge {
 		edge.From("certification", Certification.Type).Ref("artifact"),
 		edge.From("metadata", HasMetadata.Type).Ref("artifact"),
 		edge.From("poc", PointOfContact.Type).Ref("artifact"),
+		edge.From("policies", CertifyPolicy.Type).Ref("artifact"),
 		edge.From("included_in_sboms", BillOfMaterials.Type).Ref("included_software_artifacts"),
 	}
 }
@@ -29,6 +29,13 @@ type BillOfMaterials struct {
 	ent.Schema
 }
 
+// Mixin of the BillOfMaterials.
+func (BillOfMaterials) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the SBOM.
 func (BillOfMaterials) Fields() []ent.Field {
 	return []ent.Field{
@@ -68,10 +75,10 @@ func (BillOfMaterials) Edges() []ent.Edge {
 // Indexes of the Material.
 func (BillOfMaterials) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash",
+		index.Fields("tenant", "algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash",
 			"included_artifacts_hash", "included_dependencies_hash", "included_occurrences_hash", "origin", "collector", "document_ref").Edges("package").Unique().
 			Annotations(entsql.IndexWhere("package_id IS NOT NULL AND artifact_id IS NULL")).StorageKey("sbom_package_id"),
-		index.Fields("algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash",
+		index.Fields("tenant", "algorithm", "digest", "uri", "download_location", "known_since", "included_packages_hash",
 			"included_artifacts_hash", "included_dependencies_hash", "included_occurrences_hash", "origin", "collector", "document_ref").Edges("artifact").Unique().
 			Annotations(entsql.IndexWhere("package_id IS NULL AND artifact_id IS NOT NULL")).StorageKey("sbom_artifact_id"),
 		index.Fields("package_id").Annotations(entsql.IndexWhere("package_id IS NOT NULL AND artifact_id IS NULL")),  // query when subject is package ID
@@ -29,6 +29,13 @@ type Certification struct {
 	ent.Schema
 }
 
+// Mixin of the Certification.
+func (Certification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Certification.
 func (Certification) Fields() []ent.Field {
 	return []ent.Field{
@@ -62,9 +69,13 @@ func (Certification) Edges() []ent.Edge {
 
 func (Certification) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("type", "justification", "origin", "collector", "source_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL")),
-		index.Fields("type", "justification", "origin", "collector", "package_version_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL")),
-		index.Fields("type", "justification", "origin", "collector", "package_name_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL")),
-		index.Fields("type", "justification", "origin", "collector", "artifact_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL")),
+		index.Fields("tenant", "type", "justification", "origin", "collector", "source_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL")).StorageKey("certification_tenant_source_id"),
+		index.Fields("tenant", "type", "justification", "origin", "collector", "package_version_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL")).StorageKey("certification_tenant_package_version_id"),
+		index.Fields("tenant", "type", "justification", "origin", "collector", "package_name_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL")).StorageKey("certification_tenant_package_name_id"),
+		index.Fields("tenant", "type", "justification", "origin", "collector", "artifact_id", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL")).StorageKey("certification_tenant_artifact_id"),
+		index.Fields("package_version_id"), // followed by paths and closures
+		index.Fields("package_name_id"),    // followed by paths and closures
+		index.Fields("source_id"),          // followed by paths and closures
+		index.Fields("artifact_id"),        // followed by paths and closures
 	}
 }
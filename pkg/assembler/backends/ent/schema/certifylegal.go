@@ -29,6 +29,13 @@ type CertifyLegal struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyLegal.
+func (CertifyLegal) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the CertifyLegal.
 func (CertifyLegal) Fields() []ent.Field {
 	return []ent.Field{
@@ -63,15 +70,16 @@ func (CertifyLegal) Edges() []ent.Edge {
 
 func (CertifyLegal) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("source_id", "declared_license", "justification", "time_scanned",
+		index.Fields("tenant", "source_id", "declared_license", "justification", "time_scanned",
 			"origin", "collector", "document_ref", "declared_licenses_hash", "discovered_licenses_hash").
 			Unique().
-			Annotations(entsql.IndexWhere("package_id IS NULL AND source_id IS NOT NULL")),
-		index.Fields("package_id", "declared_license", "justification", "time_scanned",
+			Annotations(entsql.IndexWhere("package_id IS NULL AND source_id IS NOT NULL")).StorageKey("certifylegal_tenant_source_id"),
+		index.Fields("tenant", "package_id", "declared_license", "justification", "time_scanned",
 			"origin", "collector", "document_ref", "declared_licenses_hash", "discovered_licenses_hash").
 			Unique().
-			Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")),
+			Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")).StorageKey("certifylegal_tenant_package_id"),
 		index.Fields("package_id").Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")),                                                                       // query when subject is package ID
 		index.Fields("package_id", "declared_licenses_hash", "discovered_licenses_hash", "time_scanned").Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")), // index on for batch query
+		index.Fields("source_id"), // followed by paths and closures
 	}
 }
@@ -0,0 +1,81 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CertifyPolicy holds the schema definition for the CertifyPolicy entity.
+type CertifyPolicy struct {
+	ent.Schema
+}
+
+// Annotations of the CertifyPolicy.
+func (CertifyPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "certify_policies"},
+	}
+}
+
+// Mixin of the CertifyPolicy.
+func (CertifyPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the CertifyPolicy.
+func (CertifyPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(getUUIDv7).
+			Unique().
+			Immutable(),
+		field.UUID("artifact_id", getUUIDv7()).Comment("ID of the verified artifact"),
+		field.String("verifier").Comment("URI identifying the verifier"),
+		field.String("policy_uri").Comment("URI of the policy the artifact was verified against"),
+		field.String("policy_digest").Comment("Digest of the policy"),
+		field.Enum("result").Values("PASSED", "FAILED"),
+		field.Strings("verified_levels").Optional().Comment("SLSA levels the artifact was verified at"),
+		field.Time("time_verified"),
+		field.String("origin"),
+		field.String("collector"),
+		field.String("document_ref"),
+	}
+}
+
+// Edges of the CertifyPolicy.
+func (CertifyPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("artifact", Artifact.Type).Unique().Field("artifact_id").Required().Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the CertifyPolicy.
+func (CertifyPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant", "artifact_id", "verifier", "policy_uri", "policy_digest", "result", "time_verified",
+			"origin", "collector", "document_ref").Unique().StorageKey("certifypolicy_tenant_artifact_id"),
+		index.Fields("artifact_id"), // followed by paths and closures
+	}
+}
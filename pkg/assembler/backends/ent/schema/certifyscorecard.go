@@ -31,6 +31,13 @@ type CertifyScorecard struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyScorecard.
+func (CertifyScorecard) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the CertifyScorecard.
 func (CertifyScorecard) Fields() []ent.Field {
 	return []ent.Field{
@@ -59,6 +66,7 @@ func (CertifyScorecard) Edges() []ent.Edge {
 }
 func (CertifyScorecard) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("source_id", "origin", "collector", "scorecard_version", "scorecard_commit", "aggregate_score", "time_scanned", "checks_hash", "document_ref").Unique(),
+		index.Fields("tenant", "source_id", "origin", "collector", "scorecard_version", "scorecard_commit", "aggregate_score", "time_scanned", "checks_hash", "document_ref").Unique().StorageKey("certifyscorecard_tenant_source_id"),
+		index.Fields("source_id"), // followed by paths and closures
 	}
 }
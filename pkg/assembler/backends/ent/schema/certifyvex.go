@@ -29,6 +29,13 @@ type CertifyVex struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyVex.
+func (CertifyVex) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the VEX.
 func (CertifyVex) Fields() []ent.Field {
 	return []ent.Field{
@@ -68,9 +75,12 @@ func (CertifyVex) Edges() []ent.Edge {
 // Indexes of the VEX.
 func (CertifyVex) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("known_since", "justification", "status", "origin", "collector", "document_ref").
+		index.Fields("tenant", "known_since", "justification", "status", "origin", "collector", "document_ref").
 			Edges("vulnerability", "package").Unique().Annotations(entsql.IndexWhere("artifact_id IS NULL")).StorageKey("vex_artifact_id"),
-		index.Fields("known_since", "justification", "status", "origin", "collector", "document_ref").
+		index.Fields("tenant", "known_since", "justification", "status", "origin", "collector", "document_ref").
 			Edges("vulnerability", "artifact").Unique().Annotations(entsql.IndexWhere("package_id IS NULL")).StorageKey("vex_package_id"),
+		index.Fields("package_id"),       // followed by paths and closures
+		index.Fields("artifact_id"),      // followed by paths and closures
+		index.Fields("vulnerability_id"), // followed by paths and closures
 	}
 }
@@ -29,6 +29,13 @@ type CertifyVuln struct {
 	ent.Schema
 }
 
+// Mixin of the CertifyVuln.
+func (CertifyVuln) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Vulnerability.
 func (CertifyVuln) Fields() []ent.Field {
 	return []ent.Field{
@@ -60,7 +67,7 @@ func (CertifyVuln) Edges() []ent.Edge {
 // Indexes of the Vulnerability.
 func (CertifyVuln) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("db_uri", "db_version", "scanner_uri", "scanner_version", "origin", "collector", "time_scanned", "document_ref").Edges("vulnerability", "package").Unique(),
+		index.Fields("tenant", "db_uri", "db_version", "scanner_uri", "scanner_version", "origin", "collector", "time_scanned", "document_ref").Edges("vulnerability", "package").Unique().StorageKey("certifyvuln_tenant_package_id_vulnerability_id"),
 		index.Fields("package_id"),                                     // speed up frequently run queries to check when CV nodes affect certain package IDs
 		index.Fields("vulnerability_id"),                               // speed up frequently run queries to check when CV nodes have a vulnerability
 		index.Fields("vulnerability_id", "package_id", "time_scanned"), // index on for batch query
@@ -38,6 +38,13 @@ func (Dependency) Annotations() []schema.Annotation {
 	}
 }
 
+// Mixin of the Dependency.
+func (Dependency) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Dependency.
 func (Dependency) Fields() []ent.Field {
 	return []ent.Field{
@@ -48,6 +55,7 @@ func (Dependency) Fields() []ent.Field {
 		field.UUID("package_id", getUUIDv7()),
 		field.UUID("dependent_package_version_id", getUUIDv7()),
 		field.Enum("dependency_type").Values(model.DependencyTypeDirect.String(), model.DependencyTypeIndirect.String(), model.DependencyTypeUnknown.String()),
+		field.String("version_range").Default(""),
 		field.String("justification"),
 		field.String("origin"),
 		field.String("collector"),
@@ -73,7 +81,7 @@ func (Dependency) Edges() []ent.Edge {
 // Indexes of the Dependency.
 func (Dependency) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("dependency_type", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id").Unique(),
+		index.Fields("tenant", "dependency_type", "version_range", "justification", "origin", "collector", "document_ref", "package_id", "dependent_package_version_id").Unique().StorageKey("dependency_tenant_package_id_dependent_package_version_id"),
 		index.Fields("package_id"),                   // speed up frequently run queries to check for deps with a certain package ID
 		index.Fields("dependent_package_version_id"), // query via the dependent package ID
 	}
@@ -28,6 +28,13 @@ type HashEqual struct {
 	ent.Schema
 }
 
+// Mixin of the HashEqual.
+func (HashEqual) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the HashEqual.
 func (HashEqual) Fields() []ent.Field {
 	return []ent.Field{
@@ -56,6 +63,8 @@ func (HashEqual) Edges() []ent.Edge {
 // Indexes of the HashEqual.
 func (HashEqual) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("art_id", "equal_art_id", "artifacts_hash", "origin", "justification", "collector", "document_ref").Unique(),
+		index.Fields("tenant", "art_id", "equal_art_id", "artifacts_hash", "origin", "justification", "collector", "document_ref").Unique().StorageKey("hashequal_tenant_art_id_equal_art_id"),
+		index.Fields("art_id"),       // followed by paths and closures
+		index.Fields("equal_art_id"), // followed by paths and closures
 	}
 }
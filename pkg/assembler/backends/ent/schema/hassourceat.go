@@ -29,6 +29,13 @@ type HasSourceAt struct {
 	ent.Schema
 }
 
+// Mixin of the HasSourceAt.
+func (HasSourceAt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the HasSourceAt.
 func (HasSourceAt) Fields() []ent.Field {
 	return []ent.Field{
@@ -58,7 +65,10 @@ func (HasSourceAt) Edges() []ent.Edge {
 
 func (HasSourceAt) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("source_id", "package_version_id", "justification", "origin", "collector", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("package_version_id IS NOT NULL AND package_name_id IS NULL")),
-		index.Fields("source_id", "package_name_id", "justification", "origin", "collector", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("package_name_id IS NOT NULL AND package_version_id IS NULL")),
+		index.Fields("tenant", "source_id", "package_version_id", "justification", "origin", "collector", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("package_version_id IS NOT NULL AND package_name_id IS NULL")).StorageKey("hassourceat_tenant_package_version_id"),
+		index.Fields("tenant", "source_id", "package_name_id", "justification", "origin", "collector", "known_since", "document_ref").Unique().Annotations(entsql.IndexWhere("package_name_id IS NOT NULL AND package_version_id IS NULL")).StorageKey("hassourceat_tenant_package_name_id"),
+		index.Fields("package_version_id"), // followed by paths and closures
+		index.Fields("package_name_id"),    // followed by paths and closures
+		index.Fields("source_id"),          // followed by paths and closures
 	}
 }
@@ -35,6 +35,13 @@ type Occurrence struct {
 // 	}
 // }
 
+// Mixin of the Occurrence.
+func (Occurrence) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the Occurrence.
 func (Occurrence) Fields() []ent.Field {
 	return []ent.Field{
@@ -65,11 +72,12 @@ func (Occurrence) Edges() []ent.Edge {
 // Indexes of the Occurrence.
 func (Occurrence) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("justification", "origin", "collector", "document_ref").Edges("artifact", "package").Unique().
+		index.Fields("tenant", "justification", "origin", "collector", "document_ref").Edges("artifact", "package").Unique().
 			Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")).StorageKey("occurrence_package_id"),
-		index.Fields("justification", "origin", "collector", "document_ref").Edges("artifact", "source").Unique().
+		index.Fields("tenant", "justification", "origin", "collector", "document_ref").Edges("artifact", "source").Unique().
 			Annotations(entsql.IndexWhere("package_id IS NULL AND source_id IS NOT NULL")).StorageKey("occurrence_source_id"),
 		index.Fields("package_id").Annotations(entsql.IndexWhere("package_id IS NOT NULL AND source_id IS NULL")).StorageKey("query_occurrence_package_id"), //querying subject - package ID
-		index.Fields("artifact_id"), //querying object - artifact ID
+		index.Fields("artifact_id"),                                        //querying object - artifact ID
+		index.Fields("source_id").StorageKey("query_occurrence_source_id"), // followed by paths and closures
 	}
 }
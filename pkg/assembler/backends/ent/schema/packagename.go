@@ -50,6 +50,7 @@ func (PackageName) Edges() []ent.Edge {
 		edge.From("certification", Certification.Type).Ref("all_versions"),
 		edge.From("metadata", HasMetadata.Type).Ref("all_versions"),
 		edge.From("poc", PointOfContact.Type).Ref("all_versions"),
+		edge.From("vulnerability_ranges", VulnerabilityRange.Type).Ref("package"),
 	}
 }
 
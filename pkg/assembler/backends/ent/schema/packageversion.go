@@ -76,5 +76,6 @@ func (PackageVersion) Indexes() []ent.Index {
 			entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"}),
 		),
 		index.Fields("version", "subpath", "qualifiers").Edges("name").Unique(),
+		index.Fields("name_id"), // followed by paths and closures
 	}
 }
@@ -34,6 +34,13 @@ type PkgEqual struct {
 // 	}
 // }
 
+// Mixin of the PkgEqual.
+func (PkgEqual) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the PkgEqual.
 func (PkgEqual) Fields() []ent.Field {
 	return []ent.Field{
@@ -62,6 +69,8 @@ func (PkgEqual) Edges() []ent.Edge {
 // Indexes of the PkgEqual.
 func (PkgEqual) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("pkg_id", "equal_pkg_id", "packages_hash", "origin", "justification", "collector", "document_ref").Unique(),
+		index.Fields("tenant", "pkg_id", "equal_pkg_id", "packages_hash", "origin", "justification", "collector", "document_ref").Unique().StorageKey("pkgequal_tenant_pkg_id_equal_pkg_id"),
+		index.Fields("pkg_id"),       // followed by paths and closures
+		index.Fields("equal_pkg_id"), // followed by paths and closures
 	}
 }
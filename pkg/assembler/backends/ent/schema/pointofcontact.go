@@ -29,6 +29,13 @@ type PointOfContact struct {
 	ent.Schema
 }
 
+// Mixin of the PointOfContact.
+func (PointOfContact) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the PointOfContact.
 func (PointOfContact) Fields() []ent.Field {
 	return []ent.Field{
@@ -62,17 +69,21 @@ func (PointOfContact) Edges() []ent.Edge {
 
 func (PointOfContact) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("since", "email", "info", "justification", "origin", "collector", "document_ref", "source_id").Unique().
+		index.Fields("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "source_id").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NOT NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NULL")).
 			StorageKey("poc_source_id"),
-		index.Fields("since", "email", "info", "justification", "origin", "collector", "document_ref", "package_version_id").Unique().
+		index.Fields("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "package_version_id").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NOT NULL AND package_name_id IS NULL AND artifact_id IS NULL")).
 			StorageKey("poc_package_version_id"),
-		index.Fields("since", "email", "info", "justification", "origin", "collector", "document_ref", "package_name_id").Unique().
+		index.Fields("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "package_name_id").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NOT NULL AND artifact_id IS NULL")).
 			StorageKey("poc_package_name_id"),
-		index.Fields("since", "email", "info", "justification", "origin", "collector", "document_ref", "artifact_id").Unique().
+		index.Fields("tenant", "since", "email", "info", "justification", "origin", "collector", "document_ref", "artifact_id").Unique().
 			Annotations(entsql.IndexWhere("source_id IS NULL AND package_version_id IS NULL AND package_name_id IS NULL AND artifact_id IS NOT NULL")).
 			StorageKey("poc_artifact_id"),
+		index.Fields("package_version_id"), // followed by paths and closures
+		index.Fields("package_name_id"),    // followed by paths and closures
+		index.Fields("source_id"),          // followed by paths and closures
+		index.Fields("artifact_id"),        // followed by paths and closures
 	}
 }
@@ -38,6 +38,13 @@ func (SLSAAttestation) Annotations() []schema.Annotation {
 	}
 }
 
+// Mixin of the SLSAAttestation.
+func (SLSAAttestation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
 // Fields of the SLSA.
 func (SLSAAttestation) Fields() []ent.Field {
 	return []ent.Field{
@@ -72,7 +79,9 @@ func (SLSAAttestation) Edges() []ent.Edge {
 
 func (SLSAAttestation) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("subject_id", "origin", "collector", "document_ref", "build_type",
-			"slsa_version", "built_by_id", "built_from_hash", "started_on", "finished_on").Unique(),
+		index.Fields("tenant", "subject_id", "origin", "collector", "document_ref", "build_type",
+			"slsa_version", "built_by_id", "built_from_hash", "started_on", "finished_on").Unique().StorageKey("slsaattestation_tenant_subject_id"),
+		index.Fields("subject_id"),  // followed by paths and closures
+		index.Fields("built_by_id"), // followed by paths and closures
 	}
 }
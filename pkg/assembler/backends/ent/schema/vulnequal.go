@@ -57,5 +57,6 @@ func (VulnEqual) Edges() []ent.Edge {
 func (VulnEqual) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("vuln_id", "equal_vuln_id", "vulnerabilities_hash", "justification", "origin", "collector", "document_ref").Unique(),
+		index.Fields("equal_vuln_id"), // followed by paths and closures
 	}
 }
@@ -48,6 +48,7 @@ func (VulnerabilityID) Edges() []ent.Edge {
 		edge.From("metadata", VulnerabilityMetadata.Type).Ref("vulnerability_id"),
 		edge.From("certify_vuln", CertifyVuln.Type).Ref("vulnerability"),
 		edge.From("vex", CertifyVex.Type).Ref("vulnerability"),
+		edge.From("vulnerability_ranges", VulnerabilityRange.Type).Ref("vulnerability"),
 	}
 }
 
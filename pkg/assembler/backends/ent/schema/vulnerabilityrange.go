@@ -0,0 +1,82 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// VulnerabilityRange holds the schema definition for the VulnerabilityRange entity.
+type VulnerabilityRange struct {
+	ent.Schema
+}
+
+// Annotations of the VulnerabilityRange.
+func (VulnerabilityRange) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vulnerability_ranges"},
+	}
+}
+
+// Mixin of the VulnerabilityRange.
+func (VulnerabilityRange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TenantMixin{},
+	}
+}
+
+// Fields of the VulnerabilityRange.
+func (VulnerabilityRange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(getUUIDv7).
+			Unique().
+			Immutable(),
+		field.UUID("vulnerability_id", getUUIDv7()),
+		field.UUID("package_name_id", getUUIDv7()).Comment("ID of the affected package name"),
+		field.Enum("range_type").Values("SEMVER", "ECOSYSTEM", "GIT"),
+		field.String("introduced").Comment("First affected version or commit"),
+		field.String("fixed").Comment("First version or commit that is no longer affected, empty if not fixed"),
+		field.String("last_affected").Comment("Last affected version or commit, empty if not known"),
+		field.String("origin"),
+		field.String("collector"),
+		field.String("document_ref"),
+	}
+}
+
+// Edges of the VulnerabilityRange.
+func (VulnerabilityRange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("vulnerability", VulnerabilityID.Type).Unique().Field("vulnerability_id").Required().Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("package", PackageName.Type).Unique().Field("package_name_id").Required().Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the VulnerabilityRange.
+func (VulnerabilityRange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant", "vulnerability_id", "package_name_id", "range_type", "introduced", "fixed", "last_affected",
+			"origin", "collector", "document_ref").Unique().StorageKey("vulnerabilityrange_tenant_vulnerability_id_package_name_id"),
+		index.Fields("vulnerability_id"), // followed by paths and closures
+		index.Fields("package_name_id"),  // followed by paths and closures
+	}
+}
@@ -22,6 +22,8 @@ type SLSAAttestation struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
 	// Type of the builder
 	BuildType string `json:"build_type,omitempty"`
 	// ID of the builder
@@ -105,7 +107,7 @@ func (*SLSAAttestation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case slsaattestation.FieldSlsaPredicate:
 			values[i] = new([]byte)
-		case slsaattestation.FieldBuildType, slsaattestation.FieldSlsaVersion, slsaattestation.FieldOrigin, slsaattestation.FieldCollector, slsaattestation.FieldDocumentRef, slsaattestation.FieldBuiltFromHash:
+		case slsaattestation.FieldTenant, slsaattestation.FieldBuildType, slsaattestation.FieldSlsaVersion, slsaattestation.FieldOrigin, slsaattestation.FieldCollector, slsaattestation.FieldDocumentRef, slsaattestation.FieldBuiltFromHash:
 			values[i] = new(sql.NullString)
 		case slsaattestation.FieldStartedOn, slsaattestation.FieldFinishedOn:
 			values[i] = new(sql.NullTime)
@@ -132,6 +134,12 @@ func (sa *SLSAAttestation) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				sa.ID = *value
 			}
+		case slsaattestation.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				sa.Tenant = value.String
+			}
 		case slsaattestation.FieldBuildType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field build_type", values[i])
@@ -251,6 +259,9 @@ func (sa *SLSAAttestation) String() string {
 	var builder strings.Builder
 	builder.WriteString("SLSAAttestation(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(sa.Tenant)
+	builder.WriteString(", ")
 	builder.WriteString("build_type=")
 	builder.WriteString(sa.BuildType)
 	builder.WriteString(", ")
@@ -13,6 +13,8 @@ const (
 	Label = "slsa_attestation"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
 	// FieldBuildType holds the string denoting the build_type field in the database.
 	FieldBuildType = "build_type"
 	// FieldBuiltByID holds the string denoting the built_by_id field in the database.
@@ -67,6 +69,7 @@ const (
 // Columns holds all SQL columns for slsaattestation fields.
 var Columns = []string{
 	FieldID,
+	FieldTenant,
 	FieldBuildType,
 	FieldBuiltByID,
 	FieldSubjectID,
@@ -97,6 +100,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -109,6 +114,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
 // ByBuildType orders the results by the build_type field.
 func ByBuildType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBuildType, opts...).ToFunc()
@@ -374,12 +374,12 @@ func (saq *SLSAAttestationQuery) WithSubject(opts ...func(*ArtifactQuery)) *SLSA
 // Example:
 //
 //	var v []struct {
-//		BuildType string `json:"build_type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.SLSAAttestation.Query().
-//		GroupBy(slsaattestation.FieldBuildType).
+//		GroupBy(slsaattestation.FieldTenant).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (saq *SLSAAttestationQuery) GroupBy(field string, fields ...string) *SLSAAttestationGroupBy {
@@ -397,11 +397,11 @@ func (saq *SLSAAttestationQuery) GroupBy(field string, fields ...string) *SLSAAt
 // Example:
 //
 //	var v []struct {
-//		BuildType string `json:"build_type,omitempty"`
+//		Tenant string `json:"tenant,omitempty"`
 //	}
 //
 //	client.SLSAAttestation.Query().
-//		Select(slsaattestation.FieldBuildType).
+//		Select(slsaattestation.FieldTenant).
 //		Scan(ctx, &v)
 func (saq *SLSAAttestationQuery) Select(fields ...string) *SLSAAttestationSelect {
 	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -26,6 +28,8 @@ type Tx struct {
 	Certification *CertificationClient
 	// CertifyLegal is the client for interacting with the CertifyLegal builders.
 	CertifyLegal *CertifyLegalClient
+	// CertifyPolicy is the client for interacting with the CertifyPolicy builders.
+	CertifyPolicy *CertifyPolicyClient
 	// CertifyScorecard is the client for interacting with the CertifyScorecard builders.
 	CertifyScorecard *CertifyScorecardClient
 	// CertifyVex is the client for interacting with the CertifyVex builders.
@@ -80,6 +84,8 @@ type Tx struct {
 	VulnerabilityID *VulnerabilityIDClient
 	// VulnerabilityMetadata is the client for interacting with the VulnerabilityMetadata builders.
 	VulnerabilityMetadata *VulnerabilityMetadataClient
+	// VulnerabilityRange is the client for interacting with the VulnerabilityRange builders.
+	VulnerabilityRange *VulnerabilityRangeClient
 
 	// lazily loaded.
 	client     *Client
@@ -218,6 +224,7 @@ func (tx *Tx) init() {
 	tx.CWE = NewCWEClient(tx.config)
 	tx.Certification = NewCertificationClient(tx.config)
 	tx.CertifyLegal = NewCertifyLegalClient(tx.config)
+	tx.CertifyPolicy = NewCertifyPolicyClient(tx.config)
 	tx.CertifyScorecard = NewCertifyScorecardClient(tx.config)
 	tx.CertifyVex = NewCertifyVexClient(tx.config)
 	tx.CertifyVuln = NewCertifyVulnClient(tx.config)
@@ -245,6 +252,7 @@ func (tx *Tx) init() {
 	tx.VulnEqual = NewVulnEqualClient(tx.config)
 	tx.VulnerabilityID = NewVulnerabilityIDClient(tx.config)
 	tx.VulnerabilityMetadata = NewVulnerabilityMetadataClient(tx.config)
+	tx.VulnerabilityRange = NewVulnerabilityRangeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -307,3 +315,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
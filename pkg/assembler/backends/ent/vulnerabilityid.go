@@ -39,17 +39,20 @@ type VulnerabilityIDEdges struct {
 	CertifyVuln []*CertifyVuln `json:"certify_vuln,omitempty"`
 	// Vex holds the value of the vex edge.
 	Vex []*CertifyVex `json:"vex,omitempty"`
+	// VulnerabilityRanges holds the value of the vulnerability_ranges edge.
+	VulnerabilityRanges []*VulnerabilityRange `json:"vulnerability_ranges,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 	// totalCount holds the count of the edges above.
-	totalCount [5]map[string]int
-
-	namedVulnEqualVulnA map[string][]*VulnEqual
-	namedVulnEqualVulnB map[string][]*VulnEqual
-	namedMetadata       map[string][]*VulnerabilityMetadata
-	namedCertifyVuln    map[string][]*CertifyVuln
-	namedVex            map[string][]*CertifyVex
+	totalCount [6]map[string]int
+
+	namedVulnEqualVulnA      map[string][]*VulnEqual
+	namedVulnEqualVulnB      map[string][]*VulnEqual
+	namedMetadata            map[string][]*VulnerabilityMetadata
+	namedCertifyVuln         map[string][]*CertifyVuln
+	namedVex                 map[string][]*CertifyVex
+	namedVulnerabilityRanges map[string][]*VulnerabilityRange
 }
 
 // VulnEqualVulnAOrErr returns the VulnEqualVulnA value or an error if the edge
@@ -97,6 +100,15 @@ func (e VulnerabilityIDEdges) VexOrErr() ([]*CertifyVex, error) {
 	return nil, &NotLoadedError{edge: "vex"}
 }
 
+// VulnerabilityRangesOrErr returns the VulnerabilityRanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e VulnerabilityIDEdges) VulnerabilityRangesOrErr() ([]*VulnerabilityRange, error) {
+	if e.loadedTypes[5] {
+		return e.VulnerabilityRanges, nil
+	}
+	return nil, &NotLoadedError{edge: "vulnerability_ranges"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*VulnerabilityID) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -177,6 +189,11 @@ func (vi *VulnerabilityID) QueryVex() *CertifyVexQuery {
 	return NewVulnerabilityIDClient(vi.config).QueryVex(vi)
 }
 
+// QueryVulnerabilityRanges queries the "vulnerability_ranges" edge of the VulnerabilityID entity.
+func (vi *VulnerabilityID) QueryVulnerabilityRanges() *VulnerabilityRangeQuery {
+	return NewVulnerabilityIDClient(vi.config).QueryVulnerabilityRanges(vi)
+}
+
 // Update returns a builder for updating this VulnerabilityID.
 // Note that you need to call VulnerabilityID.Unwrap() before calling this method if this VulnerabilityID
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -329,5 +346,29 @@ func (vi *VulnerabilityID) appendNamedVex(name string, edges ...*CertifyVex) {
 	}
 }
 
+// NamedVulnerabilityRanges returns the VulnerabilityRanges named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (vi *VulnerabilityID) NamedVulnerabilityRanges(name string) ([]*VulnerabilityRange, error) {
+	if vi.Edges.namedVulnerabilityRanges == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := vi.Edges.namedVulnerabilityRanges[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (vi *VulnerabilityID) appendNamedVulnerabilityRanges(name string, edges ...*VulnerabilityRange) {
+	if vi.Edges.namedVulnerabilityRanges == nil {
+		vi.Edges.namedVulnerabilityRanges = make(map[string][]*VulnerabilityRange)
+	}
+	if len(edges) == 0 {
+		vi.Edges.namedVulnerabilityRanges[name] = []*VulnerabilityRange{}
+	} else {
+		vi.Edges.namedVulnerabilityRanges[name] = append(vi.Edges.namedVulnerabilityRanges[name], edges...)
+	}
+}
+
 // VulnerabilityIDs is a parsable slice of VulnerabilityID.
 type VulnerabilityIDs []*VulnerabilityID
@@ -27,6 +27,8 @@ const (
 	EdgeCertifyVuln = "certify_vuln"
 	// EdgeVex holds the string denoting the vex edge name in mutations.
 	EdgeVex = "vex"
+	// EdgeVulnerabilityRanges holds the string denoting the vulnerability_ranges edge name in mutations.
+	EdgeVulnerabilityRanges = "vulnerability_ranges"
 	// Table holds the table name of the vulnerabilityid in the database.
 	Table = "vulnerability_ids"
 	// VulnEqualVulnATable is the table that holds the vuln_equal_vuln_a relation/edge.
@@ -64,6 +66,13 @@ const (
 	VexInverseTable = "certify_vexes"
 	// VexColumn is the table column denoting the vex relation/edge.
 	VexColumn = "vulnerability_id"
+	// VulnerabilityRangesTable is the table that holds the vulnerability_ranges relation/edge.
+	VulnerabilityRangesTable = "vulnerability_ranges"
+	// VulnerabilityRangesInverseTable is the table name for the VulnerabilityRange entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityrange" package.
+	VulnerabilityRangesInverseTable = "vulnerability_ranges"
+	// VulnerabilityRangesColumn is the table column denoting the vulnerability_ranges relation/edge.
+	VulnerabilityRangesColumn = "vulnerability_id"
 )
 
 // Columns holds all SQL columns for vulnerabilityid fields.
@@ -177,6 +186,20 @@ func ByVex(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVexStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVulnerabilityRangesCount orders the results by vulnerability_ranges count.
+func ByVulnerabilityRangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVulnerabilityRangesStep(), opts...)
+	}
+}
+
+// ByVulnerabilityRanges orders the results by vulnerability_ranges terms.
+func ByVulnerabilityRanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVulnerabilityRangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVulnEqualVulnAStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -212,3 +235,10 @@ func newVexStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, VexTable, VexColumn),
 	)
 }
+func newVulnerabilityRangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VulnerabilityRangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, VulnerabilityRangesTable, VulnerabilityRangesColumn),
+	)
+}
@@ -309,6 +309,29 @@ func HasVexWith(preds ...predicate.CertifyVex) predicate.VulnerabilityID {
 	})
 }
 
+// HasVulnerabilityRanges applies the HasEdge predicate on the "vulnerability_ranges" edge.
+func HasVulnerabilityRanges() predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, VulnerabilityRangesTable, VulnerabilityRangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVulnerabilityRangesWith applies the HasEdge predicate on the "vulnerability_ranges" edge with a given conditions (other predicates).
+func HasVulnerabilityRangesWith(preds ...predicate.VulnerabilityRange) predicate.VulnerabilityID {
+	return predicate.VulnerabilityID(func(s *sql.Selector) {
+		step := newVulnerabilityRangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.VulnerabilityID) predicate.VulnerabilityID {
 	return predicate.VulnerabilityID(sql.AndPredicates(predicates...))
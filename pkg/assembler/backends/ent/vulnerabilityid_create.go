@@ -17,6 +17,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // VulnerabilityIDCreate is the builder for creating a VulnerabilityID entity.
@@ -128,6 +129,21 @@ func (vic *VulnerabilityIDCreate) AddVex(c ...*CertifyVex) *VulnerabilityIDCreat
 	return vic.AddVexIDs(ids...)
 }
 
+// AddVulnerabilityRangeIDs adds the "vulnerability_ranges" edge to the VulnerabilityRange entity by IDs.
+func (vic *VulnerabilityIDCreate) AddVulnerabilityRangeIDs(ids ...uuid.UUID) *VulnerabilityIDCreate {
+	vic.mutation.AddVulnerabilityRangeIDs(ids...)
+	return vic
+}
+
+// AddVulnerabilityRanges adds the "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (vic *VulnerabilityIDCreate) AddVulnerabilityRanges(v ...*VulnerabilityRange) *VulnerabilityIDCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vic.AddVulnerabilityRangeIDs(ids...)
+}
+
 // Mutation returns the VulnerabilityIDMutation object of the builder.
 func (vic *VulnerabilityIDCreate) Mutation() *VulnerabilityIDMutation {
 	return vic.mutation
@@ -306,6 +322,22 @@ func (vic *VulnerabilityIDCreate) createSpec() (*VulnerabilityID, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := vic.mutation.VulnerabilityRangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
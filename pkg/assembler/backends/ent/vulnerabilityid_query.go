@@ -19,27 +19,30 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // VulnerabilityIDQuery is the builder for querying VulnerabilityID entities.
 type VulnerabilityIDQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []vulnerabilityid.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.VulnerabilityID
-	withVulnEqualVulnA      *VulnEqualQuery
-	withVulnEqualVulnB      *VulnEqualQuery
-	withMetadata            *VulnerabilityMetadataQuery
-	withCertifyVuln         *CertifyVulnQuery
-	withVex                 *CertifyVexQuery
-	modifiers               []func(*sql.Selector)
-	loadTotal               []func(context.Context, []*VulnerabilityID) error
-	withNamedVulnEqualVulnA map[string]*VulnEqualQuery
-	withNamedVulnEqualVulnB map[string]*VulnEqualQuery
-	withNamedMetadata       map[string]*VulnerabilityMetadataQuery
-	withNamedCertifyVuln    map[string]*CertifyVulnQuery
-	withNamedVex            map[string]*CertifyVexQuery
+	ctx                          *QueryContext
+	order                        []vulnerabilityid.OrderOption
+	inters                       []Interceptor
+	predicates                   []predicate.VulnerabilityID
+	withVulnEqualVulnA           *VulnEqualQuery
+	withVulnEqualVulnB           *VulnEqualQuery
+	withMetadata                 *VulnerabilityMetadataQuery
+	withCertifyVuln              *CertifyVulnQuery
+	withVex                      *CertifyVexQuery
+	withVulnerabilityRanges      *VulnerabilityRangeQuery
+	modifiers                    []func(*sql.Selector)
+	loadTotal                    []func(context.Context, []*VulnerabilityID) error
+	withNamedVulnEqualVulnA      map[string]*VulnEqualQuery
+	withNamedVulnEqualVulnB      map[string]*VulnEqualQuery
+	withNamedMetadata            map[string]*VulnerabilityMetadataQuery
+	withNamedCertifyVuln         map[string]*CertifyVulnQuery
+	withNamedVex                 map[string]*CertifyVexQuery
+	withNamedVulnerabilityRanges map[string]*VulnerabilityRangeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -186,6 +189,28 @@ func (viq *VulnerabilityIDQuery) QueryVex() *CertifyVexQuery {
 	return query
 }
 
+// QueryVulnerabilityRanges chains the current query on the "vulnerability_ranges" edge.
+func (viq *VulnerabilityIDQuery) QueryVulnerabilityRanges() *VulnerabilityRangeQuery {
+	query := (&VulnerabilityRangeClient{config: viq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := viq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := viq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityid.Table, vulnerabilityid.FieldID, selector),
+			sqlgraph.To(vulnerabilityrange.Table, vulnerabilityrange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, vulnerabilityid.VulnerabilityRangesTable, vulnerabilityid.VulnerabilityRangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(viq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first VulnerabilityID entity from the query.
 // Returns a *NotFoundError when no VulnerabilityID was found.
 func (viq *VulnerabilityIDQuery) First(ctx context.Context) (*VulnerabilityID, error) {
@@ -373,16 +398,17 @@ func (viq *VulnerabilityIDQuery) Clone() *VulnerabilityIDQuery {
 		return nil
 	}
 	return &VulnerabilityIDQuery{
-		config:             viq.config,
-		ctx:                viq.ctx.Clone(),
-		order:              append([]vulnerabilityid.OrderOption{}, viq.order...),
-		inters:             append([]Interceptor{}, viq.inters...),
-		predicates:         append([]predicate.VulnerabilityID{}, viq.predicates...),
-		withVulnEqualVulnA: viq.withVulnEqualVulnA.Clone(),
-		withVulnEqualVulnB: viq.withVulnEqualVulnB.Clone(),
-		withMetadata:       viq.withMetadata.Clone(),
-		withCertifyVuln:    viq.withCertifyVuln.Clone(),
-		withVex:            viq.withVex.Clone(),
+		config:                  viq.config,
+		ctx:                     viq.ctx.Clone(),
+		order:                   append([]vulnerabilityid.OrderOption{}, viq.order...),
+		inters:                  append([]Interceptor{}, viq.inters...),
+		predicates:              append([]predicate.VulnerabilityID{}, viq.predicates...),
+		withVulnEqualVulnA:      viq.withVulnEqualVulnA.Clone(),
+		withVulnEqualVulnB:      viq.withVulnEqualVulnB.Clone(),
+		withMetadata:            viq.withMetadata.Clone(),
+		withCertifyVuln:         viq.withCertifyVuln.Clone(),
+		withVex:                 viq.withVex.Clone(),
+		withVulnerabilityRanges: viq.withVulnerabilityRanges.Clone(),
 		// clone intermediate query.
 		sql:  viq.sql.Clone(),
 		path: viq.path,
@@ -444,6 +470,17 @@ func (viq *VulnerabilityIDQuery) WithVex(opts ...func(*CertifyVexQuery)) *Vulner
 	return viq
 }
 
+// WithVulnerabilityRanges tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability_ranges" edge. The optional arguments are used to configure the query builder of the edge.
+func (viq *VulnerabilityIDQuery) WithVulnerabilityRanges(opts ...func(*VulnerabilityRangeQuery)) *VulnerabilityIDQuery {
+	query := (&VulnerabilityRangeClient{config: viq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	viq.withVulnerabilityRanges = query
+	return viq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -522,12 +559,13 @@ func (viq *VulnerabilityIDQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*VulnerabilityID{}
 		_spec       = viq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			viq.withVulnEqualVulnA != nil,
 			viq.withVulnEqualVulnB != nil,
 			viq.withMetadata != nil,
 			viq.withCertifyVuln != nil,
 			viq.withVex != nil,
+			viq.withVulnerabilityRanges != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -586,6 +624,15 @@ func (viq *VulnerabilityIDQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := viq.withVulnerabilityRanges; query != nil {
+		if err := viq.loadVulnerabilityRanges(ctx, query, nodes,
+			func(n *VulnerabilityID) { n.Edges.VulnerabilityRanges = []*VulnerabilityRange{} },
+			func(n *VulnerabilityID, e *VulnerabilityRange) {
+				n.Edges.VulnerabilityRanges = append(n.Edges.VulnerabilityRanges, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range viq.withNamedVulnEqualVulnA {
 		if err := viq.loadVulnEqualVulnA(ctx, query, nodes,
 			func(n *VulnerabilityID) { n.appendNamedVulnEqualVulnA(name) },
@@ -621,6 +668,13 @@ func (viq *VulnerabilityIDQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	for name, query := range viq.withNamedVulnerabilityRanges {
+		if err := viq.loadVulnerabilityRanges(ctx, query, nodes,
+			func(n *VulnerabilityID) { n.appendNamedVulnerabilityRanges(name) },
+			func(n *VulnerabilityID, e *VulnerabilityRange) { n.appendNamedVulnerabilityRanges(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range viq.loadTotal {
 		if err := viq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -780,6 +834,36 @@ func (viq *VulnerabilityIDQuery) loadVex(ctx context.Context, query *CertifyVexQ
 	}
 	return nil
 }
+func (viq *VulnerabilityIDQuery) loadVulnerabilityRanges(ctx context.Context, query *VulnerabilityRangeQuery, nodes []*VulnerabilityID, init func(*VulnerabilityID), assign func(*VulnerabilityID, *VulnerabilityRange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*VulnerabilityID)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(vulnerabilityrange.FieldVulnerabilityID)
+	}
+	query.Where(predicate.VulnerabilityRange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vulnerabilityid.VulnerabilityRangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VulnerabilityID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vulnerability_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (viq *VulnerabilityIDQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := viq.querySpec()
@@ -935,6 +1019,20 @@ func (viq *VulnerabilityIDQuery) WithNamedVex(name string, opts ...func(*Certify
 	return viq
 }
 
+// WithNamedVulnerabilityRanges tells the query-builder to eager-load the nodes that are connected to the "vulnerability_ranges"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (viq *VulnerabilityIDQuery) WithNamedVulnerabilityRanges(name string, opts ...func(*VulnerabilityRangeQuery)) *VulnerabilityIDQuery {
+	query := (&VulnerabilityRangeClient{config: viq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if viq.withNamedVulnerabilityRanges == nil {
+		viq.withNamedVulnerabilityRanges = make(map[string]*VulnerabilityRangeQuery)
+	}
+	viq.withNamedVulnerabilityRanges[name] = query
+	return viq
+}
+
 // VulnerabilityIDGroupBy is the group-by builder for VulnerabilityID entities.
 type VulnerabilityIDGroupBy struct {
 	selector
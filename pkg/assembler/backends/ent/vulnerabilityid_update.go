@@ -17,6 +17,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
 )
 
 // VulnerabilityIDUpdate is the builder for updating VulnerabilityID entities.
@@ -135,6 +136,21 @@ func (viu *VulnerabilityIDUpdate) AddVex(c ...*CertifyVex) *VulnerabilityIDUpdat
 	return viu.AddVexIDs(ids...)
 }
 
+// AddVulnerabilityRangeIDs adds the "vulnerability_ranges" edge to the VulnerabilityRange entity by IDs.
+func (viu *VulnerabilityIDUpdate) AddVulnerabilityRangeIDs(ids ...uuid.UUID) *VulnerabilityIDUpdate {
+	viu.mutation.AddVulnerabilityRangeIDs(ids...)
+	return viu
+}
+
+// AddVulnerabilityRanges adds the "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (viu *VulnerabilityIDUpdate) AddVulnerabilityRanges(v ...*VulnerabilityRange) *VulnerabilityIDUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return viu.AddVulnerabilityRangeIDs(ids...)
+}
+
 // Mutation returns the VulnerabilityIDMutation object of the builder.
 func (viu *VulnerabilityIDUpdate) Mutation() *VulnerabilityIDMutation {
 	return viu.mutation
@@ -245,6 +261,27 @@ func (viu *VulnerabilityIDUpdate) RemoveVex(c ...*CertifyVex) *VulnerabilityIDUp
 	return viu.RemoveVexIDs(ids...)
 }
 
+// ClearVulnerabilityRanges clears all "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (viu *VulnerabilityIDUpdate) ClearVulnerabilityRanges() *VulnerabilityIDUpdate {
+	viu.mutation.ClearVulnerabilityRanges()
+	return viu
+}
+
+// RemoveVulnerabilityRangeIDs removes the "vulnerability_ranges" edge to VulnerabilityRange entities by IDs.
+func (viu *VulnerabilityIDUpdate) RemoveVulnerabilityRangeIDs(ids ...uuid.UUID) *VulnerabilityIDUpdate {
+	viu.mutation.RemoveVulnerabilityRangeIDs(ids...)
+	return viu
+}
+
+// RemoveVulnerabilityRanges removes "vulnerability_ranges" edges to VulnerabilityRange entities.
+func (viu *VulnerabilityIDUpdate) RemoveVulnerabilityRanges(v ...*VulnerabilityRange) *VulnerabilityIDUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return viu.RemoveVulnerabilityRangeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (viu *VulnerabilityIDUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, viu.sqlSave, viu.mutation, viu.hooks)
@@ -525,6 +562,51 @@ func (viu *VulnerabilityIDUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if viu.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viu.mutation.RemovedVulnerabilityRangesIDs(); len(nodes) > 0 && !viu.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viu.mutation.VulnerabilityRangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, viu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vulnerabilityid.Label}
@@ -648,6 +730,21 @@ func (viuo *VulnerabilityIDUpdateOne) AddVex(c ...*CertifyVex) *VulnerabilityIDU
 	return viuo.AddVexIDs(ids...)
 }
 
+// AddVulnerabilityRangeIDs adds the "vulnerability_ranges" edge to the VulnerabilityRange entity by IDs.
+func (viuo *VulnerabilityIDUpdateOne) AddVulnerabilityRangeIDs(ids ...uuid.UUID) *VulnerabilityIDUpdateOne {
+	viuo.mutation.AddVulnerabilityRangeIDs(ids...)
+	return viuo
+}
+
+// AddVulnerabilityRanges adds the "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (viuo *VulnerabilityIDUpdateOne) AddVulnerabilityRanges(v ...*VulnerabilityRange) *VulnerabilityIDUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return viuo.AddVulnerabilityRangeIDs(ids...)
+}
+
 // Mutation returns the VulnerabilityIDMutation object of the builder.
 func (viuo *VulnerabilityIDUpdateOne) Mutation() *VulnerabilityIDMutation {
 	return viuo.mutation
@@ -758,6 +855,27 @@ func (viuo *VulnerabilityIDUpdateOne) RemoveVex(c ...*CertifyVex) *Vulnerability
 	return viuo.RemoveVexIDs(ids...)
 }
 
+// ClearVulnerabilityRanges clears all "vulnerability_ranges" edges to the VulnerabilityRange entity.
+func (viuo *VulnerabilityIDUpdateOne) ClearVulnerabilityRanges() *VulnerabilityIDUpdateOne {
+	viuo.mutation.ClearVulnerabilityRanges()
+	return viuo
+}
+
+// RemoveVulnerabilityRangeIDs removes the "vulnerability_ranges" edge to VulnerabilityRange entities by IDs.
+func (viuo *VulnerabilityIDUpdateOne) RemoveVulnerabilityRangeIDs(ids ...uuid.UUID) *VulnerabilityIDUpdateOne {
+	viuo.mutation.RemoveVulnerabilityRangeIDs(ids...)
+	return viuo
+}
+
+// RemoveVulnerabilityRanges removes "vulnerability_ranges" edges to VulnerabilityRange entities.
+func (viuo *VulnerabilityIDUpdateOne) RemoveVulnerabilityRanges(v ...*VulnerabilityRange) *VulnerabilityIDUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return viuo.RemoveVulnerabilityRangeIDs(ids...)
+}
+
 // Where appends a list predicates to the VulnerabilityIDUpdate builder.
 func (viuo *VulnerabilityIDUpdateOne) Where(ps ...predicate.VulnerabilityID) *VulnerabilityIDUpdateOne {
 	viuo.mutation.Where(ps...)
@@ -1068,6 +1186,51 @@ func (viuo *VulnerabilityIDUpdateOne) sqlSave(ctx context.Context) (_node *Vulne
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if viuo.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viuo.mutation.RemovedVulnerabilityRangesIDs(); len(nodes) > 0 && !viuo.mutation.VulnerabilityRangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := viuo.mutation.VulnerabilityRangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   vulnerabilityid.VulnerabilityRangesTable,
+			Columns: []string{vulnerabilityid.VulnerabilityRangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &VulnerabilityID{config: viuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
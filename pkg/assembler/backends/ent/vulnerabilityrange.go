@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+)
+
+// VulnerabilityRange is the model entity for the VulnerabilityRange schema.
+type VulnerabilityRange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant holds the value of the "tenant" field.
+	Tenant string `json:"tenant,omitempty"`
+	// VulnerabilityID holds the value of the "vulnerability_id" field.
+	VulnerabilityID uuid.UUID `json:"vulnerability_id,omitempty"`
+	// ID of the affected package name
+	PackageNameID uuid.UUID `json:"package_name_id,omitempty"`
+	// RangeType holds the value of the "range_type" field.
+	RangeType vulnerabilityrange.RangeType `json:"range_type,omitempty"`
+	// First affected version or commit
+	Introduced string `json:"introduced,omitempty"`
+	// First version or commit that is no longer affected, empty if not fixed
+	Fixed string `json:"fixed,omitempty"`
+	// Last affected version or commit, empty if not known
+	LastAffected string `json:"last_affected,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// DocumentRef holds the value of the "document_ref" field.
+	DocumentRef string `json:"document_ref,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VulnerabilityRangeQuery when eager-loading is set.
+	Edges        VulnerabilityRangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VulnerabilityRangeEdges holds the relations/edges for other nodes in the graph.
+type VulnerabilityRangeEdges struct {
+	// Vulnerability holds the value of the vulnerability edge.
+	Vulnerability *VulnerabilityID `json:"vulnerability,omitempty"`
+	// Package holds the value of the package edge.
+	Package *PackageName `json:"package,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// VulnerabilityOrErr returns the Vulnerability value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VulnerabilityRangeEdges) VulnerabilityOrErr() (*VulnerabilityID, error) {
+	if e.Vulnerability != nil {
+		return e.Vulnerability, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vulnerabilityid.Label}
+	}
+	return nil, &NotLoadedError{edge: "vulnerability"}
+}
+
+// PackageOrErr returns the Package value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VulnerabilityRangeEdges) PackageOrErr() (*PackageName, error) {
+	if e.Package != nil {
+		return e.Package, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: packagename.Label}
+	}
+	return nil, &NotLoadedError{edge: "package"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VulnerabilityRange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vulnerabilityrange.FieldTenant, vulnerabilityrange.FieldRangeType, vulnerabilityrange.FieldIntroduced, vulnerabilityrange.FieldFixed, vulnerabilityrange.FieldLastAffected, vulnerabilityrange.FieldOrigin, vulnerabilityrange.FieldCollector, vulnerabilityrange.FieldDocumentRef:
+			values[i] = new(sql.NullString)
+		case vulnerabilityrange.FieldID, vulnerabilityrange.FieldVulnerabilityID, vulnerabilityrange.FieldPackageNameID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VulnerabilityRange fields.
+func (vr *VulnerabilityRange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vulnerabilityrange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				vr.ID = *value
+			}
+		case vulnerabilityrange.FieldTenant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant", values[i])
+			} else if value.Valid {
+				vr.Tenant = value.String
+			}
+		case vulnerabilityrange.FieldVulnerabilityID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field vulnerability_id", values[i])
+			} else if value != nil {
+				vr.VulnerabilityID = *value
+			}
+		case vulnerabilityrange.FieldPackageNameID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field package_name_id", values[i])
+			} else if value != nil {
+				vr.PackageNameID = *value
+			}
+		case vulnerabilityrange.FieldRangeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field range_type", values[i])
+			} else if value.Valid {
+				vr.RangeType = vulnerabilityrange.RangeType(value.String)
+			}
+		case vulnerabilityrange.FieldIntroduced:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field introduced", values[i])
+			} else if value.Valid {
+				vr.Introduced = value.String
+			}
+		case vulnerabilityrange.FieldFixed:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fixed", values[i])
+			} else if value.Valid {
+				vr.Fixed = value.String
+			}
+		case vulnerabilityrange.FieldLastAffected:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_affected", values[i])
+			} else if value.Valid {
+				vr.LastAffected = value.String
+			}
+		case vulnerabilityrange.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				vr.Origin = value.String
+			}
+		case vulnerabilityrange.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				vr.Collector = value.String
+			}
+		case vulnerabilityrange.FieldDocumentRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_ref", values[i])
+			} else if value.Valid {
+				vr.DocumentRef = value.String
+			}
+		default:
+			vr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VulnerabilityRange.
+// This includes values selected through modifiers, order, etc.
+func (vr *VulnerabilityRange) Value(name string) (ent.Value, error) {
+	return vr.selectValues.Get(name)
+}
+
+// QueryVulnerability queries the "vulnerability" edge of the VulnerabilityRange entity.
+func (vr *VulnerabilityRange) QueryVulnerability() *VulnerabilityIDQuery {
+	return NewVulnerabilityRangeClient(vr.config).QueryVulnerability(vr)
+}
+
+// QueryPackage queries the "package" edge of the VulnerabilityRange entity.
+func (vr *VulnerabilityRange) QueryPackage() *PackageNameQuery {
+	return NewVulnerabilityRangeClient(vr.config).QueryPackage(vr)
+}
+
+// Update returns a builder for updating this VulnerabilityRange.
+// Note that you need to call VulnerabilityRange.Unwrap() before calling this method if this VulnerabilityRange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vr *VulnerabilityRange) Update() *VulnerabilityRangeUpdateOne {
+	return NewVulnerabilityRangeClient(vr.config).UpdateOne(vr)
+}
+
+// Unwrap unwraps the VulnerabilityRange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vr *VulnerabilityRange) Unwrap() *VulnerabilityRange {
+	_tx, ok := vr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VulnerabilityRange is not a transactional entity")
+	}
+	vr.config.driver = _tx.drv
+	return vr
+}
+
+// String implements the fmt.Stringer.
+func (vr *VulnerabilityRange) String() string {
+	var builder strings.Builder
+	builder.WriteString("VulnerabilityRange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vr.ID))
+	builder.WriteString("tenant=")
+	builder.WriteString(vr.Tenant)
+	builder.WriteString(", ")
+	builder.WriteString("vulnerability_id=")
+	builder.WriteString(fmt.Sprintf("%v", vr.VulnerabilityID))
+	builder.WriteString(", ")
+	builder.WriteString("package_name_id=")
+	builder.WriteString(fmt.Sprintf("%v", vr.PackageNameID))
+	builder.WriteString(", ")
+	builder.WriteString("range_type=")
+	builder.WriteString(fmt.Sprintf("%v", vr.RangeType))
+	builder.WriteString(", ")
+	builder.WriteString("introduced=")
+	builder.WriteString(vr.Introduced)
+	builder.WriteString(", ")
+	builder.WriteString("fixed=")
+	builder.WriteString(vr.Fixed)
+	builder.WriteString(", ")
+	builder.WriteString("last_affected=")
+	builder.WriteString(vr.LastAffected)
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(vr.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(vr.Collector)
+	builder.WriteString(", ")
+	builder.WriteString("document_ref=")
+	builder.WriteString(vr.DocumentRef)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VulnerabilityRanges is a parsable slice of VulnerabilityRange.
+type VulnerabilityRanges []*VulnerabilityRange
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerabilityrange
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the vulnerabilityrange type in the database.
+	Label = "vulnerability_range"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenant holds the string denoting the tenant field in the database.
+	FieldTenant = "tenant"
+	// FieldVulnerabilityID holds the string denoting the vulnerability_id field in the database.
+	FieldVulnerabilityID = "vulnerability_id"
+	// FieldPackageNameID holds the string denoting the package_name_id field in the database.
+	FieldPackageNameID = "package_name_id"
+	// FieldRangeType holds the string denoting the range_type field in the database.
+	FieldRangeType = "range_type"
+	// FieldIntroduced holds the string denoting the introduced field in the database.
+	FieldIntroduced = "introduced"
+	// FieldFixed holds the string denoting the fixed field in the database.
+	FieldFixed = "fixed"
+	// FieldLastAffected holds the string denoting the last_affected field in the database.
+	FieldLastAffected = "last_affected"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// FieldDocumentRef holds the string denoting the document_ref field in the database.
+	FieldDocumentRef = "document_ref"
+	// EdgeVulnerability holds the string denoting the vulnerability edge name in mutations.
+	EdgeVulnerability = "vulnerability"
+	// EdgePackage holds the string denoting the package edge name in mutations.
+	EdgePackage = "package"
+	// Table holds the table name of the vulnerabilityrange in the database.
+	Table = "vulnerability_ranges"
+	// VulnerabilityTable is the table that holds the vulnerability relation/edge.
+	VulnerabilityTable = "vulnerability_ranges"
+	// VulnerabilityInverseTable is the table name for the VulnerabilityID entity.
+	// It exists in this package in order to avoid circular dependency with the "vulnerabilityid" package.
+	VulnerabilityInverseTable = "vulnerability_ids"
+	// VulnerabilityColumn is the table column denoting the vulnerability relation/edge.
+	VulnerabilityColumn = "vulnerability_id"
+	// PackageTable is the table that holds the package relation/edge.
+	PackageTable = "vulnerability_ranges"
+	// PackageInverseTable is the table name for the PackageName entity.
+	// It exists in this package in order to avoid circular dependency with the "packagename" package.
+	PackageInverseTable = "package_names"
+	// PackageColumn is the table column denoting the package relation/edge.
+	PackageColumn = "package_name_id"
+)
+
+// Columns holds all SQL columns for vulnerabilityrange fields.
+var Columns = []string{
+	FieldID,
+	FieldTenant,
+	FieldVulnerabilityID,
+	FieldPackageNameID,
+	FieldRangeType,
+	FieldIntroduced,
+	FieldFixed,
+	FieldLastAffected,
+	FieldOrigin,
+	FieldCollector,
+	FieldDocumentRef,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTenant holds the default value on creation for the "tenant" field.
+	DefaultTenant string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// RangeType defines the type for the "range_type" enum field.
+type RangeType string
+
+// RangeType values.
+const (
+	RangeTypeSEMVER    RangeType = "SEMVER"
+	RangeTypeECOSYSTEM RangeType = "ECOSYSTEM"
+	RangeTypeGIT       RangeType = "GIT"
+)
+
+func (rt RangeType) String() string {
+	return string(rt)
+}
+
+// RangeTypeValidator is a validator for the "range_type" field enum values. It is called by the builders before save.
+func RangeTypeValidator(rt RangeType) error {
+	switch rt {
+	case RangeTypeSEMVER, RangeTypeECOSYSTEM, RangeTypeGIT:
+		return nil
+	default:
+		return fmt.Errorf("vulnerabilityrange: invalid enum value for range_type field: %q", rt)
+	}
+}
+
+// OrderOption defines the ordering options for the VulnerabilityRange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenant orders the results by the tenant field.
+func ByTenant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenant, opts...).ToFunc()
+}
+
+// ByVulnerabilityID orders the results by the vulnerability_id field.
+func ByVulnerabilityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVulnerabilityID, opts...).ToFunc()
+}
+
+// ByPackageNameID orders the results by the package_name_id field.
+func ByPackageNameID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageNameID, opts...).ToFunc()
+}
+
+// ByRangeType orders the results by the range_type field.
+func ByRangeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRangeType, opts...).ToFunc()
+}
+
+// ByIntroduced orders the results by the introduced field.
+func ByIntroduced(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntroduced, opts...).ToFunc()
+}
+
+// ByFixed orders the results by the fixed field.
+func ByFixed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFixed, opts...).ToFunc()
+}
+
+// ByLastAffected orders the results by the last_affected field.
+func ByLastAffected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastAffected, opts...).ToFunc()
+}
+
+// ByOrigin orders the results by the origin field.
+func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
+}
+
+// ByCollector orders the results by the collector field.
+func ByCollector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollector, opts...).ToFunc()
+}
+
+// ByDocumentRef orders the results by the document_ref field.
+func ByDocumentRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentRef, opts...).ToFunc()
+}
+
+// ByVulnerabilityField orders the results by vulnerability field.
+func ByVulnerabilityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVulnerabilityStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPackageField orders the results by package field.
+func ByPackageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPackageStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVulnerabilityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VulnerabilityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityTable, VulnerabilityColumn),
+	)
+}
+func newPackageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PackageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e RangeType) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *RangeType) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = RangeType(str)
+	if err := RangeTypeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid RangeType", str)
+	}
+	return nil
+}
@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vulnerabilityrange
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldID, id))
+}
+
+// Tenant applies equality check predicate on the "tenant" field. It's identical to TenantEQ.
+func Tenant(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldTenant, v))
+}
+
+// VulnerabilityID applies equality check predicate on the "vulnerability_id" field. It's identical to VulnerabilityIDEQ.
+func VulnerabilityID(v uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldVulnerabilityID, v))
+}
+
+// PackageNameID applies equality check predicate on the "package_name_id" field. It's identical to PackageNameIDEQ.
+func PackageNameID(v uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldPackageNameID, v))
+}
+
+// Introduced applies equality check predicate on the "introduced" field. It's identical to IntroducedEQ.
+func Introduced(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldIntroduced, v))
+}
+
+// Fixed applies equality check predicate on the "fixed" field. It's identical to FixedEQ.
+func Fixed(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldFixed, v))
+}
+
+// LastAffected applies equality check predicate on the "last_affected" field. It's identical to LastAffectedEQ.
+func LastAffected(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldLastAffected, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldCollector, v))
+}
+
+// DocumentRef applies equality check predicate on the "document_ref" field. It's identical to DocumentRefEQ.
+func DocumentRef(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// TenantEQ applies the EQ predicate on the "tenant" field.
+func TenantEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldTenant, v))
+}
+
+// TenantNEQ applies the NEQ predicate on the "tenant" field.
+func TenantNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldTenant, v))
+}
+
+// TenantIn applies the In predicate on the "tenant" field.
+func TenantIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldTenant, vs...))
+}
+
+// TenantNotIn applies the NotIn predicate on the "tenant" field.
+func TenantNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldTenant, vs...))
+}
+
+// TenantGT applies the GT predicate on the "tenant" field.
+func TenantGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldTenant, v))
+}
+
+// TenantGTE applies the GTE predicate on the "tenant" field.
+func TenantGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldTenant, v))
+}
+
+// TenantLT applies the LT predicate on the "tenant" field.
+func TenantLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldTenant, v))
+}
+
+// TenantLTE applies the LTE predicate on the "tenant" field.
+func TenantLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldTenant, v))
+}
+
+// TenantContains applies the Contains predicate on the "tenant" field.
+func TenantContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldTenant, v))
+}
+
+// TenantHasPrefix applies the HasPrefix predicate on the "tenant" field.
+func TenantHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldTenant, v))
+}
+
+// TenantHasSuffix applies the HasSuffix predicate on the "tenant" field.
+func TenantHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldTenant, v))
+}
+
+// TenantEqualFold applies the EqualFold predicate on the "tenant" field.
+func TenantEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldTenant, v))
+}
+
+// TenantContainsFold applies the ContainsFold predicate on the "tenant" field.
+func TenantContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldTenant, v))
+}
+
+// VulnerabilityIDEQ applies the EQ predicate on the "vulnerability_id" field.
+func VulnerabilityIDEQ(v uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldVulnerabilityID, v))
+}
+
+// VulnerabilityIDNEQ applies the NEQ predicate on the "vulnerability_id" field.
+func VulnerabilityIDNEQ(v uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldVulnerabilityID, v))
+}
+
+// VulnerabilityIDIn applies the In predicate on the "vulnerability_id" field.
+func VulnerabilityIDIn(vs ...uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldVulnerabilityID, vs...))
+}
+
+// VulnerabilityIDNotIn applies the NotIn predicate on the "vulnerability_id" field.
+func VulnerabilityIDNotIn(vs ...uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldVulnerabilityID, vs...))
+}
+
+// PackageNameIDEQ applies the EQ predicate on the "package_name_id" field.
+func PackageNameIDEQ(v uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldPackageNameID, v))
+}
+
+// PackageNameIDNEQ applies the NEQ predicate on the "package_name_id" field.
+func PackageNameIDNEQ(v uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldPackageNameID, v))
+}
+
+// PackageNameIDIn applies the In predicate on the "package_name_id" field.
+func PackageNameIDIn(vs ...uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldPackageNameID, vs...))
+}
+
+// PackageNameIDNotIn applies the NotIn predicate on the "package_name_id" field.
+func PackageNameIDNotIn(vs ...uuid.UUID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldPackageNameID, vs...))
+}
+
+// RangeTypeEQ applies the EQ predicate on the "range_type" field.
+func RangeTypeEQ(v RangeType) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldRangeType, v))
+}
+
+// RangeTypeNEQ applies the NEQ predicate on the "range_type" field.
+func RangeTypeNEQ(v RangeType) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldRangeType, v))
+}
+
+// RangeTypeIn applies the In predicate on the "range_type" field.
+func RangeTypeIn(vs ...RangeType) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldRangeType, vs...))
+}
+
+// RangeTypeNotIn applies the NotIn predicate on the "range_type" field.
+func RangeTypeNotIn(vs ...RangeType) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldRangeType, vs...))
+}
+
+// IntroducedEQ applies the EQ predicate on the "introduced" field.
+func IntroducedEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldIntroduced, v))
+}
+
+// IntroducedNEQ applies the NEQ predicate on the "introduced" field.
+func IntroducedNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldIntroduced, v))
+}
+
+// IntroducedIn applies the In predicate on the "introduced" field.
+func IntroducedIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldIntroduced, vs...))
+}
+
+// IntroducedNotIn applies the NotIn predicate on the "introduced" field.
+func IntroducedNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldIntroduced, vs...))
+}
+
+// IntroducedGT applies the GT predicate on the "introduced" field.
+func IntroducedGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldIntroduced, v))
+}
+
+// IntroducedGTE applies the GTE predicate on the "introduced" field.
+func IntroducedGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldIntroduced, v))
+}
+
+// IntroducedLT applies the LT predicate on the "introduced" field.
+func IntroducedLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldIntroduced, v))
+}
+
+// IntroducedLTE applies the LTE predicate on the "introduced" field.
+func IntroducedLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldIntroduced, v))
+}
+
+// IntroducedContains applies the Contains predicate on the "introduced" field.
+func IntroducedContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldIntroduced, v))
+}
+
+// IntroducedHasPrefix applies the HasPrefix predicate on the "introduced" field.
+func IntroducedHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldIntroduced, v))
+}
+
+// IntroducedHasSuffix applies the HasSuffix predicate on the "introduced" field.
+func IntroducedHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldIntroduced, v))
+}
+
+// IntroducedEqualFold applies the EqualFold predicate on the "introduced" field.
+func IntroducedEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldIntroduced, v))
+}
+
+// IntroducedContainsFold applies the ContainsFold predicate on the "introduced" field.
+func IntroducedContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldIntroduced, v))
+}
+
+// FixedEQ applies the EQ predicate on the "fixed" field.
+func FixedEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldFixed, v))
+}
+
+// FixedNEQ applies the NEQ predicate on the "fixed" field.
+func FixedNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldFixed, v))
+}
+
+// FixedIn applies the In predicate on the "fixed" field.
+func FixedIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldFixed, vs...))
+}
+
+// FixedNotIn applies the NotIn predicate on the "fixed" field.
+func FixedNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldFixed, vs...))
+}
+
+// FixedGT applies the GT predicate on the "fixed" field.
+func FixedGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldFixed, v))
+}
+
+// FixedGTE applies the GTE predicate on the "fixed" field.
+func FixedGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldFixed, v))
+}
+
+// FixedLT applies the LT predicate on the "fixed" field.
+func FixedLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldFixed, v))
+}
+
+// FixedLTE applies the LTE predicate on the "fixed" field.
+func FixedLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldFixed, v))
+}
+
+// FixedContains applies the Contains predicate on the "fixed" field.
+func FixedContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldFixed, v))
+}
+
+// FixedHasPrefix applies the HasPrefix predicate on the "fixed" field.
+func FixedHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldFixed, v))
+}
+
+// FixedHasSuffix applies the HasSuffix predicate on the "fixed" field.
+func FixedHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldFixed, v))
+}
+
+// FixedEqualFold applies the EqualFold predicate on the "fixed" field.
+func FixedEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldFixed, v))
+}
+
+// FixedContainsFold applies the ContainsFold predicate on the "fixed" field.
+func FixedContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldFixed, v))
+}
+
+// LastAffectedEQ applies the EQ predicate on the "last_affected" field.
+func LastAffectedEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldLastAffected, v))
+}
+
+// LastAffectedNEQ applies the NEQ predicate on the "last_affected" field.
+func LastAffectedNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldLastAffected, v))
+}
+
+// LastAffectedIn applies the In predicate on the "last_affected" field.
+func LastAffectedIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldLastAffected, vs...))
+}
+
+// LastAffectedNotIn applies the NotIn predicate on the "last_affected" field.
+func LastAffectedNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldLastAffected, vs...))
+}
+
+// LastAffectedGT applies the GT predicate on the "last_affected" field.
+func LastAffectedGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldLastAffected, v))
+}
+
+// LastAffectedGTE applies the GTE predicate on the "last_affected" field.
+func LastAffectedGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldLastAffected, v))
+}
+
+// LastAffectedLT applies the LT predicate on the "last_affected" field.
+func LastAffectedLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldLastAffected, v))
+}
+
+// LastAffectedLTE applies the LTE predicate on the "last_affected" field.
+func LastAffectedLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldLastAffected, v))
+}
+
+// LastAffectedContains applies the Contains predicate on the "last_affected" field.
+func LastAffectedContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldLastAffected, v))
+}
+
+// LastAffectedHasPrefix applies the HasPrefix predicate on the "last_affected" field.
+func LastAffectedHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldLastAffected, v))
+}
+
+// LastAffectedHasSuffix applies the HasSuffix predicate on the "last_affected" field.
+func LastAffectedHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldLastAffected, v))
+}
+
+// LastAffectedEqualFold applies the EqualFold predicate on the "last_affected" field.
+func LastAffectedEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldLastAffected, v))
+}
+
+// LastAffectedContainsFold applies the ContainsFold predicate on the "last_affected" field.
+func LastAffectedContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldLastAffected, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// DocumentRefEQ applies the EQ predicate on the "document_ref" field.
+func DocumentRefEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefNEQ applies the NEQ predicate on the "document_ref" field.
+func DocumentRefNEQ(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefIn applies the In predicate on the "document_ref" field.
+func DocumentRefIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefNotIn applies the NotIn predicate on the "document_ref" field.
+func DocumentRefNotIn(vs ...string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldNotIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefGT applies the GT predicate on the "document_ref" field.
+func DocumentRefGT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGT(FieldDocumentRef, v))
+}
+
+// DocumentRefGTE applies the GTE predicate on the "document_ref" field.
+func DocumentRefGTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldGTE(FieldDocumentRef, v))
+}
+
+// DocumentRefLT applies the LT predicate on the "document_ref" field.
+func DocumentRefLT(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLT(FieldDocumentRef, v))
+}
+
+// DocumentRefLTE applies the LTE predicate on the "document_ref" field.
+func DocumentRefLTE(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldLTE(FieldDocumentRef, v))
+}
+
+// DocumentRefContains applies the Contains predicate on the "document_ref" field.
+func DocumentRefContains(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContains(FieldDocumentRef, v))
+}
+
+// DocumentRefHasPrefix applies the HasPrefix predicate on the "document_ref" field.
+func DocumentRefHasPrefix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasPrefix(FieldDocumentRef, v))
+}
+
+// DocumentRefHasSuffix applies the HasSuffix predicate on the "document_ref" field.
+func DocumentRefHasSuffix(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldHasSuffix(FieldDocumentRef, v))
+}
+
+// DocumentRefEqualFold applies the EqualFold predicate on the "document_ref" field.
+func DocumentRefEqualFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldEqualFold(FieldDocumentRef, v))
+}
+
+// DocumentRefContainsFold applies the ContainsFold predicate on the "document_ref" field.
+func DocumentRefContainsFold(v string) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.FieldContainsFold(FieldDocumentRef, v))
+}
+
+// HasVulnerability applies the HasEdge predicate on the "vulnerability" edge.
+func HasVulnerability() predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VulnerabilityTable, VulnerabilityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVulnerabilityWith applies the HasEdge predicate on the "vulnerability" edge with a given conditions (other predicates).
+func HasVulnerabilityWith(preds ...predicate.VulnerabilityID) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(func(s *sql.Selector) {
+		step := newVulnerabilityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPackage applies the HasEdge predicate on the "package" edge.
+func HasPackage() predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PackageTable, PackageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackageWith applies the HasEdge predicate on the "package" edge with a given conditions (other predicates).
+func HasPackageWith(preds ...predicate.PackageName) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(func(s *sql.Selector) {
+		step := newPackageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VulnerabilityRange) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VulnerabilityRange) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VulnerabilityRange) predicate.VulnerabilityRange {
+	return predicate.VulnerabilityRange(sql.NotPredicates(p))
+}
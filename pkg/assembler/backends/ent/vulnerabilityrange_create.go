@@ -0,0 +1,1018 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+)
+
+// VulnerabilityRangeCreate is the builder for creating a VulnerabilityRange entity.
+type VulnerabilityRangeCreate struct {
+	config
+	mutation *VulnerabilityRangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTenant sets the "tenant" field.
+func (vrc *VulnerabilityRangeCreate) SetTenant(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetTenant(s)
+	return vrc
+}
+
+// SetNillableTenant sets the "tenant" field if the given value is not nil.
+func (vrc *VulnerabilityRangeCreate) SetNillableTenant(s *string) *VulnerabilityRangeCreate {
+	if s != nil {
+		vrc.SetTenant(*s)
+	}
+	return vrc
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (vrc *VulnerabilityRangeCreate) SetVulnerabilityID(u uuid.UUID) *VulnerabilityRangeCreate {
+	vrc.mutation.SetVulnerabilityID(u)
+	return vrc
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (vrc *VulnerabilityRangeCreate) SetPackageNameID(u uuid.UUID) *VulnerabilityRangeCreate {
+	vrc.mutation.SetPackageNameID(u)
+	return vrc
+}
+
+// SetRangeType sets the "range_type" field.
+func (vrc *VulnerabilityRangeCreate) SetRangeType(vt vulnerabilityrange.RangeType) *VulnerabilityRangeCreate {
+	vrc.mutation.SetRangeType(vt)
+	return vrc
+}
+
+// SetIntroduced sets the "introduced" field.
+func (vrc *VulnerabilityRangeCreate) SetIntroduced(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetIntroduced(s)
+	return vrc
+}
+
+// SetFixed sets the "fixed" field.
+func (vrc *VulnerabilityRangeCreate) SetFixed(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetFixed(s)
+	return vrc
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (vrc *VulnerabilityRangeCreate) SetLastAffected(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetLastAffected(s)
+	return vrc
+}
+
+// SetOrigin sets the "origin" field.
+func (vrc *VulnerabilityRangeCreate) SetOrigin(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetOrigin(s)
+	return vrc
+}
+
+// SetCollector sets the "collector" field.
+func (vrc *VulnerabilityRangeCreate) SetCollector(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetCollector(s)
+	return vrc
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (vrc *VulnerabilityRangeCreate) SetDocumentRef(s string) *VulnerabilityRangeCreate {
+	vrc.mutation.SetDocumentRef(s)
+	return vrc
+}
+
+// SetID sets the "id" field.
+func (vrc *VulnerabilityRangeCreate) SetID(u uuid.UUID) *VulnerabilityRangeCreate {
+	vrc.mutation.SetID(u)
+	return vrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (vrc *VulnerabilityRangeCreate) SetNillableID(u *uuid.UUID) *VulnerabilityRangeCreate {
+	if u != nil {
+		vrc.SetID(*u)
+	}
+	return vrc
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (vrc *VulnerabilityRangeCreate) SetVulnerability(v *VulnerabilityID) *VulnerabilityRangeCreate {
+	return vrc.SetVulnerabilityID(v.ID)
+}
+
+// SetPackageID sets the "package" edge to the PackageName entity by ID.
+func (vrc *VulnerabilityRangeCreate) SetPackageID(id uuid.UUID) *VulnerabilityRangeCreate {
+	vrc.mutation.SetPackageID(id)
+	return vrc
+}
+
+// SetPackage sets the "package" edge to the PackageName entity.
+func (vrc *VulnerabilityRangeCreate) SetPackage(p *PackageName) *VulnerabilityRangeCreate {
+	return vrc.SetPackageID(p.ID)
+}
+
+// Mutation returns the VulnerabilityRangeMutation object of the builder.
+func (vrc *VulnerabilityRangeCreate) Mutation() *VulnerabilityRangeMutation {
+	return vrc.mutation
+}
+
+// Save creates the VulnerabilityRange in the database.
+func (vrc *VulnerabilityRangeCreate) Save(ctx context.Context) (*VulnerabilityRange, error) {
+	vrc.defaults()
+	return withHooks(ctx, vrc.sqlSave, vrc.mutation, vrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vrc *VulnerabilityRangeCreate) SaveX(ctx context.Context) *VulnerabilityRange {
+	v, err := vrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vrc *VulnerabilityRangeCreate) Exec(ctx context.Context) error {
+	_, err := vrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vrc *VulnerabilityRangeCreate) ExecX(ctx context.Context) {
+	if err := vrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vrc *VulnerabilityRangeCreate) defaults() {
+	if _, ok := vrc.mutation.Tenant(); !ok {
+		v := vulnerabilityrange.DefaultTenant
+		vrc.mutation.SetTenant(v)
+	}
+	if _, ok := vrc.mutation.ID(); !ok {
+		v := vulnerabilityrange.DefaultID()
+		vrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vrc *VulnerabilityRangeCreate) check() error {
+	if _, ok := vrc.mutation.Tenant(); !ok {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required field "VulnerabilityRange.tenant"`)}
+	}
+	if _, ok := vrc.mutation.VulnerabilityID(); !ok {
+		return &ValidationError{Name: "vulnerability_id", err: errors.New(`ent: missing required field "VulnerabilityRange.vulnerability_id"`)}
+	}
+	if _, ok := vrc.mutation.PackageNameID(); !ok {
+		return &ValidationError{Name: "package_name_id", err: errors.New(`ent: missing required field "VulnerabilityRange.package_name_id"`)}
+	}
+	if _, ok := vrc.mutation.RangeType(); !ok {
+		return &ValidationError{Name: "range_type", err: errors.New(`ent: missing required field "VulnerabilityRange.range_type"`)}
+	}
+	if v, ok := vrc.mutation.RangeType(); ok {
+		if err := vulnerabilityrange.RangeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "range_type", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityRange.range_type": %w`, err)}
+		}
+	}
+	if _, ok := vrc.mutation.Introduced(); !ok {
+		return &ValidationError{Name: "introduced", err: errors.New(`ent: missing required field "VulnerabilityRange.introduced"`)}
+	}
+	if _, ok := vrc.mutation.Fixed(); !ok {
+		return &ValidationError{Name: "fixed", err: errors.New(`ent: missing required field "VulnerabilityRange.fixed"`)}
+	}
+	if _, ok := vrc.mutation.LastAffected(); !ok {
+		return &ValidationError{Name: "last_affected", err: errors.New(`ent: missing required field "VulnerabilityRange.last_affected"`)}
+	}
+	if _, ok := vrc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`ent: missing required field "VulnerabilityRange.origin"`)}
+	}
+	if _, ok := vrc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`ent: missing required field "VulnerabilityRange.collector"`)}
+	}
+	if _, ok := vrc.mutation.DocumentRef(); !ok {
+		return &ValidationError{Name: "document_ref", err: errors.New(`ent: missing required field "VulnerabilityRange.document_ref"`)}
+	}
+	if len(vrc.mutation.VulnerabilityIDs()) == 0 {
+		return &ValidationError{Name: "vulnerability", err: errors.New(`ent: missing required edge "VulnerabilityRange.vulnerability"`)}
+	}
+	if len(vrc.mutation.PackageIDs()) == 0 {
+		return &ValidationError{Name: "package", err: errors.New(`ent: missing required edge "VulnerabilityRange.package"`)}
+	}
+	return nil
+}
+
+func (vrc *VulnerabilityRangeCreate) sqlSave(ctx context.Context) (*VulnerabilityRange, error) {
+	if err := vrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	vrc.mutation.id = &_node.ID
+	vrc.mutation.done = true
+	return _node, nil
+}
+
+func (vrc *VulnerabilityRangeCreate) createSpec() (*VulnerabilityRange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VulnerabilityRange{config: vrc.config}
+		_spec = sqlgraph.NewCreateSpec(vulnerabilityrange.Table, sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = vrc.conflict
+	if id, ok := vrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := vrc.mutation.Tenant(); ok {
+		_spec.SetField(vulnerabilityrange.FieldTenant, field.TypeString, value)
+		_node.Tenant = value
+	}
+	if value, ok := vrc.mutation.RangeType(); ok {
+		_spec.SetField(vulnerabilityrange.FieldRangeType, field.TypeEnum, value)
+		_node.RangeType = value
+	}
+	if value, ok := vrc.mutation.Introduced(); ok {
+		_spec.SetField(vulnerabilityrange.FieldIntroduced, field.TypeString, value)
+		_node.Introduced = value
+	}
+	if value, ok := vrc.mutation.Fixed(); ok {
+		_spec.SetField(vulnerabilityrange.FieldFixed, field.TypeString, value)
+		_node.Fixed = value
+	}
+	if value, ok := vrc.mutation.LastAffected(); ok {
+		_spec.SetField(vulnerabilityrange.FieldLastAffected, field.TypeString, value)
+		_node.LastAffected = value
+	}
+	if value, ok := vrc.mutation.Origin(); ok {
+		_spec.SetField(vulnerabilityrange.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := vrc.mutation.Collector(); ok {
+		_spec.SetField(vulnerabilityrange.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := vrc.mutation.DocumentRef(); ok {
+		_spec.SetField(vulnerabilityrange.FieldDocumentRef, field.TypeString, value)
+		_node.DocumentRef = value
+	}
+	if nodes := vrc.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.VulnerabilityTable,
+			Columns: []string{vulnerabilityrange.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VulnerabilityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vrc.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.PackageTable,
+			Columns: []string{vulnerabilityrange.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackageNameID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VulnerabilityRange.Create().
+//		SetTenant(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VulnerabilityRangeUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+func (vrc *VulnerabilityRangeCreate) OnConflict(opts ...sql.ConflictOption) *VulnerabilityRangeUpsertOne {
+	vrc.conflict = opts
+	return &VulnerabilityRangeUpsertOne{
+		create: vrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VulnerabilityRange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vrc *VulnerabilityRangeCreate) OnConflictColumns(columns ...string) *VulnerabilityRangeUpsertOne {
+	vrc.conflict = append(vrc.conflict, sql.ConflictColumns(columns...))
+	return &VulnerabilityRangeUpsertOne{
+		create: vrc,
+	}
+}
+
+type (
+	// VulnerabilityRangeUpsertOne is the builder for "upsert"-ing
+	//  one VulnerabilityRange node.
+	VulnerabilityRangeUpsertOne struct {
+		create *VulnerabilityRangeCreate
+	}
+
+	// VulnerabilityRangeUpsert is the "OnConflict" setter.
+	VulnerabilityRangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *VulnerabilityRangeUpsert) SetVulnerabilityID(v uuid.UUID) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldVulnerabilityID, v)
+	return u
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateVulnerabilityID() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldVulnerabilityID)
+	return u
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *VulnerabilityRangeUpsert) SetPackageNameID(v uuid.UUID) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldPackageNameID, v)
+	return u
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdatePackageNameID() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldPackageNameID)
+	return u
+}
+
+// SetRangeType sets the "range_type" field.
+func (u *VulnerabilityRangeUpsert) SetRangeType(v vulnerabilityrange.RangeType) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldRangeType, v)
+	return u
+}
+
+// UpdateRangeType sets the "range_type" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateRangeType() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldRangeType)
+	return u
+}
+
+// SetIntroduced sets the "introduced" field.
+func (u *VulnerabilityRangeUpsert) SetIntroduced(v string) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldIntroduced, v)
+	return u
+}
+
+// UpdateIntroduced sets the "introduced" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateIntroduced() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldIntroduced)
+	return u
+}
+
+// SetFixed sets the "fixed" field.
+func (u *VulnerabilityRangeUpsert) SetFixed(v string) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldFixed, v)
+	return u
+}
+
+// UpdateFixed sets the "fixed" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateFixed() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldFixed)
+	return u
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (u *VulnerabilityRangeUpsert) SetLastAffected(v string) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldLastAffected, v)
+	return u
+}
+
+// UpdateLastAffected sets the "last_affected" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateLastAffected() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldLastAffected)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *VulnerabilityRangeUpsert) SetOrigin(v string) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateOrigin() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *VulnerabilityRangeUpsert) SetCollector(v string) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateCollector() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldCollector)
+	return u
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *VulnerabilityRangeUpsert) SetDocumentRef(v string) *VulnerabilityRangeUpsert {
+	u.Set(vulnerabilityrange.FieldDocumentRef, v)
+	return u
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsert) UpdateDocumentRef() *VulnerabilityRangeUpsert {
+	u.SetExcluded(vulnerabilityrange.FieldDocumentRef)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VulnerabilityRange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vulnerabilityrange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VulnerabilityRangeUpsertOne) UpdateNewValues() *VulnerabilityRangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(vulnerabilityrange.FieldID)
+		}
+		if _, exists := u.create.mutation.Tenant(); exists {
+			s.SetIgnore(vulnerabilityrange.FieldTenant)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VulnerabilityRange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VulnerabilityRangeUpsertOne) Ignore() *VulnerabilityRangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VulnerabilityRangeUpsertOne) DoNothing() *VulnerabilityRangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VulnerabilityRangeCreate.OnConflict
+// documentation for more info.
+func (u *VulnerabilityRangeUpsertOne) Update(set func(*VulnerabilityRangeUpsert)) *VulnerabilityRangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VulnerabilityRangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *VulnerabilityRangeUpsertOne) SetVulnerabilityID(v uuid.UUID) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateVulnerabilityID() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *VulnerabilityRangeUpsertOne) SetPackageNameID(v uuid.UUID) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetPackageNameID(v)
+	})
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdatePackageNameID() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdatePackageNameID()
+	})
+}
+
+// SetRangeType sets the "range_type" field.
+func (u *VulnerabilityRangeUpsertOne) SetRangeType(v vulnerabilityrange.RangeType) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetRangeType(v)
+	})
+}
+
+// UpdateRangeType sets the "range_type" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateRangeType() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateRangeType()
+	})
+}
+
+// SetIntroduced sets the "introduced" field.
+func (u *VulnerabilityRangeUpsertOne) SetIntroduced(v string) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetIntroduced(v)
+	})
+}
+
+// UpdateIntroduced sets the "introduced" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateIntroduced() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateIntroduced()
+	})
+}
+
+// SetFixed sets the "fixed" field.
+func (u *VulnerabilityRangeUpsertOne) SetFixed(v string) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetFixed(v)
+	})
+}
+
+// UpdateFixed sets the "fixed" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateFixed() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateFixed()
+	})
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (u *VulnerabilityRangeUpsertOne) SetLastAffected(v string) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetLastAffected(v)
+	})
+}
+
+// UpdateLastAffected sets the "last_affected" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateLastAffected() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateLastAffected()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *VulnerabilityRangeUpsertOne) SetOrigin(v string) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateOrigin() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *VulnerabilityRangeUpsertOne) SetCollector(v string) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateCollector() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *VulnerabilityRangeUpsertOne) SetDocumentRef(v string) *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertOne) UpdateDocumentRef() *VulnerabilityRangeUpsertOne {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *VulnerabilityRangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VulnerabilityRangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VulnerabilityRangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VulnerabilityRangeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VulnerabilityRangeUpsertOne.ID is not supported by MySQL driver. Use VulnerabilityRangeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VulnerabilityRangeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VulnerabilityRangeCreateBulk is the builder for creating many VulnerabilityRange entities in bulk.
+type VulnerabilityRangeCreateBulk struct {
+	config
+	err      error
+	builders []*VulnerabilityRangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VulnerabilityRange entities in the database.
+func (vrcb *VulnerabilityRangeCreateBulk) Save(ctx context.Context) ([]*VulnerabilityRange, error) {
+	if vrcb.err != nil {
+		return nil, vrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vrcb.builders))
+	nodes := make([]*VulnerabilityRange, len(vrcb.builders))
+	mutators := make([]Mutator, len(vrcb.builders))
+	for i := range vrcb.builders {
+		func(i int, root context.Context) {
+			builder := vrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VulnerabilityRangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vrcb *VulnerabilityRangeCreateBulk) SaveX(ctx context.Context) []*VulnerabilityRange {
+	v, err := vrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vrcb *VulnerabilityRangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := vrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vrcb *VulnerabilityRangeCreateBulk) ExecX(ctx context.Context) {
+	if err := vrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VulnerabilityRange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VulnerabilityRangeUpsert) {
+//			SetTenant(v+v).
+//		}).
+//		Exec(ctx)
+func (vrcb *VulnerabilityRangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *VulnerabilityRangeUpsertBulk {
+	vrcb.conflict = opts
+	return &VulnerabilityRangeUpsertBulk{
+		create: vrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VulnerabilityRange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vrcb *VulnerabilityRangeCreateBulk) OnConflictColumns(columns ...string) *VulnerabilityRangeUpsertBulk {
+	vrcb.conflict = append(vrcb.conflict, sql.ConflictColumns(columns...))
+	return &VulnerabilityRangeUpsertBulk{
+		create: vrcb,
+	}
+}
+
+// VulnerabilityRangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of VulnerabilityRange nodes.
+type VulnerabilityRangeUpsertBulk struct {
+	create *VulnerabilityRangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VulnerabilityRange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vulnerabilityrange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VulnerabilityRangeUpsertBulk) UpdateNewValues() *VulnerabilityRangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(vulnerabilityrange.FieldID)
+			}
+			if _, exists := b.mutation.Tenant(); exists {
+				s.SetIgnore(vulnerabilityrange.FieldTenant)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VulnerabilityRange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VulnerabilityRangeUpsertBulk) Ignore() *VulnerabilityRangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VulnerabilityRangeUpsertBulk) DoNothing() *VulnerabilityRangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VulnerabilityRangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *VulnerabilityRangeUpsertBulk) Update(set func(*VulnerabilityRangeUpsert)) *VulnerabilityRangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VulnerabilityRangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (u *VulnerabilityRangeUpsertBulk) SetVulnerabilityID(v uuid.UUID) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetVulnerabilityID(v)
+	})
+}
+
+// UpdateVulnerabilityID sets the "vulnerability_id" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateVulnerabilityID() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateVulnerabilityID()
+	})
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (u *VulnerabilityRangeUpsertBulk) SetPackageNameID(v uuid.UUID) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetPackageNameID(v)
+	})
+}
+
+// UpdatePackageNameID sets the "package_name_id" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdatePackageNameID() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdatePackageNameID()
+	})
+}
+
+// SetRangeType sets the "range_type" field.
+func (u *VulnerabilityRangeUpsertBulk) SetRangeType(v vulnerabilityrange.RangeType) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetRangeType(v)
+	})
+}
+
+// UpdateRangeType sets the "range_type" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateRangeType() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateRangeType()
+	})
+}
+
+// SetIntroduced sets the "introduced" field.
+func (u *VulnerabilityRangeUpsertBulk) SetIntroduced(v string) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetIntroduced(v)
+	})
+}
+
+// UpdateIntroduced sets the "introduced" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateIntroduced() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateIntroduced()
+	})
+}
+
+// SetFixed sets the "fixed" field.
+func (u *VulnerabilityRangeUpsertBulk) SetFixed(v string) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetFixed(v)
+	})
+}
+
+// UpdateFixed sets the "fixed" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateFixed() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateFixed()
+	})
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (u *VulnerabilityRangeUpsertBulk) SetLastAffected(v string) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetLastAffected(v)
+	})
+}
+
+// UpdateLastAffected sets the "last_affected" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateLastAffected() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateLastAffected()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *VulnerabilityRangeUpsertBulk) SetOrigin(v string) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateOrigin() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *VulnerabilityRangeUpsertBulk) SetCollector(v string) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateCollector() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *VulnerabilityRangeUpsertBulk) SetDocumentRef(v string) *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *VulnerabilityRangeUpsertBulk) UpdateDocumentRef() *VulnerabilityRangeUpsertBulk {
+	return u.Update(func(s *VulnerabilityRangeUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *VulnerabilityRangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VulnerabilityRangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VulnerabilityRangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VulnerabilityRangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
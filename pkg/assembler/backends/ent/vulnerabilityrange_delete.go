@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+)
+
+// VulnerabilityRangeDelete is the builder for deleting a VulnerabilityRange entity.
+type VulnerabilityRangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *VulnerabilityRangeMutation
+}
+
+// Where appends a list predicates to the VulnerabilityRangeDelete builder.
+func (vrd *VulnerabilityRangeDelete) Where(ps ...predicate.VulnerabilityRange) *VulnerabilityRangeDelete {
+	vrd.mutation.Where(ps...)
+	return vrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vrd *VulnerabilityRangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vrd.sqlExec, vrd.mutation, vrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vrd *VulnerabilityRangeDelete) ExecX(ctx context.Context) int {
+	n, err := vrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vrd *VulnerabilityRangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vulnerabilityrange.Table, sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID))
+	if ps := vrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vrd.mutation.done = true
+	return affected, err
+}
+
+// VulnerabilityRangeDeleteOne is the builder for deleting a single VulnerabilityRange entity.
+type VulnerabilityRangeDeleteOne struct {
+	vrd *VulnerabilityRangeDelete
+}
+
+// Where appends a list predicates to the VulnerabilityRangeDelete builder.
+func (vrdo *VulnerabilityRangeDeleteOne) Where(ps ...predicate.VulnerabilityRange) *VulnerabilityRangeDeleteOne {
+	vrdo.vrd.mutation.Where(ps...)
+	return vrdo
+}
+
+// Exec executes the deletion query.
+func (vrdo *VulnerabilityRangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := vrdo.vrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vulnerabilityrange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vrdo *VulnerabilityRangeDeleteOne) ExecX(ctx context.Context) {
+	if err := vrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+)
+
+// VulnerabilityRangeQuery is the builder for querying VulnerabilityRange entities.
+type VulnerabilityRangeQuery struct {
+	config
+	ctx               *QueryContext
+	order             []vulnerabilityrange.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.VulnerabilityRange
+	withVulnerability *VulnerabilityIDQuery
+	withPackage       *PackageNameQuery
+	modifiers         []func(*sql.Selector)
+	loadTotal         []func(context.Context, []*VulnerabilityRange) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VulnerabilityRangeQuery builder.
+func (vrq *VulnerabilityRangeQuery) Where(ps ...predicate.VulnerabilityRange) *VulnerabilityRangeQuery {
+	vrq.predicates = append(vrq.predicates, ps...)
+	return vrq
+}
+
+// Limit the number of records to be returned by this query.
+func (vrq *VulnerabilityRangeQuery) Limit(limit int) *VulnerabilityRangeQuery {
+	vrq.ctx.Limit = &limit
+	return vrq
+}
+
+// Offset to start from.
+func (vrq *VulnerabilityRangeQuery) Offset(offset int) *VulnerabilityRangeQuery {
+	vrq.ctx.Offset = &offset
+	return vrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vrq *VulnerabilityRangeQuery) Unique(unique bool) *VulnerabilityRangeQuery {
+	vrq.ctx.Unique = &unique
+	return vrq
+}
+
+// Order specifies how the records should be ordered.
+func (vrq *VulnerabilityRangeQuery) Order(o ...vulnerabilityrange.OrderOption) *VulnerabilityRangeQuery {
+	vrq.order = append(vrq.order, o...)
+	return vrq
+}
+
+// QueryVulnerability chains the current query on the "vulnerability" edge.
+func (vrq *VulnerabilityRangeQuery) QueryVulnerability() *VulnerabilityIDQuery {
+	query := (&VulnerabilityIDClient{config: vrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityrange.Table, vulnerabilityrange.FieldID, selector),
+			sqlgraph.To(vulnerabilityid.Table, vulnerabilityid.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnerabilityrange.VulnerabilityTable, vulnerabilityrange.VulnerabilityColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPackage chains the current query on the "package" edge.
+func (vrq *VulnerabilityRangeQuery) QueryPackage() *PackageNameQuery {
+	query := (&PackageNameClient{config: vrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vulnerabilityrange.Table, vulnerabilityrange.FieldID, selector),
+			sqlgraph.To(packagename.Table, packagename.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, vulnerabilityrange.PackageTable, vulnerabilityrange.PackageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first VulnerabilityRange entity from the query.
+// Returns a *NotFoundError when no VulnerabilityRange was found.
+func (vrq *VulnerabilityRangeQuery) First(ctx context.Context) (*VulnerabilityRange, error) {
+	nodes, err := vrq.Limit(1).All(setContextOp(ctx, vrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vulnerabilityrange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) FirstX(ctx context.Context) *VulnerabilityRange {
+	node, err := vrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VulnerabilityRange ID from the query.
+// Returns a *NotFoundError when no VulnerabilityRange ID was found.
+func (vrq *VulnerabilityRangeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = vrq.Limit(1).IDs(setContextOp(ctx, vrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vulnerabilityrange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := vrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VulnerabilityRange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VulnerabilityRange entity is found.
+// Returns a *NotFoundError when no VulnerabilityRange entities are found.
+func (vrq *VulnerabilityRangeQuery) Only(ctx context.Context) (*VulnerabilityRange, error) {
+	nodes, err := vrq.Limit(2).All(setContextOp(ctx, vrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vulnerabilityrange.Label}
+	default:
+		return nil, &NotSingularError{vulnerabilityrange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) OnlyX(ctx context.Context) *VulnerabilityRange {
+	node, err := vrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VulnerabilityRange ID in the query.
+// Returns a *NotSingularError when more than one VulnerabilityRange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vrq *VulnerabilityRangeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = vrq.Limit(2).IDs(setContextOp(ctx, vrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vulnerabilityrange.Label}
+	default:
+		err = &NotSingularError{vulnerabilityrange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := vrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VulnerabilityRanges.
+func (vrq *VulnerabilityRangeQuery) All(ctx context.Context) ([]*VulnerabilityRange, error) {
+	ctx = setContextOp(ctx, vrq.ctx, ent.OpQueryAll)
+	if err := vrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VulnerabilityRange, *VulnerabilityRangeQuery]()
+	return withInterceptors[[]*VulnerabilityRange](ctx, vrq, qr, vrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) AllX(ctx context.Context) []*VulnerabilityRange {
+	nodes, err := vrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VulnerabilityRange IDs.
+func (vrq *VulnerabilityRangeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if vrq.ctx.Unique == nil && vrq.path != nil {
+		vrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vrq.ctx, ent.OpQueryIDs)
+	if err = vrq.Select(vulnerabilityrange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := vrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vrq *VulnerabilityRangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vrq.ctx, ent.OpQueryCount)
+	if err := vrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vrq, querierCount[*VulnerabilityRangeQuery](), vrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) CountX(ctx context.Context) int {
+	count, err := vrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vrq *VulnerabilityRangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vrq.ctx, ent.OpQueryExist)
+	switch _, err := vrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vrq *VulnerabilityRangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := vrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VulnerabilityRangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vrq *VulnerabilityRangeQuery) Clone() *VulnerabilityRangeQuery {
+	if vrq == nil {
+		return nil
+	}
+	return &VulnerabilityRangeQuery{
+		config:            vrq.config,
+		ctx:               vrq.ctx.Clone(),
+		order:             append([]vulnerabilityrange.OrderOption{}, vrq.order...),
+		inters:            append([]Interceptor{}, vrq.inters...),
+		predicates:        append([]predicate.VulnerabilityRange{}, vrq.predicates...),
+		withVulnerability: vrq.withVulnerability.Clone(),
+		withPackage:       vrq.withPackage.Clone(),
+		// clone intermediate query.
+		sql:  vrq.sql.Clone(),
+		path: vrq.path,
+	}
+}
+
+// WithVulnerability tells the query-builder to eager-load the nodes that are connected to
+// the "vulnerability" edge. The optional arguments are used to configure the query builder of the edge.
+func (vrq *VulnerabilityRangeQuery) WithVulnerability(opts ...func(*VulnerabilityIDQuery)) *VulnerabilityRangeQuery {
+	query := (&VulnerabilityIDClient{config: vrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vrq.withVulnerability = query
+	return vrq
+}
+
+// WithPackage tells the query-builder to eager-load the nodes that are connected to
+// the "package" edge. The optional arguments are used to configure the query builder of the edge.
+func (vrq *VulnerabilityRangeQuery) WithPackage(opts ...func(*PackageNameQuery)) *VulnerabilityRangeQuery {
+	query := (&PackageNameClient{config: vrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vrq.withPackage = query
+	return vrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VulnerabilityRange.Query().
+//		GroupBy(vulnerabilityrange.FieldTenant).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vrq *VulnerabilityRangeQuery) GroupBy(field string, fields ...string) *VulnerabilityRangeGroupBy {
+	vrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VulnerabilityRangeGroupBy{build: vrq}
+	grbuild.flds = &vrq.ctx.Fields
+	grbuild.label = vulnerabilityrange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Tenant string `json:"tenant,omitempty"`
+//	}
+//
+//	client.VulnerabilityRange.Query().
+//		Select(vulnerabilityrange.FieldTenant).
+//		Scan(ctx, &v)
+func (vrq *VulnerabilityRangeQuery) Select(fields ...string) *VulnerabilityRangeSelect {
+	vrq.ctx.Fields = append(vrq.ctx.Fields, fields...)
+	sbuild := &VulnerabilityRangeSelect{VulnerabilityRangeQuery: vrq}
+	sbuild.label = vulnerabilityrange.Label
+	sbuild.flds, sbuild.scan = &vrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VulnerabilityRangeSelect configured with the given aggregations.
+func (vrq *VulnerabilityRangeQuery) Aggregate(fns ...AggregateFunc) *VulnerabilityRangeSelect {
+	return vrq.Select().Aggregate(fns...)
+}
+
+func (vrq *VulnerabilityRangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vrq.ctx.Fields {
+		if !vulnerabilityrange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vrq.path != nil {
+		prev, err := vrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vrq.sql = prev
+	}
+	return nil
+}
+
+func (vrq *VulnerabilityRangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VulnerabilityRange, error) {
+	var (
+		nodes       = []*VulnerabilityRange{}
+		_spec       = vrq.querySpec()
+		loadedTypes = [2]bool{
+			vrq.withVulnerability != nil,
+			vrq.withPackage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VulnerabilityRange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VulnerabilityRange{config: vrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(vrq.modifiers) > 0 {
+		_spec.Modifiers = vrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := vrq.withVulnerability; query != nil {
+		if err := vrq.loadVulnerability(ctx, query, nodes, nil,
+			func(n *VulnerabilityRange, e *VulnerabilityID) { n.Edges.Vulnerability = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := vrq.withPackage; query != nil {
+		if err := vrq.loadPackage(ctx, query, nodes, nil,
+			func(n *VulnerabilityRange, e *PackageName) { n.Edges.Package = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range vrq.loadTotal {
+		if err := vrq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (vrq *VulnerabilityRangeQuery) loadVulnerability(ctx context.Context, query *VulnerabilityIDQuery, nodes []*VulnerabilityRange, init func(*VulnerabilityRange), assign func(*VulnerabilityRange, *VulnerabilityID)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*VulnerabilityRange)
+	for i := range nodes {
+		fk := nodes[i].VulnerabilityID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vulnerabilityid.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vulnerability_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (vrq *VulnerabilityRangeQuery) loadPackage(ctx context.Context, query *PackageNameQuery, nodes []*VulnerabilityRange, init func(*VulnerabilityRange), assign func(*VulnerabilityRange, *PackageName)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*VulnerabilityRange)
+	for i := range nodes {
+		fk := nodes[i].PackageNameID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(packagename.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "package_name_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (vrq *VulnerabilityRangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vrq.querySpec()
+	if len(vrq.modifiers) > 0 {
+		_spec.Modifiers = vrq.modifiers
+	}
+	_spec.Node.Columns = vrq.ctx.Fields
+	if len(vrq.ctx.Fields) > 0 {
+		_spec.Unique = vrq.ctx.Unique != nil && *vrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vrq.driver, _spec)
+}
+
+func (vrq *VulnerabilityRangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(vulnerabilityrange.Table, vulnerabilityrange.Columns, sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID))
+	_spec.From = vrq.sql
+	if unique := vrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vulnerabilityrange.FieldID)
+		for i := range fields {
+			if fields[i] != vulnerabilityrange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if vrq.withVulnerability != nil {
+			_spec.Node.AddColumnOnce(vulnerabilityrange.FieldVulnerabilityID)
+		}
+		if vrq.withPackage != nil {
+			_spec.Node.AddColumnOnce(vulnerabilityrange.FieldPackageNameID)
+		}
+	}
+	if ps := vrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vrq *VulnerabilityRangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vrq.driver.Dialect())
+	t1 := builder.Table(vulnerabilityrange.Table)
+	columns := vrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = vulnerabilityrange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vrq.sql != nil {
+		selector = vrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vrq.ctx.Unique != nil && *vrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vrq.predicates {
+		p(selector)
+	}
+	for _, p := range vrq.order {
+		p(selector)
+	}
+	if offset := vrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VulnerabilityRangeGroupBy is the group-by builder for VulnerabilityRange entities.
+type VulnerabilityRangeGroupBy struct {
+	selector
+	build *VulnerabilityRangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vrgb *VulnerabilityRangeGroupBy) Aggregate(fns ...AggregateFunc) *VulnerabilityRangeGroupBy {
+	vrgb.fns = append(vrgb.fns, fns...)
+	return vrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vrgb *VulnerabilityRangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := vrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VulnerabilityRangeQuery, *VulnerabilityRangeGroupBy](ctx, vrgb.build, vrgb, vrgb.build.inters, v)
+}
+
+func (vrgb *VulnerabilityRangeGroupBy) sqlScan(ctx context.Context, root *VulnerabilityRangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vrgb.fns))
+	for _, fn := range vrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vrgb.flds)+len(vrgb.fns))
+		for _, f := range *vrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VulnerabilityRangeSelect is the builder for selecting fields of VulnerabilityRange entities.
+type VulnerabilityRangeSelect struct {
+	*VulnerabilityRangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vrs *VulnerabilityRangeSelect) Aggregate(fns ...AggregateFunc) *VulnerabilityRangeSelect {
+	vrs.fns = append(vrs.fns, fns...)
+	return vrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vrs *VulnerabilityRangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vrs.ctx, ent.OpQuerySelect)
+	if err := vrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VulnerabilityRangeQuery, *VulnerabilityRangeSelect](ctx, vrs.VulnerabilityRangeQuery, vrs, vrs.inters, v)
+}
+
+func (vrs *VulnerabilityRangeSelect) sqlScan(ctx context.Context, root *VulnerabilityRangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vrs.fns))
+	for _, fn := range vrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/packagename"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityrange"
+)
+
+// VulnerabilityRangeUpdate is the builder for updating VulnerabilityRange entities.
+type VulnerabilityRangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VulnerabilityRangeMutation
+}
+
+// Where appends a list predicates to the VulnerabilityRangeUpdate builder.
+func (vru *VulnerabilityRangeUpdate) Where(ps ...predicate.VulnerabilityRange) *VulnerabilityRangeUpdate {
+	vru.mutation.Where(ps...)
+	return vru
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (vru *VulnerabilityRangeUpdate) SetVulnerabilityID(u uuid.UUID) *VulnerabilityRangeUpdate {
+	vru.mutation.SetVulnerabilityID(u)
+	return vru
+}
+
+// SetNillableVulnerabilityID sets the "vulnerability_id" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableVulnerabilityID(u *uuid.UUID) *VulnerabilityRangeUpdate {
+	if u != nil {
+		vru.SetVulnerabilityID(*u)
+	}
+	return vru
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (vru *VulnerabilityRangeUpdate) SetPackageNameID(u uuid.UUID) *VulnerabilityRangeUpdate {
+	vru.mutation.SetPackageNameID(u)
+	return vru
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillablePackageNameID(u *uuid.UUID) *VulnerabilityRangeUpdate {
+	if u != nil {
+		vru.SetPackageNameID(*u)
+	}
+	return vru
+}
+
+// SetRangeType sets the "range_type" field.
+func (vru *VulnerabilityRangeUpdate) SetRangeType(vt vulnerabilityrange.RangeType) *VulnerabilityRangeUpdate {
+	vru.mutation.SetRangeType(vt)
+	return vru
+}
+
+// SetNillableRangeType sets the "range_type" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableRangeType(vt *vulnerabilityrange.RangeType) *VulnerabilityRangeUpdate {
+	if vt != nil {
+		vru.SetRangeType(*vt)
+	}
+	return vru
+}
+
+// SetIntroduced sets the "introduced" field.
+func (vru *VulnerabilityRangeUpdate) SetIntroduced(s string) *VulnerabilityRangeUpdate {
+	vru.mutation.SetIntroduced(s)
+	return vru
+}
+
+// SetNillableIntroduced sets the "introduced" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableIntroduced(s *string) *VulnerabilityRangeUpdate {
+	if s != nil {
+		vru.SetIntroduced(*s)
+	}
+	return vru
+}
+
+// SetFixed sets the "fixed" field.
+func (vru *VulnerabilityRangeUpdate) SetFixed(s string) *VulnerabilityRangeUpdate {
+	vru.mutation.SetFixed(s)
+	return vru
+}
+
+// SetNillableFixed sets the "fixed" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableFixed(s *string) *VulnerabilityRangeUpdate {
+	if s != nil {
+		vru.SetFixed(*s)
+	}
+	return vru
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (vru *VulnerabilityRangeUpdate) SetLastAffected(s string) *VulnerabilityRangeUpdate {
+	vru.mutation.SetLastAffected(s)
+	return vru
+}
+
+// SetNillableLastAffected sets the "last_affected" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableLastAffected(s *string) *VulnerabilityRangeUpdate {
+	if s != nil {
+		vru.SetLastAffected(*s)
+	}
+	return vru
+}
+
+// SetOrigin sets the "origin" field.
+func (vru *VulnerabilityRangeUpdate) SetOrigin(s string) *VulnerabilityRangeUpdate {
+	vru.mutation.SetOrigin(s)
+	return vru
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableOrigin(s *string) *VulnerabilityRangeUpdate {
+	if s != nil {
+		vru.SetOrigin(*s)
+	}
+	return vru
+}
+
+// SetCollector sets the "collector" field.
+func (vru *VulnerabilityRangeUpdate) SetCollector(s string) *VulnerabilityRangeUpdate {
+	vru.mutation.SetCollector(s)
+	return vru
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableCollector(s *string) *VulnerabilityRangeUpdate {
+	if s != nil {
+		vru.SetCollector(*s)
+	}
+	return vru
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (vru *VulnerabilityRangeUpdate) SetDocumentRef(s string) *VulnerabilityRangeUpdate {
+	vru.mutation.SetDocumentRef(s)
+	return vru
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (vru *VulnerabilityRangeUpdate) SetNillableDocumentRef(s *string) *VulnerabilityRangeUpdate {
+	if s != nil {
+		vru.SetDocumentRef(*s)
+	}
+	return vru
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (vru *VulnerabilityRangeUpdate) SetVulnerability(v *VulnerabilityID) *VulnerabilityRangeUpdate {
+	return vru.SetVulnerabilityID(v.ID)
+}
+
+// SetPackageID sets the "package" edge to the PackageName entity by ID.
+func (vru *VulnerabilityRangeUpdate) SetPackageID(id uuid.UUID) *VulnerabilityRangeUpdate {
+	vru.mutation.SetPackageID(id)
+	return vru
+}
+
+// SetPackage sets the "package" edge to the PackageName entity.
+func (vru *VulnerabilityRangeUpdate) SetPackage(p *PackageName) *VulnerabilityRangeUpdate {
+	return vru.SetPackageID(p.ID)
+}
+
+// Mutation returns the VulnerabilityRangeMutation object of the builder.
+func (vru *VulnerabilityRangeUpdate) Mutation() *VulnerabilityRangeMutation {
+	return vru.mutation
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (vru *VulnerabilityRangeUpdate) ClearVulnerability() *VulnerabilityRangeUpdate {
+	vru.mutation.ClearVulnerability()
+	return vru
+}
+
+// ClearPackage clears the "package" edge to the PackageName entity.
+func (vru *VulnerabilityRangeUpdate) ClearPackage() *VulnerabilityRangeUpdate {
+	vru.mutation.ClearPackage()
+	return vru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vru *VulnerabilityRangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vru.sqlSave, vru.mutation, vru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vru *VulnerabilityRangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := vru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vru *VulnerabilityRangeUpdate) Exec(ctx context.Context) error {
+	_, err := vru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vru *VulnerabilityRangeUpdate) ExecX(ctx context.Context) {
+	if err := vru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vru *VulnerabilityRangeUpdate) check() error {
+	if v, ok := vru.mutation.RangeType(); ok {
+		if err := vulnerabilityrange.RangeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "range_type", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityRange.range_type": %w`, err)}
+		}
+	}
+	if vru.mutation.VulnerabilityCleared() && len(vru.mutation.VulnerabilityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VulnerabilityRange.vulnerability"`)
+	}
+	if vru.mutation.PackageCleared() && len(vru.mutation.PackageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VulnerabilityRange.package"`)
+	}
+	return nil
+}
+
+func (vru *VulnerabilityRangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vulnerabilityrange.Table, vulnerabilityrange.Columns, sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID))
+	if ps := vru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vru.mutation.RangeType(); ok {
+		_spec.SetField(vulnerabilityrange.FieldRangeType, field.TypeEnum, value)
+	}
+	if value, ok := vru.mutation.Introduced(); ok {
+		_spec.SetField(vulnerabilityrange.FieldIntroduced, field.TypeString, value)
+	}
+	if value, ok := vru.mutation.Fixed(); ok {
+		_spec.SetField(vulnerabilityrange.FieldFixed, field.TypeString, value)
+	}
+	if value, ok := vru.mutation.LastAffected(); ok {
+		_spec.SetField(vulnerabilityrange.FieldLastAffected, field.TypeString, value)
+	}
+	if value, ok := vru.mutation.Origin(); ok {
+		_spec.SetField(vulnerabilityrange.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := vru.mutation.Collector(); ok {
+		_spec.SetField(vulnerabilityrange.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := vru.mutation.DocumentRef(); ok {
+		_spec.SetField(vulnerabilityrange.FieldDocumentRef, field.TypeString, value)
+	}
+	if vru.mutation.VulnerabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.VulnerabilityTable,
+			Columns: []string{vulnerabilityrange.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vru.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.VulnerabilityTable,
+			Columns: []string{vulnerabilityrange.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vru.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.PackageTable,
+			Columns: []string{vulnerabilityrange.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vru.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.PackageTable,
+			Columns: []string{vulnerabilityrange.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vulnerabilityrange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vru.mutation.done = true
+	return n, nil
+}
+
+// VulnerabilityRangeUpdateOne is the builder for updating a single VulnerabilityRange entity.
+type VulnerabilityRangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VulnerabilityRangeMutation
+}
+
+// SetVulnerabilityID sets the "vulnerability_id" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetVulnerabilityID(u uuid.UUID) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetVulnerabilityID(u)
+	return vruo
+}
+
+// SetNillableVulnerabilityID sets the "vulnerability_id" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableVulnerabilityID(u *uuid.UUID) *VulnerabilityRangeUpdateOne {
+	if u != nil {
+		vruo.SetVulnerabilityID(*u)
+	}
+	return vruo
+}
+
+// SetPackageNameID sets the "package_name_id" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetPackageNameID(u uuid.UUID) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetPackageNameID(u)
+	return vruo
+}
+
+// SetNillablePackageNameID sets the "package_name_id" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillablePackageNameID(u *uuid.UUID) *VulnerabilityRangeUpdateOne {
+	if u != nil {
+		vruo.SetPackageNameID(*u)
+	}
+	return vruo
+}
+
+// SetRangeType sets the "range_type" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetRangeType(vt vulnerabilityrange.RangeType) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetRangeType(vt)
+	return vruo
+}
+
+// SetNillableRangeType sets the "range_type" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableRangeType(vt *vulnerabilityrange.RangeType) *VulnerabilityRangeUpdateOne {
+	if vt != nil {
+		vruo.SetRangeType(*vt)
+	}
+	return vruo
+}
+
+// SetIntroduced sets the "introduced" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetIntroduced(s string) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetIntroduced(s)
+	return vruo
+}
+
+// SetNillableIntroduced sets the "introduced" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableIntroduced(s *string) *VulnerabilityRangeUpdateOne {
+	if s != nil {
+		vruo.SetIntroduced(*s)
+	}
+	return vruo
+}
+
+// SetFixed sets the "fixed" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetFixed(s string) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetFixed(s)
+	return vruo
+}
+
+// SetNillableFixed sets the "fixed" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableFixed(s *string) *VulnerabilityRangeUpdateOne {
+	if s != nil {
+		vruo.SetFixed(*s)
+	}
+	return vruo
+}
+
+// SetLastAffected sets the "last_affected" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetLastAffected(s string) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetLastAffected(s)
+	return vruo
+}
+
+// SetNillableLastAffected sets the "last_affected" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableLastAffected(s *string) *VulnerabilityRangeUpdateOne {
+	if s != nil {
+		vruo.SetLastAffected(*s)
+	}
+	return vruo
+}
+
+// SetOrigin sets the "origin" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetOrigin(s string) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetOrigin(s)
+	return vruo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableOrigin(s *string) *VulnerabilityRangeUpdateOne {
+	if s != nil {
+		vruo.SetOrigin(*s)
+	}
+	return vruo
+}
+
+// SetCollector sets the "collector" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetCollector(s string) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetCollector(s)
+	return vruo
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableCollector(s *string) *VulnerabilityRangeUpdateOne {
+	if s != nil {
+		vruo.SetCollector(*s)
+	}
+	return vruo
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (vruo *VulnerabilityRangeUpdateOne) SetDocumentRef(s string) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetDocumentRef(s)
+	return vruo
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (vruo *VulnerabilityRangeUpdateOne) SetNillableDocumentRef(s *string) *VulnerabilityRangeUpdateOne {
+	if s != nil {
+		vruo.SetDocumentRef(*s)
+	}
+	return vruo
+}
+
+// SetVulnerability sets the "vulnerability" edge to the VulnerabilityID entity.
+func (vruo *VulnerabilityRangeUpdateOne) SetVulnerability(v *VulnerabilityID) *VulnerabilityRangeUpdateOne {
+	return vruo.SetVulnerabilityID(v.ID)
+}
+
+// SetPackageID sets the "package" edge to the PackageName entity by ID.
+func (vruo *VulnerabilityRangeUpdateOne) SetPackageID(id uuid.UUID) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.SetPackageID(id)
+	return vruo
+}
+
+// SetPackage sets the "package" edge to the PackageName entity.
+func (vruo *VulnerabilityRangeUpdateOne) SetPackage(p *PackageName) *VulnerabilityRangeUpdateOne {
+	return vruo.SetPackageID(p.ID)
+}
+
+// Mutation returns the VulnerabilityRangeMutation object of the builder.
+func (vruo *VulnerabilityRangeUpdateOne) Mutation() *VulnerabilityRangeMutation {
+	return vruo.mutation
+}
+
+// ClearVulnerability clears the "vulnerability" edge to the VulnerabilityID entity.
+func (vruo *VulnerabilityRangeUpdateOne) ClearVulnerability() *VulnerabilityRangeUpdateOne {
+	vruo.mutation.ClearVulnerability()
+	return vruo
+}
+
+// ClearPackage clears the "package" edge to the PackageName entity.
+func (vruo *VulnerabilityRangeUpdateOne) ClearPackage() *VulnerabilityRangeUpdateOne {
+	vruo.mutation.ClearPackage()
+	return vruo
+}
+
+// Where appends a list predicates to the VulnerabilityRangeUpdate builder.
+func (vruo *VulnerabilityRangeUpdateOne) Where(ps ...predicate.VulnerabilityRange) *VulnerabilityRangeUpdateOne {
+	vruo.mutation.Where(ps...)
+	return vruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vruo *VulnerabilityRangeUpdateOne) Select(field string, fields ...string) *VulnerabilityRangeUpdateOne {
+	vruo.fields = append([]string{field}, fields...)
+	return vruo
+}
+
+// Save executes the query and returns the updated VulnerabilityRange entity.
+func (vruo *VulnerabilityRangeUpdateOne) Save(ctx context.Context) (*VulnerabilityRange, error) {
+	return withHooks(ctx, vruo.sqlSave, vruo.mutation, vruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vruo *VulnerabilityRangeUpdateOne) SaveX(ctx context.Context) *VulnerabilityRange {
+	node, err := vruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vruo *VulnerabilityRangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := vruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vruo *VulnerabilityRangeUpdateOne) ExecX(ctx context.Context) {
+	if err := vruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vruo *VulnerabilityRangeUpdateOne) check() error {
+	if v, ok := vruo.mutation.RangeType(); ok {
+		if err := vulnerabilityrange.RangeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "range_type", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityRange.range_type": %w`, err)}
+		}
+	}
+	if vruo.mutation.VulnerabilityCleared() && len(vruo.mutation.VulnerabilityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VulnerabilityRange.vulnerability"`)
+	}
+	if vruo.mutation.PackageCleared() && len(vruo.mutation.PackageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "VulnerabilityRange.package"`)
+	}
+	return nil
+}
+
+func (vruo *VulnerabilityRangeUpdateOne) sqlSave(ctx context.Context) (_node *VulnerabilityRange, err error) {
+	if err := vruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vulnerabilityrange.Table, vulnerabilityrange.Columns, sqlgraph.NewFieldSpec(vulnerabilityrange.FieldID, field.TypeUUID))
+	id, ok := vruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VulnerabilityRange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vulnerabilityrange.FieldID)
+		for _, f := range fields {
+			if !vulnerabilityrange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vulnerabilityrange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vruo.mutation.RangeType(); ok {
+		_spec.SetField(vulnerabilityrange.FieldRangeType, field.TypeEnum, value)
+	}
+	if value, ok := vruo.mutation.Introduced(); ok {
+		_spec.SetField(vulnerabilityrange.FieldIntroduced, field.TypeString, value)
+	}
+	if value, ok := vruo.mutation.Fixed(); ok {
+		_spec.SetField(vulnerabilityrange.FieldFixed, field.TypeString, value)
+	}
+	if value, ok := vruo.mutation.LastAffected(); ok {
+		_spec.SetField(vulnerabilityrange.FieldLastAffected, field.TypeString, value)
+	}
+	if value, ok := vruo.mutation.Origin(); ok {
+		_spec.SetField(vulnerabilityrange.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := vruo.mutation.Collector(); ok {
+		_spec.SetField(vulnerabilityrange.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := vruo.mutation.DocumentRef(); ok {
+		_spec.SetField(vulnerabilityrange.FieldDocumentRef, field.TypeString, value)
+	}
+	if vruo.mutation.VulnerabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.VulnerabilityTable,
+			Columns: []string{vulnerabilityrange.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vruo.mutation.VulnerabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.VulnerabilityTable,
+			Columns: []string{vulnerabilityrange.VulnerabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vulnerabilityid.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vruo.mutation.PackageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.PackageTable,
+			Columns: []string{vulnerabilityrange.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vruo.mutation.PackageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   vulnerabilityrange.PackageTable,
+			Columns: []string{vulnerabilityrange.PackageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(packagename.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VulnerabilityRange{config: vruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vulnerabilityrange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vruo.mutation.done = true
+	return _node, nil
+}
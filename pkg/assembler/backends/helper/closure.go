@@ -0,0 +1,81 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// ClosureLink is a link followed by DependencyClosure and DependentClosure,
+// from a package version or artifact to another one.
+type ClosureLink struct {
+	To string
+	// Equivalent is set for the links of IsOccurrence and HashEqual, which
+	// do not add to the depth.
+	Equivalent bool
+}
+
+// Closure returns the nodes reachable from subject through the links returned
+// by next, each with the least number of links that are not equivalences
+// needed to reach it, up to maxDepth if set. The subject and its equivalent
+// nodes are left out. Nodes are sorted by depth and then by ID.
+func Closure(subject string, maxDepth *int, next func(id string) ([]ClosureLink, error)) ([]*model.ClosureEntry, error) {
+	depth := map[string]int{subject: 0}
+	// Equivalences cost nothing, so they go to the front of the queue to
+	// visit nodes in order of depth.
+	queue := []string{subject}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		links, err := next(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range links {
+			d := depth[id]
+			if !l.Equivalent {
+				d++
+			}
+			if maxDepth != nil && d > *maxDepth {
+				continue
+			}
+			if old, seen := depth[l.To]; seen && old <= d {
+				continue
+			}
+			depth[l.To] = d
+			if l.Equivalent {
+				queue = append([]string{l.To}, queue...)
+			} else {
+				queue = append(queue, l.To)
+			}
+		}
+	}
+
+	out := make([]*model.ClosureEntry, 0, len(depth))
+	for id, d := range depth {
+		if d > 0 {
+			out = append(out, &model.ClosureEntry{ID: id, Depth: d})
+		}
+	}
+	slices.SortFunc(out, func(a, b *model.ClosureEntry) int {
+		return cmp.Or(cmp.Compare(a.Depth, b.Depth), cmp.Compare(a.ID, b.ID))
+	})
+	return out, nil
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestClosure(t *testing.T) {
+	// a depends on b and c, b is the same artifact as b2, which depends on d
+	// and c also depends on d. e depends back on a.
+	links := map[string][]ClosureLink{
+		"a":  {{To: "b"}, {To: "c"}, {To: "a2", Equivalent: true}},
+		"a2": {{To: "a", Equivalent: true}},
+		"b":  {{To: "b2", Equivalent: true}},
+		"b2": {{To: "b", Equivalent: true}, {To: "d"}},
+		"c":  {{To: "d"}},
+		"d":  {{To: "e"}},
+		"e":  {{To: "a"}},
+	}
+	next := func(id string) ([]ClosureLink, error) {
+		return links[id], nil
+	}
+	one := 1
+
+	tests := []struct {
+		name     string
+		maxDepth *int
+		want     []*model.ClosureEntry
+	}{
+		{
+			name: "all",
+			want: []*model.ClosureEntry{
+				{ID: "b", Depth: 1},
+				{ID: "b2", Depth: 1},
+				{ID: "c", Depth: 1},
+				{ID: "d", Depth: 2},
+				{ID: "e", Depth: 3},
+			},
+		},
+		{
+			name:     "max depth",
+			maxDepth: &one,
+			want: []*model.ClosureEntry{
+				{ID: "b", Depth: 1},
+				{ID: "b2", Depth: 1},
+				{ID: "c", Depth: 1},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Closure("a", test.maxDepth, next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
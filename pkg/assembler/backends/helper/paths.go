@@ -0,0 +1,236 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// PathPattern is a deterministic automaton over edges that tells which edges a
+// path of AllPaths or ConstrainedPath can follow next. A walk starts in state
+// 0 and a path is returned when it reaches the target in an accepting state.
+type PathPattern struct {
+	next      []map[model.Edge]int
+	accepting []bool
+	weights   map[model.Edge]float64
+}
+
+// AllPathsPattern returns the pattern of AllPaths, which follows any edge of
+// usingOnly, or any edge if usingOnly is empty, with the given weights.
+func AllPathsPattern(usingOnly []model.Edge, weights []*model.EdgeWeight) (*PathPattern, error) {
+	if len(usingOnly) == 0 {
+		usingOnly = model.AllEdge
+	}
+	p := &PathPattern{
+		next:      []map[model.Edge]int{{}},
+		accepting: []bool{true},
+		weights:   map[model.Edge]float64{},
+	}
+	for _, e := range usingOnly {
+		if !e.IsValid() {
+			return nil, fmt.Errorf("invalid edge %q", e)
+		}
+		p.next[0][e] = 0
+	}
+	for _, w := range weights {
+		if !w.Edge.IsValid() {
+			return nil, fmt.Errorf("invalid edge %q in weights", w.Edge)
+		}
+		if w.Weight < 0 {
+			return nil, fmt.Errorf("weight of %s must not be negative, got %v", w.Edge, w.Weight)
+		}
+		p.weights[w.Edge] = w.Weight
+	}
+	return p, nil
+}
+
+// ConstrainedPathPattern returns the pattern of ConstrainedPath, which follows
+// the edges of each segment in order, repeating the repeated segments zero or
+// more times.
+func ConstrainedPathPattern(segments []*model.PathSegment) (*PathPattern, error) {
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("at least one path segment is needed")
+	}
+
+	// Build a nondeterministic automaton with a state per edge of each
+	// segment, plus a final state, then turn it into a deterministic one so
+	// that each path is found once.
+	start := make([]int, len(segments)+1)
+	for i, s := range segments {
+		if len(s.Edges) == 0 {
+			return nil, fmt.Errorf("path segment %d has no edges", i)
+		}
+		for _, e := range s.Edges {
+			if !e.IsValid() {
+				return nil, fmt.Errorf("invalid edge %q in path segment %d", e, i)
+			}
+		}
+		start[i+1] = start[i] + len(s.Edges)
+	}
+	final := start[len(segments)]
+
+	// entry[i] is the set of states a walk can be in when starting segment i,
+	// which skips the following repeated segments.
+	entry := make([][]int, len(segments)+1)
+	entry[len(segments)] = []int{final}
+	for i := len(segments) - 1; i >= 0; i-- {
+		entry[i] = []int{start[i]}
+		if segments[i].Repeated != nil && *segments[i].Repeated {
+			entry[i] = append(entry[i], entry[i+1]...)
+		}
+	}
+
+	type step struct {
+		edge model.Edge
+		to   []int
+	}
+	steps := make([]step, final)
+	for i, s := range segments {
+		for j, e := range s.Edges {
+			to := entry[i+1]
+			switch {
+			case j+1 < len(s.Edges):
+				to = []int{start[i] + j + 1}
+			case s.Repeated != nil && *s.Repeated:
+				to = entry[i]
+			}
+			steps[start[i]+j] = step{edge: e, to: to}
+		}
+	}
+
+	p := &PathPattern{weights: map[model.Edge]float64{}}
+	stateOf := map[string]int{}
+	var sets [][]int
+	add := func(set []int) int {
+		set = slices.Compact(slices.Sorted(slices.Values(set)))
+		key := fmt.Sprint(set)
+		if id, ok := stateOf[key]; ok {
+			return id
+		}
+		id := len(sets)
+		stateOf[key] = id
+		sets = append(sets, set)
+		p.next = append(p.next, map[model.Edge]int{})
+		p.accepting = append(p.accepting, slices.Contains(set, final))
+		return id
+	}
+	add(entry[0])
+	for id := 0; id < len(sets); id++ {
+		targets := map[model.Edge][]int{}
+		for _, q := range sets[id] {
+			if q != final {
+				targets[steps[q].edge] = append(targets[steps[q].edge], steps[q].to...)
+			}
+		}
+		for e, to := range targets {
+			p.next[id][e] = add(to)
+		}
+	}
+	return p, nil
+}
+
+// States returns the number of states of the pattern.
+func (p *PathPattern) States() int {
+	return len(p.next)
+}
+
+// Edges returns the edges that can be followed from state, sorted.
+func (p *PathPattern) Edges(state int) []model.Edge {
+	edges := make([]model.Edge, 0, len(p.next[state]))
+	for e := range p.next[state] {
+		edges = append(edges, e)
+	}
+	slices.Sort(edges)
+	return edges
+}
+
+// Next returns the state reached by following edge from state, and whether the
+// edge can be followed.
+func (p *PathPattern) Next(state int, edge model.Edge) (int, bool) {
+	to, ok := p.next[state][edge]
+	return to, ok
+}
+
+// Accepting reports whether a path can end in state.
+func (p *PathPattern) Accepting(state int) bool {
+	return p.accepting[state]
+}
+
+// Weight returns the weight of following edge.
+func (p *PathPattern) Weight(edge model.Edge) float64 {
+	if w, ok := p.weights[edge]; ok {
+		return w
+	}
+	return 1
+}
+
+// FoundPath is a path found by a backend, as the IDs of its nodes.
+type FoundPath struct {
+	IDs    []string
+	Weight float64
+}
+
+// SortFoundPaths orders paths by weight, then length, then node IDs, which is
+// the order of AllPaths and ConstrainedPath.
+func SortFoundPaths(paths []FoundPath) {
+	slices.SortFunc(paths, func(a, b FoundPath) int {
+		return cmp.Or(
+			cmp.Compare(a.Weight, b.Weight),
+			cmp.Compare(len(a.IDs), len(b.IDs)),
+			strings.Compare(strings.Join(a.IDs, "\x00"), strings.Join(b.IDs, "\x00")),
+		)
+	})
+}
+
+// BuildPaths turns found paths into model paths, looking up each node once with
+// nodes, which must return the nodes of ids in the same order.
+func BuildPaths(paths []FoundPath, nodes func(ids []string) ([]model.Node, error)) ([]*model.Path, error) {
+	var ids []string
+	for _, p := range paths {
+		ids = append(ids, p.IDs...)
+	}
+	ids = SortAndRemoveDups(ids)
+	found, err := nodes(ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(ids) {
+		return nil, fmt.Errorf("found %d of the %d nodes on the paths", len(found), len(ids))
+	}
+	byID := make(map[string]model.Node, len(ids))
+	for i, id := range ids {
+		byID[id] = found[i]
+	}
+
+	out := make([]*model.Path, 0, len(paths))
+	for _, p := range paths {
+		path := &model.Path{
+			Length: len(p.IDs) - 1,
+			Weight: p.Weight,
+			Nodes:  make([]model.Node, 0, len(p.IDs)),
+		}
+		for _, id := range p.IDs {
+			path.Nodes = append(path.Nodes, byID[id])
+		}
+		out = append(out, path)
+	}
+	return out, nil
+}
@@ -0,0 +1,125 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// accepts reports whether the pattern accepts a path following edges.
+func accepts(p *PathPattern, edges ...model.Edge) bool {
+	state := 0
+	for _, e := range edges {
+		next, ok := p.Next(state, e)
+		if !ok {
+			return false
+		}
+		state = next
+	}
+	return p.Accepting(state)
+}
+
+func TestConstrainedPathPattern(t *testing.T) {
+	repeated := true
+	occurrence := []model.Edge{model.EdgeArtifactIsOccurrence, model.EdgeIsOccurrencePackage}
+	dependency := []model.Edge{model.EdgePackageIsDependency, model.EdgeIsDependencyPackage}
+	p, err := ConstrainedPathPattern([]*model.PathSegment{
+		{Edges: occurrence},
+		{Edges: dependency, Repeated: &repeated},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		edges []model.Edge
+		want  bool
+	}{
+		{"no edges", nil, false},
+		{"occurrence", occurrence, true},
+		{"half an occurrence", occurrence[:1], false},
+		{"one dependency", append(occurrence, dependency...), true},
+		{"two dependencies", append(append(occurrence, dependency...), dependency...), true},
+		{"half a dependency", append(occurrence, dependency[0]), false},
+		{"dependency first", append(dependency, occurrence...), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := accepts(p, test.edges...); got != test.want {
+				t.Errorf("accepts %v = %v, want %v", test.edges, got, test.want)
+			}
+		})
+	}
+}
+
+func TestConstrainedPathPatternOverlap(t *testing.T) {
+	// Both segments start with the same edge, which a deterministic pattern
+	// follows to a single state.
+	repeated := true
+	p, err := ConstrainedPathPattern([]*model.PathSegment{
+		{Edges: []model.Edge{model.EdgePackageIsDependency, model.EdgeIsDependencyPackage}, Repeated: &repeated},
+		{Edges: []model.Edge{model.EdgePackageIsDependency}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !accepts(p, model.EdgePackageIsDependency) {
+		t.Error("pattern does not accept skipping the repeated segment")
+	}
+	if !accepts(p, model.EdgePackageIsDependency, model.EdgeIsDependencyPackage, model.EdgePackageIsDependency) {
+		t.Error("pattern does not accept repeating the first segment once")
+	}
+	if accepts(p, model.EdgePackageIsDependency, model.EdgeIsDependencyPackage) {
+		t.Error("pattern accepts a path without the last segment")
+	}
+}
+
+func TestPathPatternErrors(t *testing.T) {
+	if _, err := ConstrainedPathPattern(nil); err == nil {
+		t.Error("no segments: want error")
+	}
+	if _, err := ConstrainedPathPattern([]*model.PathSegment{{}}); err == nil {
+		t.Error("segment without edges: want error")
+	}
+	if _, err := ConstrainedPathPattern([]*model.PathSegment{{Edges: []model.Edge{"NOT_AN_EDGE"}}}); err == nil {
+		t.Error("invalid edge: want error")
+	}
+	if _, err := AllPathsPattern(nil, []*model.EdgeWeight{{Edge: model.EdgePackageIsDependency, Weight: -1}}); err == nil {
+		t.Error("negative weight: want error")
+	}
+}
+
+func TestAllPathsPattern(t *testing.T) {
+	p, err := AllPathsPattern([]model.Edge{model.EdgePackageIsDependency}, []*model.EdgeWeight{{Edge: model.EdgePackageIsDependency, Weight: 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !accepts(p) || !accepts(p, model.EdgePackageIsDependency, model.EdgePackageIsDependency) {
+		t.Error("pattern does not accept paths of allowed edges")
+	}
+	if accepts(p, model.EdgeIsDependencyPackage) {
+		t.Error("pattern accepts an edge not in usingOnly")
+	}
+	if w := p.Weight(model.EdgePackageIsDependency); w != 3 {
+		t.Errorf("weight = %v, want 3", w)
+	}
+	if w := p.Weight(model.EdgeIsDependencyPackage); w != 1 {
+		t.Errorf("default weight = %v, want 1", w)
+	}
+}
@@ -43,6 +43,7 @@ type artStruct struct {
 	GoodLinks           []string
 	HasMetadataLinks    []string
 	PointOfContactLinks []string
+	CertifyPolicyLinks  []string
 }
 
 func (n *artStruct) ID() string { return n.ThisID }
@@ -76,6 +77,9 @@ func (n *artStruct) Neighbors(allowedEdges edgeMap) []string {
 	if allowedEdges[model.EdgeArtifactPointOfContact] {
 		out = append(out, n.PointOfContactLinks...)
 	}
+	if allowedEdges[model.EdgeArtifactCertifyPolicy] {
+		out = append(out, n.CertifyPolicyLinks...)
+	}
 
 	return out
 }
@@ -121,6 +125,11 @@ func (n *artStruct) setPointOfContactLinks(ctx context.Context, ID string, c *de
 	return setkv(ctx, artCol, n, c)
 }
 
+func (n *artStruct) setCertifyPolicyLinks(ctx context.Context, ID string, c *demoClient) error {
+	n.CertifyPolicyLinks = append(n.CertifyPolicyLinks, ID)
+	return setkv(ctx, artCol, n, c)
+}
+
 func (n *artStruct) Key() string {
 	return hashKey(strings.Join([]string{n.Algorithm, n.Digest}, ":"))
 }
@@ -168,8 +177,8 @@ func (c *demoClient) ingestArtifact(ctx context.Context, artifact *model.IDorArt
 		Digest:    digest,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	outA, err := byKeykv[*artStruct](ctx, artCol, inA.Key(), c)
 
@@ -194,6 +203,9 @@ func (c *demoClient) ingestArtifact(ctx context.Context, artifact *model.IDorArt
 		outA = inA
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return outA.ThisID, nil
 }
 
@@ -0,0 +1,156 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"time"
+)
+
+// knownAsOf reports whether a predicate of time at is known at asOf: it is
+// not after asOf, and no predicate of ids about the same subject is both
+// later than it and not after asOf. A nil asOf keeps every predicate.
+func knownAsOf[E node](ctx context.Context, c *demoClient, asOf *time.Time, at time.Time, ids []string,
+	same func(E) bool, timeOf func(E) time.Time) (bool, error) {
+	if asOf == nil {
+		return true, nil
+	}
+	if at.After(*asOf) {
+		return false, nil
+	}
+	for _, id := range ids {
+		other, err := byIDkv[E](ctx, id, c)
+		if err != nil {
+			return false, err
+		}
+		if !same(other) {
+			continue
+		}
+		if t := timeOf(other); t.After(at) && !t.After(*asOf) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// hasSBOMKnownAsOf hides the SBOMs superseded by a later SBOM of the same
+// subject.
+func (c *demoClient) hasSBOMKnownAsOf(ctx context.Context, link *hasSBOMStruct, asOf *time.Time) (bool, error) {
+	if asOf == nil {
+		return true, nil
+	}
+	var ids []string
+	if link.Pkg != "" {
+		pkg, err := byIDkv[*pkgVersion](ctx, link.Pkg, c)
+		if err != nil {
+			return false, err
+		}
+		ids = pkg.HasSBOMs
+	} else {
+		art, err := byIDkv[*artStruct](ctx, link.Artifact, c)
+		if err != nil {
+			return false, err
+		}
+		ids = art.HasSBOMs
+	}
+	return knownAsOf(ctx, c, asOf, link.KnownSince, ids,
+		func(o *hasSBOMStruct) bool { return o.Pkg == link.Pkg && o.Artifact == link.Artifact },
+		func(o *hasSBOMStruct) time.Time { return o.KnownSince })
+}
+
+// certifyVulnKnownAsOf hides the results of a scan superseded by a later
+// scan of the same package by the same scanner.
+func (c *demoClient) certifyVulnKnownAsOf(ctx context.Context, link *certifyVulnerabilityLink, asOf *time.Time) (bool, error) {
+	if asOf == nil {
+		return true, nil
+	}
+	pkg, err := byIDkv[*pkgVersion](ctx, link.PackageID, c)
+	if err != nil {
+		return false, err
+	}
+	return knownAsOf(ctx, c, asOf, link.TimeScanned, pkg.CertifyVulnLinks,
+		func(o *certifyVulnerabilityLink) bool {
+			return o.PackageID == link.PackageID && o.ScannerURI == link.ScannerURI
+		},
+		func(o *certifyVulnerabilityLink) time.Time { return o.TimeScanned })
+}
+
+// vexKnownAsOf hides the VEX statements superseded by a later statement
+// about the same subject and vulnerability.
+func (c *demoClient) vexKnownAsOf(ctx context.Context, link *vexLink, asOf *time.Time) (bool, error) {
+	if asOf == nil {
+		return true, nil
+	}
+	var ids []string
+	if link.PackageID != "" {
+		pkg, err := byIDkv[*pkgVersion](ctx, link.PackageID, c)
+		if err != nil {
+			return false, err
+		}
+		ids = pkg.VexLinks
+	} else {
+		art, err := byIDkv[*artStruct](ctx, link.ArtifactID, c)
+		if err != nil {
+			return false, err
+		}
+		ids = art.VexLinks
+	}
+	return knownAsOf(ctx, c, asOf, link.KnownSince, ids,
+		func(o *vexLink) bool {
+			return o.PackageID == link.PackageID && o.ArtifactID == link.ArtifactID && o.VulnerabilityID == link.VulnerabilityID
+		},
+		func(o *vexLink) time.Time { return o.KnownSince })
+}
+
+// legalKnownAsOf hides the legal certifications superseded by a later one
+// of the same subject.
+func (c *demoClient) legalKnownAsOf(ctx context.Context, link *certifyLegalStruct, asOf *time.Time) (bool, error) {
+	if asOf == nil {
+		return true, nil
+	}
+	var ids []string
+	if link.Pkg != "" {
+		pkg, err := byIDkv[*pkgVersion](ctx, link.Pkg, c)
+		if err != nil {
+			return false, err
+		}
+		ids = pkg.CertifyLegals
+	} else {
+		src, err := byIDkv[*srcNameNode](ctx, link.Source, c)
+		if err != nil {
+			return false, err
+		}
+		ids = src.CertifyLegals
+	}
+	return knownAsOf(ctx, c, asOf, link.TimeScanned, ids,
+		func(o *certifyLegalStruct) bool { return o.Pkg == link.Pkg && o.Source == link.Source },
+		func(o *certifyLegalStruct) time.Time { return o.TimeScanned })
+}
+
+// scorecardKnownAsOf hides the scorecards superseded by a later scorecard of
+// the same source.
+func (c *demoClient) scorecardKnownAsOf(ctx context.Context, link *scorecardLink, asOf *time.Time) (bool, error) {
+	if asOf == nil {
+		return true, nil
+	}
+	src, err := byIDkv[*srcNameNode](ctx, link.SourceID, c)
+	if err != nil {
+		return false, err
+	}
+	return knownAsOf(ctx, c, asOf, link.TimeScanned, src.ScorecardLinks,
+		func(o *scorecardLink) bool { return o.SourceID == link.SourceID },
+		func(o *scorecardLink) time.Time { return o.TimeScanned })
+}
@@ -22,6 +22,7 @@ import (
 	"hash/fnv"
 	"math"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,6 +31,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/bolt"
 	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
 	"github.com/guacsec/guac/pkg/assembler/kv/redis"
 	"github.com/spf13/cobra"
@@ -41,14 +43,19 @@ var flags = struct {
 	kvStore string
 	kvRedis string
 	kvTiKV  string
+	kvPath  string
+
+	kvTiKVMigrate bool
 }{}
 
 // registerFlags registers KeyValue-specific command line flags
 func registerFlags(cmd *cobra.Command) error {
 	flagSet := cmd.Flags()
-	flagSet.StringVar(&flags.kvStore, "kv-store", "memmap", "Which keyvalue store to use: memmap, redis, tikv.")
+	flagSet.StringVar(&flags.kvStore, "kv-store", "memmap", "Which keyvalue store to use: memmap, bolt, redis, tikv.")
+	flagSet.StringVar(&flags.kvPath, "kv-path", "guac.db", "Path of the database file of the bolt keyvalue store, created if it does not exist")
 	flagSet.StringVar(&flags.kvRedis, "kv-redis", "redis://user@localhost:6379/0", "Experimental: Redis connection string for keyvalue backend")
 	flagSet.StringVar(&flags.kvTiKV, "kv-tikv", "127.0.0.1:2379", "Experimental: TiKV address and port")
+	flagSet.BoolVar(&flags.kvTiKVMigrate, "kv-tikv-migrate", false, "Move data written with the raw TiKV API by earlier GUAC versions to the transactional API before starting")
 
 	if err := viper.BindPFlags(flagSet); err != nil {
 		return fmt.Errorf("failed to bind flags: %w", err)
@@ -58,11 +65,16 @@ func registerFlags(cmd *cobra.Command) error {
 	flags.kvStore = viper.GetString("kv-store")
 	flags.kvRedis = viper.GetString("kv-redis")
 	flags.kvTiKV = viper.GetString("kv-tikv")
+	flags.kvPath = viper.GetString("kv-path")
+	flags.kvTiKVMigrate = viper.GetBool("kv-tikv-migrate")
 
 	return nil
 }
 
-var tikvGS func(context.Context, string) (kv.Store, error)
+var (
+	tikvGS      func(context.Context, string) (kv.Store, error)
+	tikvMigrate func(context.Context, string) error
+)
 
 // parseFlags returns the KeyValue store configuration from parsed flags
 func parseFlags(ctx context.Context) (backends.BackendArgs, error) {
@@ -70,6 +82,12 @@ func parseFlags(ctx context.Context) (backends.BackendArgs, error) {
 	case "memmap":
 		// default is memmap
 		return nil, nil
+	case "bolt":
+		s, err := bolt.GetStore(flags.kvPath)
+		if err != nil {
+			return nil, fmt.Errorf("error with bolt: %w", err)
+		}
+		return s, nil
 	case "redis":
 		s, err := redis.GetStore(flags.kvRedis)
 		if err != nil {
@@ -80,6 +98,11 @@ func parseFlags(ctx context.Context) (backends.BackendArgs, error) {
 		if tikvGS == nil {
 			return nil, fmt.Errorf("TiKV not supported on 32-bit")
 		}
+		if flags.kvTiKVMigrate {
+			if err := tikvMigrate(ctx, flags.kvTiKV); err != nil {
+				return nil, fmt.Errorf("error migrating TiKV data: %w", err)
+			}
+		}
 		s, err := tikvGS(ctx, flags.kvTiKV)
 		if err != nil {
 			return nil, fmt.Errorf("error with TiKV: %w", err)
@@ -152,6 +175,7 @@ const (
 	builderCol  = "builders"
 	licenseCol  = "licenses"
 	clCol       = "certifyLegals"
+	cpCol       = "certifyPolicies"
 	cscCol      = "certifyScorecards"
 	slsaCol     = "hasSLSAs"
 	hsaCol      = "hasSourceAts"
@@ -162,6 +186,7 @@ const (
 	vulnIDCol   = "vulnIDs"
 	vulnEqCol   = "vulnEquals"
 	vulnMDCol   = "vulnMetadatas"
+	vulnRngCol  = "vulnerabilityRanges"
 	cVEXCol     = "certifyVEXs"
 	cVulnCol    = "certifyVulns"
 )
@@ -202,6 +227,8 @@ func typeColMap(col string) node {
 		return &licStruct{}
 	case clCol:
 		return &certifyLegalStruct{}
+	case cpCol:
+		return &certifyPolicyLink{}
 	case cscCol:
 		return &scorecardLink{}
 	case slsaCol:
@@ -222,6 +249,8 @@ func typeColMap(col string) node {
 		return &vulnerabilityEqualLink{}
 	case vulnMDCol:
 		return &vulnerabilityMetadataLink{}
+	case vulnRngCol:
+		return &vulnerabilityRangeLink{}
 	case cVEXCol:
 		return &vexLink{}
 	case cVulnCol:
@@ -232,14 +261,36 @@ func typeColMap(col string) node {
 
 // atomic add to ensure ID is not duplicated
 func (c *demoClient) getNextID() string {
-	atomic.AddUint32(&c.id, 1)
-	return fmt.Sprintf("%d", c.id)
+	return fmt.Sprintf("%d", atomic.AddUint32(&c.id, 1))
+}
+
+// restoreNextID continues the IDs after the largest one already in the
+// index, so that a persistent store opened again after a restart does not
+// hand out IDs that are in use.
+func (c *demoClient) restoreNextID(ctx context.Context) error {
+	var last uint64
+	scn := c.kv.Keys(indexCol)
+	for done := false; !done; {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if id, err := strconv.ParseUint(k, 10, 32); err == nil && id > last {
+				last = id
+			}
+		}
+	}
+	atomic.StoreUint32(&c.id, uint32(last))
+	return nil
 }
 
 type demoClient struct {
 	id uint32
 	m  sync.RWMutex
-	kv kv.Store
+	kv *txnStore
 }
 
 func getBackend(ctx context.Context, opts backends.BackendArgs) (backends.Backend, error) {
@@ -248,7 +299,11 @@ func getBackend(ctx context.Context, opts backends.BackendArgs) (backends.Backen
 	if !ok {
 		store = memmap.GetStore()
 	}
-	return &demoClient{kv: store}, nil
+	c := &demoClient{kv: newTxnStore(store)}
+	if err := c.restoreNextID(ctx); err != nil {
+		return nil, fmt.Errorf("failed to read existing IDs: %w", err)
+	}
+	return c, nil
 }
 
 func noMatch(filter *string, value string) bool {
@@ -323,19 +378,26 @@ func validateType[E node](n E, c string) error {
 		reflect.TypeOf(typeColMap(c)), reflect.TypeOf(n))
 }
 
-func lock(m *sync.RWMutex, readOnly bool) {
+// lock takes the read lock, or the write lock and opens a transaction on the
+// store. Writes made under the write lock are only stored once they are
+// committed with c.kv.commit.
+func (c *demoClient) lock(readOnly bool) {
 	if readOnly {
-		m.RLock()
+		c.m.RLock()
 	} else {
-		m.Lock()
+		c.m.Lock()
+		c.kv.begin()
 	}
 }
 
-func unlock(m *sync.RWMutex, readOnly bool) {
+// unlock releases the lock taken with lock, dropping any writes that were not
+// committed.
+func (c *demoClient) unlock(readOnly bool) {
 	if readOnly {
-		m.RUnlock()
+		c.m.RUnlock()
 	} else {
-		m.Unlock()
+		c.kv.rollback()
+		c.m.Unlock()
 	}
 }
 
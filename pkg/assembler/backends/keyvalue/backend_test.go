@@ -0,0 +1,102 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+// TestRestoreNextID reopens a backend on a store that already has data, as
+// happens with a persistent store after a restart, and checks that new nodes
+// do not reuse IDs.
+func TestRestoreNextID(t *testing.T) {
+	ctx := context.Background()
+	store := memmap.GetStore()
+
+	b1, err := getBackend(ctx, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := b1.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "1111",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b2, err := getBackend(ctx, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := b2.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "2222",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Errorf("artifact ingested after reopening reused ID %q", first)
+	}
+
+	got, err := b2.Artifacts(ctx, &model.ArtifactSpec{Digest: ptrfrom.String("1111")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != first {
+		t.Errorf("artifact ingested before reopening = %+v, want ID %q", got, first)
+	}
+}
+
+// TestNegativeFirst checks that a negative page size is rejected instead of
+// panicking when slicing the results.
+func TestNegativeFirst(t *testing.T) {
+	ctx := context.Background()
+	b, err := getBackend(ctx, memmap.GetStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "1111",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := ptrfrom.Int(-1)
+	if _, err := b.AllPaths(ctx, id, id, 2, nil, nil, first); err == nil {
+		t.Error("AllPaths accepted a negative first")
+	}
+	if _, err := b.ConstrainedPath(ctx, id, id, nil, 2, first); err == nil {
+		t.Error("ConstrainedPath accepted a negative first")
+	}
+	if _, err := b.NeighborsList(ctx, id, nil, nil, first); err == nil {
+		t.Error("NeighborsList accepted a negative first")
+	}
+	if _, err := b.FindSoftwareList(ctx, "1111", nil, first); err == nil {
+		t.Error("FindSoftwareList accepted a negative first")
+	}
+	if _, err := b.QueryPackagesListForScan(ctx, []string{id}, nil, first); err == nil {
+		t.Error("QueryPackagesListForScan accepted a negative first")
+	}
+}
@@ -87,8 +87,8 @@ func (c *demoClient) ingestBuilder(ctx context.Context, builder *model.IDorBuild
 		URI: builder.BuilderInput.URI,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	out, err := byKeykv[*builderStruct](ctx, builderCol, in.Key(), c)
 	if err == nil {
@@ -111,6 +111,9 @@ func (c *demoClient) ingestBuilder(ctx context.Context, builder *model.IDorBuild
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -122,8 +122,8 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 		KnownSince:    certifyBad.KnownSince.UTC(),
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var foundPkgNameOrVersionNode pkgNameOrVersion
 	var foundArtStruct *artStruct
@@ -187,6 +187,9 @@ func (c *demoClient) ingestCertifyBad(ctx context.Context, subject model.Package
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -450,6 +453,9 @@ func (c *demoClient) CertifyBad(ctx context.Context, filter *model.CertifyBadSpe
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
+				if cb == nil {
+					continue
+				}
 
 				out = append(out, cb)
 			}
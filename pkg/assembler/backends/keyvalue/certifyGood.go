@@ -121,8 +121,8 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 		KnownSince:    certifyGood.KnownSince.UTC(),
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var foundPkgNameOrVersionNode pkgNameOrVersion
 	var foundArtStruct *artStruct
@@ -186,6 +186,9 @@ func (c *demoClient) ingestCertifyGood(ctx context.Context, subject model.Packag
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -449,6 +452,9 @@ func (c *demoClient) CertifyGood(ctx context.Context, filter *model.CertifyGoodS
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
+				if cg == nil {
+					continue
+				}
 
 				out = append(out, cg)
 			}
@@ -127,8 +127,8 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		DocumentRef:       certifyLegal.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var dec []string
 	for _, lis := range declaredLicenses {
@@ -222,6 +222,9 @@ func (c *demoClient) ingestCertifyLegal(ctx context.Context, subject model.Packa
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -267,7 +270,7 @@ func (c *demoClient) convLegal(ctx context.Context, in *certifyLegalStruct) (*mo
 	return cl, nil
 }
 
-func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 
@@ -279,6 +282,11 @@ func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec mode
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.legalKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyLegal, err := c.convLegal(ctx, link)
 		if err != nil {
@@ -348,7 +356,7 @@ func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec mode
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			legal, err := c.legalIfMatch(ctx, &certifyLegalSpec, link)
+			legal, err := c.legalIfMatch(ctx, &certifyLegalSpec, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -403,7 +411,7 @@ func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec mode
 				if err != nil {
 					return nil, err
 				}
-				legal, err := c.legalIfMatch(ctx, &certifyLegalSpec, link)
+				legal, err := c.legalIfMatch(ctx, &certifyLegalSpec, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -454,7 +462,7 @@ func (c *demoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec mode
 	return nil, nil
 }
 
-func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error) {
 	funcName := "CertifyLegal"
 
 	c.m.RLock()
@@ -466,6 +474,11 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.legalKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		o, err := c.convLegal(ctx, link)
 		if err != nil {
@@ -517,7 +530,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			legal, err := c.legalIfMatch(ctx, filter, link)
+			legal, err := c.legalIfMatch(ctx, filter, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -543,7 +556,7 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 				if err != nil {
 					return nil, err
 				}
-				legal, err := c.legalIfMatch(ctx, filter, link)
+				legal, err := c.legalIfMatch(ctx, filter, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -559,9 +572,12 @@ func (c *demoClient) CertifyLegal(ctx context.Context, filter *model.CertifyLega
 	return out, nil
 }
 
-func (c *demoClient) legalIfMatch(ctx context.Context, filter *model.CertifyLegalSpec, link *certifyLegalStruct) (
+func (c *demoClient) legalIfMatch(ctx context.Context, filter *model.CertifyLegalSpec, link *certifyLegalStruct, asOf *time.Time) (
 	*model.CertifyLegal, error,
 ) {
+	if known, err := c.legalKnownAsOf(ctx, link, asOf); err != nil || !known {
+		return nil, err
+	}
 	if noMatch(filter.DeclaredLicense, link.DeclaredLicense) ||
 		noMatch(filter.DiscoveredLicense, link.DiscoveredLicense) ||
 		noMatch(filter.Attribution, link.Attribution) ||
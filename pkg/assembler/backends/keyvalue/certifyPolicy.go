@@ -0,0 +1,332 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// Internal data: link between artifacts and the policies they were verified
+// against
+type certifyPolicyLink struct {
+	ThisID         string
+	Subject        string
+	Verifier       string
+	PolicyURI      string
+	PolicyDigest   string
+	Result         model.PolicyVerificationResult
+	VerifiedLevels []string
+	TimeVerified   time.Time
+	Origin         string
+	Collector      string
+	DocumentRef    string
+}
+
+func (n *certifyPolicyLink) ID() string { return n.ThisID }
+func (n *certifyPolicyLink) Key() string {
+	return hashKey(strings.Join([]string{
+		n.Subject,
+		n.Verifier,
+		n.PolicyURI,
+		n.PolicyDigest,
+		string(n.Result),
+		fmt.Sprint(n.VerifiedLevels),
+		timeKey(n.TimeVerified),
+		n.Origin,
+		n.Collector,
+		n.DocumentRef,
+	}, ":"))
+}
+
+func (n *certifyPolicyLink) Neighbors(allowedEdges edgeMap) []string {
+	if allowedEdges[model.EdgeCertifyPolicyArtifact] {
+		return []string{n.Subject}
+	}
+	return []string{}
+}
+
+func (n *certifyPolicyLink) BuildModelNode(ctx context.Context, c *demoClient) (model.Node, error) {
+	return c.convCertifyPolicy(ctx, n)
+}
+
+// Ingest CertifyPolicy
+
+func (c *demoClient) IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error) {
+	var modelCertifyPolicyList []string
+	for i := range subjects {
+		cp, err := c.IngestCertifyPolicy(ctx, *subjects[i], *certifyPolicies[i])
+		if err != nil {
+			return nil, gqlerror.Errorf("IngestCertifyPolicy failed with err: %v", err)
+		}
+		modelCertifyPolicyList = append(modelCertifyPolicyList, cp)
+	}
+	return modelCertifyPolicyList, nil
+}
+
+func (c *demoClient) IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error) {
+	return c.ingestCertifyPolicy(ctx, subject, certifyPolicy, true)
+}
+
+func (c *demoClient) ingestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec, readOnly bool) (string, error) {
+	funcName := "IngestCertifyPolicy"
+	levels := slices.Clone(certifyPolicy.VerifiedLevels)
+	slices.Sort(levels)
+	in := &certifyPolicyLink{
+		Verifier:       certifyPolicy.Verifier,
+		PolicyURI:      certifyPolicy.PolicyURI,
+		PolicyDigest:   certifyPolicy.PolicyDigest,
+		Result:         certifyPolicy.Result,
+		VerifiedLevels: slices.Compact(levels),
+		TimeVerified:   certifyPolicy.TimeVerified.UTC(),
+		Origin:         certifyPolicy.Origin,
+		Collector:      certifyPolicy.Collector,
+		DocumentRef:    certifyPolicy.DocumentRef,
+	}
+
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
+
+	a, err := c.returnFoundArtifact(ctx, &subject)
+	if err != nil {
+		return "", gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	in.Subject = a.ThisID
+
+	out, err := byKeykv[*certifyPolicyLink](ctx, cpCol, in.Key(), c)
+	if err == nil {
+		return out.ThisID, nil
+	}
+	if !errors.Is(err, kv.NotFoundError) {
+		return "", err
+	}
+
+	if readOnly {
+		c.m.RUnlock()
+		cp, err := c.ingestCertifyPolicy(ctx, subject, certifyPolicy, false)
+		c.m.RLock() // relock so that defer unlock does not panic
+		return cp, err
+	}
+
+	in.ThisID = c.getNextID()
+	if err := c.addToIndex(ctx, cpCol, in); err != nil {
+		return "", err
+	}
+	if err := a.setCertifyPolicyLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
+	if err := setkv(ctx, cpCol, in, c); err != nil {
+		return "", err
+	}
+
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
+	return in.ThisID, nil
+}
+
+// Query CertifyPolicy
+
+func (c *demoClient) CertifyPolicyList(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error) {
+	funcName := "CertifyPolicy"
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	var out []*model.CertifyPolicy
+	if certifyPolicySpec.ID != nil {
+		link, err := byIDkv[*certifyPolicyLink](ctx, *certifyPolicySpec.ID, c)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		cp, err := c.convCertifyPolicy(ctx, link)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		out = append(out, cp)
+	} else {
+		var err error
+		out, err = c.searchCertifyPolicies(ctx, &certifyPolicySpec)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	totalCount := len(out)
+	if after != nil {
+		i := slices.IndexFunc(out, func(cp *model.CertifyPolicy) bool { return cp.ID == *after })
+		if i < 0 {
+			return nil, gqlerror.Errorf("%v :: cursor %q not found", funcName, *after)
+		}
+		out = out[i+1:]
+	}
+	hasNextPage := false
+	if first != nil && *first < len(out) {
+		out = out[:*first]
+		hasNextPage = true
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	edges := make([]*model.CertifyPolicyEdge, 0, len(out))
+	for _, cp := range out {
+		edges = append(edges, &model.CertifyPolicyEdge{
+			Cursor: cp.ID,
+			Node:   cp,
+		})
+	}
+	return &model.CertifyPolicyConnection{
+		TotalCount: totalCount,
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+			StartCursor: ptrfrom.String(out[0].ID),
+			EndCursor:   ptrfrom.String(out[len(out)-1].ID),
+		},
+		Edges: edges,
+	}, nil
+}
+
+func (c *demoClient) CertifyPolicy(ctx context.Context, filter *model.CertifyPolicySpec) ([]*model.CertifyPolicy, error) {
+	funcName := "CertifyPolicy"
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if filter != nil && filter.ID != nil {
+		link, err := byIDkv[*certifyPolicyLink](ctx, *filter.ID, c)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		cp, err := c.convCertifyPolicy(ctx, link)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		return []*model.CertifyPolicy{cp}, nil
+	}
+
+	out, err := c.searchCertifyPolicies(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+func (c *demoClient) searchCertifyPolicies(ctx context.Context, filter *model.CertifyPolicySpec) ([]*model.CertifyPolicy, error) {
+	var out []*model.CertifyPolicy
+	if filter != nil && filter.Subject != nil {
+		exactArtifact, err := c.artifactExact(ctx, filter.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if exactArtifact != nil {
+			for _, id := range exactArtifact.CertifyPolicyLinks {
+				link, err := byIDkv[*certifyPolicyLink](ctx, id, c)
+				if err != nil {
+					return nil, err
+				}
+				cp, err := c.addCertifyPolicyIfMatch(ctx, filter, link)
+				if err != nil {
+					return nil, err
+				}
+				if cp != nil {
+					out = append(out, cp)
+				}
+			}
+			return out, nil
+		}
+	}
+
+	var done bool
+	scn := c.kv.Keys(cpCol)
+	for !done {
+		var cpKeys []string
+		var err error
+		cpKeys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, cpk := range cpKeys {
+			link, err := byKeykv[*certifyPolicyLink](ctx, cpCol, cpk, c)
+			if err != nil {
+				return nil, err
+			}
+			cp, err := c.addCertifyPolicyIfMatch(ctx, filter, link)
+			if err != nil {
+				return nil, err
+			}
+			if cp != nil {
+				out = append(out, cp)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (c *demoClient) addCertifyPolicyIfMatch(ctx context.Context, filter *model.CertifyPolicySpec, link *certifyPolicyLink) (*model.CertifyPolicy, error) {
+	if filter != nil {
+		if noMatch(filter.Verifier, link.Verifier) ||
+			noMatch(filter.PolicyURI, link.PolicyURI) ||
+			noMatch(filter.PolicyDigest, link.PolicyDigest) ||
+			noMatch(filter.Origin, link.Origin) ||
+			noMatch(filter.Collector, link.Collector) ||
+			noMatch(filter.DocumentRef, link.DocumentRef) ||
+			(filter.Result != nil && *filter.Result != link.Result) ||
+			(filter.TimeVerified != nil && filter.TimeVerified.After(link.TimeVerified)) ||
+			slices.ContainsFunc(filter.VerifiedLevels, func(l string) bool { return !slices.Contains(link.VerifiedLevels, l) }) ||
+			!c.matchArtifacts(ctx, []*model.ArtifactSpec{filter.Subject}, []string{link.Subject}) {
+			return nil, nil
+		}
+	}
+	return c.convCertifyPolicy(ctx, link)
+}
+
+func (c *demoClient) convCertifyPolicy(ctx context.Context, in *certifyPolicyLink) (*model.CertifyPolicy, error) {
+	a, err := byIDkv[*artStruct](ctx, in.Subject, c)
+	if err != nil {
+		return nil, err
+	}
+	levels := in.VerifiedLevels
+	if levels == nil {
+		levels = []string{}
+	}
+	return &model.CertifyPolicy{
+		ID:             in.ThisID,
+		Subject:        c.convArtifact(a),
+		Verifier:       in.Verifier,
+		PolicyURI:      in.PolicyURI,
+		PolicyDigest:   in.PolicyDigest,
+		Result:         in.Result,
+		VerifiedLevels: levels,
+		TimeVerified:   in.TimeVerified,
+		Origin:         in.Origin,
+		Collector:      in.Collector,
+		DocumentRef:    in.DocumentRef,
+	}, nil
+}
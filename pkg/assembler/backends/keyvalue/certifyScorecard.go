@@ -105,8 +105,8 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.IDorSour
 		DocumentRef:      scorecard.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	srcName, err := c.returnFoundSource(ctx, &source)
 	if err != nil {
@@ -140,12 +140,15 @@ func (c *demoClient) certifyScorecard(ctx context.Context, source model.IDorSour
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
 // Query CertifyScorecard
 
-func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 
@@ -157,6 +160,11 @@ func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.Cer
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.scorecardKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 
 		exactCertifyScorecard, err := c.buildScorecard(ctx, link, &scorecardSpec, true)
 		if err != nil {
@@ -205,7 +213,7 @@ func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.Cer
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			out, err = c.addSCIfMatch(ctx, out, &scorecardSpec, link)
+			out, err = c.addSCIfMatch(ctx, out, &scorecardSpec, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -262,7 +270,7 @@ func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.Cer
 					return nil, err
 				}
 
-				scorecardOut, err := c.SCIfMatch(ctx, &scorecardSpec, link)
+				scorecardOut, err := c.SCIfMatch(ctx, &scorecardSpec, link, asOf)
 
 				if err != nil {
 					return nil, err
@@ -314,7 +322,7 @@ func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.Cer
 	return nil, nil
 }
 
-func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 	funcName := "Scorecards"
@@ -325,6 +333,11 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.scorecardKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		foundCertifyScorecard, err := c.buildScorecard(ctx, link, filter, true)
 		if err != nil {
 			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
@@ -352,7 +365,7 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			out, err = c.addSCIfMatch(ctx, out, filter, link)
+			out, err = c.addSCIfMatch(ctx, out, filter, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -372,7 +385,7 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 				if err != nil {
 					return nil, err
 				}
-				out, err = c.addSCIfMatch(ctx, out, filter, link)
+				out, err = c.addSCIfMatch(ctx, out, filter, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -383,8 +396,11 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 	return out, nil
 }
 
-func (c *demoClient) SCIfMatch(ctx context.Context, filter *model.CertifyScorecardSpec, link *scorecardLink) (
+func (c *demoClient) SCIfMatch(ctx context.Context, filter *model.CertifyScorecardSpec, link *scorecardLink, asOf *time.Time) (
 	*model.CertifyScorecard, error) {
+	if known, err := c.scorecardKnownAsOf(ctx, link, asOf); err != nil || !known {
+		return nil, err
+	}
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return nil, nil
 	}
@@ -419,8 +435,11 @@ func (c *demoClient) SCIfMatch(ctx context.Context, filter *model.CertifyScoreca
 }
 
 func (c *demoClient) addSCIfMatch(ctx context.Context, out []*model.CertifyScorecard,
-	filter *model.CertifyScorecardSpec, link *scorecardLink) (
+	filter *model.CertifyScorecardSpec, link *scorecardLink, asOf *time.Time) (
 	[]*model.CertifyScorecard, error) {
+	if known, err := c.scorecardKnownAsOf(ctx, link, asOf); err != nil || !known {
+		return out, err
+	}
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return out, nil
 	}
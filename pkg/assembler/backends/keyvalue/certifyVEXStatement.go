@@ -164,7 +164,7 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 	}
 
 	if vexStatement.Description != nil {
-		in.Statement = *vexStatement.Description
+		in.Description = *vexStatement.Description
 	}
 
 	if vexStatement.Cvss != nil {
@@ -187,8 +187,8 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 		in.Priority = *vexStatement.Priority
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var foundPkgVersionNode *pkgVersion
 	var foundArtStruct *artStruct
@@ -250,12 +250,15 @@ func (c *demoClient) ingestVEXStatement(ctx context.Context, subject model.Packa
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
 // Query CertifyVex
 
-func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
+func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 	funcName := "CertifyVEXStatement"
@@ -266,6 +269,11 @@ func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStat
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.vexKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyVex, err := c.buildCertifyVEXStatement(ctx, link, &certifyVEXStatementSpec, true)
 		if err != nil {
@@ -334,7 +342,7 @@ func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStat
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			vex, err := c.vexIfMatch(ctx, &certifyVEXStatementSpec, link)
+			vex, err := c.vexIfMatch(ctx, &certifyVEXStatementSpec, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -390,7 +398,7 @@ func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStat
 				if err != nil {
 					return nil, err
 				}
-				vex, err := c.vexIfMatch(ctx, &certifyVEXStatementSpec, link)
+				vex, err := c.vexIfMatch(ctx, &certifyVEXStatementSpec, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%vex :: %vex", funcName, err)
 				}
@@ -441,7 +449,7 @@ func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStat
 	return nil, nil
 }
 
-func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 	funcName := "CertifyVEXStatement"
@@ -452,6 +460,11 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.vexKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyVex, err := c.buildCertifyVEXStatement(ctx, link, filter, true)
 		if err != nil {
@@ -501,7 +514,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			v, err := c.vexIfMatch(ctx, filter, link)
+			v, err := c.vexIfMatch(ctx, filter, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -527,7 +540,7 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 				if err != nil {
 					return nil, err
 				}
-				v, err := c.vexIfMatch(ctx, filter, link)
+				v, err := c.vexIfMatch(ctx, filter, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -543,8 +556,11 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, filter *model.Cert
 	return out, nil
 }
 
-func (c *demoClient) vexIfMatch(ctx context.Context, filter *model.CertifyVEXStatementSpec, link *vexLink) (
+func (c *demoClient) vexIfMatch(ctx context.Context, filter *model.CertifyVEXStatementSpec, link *vexLink, asOf *time.Time) (
 	*model.CertifyVEXStatement, error) {
+	if known, err := c.vexKnownAsOf(ctx, link, asOf); err != nil || !known {
+		return nil, err
+	}
 
 	if filter != nil && filter.KnownSince != nil && !filter.KnownSince.Equal(link.KnownSince) {
 		return nil, nil
@@ -673,6 +689,7 @@ func (c *demoClient) buildCertifyVEXStatement(ctx context.Context, link *vexLink
 	return &model.CertifyVEXStatement{
 		ID:               link.ThisID,
 		Subject:          subj,
+		Vulnerability:    vuln,
 		Status:           link.Status,
 		VexJustification: link.Justification,
 		Statement:        link.Statement,
@@ -107,8 +107,8 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.I
 		DocumentRef:    certifyVuln.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	foundPackage, err := c.returnFoundPkgVersion(ctx, &packageArg)
 	if err != nil {
@@ -152,12 +152,15 @@ func (c *demoClient) ingestVulnerability(ctx context.Context, packageArg model.I
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
 // Query CertifyVuln
 
-func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 	funcName := "CertifyVuln"
@@ -168,6 +171,11 @@ func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.certifyVulnKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyVuln, err := c.buildCertifyVulnerability(ctx, link, &certifyVulnSpec, true)
 		if err != nil {
@@ -244,7 +252,7 @@ func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			cv, err := c.certifyVulnIfMatch(ctx, &certifyVulnSpec, link)
+			cv, err := c.certifyVulnIfMatch(ctx, &certifyVulnSpec, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -299,7 +307,7 @@ func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.
 				if err != nil {
 					return nil, err
 				}
-				cv, err := c.certifyVulnIfMatch(ctx, &certifyVulnSpec, link)
+				cv, err := c.certifyVulnIfMatch(ctx, &certifyVulnSpec, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -350,7 +358,7 @@ func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.
 	return nil, nil
 }
 
-func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error) {
 	c.m.RLock()
 	defer c.m.RUnlock()
 	funcName := "CertifyVuln"
@@ -361,6 +369,11 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.certifyVulnKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		foundCertifyVuln, err := c.buildCertifyVulnerability(ctx, link, filter, true)
 		if err != nil {
@@ -419,7 +432,7 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			cv, err := c.certifyVulnIfMatch(ctx, filter, link)
+			cv, err := c.certifyVulnIfMatch(ctx, filter, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -445,7 +458,7 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 				if err != nil {
 					return nil, err
 				}
-				cv, err := c.certifyVulnIfMatch(ctx, filter, link)
+				cv, err := c.certifyVulnIfMatch(ctx, filter, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -462,8 +475,11 @@ func (c *demoClient) CertifyVuln(ctx context.Context, filter *model.CertifyVulnS
 	return out, nil
 }
 
-func (c *demoClient) certifyVulnIfMatch(ctx context.Context, filter *model.CertifyVulnSpec, link *certifyVulnerabilityLink) (
+func (c *demoClient) certifyVulnIfMatch(ctx context.Context, filter *model.CertifyVulnSpec, link *certifyVulnerabilityLink, asOf *time.Time) (
 	*model.CertifyVuln, error) {
+	if known, err := c.certifyVulnKnownAsOf(ctx, link, asOf); err != nil || !known {
+		return nil, err
+	}
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return nil, nil
 	}
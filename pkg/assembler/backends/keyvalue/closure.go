@@ -0,0 +1,188 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *demoClient) DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	out, err := c.closure(ctx, subject, maxDepth, false)
+	if err != nil {
+		return nil, gqlerror.Errorf("DependencyClosure :: %v", err)
+	}
+	return out, nil
+}
+
+func (c *demoClient) DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	out, err := c.closure(ctx, subject, maxDepth, true)
+	if err != nil {
+		return nil, gqlerror.Errorf("DependentClosure :: %v", err)
+	}
+	return out, nil
+}
+
+func (c *demoClient) closure(ctx context.Context, subject string, maxDepth *int, dependents bool) ([]*model.ClosureEntry, error) {
+	n, err := c.nodeFromId(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	switch n.(type) {
+	case *pkgVersion, *artStruct:
+	default:
+		return nil, fmt.Errorf("subject %s is not a package version or an artifact", subject)
+	}
+
+	// SBOMs are only linked from their subject, so finding what includes a
+	// node takes a scan of all of them.
+	var includedBy map[string][]string
+	if dependents {
+		if includedBy, err = c.sbomIncludedBy(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return helper.Closure(subject, maxDepth, func(id string) ([]helper.ClosureLink, error) {
+		return c.closureLinks(ctx, id, dependents, includedBy)
+	})
+}
+
+// closureLinks returns the links of a package version or artifact, to its
+// dependencies or to its dependents.
+func (c *demoClient) closureLinks(ctx context.Context, id string, dependents bool, includedBy map[string][]string) ([]helper.ClosureLink, error) {
+	n, err := c.nodeFromId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []helper.ClosureLink
+	var occurrences, sboms []string
+	switch n := n.(type) {
+	case *pkgVersion:
+		for _, linkID := range n.IsDependencyLinks {
+			link, err := byIDkv[*isDependencyLink](ctx, linkID, c)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case !dependents && link.PackageID == id:
+				out = append(out, helper.ClosureLink{To: link.DepPackageID})
+			case dependents && link.DepPackageID == id:
+				out = append(out, helper.ClosureLink{To: link.PackageID})
+			}
+		}
+		occurrences, sboms = n.Occurrences, n.HasSBOMs
+	case *artStruct:
+		for _, heID := range n.HashEquals {
+			he, err := byIDkv[*hashEqualStruct](ctx, heID, c)
+			if err != nil {
+				return nil, err
+			}
+			for _, a := range he.Artifacts {
+				if a != id {
+					out = append(out, helper.ClosureLink{To: a, Equivalent: true})
+				}
+			}
+		}
+		for _, slsaID := range n.HasSLSAs {
+			slsa, err := byIDkv[*hasSLSAStruct](ctx, slsaID, c)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case !dependents && slsa.Subject == id:
+				for _, a := range slsa.BuiltFrom {
+					out = append(out, helper.ClosureLink{To: a})
+				}
+			case dependents && slsa.Subject != id:
+				out = append(out, helper.ClosureLink{To: slsa.Subject})
+			}
+		}
+		occurrences, sboms = n.Occurrences, n.HasSBOMs
+	default:
+		return nil, nil
+	}
+
+	for _, occID := range occurrences {
+		occ, err := byIDkv[*isOccurrenceStruct](ctx, occID, c)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case occ.Pkg == id:
+			out = append(out, helper.ClosureLink{To: occ.Artifact, Equivalent: true})
+		case occ.Artifact == id && occ.Pkg != "":
+			out = append(out, helper.ClosureLink{To: occ.Pkg, Equivalent: true})
+		}
+	}
+
+	if dependents {
+		for _, s := range includedBy[id] {
+			out = append(out, helper.ClosureLink{To: s})
+		}
+		return out, nil
+	}
+	for _, sbomID := range sboms {
+		sbom, err := byIDkv[*hasSBOMStruct](ctx, sbomID, c)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sbom.IncludedSoftware {
+			out = append(out, helper.ClosureLink{To: s})
+		}
+	}
+	return out, nil
+}
+
+// sbomIncludedBy maps each node included in an SBOM to the subjects of the
+// SBOMs including it.
+func (c *demoClient) sbomIncludedBy(ctx context.Context) (map[string][]string, error) {
+	out := map[string][]string{}
+	var done bool
+	scn := c.kv.Keys(hasSBOMCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			sbom, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, k, c)
+			if err != nil {
+				return nil, err
+			}
+			subject := sbom.Pkg
+			if subject == "" {
+				subject = sbom.Artifact
+			}
+			for _, s := range sbom.IncludedSoftware {
+				out[s] = append(out[s], subject)
+			}
+		}
+	}
+	return out, nil
+}
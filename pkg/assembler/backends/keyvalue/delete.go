@@ -0,0 +1,249 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// Delete node and all associated relationships.
+//
+// Deleting a node cascades to every node that cannot exist without it: the
+// children of a package, source or vulnerability trie node, and the
+// predicates that have the node as one of their subjects or objects. The
+// back-reference lists of the remaining neighbors are updated so that they no
+// longer contain the deleted IDs.
+func (c *demoClient) Delete(ctx context.Context, node string) (bool, error) {
+	c.lock(false)
+	defer c.unlock(false)
+
+	if _, _, err := c.nodeByID(ctx, node); err != nil {
+		if errors.Is(err, kv.NotFoundError) {
+			return false, nil
+		}
+		return false, gqlerror.Errorf("Delete :: %v", err)
+	}
+	if err := c.deleteNode(ctx, node, map[string]bool{}); err != nil {
+		return false, gqlerror.Errorf("Delete :: %v", err)
+	}
+	if err := c.kv.commit(ctx); err != nil {
+		return false, gqlerror.Errorf("Delete :: %v", err)
+	}
+	return true, nil
+}
+
+// nodeByID returns the collection and the node stored for an ID.
+func (c *demoClient) nodeByID(ctx context.Context, id string) (string, node, error) {
+	var k string
+	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
+		return "", nil, fmt.Errorf("%w : id not found in index %q", err, id)
+	}
+	sub := strings.SplitN(k, ":", 2)
+	if len(sub) != 2 {
+		return "", nil, fmt.Errorf("Bad value was stored in index map: %v", k)
+	}
+	n := typeColMap(sub[0])
+	if err := c.kv.Get(ctx, sub[0], sub[1], &n); err != nil {
+		return "", nil, err
+	}
+	return sub[0], n, nil
+}
+
+func (c *demoClient) deleteNode(ctx context.Context, id string, deleted map[string]bool) error {
+	if deleted[id] {
+		return nil
+	}
+	deleted[id] = true
+
+	coll, n, err := c.nodeByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	neighbors := n.Neighbors(processUsingOnly(nil))
+	// HasSBOM is not back-referenced from the software it includes, so those
+	// have to be found by scanning.
+	if includable(n) {
+		sboms, err := c.hasSBOMsIncluding(ctx, id)
+		if err != nil {
+			return err
+		}
+		neighbors = append(neighbors, sboms...)
+	}
+
+	for _, nID := range neighbors {
+		if nID == "" || deleted[nID] {
+			continue
+		}
+		nColl, nNode, err := c.nodeByID(ctx, nID)
+		if errors.Is(err, kv.NotFoundError) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if slices.Contains(references(nNode), id) {
+			if err := c.deleteNode(ctx, nID, deleted); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.removeBackReference(ctx, nColl, nNode, id); err != nil {
+			return err
+		}
+	}
+
+	if err := c.kv.Delete(ctx, coll, n.Key()); err != nil {
+		return err
+	}
+	return c.kv.Delete(ctx, indexCol, id)
+}
+
+// references returns the IDs of the nodes that n cannot exist without.
+func references(n node) []string {
+	switch n := n.(type) {
+	case *pkgNamespace:
+		return []string{n.Parent}
+	case *pkgName:
+		return []string{n.Parent}
+	case *pkgVersion:
+		return []string{n.Parent}
+	case *srcNamespace:
+		return []string{n.Parent}
+	case *srcNameNode:
+		return []string{n.Parent}
+	case *vulnIDNode:
+		return []string{n.Parent}
+	case *badLink:
+		return []string{n.PackageID, n.ArtifactID, n.SourceID}
+	case *goodLink:
+		return []string{n.PackageID, n.ArtifactID, n.SourceID}
+	case *hasMetadataLink:
+		return []string{n.PackageID, n.ArtifactID, n.SourceID}
+	case *pointOfContactLink:
+		return []string{n.PackageID, n.ArtifactID, n.SourceID}
+	case *certifyLegalStruct:
+		out := []string{n.Pkg, n.Source}
+		out = append(out, n.DeclaredLicenses...)
+		return append(out, n.DiscoveredLicenses...)
+	case *certifyPolicyLink:
+		return []string{n.Subject}
+	case *scorecardLink:
+		return []string{n.SourceID}
+	case *vexLink:
+		return []string{n.PackageID, n.ArtifactID, n.VulnerabilityID}
+	case *certifyVulnerabilityLink:
+		return []string{n.PackageID, n.VulnerabilityID}
+	case *hasSBOMStruct:
+		return []string{n.Pkg, n.Artifact}
+	case *hasSLSAStruct:
+		return append([]string{n.Subject, n.BuiltBy}, n.BuiltFrom...)
+	case *srcMapLink:
+		return []string{n.SourceID, n.PackageID}
+	case *hashEqualStruct:
+		return n.Artifacts
+	case *isDependencyLink:
+		return []string{n.PackageID, n.DepPackageID}
+	case *isOccurrenceStruct:
+		return []string{n.Pkg, n.Source, n.Artifact}
+	case *pkgEqualStruct:
+		return n.Pkgs
+	case *vulnerabilityEqualLink:
+		return n.Vulnerabilities
+	case *vulnerabilityMetadataLink:
+		return []string{n.VulnerabilityID}
+	case *vulnerabilityRangeLink:
+		return []string{n.PackageID, n.VulnerabilityID}
+	}
+	return nil
+}
+
+// includable reports whether n can be part of the software included in a
+// HasSBOM.
+func includable(n node) bool {
+	switch n.(type) {
+	case *pkgVersion, *artStruct, *isDependencyLink, *isOccurrenceStruct:
+		return true
+	}
+	return false
+}
+
+func (c *demoClient) hasSBOMsIncluding(ctx context.Context, id string) ([]string, error) {
+	var out []string
+	var done bool
+	scn := c.kv.Keys(hasSBOMCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			sbom, err := byKeykv[*hasSBOMStruct](ctx, hasSBOMCol, k, c)
+			if err != nil {
+				return nil, err
+			}
+			if slices.Contains(sbom.IncludedSoftware, id) ||
+				slices.Contains(sbom.IncludedDependencies, id) ||
+				slices.Contains(sbom.IncludedOccurrences, id) {
+				out = append(out, sbom.ThisID)
+			}
+		}
+	}
+	return out, nil
+}
+
+// removeBackReference drops id from all the ID lists of n and stores n again.
+// If the lists are part of the key of n, n is moved to its new key.
+func (c *demoClient) removeBackReference(ctx context.Context, coll string, n node, id string) error {
+	oldKey := n.Key()
+	changed := false
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Type() != reflect.TypeOf([]string(nil)) || !f.CanSet() {
+			continue
+		}
+		ids := f.Interface().([]string)
+		if !slices.Contains(ids, id) {
+			continue
+		}
+		f.Set(reflect.ValueOf(slices.DeleteFunc(slices.Clone(ids), func(s string) bool { return s == id })))
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	if newKey := n.Key(); newKey != oldKey {
+		if err := c.kv.Delete(ctx, coll, oldKey); err != nil {
+			return err
+		}
+		if err := c.addToIndex(ctx, coll, n); err != nil {
+			return err
+		}
+	}
+	return setkv(ctx, coll, n, c)
+}
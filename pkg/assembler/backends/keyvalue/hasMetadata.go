@@ -126,8 +126,8 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 		DocumentRef:   hasMetadata.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var foundPkgNameOrVersionNode pkgNameOrVersion
 	var foundArtStruct *artStruct
@@ -196,6 +196,9 @@ func (c *demoClient) ingestHasMetadata(ctx context.Context, subject model.Packag
 	}
 
 	// build return GraphQL type
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -191,8 +191,8 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		IncludedOccurrences:  includedOccurrences,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var pkg *pkgVersion
 	var art *artStruct
@@ -248,6 +248,9 @@ func (c *demoClient) ingestHasSbom(ctx context.Context, subject model.PackageOrA
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -324,7 +327,7 @@ func (c *demoClient) convHasSBOM(ctx context.Context, in *hasSBOMStruct) (*model
 
 // Query HasSBOM
 
-func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error) {
 	funcName := "HasSBOM"
 	c.m.RLock()
 	defer c.m.RUnlock()
@@ -335,6 +338,11 @@ func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMS
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.hasSBOMKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		hs, err := c.convHasSBOM(ctx, link)
 		if err != nil {
@@ -391,7 +399,7 @@ func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMS
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			hs, err := c.hasSBOMIfMatch(ctx, &hasSBOMSpec, link)
+			hs, err := c.hasSBOMIfMatch(ctx, &hasSBOMSpec, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -446,7 +454,7 @@ func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMS
 				if err != nil {
 					return nil, err
 				}
-				hs, err := c.hasSBOMIfMatch(ctx, &hasSBOMSpec, link)
+				hs, err := c.hasSBOMIfMatch(ctx, &hasSBOMSpec, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -497,7 +505,7 @@ func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMS
 	return nil, nil
 }
 
-func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error) {
 	funcName := "HasSBOM"
 	c.m.RLock()
 	defer c.m.RUnlock()
@@ -508,6 +516,11 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 			// Not found
 			return nil, nil
 		}
+		if known, err := c.hasSBOMKnownAsOf(ctx, link, asOf); err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		} else if !known {
+			return nil, nil
+		}
 		// If found by id, ignore rest of fields in spec and return as a match
 		sb, err := c.convHasSBOM(ctx, link)
 		if err != nil {
@@ -546,7 +559,7 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			hs, err := c.hasSBOMIfMatch(ctx, filter, link)
+			hs, err := c.hasSBOMIfMatch(ctx, filter, link, asOf)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
@@ -572,7 +585,7 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 				if err != nil {
 					return nil, err
 				}
-				hs, err := c.hasSBOMIfMatch(ctx, filter, link)
+				hs, err := c.hasSBOMIfMatch(ctx, filter, link, asOf)
 				if err != nil {
 					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 				}
@@ -589,8 +602,11 @@ func (c *demoClient) HasSBOM(ctx context.Context, filter *model.HasSBOMSpec) ([]
 	return out, nil
 }
 
-func (c *demoClient) hasSBOMIfMatch(ctx context.Context, filter *model.HasSBOMSpec, link *hasSBOMStruct) (
+func (c *demoClient) hasSBOMIfMatch(ctx context.Context, filter *model.HasSBOMSpec, link *hasSBOMStruct, asOf *time.Time) (
 	*model.HasSbom, error) {
+	if known, err := c.hasSBOMKnownAsOf(ctx, link, asOf); err != nil || !known {
+		return nil, err
+	}
 
 	if filter != nil {
 		if noMatch(filter.URI, link.URI) ||
@@ -57,12 +57,16 @@ func (n *hasSLSAStruct) Key() string {
 	if n.Finish != nil {
 		fn = timeKey(*n.Finish)
 	}
+	preds := make([]string, 0, len(n.Predicates))
+	for _, p := range n.Predicates {
+		preds = append(preds, p.Key+"="+p.Value)
+	}
 	return hashKey(strings.Join([]string{
 		n.Subject,
 		fmt.Sprint(n.BuiltFrom),
 		n.BuiltBy,
 		n.BuildType,
-		fmt.Sprint(n.Predicates),
+		fmt.Sprint(preds),
 		n.Version,
 		st,
 		fn,
@@ -424,8 +428,8 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 		in.Finish = &t
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	s, err := c.returnFoundArtifact(ctx, &subject)
 	if err != nil {
@@ -487,6 +491,9 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
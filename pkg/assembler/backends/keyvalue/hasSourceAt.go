@@ -99,8 +99,8 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.IDo
 		DocumentRef:   hasSourceAt.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var pkgNameOrVersionNode pkgNameOrVersion
 	var err error
@@ -146,6 +146,9 @@ func (c *demoClient) ingestHasSourceAt(ctx context.Context, packageArg model.IDo
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -88,8 +88,8 @@ func (c *demoClient) ingestHashEqual(ctx context.Context, artifact model.IDorArt
 		DocumentRef:   hashEqual.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	aInt1, err := c.returnFoundArtifact(ctx, &artifact)
 	if err != nil {
@@ -132,6 +132,9 @@ func (c *demoClient) ingestHashEqual(ctx context.Context, artifact model.IDorArt
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
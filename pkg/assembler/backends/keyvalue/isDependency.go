@@ -36,6 +36,7 @@ type isDependencyLink struct {
 	PackageID      string
 	DepPackageID   string
 	DependencyType model.DependencyType
+	VersionRange   string
 	Justification  string
 	Origin         string
 	Collector      string
@@ -44,7 +45,7 @@ type isDependencyLink struct {
 
 func (n *isDependencyLink) ID() string { return n.ThisID }
 func (n *isDependencyLink) Key() string {
-	return hashKey(strings.Join([]string{
+	fields := []string{
 		n.PackageID,
 		n.DepPackageID,
 		string(n.DependencyType),
@@ -52,7 +53,13 @@ func (n *isDependencyLink) Key() string {
 		n.Origin,
 		n.Collector,
 		n.DocumentRef,
-	}, ":"))
+	}
+	// the version range is only added when known to keep the keys of
+	// dependencies recorded without one unchanged
+	if n.VersionRange != "" {
+		fields = append(fields, n.VersionRange)
+	}
+	return hashKey(strings.Join(fields, ":"))
 }
 
 func (n *isDependencyLink) Neighbors(allowedEdges edgeMap) []string {
@@ -92,6 +99,7 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.IDor
 
 	inLink := &isDependencyLink{
 		DependencyType: dependency.DependencyType,
+		VersionRange:   nilToEmpty(dependency.VersionRange),
 		Justification:  dependency.Justification,
 		Origin:         dependency.Origin,
 		Collector:      dependency.Collector,
@@ -99,8 +107,8 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.IDor
 	}
 	helper.FixDependencyType(&inLink.DependencyType)
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var depPkg *pkgVersion
 	var err error
@@ -146,6 +154,9 @@ func (c *demoClient) ingestDependency(ctx context.Context, packageArg model.IDor
 	}
 	outLink = inLink
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return outLink.ThisID, nil
 }
 
@@ -434,6 +445,7 @@ func (c *demoClient) buildIsDependency(ctx context.Context, link *isDependencyLi
 		Package:           p,
 		DependencyPackage: dep,
 		DependencyType:    link.DependencyType,
+		VersionRange:      link.VersionRange,
 		Justification:     link.Justification,
 		Origin:            link.Origin,
 		Collector:         link.Collector,
@@ -460,7 +472,8 @@ func (c *demoClient) depIfMatch(ctx context.Context, filter *model.IsDependencyS
 
 func noMatchIsDep(filter *model.IsDependencySpec, link *isDependencyLink) bool {
 	if filter != nil {
-		return noMatch(filter.Justification, link.Justification) ||
+		return noMatch(filter.VersionRange, link.VersionRange) ||
+			noMatch(filter.Justification, link.Justification) ||
 			noMatch(filter.Origin, link.Origin) ||
 			noMatch(filter.Collector, link.Collector) ||
 			noMatch(filter.DocumentRef, link.DocumentRef) ||
@@ -116,8 +116,8 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		DocumentRef:   occurrence.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	a, err := c.returnFoundArtifact(ctx, &artifact)
 	if err != nil {
@@ -179,6 +179,9 @@ func (c *demoClient) ingestOccurrence(ctx context.Context, subject model.Package
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -94,8 +94,8 @@ func (c *demoClient) ingestLicense(ctx context.Context, license *model.IDorLicen
 		ListVersion: nilToEmpty(license.LicenseInput.ListVersion),
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	out, err := byKeykv[*licStruct](ctx, licenseCol, in.Key(), c)
 	if err == nil {
@@ -119,6 +119,9 @@ func (c *demoClient) ingestLicense(ctx context.Context, license *model.IDorLicen
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
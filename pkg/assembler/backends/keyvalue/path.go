@@ -18,10 +18,13 @@ package keyvalue
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -45,8 +48,130 @@ func (c *demoClient) Path(ctx context.Context, source string, target string, max
 	return c.bfs(ctx, source, target, maxPathLength, processUsingOnly(usingOnly))
 }
 
+func (c *demoClient) AllPaths(ctx context.Context, source string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error) {
+	pattern, err := helper.AllPathsPattern(usingOnly, weights)
+	if err != nil {
+		return nil, gqlerror.Errorf("AllPaths :: %v", err)
+	}
+	if err := checkFirst(first); err != nil {
+		return nil, gqlerror.Errorf("AllPaths :: %v", err)
+	}
+	return c.paths(ctx, source, target, maxPathLength, pattern, first)
+}
+
+func (c *demoClient) ConstrainedPath(ctx context.Context, source string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error) {
+	pattern, err := helper.ConstrainedPathPattern(segments)
+	if err != nil {
+		return nil, gqlerror.Errorf("ConstrainedPath :: %v", err)
+	}
+	if err := checkFirst(first); err != nil {
+		return nil, gqlerror.Errorf("ConstrainedPath :: %v", err)
+	}
+	return c.paths(ctx, source, target, maxPathLength, pattern, first)
+}
+
+func (c *demoClient) paths(ctx context.Context, from, to string, maxLength int, pattern *helper.PathPattern, first *int) ([]*model.Path, error) {
+	c.m.RLock()
+	found, err := c.dfs(ctx, from, to, maxLength, pattern)
+	c.m.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	helper.SortFoundPaths(found)
+	if first != nil && *first < len(found) {
+		found = found[:*first]
+	}
+	return helper.BuildPaths(found, func(ids []string) ([]model.Node, error) {
+		return c.Nodes(ctx, ids)
+	})
+}
+
+// dfs returns every path from one node to another that does not visit a node
+// twice, is at most maxLength edges long and follows the pattern.
+func (c *demoClient) dfs(ctx context.Context, from, to string, maxLength int, pattern *helper.PathPattern) ([]helper.FoundPath, error) {
+	var found []helper.FoundPath
+	path := []string{from}
+	onPath := map[string]bool{from: true}
+
+	var walk func(id string, state int, weight float64) error
+	walk = func(id string, state int, weight float64) error {
+		if id == to {
+			if pattern.Accepting(state) {
+				found = append(found, helper.FoundPath{IDs: slices.Clone(path), Weight: weight})
+			}
+			return nil
+		}
+		if len(path) > maxLength {
+			return nil
+		}
+
+		n, err := c.nodeFromId(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, edge := range pattern.Edges(state) {
+			nextState, _ := pattern.Next(state, edge)
+			for _, next := range helper.SortAndRemoveDups(n.Neighbors(edgeMap{edge: true})) {
+				if onPath[next] {
+					continue
+				}
+				onPath[next] = true
+				path = append(path, next)
+				err := walk(next, nextState, weight+pattern.Weight(edge))
+				path = path[:len(path)-1]
+				delete(onPath, next)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := walk(from, 0, 0); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
 func (c *demoClient) NeighborsList(ctx context.Context, node string, usingOnly []model.Edge, after *string, first *int) (*model.NeighborConnection, error) {
-	return nil, fmt.Errorf("not implemented: NeighborsList")
+	if err := checkFirst(first); err != nil {
+		return nil, gqlerror.Errorf("NeighborsList :: %v", err)
+	}
+	c.m.RLock()
+	neighbors, err := c.neighborsFromId(ctx, node, processUsingOnly(usingOnly))
+	c.m.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors = helper.SortAndRemoveDups(neighbors)
+	page, hasNextPage := pageIDs(neighbors, after, first)
+	if len(page) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Nodes(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.NeighborEdge, 0, len(page))
+	for i, id := range page {
+		edges = append(edges, &model.NeighborEdge{
+			Cursor: id,
+			Node:   nodes[i],
+		})
+	}
+	return &model.NeighborConnection{
+		TotalCount: len(neighbors),
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+			StartCursor: ptrfrom.String(page[0]),
+			EndCursor:   ptrfrom.String(page[len(page)-1]),
+		},
+		Edges: edges,
+	}, nil
 }
 
 func (c *demoClient) Neighbors(ctx context.Context, source string, usingOnly []model.Edge) ([]model.Node, error) {
@@ -62,6 +187,14 @@ func (c *demoClient) Neighbors(ctx context.Context, source string, usingOnly []m
 }
 
 func (c *demoClient) neighborsFromId(ctx context.Context, id string, allowedEdges edgeMap) ([]string, error) {
+	node, err := c.nodeFromId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return node.Neighbors(allowedEdges), nil
+}
+
+func (c *demoClient) nodeFromId(ctx context.Context, id string) (node, error) {
 	var k string
 	if err := c.kv.Get(ctx, indexCol, id, &k); err != nil {
 		return nil, fmt.Errorf("%w : id not found in index %q", err, id)
@@ -76,8 +209,7 @@ func (c *demoClient) neighborsFromId(ctx context.Context, id string, allowedEdge
 	if err := c.kv.Get(ctx, sub[0], sub[1], &node); err != nil {
 		return nil, err
 	}
-
-	return node.Neighbors(allowedEdges), nil
+	return node, nil
 }
 
 func (c *demoClient) bfs(ctx context.Context, from, to string, maxLength int, allowedEdges edgeMap) ([]model.Node, error) {
@@ -190,8 +322,29 @@ func (c *demoClient) Nodes(ctx context.Context, ids []string) ([]model.Node, err
 	return rv, nil
 }
 
-// Delete node and all associated relationships. This functionality is only implemented for
-// certifyVuln, HasSBOM and HasSLSA.
-func (c *demoClient) Delete(ctx context.Context, node string) (bool, error) {
-	panic(fmt.Errorf("not implemented: Delete"))
+// checkFirst rejects a negative page size. GraphQL accepts any Int, and
+// slicing with one would panic.
+func checkFirst(first *int) error {
+	if first != nil && *first < 0 {
+		return fmt.Errorf("first must not be negative, got %d", *first)
+	}
+	return nil
+}
+
+// pageIDs returns the IDs that follow the after cursor, up to first of them,
+// and whether more IDs are left. The IDs must be sorted, and the cursor does
+// not need to be one of them, so that paging stays stable when the graph
+// changes between calls.
+func pageIDs(ids []string, after *string, first *int) ([]string, bool) {
+	if after != nil {
+		i, _ := slices.BinarySearch(ids, *after)
+		if i < len(ids) && ids[i] == *after {
+			i++
+		}
+		ids = ids[i:]
+	}
+	if first != nil && *first < len(ids) {
+		return ids[:*first], true
+	}
+	return ids, false
 }
@@ -54,6 +54,7 @@ type pkgName struct {
 	GoodLinks           []string
 	HasMetadataLinks    []string
 	PointOfContactLinks []string
+	VulnRangeLinks      []string
 }
 type pkgVersion struct {
 	ThisID              string
@@ -143,6 +144,9 @@ func (n *pkgName) Neighbors(allowedEdges edgeMap) []string {
 	if allowedEdges[model.EdgePackagePointOfContact] {
 		out = append(out, n.PointOfContactLinks...)
 	}
+	if allowedEdges[model.EdgePackageVulnerabilityRange] {
+		out = append(out, n.VulnRangeLinks...)
+	}
 
 	return out
 }
@@ -221,6 +225,10 @@ func (p *pkgName) setIsDependencyLinks(ctx context.Context, id string, c *demoCl
 	p.IsDependencyLinks = append(p.IsDependencyLinks, id)
 	return setkv(ctx, pkgNameCol, p, c)
 }
+func (p *pkgName) setVulnRangeLinks(ctx context.Context, id string, c *demoClient) error {
+	p.VulnRangeLinks = append(p.VulnRangeLinks, id)
+	return setkv(ctx, pkgNameCol, p, c)
+}
 func (p *pkgVersion) setIsDependencyLinks(ctx context.Context, id string, c *demoClient) error {
 	p.IsDependencyLinks = append(p.IsDependencyLinks, id)
 	return setkv(ctx, pkgVerCol, p, c)
@@ -376,25 +384,29 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.IDorPkgInput
 		if !errors.Is(err, kv.NotFoundError) {
 			return nil, err
 		}
-		c.m.Lock()
+		c.lock(false)
 		outType, err = byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inType.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, pkgTypeCol, inType); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, pkgTypeCol, inType, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outType = inType
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	inNamespace := &pkgNamespace{
@@ -405,29 +417,39 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.IDorPkgInput
 	outNamespace, err := byKeykv[*pkgNamespace](ctx, pkgNSCol, inNamespace.Key(), c)
 	c.m.RUnlock()
 	if err != nil {
-		c.m.Lock()
+		c.lock(false)
 		outNamespace, err = byKeykv[*pkgNamespace](ctx, pkgNSCol, inNamespace.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inNamespace.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, pkgNSCol, inNamespace); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, pkgNSCol, inNamespace, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
+				return nil, err
+			}
+			// The parent was read without the write lock, get its current
+			// version so that children added concurrently are not lost.
+			if outType, err = byKeykv[*pkgType](ctx, pkgTypeCol, outType.Key(), c); err != nil {
+				c.unlock(false)
 				return nil, err
 			}
 			if err := outType.addNamespace(ctx, inNamespace.ThisID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outNamespace = inNamespace
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	inName := &pkgName{
@@ -438,29 +460,39 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.IDorPkgInput
 	outName, err := byKeykv[*pkgName](ctx, pkgNameCol, inName.Key(), c)
 	c.m.RUnlock()
 	if err != nil {
-		c.m.Lock()
+		c.lock(false)
 		outName, err = byKeykv[*pkgName](ctx, pkgNameCol, inName.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inName.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, pkgNameCol, inName); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, pkgNameCol, inName, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
+				return nil, err
+			}
+			// The parent was read without the write lock, get its current
+			// version so that children added concurrently are not lost.
+			if outNamespace, err = byKeykv[*pkgNamespace](ctx, pkgNSCol, outNamespace.Key(), c); err != nil {
+				c.unlock(false)
 				return nil, err
 			}
 			if err := outNamespace.addName(ctx, inName.ThisID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outName = inName
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	inVersion := &pkgVersion{
@@ -473,29 +505,39 @@ func (c *demoClient) IngestPackage(ctx context.Context, input model.IDorPkgInput
 	outVersion, err := byKeykv[*pkgVersion](ctx, pkgVerCol, inVersion.Key(), c)
 	c.m.RUnlock()
 	if err != nil {
-		c.m.Lock()
+		c.lock(false)
 		outVersion, err = byKeykv[*pkgVersion](ctx, pkgVerCol, inVersion.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inVersion.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, pkgVerCol, inVersion); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, pkgVerCol, inVersion, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
+				return nil, err
+			}
+			// The parent was read without the write lock, get its current
+			// version so that children added concurrently are not lost.
+			if outName, err = byKeykv[*pkgName](ctx, pkgNameCol, outName.Key(), c); err != nil {
+				c.unlock(false)
 				return nil, err
 			}
 			if err := outName.addVersion(ctx, inVersion.ThisID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outVersion = inVersion
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	return &model.PackageIDs{
@@ -702,8 +744,8 @@ func (c *demoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, af
 			TotalCount: totalCount + addToCount,
 			PageInfo: &model.PageInfo{
 				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
+				StartCursor: ptrfrom.String(edges[0].Cursor),
+				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Cursor),
 			},
 			Edges: edges,
 		}, nil
@@ -1249,6 +1291,25 @@ func (c *demoClient) returnFoundPkgVersion(ctx context.Context, pkgIDorInput *mo
 	}
 }
 
+// returnFoundPkgName return the package name node by first searching via ID. If the ID is not specified, it defaults to searching via inputspec
+func (c *demoClient) returnFoundPkgName(ctx context.Context, pkgIDorInput *model.IDorPkgInput) (*pkgName, error) {
+	if pkgIDorInput.PackageNameID != nil {
+		foundPkgNameNode, err := byIDkv[*pkgName](ctx, *pkgIDorInput.PackageNameID, c)
+		if err != nil {
+			return nil, gqlerror.Errorf("failed to return pkgName node by ID with error: %v", err)
+		}
+		return foundPkgNameNode, nil
+	}
+	if pkgIDorInput.PackageInput == nil {
+		return nil, gqlerror.Errorf("either a package name ID or a package input must be specified")
+	}
+	foundPkgNameNode, err := c.getPackageNameFromInput(ctx, *pkgIDorInput.PackageInput)
+	if err != nil {
+		return nil, gqlerror.Errorf("failed to getPackageNameFromInput with error: %v", err)
+	}
+	return foundPkgNameNode, nil
+}
+
 // returnFoundPkgBasedOnMatchType return the node by first searching via ID depending on the match type. If the ID is not specified, it defaults to searching via inputspec
 func (c *demoClient) returnFoundPkgBasedOnMatchType(ctx context.Context, pkgIDorInput *model.IDorPkgInput, pkgMatchType *model.MatchFlags) (string, pkgNameOrVersion, error) {
 	if pkgIDorInput.PackageVersionID != nil && pkgIDorInput.PackageNameID != nil {
@@ -127,8 +127,8 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 		DocumentRef:   pointOfContact.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	var foundPkgNameOrVersionNode pkgNameOrVersion
 	var foundArtStruct *artStruct
@@ -194,6 +194,9 @@ func (c *demoClient) ingestPointOfContact(ctx context.Context, subject model.Pac
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
@@ -19,19 +19,97 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 	"golang.org/x/exp/maps"
 )
 
 const guacType string = "guac"
 
 func (c *demoClient) FindSoftwareList(ctx context.Context, searchText string, after *string, first *int) (*model.FindSoftwareConnection, error) {
-	return nil, fmt.Errorf("not implemented: FindSoftwareList")
+	if err := checkFirst(first); err != nil {
+		return nil, gqlerror.Errorf("FindSoftwareList :: %v", err)
+	}
+	software, err := c.FindSoftware(ctx, searchText)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each package version, source name and artifact found is its own edge,
+	// with its ID as cursor, so that pages do not depend on how the tries
+	// are grouped.
+	leaves := map[string]model.PackageSourceOrArtifact{}
+	for _, sw := range software {
+		switch sw := sw.(type) {
+		case *model.Package:
+			for _, ns := range sw.Namespaces {
+				for _, n := range ns.Names {
+					for _, v := range n.Versions {
+						leaves[v.ID] = &model.Package{
+							ID:   sw.ID,
+							Type: sw.Type,
+							Namespaces: []*model.PackageNamespace{{
+								ID:        ns.ID,
+								Namespace: ns.Namespace,
+								Names: []*model.PackageName{{
+									ID:       n.ID,
+									Name:     n.Name,
+									Versions: []*model.PackageVersion{v},
+								}},
+							}},
+						}
+					}
+				}
+			}
+		case *model.Source:
+			for _, ns := range sw.Namespaces {
+				for _, n := range ns.Names {
+					leaves[n.ID] = &model.Source{
+						ID:   sw.ID,
+						Type: sw.Type,
+						Namespaces: []*model.SourceNamespace{{
+							ID:        ns.ID,
+							Namespace: ns.Namespace,
+							Names:     []*model.SourceName{n},
+						}},
+					}
+				}
+			}
+		case *model.Artifact:
+			leaves[sw.ID] = sw
+		}
+	}
+
+	ids := maps.Keys(leaves)
+	sort.Strings(ids)
+	page, hasNextPage := pageIDs(ids, after, first)
+	if len(page) == 0 {
+		return nil, nil
+	}
+
+	edges := make([]*model.SoftwareEdge, 0, len(page))
+	for _, id := range page {
+		edges = append(edges, &model.SoftwareEdge{
+			Cursor: id,
+			Node:   leaves[id],
+		})
+	}
+	return &model.FindSoftwareConnection{
+		TotalCount: len(ids),
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+			StartCursor: ptrfrom.String(page[0]),
+			EndCursor:   ptrfrom.String(page[len(page)-1]),
+		},
+		Edges: edges,
+	}, nil
 }
 
 func (c *demoClient) BatchQuerySubjectPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
@@ -61,7 +139,7 @@ func (c *demoClient) BatchQueryDepPkgDependency(ctx context.Context, pkgIDs []st
 func (c *demoClient) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error) {
 	pkgCVs := make(map[string][]*model.CertifyVuln)
 	for _, pkgID := range pkgIDs {
-		certVuln, err := c.CertifyVuln(ctx, &model.CertifyVulnSpec{Package: &model.PkgSpec{ID: &pkgID}})
+		certVuln, err := c.CertifyVuln(ctx, &model.CertifyVulnSpec{Package: &model.PkgSpec{ID: &pkgID}}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query CertifyVuln for pkgID: %s, with error: %w", pkgID, err)
 		}
@@ -70,6 +148,9 @@ func (c *demoClient) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []st
 
 	deduplicatedPkgCVs := make(map[string][]*model.CertifyVuln)
 	for _, certVulns := range pkgCVs {
+		if len(certVulns) == 0 {
+			continue
+		}
 		pkgID := certVulns[0].Package.Namespaces[0].Names[0].Versions[0].ID
 		cvsByVulnID := make(map[string]*model.CertifyVuln)
 		for _, cv := range certVulns {
@@ -96,7 +177,7 @@ func (c *demoClient) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []st
 func (c *demoClient) BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []string) ([]*model.CertifyLegal, error) {
 	pkgCLs := make(map[string][]*model.CertifyLegal)
 	for _, pkgID := range pkgIDs {
-		certLegal, err := c.CertifyLegal(ctx, &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{ID: &pkgID}}})
+		certLegal, err := c.CertifyLegal(ctx, &model.CertifyLegalSpec{Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{ID: &pkgID}}}, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query CertifyLegal for pkgID: %s, with error: %w", pkgID, err)
 		}
@@ -105,6 +186,9 @@ func (c *demoClient) BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []s
 
 	deduplicatedPkgCLs := make(map[string]*model.CertifyLegal)
 	for _, certLegals := range pkgCLs {
+		if len(certLegals) == 0 {
+			continue
+		}
 		if pkg, ok := certLegals[0].Subject.(*model.Package); ok {
 			var latest time.Time
 			pkgID := pkg.Namespaces[0].Names[0].Versions[0].ID
@@ -466,6 +550,9 @@ func (c *demoClient) FindPackagesThatNeedScanning(ctx context.Context, queryType
 }
 
 func (c *demoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []string, after *string, first *int) (*model.PackageConnection, error) {
+	if err := checkFirst(first); err != nil {
+		return nil, gqlerror.Errorf("QueryPackagesListForScan :: %v", err)
+	}
 	c.m.RLock()
 	defer c.m.RUnlock()
 
@@ -474,8 +561,14 @@ func (c *demoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []stri
 		return nil, nil
 	}
 
+	ids := helper.SortAndRemoveDups(slices.Clone(pkgIDs))
+	page, hasNextPage := pageIDs(ids, after, first)
+	if len(page) == 0 {
+		return nil, nil
+	}
+
 	var edges []*model.PackageEdge
-	for _, pkgID := range pkgIDs {
+	for _, pkgID := range page {
 		p, err := c.buildPackageResponse(ctx, pkgID, nil)
 		if err != nil {
 			if errors.Is(err, errNotFound) {
@@ -485,17 +578,17 @@ func (c *demoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []stri
 			return nil, err
 		}
 		edges = append(edges, &model.PackageEdge{
-			Cursor: p.ID,
+			Cursor: pkgID,
 			Node:   p,
 		})
 	}
 
 	return &model.PackageConnection{
-		TotalCount: len(pkgIDs),
+		TotalCount: len(ids),
 		PageInfo: &model.PageInfo{
-			HasNextPage: false,
-			StartCursor: ptrfrom.String(pkgIDs[0]),
-			EndCursor:   ptrfrom.String(pkgIDs[len(pkgIDs)-1]),
+			HasNextPage: hasNextPage,
+			StartCursor: ptrfrom.String(page[0]),
+			EndCursor:   ptrfrom.String(page[len(page)-1]),
 		},
 		Edges: edges,
 	}, nil
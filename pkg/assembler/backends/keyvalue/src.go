@@ -209,25 +209,29 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.IDorSourceInp
 		if !errors.Is(err, kv.NotFoundError) {
 			return nil, err
 		}
-		c.m.Lock()
+		c.lock(false)
 		outType, err = byKeykv[*srcType](ctx, srcTypeCol, inType.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inType.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, srcTypeCol, inType); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, srcTypeCol, inType, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outType = inType
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	inNamespace := &srcNamespace{
@@ -241,29 +245,39 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.IDorSourceInp
 		if !errors.Is(err, kv.NotFoundError) {
 			return nil, err
 		}
-		c.m.Lock()
+		c.lock(false)
 		outNamespace, err = byKeykv[*srcNamespace](ctx, srcNSCol, inNamespace.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inNamespace.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, srcNSCol, inNamespace); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, srcNSCol, inNamespace, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
+				return nil, err
+			}
+			// The parent was read without the write lock, get its current
+			// version so that children added concurrently are not lost.
+			if outType, err = byKeykv[*srcType](ctx, srcTypeCol, outType.Key(), c); err != nil {
+				c.unlock(false)
 				return nil, err
 			}
 			if err := outType.addNamespace(ctx, inNamespace.ThisID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outNamespace = inNamespace
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	inName := &srcNameNode{
@@ -279,29 +293,39 @@ func (c *demoClient) IngestSource(ctx context.Context, input model.IDorSourceInp
 		if !errors.Is(err, kv.NotFoundError) {
 			return nil, err
 		}
-		c.m.Lock()
+		c.lock(false)
 		outName, err = byKeykv[*srcNameNode](ctx, srcNameCol, inName.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inName.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, srcNameCol, inName); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, srcNameCol, inName, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
+				return nil, err
+			}
+			// The parent was read without the write lock, get its current
+			// version so that children added concurrently are not lost.
+			if outNamespace, err = byKeykv[*srcNamespace](ctx, srcNSCol, outNamespace.Key(), c); err != nil {
+				c.unlock(false)
 				return nil, err
 			}
 			if err := outNamespace.addName(ctx, inName.ThisID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outName = inName
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	return &model.SourceIDs{
@@ -472,8 +496,8 @@ func (c *demoClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpe
 			TotalCount: totalCount + addToCount,
 			PageInfo: &model.PageInfo{
 				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
+				StartCursor: ptrfrom.String(edges[0].Cursor),
+				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Cursor),
 			},
 			Edges: edges,
 		}, nil
@@ -17,10 +17,24 @@
 
 package keyvalue
 
-import "github.com/guacsec/guac/pkg/assembler/kv/tikv"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/tikv"
+)
 
 func init() {
 	// TiKV does not support 32 bit. Also darwin required CGO and cross compile
 	// using xcode...
-	tikvGS = tikv.GetStore
+	tikvGS = func(ctx context.Context, s string) (kv.Store, error) {
+		st, err := tikv.GetStore(ctx, s)
+		if errors.Is(err, tikv.ErrRawData) {
+			return nil, fmt.Errorf("%w: start once with --kv-tikv-migrate", err)
+		}
+		return st, err
+	}
+	tikvMigrate = tikv.Migrate
 }
@@ -0,0 +1,179 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// txnStore wraps the kv.Store of the backend. While a write transaction is
+// open, Set and Delete are buffered and only reach the underlying store on
+// commit, as one atomic kv.Batch. Reads see the buffered writes. An ingest
+// that fails half way is rolled back, so it does not leave dangling IDs or
+// partially linked nodes behind.
+//
+// Transactions are only opened while holding the exclusive lock of the
+// demoClient, so there is at most one open at a time.
+type txnStore struct {
+	kv.Store
+	batch   *kv.Batch
+	pending map[string]map[string]pendingValue
+}
+
+type pendingValue struct {
+	value   any
+	deleted bool
+}
+
+func newTxnStore(s kv.Store) *txnStore {
+	return &txnStore{Store: s}
+}
+
+func (t *txnStore) begin() {
+	t.batch = &kv.Batch{}
+	t.pending = make(map[string]map[string]pendingValue)
+}
+
+// commit writes all the buffered writes to the underlying store. It is a
+// no-op if no transaction is open.
+func (t *txnStore) commit(ctx context.Context) error {
+	if t.batch == nil {
+		return nil
+	}
+	b := t.batch
+	t.rollback()
+	return t.Store.Write(ctx, b)
+}
+
+// rollback drops all the buffered writes.
+func (t *txnStore) rollback() {
+	t.batch = nil
+	t.pending = nil
+}
+
+func (t *txnStore) lookup(c, k string) (pendingValue, bool) {
+	if t.pending == nil {
+		return pendingValue{}, false
+	}
+	p, ok := t.pending[c][k]
+	return p, ok
+}
+
+func (t *txnStore) record(c, k string, p pendingValue) {
+	if t.pending[c] == nil {
+		t.pending[c] = make(map[string]pendingValue)
+	}
+	t.pending[c][k] = p
+}
+
+func (t *txnStore) Get(ctx context.Context, c, k string, v any) error {
+	p, ok := t.lookup(c, k)
+	if !ok {
+		return t.Store.Get(ctx, c, k, v)
+	}
+	if p.deleted {
+		return fmt.Errorf("%w : Key %q", kv.NotFoundError, k)
+	}
+	dst := reflect.ValueOf(v)
+	if dst.Kind() != reflect.Pointer || !dst.Elem().CanSet() {
+		return fmt.Errorf("%w : Not a settable pointer", kv.BadPtrError)
+	}
+	dst.Elem().Set(reflect.ValueOf(p.value))
+	return nil
+}
+
+func (t *txnStore) Set(ctx context.Context, c, k string, v any) error {
+	if t.batch == nil {
+		return t.Store.Set(ctx, c, k, v)
+	}
+	t.batch.Set(c, k, v)
+	t.record(c, k, pendingValue{value: v})
+	return nil
+}
+
+func (t *txnStore) Delete(ctx context.Context, c, k string) error {
+	if t.batch == nil {
+		return t.Store.Delete(ctx, c, k)
+	}
+	t.batch.Delete(c, k)
+	t.record(c, k, pendingValue{deleted: true})
+	return nil
+}
+
+func (t *txnStore) Write(ctx context.Context, b *kv.Batch) error {
+	if t.batch == nil {
+		return t.Store.Write(ctx, b)
+	}
+	for _, op := range b.Ops() {
+		if op.Delete {
+			_ = t.Delete(ctx, op.Collection, op.Key)
+			continue
+		}
+		_ = t.Set(ctx, op.Collection, op.Key, op.Value)
+	}
+	return nil
+}
+
+func (t *txnStore) Keys(c string) kv.Scanner {
+	if len(t.pending[c]) == 0 {
+		return t.Store.Keys(c)
+	}
+	return &txnScanner{
+		scn:     t.Store.Keys(c),
+		pending: t.pending[c],
+	}
+}
+
+// txnScanner merges the keys of the underlying store with the buffered
+// writes of a collection. All the keys are returned by the first Scan.
+type txnScanner struct {
+	scn     kv.Scanner
+	pending map[string]pendingValue
+	done    bool
+}
+
+func (s *txnScanner) Scan(ctx context.Context) ([]string, bool, error) {
+	if s.done {
+		return nil, true, nil
+	}
+	s.done = true
+	keys := make(map[string]bool)
+	for done := false; !done; {
+		var ks []string
+		var err error
+		ks, done, err = s.scn.Scan(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, k := range ks {
+			keys[k] = true
+		}
+	}
+	for k, p := range s.pending {
+		if p.deleted {
+			delete(keys, k)
+		} else {
+			keys[k] = true
+		}
+	}
+	return maps.Keys(keys), true, nil
+}
@@ -0,0 +1,115 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+func scanAll(ctx context.Context, t *testing.T, s kv.Store, c string) []string {
+	t.Helper()
+	var out []string
+	scn := s.Keys(c)
+	for done := false; !done; {
+		var ks []string
+		var err error
+		ks, done, err = scn.Scan(ctx)
+		if err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+		out = append(out, ks...)
+	}
+	slices.Sort(out)
+	return out
+}
+
+func TestTxnStore(t *testing.T) {
+	ctx := context.Background()
+	base := memmap.GetStore()
+	if err := base.Set(ctx, "c", "a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	s := newTxnStore(base)
+
+	s.begin()
+	_ = s.Set(ctx, "c", "b", "2")
+	_ = s.Delete(ctx, "c", "a")
+	var v string
+	if err := s.Get(ctx, "c", "b", &v); err != nil || v != "2" {
+		t.Errorf("Get of pending write = %q, %v", v, err)
+	}
+	if err := s.Get(ctx, "c", "a", &v); !errors.Is(err, kv.NotFoundError) {
+		t.Errorf("Get of pending delete, want NotFoundError, got %v", err)
+	}
+	if got := scanAll(ctx, t, s, "c"); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("Keys in transaction = %v", got)
+	}
+	if got := scanAll(ctx, t, base, "c"); !slices.Equal(got, []string{"a"}) {
+		t.Errorf("Keys of underlying store before commit = %v", got)
+	}
+	s.rollback()
+	if got := scanAll(ctx, t, s, "c"); !slices.Equal(got, []string{"a"}) {
+		t.Errorf("Keys after rollback = %v", got)
+	}
+
+	s.begin()
+	_ = s.Set(ctx, "c", "b", "2")
+	_ = s.Delete(ctx, "c", "a")
+	if err := s.commit(ctx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if got := scanAll(ctx, t, base, "c"); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("Keys of underlying store after commit = %v", got)
+	}
+}
+
+// failingStore fails every batched write.
+type failingStore struct {
+	kv.Store
+}
+
+func (failingStore) Write(context.Context, *kv.Batch) error {
+	return errors.New("write failed")
+}
+
+func TestIngestRollsBackOnFailedCommit(t *testing.T) {
+	ctx := context.Background()
+	base := memmap.GetStore()
+	b, err := getBackend(ctx, failingStore{Store: base})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := b.(*demoClient)
+
+	if _, err := c.IngestPackage(ctx, model.IDorPkgInput{PackageInput: &model.PkgInputSpec{
+		Type: "pypi",
+		Name: "tensorflow",
+	}}); err == nil {
+		t.Fatal("expected IngestPackage to fail")
+	}
+	for _, coll := range []string{indexCol, pkgTypeCol, pkgNSCol, pkgNameCol, pkgVerCol} {
+		if got := scanAll(ctx, t, base, coll); len(got) != 0 {
+			t.Errorf("collection %q has keys after failed ingest: %v", coll, got)
+		}
+	}
+}
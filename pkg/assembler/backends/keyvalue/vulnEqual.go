@@ -89,8 +89,8 @@ func (c *demoClient) ingestVulnEqual(ctx context.Context, vulnerability model.ID
 		DocumentRef:   vulnEqual.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	vIDs := make([]string, 0, 2)
 	vs := make([]*vulnIDNode, 0, 2)
@@ -133,6 +133,9 @@ func (c *demoClient) ingestVulnEqual(ctx context.Context, vulnerability model.ID
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
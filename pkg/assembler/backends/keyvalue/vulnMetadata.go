@@ -94,8 +94,8 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 		DocumentRef: vulnerabilityMetadata.DocumentRef,
 	}
 
-	lock(&c.m, readOnly)
-	defer unlock(&c.m, readOnly)
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
 
 	foundVulnNode, err := c.returnFoundVulnerability(ctx, &vulnerability)
 	if err != nil {
@@ -129,6 +129,9 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 		return "", err
 	}
 
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
 	return in.ThisID, nil
 }
 
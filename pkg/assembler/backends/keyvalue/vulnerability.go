@@ -45,6 +45,7 @@ type vulnIDNode struct {
 	VulnEqualLinks    []string
 	VexLinks          []string
 	VulnMetadataLinks []string
+	VulnRangeLinks    []string
 }
 
 func (n *vulnTypeStruct) ID() string { return n.ThisID }
@@ -85,6 +86,9 @@ func (n *vulnIDNode) Neighbors(allowedEdges edgeMap) []string {
 	if allowedEdges[model.EdgeVulnMetadataVulnerability] {
 		out = append(out, n.VulnMetadataLinks...)
 	}
+	if allowedEdges[model.EdgeVulnerabilityVulnerabilityRange] {
+		out = append(out, n.VulnRangeLinks...)
+	}
 
 	return out
 }
@@ -120,6 +124,11 @@ func (n *vulnIDNode) setVulnMetadataLinks(ctx context.Context, id string, c *dem
 	return setkv(ctx, vulnIDCol, n, c)
 }
 
+func (n *vulnIDNode) setVulnRangeLinks(ctx context.Context, id string, c *demoClient) error {
+	n.VulnRangeLinks = append(n.VulnRangeLinks, id)
+	return setkv(ctx, vulnIDCol, n, c)
+}
+
 func (n *vulnTypeStruct) addVulnID(ctx context.Context, vulnID string, c *demoClient) error {
 	n.VulnIDs = append(n.VulnIDs, vulnID)
 	return setkv(ctx, vulnTypeCol, n, c)
@@ -150,25 +159,29 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, input model.IDorVu
 		if !errors.Is(err, kv.NotFoundError) {
 			return nil, err
 		}
-		c.m.Lock()
+		c.lock(false)
 		outType, err = byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, inType.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inType.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, vulnTypeCol, inType); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, vulnTypeCol, inType, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outType = inType
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	inVulnID := &vulnIDNode{
@@ -182,29 +195,39 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, input model.IDorVu
 		if !errors.Is(err, kv.NotFoundError) {
 			return nil, err
 		}
-		c.m.Lock()
+		c.lock(false)
 		outVulnID, err = byKeykv[*vulnIDNode](ctx, vulnIDCol, inVulnID.Key(), c)
 		if err != nil {
 			if !errors.Is(err, kv.NotFoundError) {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			inVulnID.ThisID = c.getNextID()
 			if err := c.addToIndex(ctx, vulnIDCol, inVulnID); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			if err := setkv(ctx, vulnIDCol, inVulnID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
+				return nil, err
+			}
+			// The parent was read without the write lock, get its current
+			// version so that children added concurrently are not lost.
+			if outType, err = byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, outType.Key(), c); err != nil {
+				c.unlock(false)
 				return nil, err
 			}
 			if err := outType.addVulnID(ctx, inVulnID.ThisID, c); err != nil {
-				c.m.Unlock()
+				c.unlock(false)
 				return nil, err
 			}
 			outVulnID = inVulnID
 		}
-		c.m.Unlock()
+		if err := c.kv.commit(ctx); err != nil {
+			c.unlock(false)
+			return nil, err
+		}
+		c.unlock(false)
 	}
 
 	return &model.VulnerabilityIDs{
@@ -371,8 +394,8 @@ func (c *demoClient) VulnerabilityList(ctx context.Context, vulnSpec model.Vulne
 			TotalCount: totalCount + addToCount,
 			PageInfo: &model.PageInfo{
 				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
+				StartCursor: ptrfrom.String(edges[0].Cursor),
+				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Cursor),
 			},
 			Edges: edges,
 		}, nil
@@ -0,0 +1,365 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// Internal data: link between package names and the vulnerabilities affecting
+// a range of their versions
+type vulnerabilityRangeLink struct {
+	ThisID          string
+	PackageID       string
+	VulnerabilityID string
+	RangeType       model.VulnerabilityRangeType
+	Introduced      string
+	Fixed           string
+	LastAffected    string
+	Origin          string
+	Collector       string
+	DocumentRef     string
+}
+
+func (n *vulnerabilityRangeLink) ID() string { return n.ThisID }
+func (n *vulnerabilityRangeLink) Key() string {
+	return hashKey(strings.Join([]string{
+		n.PackageID,
+		n.VulnerabilityID,
+		string(n.RangeType),
+		n.Introduced,
+		n.Fixed,
+		n.LastAffected,
+		n.Origin,
+		n.Collector,
+		n.DocumentRef,
+	}, ":"))
+}
+
+func (n *vulnerabilityRangeLink) Neighbors(allowedEdges edgeMap) []string {
+	out := make([]string, 0, 2)
+	if allowedEdges[model.EdgeVulnerabilityRangePackage] {
+		out = append(out, n.PackageID)
+	}
+	if allowedEdges[model.EdgeVulnerabilityRangeVulnerability] {
+		out = append(out, n.VulnerabilityID)
+	}
+	return out
+}
+
+func (n *vulnerabilityRangeLink) BuildModelNode(ctx context.Context, c *demoClient) (model.Node, error) {
+	return c.convVulnerabilityRange(ctx, n, nil)
+}
+
+// Ingest VulnerabilityRange
+
+func (c *demoClient) IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error) {
+	var modelVulnerabilityRangeList []string
+	for i := range vulnerabilityRanges {
+		vr, err := c.IngestVulnerabilityRange(ctx, *pkgs[i], *vulnerabilities[i], *vulnerabilityRanges[i])
+		if err != nil {
+			return nil, gqlerror.Errorf("IngestVulnerabilityRange failed with err: %v", err)
+		}
+		modelVulnerabilityRangeList = append(modelVulnerabilityRangeList, vr)
+	}
+	return modelVulnerabilityRangeList, nil
+}
+
+func (c *demoClient) IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error) {
+	return c.ingestVulnerabilityRange(ctx, pkg, vulnerability, vulnerabilityRange, true)
+}
+
+func (c *demoClient) ingestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec, readOnly bool) (string, error) {
+	funcName := "IngestVulnerabilityRange"
+
+	in := &vulnerabilityRangeLink{
+		RangeType:    vulnerabilityRange.RangeType,
+		Introduced:   vulnerabilityRange.Introduced,
+		Fixed:        vulnerabilityRange.Fixed,
+		LastAffected: vulnerabilityRange.LastAffected,
+		Origin:       vulnerabilityRange.Origin,
+		Collector:    vulnerabilityRange.Collector,
+		DocumentRef:  vulnerabilityRange.DocumentRef,
+	}
+
+	c.lock(readOnly)
+	defer c.unlock(readOnly)
+
+	foundPkgName, err := c.returnFoundPkgName(ctx, &pkg)
+	if err != nil {
+		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	in.PackageID = foundPkgName.ThisID
+
+	foundVulnNode, err := c.returnFoundVulnerability(ctx, &vulnerability)
+	if err != nil {
+		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	in.VulnerabilityID = foundVulnNode.ThisID
+
+	out, err := byKeykv[*vulnerabilityRangeLink](ctx, vulnRngCol, in.Key(), c)
+	if err == nil {
+		return out.ThisID, nil
+	}
+	if !errors.Is(err, kv.NotFoundError) {
+		return "", err
+	}
+
+	if readOnly {
+		c.m.RUnlock()
+		vr, err := c.ingestVulnerabilityRange(ctx, pkg, vulnerability, vulnerabilityRange, false)
+		c.m.RLock() // relock so that defer unlock does not panic
+		return vr, err
+	}
+
+	in.ThisID = c.getNextID()
+	if err := c.addToIndex(ctx, vulnRngCol, in); err != nil {
+		return "", err
+	}
+	// set the backlinks
+	if err := foundPkgName.setVulnRangeLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
+	if err := foundVulnNode.setVulnRangeLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
+	if err := setkv(ctx, vulnRngCol, in, c); err != nil {
+		return "", err
+	}
+
+	if err := c.kv.commit(ctx); err != nil {
+		return "", err
+	}
+	return in.ThisID, nil
+}
+
+// Query VulnerabilityRange
+
+func (c *demoClient) VulnerabilityRangeList(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+	funcName := "VulnerabilityRange"
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	out, err := c.searchVulnerabilityRanges(ctx, &vulnerabilityRangeSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	totalCount := len(out)
+	if after != nil {
+		i := slices.IndexFunc(out, func(vr *model.VulnerabilityRange) bool { return vr.ID == *after })
+		if i < 0 {
+			return nil, gqlerror.Errorf("%v :: cursor %q not found", funcName, *after)
+		}
+		out = out[i+1:]
+	}
+	hasNextPage := false
+	if first != nil && *first < len(out) {
+		out = out[:*first]
+		hasNextPage = true
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	edges := make([]*model.VulnerabilityRangeEdge, 0, len(out))
+	for _, vr := range out {
+		edges = append(edges, &model.VulnerabilityRangeEdge{
+			Cursor: vr.ID,
+			Node:   vr,
+		})
+	}
+	return &model.VulnerabilityRangeConnection{
+		TotalCount: totalCount,
+		PageInfo: &model.PageInfo{
+			HasNextPage: hasNextPage,
+			StartCursor: ptrfrom.String(out[0].ID),
+			EndCursor:   ptrfrom.String(out[len(out)-1].ID),
+		},
+		Edges: edges,
+	}, nil
+}
+
+func (c *demoClient) VulnerabilityRange(ctx context.Context, filter *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error) {
+	funcName := "VulnerabilityRange"
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	out, err := c.searchVulnerabilityRanges(ctx, filter)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+	}
+	return out, nil
+}
+
+func (c *demoClient) searchVulnerabilityRanges(ctx context.Context, filter *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error) {
+	if filter != nil && filter.ID != nil {
+		link, err := byIDkv[*vulnerabilityRangeLink](ctx, *filter.ID, c)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		vr, err := c.convVulnerabilityRange(ctx, link, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []*model.VulnerabilityRange{vr}, nil
+	}
+
+	var search []string
+	foundOne := false
+	if filter != nil && filter.Package != nil {
+		exactPkgName, err := c.exactPackageName(ctx, pkgNameSpec(filter.Package))
+		if err != nil {
+			return nil, err
+		}
+		if exactPkgName != nil {
+			search = exactPkgName.VulnRangeLinks
+			foundOne = true
+		}
+	}
+	if !foundOne && filter != nil && filter.Vulnerability != nil {
+		exactVuln, err := c.exactVulnerability(ctx, filter.Vulnerability)
+		if err != nil {
+			return nil, err
+		}
+		if exactVuln != nil {
+			search = exactVuln.VulnRangeLinks
+			foundOne = true
+		}
+	}
+
+	var out []*model.VulnerabilityRange
+	if foundOne {
+		for _, id := range search {
+			link, err := byIDkv[*vulnerabilityRangeLink](ctx, id, c)
+			if err != nil {
+				return nil, err
+			}
+			vr, err := c.vulnerabilityRangeIfMatch(ctx, filter, link)
+			if err != nil {
+				return nil, err
+			}
+			if vr != nil {
+				out = append(out, vr)
+			}
+		}
+		return out, nil
+	}
+
+	var done bool
+	scn := c.kv.Keys(vulnRngCol)
+	for !done {
+		var keys []string
+		var err error
+		keys, done, err = scn.Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			link, err := byKeykv[*vulnerabilityRangeLink](ctx, vulnRngCol, key, c)
+			if err != nil {
+				return nil, err
+			}
+			vr, err := c.vulnerabilityRangeIfMatch(ctx, filter, link)
+			if err != nil {
+				return nil, err
+			}
+			if vr != nil {
+				out = append(out, vr)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (c *demoClient) vulnerabilityRangeIfMatch(ctx context.Context, filter *model.VulnerabilityRangeSpec, link *vulnerabilityRangeLink) (*model.VulnerabilityRange, error) {
+	if filter != nil {
+		if (filter.RangeType != nil && *filter.RangeType != link.RangeType) ||
+			noMatch(filter.Introduced, link.Introduced) ||
+			noMatch(filter.Fixed, link.Fixed) ||
+			noMatch(filter.LastAffected, link.LastAffected) ||
+			noMatch(filter.Origin, link.Origin) ||
+			noMatch(filter.Collector, link.Collector) ||
+			noMatch(filter.DocumentRef, link.DocumentRef) {
+			return nil, nil
+		}
+	}
+	return c.convVulnerabilityRange(ctx, link, filter)
+}
+
+// convVulnerabilityRange returns the VulnerabilityRange of the link, or nil if
+// its package or vulnerability do not match the filter.
+func (c *demoClient) convVulnerabilityRange(ctx context.Context, link *vulnerabilityRangeLink, filter *model.VulnerabilityRangeSpec) (*model.VulnerabilityRange, error) {
+	var pkgFilter *model.PkgSpec
+	var vulnFilter *model.VulnerabilitySpec
+	if filter != nil {
+		pkgFilter = pkgNameSpec(filter.Package)
+		vulnFilter = filter.Vulnerability
+	}
+	p, err := c.buildPackageResponse(ctx, link.PackageID, pkgFilter)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, nil
+	}
+	vuln, err := c.buildVulnResponse(ctx, link.VulnerabilityID, vulnFilter)
+	if err != nil {
+		return nil, err
+	}
+	if vuln == nil {
+		return nil, nil
+	}
+	return &model.VulnerabilityRange{
+		ID:            link.ThisID,
+		Vulnerability: vuln,
+		Package:       p,
+		RangeType:     link.RangeType,
+		Introduced:    link.Introduced,
+		Fixed:         link.Fixed,
+		LastAffected:  link.LastAffected,
+		Origin:        link.Origin,
+		Collector:     link.Collector,
+		DocumentRef:   link.DocumentRef,
+	}, nil
+}
+
+// pkgNameSpec returns the package name fields of a package filter: the
+// version fields do not apply to package names.
+func pkgNameSpec(filter *model.PkgSpec) *model.PkgSpec {
+	if filter == nil {
+		return nil
+	}
+	return &model.PkgSpec{
+		ID:        filter.ID,
+		Type:      filter.Type,
+		Namespace: filter.Namespace,
+		Name:      filter.Name,
+	}
+}
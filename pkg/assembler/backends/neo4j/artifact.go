@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -66,7 +67,7 @@ func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.Artifac
 }
 
 func (c *neo4jClient) IngestArtifacts(ctx context.Context, artifacts []*model.IDorArtifactInput) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestArtifacts")
+	return []string{}, fmt.Errorf("%w: IngestArtifacts", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestArtifact(ctx context.Context, artifact *model.IDorArtifactInput) (string, error) {
@@ -141,5 +142,5 @@ func generateModelArtifact(algorithm, digest string) *model.Artifact {
 }
 
 func (c *neo4jClient) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
-	return nil, fmt.Errorf("not implemented: ArtifactsList")
+	return nil, fmt.Errorf("%w: ArtifactsList", backends.ErrNotImplemented)
 }
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
@@ -158,30 +159,30 @@ func matchProperties(sb *strings.Builder, firstMatch bool, label, property strin
 }
 
 func (c *neo4jClient) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
-	panic(fmt.Errorf("not implemented: Licenses"))
+	panic(fmt.Errorf("%w: Licenses", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) LicenseList(ctx context.Context, licenseSpec model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
-	panic(fmt.Errorf("not implemented: LicenseList"))
+	panic(fmt.Errorf("%w: LicenseList", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestLicense(ctx context.Context, license *model.IDorLicenseInput) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestLicense"))
+	panic(fmt.Errorf("%w: IngestLicense", backends.ErrNotImplemented))
 }
 func (c *neo4jClient) IngestLicenses(ctx context.Context, licenses []*model.IDorLicenseInput) ([]string, error) {
-	panic(fmt.Errorf("not implemented: IngestLicenses"))
+	panic(fmt.Errorf("%w: IngestLicenses", backends.ErrNotImplemented))
 }
 
-func (c *neo4jClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
-	panic(fmt.Errorf("not implemented: CertifyLegalList"))
+func (c *neo4jClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error) {
+	panic(fmt.Errorf("%w: CertifyLegalList", backends.ErrNotImplemented))
 }
 
-func (c *neo4jClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
-	panic(fmt.Errorf("not implemented: CertifyLegal"))
+func (c *neo4jClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error) {
+	panic(fmt.Errorf("%w: CertifyLegal", backends.ErrNotImplemented))
 }
 func (c *neo4jClient) IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal *model.CertifyLegalInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestCertifyLegal"))
+	panic(fmt.Errorf("%w: IngestCertifyLegal", backends.ErrNotImplemented))
 }
 func (c *neo4jClient) IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error) {
-	panic(fmt.Errorf("not implemented: IngestCertifyLegals"))
+	panic(fmt.Errorf("%w: IngestCertifyLegals", backends.ErrNotImplemented))
 }
@@ -19,12 +19,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
 
 func (c *neo4jClient) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
-	return nil, fmt.Errorf("not implemented: BuildersList")
+	return nil, fmt.Errorf("%w: BuildersList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
@@ -67,7 +68,7 @@ func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSp
 }
 
 func (c *neo4jClient) IngestBuilders(ctx context.Context, builders []*model.IDorBuilderInput) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestBuilders")
+	return []string{}, fmt.Errorf("%w: IngestBuilders", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestBuilder(ctx context.Context, builder *model.IDorBuilderInput) (string, error) {
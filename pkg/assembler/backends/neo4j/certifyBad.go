@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -29,7 +30,7 @@ import (
 // query certifyBad
 
 func (c *neo4jClient) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyBadList")
+	return nil, fmt.Errorf("%w: CertifyBadList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
@@ -268,9 +269,9 @@ func generateModelCertifyBad(subject model.PackageSourceOrArtifact, justificatio
 // ingest certifyBad
 
 func (c *neo4jClient) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestCertifyBad - IngestCertifyBad"))
+	panic(fmt.Errorf("%w: IngestCertifyBad - IngestCertifyBad", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestCertifyBads(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyBads []*model.CertifyBadInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestCertifyBads")
+	return []string{}, fmt.Errorf("%w: IngestCertifyBads", backends.ErrNotImplemented)
 }
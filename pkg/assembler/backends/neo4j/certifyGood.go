@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -29,7 +30,7 @@ import (
 // query certifyGood
 
 func (c *neo4jClient) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
-	return nil, fmt.Errorf("not implemented: BuildersList")
+	return nil, fmt.Errorf("%w: BuildersList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
@@ -262,9 +263,9 @@ func generateModelCertifyGood(subject model.PackageSourceOrArtifact, justificati
 // ingest certifyGood
 
 func (c *neo4jClient) IngestCertifyGood(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyGood model.CertifyGoodInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestCertifyGood - IngestCertifyGood"))
+	panic(fmt.Errorf("%w: IngestCertifyGood - IngestCertifyGood", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestCertifyGoods(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyGoods []*model.CertifyGoodInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestCertifyGoods")
+	return []string{}, fmt.Errorf("%w: IngestCertifyGoods", backends.ErrNotImplemented)
 }
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -39,11 +40,15 @@ const (
 
 // Query Scorecards
 
-func (c *neo4jClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
-	return nil, fmt.Errorf("not implemented: BuildersList")
+func (c *neo4jClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	return nil, fmt.Errorf("%w: BuildersList", backends.ErrNotImplemented)
 }
 
-func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: Scorecards asOf", backends.ErrNotImplemented)
+	}
+
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
@@ -195,7 +200,7 @@ func setCertifyScorecardValues(sb *strings.Builder, certifyScorecardSpec *model.
 // Ingest Scorecards
 
 func (c *neo4jClient) IngestScorecards(ctx context.Context, sources []*model.IDorSourceInput, scorecards []*model.ScorecardInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestScorecards")
+	return []string{}, fmt.Errorf("%w: IngestScorecards", backends.ErrNotImplemented)
 }
 
 // Ingest Scorecard
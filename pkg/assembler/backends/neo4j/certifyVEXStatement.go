@@ -18,16 +18,21 @@ package neo4j
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
-func (c *neo4jClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
-	return nil, fmt.Errorf("not implemented: BuildersList")
+func (c *neo4jClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error) {
+	return nil, fmt.Errorf("%w: BuildersList", backends.ErrNotImplemented)
 }
 
 // TODO (pxp928): fix for new vulnerability
-func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: CertifyVEXStatement asOf", backends.ErrNotImplemented)
+	}
 
 	// // TODO: Fix validation
 	// querySubjectAll := true
@@ -527,7 +532,7 @@ func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStateme
 	// }
 
 	// return aggregateCertifyVEXStatement, nil
-	return []*model.CertifyVEXStatement{}, fmt.Errorf("not implemented - CertifyVEXStatement")
+	return []*model.CertifyVEXStatement{}, fmt.Errorf("%w - CertifyVEXStatement", backends.ErrNotImplemented)
 }
 
 // func setCertifyVEXStatementValues(sb *strings.Builder, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, firstMatch *bool, queryValues map[string]any) {
@@ -644,10 +649,10 @@ func (c *neo4jClient) IngestVEXStatement(ctx context.Context, subject model.Pack
 	// if err != nil {
 	// 	return nil, err
 	// }
-	// panic(fmt.Errorf("not implemented: IngestVEXStatement - IngestVEXStatement"))
-	return "", fmt.Errorf("not implemented - IngestVEXStatement")
+	// panic(fmt.Errorf("%w: IngestVEXStatement - IngestVEXStatement", backends.ErrNotImplemented))
+	return "", fmt.Errorf("%w - IngestVEXStatement", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestVEXStatements(ctx context.Context, subjects model.PackageOrArtifactInputs, vulnerabilities []*model.IDorVulnerabilityInput, vexStatements []*model.VexStatementInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented - IngestVEXStatements")
+	return []string{}, fmt.Errorf("%w - IngestVEXStatements", backends.ErrNotImplemented)
 }
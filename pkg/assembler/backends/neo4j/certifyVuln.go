@@ -18,7 +18,9 @@ package neo4j
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -31,12 +33,15 @@ const (
 
 // Query CertifyVuln
 
-func (c *neo4jClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyVulnList")
+func (c *neo4jClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	return nil, fmt.Errorf("%w: CertifyVulnList", backends.ErrNotImplemented)
 }
 
 // TODO (pxp928): fix for new vulnerability
-func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: CertifyVuln asOf", backends.ErrNotImplemented)
+	}
 
 	// session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	// defer session.Close()
@@ -268,7 +273,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 	// 	aggregateCertifyVuln = append(aggregateCertifyVuln, result.([]*model.CertifyVuln)...)
 	// }
 	// return aggregateCertifyVuln, nil
-	return []*model.CertifyVuln{}, fmt.Errorf("not implemented - CertifyVuln")
+	return []*model.CertifyVuln{}, fmt.Errorf("%w - CertifyVuln", backends.ErrNotImplemented)
 }
 
 // func setCertifyVulnValues(sb *strings.Builder, certifyVulnSpec *model.CertifyVulnSpec, firstMatch *bool, queryValues map[string]any) {
@@ -559,9 +564,9 @@ func (c *neo4jClient) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgIn
 	// } else {
 	// 	return nil, gqlerror.Errorf("package or source not specified for IngestOccurrence")
 	// }
-	return "", fmt.Errorf("not implemented - IngestCertifyVuln")
+	return "", fmt.Errorf("%w - IngestCertifyVuln", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented - IngestCertifyVulns")
+	return []string{}, fmt.Errorf("%w - IngestCertifyVulns", backends.ErrNotImplemented)
 }
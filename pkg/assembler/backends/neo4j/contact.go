@@ -19,21 +19,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
-	return nil, fmt.Errorf("not implemented: PointOfContactList")
+	return nil, fmt.Errorf("%w: PointOfContactList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestPointOfContact(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, pointOfContact model.PointOfContactInputSpec) (string, error) {
-	return "", fmt.Errorf("not implemented: IngestPointOfContact")
+	return "", fmt.Errorf("%w: IngestPointOfContact", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
-	return nil, fmt.Errorf("not implemented: PointOfContact")
+	return nil, fmt.Errorf("%w: PointOfContact", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestPointOfContacts(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, pointOfContacts []*model.PointOfContactInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestPointOfContacts")
+	return []string{}, fmt.Errorf("%w: IngestPointOfContacts", backends.ErrNotImplemented)
 }
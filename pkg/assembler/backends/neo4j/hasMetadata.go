@@ -19,21 +19,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasMetadataList")
+	return nil, fmt.Errorf("%w: HasMetadataList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
-	return "", fmt.Errorf("not implemented: IngestHasMetadata")
+	return "", fmt.Errorf("%w: IngestHasMetadata", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
-	return nil, fmt.Errorf("not implemented: HasMetadata")
+	return nil, fmt.Errorf("%w: HasMetadata", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestBulkHasMetadata(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, hasMetadataList []*model.HasMetadataInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestBulkHasMetadata")
+	return []string{}, fmt.Errorf("%w: IngestBulkHasMetadata", backends.ErrNotImplemented)
 }
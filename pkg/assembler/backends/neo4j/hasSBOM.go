@@ -19,7 +19,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -30,12 +32,15 @@ const (
 	uri string = "uri"
 )
 
-func (c *neo4jClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSBOMList")
+func (c *neo4jClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error) {
+	return nil, fmt.Errorf("%w: HasSBOMList", backends.ErrNotImplemented)
 }
 
 // TODO: noe4j backend does not match the schema. This needs updating before use!
-func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error) {
+	if asOf != nil {
+		return nil, fmt.Errorf("%w: HasSBOM asOf", backends.ErrNotImplemented)
+	}
 
 	queryAll := true
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
@@ -192,9 +197,9 @@ func generateModelHasSBOM(subject model.PackageOrArtifact, uri, origin, collecto
 }
 
 func (c *neo4jClient) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestHasSbom - IngestHasSbom"))
+	panic(fmt.Errorf("%w: IngestHasSbom - IngestHasSbom", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestHasSBOMs")
+	return []string{}, fmt.Errorf("%w: IngestHasSBOMs", backends.ErrNotImplemented)
 }
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -31,7 +32,7 @@ const (
 )
 
 func (c *neo4jClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSLSAList")
+	return nil, fmt.Errorf("%w: HasSLSAList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
@@ -602,9 +603,9 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 // }
 
 func (c *neo4jClient) IngestSLSA(ctx context.Context, subject model.IDorArtifactInput, builtFrom []*model.IDorArtifactInput, builtBy model.IDorBuilderInput, slsa model.SLSAInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestSlsa - ingestSLSA"))
+	panic(fmt.Errorf("%w: IngestSlsa - ingestSLSA", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestSLSAs(ctx context.Context, subjects []*model.IDorArtifactInput, builtFromList [][]*model.IDorArtifactInput, builtByList []*model.IDorBuilderInput, slsaList []*model.SLSAInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestSLSAs")
+	return []string{}, fmt.Errorf("%w: IngestSLSAs", backends.ErrNotImplemented)
 }
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -32,7 +33,7 @@ const (
 )
 
 func (c *neo4jClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSourceAtList")
+	return nil, fmt.Errorf("%w: HasSourceAtList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
@@ -163,9 +164,9 @@ func setHasSourceAtValues(sb *strings.Builder, hasSourceAtSpec *model.HasSourceA
 }
 
 func (c *neo4jClient) IngestHasSourceAt(ctx context.Context, pkg model.IDorPkgInput, pkgMatchType model.MatchFlags, source model.IDorSourceInput, hasSourceAt model.HasSourceAtInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestHasSourceAt"))
+	panic(fmt.Errorf("%w: IngestHasSourceAt", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestHasSourceAts(ctx context.Context, pkgs []*model.IDorPkgInput, pkgMatchType *model.MatchFlags, sources []*model.IDorSourceInput, hasSourceAts []*model.HasSourceAtInputSpec) ([]string, error) {
-	panic(fmt.Errorf("not implemented: IngestHasSourceAts"))
+	panic(fmt.Errorf("%w: IngestHasSourceAts", backends.ErrNotImplemented))
 }
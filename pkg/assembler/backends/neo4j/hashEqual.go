@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -27,7 +28,7 @@ import (
 )
 
 func (c *neo4jClient) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
-	return nil, fmt.Errorf("not implemented: HashEqualList")
+	return nil, fmt.Errorf("%w: HashEqualList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
@@ -143,9 +144,9 @@ func setHashEqualValues(sb *strings.Builder, hashEqualSpec *model.HashEqualSpec,
 }
 
 func (c *neo4jClient) IngestHashEqual(ctx context.Context, artifact model.IDorArtifactInput, equalArtifact model.IDorArtifactInput, hashEqual model.HashEqualInputSpec) (string, error) {
-	panic(fmt.Errorf("not implemented: IngestHashEqual - IngestHashEqual"))
+	panic(fmt.Errorf("%w: IngestHashEqual - IngestHashEqual", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) IngestHashEquals(ctx context.Context, artifacts []*model.IDorArtifactInput, otherArtifacts []*model.IDorArtifactInput, hashEquals []*model.HashEqualInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestHashEquals")
+	return []string{}, fmt.Errorf("%w: IngestHashEquals", backends.ErrNotImplemented)
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -34,7 +35,7 @@ const (
 // Query IsDependency
 
 func (c *neo4jClient) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
-	return nil, fmt.Errorf("not implemented: IsDependencyList")
+	return nil, fmt.Errorf("%w: IsDependencyList", backends.ErrNotImplemented)
 }
 
 // note this has not been optimized to remove pkgVersion -> pkgName
@@ -158,7 +159,7 @@ func setIsDependencyValues(sb *strings.Builder, isDependencySpec *model.IsDepend
 // Ingest IngestDependencies
 
 func (c *neo4jClient) IngestDependencies(ctx context.Context, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, dependencies []*model.IsDependencyInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestDependencies")
+	return []string{}, fmt.Errorf("%w: IngestDependencies", backends.ErrNotImplemented)
 }
 
 // Ingest IsDependency
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -30,7 +31,7 @@ import (
 // Query IsOccurrence
 
 func (c *neo4jClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
-	return nil, fmt.Errorf("not implemented: IsOccurrenceList")
+	return nil, fmt.Errorf("%w: IsOccurrenceList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
@@ -206,7 +207,7 @@ func generateModelIsOccurrence(subject model.PackageOrSource, artifact *model.Ar
 // Ingest IngestOccurrences
 
 func (c *neo4jClient) IngestOccurrences(ctx context.Context, subjects model.PackageOrSourceInputs, artifacts []*model.IDorArtifactInput, occurrences []*model.IsOccurrenceInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented: IngestOccurrences")
+	return []string{}, fmt.Errorf("%w: IngestOccurrences", backends.ErrNotImplemented)
 }
 
 // Ingest IngestOccurrence
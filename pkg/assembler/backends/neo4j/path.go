@@ -19,29 +19,46 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
-	panic(fmt.Errorf("not implemented: Path - path"))
+	panic(fmt.Errorf("%w: Path - path", backends.ErrNotImplemented))
+}
+
+func (c *neo4jClient) AllPaths(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error) {
+	return nil, fmt.Errorf("%w: AllPaths", backends.ErrNotImplemented)
+}
+
+func (c *neo4jClient) ConstrainedPath(ctx context.Context, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error) {
+	return nil, fmt.Errorf("%w: ConstrainedPath", backends.ErrNotImplemented)
+}
+
+func (c *neo4jClient) DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	return nil, fmt.Errorf("%w: DependencyClosure", backends.ErrNotImplemented)
+}
+
+func (c *neo4jClient) DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	return nil, fmt.Errorf("%w: DependentClosure", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) Delete(ctx context.Context, node string) (bool, error) {
-	panic(fmt.Errorf("not implemented: Delete"))
+	panic(fmt.Errorf("%w: Delete", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) NeighborsList(ctx context.Context, node string, usingOnly []model.Edge, after *string, first *int) (*model.NeighborConnection, error) {
-	return nil, fmt.Errorf("not implemented: NeighborsList")
+	return nil, fmt.Errorf("%w: NeighborsList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
-	panic(fmt.Errorf("not implemented: Neighbors - neighbors"))
+	panic(fmt.Errorf("%w: Neighbors - neighbors", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) Node(ctx context.Context, node string) (model.Node, error) {
-	panic(fmt.Errorf("not implemented: Node - node"))
+	panic(fmt.Errorf("%w: Node - node", backends.ErrNotImplemented))
 }
 
 func (c *neo4jClient) Nodes(ctx context.Context, nodes []string) ([]model.Node, error) {
-	panic(fmt.Errorf("not implemented: Nodes - nodes"))
+	panic(fmt.Errorf("%w: Nodes - nodes", backends.ErrNotImplemented))
 }
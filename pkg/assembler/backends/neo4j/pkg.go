@@ -21,13 +21,14 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
 
 func (c *neo4jClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
-	return nil, fmt.Errorf("not implemented: PackagesList")
+	return nil, fmt.Errorf("%w: PackagesList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
@@ -361,7 +362,7 @@ func removeInvalidCharFromProperty(key string) string {
 }
 
 func (c *neo4jClient) IngestPackages(ctx context.Context, pkgs []*model.IDorPkgInput) ([]*model.PackageIDs, error) {
-	return []*model.PackageIDs{}, fmt.Errorf("not implemented: IngestPackages")
+	return []*model.PackageIDs{}, fmt.Errorf("%w: IngestPackages", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestPackage(ctx context.Context, pkg model.IDorPkgInput) (*model.PackageIDs, error) {
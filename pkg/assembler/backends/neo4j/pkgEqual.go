@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -30,7 +31,7 @@ import (
 // Query PkgEqual
 
 func (c *neo4jClient) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
-	return nil, fmt.Errorf("not implemented: PkgEqualList")
+	return nil, fmt.Errorf("%w: PkgEqualList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
@@ -263,5 +264,5 @@ func (c *neo4jClient) IngestPkgEqual(ctx context.Context, pkg model.IDorPkgInput
 }
 
 func (c *neo4jClient) IngestPkgEquals(ctx context.Context, pkgs []*model.IDorPkgInput, otherPackages []*model.IDorPkgInput, pkgEquals []*model.PkgEqualInputSpec) ([]string, error) {
-	return nil, fmt.Errorf("not implemented - IngestPkgEquals")
+	return nil, fmt.Errorf("%w - IngestPkgEquals", backends.ErrNotImplemented)
 }
@@ -19,37 +19,38 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) FindSoftware(ctx context.Context, searchText string) ([]model.PackageSourceOrArtifact, error) {
-	return []model.PackageSourceOrArtifact{}, fmt.Errorf("not implemented: FindSoftware")
+	return []model.PackageSourceOrArtifact{}, fmt.Errorf("%w: FindSoftware", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) FindSoftwareList(ctx context.Context, searchText string, after *string, first *int) (*model.FindSoftwareConnection, error) {
-	return nil, fmt.Errorf("not implemented: FindSoftwareList")
+	return nil, fmt.Errorf("%w: FindSoftwareList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []string, after *string, first *int) (*model.PackageConnection, error) {
-	return nil, fmt.Errorf("not implemented: QueryPackagesListForScan")
+	return nil, fmt.Errorf("%w: QueryPackagesListForScan", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error) {
-	return nil, fmt.Errorf("not implemented: FindPackagesThatNeedScanning")
+	return nil, fmt.Errorf("%w: FindPackagesThatNeedScanning", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryPkgIDCertifyVuln")
+	return nil, fmt.Errorf("%w: BatchQueryPkgIDCertifyVuln", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []string) ([]*model.CertifyLegal, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryPkgIDCertifyLegal")
+	return nil, fmt.Errorf("%w: BatchQueryPkgIDCertifyLegal", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) BatchQuerySubjectPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
-	return nil, fmt.Errorf("not implemented: BatchQuerySubjectPkgDependency")
+	return nil, fmt.Errorf("%w: BatchQuerySubjectPkgDependency", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) BatchQueryDepPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryDepPkgDependency")
+	return nil, fmt.Errorf("%w: BatchQueryDepPkgDependency", backends.ErrNotImplemented)
 }
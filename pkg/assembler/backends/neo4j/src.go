@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -27,7 +28,7 @@ import (
 )
 
 func (c *neo4jClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
-	return nil, fmt.Errorf("not implemented: SourcesList")
+	return nil, fmt.Errorf("%w: SourcesList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
@@ -246,7 +247,7 @@ func (c *neo4jClient) sourcesNamespace(ctx context.Context, sourceSpec *model.So
 }
 
 func (c *neo4jClient) IngestSources(ctx context.Context, sources []*model.IDorSourceInput) ([]*model.SourceIDs, error) {
-	return []*model.SourceIDs{}, fmt.Errorf("not implemented: IngestSources")
+	return []*model.SourceIDs{}, fmt.Errorf("%w: IngestSources", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestSource(ctx context.Context, source model.IDorSourceInput) (*model.SourceIDs, error) {
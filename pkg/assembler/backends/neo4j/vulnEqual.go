@@ -19,11 +19,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
-	return nil, fmt.Errorf("not implemented: VulnEqualList")
+	return nil, fmt.Errorf("%w: VulnEqualList", backends.ErrNotImplemented)
 }
 
 // TODO (pxp928): fix for new vulnerability
@@ -164,7 +165,7 @@ func (c *neo4jClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEq
 	// 	aggregateIsVulnerability = append(aggregateIsVulnerability, result.([]*model.IsVulnerability)...)
 	// }
 	// return aggregateIsVulnerability, nil
-	return []*model.VulnEqual{}, fmt.Errorf("not implemented - VulnEqual")
+	return []*model.VulnEqual{}, fmt.Errorf("%w - VulnEqual", backends.ErrNotImplemented)
 }
 
 // func setIsVulnerabilityValues(sb *strings.Builder, isVulnerabilitySpec *model.IsVulnerabilitySpec, firstMatch *bool, queryValues map[string]any) {
@@ -197,9 +198,9 @@ func (c *neo4jClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEq
 // }
 
 func (c *neo4jClient) IngestVulnEqual(ctx context.Context, vulnerability model.IDorVulnerabilityInput, otherVulnerability model.IDorVulnerabilityInput, vulnEqual model.VulnEqualInputSpec) (string, error) {
-	return "", fmt.Errorf("not implemented - IngestVulnEqual")
+	return "", fmt.Errorf("%w - IngestVulnEqual", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestVulnEquals(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, otherVulnerabilities []*model.IDorVulnerabilityInput, vulnEquals []*model.VulnEqualInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented - IngestVulnEquals")
+	return []string{}, fmt.Errorf("%w - IngestVulnEquals", backends.ErrNotImplemented)
 }
@@ -19,21 +19,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
-	return nil, fmt.Errorf("not implemented: VulnerabilityMetadataList")
+	return nil, fmt.Errorf("%w: VulnerabilityMetadataList", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestVulnerabilityMetadata(ctx context.Context, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) (string, error) {
-	return "", fmt.Errorf("not implemented - IngestVulnerabilityMetadata")
+	return "", fmt.Errorf("%w - IngestVulnerabilityMetadata", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error) {
-	return []string{}, fmt.Errorf("not implemented - IngestBulkVulnerabilityMetadata")
+	return []string{}, fmt.Errorf("%w - IngestBulkVulnerabilityMetadata", backends.ErrNotImplemented)
 }
 
 func (c *neo4jClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
-	return []*model.VulnerabilityMetadata{}, fmt.Errorf("not implemented - VulnerabilityMetadata")
+	return []*model.VulnerabilityMetadata{}, fmt.Errorf("%w - VulnerabilityMetadata", backends.ErrNotImplemented)
 }
@@ -19,11 +19,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
 func (c *neo4jClient) VulnerabilityList(ctx context.Context, vulnSpec model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
-	return nil, fmt.Errorf("not implemented: VulnerabilityList")
+	return nil, fmt.Errorf("%w: VulnerabilityList", backends.ErrNotImplemented)
 }
 
 // TODO (pxp928): fix for new vulnerability
@@ -90,7 +91,7 @@ func (c *neo4jClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vulne
 	// 	return nil, err
 	// }
 	//return result.(*model.Cve), nil
-	return []*model.Vulnerability{}, fmt.Errorf("not implemented - Vulnerabilities")
+	return []*model.Vulnerability{}, fmt.Errorf("%w - Vulnerabilities", backends.ErrNotImplemented)
 }
 
 // func (c *neo4jClient) cveYear(ctx context.Context, cveSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
@@ -155,7 +156,7 @@ func (c *neo4jClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vulne
 // }
 
 func (c *neo4jClient) IngestVulnerabilities(ctx context.Context, vulns []*model.IDorVulnerabilityInput) ([]*model.VulnerabilityIDs, error) {
-	return []*model.VulnerabilityIDs{}, fmt.Errorf("not implemented: IngestVulnerabilities")
+	return []*model.VulnerabilityIDs{}, fmt.Errorf("%w: IngestVulnerabilities", backends.ErrNotImplemented)
 }
 
 // TODO (pxp928): fix for new vulnerability
@@ -195,7 +196,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, vuln model.IDorVu
 	// 	}
 
 	// return result.(*model.Cve), nil
-	return nil, fmt.Errorf("not implemented: IngestVulnerabilities")
+	return nil, fmt.Errorf("%w: IngestVulnerabilities", backends.ErrNotImplemented)
 }
 
 // // TODO: update to pass in the ID from neo4j
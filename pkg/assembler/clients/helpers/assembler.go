@@ -240,6 +240,20 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 					return err
 				}
 			}
+
+			logger.Infof("assembling CertifyPolicy : %v", len(p.CertifyPolicy))
+			for _, cp := range p.CertifyPolicy {
+				if err := ingestCertifyPolicy(ctx, gqlclient, cp, collectedIDorArtInputs); err != nil {
+					return err
+				}
+			}
+
+			logger.Infof("assembling VulnerabilityRange : %v", len(p.VulnerabilityRange))
+			for _, vr := range p.VulnerabilityRange {
+				if err := ingestVulnerabilityRange(ctx, gqlclient, vr, collectedIDorPkgInputs, collectedIDorVulnInputs); err != nil {
+					return err
+				}
+			}
 		}
 		return nil
 	}
@@ -729,6 +743,43 @@ func ingestHashEqual(ctx context.Context, client graphql.Client, he assembler.Ha
 	return err
 }
 
+func ingestCertifyPolicy(ctx context.Context, client graphql.Client, cp assembler.CertifyPolicyIngest, artInputMap map[string]*model.IDorArtifactInput) error {
+	if cp.Artifact == nil {
+		return fmt.Errorf("unable to create CertifyPolicy without artifact")
+	}
+
+	artID, found := artInputMap[helpers.GetKey[*model.ArtifactInputSpec, string](cp.Artifact, helpers.ArtifactClientKey)]
+	if !found {
+		return fmt.Errorf("failed to find ingested artifact ID for CertifyPolicy: %s", helpers.GetKey[*model.ArtifactInputSpec, string](cp.Artifact, helpers.ArtifactClientKey))
+	}
+
+	_, err := model.IngestCertifyPolicyArtifact(ctx, client, *artID, *cp.CertifyPolicy)
+	return err
+}
+
+func ingestVulnerabilityRange(ctx context.Context, client graphql.Client, vr assembler.VulnerabilityRangeIngest, packageInputMap map[string]*model.IDorPkgInput,
+	vulnInputMap map[string]*model.IDorVulnerabilityInput) error {
+
+	if vr.Pkg == nil {
+		return fmt.Errorf("unable to create VulnerabilityRange without package")
+	}
+	if vr.Vulnerability == nil {
+		return fmt.Errorf("unable to create VulnerabilityRange without vulnerability")
+	}
+
+	pkgID, found := packageInputMap[helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](vr.Pkg, helpers.PkgClientKey).VersionId]
+	if !found {
+		return fmt.Errorf("failed to find ingested package ID for vulnerabilityRange: %s", helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](vr.Pkg, helpers.PkgClientKey).VersionId)
+	}
+	vulnID, found := vulnInputMap[helpers.GetKey[*model.VulnerabilityInputSpec, helpers.VulnIds](vr.Vulnerability, helpers.VulnClientKey).VulnerabilityID]
+	if !found {
+		return fmt.Errorf("failed to find ingested vulnerability ID for vulnerabilityRange: %s", helpers.GetKey[*model.VulnerabilityInputSpec, helpers.VulnIds](vr.Vulnerability, helpers.VulnClientKey).VulnerabilityID)
+	}
+
+	_, err := model.IngestVulnerabilityRange(ctx, client, *pkgID, *vulnID, *vr.VulnerabilityRange)
+	return err
+}
+
 func ingestCertifyLegal(ctx context.Context, client graphql.Client, cl assembler.CertifyLegalIngest, packageInputMap map[string]*model.IDorPkgInput,
 	sourceInputMap map[string]*model.IDorSourceInput, licenseInputMap map[string]*model.IDorLicenseInput) error {
 
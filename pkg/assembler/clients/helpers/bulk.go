@@ -230,6 +230,18 @@ func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient
 				logger.Errorf("ingestCertifyLegals failed with error: %v", err)
 				rvErr = err
 			}
+
+			logger.Infof("assembling CertifyPolicy : %v", len(p.CertifyPolicy))
+			if err := ingestCertifyPolicies(ctx, gqlclient, p.CertifyPolicy, collectedIDorArtInputs); err != nil {
+				logger.Errorf("ingestCertifyPolicies failed with error: %v", err)
+				rvErr = err
+			}
+
+			logger.Infof("assembling VulnerabilityRange : %v", len(p.VulnerabilityRange))
+			if err := ingestVulnerabilityRanges(ctx, gqlclient, p.VulnerabilityRange, collectedIDorPkgInputs, collectedIDorVulnInputs); err != nil {
+				logger.Errorf("ingestVulnerabilityRanges failed with error: %v", err)
+				rvErr = err
+			}
 		}
 		return ingestedIDs, rvErr
 	}
@@ -716,6 +728,53 @@ func ingestHashEquals(ctx context.Context, client graphql.Client, he []assembler
 	return nil
 }
 
+func ingestCertifyPolicies(ctx context.Context, client graphql.Client, cps []assembler.CertifyPolicyIngest, artInputMap map[string]*model.IDorArtifactInput) error {
+	var artIDs []model.IDorArtifactInput
+	var certifyPolicies []model.CertifyPolicyInputSpec
+	for _, ingest := range cps {
+		if artID, found := artInputMap[helpers.GetKey[*model.ArtifactInputSpec, string](ingest.Artifact, helpers.ArtifactClientKey)]; found {
+			artIDs = append(artIDs, *artID)
+		} else {
+			return fmt.Errorf("failed to find ingested artifact ID for certifyPolicy: %s", helpers.GetKey[*model.ArtifactInputSpec, string](ingest.Artifact, helpers.ArtifactClientKey))
+		}
+		certifyPolicies = append(certifyPolicies, *ingest.CertifyPolicy)
+	}
+	if len(cps) > 0 {
+		_, err := model.IngestCertifyPolicyArtifacts(ctx, client, artIDs, certifyPolicies)
+		if err != nil {
+			return fmt.Errorf("CertifyPolicies failed with error: %w", err)
+		}
+	}
+	return nil
+}
+
+func ingestVulnerabilityRanges(ctx context.Context, client graphql.Client, vrs []assembler.VulnerabilityRangeIngest, packageInputMap map[string]*model.IDorPkgInput,
+	vulnInputMap map[string]*model.IDorVulnerabilityInput) error {
+	var pkgIDs []model.IDorPkgInput
+	var vulnIDs []model.IDorVulnerabilityInput
+	var vulnerabilityRanges []model.VulnerabilityRangeInputSpec
+	for _, ingest := range vrs {
+		if pkgID, found := packageInputMap[helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](ingest.Pkg, helpers.PkgClientKey).VersionId]; found {
+			pkgIDs = append(pkgIDs, *pkgID)
+		} else {
+			return fmt.Errorf("failed to find ingested package ID for vulnerabilityRange: %s", helpers.GetKey[*model.PkgInputSpec, helpers.PkgIds](ingest.Pkg, helpers.PkgClientKey).VersionId)
+		}
+		if vulnID, found := vulnInputMap[helpers.GetKey[*model.VulnerabilityInputSpec, helpers.VulnIds](ingest.Vulnerability, helpers.VulnClientKey).VulnerabilityID]; found {
+			vulnIDs = append(vulnIDs, *vulnID)
+		} else {
+			return fmt.Errorf("failed to find ingested vulnerability ID for vulnerabilityRange: %s", helpers.GetKey[*model.VulnerabilityInputSpec, helpers.VulnIds](ingest.Vulnerability, helpers.VulnClientKey).VulnerabilityID)
+		}
+		vulnerabilityRanges = append(vulnerabilityRanges, *ingest.VulnerabilityRange)
+	}
+	if len(vrs) > 0 {
+		_, err := model.IngestVulnerabilityRanges(ctx, client, pkgIDs, vulnIDs, vulnerabilityRanges)
+		if err != nil {
+			return fmt.Errorf("VulnerabilityRanges failed with error: %w", err)
+		}
+	}
+	return nil
+}
+
 func ingestHasSBOMs(ctx context.Context, client graphql.Client, hs []assembler.HasSBOMIngest, includes model.HasSBOMIncludesInputSpec, packageInputMap map[string]*model.IDorPkgInput,
 	artInputMap map[string]*model.IDorArtifactInput, ingestedIDs *AssemblerIngestedIDs) error {
 
@@ -0,0 +1,112 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// publishingBackend publishes an event on the bus after each successful
+// ingestion of the predicates that can be subscribed to. All other calls go
+// straight to the wrapped backend.
+type publishingBackend struct {
+	backends.Backend
+	bus *Bus
+}
+
+// Publishing wraps a backend so that it publishes ingestion events to bus.
+func Publishing(b backends.Backend, bus *Bus) backends.Backend {
+	return &publishingBackend{Backend: b, bus: bus}
+}
+
+func (p *publishingBackend) publish(ctx context.Context, kind Kind, ids ...string) {
+	t := tenant.FromContext(ctx)
+	for _, id := range ids {
+		// backends are allowed to return empty IDs
+		if id == "" {
+			continue
+		}
+		p.bus.Publish(Event{Kind: kind, ID: id, Tenant: t})
+	}
+}
+
+func (p *publishingBackend) IngestCertifyVuln(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) (string, error) {
+	id, err := p.Backend.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	if err == nil {
+		p.publish(ctx, CertifyVulnIngested, id)
+	}
+	return id, err
+}
+
+func (p *publishingBackend) IngestCertifyVulns(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) ([]string, error) {
+	ids, err := p.Backend.IngestCertifyVulns(ctx, pkgs, vulnerabilities, certifyVulns)
+	if err == nil {
+		p.publish(ctx, CertifyVulnIngested, ids...)
+	}
+	return ids, err
+}
+
+func (p *publishingBackend) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.IDorVulnerabilityInput, vexStatement model.VexStatementInputSpec) (string, error) {
+	id, err := p.Backend.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	if err == nil {
+		p.publish(ctx, VEXStatementIngested, id)
+	}
+	return id, err
+}
+
+func (p *publishingBackend) IngestVEXStatements(ctx context.Context, subjects model.PackageOrArtifactInputs, vulnerabilities []*model.IDorVulnerabilityInput, vexStatements []*model.VexStatementInputSpec) ([]string, error) {
+	ids, err := p.Backend.IngestVEXStatements(ctx, subjects, vulnerabilities, vexStatements)
+	if err == nil {
+		p.publish(ctx, VEXStatementIngested, ids...)
+	}
+	return ids, err
+}
+
+func (p *publishingBackend) IngestHasSbom(ctx context.Context, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) (string, error) {
+	id, err := p.Backend.IngestHasSbom(ctx, subject, hasSbom, includes)
+	if err == nil {
+		p.publish(ctx, HasSBOMIngested, id)
+	}
+	return id, err
+}
+
+func (p *publishingBackend) IngestHasSBOMs(ctx context.Context, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) ([]string, error) {
+	ids, err := p.Backend.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	if err == nil {
+		p.publish(ctx, HasSBOMIngested, ids...)
+	}
+	return ids, err
+}
+
+func (p *publishingBackend) IngestCertifyBad(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) (string, error) {
+	id, err := p.Backend.IngestCertifyBad(ctx, subject, pkgMatchType, certifyBad)
+	if err == nil {
+		p.publish(ctx, CertifyBadIngested, id)
+	}
+	return id, err
+}
+
+func (p *publishingBackend) IngestCertifyBads(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType *model.MatchFlags, certifyBads []*model.CertifyBadInputSpec) ([]string, error) {
+	ids, err := p.Backend.IngestCertifyBads(ctx, subjects, pkgMatchType, certifyBads)
+	if err == nil {
+		p.publish(ctx, CertifyBadIngested, ids...)
+	}
+	return ids, err
+}
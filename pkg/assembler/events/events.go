@@ -0,0 +1,128 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events is an in-process bus that announces the predicates a
+// backend has ingested. It backs the GraphQL subscriptions and any other
+// consumer that wants to react to new data without polling.
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Kind identifies the type of predicate an Event refers to.
+type Kind string
+
+const (
+	CertifyVulnIngested  Kind = "CertifyVulnIngested"
+	VEXStatementIngested Kind = "VEXStatementIngested"
+	HasSBOMIngested      Kind = "HasSBOMIngested"
+	CertifyBadIngested   Kind = "CertifyBadIngested"
+)
+
+// subscriberBuffer is the number of events queued for a subscriber before
+// new events are dropped for it.
+const subscriberBuffer = 256
+
+// Event announces that a predicate was ingested successfully.
+type Event struct {
+	Kind Kind
+	// ID is the global ID returned by the backend for the predicate.
+	ID string
+	// Tenant is the tenant that ingested the predicate, empty if global.
+	Tenant string
+}
+
+type subscriber struct {
+	ctx   context.Context
+	kinds map[Kind]bool
+	ch    chan Event
+	// handle, if set, is called with each event instead of sending it on
+	// ch.
+	handle func(Event)
+}
+
+// Bus fans out published events to all matching subscribers. Publishing
+// never blocks on channel subscribers: those that do not keep up lose events.
+// Handlers are called by the publisher and never lose events.
+type Bus struct {
+	mu   sync.RWMutex
+	subs map[*subscriber]struct{}
+}
+
+// NewBus returns an empty event bus.
+func NewBus() *Bus {
+	return &Bus{subs: map[*subscriber]struct{}{}}
+}
+
+// Subscribe returns a channel receiving the events of the given kinds, or of
+// all kinds if none is given. The channel is closed once ctx is done.
+func (b *Bus) Subscribe(ctx context.Context, kinds ...Kind) <-chan Event {
+	s := &subscriber{ch: make(chan Event, subscriberBuffer)}
+	b.add(ctx, s, kinds)
+	return s.ch
+}
+
+// Handle calls handle with each event of the given kinds, or of all kinds if
+// none is given, until ctx is done. It is called synchronously by Publish, so
+// no event is dropped but a slow handler slows down the publishers.
+func (b *Bus) Handle(ctx context.Context, handle func(Event), kinds ...Kind) {
+	b.add(ctx, &subscriber{handle: handle}, kinds)
+}
+
+func (b *Bus) add(ctx context.Context, s *subscriber, kinds []Kind) {
+	s.ctx = ctx
+	s.kinds = map[Kind]bool{}
+	for _, k := range kinds {
+		s.kinds[k] = true
+	}
+
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, s)
+		if s.ch != nil {
+			close(s.ch)
+		}
+		b.mu.Unlock()
+	}()
+}
+
+// Publish delivers e to every subscriber interested in its kind.
+func (b *Bus) Publish(e Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for s := range b.subs {
+		if len(s.kinds) > 0 && !s.kinds[e.Kind] {
+			continue
+		}
+		if s.handle != nil {
+			s.handle(e)
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			logging.FromContext(s.ctx).Warnf("event subscriber is full, dropping %s event for %s", e.Kind, e.ID)
+		}
+	}
+}
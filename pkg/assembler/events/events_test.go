@@ -0,0 +1,169 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/tenant"
+	"go.uber.org/mock/gomock"
+)
+
+func receive(t *testing.T, ch <-chan events.Event) events.Event {
+	t.Helper()
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return events.Event{}
+}
+
+func expectNone(t *testing.T, ch <-chan events.Event) {
+	t.Helper()
+	select {
+	case e, ok := <-ch:
+		if ok {
+			t.Fatalf("unexpected event: %+v", e)
+		}
+	default:
+	}
+}
+
+func TestBus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := events.NewBus()
+
+	all := bus.Subscribe(ctx)
+	vulns := bus.Subscribe(ctx, events.CertifyVulnIngested)
+
+	vuln := events.Event{Kind: events.CertifyVulnIngested, ID: "1"}
+	sbom := events.Event{Kind: events.HasSBOMIngested, ID: "2"}
+	bus.Publish(vuln)
+	bus.Publish(sbom)
+
+	if diff := cmp.Diff(vuln, receive(t, all)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(sbom, receive(t, all)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(vuln, receive(t, vulns)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+	expectNone(t, vulns)
+
+	cancel()
+	select {
+	case _, ok := <-all:
+		if ok {
+			t.Errorf("expected channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed after cancel")
+	}
+	// publishing after all subscribers are gone must not block or panic
+	bus.Publish(vuln)
+}
+
+func TestHandle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := events.NewBus()
+
+	var got []string
+	bus.Handle(ctx, func(e events.Event) {
+		got = append(got, e.ID)
+	}, events.CertifyVulnIngested)
+
+	// more events than a channel subscriber buffers are all handled
+	var want []string
+	for i := 0; i < 1000; i++ {
+		id := fmt.Sprint(i)
+		want = append(want, id)
+		bus.Publish(events.Event{Kind: events.CertifyVulnIngested, ID: id})
+		bus.Publish(events.Event{Kind: events.HasSBOMIngested, ID: id})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected handled events (-want +got):\n%s", diff)
+	}
+
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for {
+		got = nil
+		bus.Publish(events.Event{Kind: events.CertifyVulnIngested, ID: "late"})
+		if len(got) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("handler still called after cancel")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPublishing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := tenant.WithTenant(context.Background(), "team-a")
+	bus := events.NewBus()
+	sub := bus.Subscribe(ctx)
+
+	b := mocks.NewMockBackend(ctrl)
+	p := events.Publishing(b, bus)
+
+	b.EXPECT().IngestCertifyVuln(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return("cv1", nil)
+	if _, err := p.IngestCertifyVuln(ctx, model.IDorPkgInput{}, model.IDorVulnerabilityInput{}, model.ScanMetadataInput{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := events.Event{Kind: events.CertifyVulnIngested, ID: "cv1", Tenant: "team-a"}
+	if diff := cmp.Diff(want, receive(t, sub)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+
+	// empty IDs are skipped
+	b.EXPECT().IngestVEXStatements(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"", "vex1"}, nil)
+	if _, err := p.IngestVEXStatements(ctx, model.PackageOrArtifactInputs{}, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = events.Event{Kind: events.VEXStatementIngested, ID: "vex1", Tenant: "team-a"}
+	if diff := cmp.Diff(want, receive(t, sub)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+
+	// failed ingestions are not published
+	b.EXPECT().IngestHasSbom(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("boom"))
+	if _, err := p.IngestHasSbom(ctx, model.PackageOrArtifactInput{}, model.HasSBOMInputSpec{}, model.HasSBOMIncludesInputSpec{}); err == nil {
+		t.Fatalf("expected error")
+	}
+	expectNone(t, sub)
+
+	// other calls go to the wrapped backend untouched
+	b.EXPECT().Packages(ctx, gomock.Any()).Return(nil, nil)
+	if _, err := p.Packages(ctx, &model.PkgSpec{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectNone(t, sub)
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// PreviousVEXStatement returns the latest statement known before vex for the
+// same subject and vulnerability, or nil if vex is the first one. A VEX
+// statement changes the status of the subject when there is no previous
+// statement or when the previous one has a different status.
+func PreviousVEXStatement(ctx context.Context, b backends.Backend, vex *model.CertifyVEXStatement) (*model.CertifyVEXStatement, error) {
+	statements, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{
+		Subject:       subjectSpecByID(vex.Subject),
+		Vulnerability: &model.VulnerabilitySpec{ID: vulnerabilityID(vex.Vulnerability)},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var latest *model.CertifyVEXStatement
+	for _, s := range statements {
+		if s.ID == vex.ID || s.KnownSince.After(vex.KnownSince) {
+			continue
+		}
+		if latest == nil || s.KnownSince.After(latest.KnownSince) {
+			latest = s
+		}
+	}
+	return latest, nil
+}
+
+// subjectSpecByID returns a spec selecting exactly the given subject.
+func subjectSpecByID(subject model.PackageOrArtifact) *model.PackageOrArtifactSpec {
+	switch s := subject.(type) {
+	case *model.Package:
+		id := s.ID
+		for _, ns := range s.Namespaces {
+			for _, n := range ns.Names {
+				id = n.ID
+				for _, v := range n.Versions {
+					id = v.ID
+				}
+			}
+		}
+		return &model.PackageOrArtifactSpec{Package: &model.PkgSpec{ID: &id}}
+	case *model.Artifact:
+		return &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{ID: &s.ID}}
+	}
+	return nil
+}
+
+// vulnerabilityID returns the ID to use in a VulnerabilitySpec selecting v.
+func vulnerabilityID(v *model.Vulnerability) *string {
+	if v == nil {
+		return nil
+	}
+	if len(v.VulnerabilityIDs) > 0 {
+		return &v.VulnerabilityIDs[0].ID
+	}
+	return &v.ID
+}
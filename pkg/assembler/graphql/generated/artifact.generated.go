@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -29,6 +30,8 @@ type MutationResolver interface {
 	IngestCertifyGoods(ctx context.Context, subjects model.PackageSourceOrArtifactInputs, pkgMatchType model.MatchFlags, certifyGoods []*model.CertifyGoodInputSpec) ([]string, error)
 	IngestCertifyLegal(ctx context.Context, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal model.CertifyLegalInputSpec) (string, error)
 	IngestCertifyLegals(ctx context.Context, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) ([]string, error)
+	IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error)
+	IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error)
 	IngestScorecard(ctx context.Context, source model.IDorSourceInput, scorecard model.ScorecardInputSpec) (string, error)
 	IngestScorecards(ctx context.Context, sources []*model.IDorSourceInput, scorecards []*model.ScorecardInputSpec) ([]string, error)
 	IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.IDorVulnerabilityInput, vexStatement model.VexStatementInputSpec) (string, error)
@@ -66,6 +69,8 @@ type MutationResolver interface {
 	IngestBulkVulnerabilityMetadata(ctx context.Context, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityMetadataList []*model.VulnerabilityMetadataInputSpec) ([]string, error)
 	IngestVulnerability(ctx context.Context, vuln model.IDorVulnerabilityInput) (*model.VulnerabilityIDs, error)
 	IngestVulnerabilities(ctx context.Context, vulns []*model.IDorVulnerabilityInput) ([]*model.VulnerabilityIDs, error)
+	IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error)
+	IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error)
 }
 type QueryResolver interface {
 	Artifacts(ctx context.Context, artifactSpec model.ArtifactSpec) ([]*model.Artifact, error)
@@ -76,20 +81,25 @@ type QueryResolver interface {
 	CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error)
 	CertifyGood(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec) ([]*model.CertifyGood, error)
 	CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error)
-	CertifyLegal(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec) ([]*model.CertifyLegal, error)
-	CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error)
+	CertifyLegal(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error)
+	CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error)
 	BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []string) ([]*model.CertifyLegal, error)
-	Scorecards(ctx context.Context, scorecardSpec model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error)
-	ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error)
-	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error)
-	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error)
-	CertifyVuln(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) ([]*model.CertifyVuln, error)
-	CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error)
+	CertifyPolicy(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec) ([]*model.CertifyPolicy, error)
+	CertifyPolicyList(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error)
+	Scorecards(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error)
+	ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error)
+	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error)
+	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error)
+	CertifyVuln(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error)
+	CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error)
 	BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error)
+	DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error)
+	DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error)
 	PointOfContact(ctx context.Context, pointOfContactSpec model.PointOfContactSpec) ([]*model.PointOfContact, error)
 	PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error)
-	HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSpec) ([]*model.HasSbom, error)
-	HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error)
+	FixRecommendation(ctx context.Context, pkg model.PkgSpec) (*model.FixRecommendation, error)
+	HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error)
+	HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error)
 	HasSlsa(ctx context.Context, hasSLSASpec model.HasSLSASpec) ([]*model.HasSlsa, error)
 	HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error)
 	HasSourceAt(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec) ([]*model.HasSourceAt, error)
@@ -109,6 +119,8 @@ type QueryResolver interface {
 	Packages(ctx context.Context, pkgSpec model.PkgSpec) ([]*model.Package, error)
 	PackagesList(ctx context.Context, pkgSpec model.PkgSpec, after *string, first *int) (*model.PackageConnection, error)
 	Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error)
+	AllPaths(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error)
+	ConstrainedPath(ctx context.Context, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error)
 	Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error)
 	NeighborsList(ctx context.Context, node string, usingOnly []model.Edge, after *string, first *int) (*model.NeighborConnection, error)
 	Node(ctx context.Context, node string) (model.Node, error)
@@ -121,12 +133,16 @@ type QueryResolver interface {
 	FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error)
 	Sources(ctx context.Context, sourceSpec model.SourceSpec) ([]*model.Source, error)
 	SourcesList(ctx context.Context, sourceSpec model.SourceSpec, after *string, first *int) (*model.SourceConnection, error)
+	CallerTenant(ctx context.Context) (string, error)
 	VulnEqual(ctx context.Context, vulnEqualSpec model.VulnEqualSpec) ([]*model.VulnEqual, error)
 	VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error)
 	VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error)
 	VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error)
 	Vulnerabilities(ctx context.Context, vulnSpec model.VulnerabilitySpec) ([]*model.Vulnerability, error)
 	VulnerabilityList(ctx context.Context, vulnSpec model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error)
+	VulnerabilityRange(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error)
+	VulnerabilityRangeList(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error)
+	VulnerabilityRangeMatches(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRangeMatch, error)
 }
 
 // endregion ************************** generated!.gotpl **************************
@@ -1008,6 +1024,124 @@ func (ec *executionContext) field_Mutation_ingestCertifyLegals_argsCertifyLegals
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_ingestCertifyPolicies_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_ingestCertifyPolicies_argsSubjects(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["subjects"] = arg0
+	arg1, err := ec.field_Mutation_ingestCertifyPolicies_argsCertifyPolicies(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["certifyPolicies"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_ingestCertifyPolicies_argsSubjects(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.IDorArtifactInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["subjects"]
+	if !ok {
+		var zeroVal []*model.IDorArtifactInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subjects"))
+	if tmp, ok := rawArgs["subjects"]; ok {
+		return ec.unmarshalNIDorArtifactInput2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐIDorArtifactInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.IDorArtifactInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestCertifyPolicies_argsCertifyPolicies(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.CertifyPolicyInputSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["certifyPolicies"]
+	if !ok {
+		var zeroVal []*model.CertifyPolicyInputSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("certifyPolicies"))
+	if tmp, ok := rawArgs["certifyPolicies"]; ok {
+		return ec.unmarshalNCertifyPolicyInputSpec2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyPolicyInputSpecᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.CertifyPolicyInputSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestCertifyPolicy_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_ingestCertifyPolicy_argsSubject(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["subject"] = arg0
+	arg1, err := ec.field_Mutation_ingestCertifyPolicy_argsCertifyPolicy(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["certifyPolicy"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_ingestCertifyPolicy_argsSubject(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.IDorArtifactInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["subject"]
+	if !ok {
+		var zeroVal model.IDorArtifactInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalNIDorArtifactInput2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐIDorArtifactInput(ctx, tmp)
+	}
+
+	var zeroVal model.IDorArtifactInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestCertifyPolicy_argsCertifyPolicy(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.CertifyPolicyInputSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["certifyPolicy"]
+	if !ok {
+		var zeroVal model.CertifyPolicyInputSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("certifyPolicy"))
+	if tmp, ok := rawArgs["certifyPolicy"]; ok {
+		return ec.unmarshalNCertifyPolicyInputSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyPolicyInputSpec(ctx, tmp)
+	}
+
+	var zeroVal model.CertifyPolicyInputSpec
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_ingestCertifyVuln_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3495,6 +3629,178 @@ func (ec *executionContext) field_Mutation_ingestVulnerabilityMetadata_argsVulne
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_ingestVulnerabilityRange_argsPkg(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["pkg"] = arg0
+	arg1, err := ec.field_Mutation_ingestVulnerabilityRange_argsVulnerability(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerability"] = arg1
+	arg2, err := ec.field_Mutation_ingestVulnerabilityRange_argsVulnerabilityRange(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerabilityRange"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRange_argsPkg(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.IDorPkgInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["pkg"]
+	if !ok {
+		var zeroVal model.IDorPkgInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pkg"))
+	if tmp, ok := rawArgs["pkg"]; ok {
+		return ec.unmarshalNIDorPkgInput2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐIDorPkgInput(ctx, tmp)
+	}
+
+	var zeroVal model.IDorPkgInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRange_argsVulnerability(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.IDorVulnerabilityInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerability"]
+	if !ok {
+		var zeroVal model.IDorVulnerabilityInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerability"))
+	if tmp, ok := rawArgs["vulnerability"]; ok {
+		return ec.unmarshalNIDorVulnerabilityInput2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐIDorVulnerabilityInput(ctx, tmp)
+	}
+
+	var zeroVal model.IDorVulnerabilityInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRange_argsVulnerabilityRange(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.VulnerabilityRangeInputSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerabilityRange"]
+	if !ok {
+		var zeroVal model.VulnerabilityRangeInputSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityRange"))
+	if tmp, ok := rawArgs["vulnerabilityRange"]; ok {
+		return ec.unmarshalNVulnerabilityRangeInputSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeInputSpec(ctx, tmp)
+	}
+
+	var zeroVal model.VulnerabilityRangeInputSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_ingestVulnerabilityRanges_argsPkgs(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["pkgs"] = arg0
+	arg1, err := ec.field_Mutation_ingestVulnerabilityRanges_argsVulnerabilities(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerabilities"] = arg1
+	arg2, err := ec.field_Mutation_ingestVulnerabilityRanges_argsVulnerabilityRanges(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerabilityRanges"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRanges_argsPkgs(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.IDorPkgInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["pkgs"]
+	if !ok {
+		var zeroVal []*model.IDorPkgInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pkgs"))
+	if tmp, ok := rawArgs["pkgs"]; ok {
+		return ec.unmarshalNIDorPkgInput2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐIDorPkgInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.IDorPkgInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRanges_argsVulnerabilities(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.IDorVulnerabilityInput, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerabilities"]
+	if !ok {
+		var zeroVal []*model.IDorVulnerabilityInput
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilities"))
+	if tmp, ok := rawArgs["vulnerabilities"]; ok {
+		return ec.unmarshalNIDorVulnerabilityInput2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐIDorVulnerabilityInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.IDorVulnerabilityInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_ingestVulnerabilityRanges_argsVulnerabilityRanges(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.VulnerabilityRangeInputSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerabilityRanges"]
+	if !ok {
+		var zeroVal []*model.VulnerabilityRangeInputSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityRanges"))
+	if tmp, ok := rawArgs["vulnerabilityRanges"]; ok {
+		return ec.unmarshalNVulnerabilityRangeInputSpec2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeInputSpecᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.VulnerabilityRangeInputSpec
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_ingestVulnerability_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3899,16 +4205,21 @@ func (ec *executionContext) field_Query_CertifyLegalList_args(ctx context.Contex
 		return nil, err
 	}
 	args["certifyLegalSpec"] = arg0
-	arg1, err := ec.field_Query_CertifyLegalList_argsAfter(ctx, rawArgs)
+	arg1, err := ec.field_Query_CertifyLegalList_argsAsOf(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["after"] = arg1
-	arg2, err := ec.field_Query_CertifyLegalList_argsFirst(ctx, rawArgs)
+	args["asOf"] = arg1
+	arg2, err := ec.field_Query_CertifyLegalList_argsAfter(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["first"] = arg2
+	args["after"] = arg2
+	arg3, err := ec.field_Query_CertifyLegalList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Query_CertifyLegalList_argsCertifyLegalSpec(
@@ -3933,6 +4244,28 @@ func (ec *executionContext) field_Query_CertifyLegalList_argsCertifyLegalSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_CertifyLegalList_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_CertifyLegalList_argsAfter(
 	ctx context.Context,
 	rawArgs map[string]interface{},
@@ -3985,7 +4318,12 @@ func (ec *executionContext) field_Query_CertifyLegal_args(ctx context.Context, r
 		return nil, err
 	}
 	args["certifyLegalSpec"] = arg0
-	return args, nil
+	arg1, err := ec.field_Query_CertifyLegal_argsAsOf(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["asOf"] = arg1
+	return args, nil
 }
 func (ec *executionContext) field_Query_CertifyLegal_argsCertifyLegalSpec(
 	ctx context.Context,
@@ -4009,6 +4347,146 @@ func (ec *executionContext) field_Query_CertifyLegal_argsCertifyLegalSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_CertifyLegal_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_CertifyPolicyList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_CertifyPolicyList_argsCertifyPolicySpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["certifyPolicySpec"] = arg0
+	arg1, err := ec.field_Query_CertifyPolicyList_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg1
+	arg2, err := ec.field_Query_CertifyPolicyList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Query_CertifyPolicyList_argsCertifyPolicySpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.CertifyPolicySpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["certifyPolicySpec"]
+	if !ok {
+		var zeroVal model.CertifyPolicySpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("certifyPolicySpec"))
+	if tmp, ok := rawArgs["certifyPolicySpec"]; ok {
+		return ec.unmarshalNCertifyPolicySpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyPolicySpec(ctx, tmp)
+	}
+
+	var zeroVal model.CertifyPolicySpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_CertifyPolicyList_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["after"]
+	if !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOID2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_CertifyPolicyList_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["first"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_CertifyPolicy_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_CertifyPolicy_argsCertifyPolicySpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["certifyPolicySpec"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_CertifyPolicy_argsCertifyPolicySpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.CertifyPolicySpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["certifyPolicySpec"]
+	if !ok {
+		var zeroVal model.CertifyPolicySpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("certifyPolicySpec"))
+	if tmp, ok := rawArgs["certifyPolicySpec"]; ok {
+		return ec.unmarshalNCertifyPolicySpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyPolicySpec(ctx, tmp)
+	}
+
+	var zeroVal model.CertifyPolicySpec
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_CertifyVEXStatementList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4017,16 +4495,21 @@ func (ec *executionContext) field_Query_CertifyVEXStatementList_args(ctx context
 		return nil, err
 	}
 	args["certifyVEXStatementSpec"] = arg0
-	arg1, err := ec.field_Query_CertifyVEXStatementList_argsAfter(ctx, rawArgs)
+	arg1, err := ec.field_Query_CertifyVEXStatementList_argsAsOf(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["after"] = arg1
-	arg2, err := ec.field_Query_CertifyVEXStatementList_argsFirst(ctx, rawArgs)
+	args["asOf"] = arg1
+	arg2, err := ec.field_Query_CertifyVEXStatementList_argsAfter(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["first"] = arg2
+	args["after"] = arg2
+	arg3, err := ec.field_Query_CertifyVEXStatementList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Query_CertifyVEXStatementList_argsCertifyVEXStatementSpec(
@@ -4051,6 +4534,28 @@ func (ec *executionContext) field_Query_CertifyVEXStatementList_argsCertifyVEXSt
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_CertifyVEXStatementList_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_CertifyVEXStatementList_argsAfter(
 	ctx context.Context,
 	rawArgs map[string]interface{},
@@ -4103,6 +4608,11 @@ func (ec *executionContext) field_Query_CertifyVEXStatement_args(ctx context.Con
 		return nil, err
 	}
 	args["certifyVEXStatementSpec"] = arg0
+	arg1, err := ec.field_Query_CertifyVEXStatement_argsAsOf(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["asOf"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Query_CertifyVEXStatement_argsCertifyVEXStatementSpec(
@@ -4127,6 +4637,28 @@ func (ec *executionContext) field_Query_CertifyVEXStatement_argsCertifyVEXStatem
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_CertifyVEXStatement_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_CertifyVulnList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4135,16 +4667,21 @@ func (ec *executionContext) field_Query_CertifyVulnList_args(ctx context.Context
 		return nil, err
 	}
 	args["certifyVulnSpec"] = arg0
-	arg1, err := ec.field_Query_CertifyVulnList_argsAfter(ctx, rawArgs)
+	arg1, err := ec.field_Query_CertifyVulnList_argsAsOf(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["after"] = arg1
-	arg2, err := ec.field_Query_CertifyVulnList_argsFirst(ctx, rawArgs)
+	args["asOf"] = arg1
+	arg2, err := ec.field_Query_CertifyVulnList_argsAfter(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["first"] = arg2
+	args["after"] = arg2
+	arg3, err := ec.field_Query_CertifyVulnList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Query_CertifyVulnList_argsCertifyVulnSpec(
@@ -4169,6 +4706,28 @@ func (ec *executionContext) field_Query_CertifyVulnList_argsCertifyVulnSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_CertifyVulnList_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_CertifyVulnList_argsAfter(
 	ctx context.Context,
 	rawArgs map[string]interface{},
@@ -4221,6 +4780,11 @@ func (ec *executionContext) field_Query_CertifyVuln_args(ctx context.Context, ra
 		return nil, err
 	}
 	args["certifyVulnSpec"] = arg0
+	arg1, err := ec.field_Query_CertifyVuln_argsAsOf(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["asOf"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Query_CertifyVuln_argsCertifyVulnSpec(
@@ -4245,6 +4809,28 @@ func (ec *executionContext) field_Query_CertifyVuln_argsCertifyVulnSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_CertifyVuln_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_HasMetadataList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -4371,16 +4957,21 @@ func (ec *executionContext) field_Query_HasSBOMList_args(ctx context.Context, ra
 		return nil, err
 	}
 	args["hasSBOMSpec"] = arg0
-	arg1, err := ec.field_Query_HasSBOMList_argsAfter(ctx, rawArgs)
+	arg1, err := ec.field_Query_HasSBOMList_argsAsOf(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["after"] = arg1
-	arg2, err := ec.field_Query_HasSBOMList_argsFirst(ctx, rawArgs)
+	args["asOf"] = arg1
+	arg2, err := ec.field_Query_HasSBOMList_argsAfter(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["first"] = arg2
+	args["after"] = arg2
+	arg3, err := ec.field_Query_HasSBOMList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Query_HasSBOMList_argsHasSBOMSpec(
@@ -4405,6 +4996,28 @@ func (ec *executionContext) field_Query_HasSBOMList_argsHasSBOMSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_HasSBOMList_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_HasSBOMList_argsAfter(
 	ctx context.Context,
 	rawArgs map[string]interface{},
@@ -4457,6 +5070,11 @@ func (ec *executionContext) field_Query_HasSBOM_args(ctx context.Context, rawArg
 		return nil, err
 	}
 	args["hasSBOMSpec"] = arg0
+	arg1, err := ec.field_Query_HasSBOM_argsAsOf(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["asOf"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Query_HasSBOM_argsHasSBOMSpec(
@@ -4481,6 +5099,28 @@ func (ec *executionContext) field_Query_HasSBOM_argsHasSBOMSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_HasSBOM_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_HasSLSAList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -5339,44 +5979,211 @@ func (ec *executionContext) field_Query___type_argsName(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_artifactsList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_allPaths_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_artifactsList_argsArtifactSpec(ctx, rawArgs)
+	arg0, err := ec.field_Query_allPaths_argsSubject(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["artifactSpec"] = arg0
-	arg1, err := ec.field_Query_artifactsList_argsAfter(ctx, rawArgs)
+	args["subject"] = arg0
+	arg1, err := ec.field_Query_allPaths_argsTarget(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["after"] = arg1
-	arg2, err := ec.field_Query_artifactsList_argsFirst(ctx, rawArgs)
+	args["target"] = arg1
+	arg2, err := ec.field_Query_allPaths_argsMaxPathLength(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["first"] = arg2
+	args["maxPathLength"] = arg2
+	arg3, err := ec.field_Query_allPaths_argsUsingOnly(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["usingOnly"] = arg3
+	arg4, err := ec.field_Query_allPaths_argsWeights(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["weights"] = arg4
+	arg5, err := ec.field_Query_allPaths_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg5
 	return args, nil
 }
-func (ec *executionContext) field_Query_artifactsList_argsArtifactSpec(
+func (ec *executionContext) field_Query_allPaths_argsSubject(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (model.ArtifactSpec, error) {
+) (string, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
-	_, ok := rawArgs["artifactSpec"]
+	_, ok := rawArgs["subject"]
 	if !ok {
-		var zeroVal model.ArtifactSpec
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("artifactSpec"))
-	if tmp, ok := rawArgs["artifactSpec"]; ok {
-		return ec.unmarshalNArtifactSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐArtifactSpec(ctx, tmp)
-	}
-
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_allPaths_argsTarget(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["target"]
+	if !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("target"))
+	if tmp, ok := rawArgs["target"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_allPaths_argsMaxPathLength(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["maxPathLength"]
+	if !ok {
+		var zeroVal int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPathLength"))
+	if tmp, ok := rawArgs["maxPathLength"]; ok {
+		return ec.unmarshalNInt2int(ctx, tmp)
+	}
+
+	var zeroVal int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_allPaths_argsUsingOnly(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]model.Edge, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["usingOnly"]
+	if !ok {
+		var zeroVal []model.Edge
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("usingOnly"))
+	if tmp, ok := rawArgs["usingOnly"]; ok {
+		return ec.unmarshalNEdge2ᚕgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐEdgeᚄ(ctx, tmp)
+	}
+
+	var zeroVal []model.Edge
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_allPaths_argsWeights(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.EdgeWeight, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["weights"]
+	if !ok {
+		var zeroVal []*model.EdgeWeight
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("weights"))
+	if tmp, ok := rawArgs["weights"]; ok {
+		return ec.unmarshalOEdgeWeight2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐEdgeWeightᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.EdgeWeight
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_allPaths_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["first"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_artifactsList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_artifactsList_argsArtifactSpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["artifactSpec"] = arg0
+	arg1, err := ec.field_Query_artifactsList_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg1
+	arg2, err := ec.field_Query_artifactsList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Query_artifactsList_argsArtifactSpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.ArtifactSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["artifactSpec"]
+	if !ok {
+		var zeroVal model.ArtifactSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("artifactSpec"))
+	if tmp, ok := rawArgs["artifactSpec"]; ok {
+		return ec.unmarshalNArtifactSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐArtifactSpec(ctx, tmp)
+	}
+
 	var zeroVal model.ArtifactSpec
 	return zeroVal, nil
 }
@@ -5495,47 +6302,278 @@ func (ec *executionContext) field_Query_buildersList_argsBuilderSpec(
 		return ec.unmarshalNBuilderSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐBuilderSpec(ctx, tmp)
 	}
 
-	var zeroVal model.BuilderSpec
+	var zeroVal model.BuilderSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_buildersList_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["after"]
+	if !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOID2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_buildersList_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["first"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_builders_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_builders_argsBuilderSpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["builderSpec"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_builders_argsBuilderSpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.BuilderSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["builderSpec"]
+	if !ok {
+		var zeroVal model.BuilderSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("builderSpec"))
+	if tmp, ok := rawArgs["builderSpec"]; ok {
+		return ec.unmarshalNBuilderSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐBuilderSpec(ctx, tmp)
+	}
+
+	var zeroVal model.BuilderSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_constrainedPath_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_constrainedPath_argsSubject(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["subject"] = arg0
+	arg1, err := ec.field_Query_constrainedPath_argsTarget(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["target"] = arg1
+	arg2, err := ec.field_Query_constrainedPath_argsSegments(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["segments"] = arg2
+	arg3, err := ec.field_Query_constrainedPath_argsMaxPathLength(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["maxPathLength"] = arg3
+	arg4, err := ec.field_Query_constrainedPath_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg4
+	return args, nil
+}
+func (ec *executionContext) field_Query_constrainedPath_argsSubject(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["subject"]
+	if !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_constrainedPath_argsTarget(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["target"]
+	if !ok {
+		var zeroVal string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("target"))
+	if tmp, ok := rawArgs["target"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_constrainedPath_argsSegments(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.PathSegment, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["segments"]
+	if !ok {
+		var zeroVal []*model.PathSegment
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("segments"))
+	if tmp, ok := rawArgs["segments"]; ok {
+		return ec.unmarshalNPathSegment2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPathSegmentᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.PathSegment
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_constrainedPath_argsMaxPathLength(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["maxPathLength"]
+	if !ok {
+		var zeroVal int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPathLength"))
+	if tmp, ok := rawArgs["maxPathLength"]; ok {
+		return ec.unmarshalNInt2int(ctx, tmp)
+	}
+
+	var zeroVal int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_constrainedPath_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["first"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_buildersList_argsAfter(
+func (ec *executionContext) field_Query_dependencyClosure_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_dependencyClosure_argsSubject(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["subject"] = arg0
+	arg1, err := ec.field_Query_dependencyClosure_argsMaxDepth(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["maxDepth"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_dependencyClosure_argsSubject(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (*string, error) {
+) (string, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
-	_, ok := rawArgs["after"]
+	_, ok := rawArgs["subject"]
 	if !ok {
-		var zeroVal *string
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
-	if tmp, ok := rawArgs["after"]; ok {
-		return ec.unmarshalOID2ᚖstring(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal *string
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_buildersList_argsFirst(
+func (ec *executionContext) field_Query_dependencyClosure_argsMaxDepth(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (*int, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
-	_, ok := rawArgs["first"]
+	_, ok := rawArgs["maxDepth"]
 	if !ok {
 		var zeroVal *int
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
-	if tmp, ok := rawArgs["first"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("maxDepth"))
+	if tmp, ok := rawArgs["maxDepth"]; ok {
 		return ec.unmarshalOInt2ᚖint(ctx, tmp)
 	}
 
@@ -5543,35 +6581,62 @@ func (ec *executionContext) field_Query_buildersList_argsFirst(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_builders_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_dependentClosure_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_builders_argsBuilderSpec(ctx, rawArgs)
+	arg0, err := ec.field_Query_dependentClosure_argsSubject(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["builderSpec"] = arg0
+	args["subject"] = arg0
+	arg1, err := ec.field_Query_dependentClosure_argsMaxDepth(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["maxDepth"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Query_builders_argsBuilderSpec(
+func (ec *executionContext) field_Query_dependentClosure_argsSubject(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (model.BuilderSpec, error) {
+) (string, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
-	_, ok := rawArgs["builderSpec"]
+	_, ok := rawArgs["subject"]
 	if !ok {
-		var zeroVal model.BuilderSpec
+		var zeroVal string
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("builderSpec"))
-	if tmp, ok := rawArgs["builderSpec"]; ok {
-		return ec.unmarshalNBuilderSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐBuilderSpec(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalNID2string(ctx, tmp)
 	}
 
-	var zeroVal model.BuilderSpec
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_dependentClosure_argsMaxDepth(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["maxDepth"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("maxDepth"))
+	if tmp, ok := rawArgs["maxDepth"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
 	return zeroVal, nil
 }
 
@@ -5752,6 +6817,38 @@ func (ec *executionContext) field_Query_findSoftware_argsSearchText(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_fixRecommendation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_fixRecommendation_argsPkg(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["pkg"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_fixRecommendation_argsPkg(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.PkgSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["pkg"]
+	if !ok {
+		var zeroVal model.PkgSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pkg"))
+	if tmp, ok := rawArgs["pkg"]; ok {
+		return ec.unmarshalNPkgSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPkgSpec(ctx, tmp)
+	}
+
+	var zeroVal model.PkgSpec
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_licenseList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -6431,16 +7528,21 @@ func (ec *executionContext) field_Query_scorecardsList_args(ctx context.Context,
 		return nil, err
 	}
 	args["scorecardSpec"] = arg0
-	arg1, err := ec.field_Query_scorecardsList_argsAfter(ctx, rawArgs)
+	arg1, err := ec.field_Query_scorecardsList_argsAsOf(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["after"] = arg1
-	arg2, err := ec.field_Query_scorecardsList_argsFirst(ctx, rawArgs)
+	args["asOf"] = arg1
+	arg2, err := ec.field_Query_scorecardsList_argsAfter(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["first"] = arg2
+	args["after"] = arg2
+	arg3, err := ec.field_Query_scorecardsList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Query_scorecardsList_argsScorecardSpec(
@@ -6465,6 +7567,28 @@ func (ec *executionContext) field_Query_scorecardsList_argsScorecardSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_scorecardsList_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_scorecardsList_argsAfter(
 	ctx context.Context,
 	rawArgs map[string]interface{},
@@ -6517,6 +7641,11 @@ func (ec *executionContext) field_Query_scorecards_args(ctx context.Context, raw
 		return nil, err
 	}
 	args["scorecardSpec"] = arg0
+	arg1, err := ec.field_Query_scorecards_argsAsOf(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["asOf"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Query_scorecards_argsScorecardSpec(
@@ -6541,6 +7670,28 @@ func (ec *executionContext) field_Query_scorecards_argsScorecardSpec(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Query_scorecards_argsAsOf(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*time.Time, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["asOf"]
+	if !ok {
+		var zeroVal *time.Time
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("asOf"))
+	if tmp, ok := rawArgs["asOf"]; ok {
+		return ec.unmarshalOTime2ᚖtimeᚐTime(ctx, tmp)
+	}
+
+	var zeroVal *time.Time
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Query_sourcesList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -6882,62 +8033,180 @@ func (ec *executionContext) field_Query_vulnerabilityList_argsFirst(
 	// even if the argument is null.
 	_, ok := rawArgs["first"]
 	if !ok {
-		var zeroVal *int
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_vulnerabilityMetadataList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_vulnerabilityMetadataList_argsVulnerabilityMetadataSpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerabilityMetadataSpec"] = arg0
+	arg1, err := ec.field_Query_vulnerabilityMetadataList_argsAfter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg1
+	arg2, err := ec.field_Query_vulnerabilityMetadataList_argsFirst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["first"] = arg2
+	return args, nil
+}
+func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsVulnerabilityMetadataSpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.VulnerabilityMetadataSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerabilityMetadataSpec"]
+	if !ok {
+		var zeroVal model.VulnerabilityMetadataSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityMetadataSpec"))
+	if tmp, ok := rawArgs["vulnerabilityMetadataSpec"]; ok {
+		return ec.unmarshalNVulnerabilityMetadataSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityMetadataSpec(ctx, tmp)
+	}
+
+	var zeroVal model.VulnerabilityMetadataSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsAfter(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["after"]
+	if !ok {
+		var zeroVal *string
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+	if tmp, ok := rawArgs["after"]; ok {
+		return ec.unmarshalOID2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsFirst(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*int, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["first"]
+	if !ok {
+		var zeroVal *int
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+	if tmp, ok := rawArgs["first"]; ok {
+		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	}
+
+	var zeroVal *int
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_vulnerabilityMetadata_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_vulnerabilityMetadata_argsVulnerabilityMetadataSpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerabilityMetadataSpec"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_vulnerabilityMetadata_argsVulnerabilityMetadataSpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.VulnerabilityMetadataSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerabilityMetadataSpec"]
+	if !ok {
+		var zeroVal model.VulnerabilityMetadataSpec
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
-	if tmp, ok := rawArgs["first"]; ok {
-		return ec.unmarshalOInt2ᚖint(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityMetadataSpec"))
+	if tmp, ok := rawArgs["vulnerabilityMetadataSpec"]; ok {
+		return ec.unmarshalNVulnerabilityMetadataSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityMetadataSpec(ctx, tmp)
 	}
 
-	var zeroVal *int
+	var zeroVal model.VulnerabilityMetadataSpec
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_vulnerabilityMetadataList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_vulnerabilityRangeList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_vulnerabilityMetadataList_argsVulnerabilityMetadataSpec(ctx, rawArgs)
+	arg0, err := ec.field_Query_vulnerabilityRangeList_argsVulnerabilityRangeSpec(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["vulnerabilityMetadataSpec"] = arg0
-	arg1, err := ec.field_Query_vulnerabilityMetadataList_argsAfter(ctx, rawArgs)
+	args["vulnerabilityRangeSpec"] = arg0
+	arg1, err := ec.field_Query_vulnerabilityRangeList_argsAfter(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["after"] = arg1
-	arg2, err := ec.field_Query_vulnerabilityMetadataList_argsFirst(ctx, rawArgs)
+	arg2, err := ec.field_Query_vulnerabilityRangeList_argsFirst(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["first"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsVulnerabilityMetadataSpec(
+func (ec *executionContext) field_Query_vulnerabilityRangeList_argsVulnerabilityRangeSpec(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (model.VulnerabilityMetadataSpec, error) {
+) (model.VulnerabilityRangeSpec, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
-	_, ok := rawArgs["vulnerabilityMetadataSpec"]
+	_, ok := rawArgs["vulnerabilityRangeSpec"]
 	if !ok {
-		var zeroVal model.VulnerabilityMetadataSpec
+		var zeroVal model.VulnerabilityRangeSpec
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityMetadataSpec"))
-	if tmp, ok := rawArgs["vulnerabilityMetadataSpec"]; ok {
-		return ec.unmarshalNVulnerabilityMetadataSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityMetadataSpec(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityRangeSpec"))
+	if tmp, ok := rawArgs["vulnerabilityRangeSpec"]; ok {
+		return ec.unmarshalNVulnerabilityRangeSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeSpec(ctx, tmp)
 	}
 
-	var zeroVal model.VulnerabilityMetadataSpec
+	var zeroVal model.VulnerabilityRangeSpec
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsAfter(
+func (ec *executionContext) field_Query_vulnerabilityRangeList_argsAfter(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (*string, error) {
@@ -6959,7 +8228,7 @@ func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsAfter(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsFirst(
+func (ec *executionContext) field_Query_vulnerabilityRangeList_argsFirst(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (*int, error) {
@@ -6981,35 +8250,67 @@ func (ec *executionContext) field_Query_vulnerabilityMetadataList_argsFirst(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_vulnerabilityMetadata_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_vulnerabilityRangeMatches_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_vulnerabilityMetadata_argsVulnerabilityMetadataSpec(ctx, rawArgs)
+	arg0, err := ec.field_Query_vulnerabilityRangeMatches_argsVulnerabilityRangeSpec(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["vulnerabilityMetadataSpec"] = arg0
+	args["vulnerabilityRangeSpec"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_vulnerabilityMetadata_argsVulnerabilityMetadataSpec(
+func (ec *executionContext) field_Query_vulnerabilityRangeMatches_argsVulnerabilityRangeSpec(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (model.VulnerabilityMetadataSpec, error) {
+) (model.VulnerabilityRangeSpec, error) {
 	// We won't call the directive if the argument is null.
 	// Set call_argument_directives_with_null to true to call directives
 	// even if the argument is null.
-	_, ok := rawArgs["vulnerabilityMetadataSpec"]
+	_, ok := rawArgs["vulnerabilityRangeSpec"]
 	if !ok {
-		var zeroVal model.VulnerabilityMetadataSpec
+		var zeroVal model.VulnerabilityRangeSpec
 		return zeroVal, nil
 	}
 
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityMetadataSpec"))
-	if tmp, ok := rawArgs["vulnerabilityMetadataSpec"]; ok {
-		return ec.unmarshalNVulnerabilityMetadataSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityMetadataSpec(ctx, tmp)
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityRangeSpec"))
+	if tmp, ok := rawArgs["vulnerabilityRangeSpec"]; ok {
+		return ec.unmarshalNVulnerabilityRangeSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeSpec(ctx, tmp)
 	}
 
-	var zeroVal model.VulnerabilityMetadataSpec
+	var zeroVal model.VulnerabilityRangeSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_vulnerabilityRange_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_vulnerabilityRange_argsVulnerabilityRangeSpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["vulnerabilityRangeSpec"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_vulnerabilityRange_argsVulnerabilityRangeSpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.VulnerabilityRangeSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["vulnerabilityRangeSpec"]
+	if !ok {
+		var zeroVal model.VulnerabilityRangeSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("vulnerabilityRangeSpec"))
+	if tmp, ok := rawArgs["vulnerabilityRangeSpec"]; ok {
+		return ec.unmarshalNVulnerabilityRangeSpec2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeSpec(ctx, tmp)
+	}
+
+	var zeroVal model.VulnerabilityRangeSpec
 	return zeroVal, nil
 }
 
@@ -7891,6 +9192,110 @@ func (ec *executionContext) fieldContext_Mutation_ingestCertifyLegals(ctx contex
 	return fc, nil
 }
 
+func (ec *executionContext) _Mutation_ingestCertifyPolicy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestCertifyPolicy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().IngestCertifyPolicy(rctx, fc.Args["subject"].(model.IDorArtifactInput), fc.Args["certifyPolicy"].(model.CertifyPolicyInputSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_ingestCertifyPolicy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_ingestCertifyPolicy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_ingestCertifyPolicies(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestCertifyPolicies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().IngestCertifyPolicies(rctx, fc.Args["subjects"].([]*model.IDorArtifactInput), fc.Args["certifyPolicies"].([]*model.CertifyPolicyInputSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNID2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_ingestCertifyPolicies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_ingestCertifyPolicies_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Mutation_ingestScorecard(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Mutation_ingestScorecard(ctx, field)
 	if err != nil {
@@ -9668,17 +11073,127 @@ func (ec *executionContext) _Mutation_ingestVulnerabilityMetadata(ctx context.Co
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilityMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_ingestVulnerabilityMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_ingestBulkVulnerabilityMetadata(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestBulkVulnerabilityMetadata(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().IngestBulkVulnerabilityMetadata(rctx, fc.Args["vulnerabilities"].([]*model.IDorVulnerabilityInput), fc.Args["vulnerabilityMetadataList"].([]*model.VulnerabilityMetadataInputSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNID2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Mutation_ingestBulkVulnerabilityMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_ingestBulkVulnerabilityMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_ingestVulnerability(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestVulnerability(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().IngestVulnerability(rctx, fc.Args["vuln"].(model.IDorVulnerabilityInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.VulnerabilityIDs)
+	fc.Result = res
+	return ec.marshalNVulnerabilityIDs2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityIDs(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilityMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_ingestVulnerability(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "vulnerabilityTypeID":
+				return ec.fieldContext_VulnerabilityIDs_vulnerabilityTypeID(ctx, field)
+			case "vulnerabilityNodeID":
+				return ec.fieldContext_VulnerabilityIDs_vulnerabilityNodeID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityIDs", field.Name)
 		},
 	}
 	defer func() {
@@ -9688,15 +11203,15 @@ func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilityMetadata(ct
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_ingestVulnerabilityMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_ingestVulnerability_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_ingestBulkVulnerabilityMetadata(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_ingestBulkVulnerabilityMetadata(ctx, field)
+func (ec *executionContext) _Mutation_ingestVulnerabilities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestVulnerabilities(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9709,7 +11224,7 @@ func (ec *executionContext) _Mutation_ingestBulkVulnerabilityMetadata(ctx contex
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().IngestBulkVulnerabilityMetadata(rctx, fc.Args["vulnerabilities"].([]*model.IDorVulnerabilityInput), fc.Args["vulnerabilityMetadataList"].([]*model.VulnerabilityMetadataInputSpec))
+		return ec.resolvers.Mutation().IngestVulnerabilities(rctx, fc.Args["vulns"].([]*model.IDorVulnerabilityInput))
 	})
 
 	if resTmp == nil {
@@ -9718,19 +11233,25 @@ func (ec *executionContext) _Mutation_ingestBulkVulnerabilityMetadata(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*model.VulnerabilityIDs)
 	fc.Result = res
-	return ec.marshalNID2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNVulnerabilityIDs2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityIDsᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_ingestBulkVulnerabilityMetadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "vulnerabilityTypeID":
+				return ec.fieldContext_VulnerabilityIDs_vulnerabilityTypeID(ctx, field)
+			case "vulnerabilityNodeID":
+				return ec.fieldContext_VulnerabilityIDs_vulnerabilityNodeID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityIDs", field.Name)
 		},
 	}
 	defer func() {
@@ -9740,15 +11261,15 @@ func (ec *executionContext) fieldContext_Mutation_ingestBulkVulnerabilityMetadat
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_ingestBulkVulnerabilityMetadata_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_ingestVulnerabilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_ingestVulnerability(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_ingestVulnerability(ctx, field)
+func (ec *executionContext) _Mutation_ingestVulnerabilityRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestVulnerabilityRange(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9761,7 +11282,7 @@ func (ec *executionContext) _Mutation_ingestVulnerability(ctx context.Context, f
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().IngestVulnerability(rctx, fc.Args["vuln"].(model.IDorVulnerabilityInput))
+		return ec.resolvers.Mutation().IngestVulnerabilityRange(rctx, fc.Args["pkg"].(model.IDorPkgInput), fc.Args["vulnerability"].(model.IDorVulnerabilityInput), fc.Args["vulnerabilityRange"].(model.VulnerabilityRangeInputSpec))
 	})
 
 	if resTmp == nil {
@@ -9770,25 +11291,19 @@ func (ec *executionContext) _Mutation_ingestVulnerability(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.VulnerabilityIDs)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNVulnerabilityIDs2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityIDs(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_ingestVulnerability(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilityRange(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "vulnerabilityTypeID":
-				return ec.fieldContext_VulnerabilityIDs_vulnerabilityTypeID(ctx, field)
-			case "vulnerabilityNodeID":
-				return ec.fieldContext_VulnerabilityIDs_vulnerabilityNodeID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityIDs", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	defer func() {
@@ -9798,15 +11313,15 @@ func (ec *executionContext) fieldContext_Mutation_ingestVulnerability(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_ingestVulnerability_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_ingestVulnerabilityRange_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_ingestVulnerabilities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_ingestVulnerabilities(ctx, field)
+func (ec *executionContext) _Mutation_ingestVulnerabilityRanges(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_ingestVulnerabilityRanges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9819,7 +11334,7 @@ func (ec *executionContext) _Mutation_ingestVulnerabilities(ctx context.Context,
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().IngestVulnerabilities(rctx, fc.Args["vulns"].([]*model.IDorVulnerabilityInput))
+		return ec.resolvers.Mutation().IngestVulnerabilityRanges(rctx, fc.Args["pkgs"].([]*model.IDorPkgInput), fc.Args["vulnerabilities"].([]*model.IDorVulnerabilityInput), fc.Args["vulnerabilityRanges"].([]*model.VulnerabilityRangeInputSpec))
 	})
 
 	if resTmp == nil {
@@ -9828,25 +11343,19 @@ func (ec *executionContext) _Mutation_ingestVulnerabilities(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.VulnerabilityIDs)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNVulnerabilityIDs2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityIDsᚄ(ctx, field.Selections, res)
+	return ec.marshalNID2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilityRanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "vulnerabilityTypeID":
-				return ec.fieldContext_VulnerabilityIDs_vulnerabilityTypeID(ctx, field)
-			case "vulnerabilityNodeID":
-				return ec.fieldContext_VulnerabilityIDs_vulnerabilityNodeID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityIDs", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	defer func() {
@@ -9856,7 +11365,7 @@ func (ec *executionContext) fieldContext_Mutation_ingestVulnerabilities(ctx cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_ingestVulnerabilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_ingestVulnerabilityRanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -10359,7 +11868,7 @@ func (ec *executionContext) _Query_CertifyLegal(ctx context.Context, field graph
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CertifyLegal(rctx, fc.Args["certifyLegalSpec"].(model.CertifyLegalSpec))
+		return ec.resolvers.Query().CertifyLegal(rctx, fc.Args["certifyLegalSpec"].(model.CertifyLegalSpec), fc.Args["asOf"].(*time.Time))
 	})
 
 	if resTmp == nil {
@@ -10437,7 +11946,7 @@ func (ec *executionContext) _Query_CertifyLegalList(ctx context.Context, field g
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CertifyLegalList(rctx, fc.Args["certifyLegalSpec"].(model.CertifyLegalSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().CertifyLegalList(rctx, fc.Args["certifyLegalSpec"].(model.CertifyLegalSpec), fc.Args["asOf"].(*time.Time), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 
 	if resTmp == nil {
@@ -10558,6 +12067,139 @@ func (ec *executionContext) fieldContext_Query_BatchQueryPkgIDCertifyLegal(ctx c
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_CertifyPolicy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_CertifyPolicy(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CertifyPolicy(rctx, fc.Args["certifyPolicySpec"].(model.CertifyPolicySpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CertifyPolicy)
+	fc.Result = res
+	return ec.marshalNCertifyPolicy2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyPolicyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_CertifyPolicy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CertifyPolicy_id(ctx, field)
+			case "subject":
+				return ec.fieldContext_CertifyPolicy_subject(ctx, field)
+			case "verifier":
+				return ec.fieldContext_CertifyPolicy_verifier(ctx, field)
+			case "policyUri":
+				return ec.fieldContext_CertifyPolicy_policyUri(ctx, field)
+			case "policyDigest":
+				return ec.fieldContext_CertifyPolicy_policyDigest(ctx, field)
+			case "result":
+				return ec.fieldContext_CertifyPolicy_result(ctx, field)
+			case "verifiedLevels":
+				return ec.fieldContext_CertifyPolicy_verifiedLevels(ctx, field)
+			case "timeVerified":
+				return ec.fieldContext_CertifyPolicy_timeVerified(ctx, field)
+			case "origin":
+				return ec.fieldContext_CertifyPolicy_origin(ctx, field)
+			case "collector":
+				return ec.fieldContext_CertifyPolicy_collector(ctx, field)
+			case "documentRef":
+				return ec.fieldContext_CertifyPolicy_documentRef(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CertifyPolicy", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_CertifyPolicy_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_CertifyPolicyList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_CertifyPolicyList(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CertifyPolicyList(rctx, fc.Args["certifyPolicySpec"].(model.CertifyPolicySpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.CertifyPolicyConnection)
+	fc.Result = res
+	return ec.marshalOCertifyPolicyConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyPolicyConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_CertifyPolicyList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalCount":
+				return ec.fieldContext_CertifyPolicyConnection_totalCount(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_CertifyPolicyConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_CertifyPolicyConnection_edges(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CertifyPolicyConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_CertifyPolicyList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_scorecards(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_scorecards(ctx, field)
 	if err != nil {
@@ -10572,7 +12214,7 @@ func (ec *executionContext) _Query_scorecards(ctx context.Context, field graphql
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Scorecards(rctx, fc.Args["scorecardSpec"].(model.CertifyScorecardSpec))
+		return ec.resolvers.Query().Scorecards(rctx, fc.Args["scorecardSpec"].(model.CertifyScorecardSpec), fc.Args["asOf"].(*time.Time))
 	})
 
 	if resTmp == nil {
@@ -10632,7 +12274,7 @@ func (ec *executionContext) _Query_scorecardsList(ctx context.Context, field gra
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ScorecardsList(rctx, fc.Args["scorecardSpec"].(model.CertifyScorecardSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().ScorecardsList(rctx, fc.Args["scorecardSpec"].(model.CertifyScorecardSpec), fc.Args["asOf"].(*time.Time), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 
 	if resTmp == nil {
@@ -10689,7 +12331,7 @@ func (ec *executionContext) _Query_CertifyVEXStatement(ctx context.Context, fiel
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CertifyVEXStatement(rctx, fc.Args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec))
+		return ec.resolvers.Query().CertifyVEXStatement(rctx, fc.Args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), fc.Args["asOf"].(*time.Time))
 	})
 
 	if resTmp == nil {
@@ -10746,7 +12388,128 @@ func (ec *executionContext) fieldContext_Query_CertifyVEXStatement(ctx context.C
 			case "priority":
 				return ec.fieldContext_CertifyVEXStatement_priority(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CertifyVEXStatement", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CertifyVEXStatement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_CertifyVEXStatement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_CertifyVEXStatementList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_CertifyVEXStatementList(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CertifyVEXStatementList(rctx, fc.Args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), fc.Args["asOf"].(*time.Time), fc.Args["after"].(*string), fc.Args["first"].(*int))
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.VEXConnection)
+	fc.Result = res
+	return ec.marshalOVEXConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVEXConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_CertifyVEXStatementList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalCount":
+				return ec.fieldContext_VEXConnection_totalCount(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_VEXConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_VEXConnection_edges(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VEXConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_CertifyVEXStatementList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_CertifyVuln(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_CertifyVuln(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CertifyVuln(rctx, fc.Args["certifyVulnSpec"].(model.CertifyVulnSpec), fc.Args["asOf"].(*time.Time))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.CertifyVuln)
+	fc.Result = res
+	return ec.marshalNCertifyVuln2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_CertifyVuln(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CertifyVuln_id(ctx, field)
+			case "package":
+				return ec.fieldContext_CertifyVuln_package(ctx, field)
+			case "vulnerability":
+				return ec.fieldContext_CertifyVuln_vulnerability(ctx, field)
+			case "metadata":
+				return ec.fieldContext_CertifyVuln_metadata(ctx, field)
+			case "fixRecommendation":
+				return ec.fieldContext_CertifyVuln_fixRecommendation(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CertifyVuln", field.Name)
 		},
 	}
 	defer func() {
@@ -10756,15 +12519,15 @@ func (ec *executionContext) fieldContext_Query_CertifyVEXStatement(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_CertifyVEXStatement_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_CertifyVuln_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_CertifyVEXStatementList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_CertifyVEXStatementList(ctx, field)
+func (ec *executionContext) _Query_CertifyVulnList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_CertifyVulnList(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10777,18 +12540,18 @@ func (ec *executionContext) _Query_CertifyVEXStatementList(ctx context.Context,
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CertifyVEXStatementList(rctx, fc.Args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().CertifyVulnList(rctx, fc.Args["certifyVulnSpec"].(model.CertifyVulnSpec), fc.Args["asOf"].(*time.Time), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.VEXConnection)
+	res := resTmp.(*model.CertifyVulnConnection)
 	fc.Result = res
-	return ec.marshalOVEXConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVEXConnection(ctx, field.Selections, res)
+	return ec.marshalOCertifyVulnConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_CertifyVEXStatementList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_CertifyVulnList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -10797,13 +12560,13 @@ func (ec *executionContext) fieldContext_Query_CertifyVEXStatementList(ctx conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "totalCount":
-				return ec.fieldContext_VEXConnection_totalCount(ctx, field)
+				return ec.fieldContext_CertifyVulnConnection_totalCount(ctx, field)
 			case "pageInfo":
-				return ec.fieldContext_VEXConnection_pageInfo(ctx, field)
+				return ec.fieldContext_CertifyVulnConnection_pageInfo(ctx, field)
 			case "edges":
-				return ec.fieldContext_VEXConnection_edges(ctx, field)
+				return ec.fieldContext_CertifyVulnConnection_edges(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type VEXConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CertifyVulnConnection", field.Name)
 		},
 	}
 	defer func() {
@@ -10813,15 +12576,15 @@ func (ec *executionContext) fieldContext_Query_CertifyVEXStatementList(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_CertifyVEXStatementList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_CertifyVulnList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_CertifyVuln(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_CertifyVuln(ctx, field)
+func (ec *executionContext) _Query_BatchQueryPkgIDCertifyVuln(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_BatchQueryPkgIDCertifyVuln(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10834,7 +12597,7 @@ func (ec *executionContext) _Query_CertifyVuln(ctx context.Context, field graphq
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CertifyVuln(rctx, fc.Args["certifyVulnSpec"].(model.CertifyVulnSpec))
+		return ec.resolvers.Query().BatchQueryPkgIDCertifyVuln(rctx, fc.Args["pkgIDs"].([]string))
 	})
 
 	if resTmp == nil {
@@ -10848,7 +12611,7 @@ func (ec *executionContext) _Query_CertifyVuln(ctx context.Context, field graphq
 	return ec.marshalNCertifyVuln2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_CertifyVuln(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_BatchQueryPkgIDCertifyVuln(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -10864,6 +12627,8 @@ func (ec *executionContext) fieldContext_Query_CertifyVuln(ctx context.Context,
 				return ec.fieldContext_CertifyVuln_vulnerability(ctx, field)
 			case "metadata":
 				return ec.fieldContext_CertifyVuln_metadata(ctx, field)
+			case "fixRecommendation":
+				return ec.fieldContext_CertifyVuln_fixRecommendation(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type CertifyVuln", field.Name)
 		},
@@ -10875,15 +12640,15 @@ func (ec *executionContext) fieldContext_Query_CertifyVuln(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_CertifyVuln_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_BatchQueryPkgIDCertifyVuln_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_CertifyVulnList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_CertifyVulnList(ctx, field)
+func (ec *executionContext) _Query_dependencyClosure(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_dependencyClosure(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10896,18 +12661,21 @@ func (ec *executionContext) _Query_CertifyVulnList(ctx context.Context, field gr
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CertifyVulnList(rctx, fc.Args["certifyVulnSpec"].(model.CertifyVulnSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().DependencyClosure(rctx, fc.Args["subject"].(string), fc.Args["maxDepth"].(*int))
 	})
 
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.CertifyVulnConnection)
+	res := resTmp.([]*model.ClosureEntry)
 	fc.Result = res
-	return ec.marshalOCertifyVulnConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnConnection(ctx, field.Selections, res)
+	return ec.marshalNClosureEntry2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐClosureEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_CertifyVulnList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_dependencyClosure(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -10915,14 +12683,12 @@ func (ec *executionContext) fieldContext_Query_CertifyVulnList(ctx context.Conte
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "totalCount":
-				return ec.fieldContext_CertifyVulnConnection_totalCount(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_CertifyVulnConnection_pageInfo(ctx, field)
-			case "edges":
-				return ec.fieldContext_CertifyVulnConnection_edges(ctx, field)
+			case "id":
+				return ec.fieldContext_ClosureEntry_id(ctx, field)
+			case "depth":
+				return ec.fieldContext_ClosureEntry_depth(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CertifyVulnConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ClosureEntry", field.Name)
 		},
 	}
 	defer func() {
@@ -10932,15 +12698,15 @@ func (ec *executionContext) fieldContext_Query_CertifyVulnList(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_CertifyVulnList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_dependencyClosure_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_BatchQueryPkgIDCertifyVuln(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_BatchQueryPkgIDCertifyVuln(ctx, field)
+func (ec *executionContext) _Query_dependentClosure(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_dependentClosure(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10953,7 +12719,7 @@ func (ec *executionContext) _Query_BatchQueryPkgIDCertifyVuln(ctx context.Contex
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().BatchQueryPkgIDCertifyVuln(rctx, fc.Args["pkgIDs"].([]string))
+		return ec.resolvers.Query().DependentClosure(rctx, fc.Args["subject"].(string), fc.Args["maxDepth"].(*int))
 	})
 
 	if resTmp == nil {
@@ -10962,12 +12728,12 @@ func (ec *executionContext) _Query_BatchQueryPkgIDCertifyVuln(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.CertifyVuln)
+	res := resTmp.([]*model.ClosureEntry)
 	fc.Result = res
-	return ec.marshalNCertifyVuln2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnᚄ(ctx, field.Selections, res)
+	return ec.marshalNClosureEntry2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐClosureEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_BatchQueryPkgIDCertifyVuln(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_dependentClosure(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -10976,15 +12742,11 @@ func (ec *executionContext) fieldContext_Query_BatchQueryPkgIDCertifyVuln(ctx co
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_CertifyVuln_id(ctx, field)
-			case "package":
-				return ec.fieldContext_CertifyVuln_package(ctx, field)
-			case "vulnerability":
-				return ec.fieldContext_CertifyVuln_vulnerability(ctx, field)
-			case "metadata":
-				return ec.fieldContext_CertifyVuln_metadata(ctx, field)
+				return ec.fieldContext_ClosureEntry_id(ctx, field)
+			case "depth":
+				return ec.fieldContext_ClosureEntry_depth(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CertifyVuln", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ClosureEntry", field.Name)
 		},
 	}
 	defer func() {
@@ -10994,7 +12756,7 @@ func (ec *executionContext) fieldContext_Query_BatchQueryPkgIDCertifyVuln(ctx co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_BatchQueryPkgIDCertifyVuln_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_dependentClosure_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -11130,6 +12892,66 @@ func (ec *executionContext) fieldContext_Query_PointOfContactList(ctx context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_fixRecommendation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fixRecommendation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FixRecommendation(rctx, fc.Args["pkg"].(model.PkgSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.FixRecommendation)
+	fc.Result = res
+	return ec.marshalNFixRecommendation2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐFixRecommendation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_fixRecommendation(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "vulnerabilities":
+				return ec.fieldContext_FixRecommendation_vulnerabilities(ctx, field)
+			case "fixedVersion":
+				return ec.fieldContext_FixRecommendation_fixedVersion(ctx, field)
+			case "paths":
+				return ec.fieldContext_FixRecommendation_paths(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FixRecommendation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fixRecommendation_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_HasSBOM(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_HasSBOM(ctx, field)
 	if err != nil {
@@ -11144,7 +12966,7 @@ func (ec *executionContext) _Query_HasSBOM(ctx context.Context, field graphql.Co
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().HasSbom(rctx, fc.Args["hasSBOMSpec"].(model.HasSBOMSpec))
+		return ec.resolvers.Query().HasSbom(rctx, fc.Args["hasSBOMSpec"].(model.HasSBOMSpec), fc.Args["asOf"].(*time.Time))
 	})
 
 	if resTmp == nil {
@@ -11224,7 +13046,7 @@ func (ec *executionContext) _Query_HasSBOMList(ctx context.Context, field graphq
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().HasSBOMList(rctx, fc.Args["hasSBOMSpec"].(model.HasSBOMSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().HasSBOMList(rctx, fc.Args["hasSBOMSpec"].(model.HasSBOMSpec), fc.Args["asOf"].(*time.Time), fc.Args["after"].(*string), fc.Args["first"].(*int))
 	})
 
 	if resTmp == nil {
@@ -11678,6 +13500,8 @@ func (ec *executionContext) fieldContext_Query_IsDependency(ctx context.Context,
 				return ec.fieldContext_IsDependency_dependencyPackage(ctx, field)
 			case "dependencyType":
 				return ec.fieldContext_IsDependency_dependencyType(ctx, field)
+			case "versionRange":
+				return ec.fieldContext_IsDependency_versionRange(ctx, field)
 			case "justification":
 				return ec.fieldContext_IsDependency_justification(ctx, field)
 			case "origin":
@@ -11805,6 +13629,8 @@ func (ec *executionContext) fieldContext_Query_BatchQuerySubjectPkgDependency(ct
 				return ec.fieldContext_IsDependency_dependencyPackage(ctx, field)
 			case "dependencyType":
 				return ec.fieldContext_IsDependency_dependencyType(ctx, field)
+			case "versionRange":
+				return ec.fieldContext_IsDependency_versionRange(ctx, field)
 			case "justification":
 				return ec.fieldContext_IsDependency_justification(ctx, field)
 			case "origin":
@@ -11875,6 +13701,8 @@ func (ec *executionContext) fieldContext_Query_BatchQueryDepPkgDependency(ctx co
 				return ec.fieldContext_IsDependency_dependencyPackage(ctx, field)
 			case "dependencyType":
 				return ec.fieldContext_IsDependency_dependencyType(ctx, field)
+			case "versionRange":
+				return ec.fieldContext_IsDependency_versionRange(ctx, field)
 			case "justification":
 				return ec.fieldContext_IsDependency_justification(ctx, field)
 			case "origin":
@@ -12242,7 +14070,124 @@ func (ec *executionContext) _Query_HasMetadataList(ctx context.Context, field gr
 	return ec.marshalOHasMetadataConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasMetadataConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_HasMetadataList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_HasMetadataList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalCount":
+				return ec.fieldContext_HasMetadataConnection_totalCount(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_HasMetadataConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_HasMetadataConnection_edges(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HasMetadataConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_HasMetadataList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_packages(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_packages(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Packages(rctx, fc.Args["pkgSpec"].(model.PkgSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Package)
+	fc.Result = res
+	return ec.marshalNPackage2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_packages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Package_id(ctx, field)
+			case "type":
+				return ec.fieldContext_Package_type(ctx, field)
+			case "namespaces":
+				return ec.fieldContext_Package_namespaces(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Package", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_packages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_packagesList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_packagesList(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PackagesList(rctx, fc.Args["pkgSpec"].(model.PkgSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.PackageConnection)
+	fc.Result = res
+	return ec.marshalOPackageConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_packagesList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -12251,13 +14196,13 @@ func (ec *executionContext) fieldContext_Query_HasMetadataList(ctx context.Conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "totalCount":
-				return ec.fieldContext_HasMetadataConnection_totalCount(ctx, field)
+				return ec.fieldContext_PackageConnection_totalCount(ctx, field)
 			case "pageInfo":
-				return ec.fieldContext_HasMetadataConnection_pageInfo(ctx, field)
+				return ec.fieldContext_PackageConnection_pageInfo(ctx, field)
 			case "edges":
-				return ec.fieldContext_HasMetadataConnection_edges(ctx, field)
+				return ec.fieldContext_PackageConnection_edges(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type HasMetadataConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PackageConnection", field.Name)
 		},
 	}
 	defer func() {
@@ -12267,15 +14212,15 @@ func (ec *executionContext) fieldContext_Query_HasMetadataList(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_HasMetadataList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_packagesList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_packages(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_packages(ctx, field)
+func (ec *executionContext) _Query_path(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12288,7 +14233,7 @@ func (ec *executionContext) _Query_packages(ctx context.Context, field graphql.C
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Packages(rctx, fc.Args["pkgSpec"].(model.PkgSpec))
+		return ec.resolvers.Query().Path(rctx, fc.Args["subject"].(string), fc.Args["target"].(string), fc.Args["maxPathLength"].(int), fc.Args["usingOnly"].([]model.Edge))
 	})
 
 	if resTmp == nil {
@@ -12297,27 +14242,19 @@ func (ec *executionContext) _Query_packages(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Package)
+	res := resTmp.([]model.Node)
 	fc.Result = res
-	return ec.marshalNPackage2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageᚄ(ctx, field.Selections, res)
+	return ec.marshalNNode2ᚕgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_packages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Package_id(ctx, field)
-			case "type":
-				return ec.fieldContext_Package_type(ctx, field)
-			case "namespaces":
-				return ec.fieldContext_Package_namespaces(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Package", field.Name)
+			return nil, errors.New("field of type Node does not have child fields")
 		},
 	}
 	defer func() {
@@ -12327,15 +14264,15 @@ func (ec *executionContext) fieldContext_Query_packages(ctx context.Context, fie
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_packages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_path_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_packagesList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_packagesList(ctx, field)
+func (ec *executionContext) _Query_allPaths(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_allPaths(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12348,18 +14285,21 @@ func (ec *executionContext) _Query_packagesList(ctx context.Context, field graph
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().PackagesList(rctx, fc.Args["pkgSpec"].(model.PkgSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().AllPaths(rctx, fc.Args["subject"].(string), fc.Args["target"].(string), fc.Args["maxPathLength"].(int), fc.Args["usingOnly"].([]model.Edge), fc.Args["weights"].([]*model.EdgeWeight), fc.Args["first"].(*int))
 	})
 
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PackageConnection)
+	res := resTmp.([]*model.Path)
 	fc.Result = res
-	return ec.marshalOPackageConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageConnection(ctx, field.Selections, res)
+	return ec.marshalNPath2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPathᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_packagesList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_allPaths(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -12367,14 +14307,14 @@ func (ec *executionContext) fieldContext_Query_packagesList(ctx context.Context,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "totalCount":
-				return ec.fieldContext_PackageConnection_totalCount(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_PackageConnection_pageInfo(ctx, field)
-			case "edges":
-				return ec.fieldContext_PackageConnection_edges(ctx, field)
+			case "length":
+				return ec.fieldContext_Path_length(ctx, field)
+			case "weight":
+				return ec.fieldContext_Path_weight(ctx, field)
+			case "nodes":
+				return ec.fieldContext_Path_nodes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PackageConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Path", field.Name)
 		},
 	}
 	defer func() {
@@ -12384,15 +14324,15 @@ func (ec *executionContext) fieldContext_Query_packagesList(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_packagesList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_allPaths_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_path(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_path(ctx, field)
+func (ec *executionContext) _Query_constrainedPath(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_constrainedPath(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12405,7 +14345,7 @@ func (ec *executionContext) _Query_path(ctx context.Context, field graphql.Colle
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Path(rctx, fc.Args["subject"].(string), fc.Args["target"].(string), fc.Args["maxPathLength"].(int), fc.Args["usingOnly"].([]model.Edge))
+		return ec.resolvers.Query().ConstrainedPath(rctx, fc.Args["subject"].(string), fc.Args["target"].(string), fc.Args["segments"].([]*model.PathSegment), fc.Args["maxPathLength"].(int), fc.Args["first"].(*int))
 	})
 
 	if resTmp == nil {
@@ -12414,19 +14354,27 @@ func (ec *executionContext) _Query_path(ctx context.Context, field graphql.Colle
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Node)
+	res := resTmp.([]*model.Path)
 	fc.Result = res
-	return ec.marshalNNode2ᚕgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNPath2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPathᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_constrainedPath(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Node does not have child fields")
+			switch field.Name {
+			case "length":
+				return ec.fieldContext_Path_length(ctx, field)
+			case "weight":
+				return ec.fieldContext_Path_weight(ctx, field)
+			case "nodes":
+				return ec.fieldContext_Path_nodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Path", field.Name)
 		},
 	}
 	defer func() {
@@ -12436,7 +14384,7 @@ func (ec *executionContext) fieldContext_Query_path(ctx context.Context, field g
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_path_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_constrainedPath_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -13114,6 +15062,47 @@ func (ec *executionContext) fieldContext_Query_sourcesList(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_callerTenant(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_callerTenant(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().CallerTenant(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_callerTenant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_vulnEqual(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_vulnEqual(ctx, field)
 	if err != nil {
@@ -13407,7 +15396,195 @@ func (ec *executionContext) fieldContext_Query_vulnerabilities(ctx context.Conte
 			case "vulnerabilityIDs":
 				return ec.fieldContext_Vulnerability_vulnerabilityIDs(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Vulnerability", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Vulnerability", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vulnerabilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vulnerabilityList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_vulnerabilityList(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().VulnerabilityList(rctx, fc.Args["vulnSpec"].(model.VulnerabilitySpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.VulnerabilityConnection)
+	fc.Result = res
+	return ec.marshalOVulnerabilityConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_vulnerabilityList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalCount":
+				return ec.fieldContext_VulnerabilityConnection_totalCount(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_VulnerabilityConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_VulnerabilityConnection_edges(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vulnerabilityList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vulnerabilityRange(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_vulnerabilityRange(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().VulnerabilityRange(rctx, fc.Args["vulnerabilityRangeSpec"].(model.VulnerabilityRangeSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.VulnerabilityRange)
+	fc.Result = res
+	return ec.marshalNVulnerabilityRange2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_vulnerabilityRange(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VulnerabilityRange_id(ctx, field)
+			case "vulnerability":
+				return ec.fieldContext_VulnerabilityRange_vulnerability(ctx, field)
+			case "package":
+				return ec.fieldContext_VulnerabilityRange_package(ctx, field)
+			case "rangeType":
+				return ec.fieldContext_VulnerabilityRange_rangeType(ctx, field)
+			case "introduced":
+				return ec.fieldContext_VulnerabilityRange_introduced(ctx, field)
+			case "fixed":
+				return ec.fieldContext_VulnerabilityRange_fixed(ctx, field)
+			case "lastAffected":
+				return ec.fieldContext_VulnerabilityRange_lastAffected(ctx, field)
+			case "origin":
+				return ec.fieldContext_VulnerabilityRange_origin(ctx, field)
+			case "collector":
+				return ec.fieldContext_VulnerabilityRange_collector(ctx, field)
+			case "documentRef":
+				return ec.fieldContext_VulnerabilityRange_documentRef(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityRange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_vulnerabilityRange_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_vulnerabilityRangeList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_vulnerabilityRangeList(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().VulnerabilityRangeList(rctx, fc.Args["vulnerabilityRangeSpec"].(model.VulnerabilityRangeSpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.VulnerabilityRangeConnection)
+	fc.Result = res
+	return ec.marshalOVulnerabilityRangeConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_vulnerabilityRangeList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalCount":
+				return ec.fieldContext_VulnerabilityRangeConnection_totalCount(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_VulnerabilityRangeConnection_pageInfo(ctx, field)
+			case "edges":
+				return ec.fieldContext_VulnerabilityRangeConnection_edges(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityRangeConnection", field.Name)
 		},
 	}
 	defer func() {
@@ -13417,15 +15594,15 @@ func (ec *executionContext) fieldContext_Query_vulnerabilities(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_vulnerabilities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_vulnerabilityRangeList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_vulnerabilityList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_vulnerabilityList(ctx, field)
+func (ec *executionContext) _Query_vulnerabilityRangeMatches(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_vulnerabilityRangeMatches(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13438,18 +15615,21 @@ func (ec *executionContext) _Query_vulnerabilityList(ctx context.Context, field
 	}()
 	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().VulnerabilityList(rctx, fc.Args["vulnSpec"].(model.VulnerabilitySpec), fc.Args["after"].(*string), fc.Args["first"].(*int))
+		return ec.resolvers.Query().VulnerabilityRangeMatches(rctx, fc.Args["vulnerabilityRangeSpec"].(model.VulnerabilityRangeSpec))
 	})
 
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.VulnerabilityConnection)
+	res := resTmp.([]*model.VulnerabilityRangeMatch)
 	fc.Result = res
-	return ec.marshalOVulnerabilityConnection2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityConnection(ctx, field.Selections, res)
+	return ec.marshalNVulnerabilityRangeMatch2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityRangeMatchᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_vulnerabilityList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_vulnerabilityRangeMatches(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13457,14 +15637,12 @@ func (ec *executionContext) fieldContext_Query_vulnerabilityList(ctx context.Con
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "totalCount":
-				return ec.fieldContext_VulnerabilityConnection_totalCount(ctx, field)
-			case "pageInfo":
-				return ec.fieldContext_VulnerabilityConnection_pageInfo(ctx, field)
-			case "edges":
-				return ec.fieldContext_VulnerabilityConnection_edges(ctx, field)
+			case "vulnerabilityRange":
+				return ec.fieldContext_VulnerabilityRangeMatch_vulnerabilityRange(ctx, field)
+			case "package":
+				return ec.fieldContext_VulnerabilityRangeMatch_package(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityRangeMatch", field.Name)
 		},
 	}
 	defer func() {
@@ -13474,7 +15652,7 @@ func (ec *executionContext) fieldContext_Query_vulnerabilityList(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_vulnerabilityList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_vulnerabilityRangeMatches_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -13956,6 +16134,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "ingestCertifyPolicy":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_ingestCertifyPolicy(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ingestCertifyPolicies":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_ingestCertifyPolicies(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "ingestScorecard":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_ingestScorecard(ctx, field)
@@ -14215,6 +16407,20 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "ingestVulnerabilityRange":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_ingestVulnerabilityRange(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ingestVulnerabilityRanges":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_ingestVulnerabilityRanges(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14483,6 +16689,47 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "CertifyPolicy":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_CertifyPolicy(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "CertifyPolicyList":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_CertifyPolicyList(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "scorecards":
 			field := field
@@ -14628,6 +16875,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dependencyClosure":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dependencyClosure(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dependentClosure":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dependentClosure(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "PointOfContact":
 			field := field
@@ -14669,6 +16960,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "fixRecommendation":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_fixRecommendation(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "HasSBOM":
 			field := field
@@ -15104,6 +17417,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "allPaths":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_allPaths(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "constrainedPath":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_constrainedPath(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "neighbors":
 			field := field
@@ -15353,6 +17710,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "callerTenant":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_callerTenant(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "vulnEqual":
 			field := field
@@ -15476,6 +17855,69 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vulnerabilityRange":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vulnerabilityRange(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vulnerabilityRangeList":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vulnerabilityRangeList(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "vulnerabilityRangeMatches":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_vulnerabilityRangeMatches(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
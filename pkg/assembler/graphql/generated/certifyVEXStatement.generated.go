@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -18,10 +19,112 @@ import (
 
 // region    ************************** generated!.gotpl **************************
 
+type SubscriptionResolver interface {
+	VexStatusChanged(ctx context.Context, subject *model.PackageOrArtifactSpec) (<-chan *model.CertifyVEXStatement, error)
+	CertifyVulnIngested(ctx context.Context, pkgSpec *model.PkgSpec) (<-chan *model.CertifyVuln, error)
+	HasSBOMIngested(ctx context.Context, subject *model.PackageOrArtifactSpec) (<-chan *model.HasSbom, error)
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Subscription_certifyVulnIngested_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Subscription_certifyVulnIngested_argsPkgSpec(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["pkgSpec"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_certifyVulnIngested_argsPkgSpec(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*model.PkgSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["pkgSpec"]
+	if !ok {
+		var zeroVal *model.PkgSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("pkgSpec"))
+	if tmp, ok := rawArgs["pkgSpec"]; ok {
+		return ec.unmarshalOPkgSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPkgSpec(ctx, tmp)
+	}
+
+	var zeroVal *model.PkgSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_hasSBOMIngested_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Subscription_hasSBOMIngested_argsSubject(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["subject"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_hasSBOMIngested_argsSubject(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*model.PackageOrArtifactSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["subject"]
+	if !ok {
+		var zeroVal *model.PackageOrArtifactSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalOPackageOrArtifactSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageOrArtifactSpec(ctx, tmp)
+	}
+
+	var zeroVal *model.PackageOrArtifactSpec
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Subscription_vexStatusChanged_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Subscription_vexStatusChanged_argsSubject(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["subject"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Subscription_vexStatusChanged_argsSubject(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*model.PackageOrArtifactSpec, error) {
+	// We won't call the directive if the argument is null.
+	// Set call_argument_directives_with_null to true to call directives
+	// even if the argument is null.
+	_, ok := rawArgs["subject"]
+	if !ok {
+		var zeroVal *model.PackageOrArtifactSpec
+		return zeroVal, nil
+	}
+
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("subject"))
+	if tmp, ok := rawArgs["subject"]; ok {
+		return ec.unmarshalOPackageOrArtifactSpec2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐPackageOrArtifactSpec(ctx, tmp)
+	}
+
+	var zeroVal *model.PackageOrArtifactSpec
+	return zeroVal, nil
+}
+
 // endregion ***************************** args.gotpl *****************************
 
 // region    ************************** directives.gotpl **************************
@@ -1866,6 +1969,280 @@ func (ec *executionContext) fieldContext_ReachableCode_UsedArtifacts(_ context.C
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_vexStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_vexStatusChanged(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().VexStatusChanged(rctx, fc.Args["subject"].(*model.PackageOrArtifactSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.CertifyVEXStatement):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNCertifyVEXStatement2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVEXStatement(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_vexStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CertifyVEXStatement_id(ctx, field)
+			case "subject":
+				return ec.fieldContext_CertifyVEXStatement_subject(ctx, field)
+			case "vulnerability":
+				return ec.fieldContext_CertifyVEXStatement_vulnerability(ctx, field)
+			case "status":
+				return ec.fieldContext_CertifyVEXStatement_status(ctx, field)
+			case "vexJustification":
+				return ec.fieldContext_CertifyVEXStatement_vexJustification(ctx, field)
+			case "statement":
+				return ec.fieldContext_CertifyVEXStatement_statement(ctx, field)
+			case "statusNotes":
+				return ec.fieldContext_CertifyVEXStatement_statusNotes(ctx, field)
+			case "knownSince":
+				return ec.fieldContext_CertifyVEXStatement_knownSince(ctx, field)
+			case "origin":
+				return ec.fieldContext_CertifyVEXStatement_origin(ctx, field)
+			case "collector":
+				return ec.fieldContext_CertifyVEXStatement_collector(ctx, field)
+			case "documentRef":
+				return ec.fieldContext_CertifyVEXStatement_documentRef(ctx, field)
+			case "description":
+				return ec.fieldContext_CertifyVEXStatement_description(ctx, field)
+			case "cvss":
+				return ec.fieldContext_CertifyVEXStatement_cvss(ctx, field)
+			case "cwe":
+				return ec.fieldContext_CertifyVEXStatement_cwe(ctx, field)
+			case "reachableCode":
+				return ec.fieldContext_CertifyVEXStatement_reachableCode(ctx, field)
+			case "exploits":
+				return ec.fieldContext_CertifyVEXStatement_exploits(ctx, field)
+			case "priority":
+				return ec.fieldContext_CertifyVEXStatement_priority(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CertifyVEXStatement", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_vexStatusChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_certifyVulnIngested(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_certifyVulnIngested(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().CertifyVulnIngested(rctx, fc.Args["pkgSpec"].(*model.PkgSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.CertifyVuln):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNCertifyVuln2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVuln(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_certifyVulnIngested(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CertifyVuln_id(ctx, field)
+			case "package":
+				return ec.fieldContext_CertifyVuln_package(ctx, field)
+			case "vulnerability":
+				return ec.fieldContext_CertifyVuln_vulnerability(ctx, field)
+			case "metadata":
+				return ec.fieldContext_CertifyVuln_metadata(ctx, field)
+			case "fixRecommendation":
+				return ec.fieldContext_CertifyVuln_fixRecommendation(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CertifyVuln", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_certifyVulnIngested_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_hasSBOMIngested(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_hasSBOMIngested(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().HasSBOMIngested(rctx, fc.Args["subject"].(*model.PackageOrArtifactSpec))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.HasSbom):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNHasSBOM2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSbom(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_hasSBOMIngested(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_HasSBOM_id(ctx, field)
+			case "subject":
+				return ec.fieldContext_HasSBOM_subject(ctx, field)
+			case "uri":
+				return ec.fieldContext_HasSBOM_uri(ctx, field)
+			case "algorithm":
+				return ec.fieldContext_HasSBOM_algorithm(ctx, field)
+			case "digest":
+				return ec.fieldContext_HasSBOM_digest(ctx, field)
+			case "downloadLocation":
+				return ec.fieldContext_HasSBOM_downloadLocation(ctx, field)
+			case "knownSince":
+				return ec.fieldContext_HasSBOM_knownSince(ctx, field)
+			case "origin":
+				return ec.fieldContext_HasSBOM_origin(ctx, field)
+			case "collector":
+				return ec.fieldContext_HasSBOM_collector(ctx, field)
+			case "documentRef":
+				return ec.fieldContext_HasSBOM_documentRef(ctx, field)
+			case "includedSoftware":
+				return ec.fieldContext_HasSBOM_includedSoftware(ctx, field)
+			case "includedDependencies":
+				return ec.fieldContext_HasSBOM_includedDependencies(ctx, field)
+			case "includedOccurrences":
+				return ec.fieldContext_HasSBOM_includedOccurrences(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HasSBOM", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_hasSBOMIngested_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _UsedArtifact_Name(ctx context.Context, field graphql.CollectedField, obj *model.UsedArtifact) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_UsedArtifact_Name(ctx, field)
 	if err != nil {
@@ -3735,6 +4112,30 @@ func (ec *executionContext) _ReachableCode(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "vexStatusChanged":
+		return ec._Subscription_vexStatusChanged(ctx, fields[0])
+	case "certifyVulnIngested":
+		return ec._Subscription_certifyVulnIngested(ctx, fields[0])
+	case "hasSBOMIngested":
+		return ec._Subscription_hasSBOMIngested(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var usedArtifactImplementors = []string{"UsedArtifact"}
 
 func (ec *executionContext) _UsedArtifact(ctx context.Context, sel ast.SelectionSet, obj *model.UsedArtifact) graphql.Marshaler {
@@ -3870,6 +4271,10 @@ func (ec *executionContext) _VEXEdge(ctx context.Context, sel ast.SelectionSet,
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNCertifyVEXStatement2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVEXStatement(ctx context.Context, sel ast.SelectionSet, v model.CertifyVEXStatement) graphql.Marshaler {
+	return ec._CertifyVEXStatement(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNCertifyVEXStatement2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVEXStatementᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CertifyVEXStatement) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
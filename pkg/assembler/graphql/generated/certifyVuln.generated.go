@@ -18,6 +18,10 @@ import (
 
 // region    ************************** generated!.gotpl **************************
 
+type CertifyVulnResolver interface {
+	FixRecommendation(ctx context.Context, obj *model.CertifyVuln) (*model.FixRecommendation, error)
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
@@ -228,6 +232,55 @@ func (ec *executionContext) fieldContext_CertifyVuln_metadata(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _CertifyVuln_fixRecommendation(ctx context.Context, field graphql.CollectedField, obj *model.CertifyVuln) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CertifyVuln_fixRecommendation(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.CertifyVuln().FixRecommendation(rctx, obj)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.FixRecommendation)
+	fc.Result = res
+	return ec.marshalNFixRecommendation2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐFixRecommendation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_CertifyVuln_fixRecommendation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CertifyVuln",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "vulnerabilities":
+				return ec.fieldContext_FixRecommendation_vulnerabilities(ctx, field)
+			case "fixedVersion":
+				return ec.fieldContext_FixRecommendation_fixedVersion(ctx, field)
+			case "paths":
+				return ec.fieldContext_FixRecommendation_paths(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FixRecommendation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CertifyVulnConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.CertifyVulnConnection) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_CertifyVulnConnection_totalCount(ctx, field)
 	if err != nil {
@@ -450,6 +503,8 @@ func (ec *executionContext) fieldContext_CertifyVulnEdge_node(_ context.Context,
 				return ec.fieldContext_CertifyVuln_vulnerability(ctx, field)
 			case "metadata":
 				return ec.fieldContext_CertifyVuln_metadata(ctx, field)
+			case "fixRecommendation":
+				return ec.fieldContext_CertifyVuln_fixRecommendation(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type CertifyVuln", field.Name)
 		},
@@ -984,23 +1039,59 @@ func (ec *executionContext) _CertifyVuln(ctx context.Context, sel ast.SelectionS
 		case "id":
 			out.Values[i] = ec._CertifyVuln_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "package":
 			out.Values[i] = ec._CertifyVuln_package(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "vulnerability":
 			out.Values[i] = ec._CertifyVuln_vulnerability(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
 			}
 		case "metadata":
 			out.Values[i] = ec._CertifyVuln_metadata(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				out.Invalids++
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fixRecommendation":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CertifyVuln_fixRecommendation(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -1195,6 +1286,10 @@ func (ec *executionContext) _ScanMetadata(ctx context.Context, sel ast.Selection
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNCertifyVuln2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVuln(ctx context.Context, sel ast.SelectionSet, v model.CertifyVuln) graphql.Marshaler {
+	return ec._CertifyVuln(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNCertifyVuln2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐCertifyVulnᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CertifyVuln) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -525,6 +525,8 @@ func (ec *executionContext) fieldContext_HasSBOM_includedDependencies(_ context.
 				return ec.fieldContext_IsDependency_dependencyPackage(ctx, field)
 			case "dependencyType":
 				return ec.fieldContext_IsDependency_dependencyType(ctx, field)
+			case "versionRange":
+				return ec.fieldContext_IsDependency_versionRange(ctx, field)
 			case "justification":
 				return ec.fieldContext_IsDependency_justification(ctx, field)
 			case "origin":
@@ -1287,6 +1289,10 @@ func (ec *executionContext) _HasSBOMEdge(ctx context.Context, sel ast.SelectionS
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNHasSBOM2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSbom(ctx context.Context, sel ast.SelectionSet, v model.HasSbom) graphql.Marshaler {
+	return ec._HasSBOM(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNHasSBOM2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐHasSbomᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HasSbom) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
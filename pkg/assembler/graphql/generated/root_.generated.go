@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -33,9 +34,11 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	CertifyVuln() CertifyVulnResolver
 	Mutation() MutationResolver
 	Package() PackageResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -161,6 +164,31 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	CertifyPolicy struct {
+		Collector      func(childComplexity int) int
+		DocumentRef    func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Origin         func(childComplexity int) int
+		PolicyDigest   func(childComplexity int) int
+		PolicyURI      func(childComplexity int) int
+		Result         func(childComplexity int) int
+		Subject        func(childComplexity int) int
+		TimeVerified   func(childComplexity int) int
+		VerifiedLevels func(childComplexity int) int
+		Verifier       func(childComplexity int) int
+	}
+
+	CertifyPolicyConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyPolicyEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyScorecard struct {
 		ID        func(childComplexity int) int
 		Scorecard func(childComplexity int) int
@@ -199,10 +227,11 @@ type ComplexityRoot struct {
 	}
 
 	CertifyVuln struct {
-		ID            func(childComplexity int) int
-		Metadata      func(childComplexity int) int
-		Package       func(childComplexity int) int
-		Vulnerability func(childComplexity int) int
+		FixRecommendation func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Metadata          func(childComplexity int) int
+		Package           func(childComplexity int) int
+		Vulnerability     func(childComplexity int) int
 	}
 
 	CertifyVulnConnection struct {
@@ -216,6 +245,11 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	ClosureEntry struct {
+		Depth func(childComplexity int) int
+		ID    func(childComplexity int) int
+	}
+
 	Consequences struct {
 		Impact     func(childComplexity int) int
 		Likelihood func(childComplexity int) int
@@ -242,6 +276,18 @@ type ComplexityRoot struct {
 		TotalCount func(childComplexity int) int
 	}
 
+	FixPath struct {
+		Allowed      func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		FixedVersion func(childComplexity int) int
+	}
+
+	FixRecommendation struct {
+		FixedVersion    func(childComplexity int) int
+		Paths           func(childComplexity int) int
+		Vulnerabilities func(childComplexity int) int
+	}
+
 	HasMetadata struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -360,6 +406,7 @@ type ComplexityRoot struct {
 		Justification     func(childComplexity int) int
 		Origin            func(childComplexity int) int
 		Package           func(childComplexity int) int
+		VersionRange      func(childComplexity int) int
 	}
 
 	IsDependencyConnection struct {
@@ -426,6 +473,8 @@ type ComplexityRoot struct {
 		IngestCertifyGoods              func(childComplexity int, subjects model.PackageSourceOrArtifactInputs, pkgMatchType model.MatchFlags, certifyGoods []*model.CertifyGoodInputSpec) int
 		IngestCertifyLegal              func(childComplexity int, subject model.PackageOrSourceInput, declaredLicenses []*model.IDorLicenseInput, discoveredLicenses []*model.IDorLicenseInput, certifyLegal model.CertifyLegalInputSpec) int
 		IngestCertifyLegals             func(childComplexity int, subjects model.PackageOrSourceInputs, declaredLicensesList [][]*model.IDorLicenseInput, discoveredLicensesList [][]*model.IDorLicenseInput, certifyLegals []*model.CertifyLegalInputSpec) int
+		IngestCertifyPolicies           func(childComplexity int, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) int
+		IngestCertifyPolicy             func(childComplexity int, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) int
 		IngestCertifyVuln               func(childComplexity int, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, certifyVuln model.ScanMetadataInput) int
 		IngestCertifyVulns              func(childComplexity int, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) int
 		IngestDependencies              func(childComplexity int, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, dependencies []*model.IsDependencyInputSpec) int
@@ -460,6 +509,8 @@ type ComplexityRoot struct {
 		IngestVulnerabilities           func(childComplexity int, vulns []*model.IDorVulnerabilityInput) int
 		IngestVulnerability             func(childComplexity int, vuln model.IDorVulnerabilityInput) int
 		IngestVulnerabilityMetadata     func(childComplexity int, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) int
+		IngestVulnerabilityRange        func(childComplexity int, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) int
+		IngestVulnerabilityRanges       func(childComplexity int, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) int
 	}
 
 	NeighborConnection struct {
@@ -528,6 +579,12 @@ type ComplexityRoot struct {
 		StartCursor func(childComplexity int) int
 	}
 
+	Path struct {
+		Length func(childComplexity int) int
+		Nodes  func(childComplexity int) int
+		Weight func(childComplexity int) int
+	}
+
 	PkgEqual struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -579,6 +636,7 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		AllPaths                       func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) int
 		Artifacts                      func(childComplexity int, artifactSpec model.ArtifactSpec) int
 		ArtifactsList                  func(childComplexity int, artifactSpec model.ArtifactSpec, after *string, first *int) int
 		BatchQueryDepPkgDependency     func(childComplexity int, pkgIDs []string) int
@@ -587,24 +645,31 @@ type ComplexityRoot struct {
 		BatchQuerySubjectPkgDependency func(childComplexity int, pkgIDs []string) int
 		Builders                       func(childComplexity int, builderSpec model.BuilderSpec) int
 		BuildersList                   func(childComplexity int, builderSpec model.BuilderSpec, after *string, first *int) int
+		CallerTenant                   func(childComplexity int) int
 		CertifyBad                     func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
 		CertifyBadList                 func(childComplexity int, certifyBadSpec model.CertifyBadSpec, after *string, first *int) int
 		CertifyGood                    func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
 		CertifyGoodList                func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) int
-		CertifyLegal                   func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
-		CertifyLegalList               func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) int
-		CertifyVEXStatement            func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
-		CertifyVEXStatementList        func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) int
-		CertifyVuln                    func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
-		CertifyVulnList                func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) int
+		CertifyLegal                   func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time) int
+		CertifyLegalList               func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) int
+		CertifyPolicy                  func(childComplexity int, certifyPolicySpec model.CertifyPolicySpec) int
+		CertifyPolicyList              func(childComplexity int, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) int
+		CertifyVEXStatement            func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time) int
+		CertifyVEXStatementList        func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) int
+		CertifyVuln                    func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time) int
+		CertifyVulnList                func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) int
+		ConstrainedPath                func(childComplexity int, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) int
+		DependencyClosure              func(childComplexity int, subject string, maxDepth *int) int
+		DependentClosure               func(childComplexity int, subject string, maxDepth *int) int
 		FindPackagesThatNeedScanning   func(childComplexity int, queryType model.QueryType, lastScan *int) int
 		FindSoftware                   func(childComplexity int, searchText string) int
 		FindSoftwareList               func(childComplexity int, searchText string, after *string, first *int) int
+		FixRecommendation              func(childComplexity int, pkg model.PkgSpec) int
 		HasMetadata                    func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
 		HasMetadataList                func(childComplexity int, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) int
-		HasSBOMList                    func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) int
+		HasSBOMList                    func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) int
 		HasSLSAList                    func(childComplexity int, hasSLSASpec model.HasSLSASpec, after *string, first *int) int
-		HasSbom                        func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
+		HasSbom                        func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time) int
 		HasSlsa                        func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
 		HasSourceAt                    func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
 		HasSourceAtList                func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) int
@@ -628,8 +693,8 @@ type ComplexityRoot struct {
 		PointOfContact                 func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
 		PointOfContactList             func(childComplexity int, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) int
 		QueryPackagesListForScan       func(childComplexity int, pkgIDs []string, after *string, first *int) int
-		Scorecards                     func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
-		ScorecardsList                 func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) int
+		Scorecards                     func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time) int
+		ScorecardsList                 func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) int
 		Sources                        func(childComplexity int, sourceSpec model.SourceSpec) int
 		SourcesList                    func(childComplexity int, sourceSpec model.SourceSpec, after *string, first *int) int
 		VulnEqual                      func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
@@ -638,6 +703,9 @@ type ComplexityRoot struct {
 		VulnerabilityList              func(childComplexity int, vulnSpec model.VulnerabilitySpec, after *string, first *int) int
 		VulnerabilityMetadata          func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
 		VulnerabilityMetadataList      func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) int
+		VulnerabilityRange             func(childComplexity int, vulnerabilityRangeSpec model.VulnerabilityRangeSpec) int
+		VulnerabilityRangeList         func(childComplexity int, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) int
+		VulnerabilityRangeMatches      func(childComplexity int, vulnerabilityRangeSpec model.VulnerabilityRangeSpec) int
 	}
 
 	ReachableCode struct {
@@ -731,6 +799,12 @@ type ComplexityRoot struct {
 		Namespace func(childComplexity int) int
 	}
 
+	Subscription struct {
+		CertifyVulnIngested func(childComplexity int, pkgSpec *model.PkgSpec) int
+		HasSBOMIngested     func(childComplexity int, subject *model.PackageOrArtifactSpec) int
+		VexStatusChanged    func(childComplexity int, subject *model.PackageOrArtifactSpec) int
+	}
+
 	UsedArtifact struct {
 		Name        func(childComplexity int) int
 		UsedInLines func(childComplexity int) int
@@ -815,6 +889,35 @@ type ComplexityRoot struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
+
+	VulnerabilityRange struct {
+		Collector     func(childComplexity int) int
+		DocumentRef   func(childComplexity int) int
+		Fixed         func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Introduced    func(childComplexity int) int
+		LastAffected  func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Package       func(childComplexity int) int
+		RangeType     func(childComplexity int) int
+		Vulnerability func(childComplexity int) int
+	}
+
+	VulnerabilityRangeConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnerabilityRangeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	VulnerabilityRangeMatch struct {
+		Package            func(childComplexity int) int
+		VulnerabilityRange func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -1305,6 +1408,118 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyLegalEdge.Node(childComplexity), true
 
+	case "CertifyPolicy.collector":
+		if e.complexity.CertifyPolicy.Collector == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.Collector(childComplexity), true
+
+	case "CertifyPolicy.documentRef":
+		if e.complexity.CertifyPolicy.DocumentRef == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.DocumentRef(childComplexity), true
+
+	case "CertifyPolicy.id":
+		if e.complexity.CertifyPolicy.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.ID(childComplexity), true
+
+	case "CertifyPolicy.origin":
+		if e.complexity.CertifyPolicy.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.Origin(childComplexity), true
+
+	case "CertifyPolicy.policyDigest":
+		if e.complexity.CertifyPolicy.PolicyDigest == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.PolicyDigest(childComplexity), true
+
+	case "CertifyPolicy.policyUri":
+		if e.complexity.CertifyPolicy.PolicyURI == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.PolicyURI(childComplexity), true
+
+	case "CertifyPolicy.result":
+		if e.complexity.CertifyPolicy.Result == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.Result(childComplexity), true
+
+	case "CertifyPolicy.subject":
+		if e.complexity.CertifyPolicy.Subject == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.Subject(childComplexity), true
+
+	case "CertifyPolicy.timeVerified":
+		if e.complexity.CertifyPolicy.TimeVerified == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.TimeVerified(childComplexity), true
+
+	case "CertifyPolicy.verifiedLevels":
+		if e.complexity.CertifyPolicy.VerifiedLevels == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.VerifiedLevels(childComplexity), true
+
+	case "CertifyPolicy.verifier":
+		if e.complexity.CertifyPolicy.Verifier == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicy.Verifier(childComplexity), true
+
+	case "CertifyPolicyConnection.edges":
+		if e.complexity.CertifyPolicyConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicyConnection.Edges(childComplexity), true
+
+	case "CertifyPolicyConnection.pageInfo":
+		if e.complexity.CertifyPolicyConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicyConnection.PageInfo(childComplexity), true
+
+	case "CertifyPolicyConnection.totalCount":
+		if e.complexity.CertifyPolicyConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicyConnection.TotalCount(childComplexity), true
+
+	case "CertifyPolicyEdge.cursor":
+		if e.complexity.CertifyPolicyEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicyEdge.Cursor(childComplexity), true
+
+	case "CertifyPolicyEdge.node":
+		if e.complexity.CertifyPolicyEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyPolicyEdge.Node(childComplexity), true
+
 	case "CertifyScorecard.id":
 		if e.complexity.CertifyScorecard.ID == nil {
 			break
@@ -1480,6 +1695,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
 
+	case "CertifyVuln.fixRecommendation":
+		if e.complexity.CertifyVuln.FixRecommendation == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.FixRecommendation(childComplexity), true
+
 	case "CertifyVuln.id":
 		if e.complexity.CertifyVuln.ID == nil {
 			break
@@ -1543,6 +1765,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertifyVulnEdge.Node(childComplexity), true
 
+	case "ClosureEntry.depth":
+		if e.complexity.ClosureEntry.Depth == nil {
+			break
+		}
+
+		return e.complexity.ClosureEntry.Depth(childComplexity), true
+
+	case "ClosureEntry.id":
+		if e.complexity.ClosureEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.ClosureEntry.ID(childComplexity), true
+
 	case "Consequences.Impact":
 		if e.complexity.Consequences.Impact == nil {
 			break
@@ -1641,6 +1877,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FindSoftwareConnection.TotalCount(childComplexity), true
 
+	case "FixPath.allowed":
+		if e.complexity.FixPath.Allowed == nil {
+			break
+		}
+
+		return e.complexity.FixPath.Allowed(childComplexity), true
+
+	case "FixPath.dependencies":
+		if e.complexity.FixPath.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.FixPath.Dependencies(childComplexity), true
+
+	case "FixPath.fixedVersion":
+		if e.complexity.FixPath.FixedVersion == nil {
+			break
+		}
+
+		return e.complexity.FixPath.FixedVersion(childComplexity), true
+
+	case "FixRecommendation.fixedVersion":
+		if e.complexity.FixRecommendation.FixedVersion == nil {
+			break
+		}
+
+		return e.complexity.FixRecommendation.FixedVersion(childComplexity), true
+
+	case "FixRecommendation.paths":
+		if e.complexity.FixRecommendation.Paths == nil {
+			break
+		}
+
+		return e.complexity.FixRecommendation.Paths(childComplexity), true
+
+	case "FixRecommendation.vulnerabilities":
+		if e.complexity.FixRecommendation.Vulnerabilities == nil {
+			break
+		}
+
+		return e.complexity.FixRecommendation.Vulnerabilities(childComplexity), true
+
 	case "HasMetadata.collector":
 		if e.complexity.HasMetadata.Collector == nil {
 			break
@@ -2145,6 +2423,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.IsDependency.Package(childComplexity), true
 
+	case "IsDependency.versionRange":
+		if e.complexity.IsDependency.VersionRange == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.VersionRange(childComplexity), true
+
 	case "IsDependencyConnection.edges":
 		if e.complexity.IsDependencyConnection.Edges == nil {
 			break
@@ -2483,6 +2768,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestCertifyLegals(childComplexity, args["subjects"].(model.PackageOrSourceInputs), args["declaredLicensesList"].([][]*model.IDorLicenseInput), args["discoveredLicensesList"].([][]*model.IDorLicenseInput), args["certifyLegals"].([]*model.CertifyLegalInputSpec)), true
 
+	case "Mutation.ingestCertifyPolicies":
+		if e.complexity.Mutation.IngestCertifyPolicies == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyPolicies_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyPolicies(childComplexity, args["subjects"].([]*model.IDorArtifactInput), args["certifyPolicies"].([]*model.CertifyPolicyInputSpec)), true
+
+	case "Mutation.ingestCertifyPolicy":
+		if e.complexity.Mutation.IngestCertifyPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyPolicy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyPolicy(childComplexity, args["subject"].(model.IDorArtifactInput), args["certifyPolicy"].(model.CertifyPolicyInputSpec)), true
+
 	case "Mutation.ingestCertifyVuln":
 		if e.complexity.Mutation.IngestCertifyVuln == nil {
 			break
@@ -2891,6 +3200,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestVulnerabilityMetadata(childComplexity, args["vulnerability"].(model.IDorVulnerabilityInput), args["vulnerabilityMetadata"].(model.VulnerabilityMetadataInputSpec)), true
 
+	case "Mutation.ingestVulnerabilityRange":
+		if e.complexity.Mutation.IngestVulnerabilityRange == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestVulnerabilityRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestVulnerabilityRange(childComplexity, args["pkg"].(model.IDorPkgInput), args["vulnerability"].(model.IDorVulnerabilityInput), args["vulnerabilityRange"].(model.VulnerabilityRangeInputSpec)), true
+
+	case "Mutation.ingestVulnerabilityRanges":
+		if e.complexity.Mutation.IngestVulnerabilityRanges == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestVulnerabilityRanges_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestVulnerabilityRanges(childComplexity, args["pkgs"].([]*model.IDorPkgInput), args["vulnerabilities"].([]*model.IDorVulnerabilityInput), args["vulnerabilityRanges"].([]*model.VulnerabilityRangeInputSpec)), true
+
 	case "NeighborConnection.edges":
 		if e.complexity.NeighborConnection.Edges == nil {
 			break
@@ -3122,6 +3455,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PageInfo.StartCursor(childComplexity), true
 
+	case "Path.length":
+		if e.complexity.Path.Length == nil {
+			break
+		}
+
+		return e.complexity.Path.Length(childComplexity), true
+
+	case "Path.nodes":
+		if e.complexity.Path.Nodes == nil {
+			break
+		}
+
+		return e.complexity.Path.Nodes(childComplexity), true
+
+	case "Path.weight":
+		if e.complexity.Path.Weight == nil {
+			break
+		}
+
+		return e.complexity.Path.Weight(childComplexity), true
+
 	case "PkgEqual.collector":
 		if e.complexity.PkgEqual.Collector == nil {
 			break
@@ -3325,6 +3679,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PotentialMitigations.Phase(childComplexity), true
 
+	case "Query.allPaths":
+		if e.complexity.Query.AllPaths == nil {
+			break
+		}
+
+		args, err := ec.field_Query_allPaths_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AllPaths(childComplexity, args["subject"].(string), args["target"].(string), args["maxPathLength"].(int), args["usingOnly"].([]model.Edge), args["weights"].([]*model.EdgeWeight), args["first"].(*int)), true
+
 	case "Query.artifacts":
 		if e.complexity.Query.Artifacts == nil {
 			break
@@ -3421,6 +3787,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.BuildersList(childComplexity, args["builderSpec"].(model.BuilderSpec), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.callerTenant":
+		if e.complexity.Query.CallerTenant == nil {
+			break
+		}
+
+		return e.complexity.Query.CallerTenant(childComplexity), true
+
 	case "Query.CertifyBad":
 		if e.complexity.Query.CertifyBad == nil {
 			break
@@ -3479,7 +3852,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyLegal(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec)), true
+		return e.complexity.Query.CertifyLegal(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec), args["asOf"].(*time.Time)), true
 
 	case "Query.CertifyLegalList":
 		if e.complexity.Query.CertifyLegalList == nil {
@@ -3491,7 +3864,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyLegalList(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec), args["after"].(*string), args["first"].(*int)), true
+		return e.complexity.Query.CertifyLegalList(childComplexity, args["certifyLegalSpec"].(model.CertifyLegalSpec), args["asOf"].(*time.Time), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.CertifyPolicy":
+		if e.complexity.Query.CertifyPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyPolicy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyPolicy(childComplexity, args["certifyPolicySpec"].(model.CertifyPolicySpec)), true
+
+	case "Query.CertifyPolicyList":
+		if e.complexity.Query.CertifyPolicyList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_CertifyPolicyList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertifyPolicyList(childComplexity, args["certifyPolicySpec"].(model.CertifyPolicySpec), args["after"].(*string), args["first"].(*int)), true
 
 	case "Query.CertifyVEXStatement":
 		if e.complexity.Query.CertifyVEXStatement == nil {
@@ -3503,7 +3900,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec)), true
+		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), args["asOf"].(*time.Time)), true
 
 	case "Query.CertifyVEXStatementList":
 		if e.complexity.Query.CertifyVEXStatementList == nil {
@@ -3515,7 +3912,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyVEXStatementList(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), args["after"].(*string), args["first"].(*int)), true
+		return e.complexity.Query.CertifyVEXStatementList(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), args["asOf"].(*time.Time), args["after"].(*string), args["first"].(*int)), true
 
 	case "Query.CertifyVuln":
 		if e.complexity.Query.CertifyVuln == nil {
@@ -3527,7 +3924,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec)), true
+		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["asOf"].(*time.Time)), true
 
 	case "Query.CertifyVulnList":
 		if e.complexity.Query.CertifyVulnList == nil {
@@ -3539,7 +3936,43 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["after"].(*string), args["first"].(*int)), true
+		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["asOf"].(*time.Time), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.constrainedPath":
+		if e.complexity.Query.ConstrainedPath == nil {
+			break
+		}
+
+		args, err := ec.field_Query_constrainedPath_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ConstrainedPath(childComplexity, args["subject"].(string), args["target"].(string), args["segments"].([]*model.PathSegment), args["maxPathLength"].(int), args["first"].(*int)), true
+
+	case "Query.dependencyClosure":
+		if e.complexity.Query.DependencyClosure == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dependencyClosure_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DependencyClosure(childComplexity, args["subject"].(string), args["maxDepth"].(*int)), true
+
+	case "Query.dependentClosure":
+		if e.complexity.Query.DependentClosure == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dependentClosure_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DependentClosure(childComplexity, args["subject"].(string), args["maxDepth"].(*int)), true
 
 	case "Query.findPackagesThatNeedScanning":
 		if e.complexity.Query.FindPackagesThatNeedScanning == nil {
@@ -3577,21 +4010,33 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.FindSoftwareList(childComplexity, args["searchText"].(string), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.HasMetadata":
-		if e.complexity.Query.HasMetadata == nil {
+	case "Query.fixRecommendation":
+		if e.complexity.Query.FixRecommendation == nil {
 			break
 		}
 
-		args, err := ec.field_Query_HasMetadata_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_fixRecommendation_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.HasMetadata(childComplexity, args["hasMetadataSpec"].(model.HasMetadataSpec)), true
+		return e.complexity.Query.FixRecommendation(childComplexity, args["pkg"].(model.PkgSpec)), true
 
-	case "Query.HasMetadataList":
-		if e.complexity.Query.HasMetadataList == nil {
-			break
+	case "Query.HasMetadata":
+		if e.complexity.Query.HasMetadata == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasMetadata_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasMetadata(childComplexity, args["hasMetadataSpec"].(model.HasMetadataSpec)), true
+
+	case "Query.HasMetadataList":
+		if e.complexity.Query.HasMetadataList == nil {
+			break
 		}
 
 		args, err := ec.field_Query_HasMetadataList_args(context.TODO(), rawArgs)
@@ -3611,7 +4056,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.HasSBOMList(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec), args["after"].(*string), args["first"].(*int)), true
+		return e.complexity.Query.HasSBOMList(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec), args["asOf"].(*time.Time), args["after"].(*string), args["first"].(*int)), true
 
 	case "Query.HasSLSAList":
 		if e.complexity.Query.HasSLSAList == nil {
@@ -3635,7 +4080,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.HasSbom(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec)), true
+		return e.complexity.Query.HasSbom(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec), args["asOf"].(*time.Time)), true
 
 	case "Query.HasSLSA":
 		if e.complexity.Query.HasSlsa == nil {
@@ -3923,7 +4368,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec)), true
+		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec), args["asOf"].(*time.Time)), true
 
 	case "Query.scorecardsList":
 		if e.complexity.Query.ScorecardsList == nil {
@@ -3935,7 +4380,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.ScorecardsList(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec), args["after"].(*string), args["first"].(*int)), true
+		return e.complexity.Query.ScorecardsList(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec), args["asOf"].(*time.Time), args["after"].(*string), args["first"].(*int)), true
 
 	case "Query.sources":
 		if e.complexity.Query.Sources == nil {
@@ -4033,6 +4478,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.VulnerabilityMetadataList(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.vulnerabilityRange":
+		if e.complexity.Query.VulnerabilityRange == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilityRange_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnerabilityRange(childComplexity, args["vulnerabilityRangeSpec"].(model.VulnerabilityRangeSpec)), true
+
+	case "Query.vulnerabilityRangeList":
+		if e.complexity.Query.VulnerabilityRangeList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilityRangeList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnerabilityRangeList(childComplexity, args["vulnerabilityRangeSpec"].(model.VulnerabilityRangeSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.vulnerabilityRangeMatches":
+		if e.complexity.Query.VulnerabilityRangeMatches == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilityRangeMatches_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnerabilityRangeMatches(childComplexity, args["vulnerabilityRangeSpec"].(model.VulnerabilityRangeSpec)), true
+
 	case "ReachableCode.PathToFile":
 		if e.complexity.ReachableCode.PathToFile == nil {
 			break
@@ -4397,6 +4878,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceNamespace.Namespace(childComplexity), true
 
+	case "Subscription.certifyVulnIngested":
+		if e.complexity.Subscription.CertifyVulnIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyVulnIngested_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVulnIngested(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+
+	case "Subscription.hasSBOMIngested":
+		if e.complexity.Subscription.HasSBOMIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_hasSBOMIngested_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.HasSBOMIngested(childComplexity, args["subject"].(*model.PackageOrArtifactSpec)), true
+
+	case "Subscription.vexStatusChanged":
+		if e.complexity.Subscription.VexStatusChanged == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_vexStatusChanged_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.VexStatusChanged(childComplexity, args["subject"].(*model.PackageOrArtifactSpec)), true
+
 	case "UsedArtifact.Name":
 		if e.complexity.UsedArtifact.Name == nil {
 			break
@@ -4698,6 +5215,125 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadataEdge.Node(childComplexity), true
 
+	case "VulnerabilityRange.collector":
+		if e.complexity.VulnerabilityRange.Collector == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.Collector(childComplexity), true
+
+	case "VulnerabilityRange.documentRef":
+		if e.complexity.VulnerabilityRange.DocumentRef == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.DocumentRef(childComplexity), true
+
+	case "VulnerabilityRange.fixed":
+		if e.complexity.VulnerabilityRange.Fixed == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.Fixed(childComplexity), true
+
+	case "VulnerabilityRange.id":
+		if e.complexity.VulnerabilityRange.ID == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.ID(childComplexity), true
+
+	case "VulnerabilityRange.introduced":
+		if e.complexity.VulnerabilityRange.Introduced == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.Introduced(childComplexity), true
+
+	case "VulnerabilityRange.lastAffected":
+		if e.complexity.VulnerabilityRange.LastAffected == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.LastAffected(childComplexity), true
+
+	case "VulnerabilityRange.origin":
+		if e.complexity.VulnerabilityRange.Origin == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.Origin(childComplexity), true
+
+	case "VulnerabilityRange.package":
+		if e.complexity.VulnerabilityRange.Package == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.Package(childComplexity), true
+
+	case "VulnerabilityRange.rangeType":
+		if e.complexity.VulnerabilityRange.RangeType == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.RangeType(childComplexity), true
+
+	case "VulnerabilityRange.vulnerability":
+		if e.complexity.VulnerabilityRange.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRange.Vulnerability(childComplexity), true
+
+	case "VulnerabilityRangeConnection.edges":
+		if e.complexity.VulnerabilityRangeConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeConnection.Edges(childComplexity), true
+
+	case "VulnerabilityRangeConnection.pageInfo":
+		if e.complexity.VulnerabilityRangeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeConnection.PageInfo(childComplexity), true
+
+	case "VulnerabilityRangeConnection.totalCount":
+		if e.complexity.VulnerabilityRangeConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeConnection.TotalCount(childComplexity), true
+
+	case "VulnerabilityRangeEdge.cursor":
+		if e.complexity.VulnerabilityRangeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeEdge.Cursor(childComplexity), true
+
+	case "VulnerabilityRangeEdge.node":
+		if e.complexity.VulnerabilityRangeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeEdge.Node(childComplexity), true
+
+	case "VulnerabilityRangeMatch.package":
+		if e.complexity.VulnerabilityRangeMatch.Package == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeMatch.Package(childComplexity), true
+
+	case "VulnerabilityRangeMatch.vulnerabilityRange":
+		if e.complexity.VulnerabilityRangeMatch.VulnerabilityRange == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityRangeMatch.VulnerabilityRange(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -4720,6 +5356,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCertifyGoodSpec,
 		ec.unmarshalInputCertifyLegalInputSpec,
 		ec.unmarshalInputCertifyLegalSpec,
+		ec.unmarshalInputCertifyPolicyInputSpec,
+		ec.unmarshalInputCertifyPolicySpec,
 		ec.unmarshalInputCertifyScorecardSpec,
 		ec.unmarshalInputCertifyVEXStatementSpec,
 		ec.unmarshalInputCertifyVulnSpec,
@@ -4727,6 +5365,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputConsequencesInputSpec,
 		ec.unmarshalInputDetectionMethodsInput,
 		ec.unmarshalInputDetectionMethodsInputSpec,
+		ec.unmarshalInputEdgeWeight,
 		ec.unmarshalInputExploitsInput,
 		ec.unmarshalInputExploitsInputSpec,
 		ec.unmarshalInputHasMetadataInputSpec,
@@ -4763,6 +5402,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputPackageSourceOrArtifactInput,
 		ec.unmarshalInputPackageSourceOrArtifactInputs,
 		ec.unmarshalInputPackageSourceOrArtifactSpec,
+		ec.unmarshalInputPathSegment,
 		ec.unmarshalInputPkgEqualInputSpec,
 		ec.unmarshalInputPkgEqualSpec,
 		ec.unmarshalInputPkgInputSpec,
@@ -4790,6 +5430,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputVulnerabilityInputSpec,
 		ec.unmarshalInputVulnerabilityMetadataInputSpec,
 		ec.unmarshalInputVulnerabilityMetadataSpec,
+		ec.unmarshalInputVulnerabilityRangeInputSpec,
+		ec.unmarshalInputVulnerabilityRangeSpec,
 		ec.unmarshalInputVulnerabilitySpec,
 	)
 	first := true
@@ -4836,6 +5478,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -5534,9 +6193,19 @@ type CertifyLegalEdge {
 
 extend type Query {
   "Returns all legal certifications matching the input filter."
-  CertifyLegal(certifyLegalSpec: CertifyLegalSpec!): [CertifyLegal!]!
+  CertifyLegal(
+    certifyLegalSpec: CertifyLegalSpec!
+    "Returns the latest legal certification of each subject scanned by this time."
+    asOf: Time
+  ): [CertifyLegal!]!
   "Returns a paginated results via CertifyLegalConnection"
-  CertifyLegalList(certifyLegalSpec: CertifyLegalSpec!, after: ID, first: Int): CertifyLegalConnection
+  CertifyLegalList(
+    certifyLegalSpec: CertifyLegalSpec!
+    "Returns the latest legal certification of each subject scanned by this time."
+    asOf: Time
+    after: ID
+    first: Int
+  ): CertifyLegalConnection
   "Batch queries via pkgVersion IDs to find all CertifyLegal (latest timestamp)"
   BatchQueryPkgIDCertifyLegal(pkgIDs: [ID!]!): [CertifyLegal!]!
 }
@@ -5547,6 +6216,146 @@ extend type Mutation {
   "Bulk add legal certifications to packages or sources, not both at same time."
   ingestCertifyLegals(subjects: PackageOrSourceInputs!, declaredLicensesList: [[IDorLicenseInput!]!]!, discoveredLicensesList: [[IDorLicenseInput!]!]!, certifyLegals: [CertifyLegalInputSpec!]!): [ID!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/certifyPolicy.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyPolicy
+
+"""
+CertifyPolicy records the verdict of a verifier that checked an artifact
+against a policy, as found in a SLSA Verification Summary Attestation (VSA).
+
+The policy is identified by its URI and by its digest, so the version of the
+policy used for the verification is recorded along with the verdict.
+"""
+type CertifyPolicy {
+  id: ID!
+  "The artifact that was verified"
+  subject: Artifact!
+  "URI identifying the verifier"
+  verifier: String!
+  "URI of the policy the subject was verified against"
+  policyUri: String!
+  "Digest of the policy, in algorithm:digest form (empty if not known)"
+  policyDigest: String!
+  "Result of the verification"
+  result: PolicyVerificationResult!
+  "SLSA levels the subject was verified at (e.g. SLSA_BUILD_LEVEL_2)"
+  verifiedLevels: [String!]!
+  "Timestamp when the verification was performed (in RFC 3339 format)"
+  timeVerified: Time!
+  "Document from which this attestation is generated from"
+  origin: String!
+  "GUAC collector for the document"
+  collector: String!
+  "Reference location of the document in the persistent blob store (if that is configured)"
+  documentRef: String!
+}
+
+"PolicyVerificationResult is the outcome of a policy verification."
+enum PolicyVerificationResult {
+  PASSED
+  FAILED
+}
+
+"""
+CertifyPolicySpec allows filtering the list of CertifyPolicy evidence to
+return in a query.
+
+If verifiedLevels is specified, the returned values must have been verified at
+all of the given levels.
+
+If timeVerified is specified, the returned value will be after or equal to the
+specified time.
+"""
+input CertifyPolicySpec {
+  id: ID
+  subject: ArtifactSpec
+  verifier: String
+  policyUri: String
+  policyDigest: String
+  result: PolicyVerificationResult
+  verifiedLevels: [String!]
+  timeVerified: Time
+  origin: String
+  collector: String
+  documentRef: String
+}
+
+"CertifyPolicyInputSpec represents the mutation input to ingest a CertifyPolicy evidence."
+input CertifyPolicyInputSpec {
+  verifier: String!
+  policyUri: String!
+  policyDigest: String!
+  result: PolicyVerificationResult!
+  verifiedLevels: [String!]!
+  timeVerified: Time!
+  origin: String!
+  collector: String!
+  documentRef: String!
+}
+
+"""
+CertifyPolicyConnection returns the paginated results for CertifyPolicy.
+
+totalCount is the total number of results returned.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the CertifyPolicyEdge which contains the current cursor
+and the CertifyPolicy node itself
+"""
+type CertifyPolicyConnection {
+    totalCount: Int!
+    pageInfo: PageInfo!
+    edges: [CertifyPolicyEdge!]!
+}
+
+"""
+CertifyPolicyEdge contains the cursor for the resulting node and
+the CertifyPolicy node itself.
+"""
+type CertifyPolicyEdge {
+  cursor: ID!
+  node: CertifyPolicy!
+}
+
+extend type Query {
+  "Returns all policy verifications matching the filter."
+  CertifyPolicy(certifyPolicySpec: CertifyPolicySpec!): [CertifyPolicy!]!
+  "Returns a paginated results via CertifyPolicyConnection"
+  CertifyPolicyList(certifyPolicySpec: CertifyPolicySpec!, after: ID, first: Int): CertifyPolicyConnection
+}
+
+extend type Mutation {
+  "Adds a policy verification of an artifact. The returned ID can be empty string."
+  ingestCertifyPolicy(
+    subject: IDorArtifactInput!
+    certifyPolicy: CertifyPolicyInputSpec!
+  ): ID!
+  "Bulk ingest policy verifications of artifacts. The returned array of IDs can be a an array of empty string."
+  ingestCertifyPolicies(
+    subjects: [IDorArtifactInput!]!
+    certifyPolicies: [CertifyPolicyInputSpec!]!
+  ): [ID!]!
+}
 `, BuiltIn: false},
 	{Name: "../schema/certifyScorecard.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -5702,9 +6511,19 @@ type CertifyScorecardEdge {
 
 extend type Query {
   "Returns all Scorecard certifications matching the filter."
-  scorecards(scorecardSpec: CertifyScorecardSpec!): [CertifyScorecard!]!
+  scorecards(
+    scorecardSpec: CertifyScorecardSpec!
+    "Returns the latest scorecard of each source computed by this time."
+    asOf: Time
+  ): [CertifyScorecard!]!
   "Returns a paginated results via CertifyScorecardConnection"
-  scorecardsList(scorecardSpec: CertifyScorecardSpec!, after: ID, first: Int): CertifyScorecardConnection
+  scorecardsList(
+    scorecardSpec: CertifyScorecardSpec!
+    "Returns the latest scorecard of each source computed by this time."
+    asOf: Time
+    after: ID
+    first: Int
+  ): CertifyScorecardConnection
 }
 
 extend type Mutation {
@@ -6091,9 +6910,17 @@ extend type Query {
   "Returns all VEX certifications matching the input filter."
   CertifyVEXStatement(
     certifyVEXStatementSpec: CertifyVEXStatementSpec!
+    "Returns the statements known at this time, hiding those superseded by a later statement about the same subject and vulnerability."
+    asOf: Time
   ): [CertifyVEXStatement!]!
   "Returns a paginated results via CertifyVexConnection"
-  CertifyVEXStatementList(certifyVEXStatementSpec: CertifyVEXStatementSpec!, after: ID, first: Int): VEXConnection
+  CertifyVEXStatementList(
+    certifyVEXStatementSpec: CertifyVEXStatementSpec!
+    "Returns the statements known at this time, hiding those superseded by a later statement about the same subject and vulnerability."
+    asOf: Time
+    after: ID
+    first: Int
+  ): VEXConnection
 }
 
 extend type Mutation {
@@ -6110,6 +6937,18 @@ extend type Mutation {
     vexStatements: [VexStatementInputSpec!]!
   ): [ID!]!
 }
+
+extend type Subscription {
+  """
+  Streams VEX statements that change the status of a vulnerability for a
+  subject, that is statements whose status differs from the latest earlier
+  statement for the same subject and vulnerability (or that are the first
+  one).
+
+  If subject is set, only statements for matching subjects are sent.
+  """
+  vexStatusChanged(subject: PackageOrArtifactSpec): CertifyVEXStatement!
+}
 `, BuiltIn: false},
 	{Name: "../schema/certifyVuln.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -6239,9 +7078,19 @@ type CertifyVulnEdge {
 
 extend type Query {
   "Returns all vulnerability certifications matching the input filter."
-  CertifyVuln(certifyVulnSpec: CertifyVulnSpec!): [CertifyVuln!]!
+  CertifyVuln(
+    certifyVulnSpec: CertifyVulnSpec!
+    "Returns the results of the latest scan of each package by each scanner at this time."
+    asOf: Time
+  ): [CertifyVuln!]!
   "Returns a paginated results via CertifyVulnConnection"
-  CertifyVulnList(certifyVulnSpec: CertifyVulnSpec!, after: ID, first: Int): CertifyVulnConnection
+  CertifyVulnList(
+    certifyVulnSpec: CertifyVulnSpec!
+    "Returns the results of the latest scan of each package by each scanner at this time."
+    asOf: Time
+    after: ID
+    first: Int
+  ): CertifyVulnConnection
   "Batch queries via pkgVersion IDs to find all CertifyVulns (latest timestamp, including any ` + "`" + `novuln` + "`" + `)"
   BatchQueryPkgIDCertifyVuln(pkgIDs: [ID!]!): [CertifyVuln!]!
 }
@@ -6260,6 +7109,68 @@ extend type Mutation {
     certifyVulns: [ScanMetadataInput!]!
   ): [ID!]!
 }
+
+extend type Subscription {
+  """
+  Streams vulnerability certifications as they are ingested.
+
+  If pkgSpec is set, only certifications for packages matching it are sent.
+  """
+  certifyVulnIngested(pkgSpec: PkgSpec): CertifyVuln!
+}
+`, BuiltIn: false},
+	{Name: "../schema/closure.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL operations to compute transitive dependencies in a single
+# query.
+
+"""
+ClosureEntry is a package version or artifact returned by dependencyClosure or
+dependentClosure, with the number of dependency links between it and the
+subject of the query.
+"""
+type ClosureEntry {
+  id: ID!
+  depth: Int!
+}
+
+extend type Query {
+  """
+  dependencyClosure returns the package versions and artifacts that subject
+  depends on, directly or transitively, up to maxDepth dependency links.
+
+  The dependency links are the dependency packages of IsDependency, the software
+  included in HasSBOM and the materials of HasSLSA. Packages and artifacts
+  linked by IsOccurrence or HashEqual are equivalent: following them does not
+  add to the depth, and the nodes equivalent to subject are not returned.
+
+  The result is ordered by depth.
+  """
+  dependencyClosure(subject: ID!, maxDepth: Int): [ClosureEntry!]!
+
+  """
+  dependentClosure returns the package versions and artifacts that depend on
+  subject, directly or transitively, up to maxDepth dependency links. It
+  follows the links of dependencyClosure backwards.
+  """
+  dependentClosure(subject: ID!, maxDepth: Int): [ClosureEntry!]!
+}
 `, BuiltIn: false},
 	{Name: "../schema/contact.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -6458,6 +7369,74 @@ enum FilterOperation {
   CONTAINS
   STARTSWITH
 }`, BuiltIn: false},
+	{Name: "../schema/fixRecommendation.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for recommending upgrades of vulnerable packages
+
+"""
+FixRecommendation is the minimal upgrade of a package version that clears all
+the vulnerabilities known to affect the package.
+
+The vulnerable versions are those included in the ingested vulnerability
+ranges of the package and those certified vulnerable. The candidate versions
+are the versions of the package present in the graph and the fixed versions of
+its ranges.
+"""
+type FixRecommendation {
+  "The vulnerabilities known to affect the package version"
+  vulnerabilities: [Vulnerability!]!
+  "The minimal later version not known to be vulnerable, empty if the package version is not known to be vulnerable or there is no such version"
+  fixedVersion: String!
+  "The upgrade along each dependency path leading to the package version"
+  paths: [FixPath!]!
+}
+
+"""
+FixPath is the upgrade of a vulnerable package along one dependency path.
+
+The dependencies go from the root of the path down to the vulnerable package,
+so the last one is the dependency of the parent package whose version range
+constrains the upgrade. An empty version range allows any version.
+"""
+type FixPath {
+  "The dependencies from the root of the path down to the package version"
+  dependencies: [IsDependency!]!
+  "Whether the version range of the parent dependency allows the recommended fixed version"
+  allowed: Boolean!
+  "The minimal later version not known to be vulnerable that the version range of the parent dependency allows, empty if there is none"
+  fixedVersion: String!
+}
+
+extend type CertifyVuln {
+  "The minimal upgrade of the certified package version clearing all its known vulnerabilities"
+  fixRecommendation: FixRecommendation!
+}
+
+extend type Query {
+  """
+  fixRecommendation returns the minimal upgrade of a package version clearing
+  all its known vulnerabilities, whether or not a vulnerability is certified
+  for it. The package spec must match exactly one package version.
+  """
+  fixRecommendation(pkg: PkgSpec!): FixRecommendation!
+}
+`, BuiltIn: false},
 	{Name: "../schema/hasSBOM.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
 #
@@ -6577,9 +7556,19 @@ type HasSBOMEdge {
 
 extend type Query {
   "Returns all SBOM certifications."
-  HasSBOM(hasSBOMSpec: HasSBOMSpec!): [HasSBOM!]!
+  HasSBOM(
+    hasSBOMSpec: HasSBOMSpec!
+    "Returns the SBOMs known at this time, hiding those superseded by a later SBOM of the same subject."
+    asOf: Time
+  ): [HasSBOM!]!
   "Returns a paginated results via HasSBOMConnection"
-  HasSBOMList(hasSBOMSpec: HasSBOMSpec!, after: ID, first: Int): HasSBOMConnection
+  HasSBOMList(
+    hasSBOMSpec: HasSBOMSpec!
+    "Returns the SBOMs known at this time, hiding those superseded by a later SBOM of the same subject."
+    asOf: Time
+    after: ID
+    first: Int
+  ): HasSBOMConnection
 }
 
 extend type Mutation {
@@ -6596,6 +7585,15 @@ extend type Mutation {
     includes: [HasSBOMIncludesInputSpec!]!
   ): [ID!]!
 }
+
+extend type Subscription {
+  """
+  Streams SBOM certifications as they are ingested.
+
+  If subject is set, only SBOMs for matching subjects are sent.
+  """
+  hasSBOMIngested(subject: PackageOrArtifactSpec): HasSBOM!
+}
 `, BuiltIn: false},
 	{Name: "../schema/hasSLSA.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -7036,6 +8034,8 @@ type IsDependency {
   dependencyPackage: Package!
   "Type of dependency"
   dependencyType: DependencyType!
+  "Version range of the dependency package allowed by the package, empty if not known"
+  versionRange: String!
   "Justification for the attested relationship"
   justification: String!
   "Document from which this attestation is generated from"
@@ -7059,6 +8059,7 @@ input IsDependencySpec {
   package: PkgSpec
   dependencyPackage: PkgSpec
   dependencyType: DependencyType
+  versionRange: String
   justification: String
   origin: String
   collector: String
@@ -7068,6 +8069,8 @@ input IsDependencySpec {
 "IsDependencyInputSpec is the input to record a new dependency."
 input IsDependencyInputSpec {
   dependencyType: DependencyType!
+  "Version range allowed for the dependency package, such as a manifest requirement"
+  versionRange: String
   justification: String!
   origin: String!
   collector: String!
@@ -7871,6 +8874,8 @@ union Node =
   | VulnerabilityMetadata
   | License
   | CertifyLegal
+  | CertifyPolicy
+  | VulnerabilityRange
 
 """
 Edge allows filtering path/neighbors output to only contain a subset of all
@@ -7888,6 +8893,7 @@ ARTIFACT_HAS_SLSA is only from subject Artifact to HasSLSA.
 enum Edge {
   ARTIFACT_CERTIFY_BAD
   ARTIFACT_CERTIFY_GOOD
+  ARTIFACT_CERTIFY_POLICY
   ARTIFACT_CERTIFY_VEX_STATEMENT
   ARTIFACT_HASH_EQUAL
   ARTIFACT_HAS_METADATA
@@ -7915,6 +8921,7 @@ enum Edge {
   PACKAGE_POINT_OF_CONTACT
   PACKAGE_TYPE_PACKAGE_NAMESPACE
   PACKAGE_VERSION_PACKAGE_NAME
+  PACKAGE_VULNERABILITY_RANGE
   SOURCE_CERTIFY_BAD
   SOURCE_CERTIFY_GOOD
   SOURCE_CERTIFY_LEGAL
@@ -7933,6 +8940,7 @@ enum Edge {
   VULNERABILITY_TYPE_VULNERABILITY_ID
   VULNERABILITY_VULN_EQUAL
   VULNERABILITY_VULN_METADATA
+  VULNERABILITY_VULNERABILITY_RANGE
 
   CERTIFY_BAD_ARTIFACT
   CERTIFY_BAD_PACKAGE
@@ -7943,6 +8951,7 @@ enum Edge {
   CERTIFY_LEGAL_LICENSE
   CERTIFY_LEGAL_PACKAGE
   CERTIFY_LEGAL_SOURCE
+  CERTIFY_POLICY_ARTIFACT
   CERTIFY_SCORECARD_SOURCE
   CERTIFY_VEX_STATEMENT_ARTIFACT
   CERTIFY_VEX_STATEMENT_PACKAGE
@@ -7973,6 +8982,8 @@ enum Edge {
   POINT_OF_CONTACT_SOURCE
   VULN_EQUAL_VULNERABILITY
   VULN_METADATA_VULNERABILITY
+  VULNERABILITY_RANGE_PACKAGE
+  VULNERABILITY_RANGE_VULNERABILITY
 }
 
 
@@ -8003,6 +9014,41 @@ type NeighborEdge {
   node: Node!
 }
 
+"""
+Path is a path returned by allPaths and constrainedPath.
+
+nodes lists the nodes from the subject to the target, including the evidence
+nodes in between. length is the number of edges followed and weight is the sum
+of their weights.
+"""
+type Path {
+  length: Int!
+  weight: Float!
+  nodes: [Node!]!
+}
+
+"""
+EdgeWeight sets the weight of following an edge in allPaths. Edges without a
+weight weigh 1.
+"""
+input EdgeWeight {
+  edge: Edge!
+  weight: Float!
+}
+
+"""
+PathSegment is a step of the edge sequence that a path of constrainedPath must
+follow.
+
+The edges of a segment are followed in order. A repeated segment can be
+followed zero or more times in a row, for example to cross any number of
+IsDependency nodes.
+"""
+input PathSegment {
+  edges: [Edge!]!
+  repeated: Boolean
+}
+
 
 extend type Query {
   """
@@ -8021,6 +9067,49 @@ extend type Query {
     usingOnly: [Edge!]!
   ): [Node!]!
 
+  """
+  allPaths returns the paths between subject and target that visit each node at
+  most once and follow at most maxPathLength edges.
+
+  Paths are ordered by weight and then by length, so ` + "`" + `first: k` + "`" + ` returns the k
+  shortest paths and ` + "`" + `first: 1` + "`" + ` the shortest one. Edges weigh 1 unless they are
+  given another weight in ` + "`" + `weights` + "`" + `.
+
+  Specifying any Edge value in ` + "`" + `usingOnly` + "`" + ` will make the paths only contain the
+  corresponding GUAC evidence trees (GUAC verbs).
+  """
+  allPaths(
+    subject: ID!
+    target: ID!
+    maxPathLength: Int!
+    usingOnly: [Edge!]!
+    weights: [EdgeWeight!]
+    first: Int
+  ): [Path!]!
+
+  """
+  constrainedPath returns the paths between subject and target whose edges
+  follow the sequence of segments, such as an artifact to the package it is an
+  occurrence of and then any number of dependencies:
+
+  ` + "`" + `` + "`" + `` + "`" + `
+  segments: [
+    {edges: [ARTIFACT_IS_OCCURRENCE, IS_OCCURRENCE_PACKAGE]}
+    {edges: [PACKAGE_IS_DEPENDENCY, IS_DEPENDENCY_PACKAGE], repeated: true}
+  ]
+  ` + "`" + `` + "`" + `` + "`" + `
+
+  Paths visit each node at most once, follow at most maxPathLength edges and
+  are ordered by length.
+  """
+  constrainedPath(
+    subject: ID!
+    target: ID!
+    segments: [PathSegment!]!
+    maxPathLength: Int!
+    first: Int
+  ): [Path!]!
+
   """
   neighbors returns all the direct neighbors of a node.
 
@@ -8420,6 +9509,33 @@ extend type Mutation {
   "Bulk ingests sources and returns the list of corresponding source trie path. The returned array of IDs must be in the same order as the inputs."
   ingestSources(sources: [IDorSourceInput!]!): [SourceIDs!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/tenant.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL operations about how predicates are partitioned by tenant
+
+extend type Query {
+  """
+  callerTenant returns the tenant the server resolved for the caller, or an
+  empty string when the caller reads and writes global predicates.
+  """
+  callerTenant: String!
+}
 `, BuiltIn: false},
 	{Name: "../schema/vulnEqual.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -8841,6 +9957,180 @@ extend type Mutation {
   "Bulk ingests vulnerabilities and returns the list of corresponding vulnerability trie path. The returned array of IDs must be in the same order as the inputs"
   ingestVulnerabilities(vulns: [IDorVulnerabilityInput!]!): [VulnerabilityIDs!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/vulnerabilityRange.graphql", Input: `#
+# Copyright 2026 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the VulnerabilityRange
+
+"""
+VulnerabilityRangeType is the kind of versions bounding a VulnerabilityRange,
+as in OSV.
+
+SEMVER and ECOSYSTEM ranges are bounded by versions of the package, the former
+following Semantic Versioning 2.0 and the latter the versioning of the package
+ecosystem. GIT ranges are bounded by commit hashes.
+"""
+enum VulnerabilityRangeType {
+  SEMVER
+  ECOSYSTEM
+  GIT
+}
+
+"""
+VulnerabilityRange is an attestation that a range of versions of a package is
+affected by a vulnerability, as published by vulnerability databases such as
+OSV, GHSA or CSAF advisories.
+
+Unlike CertifyVuln, which links a vulnerability to a package version found by a
+scan, VulnerabilityRange links the vulnerability to a package name. The package
+versions ingested at any time can then be checked against the range, without
+re-scanning, with vulnerabilityRangeMatches.
+
+The range starts at the introduced version, included, and ends before the fixed
+version or at the lastAffected version, included. At most one of fixed and
+lastAffected is set: when neither is, all the versions from introduced onwards
+are affected. An introduced version of "0" means all the versions before the
+end of the range.
+"""
+type VulnerabilityRange {
+  id: ID!
+  "The vulnerability affecting the range of versions"
+  vulnerability: Vulnerability!
+  "The package name whose versions are affected"
+  package: Package!
+  "The kind of versions bounding the range"
+  rangeType: VulnerabilityRangeType!
+  "First affected version or commit"
+  introduced: String!
+  "First version or commit that is no longer affected (empty if not fixed)"
+  fixed: String!
+  "Last affected version or commit (empty if fixed is set or if not known)"
+  lastAffected: String!
+  "Document from which this attestation is generated from"
+  origin: String!
+  "GUAC collector for the document"
+  collector: String!
+  "Reference location of the document in the persistent blob store (if that is configured)"
+  documentRef: String!
+}
+
+"""
+VulnerabilityRangeSpec allows filtering the list of VulnerabilityRange evidence
+to return in a query.
+
+Only the type, namespace and name of the package filter select the ranges. In
+vulnerabilityRangeMatches, the version, qualifiers and subpath of the package
+filter select the package versions checked against the ranges.
+"""
+input VulnerabilityRangeSpec {
+  id: ID
+  vulnerability: VulnerabilitySpec
+  package: PkgSpec
+  rangeType: VulnerabilityRangeType
+  introduced: String
+  fixed: String
+  lastAffected: String
+  origin: String
+  collector: String
+  documentRef: String
+}
+
+"""
+VulnerabilityRangeInputSpec represents the mutation input to ingest a
+VulnerabilityRange evidence.
+"""
+input VulnerabilityRangeInputSpec {
+  rangeType: VulnerabilityRangeType!
+  introduced: String!
+  fixed: String!
+  lastAffected: String!
+  origin: String!
+  collector: String!
+  documentRef: String!
+}
+
+"""
+VulnerabilityRangeConnection returns the paginated results for VulnerabilityRange.
+
+totalCount is the total number of results returned.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the VulnerabilityRangeEdge which contains the current cursor
+and the VulnerabilityRange node itself
+"""
+type VulnerabilityRangeConnection {
+    totalCount: Int!
+    pageInfo: PageInfo!
+    edges: [VulnerabilityRangeEdge!]!
+}
+
+"""
+VulnerabilityRangeEdge contains the cursor for the resulting node and
+the VulnerabilityRange node itself.
+"""
+type VulnerabilityRangeEdge {
+  cursor: ID!
+  node: VulnerabilityRange!
+}
+
+"""
+VulnerabilityRangeMatch is a VulnerabilityRange along with the ingested
+versions of its package that are in the range.
+
+The package is returned as a trie holding only the affected versions.
+"""
+type VulnerabilityRangeMatch {
+  vulnerabilityRange: VulnerabilityRange!
+  package: Package!
+}
+
+extend type Query {
+  "Returns all the vulnerability ranges matching a filter."
+  vulnerabilityRange(vulnerabilityRangeSpec: VulnerabilityRangeSpec!): [VulnerabilityRange!]!
+  "Returns a paginated results via VulnerabilityRangeConnection"
+  vulnerabilityRangeList(vulnerabilityRangeSpec: VulnerabilityRangeSpec!, after: ID, first: Int): VulnerabilityRangeConnection
+  """
+  Returns the vulnerability ranges matching a filter that include at least one
+  ingested version of their package, along with those versions.
+
+  SEMVER and ECOSYSTEM ranges are evaluated as version constraints: the versions
+  that cannot be compared are not matched. GIT ranges are never matched.
+  """
+  vulnerabilityRangeMatches(vulnerabilityRangeSpec: VulnerabilityRangeSpec!): [VulnerabilityRangeMatch!]!
+}
+
+extend type Mutation {
+  "Adds a range of versions of a package affected by a vulnerability. The returned ID can be empty string."
+  ingestVulnerabilityRange(
+    pkg: IDorPkgInput!
+    vulnerability: IDorVulnerabilityInput!
+    vulnerabilityRange: VulnerabilityRangeInputSpec!
+  ): ID!
+  "Bulk add ranges of versions of packages affected by vulnerabilities. The returned array of IDs can be a an array of empty string."
+  ingestVulnerabilityRanges(
+    pkgs: [IDorPkgInput!]!
+    vulnerabilities: [IDorVulnerabilityInput!]!
+    vulnerabilityRanges: [VulnerabilityRangeInputSpec!]!
+  ): [ID!]!
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
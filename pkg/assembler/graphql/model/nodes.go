@@ -467,6 +467,95 @@ type CertifyLegalSpec struct {
 	DocumentRef        *string              `json:"documentRef,omitempty"`
 }
 
+// CertifyPolicy records the verdict of a verifier that checked an artifact
+// against a policy, as found in a SLSA Verification Summary Attestation (VSA).
+//
+// The policy is identified by its URI and by its digest, so the version of the
+// policy used for the verification is recorded along with the verdict.
+type CertifyPolicy struct {
+	ID string `json:"id"`
+	// The artifact that was verified
+	Subject *Artifact `json:"subject"`
+	// URI identifying the verifier
+	Verifier string `json:"verifier"`
+	// URI of the policy the subject was verified against
+	PolicyURI string `json:"policyUri"`
+	// Digest of the policy, in algorithm:digest form (empty if not known)
+	PolicyDigest string `json:"policyDigest"`
+	// Result of the verification
+	Result PolicyVerificationResult `json:"result"`
+	// SLSA levels the subject was verified at (e.g. SLSA_BUILD_LEVEL_2)
+	VerifiedLevels []string `json:"verifiedLevels"`
+	// Timestamp when the verification was performed (in RFC 3339 format)
+	TimeVerified time.Time `json:"timeVerified"`
+	// Document from which this attestation is generated from
+	Origin string `json:"origin"`
+	// GUAC collector for the document
+	Collector string `json:"collector"`
+	// Reference location of the document in the persistent blob store (if that is configured)
+	DocumentRef string `json:"documentRef"`
+}
+
+func (CertifyPolicy) IsNode() {}
+
+// CertifyPolicyConnection returns the paginated results for CertifyPolicy.
+//
+// totalCount is the total number of results returned.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the CertifyPolicyEdge which contains the current cursor
+// and the CertifyPolicy node itself
+type CertifyPolicyConnection struct {
+	TotalCount int                  `json:"totalCount"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	Edges      []*CertifyPolicyEdge `json:"edges"`
+}
+
+// CertifyPolicyEdge contains the cursor for the resulting node and
+// the CertifyPolicy node itself.
+type CertifyPolicyEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *CertifyPolicy `json:"node"`
+}
+
+// CertifyPolicyInputSpec represents the mutation input to ingest a CertifyPolicy evidence.
+type CertifyPolicyInputSpec struct {
+	Verifier       string                   `json:"verifier"`
+	PolicyURI      string                   `json:"policyUri"`
+	PolicyDigest   string                   `json:"policyDigest"`
+	Result         PolicyVerificationResult `json:"result"`
+	VerifiedLevels []string                 `json:"verifiedLevels"`
+	TimeVerified   time.Time                `json:"timeVerified"`
+	Origin         string                   `json:"origin"`
+	Collector      string                   `json:"collector"`
+	DocumentRef    string                   `json:"documentRef"`
+}
+
+// CertifyPolicySpec allows filtering the list of CertifyPolicy evidence to
+// return in a query.
+//
+// If verifiedLevels is specified, the returned values must have been verified at
+// all of the given levels.
+//
+// If timeVerified is specified, the returned value will be after or equal to the
+// specified time.
+type CertifyPolicySpec struct {
+	ID             *string                   `json:"id,omitempty"`
+	Subject        *ArtifactSpec             `json:"subject,omitempty"`
+	Verifier       *string                   `json:"verifier,omitempty"`
+	PolicyURI      *string                   `json:"policyUri,omitempty"`
+	PolicyDigest   *string                   `json:"policyDigest,omitempty"`
+	Result         *PolicyVerificationResult `json:"result,omitempty"`
+	VerifiedLevels []string                  `json:"verifiedLevels,omitempty"`
+	TimeVerified   *time.Time                `json:"timeVerified,omitempty"`
+	Origin         *string                   `json:"origin,omitempty"`
+	Collector      *string                   `json:"collector,omitempty"`
+	DocumentRef    *string                   `json:"documentRef,omitempty"`
+}
+
 // CertifyScorecard is an attestation to attach a Scorecard analysis to a
 // particular source repository.
 type CertifyScorecard struct {
@@ -595,6 +684,8 @@ type CertifyVuln struct {
 	Vulnerability *Vulnerability `json:"vulnerability"`
 	// Metadata attached to the certification
 	Metadata *ScanMetadata `json:"metadata"`
+	// The minimal upgrade of the certified package version clearing all its known vulnerabilities
+	FixRecommendation *FixRecommendation `json:"fixRecommendation"`
 }
 
 func (CertifyVuln) IsNode() {}
@@ -644,6 +735,14 @@ type CertifyVulnSpec struct {
 	DocumentRef    *string            `json:"documentRef,omitempty"`
 }
 
+// ClosureEntry is a package version or artifact returned by dependencyClosure or
+// dependentClosure, with the number of dependency links between it and the
+// subject of the query.
+type ClosureEntry struct {
+	ID    string `json:"id"`
+	Depth int    `json:"depth"`
+}
+
 type Consequences struct {
 	Scope      []*string `json:"Scope,omitempty"`
 	Impact     []*string `json:"Impact,omitempty"`
@@ -686,6 +785,13 @@ type DetectionMethodsInputSpec struct {
 	Effectiveness *string `json:"Effectiveness,omitempty"`
 }
 
+// EdgeWeight sets the weight of following an edge in allPaths. Edges without a
+// weight weigh 1.
+type EdgeWeight struct {
+	Edge   Edge    `json:"edge"`
+	Weight float64 `json:"weight"`
+}
+
 type Exploits struct {
 	ID          *string `json:"id,omitempty"`
 	Description *string `json:"Description,omitempty"`
@@ -720,6 +826,36 @@ type FindSoftwareConnection struct {
 	Edges      []*SoftwareEdge `json:"edges"`
 }
 
+// FixPath is the upgrade of a vulnerable package along one dependency path.
+//
+// The dependencies go from the root of the path down to the vulnerable package,
+// so the last one is the dependency of the parent package whose version range
+// constrains the upgrade. An empty version range allows any version.
+type FixPath struct {
+	// The dependencies from the root of the path down to the package version
+	Dependencies []*IsDependency `json:"dependencies"`
+	// Whether the version range of the parent dependency allows the recommended fixed version
+	Allowed bool `json:"allowed"`
+	// The minimal later version not known to be vulnerable that the version range of the parent dependency allows, empty if there is none
+	FixedVersion string `json:"fixedVersion"`
+}
+
+// FixRecommendation is the minimal upgrade of a package version that clears all
+// the vulnerabilities known to affect the package.
+//
+// The vulnerable versions are those included in the ingested vulnerability
+// ranges of the package and those certified vulnerable. The candidate versions
+// are the versions of the package present in the graph and the fixed versions of
+// its ranges.
+type FixRecommendation struct {
+	// The vulnerabilities known to affect the package version
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities"`
+	// The minimal later version not known to be vulnerable, empty if the package version is not known to be vulnerable or there is no such version
+	FixedVersion string `json:"fixedVersion"`
+	// The upgrade along each dependency path leading to the package version
+	Paths []*FixPath `json:"paths"`
+}
+
 // HasMetadata is an attestation that a package, source, or artifact has a certain
 // attested property (key) with value (value). For example, a source may have
 // metadata "SourceRepo2FAEnabled=true".
@@ -1159,6 +1295,8 @@ type IsDependency struct {
 	DependencyPackage *Package `json:"dependencyPackage"`
 	// Type of dependency
 	DependencyType DependencyType `json:"dependencyType"`
+	// Version range of the dependency package allowed by the package, empty if not known
+	VersionRange string `json:"versionRange"`
 	// Justification for the attested relationship
 	Justification string `json:"justification"`
 	// Document from which this attestation is generated from
@@ -1197,10 +1335,12 @@ type IsDependencyEdge struct {
 // IsDependencyInputSpec is the input to record a new dependency.
 type IsDependencyInputSpec struct {
 	DependencyType DependencyType `json:"dependencyType"`
-	Justification  string         `json:"justification"`
-	Origin         string         `json:"origin"`
-	Collector      string         `json:"collector"`
-	DocumentRef    string         `json:"documentRef"`
+	// Version range allowed for the dependency package, such as a manifest requirement
+	VersionRange  *string `json:"versionRange,omitempty"`
+	Justification string  `json:"justification"`
+	Origin        string  `json:"origin"`
+	Collector     string  `json:"collector"`
+	DocumentRef   string  `json:"documentRef"`
 }
 
 // IsDependencySpec allows filtering the list of dependencies to return.
@@ -1214,6 +1354,7 @@ type IsDependencySpec struct {
 	Package           *PkgSpec        `json:"package,omitempty"`
 	DependencyPackage *PkgSpec        `json:"dependencyPackage,omitempty"`
 	DependencyType    *DependencyType `json:"dependencyType,omitempty"`
+	VersionRange      *string         `json:"versionRange,omitempty"`
 	Justification     *string         `json:"justification,omitempty"`
 	Origin            *string         `json:"origin,omitempty"`
 	Collector         *string         `json:"collector,omitempty"`
@@ -1627,6 +1768,28 @@ type PageInfo struct {
 	EndCursor   *string `json:"endCursor,omitempty"`
 }
 
+// Path is a path returned by allPaths and constrainedPath.
+//
+// nodes lists the nodes from the subject to the target, including the evidence
+// nodes in between. length is the number of edges followed and weight is the sum
+// of their weights.
+type Path struct {
+	Length int     `json:"length"`
+	Weight float64 `json:"weight"`
+	Nodes  []Node  `json:"nodes"`
+}
+
+// PathSegment is a step of the edge sequence that a path of constrainedPath must
+// follow.
+//
+// The edges of a segment are followed in order. A repeated segment can be
+// followed zero or more times in a row, for example to cross any number of
+// IsDependency nodes.
+type PathSegment struct {
+	Edges    []Edge `json:"edges"`
+	Repeated *bool  `json:"repeated,omitempty"`
+}
+
 // PkgEqual is an attestation that two packages are similar.
 type PkgEqual struct {
 	ID string `json:"id"`
@@ -2175,6 +2338,9 @@ type SourceSpec struct {
 	Commit    *string `json:"commit,omitempty"`
 }
 
+type Subscription struct {
+}
+
 type UsedArtifact struct {
 	Name        *string `json:"Name,omitempty"`
 	UsedInLines []*int  `json:"UsedInLines,omitempty"`
@@ -2466,6 +2632,107 @@ type VulnerabilityMetadataSpec struct {
 	DocumentRef   *string                 `json:"documentRef,omitempty"`
 }
 
+// VulnerabilityRange is an attestation that a range of versions of a package is
+// affected by a vulnerability, as published by vulnerability databases such as
+// OSV, GHSA or CSAF advisories.
+//
+// Unlike CertifyVuln, which links a vulnerability to a package version found by a
+// scan, VulnerabilityRange links the vulnerability to a package name. The package
+// versions ingested at any time can then be checked against the range, without
+// re-scanning, with vulnerabilityRangeMatches.
+//
+// The range starts at the introduced version, included, and ends before the fixed
+// version or at the lastAffected version, included. At most one of fixed and
+// lastAffected is set: when neither is, all the versions from introduced onwards
+// are affected. An introduced version of "0" means all the versions before the
+// end of the range.
+type VulnerabilityRange struct {
+	ID string `json:"id"`
+	// The vulnerability affecting the range of versions
+	Vulnerability *Vulnerability `json:"vulnerability"`
+	// The package name whose versions are affected
+	Package *Package `json:"package"`
+	// The kind of versions bounding the range
+	RangeType VulnerabilityRangeType `json:"rangeType"`
+	// First affected version or commit
+	Introduced string `json:"introduced"`
+	// First version or commit that is no longer affected (empty if not fixed)
+	Fixed string `json:"fixed"`
+	// Last affected version or commit (empty if fixed is set or if not known)
+	LastAffected string `json:"lastAffected"`
+	// Document from which this attestation is generated from
+	Origin string `json:"origin"`
+	// GUAC collector for the document
+	Collector string `json:"collector"`
+	// Reference location of the document in the persistent blob store (if that is configured)
+	DocumentRef string `json:"documentRef"`
+}
+
+func (VulnerabilityRange) IsNode() {}
+
+// VulnerabilityRangeConnection returns the paginated results for VulnerabilityRange.
+//
+// totalCount is the total number of results returned.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the VulnerabilityRangeEdge which contains the current cursor
+// and the VulnerabilityRange node itself
+type VulnerabilityRangeConnection struct {
+	TotalCount int                       `json:"totalCount"`
+	PageInfo   *PageInfo                 `json:"pageInfo"`
+	Edges      []*VulnerabilityRangeEdge `json:"edges"`
+}
+
+// VulnerabilityRangeEdge contains the cursor for the resulting node and
+// the VulnerabilityRange node itself.
+type VulnerabilityRangeEdge struct {
+	Cursor string              `json:"cursor"`
+	Node   *VulnerabilityRange `json:"node"`
+}
+
+// VulnerabilityRangeInputSpec represents the mutation input to ingest a
+// VulnerabilityRange evidence.
+type VulnerabilityRangeInputSpec struct {
+	RangeType    VulnerabilityRangeType `json:"rangeType"`
+	Introduced   string                 `json:"introduced"`
+	Fixed        string                 `json:"fixed"`
+	LastAffected string                 `json:"lastAffected"`
+	Origin       string                 `json:"origin"`
+	Collector    string                 `json:"collector"`
+	DocumentRef  string                 `json:"documentRef"`
+}
+
+// VulnerabilityRangeMatch is a VulnerabilityRange along with the ingested
+// versions of its package that are in the range.
+//
+// The package is returned as a trie holding only the affected versions.
+type VulnerabilityRangeMatch struct {
+	VulnerabilityRange *VulnerabilityRange `json:"vulnerabilityRange"`
+	Package            *Package            `json:"package"`
+}
+
+// VulnerabilityRangeSpec allows filtering the list of VulnerabilityRange evidence
+// to return in a query.
+//
+// Only the type, namespace and name of the package filter select the ranges. In
+// vulnerabilityRangeMatches, the version, qualifiers and subpath of the package
+// filter select the package versions checked against the ranges.
+type VulnerabilityRangeSpec struct {
+	ID            *string                 `json:"id,omitempty"`
+	Vulnerability *VulnerabilitySpec      `json:"vulnerability,omitempty"`
+	Package       *PkgSpec                `json:"package,omitempty"`
+	RangeType     *VulnerabilityRangeType `json:"rangeType,omitempty"`
+	Introduced    *string                 `json:"introduced,omitempty"`
+	Fixed         *string                 `json:"fixed,omitempty"`
+	LastAffected  *string                 `json:"lastAffected,omitempty"`
+	Origin        *string                 `json:"origin,omitempty"`
+	Collector     *string                 `json:"collector,omitempty"`
+	DocumentRef   *string                 `json:"documentRef,omitempty"`
+}
+
 // VulnerabilitySpec allows filtering the list of vulnerabilities to return in a query.
 //
 // Use null to match on all values at that level.
@@ -2595,6 +2862,7 @@ type Edge string
 const (
 	EdgeArtifactCertifyBad               Edge = "ARTIFACT_CERTIFY_BAD"
 	EdgeArtifactCertifyGood              Edge = "ARTIFACT_CERTIFY_GOOD"
+	EdgeArtifactCertifyPolicy            Edge = "ARTIFACT_CERTIFY_POLICY"
 	EdgeArtifactCertifyVexStatement      Edge = "ARTIFACT_CERTIFY_VEX_STATEMENT"
 	EdgeArtifactHashEqual                Edge = "ARTIFACT_HASH_EQUAL"
 	EdgeArtifactHasMetadata              Edge = "ARTIFACT_HAS_METADATA"
@@ -2622,6 +2890,7 @@ const (
 	EdgePackagePointOfContact            Edge = "PACKAGE_POINT_OF_CONTACT"
 	EdgePackageTypePackageNamespace      Edge = "PACKAGE_TYPE_PACKAGE_NAMESPACE"
 	EdgePackageVersionPackageName        Edge = "PACKAGE_VERSION_PACKAGE_NAME"
+	EdgePackageVulnerabilityRange        Edge = "PACKAGE_VULNERABILITY_RANGE"
 	EdgeSourceCertifyBad                 Edge = "SOURCE_CERTIFY_BAD"
 	EdgeSourceCertifyGood                Edge = "SOURCE_CERTIFY_GOOD"
 	EdgeSourceCertifyLegal               Edge = "SOURCE_CERTIFY_LEGAL"
@@ -2640,6 +2909,7 @@ const (
 	EdgeVulnerabilityTypeVulnerabilityID Edge = "VULNERABILITY_TYPE_VULNERABILITY_ID"
 	EdgeVulnerabilityVulnEqual           Edge = "VULNERABILITY_VULN_EQUAL"
 	EdgeVulnerabilityVulnMetadata        Edge = "VULNERABILITY_VULN_METADATA"
+	EdgeVulnerabilityVulnerabilityRange  Edge = "VULNERABILITY_VULNERABILITY_RANGE"
 	EdgeCertifyBadArtifact               Edge = "CERTIFY_BAD_ARTIFACT"
 	EdgeCertifyBadPackage                Edge = "CERTIFY_BAD_PACKAGE"
 	EdgeCertifyBadSource                 Edge = "CERTIFY_BAD_SOURCE"
@@ -2649,6 +2919,7 @@ const (
 	EdgeCertifyLegalLicense              Edge = "CERTIFY_LEGAL_LICENSE"
 	EdgeCertifyLegalPackage              Edge = "CERTIFY_LEGAL_PACKAGE"
 	EdgeCertifyLegalSource               Edge = "CERTIFY_LEGAL_SOURCE"
+	EdgeCertifyPolicyArtifact            Edge = "CERTIFY_POLICY_ARTIFACT"
 	EdgeCertifyScorecardSource           Edge = "CERTIFY_SCORECARD_SOURCE"
 	EdgeCertifyVexStatementArtifact      Edge = "CERTIFY_VEX_STATEMENT_ARTIFACT"
 	EdgeCertifyVexStatementPackage       Edge = "CERTIFY_VEX_STATEMENT_PACKAGE"
@@ -2679,11 +2950,14 @@ const (
 	EdgePointOfContactSource             Edge = "POINT_OF_CONTACT_SOURCE"
 	EdgeVulnEqualVulnerability           Edge = "VULN_EQUAL_VULNERABILITY"
 	EdgeVulnMetadataVulnerability        Edge = "VULN_METADATA_VULNERABILITY"
+	EdgeVulnerabilityRangePackage        Edge = "VULNERABILITY_RANGE_PACKAGE"
+	EdgeVulnerabilityRangeVulnerability  Edge = "VULNERABILITY_RANGE_VULNERABILITY"
 )
 
 var AllEdge = []Edge{
 	EdgeArtifactCertifyBad,
 	EdgeArtifactCertifyGood,
+	EdgeArtifactCertifyPolicy,
 	EdgeArtifactCertifyVexStatement,
 	EdgeArtifactHashEqual,
 	EdgeArtifactHasMetadata,
@@ -2711,6 +2985,7 @@ var AllEdge = []Edge{
 	EdgePackagePointOfContact,
 	EdgePackageTypePackageNamespace,
 	EdgePackageVersionPackageName,
+	EdgePackageVulnerabilityRange,
 	EdgeSourceCertifyBad,
 	EdgeSourceCertifyGood,
 	EdgeSourceCertifyLegal,
@@ -2729,6 +3004,7 @@ var AllEdge = []Edge{
 	EdgeVulnerabilityTypeVulnerabilityID,
 	EdgeVulnerabilityVulnEqual,
 	EdgeVulnerabilityVulnMetadata,
+	EdgeVulnerabilityVulnerabilityRange,
 	EdgeCertifyBadArtifact,
 	EdgeCertifyBadPackage,
 	EdgeCertifyBadSource,
@@ -2738,6 +3014,7 @@ var AllEdge = []Edge{
 	EdgeCertifyLegalLicense,
 	EdgeCertifyLegalPackage,
 	EdgeCertifyLegalSource,
+	EdgeCertifyPolicyArtifact,
 	EdgeCertifyScorecardSource,
 	EdgeCertifyVexStatementArtifact,
 	EdgeCertifyVexStatementPackage,
@@ -2768,11 +3045,13 @@ var AllEdge = []Edge{
 	EdgePointOfContactSource,
 	EdgeVulnEqualVulnerability,
 	EdgeVulnMetadataVulnerability,
+	EdgeVulnerabilityRangePackage,
+	EdgeVulnerabilityRangeVulnerability,
 }
 
 func (e Edge) IsValid() bool {
 	switch e {
-	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability:
+	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyPolicy, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgePackageVulnerabilityRange, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeVulnerabilityVulnerabilityRange, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyPolicyArtifact, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability, EdgeVulnerabilityRangePackage, EdgeVulnerabilityRangeVulnerability:
 		return true
 	}
 	return false
@@ -2883,6 +3162,48 @@ func (e PkgMatchType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// PolicyVerificationResult is the outcome of a policy verification.
+type PolicyVerificationResult string
+
+const (
+	PolicyVerificationResultPassed PolicyVerificationResult = "PASSED"
+	PolicyVerificationResultFailed PolicyVerificationResult = "FAILED"
+)
+
+var AllPolicyVerificationResult = []PolicyVerificationResult{
+	PolicyVerificationResultPassed,
+	PolicyVerificationResultFailed,
+}
+
+func (e PolicyVerificationResult) IsValid() bool {
+	switch e {
+	case PolicyVerificationResultPassed, PolicyVerificationResultFailed:
+		return true
+	}
+	return false
+}
+
+func (e PolicyVerificationResult) String() string {
+	return string(e)
+}
+
+func (e *PolicyVerificationResult) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyVerificationResult(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyVerificationResult", str)
+	}
+	return nil
+}
+
+func (e PolicyVerificationResult) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // QueryType is used in conjunction with queryPackagesListForScan to
 // specify if the last time scanned is checked for either certifyVuln
 // or certifyLegal.
@@ -3028,6 +3349,55 @@ func (e VexStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// VulnerabilityRangeType is the kind of versions bounding a VulnerabilityRange,
+// as in OSV.
+//
+// SEMVER and ECOSYSTEM ranges are bounded by versions of the package, the former
+// following Semantic Versioning 2.0 and the latter the versioning of the package
+// ecosystem. GIT ranges are bounded by commit hashes.
+type VulnerabilityRangeType string
+
+const (
+	VulnerabilityRangeTypeSemver    VulnerabilityRangeType = "SEMVER"
+	VulnerabilityRangeTypeEcosystem VulnerabilityRangeType = "ECOSYSTEM"
+	VulnerabilityRangeTypeGit       VulnerabilityRangeType = "GIT"
+)
+
+var AllVulnerabilityRangeType = []VulnerabilityRangeType{
+	VulnerabilityRangeTypeSemver,
+	VulnerabilityRangeTypeEcosystem,
+	VulnerabilityRangeTypeGit,
+}
+
+func (e VulnerabilityRangeType) IsValid() bool {
+	switch e {
+	case VulnerabilityRangeTypeSemver, VulnerabilityRangeTypeEcosystem, VulnerabilityRangeTypeGit:
+		return true
+	}
+	return false
+}
+
+func (e VulnerabilityRangeType) String() string {
+	return string(e)
+}
+
+func (e *VulnerabilityRangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VulnerabilityRangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VulnerabilityRangeType", str)
+	}
+	return nil
+}
+
+func (e VulnerabilityRangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Records the type of the score being captured by the score node
 type VulnerabilityScoreType string
 
@@ -6,6 +6,7 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -48,21 +49,21 @@ func (r *mutationResolver) IngestCertifyLegals(ctx context.Context, subjects mod
 }
 
 // CertifyLegal is the resolver for the CertifyLegal field.
-func (r *queryResolver) CertifyLegal(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+func (r *queryResolver) CertifyLegal(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time) ([]*model.CertifyLegal, error) {
 	if err := validatePackageOrSourceQueryFilter(certifyLegalSpec.Subject); err != nil {
 		return nil, gqlerror.Errorf("CertifyLegal :: %v", err)
 	}
 
-	return r.Backend.CertifyLegal(ctx, &certifyLegalSpec)
+	return r.Backend.CertifyLegal(ctx, &certifyLegalSpec, asOf)
 }
 
 // CertifyLegalList is the resolver for the CertifyLegalList field.
-func (r *queryResolver) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
+func (r *queryResolver) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, asOf *time.Time, after *string, first *int) (*model.CertifyLegalConnection, error) {
 	if err := validatePackageOrSourceQueryFilter(certifyLegalSpec.Subject); err != nil {
 		return nil, gqlerror.Errorf("CertifyLegal :: %v", err)
 	}
 
-	return r.Backend.CertifyLegalList(ctx, certifyLegalSpec, after, first)
+	return r.Backend.CertifyLegalList(ctx, certifyLegalSpec, asOf, after, first)
 }
 
 // BatchQueryPkgIDCertifyLegal is the resolver for the BatchQueryPkgIDCertifyLegal field.
@@ -296,9 +296,9 @@ func TestCertifyLegal(t *testing.T) {
 			}
 			b.
 				EXPECT().
-				CertifyLegal(ctx, test.Query).
+				CertifyLegal(ctx, test.Query, nil).
 				Times(times)
-			_, err := r.Query().CertifyLegal(ctx, *test.Query)
+			_, err := r.Query().CertifyLegal(ctx, *test.Query, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
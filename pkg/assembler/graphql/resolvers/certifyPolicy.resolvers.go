@@ -0,0 +1,38 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestCertifyPolicy is the resolver for the ingestCertifyPolicy field.
+func (r *mutationResolver) IngestCertifyPolicy(ctx context.Context, subject model.IDorArtifactInput, certifyPolicy model.CertifyPolicyInputSpec) (string, error) {
+	return r.Backend.IngestCertifyPolicy(ctx, subject, certifyPolicy)
+}
+
+// IngestCertifyPolicies is the resolver for the ingestCertifyPolicies field.
+func (r *mutationResolver) IngestCertifyPolicies(ctx context.Context, subjects []*model.IDorArtifactInput, certifyPolicies []*model.CertifyPolicyInputSpec) ([]string, error) {
+	funcName := "IngestCertifyPolicies"
+	ingestedCertifyPolicyIDs := []string{}
+	if len(subjects) != len(certifyPolicies) {
+		return ingestedCertifyPolicyIDs, gqlerror.Errorf("%v :: uneven subjects and policy verifications for ingestion", funcName)
+	}
+
+	return r.Backend.IngestCertifyPolicies(ctx, subjects, certifyPolicies)
+}
+
+// CertifyPolicy is the resolver for the CertifyPolicy field.
+func (r *queryResolver) CertifyPolicy(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec) ([]*model.CertifyPolicy, error) {
+	return r.Backend.CertifyPolicy(ctx, &certifyPolicySpec)
+}
+
+// CertifyPolicyList is the resolver for the CertifyPolicyList field.
+func (r *queryResolver) CertifyPolicyList(ctx context.Context, certifyPolicySpec model.CertifyPolicySpec, after *string, first *int) (*model.CertifyPolicyConnection, error) {
+	return r.Backend.CertifyPolicyList(ctx, certifyPolicySpec, after, first)
+}
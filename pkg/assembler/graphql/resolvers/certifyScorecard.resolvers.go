@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
@@ -27,11 +28,11 @@ func (r *mutationResolver) IngestScorecards(ctx context.Context, sources []*mode
 }
 
 // Scorecards is the resolver for the scorecards field.
-func (r *queryResolver) Scorecards(ctx context.Context, scorecardSpec model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
-	return r.Backend.Scorecards(ctx, &scorecardSpec)
+func (r *queryResolver) Scorecards(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time) ([]*model.CertifyScorecard, error) {
+	return r.Backend.Scorecards(ctx, &scorecardSpec, asOf)
 }
 
 // ScorecardsList is the resolver for the scorecardsList field.
-func (r *queryResolver) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
-	return r.Backend.ScorecardsList(ctx, scorecardSpec, after, first)
+func (r *queryResolver) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, asOf *time.Time, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	return r.Backend.ScorecardsList(ctx, scorecardSpec, asOf, after, first)
 }
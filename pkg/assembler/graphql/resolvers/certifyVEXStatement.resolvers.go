@@ -7,7 +7,10 @@ package resolvers
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -96,7 +99,7 @@ func (r *mutationResolver) IngestVEXStatements(ctx context.Context, subjects mod
 }
 
 // CertifyVEXStatement is the resolver for the CertifyVEXStatement field.
-func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time) ([]*model.CertifyVEXStatement, error) {
 	if err := validatePackageOrArtifactQueryFilter(certifyVEXStatementSpec.Subject); err != nil {
 		return nil, gqlerror.Errorf("CertifyVEXStatement :: %s", err)
 	}
@@ -134,14 +137,14 @@ func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXState
 			Origin:           certifyVEXStatementSpec.Origin,
 			Collector:        certifyVEXStatementSpec.Collector,
 		}
-		return r.Backend.CertifyVEXStatement(ctx, lowercaseCertifyVexFilter)
+		return r.Backend.CertifyVEXStatement(ctx, lowercaseCertifyVexFilter, asOf)
 	} else {
-		return r.Backend.CertifyVEXStatement(ctx, &certifyVEXStatementSpec)
+		return r.Backend.CertifyVEXStatement(ctx, &certifyVEXStatementSpec, asOf)
 	}
 }
 
 // CertifyVEXStatementList is the resolver for the CertifyVEXStatementList field.
-func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
+func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, asOf *time.Time, after *string, first *int) (*model.VEXConnection, error) {
 	if err := validatePackageOrArtifactQueryFilter(certifyVEXStatementSpec.Subject); err != nil {
 		return nil, gqlerror.Errorf("CertifyVEXStatement :: %s", err)
 	}
@@ -179,8 +182,36 @@ func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXS
 			Origin:           certifyVEXStatementSpec.Origin,
 			Collector:        certifyVEXStatementSpec.Collector,
 		}
-		return r.Backend.CertifyVEXStatementList(ctx, lowercaseCertifyVexFilter, after, first)
+		return r.Backend.CertifyVEXStatementList(ctx, lowercaseCertifyVexFilter, asOf, after, first)
 	} else {
-		return r.Backend.CertifyVEXStatementList(ctx, certifyVEXStatementSpec, after, first)
+		return r.Backend.CertifyVEXStatementList(ctx, certifyVEXStatementSpec, asOf, after, first)
 	}
 }
+
+// VexStatusChanged is the resolver for the vexStatusChanged field.
+func (r *subscriptionResolver) VexStatusChanged(ctx context.Context, subject *model.PackageOrArtifactSpec) (<-chan *model.CertifyVEXStatement, error) {
+	return subscribe(ctx, r.Events, events.VEXStatementIngested, func(ctx context.Context, id string) (*model.CertifyVEXStatement, error) {
+		found, err := r.Backend.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &id}, nil)
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		vex := found[0]
+		if !matchSubject(subject, vex.Subject) {
+			return nil, nil
+		}
+		// only report statements that change the status of the vulnerability
+		previous, err := events.PreviousVEXStatement(ctx, r.Backend, vex)
+		if err != nil {
+			return nil, err
+		}
+		if previous != nil && previous.Status == vex.Status {
+			return nil, nil
+		}
+		return vex, nil
+	})
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
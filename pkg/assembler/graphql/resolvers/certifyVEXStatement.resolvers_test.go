@@ -224,9 +224,9 @@ func TestCertifyVEXStatement(t *testing.T) {
 			}
 			b.
 				EXPECT().
-				CertifyVEXStatement(ctx, gomock.Any()).
+				CertifyVEXStatement(ctx, gomock.Any(), nil).
 				Times(times)
-			_, err := r.Query().CertifyVEXStatement(ctx, *test.Query)
+			_, err := r.Query().CertifyVEXStatement(ctx, *test.Query, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -7,7 +7,10 @@ package resolvers
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -69,7 +72,7 @@ func (r *mutationResolver) IngestCertifyVulns(ctx context.Context, pkgs []*model
 }
 
 // CertifyVuln is the resolver for the CertifyVuln field.
-func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time) ([]*model.CertifyVuln, error) {
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
 
 	if certifyVulnSpec.Vulnerability != nil {
@@ -110,14 +113,14 @@ func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec model.C
 			Origin:         certifyVulnSpec.Origin,
 			Collector:      certifyVulnSpec.Collector,
 		}
-		return r.Backend.CertifyVuln(ctx, &lowercaseCertifyVulnFilter)
+		return r.Backend.CertifyVuln(ctx, &lowercaseCertifyVulnFilter, asOf)
 	} else {
-		return r.Backend.CertifyVuln(ctx, &certifyVulnSpec)
+		return r.Backend.CertifyVuln(ctx, &certifyVulnSpec, asOf)
 	}
 }
 
 // CertifyVulnList is the resolver for the CertifyVulnList field.
-func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, asOf *time.Time, after *string, first *int) (*model.CertifyVulnConnection, error) {
 	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
 
 	if certifyVulnSpec.Vulnerability != nil {
@@ -158,9 +161,9 @@ func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec mod
 			Origin:         certifyVulnSpec.Origin,
 			Collector:      certifyVulnSpec.Collector,
 		}
-		return r.Backend.CertifyVulnList(ctx, lowercaseCertifyVulnFilter, after, first)
+		return r.Backend.CertifyVulnList(ctx, lowercaseCertifyVulnFilter, asOf, after, first)
 	} else {
-		return r.Backend.CertifyVulnList(ctx, certifyVulnSpec, after, first)
+		return r.Backend.CertifyVulnList(ctx, certifyVulnSpec, asOf, after, first)
 	}
 }
 
@@ -168,3 +171,22 @@ func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec mod
 func (r *queryResolver) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error) {
 	return r.Backend.BatchQueryPkgIDCertifyVuln(ctx, pkgIDs)
 }
+
+// CertifyVulnIngested is the resolver for the certifyVulnIngested field.
+func (r *subscriptionResolver) CertifyVulnIngested(ctx context.Context, pkgSpec *model.PkgSpec) (<-chan *model.CertifyVuln, error) {
+	return subscribe(ctx, r.Events, events.CertifyVulnIngested, func(ctx context.Context, id string) (*model.CertifyVuln, error) {
+		found, err := r.Backend.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &id}, nil)
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		if !matchPackage(pkgSpec, found[0].Package) {
+			return nil, nil
+		}
+		return found[0], nil
+	})
+}
+
+// CertifyVuln returns generated.CertifyVulnResolver implementation.
+func (r *Resolver) CertifyVuln() generated.CertifyVulnResolver { return &certifyVulnResolver{r} }
+
+type certifyVulnResolver struct{ *Resolver }
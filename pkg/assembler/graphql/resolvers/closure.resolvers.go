@@ -0,0 +1,30 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// DependencyClosure is the resolver for the dependencyClosure field.
+func (r *queryResolver) DependencyClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	if maxDepth != nil && *maxDepth <= 0 {
+		return nil, gqlerror.Errorf("DependencyClosure :: maxDepth argument must be positive, got %d", *maxDepth)
+	}
+
+	return r.Backend.DependencyClosure(ctx, subject, maxDepth)
+}
+
+// DependentClosure is the resolver for the dependentClosure field.
+func (r *queryResolver) DependentClosure(ctx context.Context, subject string, maxDepth *int) ([]*model.ClosureEntry, error) {
+	if maxDepth != nil && *maxDepth <= 0 {
+		return nil, gqlerror.Errorf("DependentClosure :: maxDepth argument must be positive, got %d", *maxDepth)
+	}
+
+	return r.Backend.DependentClosure(ctx, subject, maxDepth)
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDependencyClosure(t *testing.T) {
+	tests := []struct {
+		Name         string
+		MaxDepth     *int
+		ExpIngestErr bool
+	}{
+		{
+			Name:         "Zero maxDepth",
+			MaxDepth:     ptrfrom.Int(0),
+			ExpIngestErr: true,
+		},
+		{
+			Name: "No maxDepth",
+		},
+		{
+			Name:     "Happy path",
+			MaxDepth: ptrfrom.Int(2),
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpIngestErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				DependencyClosure(ctx, "a", test.MaxDepth).
+				Return([]*model.ClosureEntry{}, nil).
+				Times(times)
+			_, err := r.Query().DependencyClosure(ctx, "a", test.MaxDepth)
+			if (err != nil) != test.ExpIngestErr {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+			}
+		})
+	}
+}
+
+func TestDependentClosure(t *testing.T) {
+	tests := []struct {
+		Name         string
+		MaxDepth     *int
+		ExpIngestErr bool
+	}{
+		{
+			Name:         "Negative maxDepth",
+			MaxDepth:     ptrfrom.Int(-1),
+			ExpIngestErr: true,
+		},
+		{
+			Name:     "Happy path",
+			MaxDepth: ptrfrom.Int(1),
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpIngestErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				DependentClosure(ctx, "a", test.MaxDepth).
+				Return([]*model.ClosureEntry{}, nil).
+				Times(times)
+			_, err := r.Query().DependentClosure(ctx, "a", test.MaxDepth)
+			if (err != nil) != test.ExpIngestErr {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+			}
+		})
+	}
+}
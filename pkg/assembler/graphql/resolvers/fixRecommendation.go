@@ -0,0 +1,314 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/misc/depversion"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// maxFixPaths bounds the number of dependency paths walked up from a
+// vulnerable package, as shared dependencies make their number grow quickly.
+const maxFixPaths = 100
+
+// vulnerableVersions holds what is known about the vulnerable versions of a
+// package name.
+type vulnerableVersions struct {
+	// the version ranges of the vulnerability ranges of the package
+	ranges []vulnerableRange
+	// the vulnerabilities certified for each version of the package
+	certified map[string][]*model.Vulnerability
+}
+
+type vulnerableRange struct {
+	vulnerability *model.Vulnerability
+	versionRange  string
+}
+
+// affecting returns the vulnerabilities known to affect the version.
+func (vv *vulnerableVersions) affecting(version string) []*model.Vulnerability {
+	vulns := append([]*model.Vulnerability{}, vv.certified[version]...)
+	for _, r := range vv.ranges {
+		in, err := depversion.DoesRangeInclude([]string{version}, r.versionRange)
+		if err != nil || !in {
+			// versions that cannot be compared to the range are not
+			// known to be affected by it
+			continue
+		}
+		vulns = append(vulns, r.vulnerability)
+	}
+	return dedupVulnerabilities(vulns)
+}
+
+// recommendFixForSpec returns the recommendation of recommendFix for the
+// package version matching the spec, which must match exactly one.
+func recommendFixForSpec(ctx context.Context, b backends.Backend, pkgSpec model.PkgSpec) (*model.FixRecommendation, error) {
+	pkgs, err := b.Packages(ctx, &pkgSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the package: %w", err)
+	}
+	var match *model.Package
+	for _, p := range pkgs {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				for _, v := range n.Versions {
+					if match != nil {
+						return nil, gqlerror.Errorf("fixRecommendation :: package spec matches more than one package version")
+					}
+					match = &model.Package{
+						ID:   p.ID,
+						Type: p.Type,
+						Namespaces: []*model.PackageNamespace{{
+							ID:        ns.ID,
+							Namespace: ns.Namespace,
+							Names: []*model.PackageName{{
+								ID:       n.ID,
+								Name:     n.Name,
+								Versions: []*model.PackageVersion{v},
+							}},
+						}},
+					}
+				}
+			}
+		}
+	}
+	if match == nil {
+		return nil, gqlerror.Errorf("fixRecommendation :: package spec matches no package version")
+	}
+	return recommendFix(ctx, b, match)
+}
+
+// recommendFix returns the minimal upgrade of the package version clearing
+// all the vulnerabilities known to affect it, along with how each dependency
+// path leading to the package constrains that upgrade.
+func recommendFix(ctx context.Context, b backends.Backend, pkg *model.Package) (*model.FixRecommendation, error) {
+	out := &model.FixRecommendation{
+		Vulnerabilities: []*model.Vulnerability{},
+		Paths:           []*model.FixPath{},
+	}
+	ns, n := rangePackageName(pkg)
+	if n == nil || len(n.Versions) == 0 {
+		return out, nil
+	}
+	current := n.Versions[0]
+	nameSpec := model.PkgSpec{Type: &pkg.Type, Namespace: &ns.Namespace, Name: &n.Name}
+
+	vv, candidates, err := knownVulnerableVersions(ctx, b, nameSpec)
+	if err != nil {
+		return nil, err
+	}
+	out.Vulnerabilities = vv.affecting(current.Version)
+	if len(out.Vulnerabilities) == 0 {
+		return out, nil
+	}
+
+	// the fixed versions are the later candidates not known to be affected,
+	// lowest first
+	var fixed []string
+	for _, c := range candidates {
+		cmp, err := depversion.CompareVersions(c, current.Version)
+		if err != nil || cmp <= 0 {
+			continue
+		}
+		if len(vv.affecting(c)) == 0 {
+			fixed = append(fixed, c)
+		}
+	}
+	if len(fixed) > 0 {
+		out.FixedVersion = fixed[0]
+	}
+
+	paths, err := dependencyPaths(ctx, b, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		versionRange := path[len(path)-1].VersionRange
+		fp := &model.FixPath{Dependencies: path}
+		for _, f := range fixed {
+			if rangeAllows(versionRange, f) {
+				fp.FixedVersion = f
+				break
+			}
+		}
+		fp.Allowed = out.FixedVersion != "" && rangeAllows(versionRange, out.FixedVersion)
+		out.Paths = append(out.Paths, fp)
+	}
+	return out, nil
+}
+
+// knownVulnerableVersions returns what is known about the vulnerable versions
+// of the package name, along with the candidate versions to upgrade to sorted
+// from lowest to highest: its versions in the graph and the fixed versions of
+// its ranges. Versions that cannot be compared are left out of the candidates.
+func knownVulnerableVersions(ctx context.Context, b backends.Backend, nameSpec model.PkgSpec) (*vulnerableVersions, []string, error) {
+	vv := &vulnerableVersions{certified: map[string][]*model.Vulnerability{}}
+	seen := map[string]bool{}
+
+	ranges, err := b.VulnerabilityRange(ctx, &model.VulnerabilityRangeSpec{Package: &nameSpec})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query the vulnerability ranges of the package: %w", err)
+	}
+	for _, vr := range ranges {
+		if vr.RangeType == model.VulnerabilityRangeTypeGit {
+			continue
+		}
+		vv.ranges = append(vv.ranges, vulnerableRange{
+			vulnerability: vr.Vulnerability,
+			versionRange:  depversion.IntervalConstraint(vr.Introduced, vr.Fixed, vr.LastAffected),
+		})
+		if vr.Fixed != "" {
+			seen[vr.Fixed] = true
+		}
+	}
+
+	noVuln := false
+	certs, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{
+		Package:       &nameSpec,
+		Vulnerability: &model.VulnerabilitySpec{NoVuln: &noVuln},
+	}, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query the vulnerabilities certified for the package: %w", err)
+	}
+	for _, cv := range certs {
+		if _, n := rangePackageName(cv.Package); n != nil {
+			for _, v := range n.Versions {
+				vv.certified[v.Version] = append(vv.certified[v.Version], cv.Vulnerability)
+			}
+		}
+	}
+
+	pkgs, err := b.Packages(ctx, &nameSpec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query the versions of the package: %w", err)
+	}
+	for _, p := range pkgs {
+		if _, n := rangePackageName(p); n != nil {
+			for _, v := range n.Versions {
+				seen[v.Version] = true
+			}
+		}
+	}
+
+	var candidates []string
+	for c := range seen {
+		if _, err := depversion.CompareVersions(c, c); err == nil {
+			candidates = append(candidates, c)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		cmp, _ := depversion.CompareVersions(candidates[i], candidates[j])
+		if cmp == 0 {
+			// keep equal versions written differently in a stable order
+			return candidates[i] < candidates[j]
+		}
+		return cmp < 0
+	})
+	return vv, candidates, nil
+}
+
+// dependencyPaths returns the dependency paths leading to the package
+// version, each going from a package nothing depends on down to the direct
+// dependency on the package version. At most maxFixPaths paths are returned.
+func dependencyPaths(ctx context.Context, b backends.Backend, versionID string) ([][]*model.IsDependency, error) {
+	// the dependents of a package version are looked up once for all paths
+	dependents := map[string][]*model.IsDependency{}
+	lookup := func(id string) ([]*model.IsDependency, error) {
+		if deps, ok := dependents[id]; ok {
+			return deps, nil
+		}
+		deps, err := b.IsDependency(ctx, &model.IsDependencySpec{DependencyPackage: &model.PkgSpec{ID: &id}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the dependents of package %s: %w", id, err)
+		}
+		dependents[id] = deps
+		return deps, nil
+	}
+
+	var paths [][]*model.IsDependency
+	// walk holds the dependencies from the package version up to id, and
+	// visited the package versions on it to not follow dependency cycles
+	var walk func(id string, up []*model.IsDependency, visited map[string]bool) error
+	walk = func(id string, up []*model.IsDependency, visited map[string]bool) error {
+		deps, err := lookup(id)
+		if err != nil {
+			return err
+		}
+		extended := false
+		for _, dep := range deps {
+			if len(paths) >= maxFixPaths {
+				return nil
+			}
+			_, n := rangePackageName(dep.Package)
+			if n == nil || len(n.Versions) == 0 || visited[n.Versions[0].ID] {
+				continue
+			}
+			extended = true
+			visited[n.Versions[0].ID] = true
+			if err := walk(n.Versions[0].ID, append(up, dep), visited); err != nil {
+				return err
+			}
+			delete(visited, n.Versions[0].ID)
+		}
+		if !extended && len(up) > 0 && len(paths) < maxFixPaths {
+			path := make([]*model.IsDependency, len(up))
+			for i, dep := range up {
+				path[len(up)-1-i] = dep
+			}
+			paths = append(paths, path)
+		}
+		return nil
+	}
+	if err := walk(versionID, nil, map[string]bool{versionID: true}); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// rangeAllows returns whether the version range allows the version. An empty
+// range allows any version, and a range that cannot be parsed none.
+func rangeAllows(versionRange, version string) bool {
+	if versionRange == "" {
+		return true
+	}
+	in, err := depversion.DoesRangeInclude([]string{version}, versionRange)
+	return err == nil && in
+}
+
+// dedupVulnerabilities returns the vulnerabilities without repeated
+// vulnerability IDs.
+func dedupVulnerabilities(vulns []*model.Vulnerability) []*model.Vulnerability {
+	seen := map[string]bool{}
+	out := []*model.Vulnerability{}
+	for _, v := range vulns {
+		key := v.ID
+		for _, id := range v.VulnerabilityIDs {
+			key = id.ID
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// FixRecommendation is the resolver for the fixRecommendation field.
+func (r *certifyVulnResolver) FixRecommendation(ctx context.Context, obj *model.CertifyVuln) (*model.FixRecommendation, error) {
+	return recommendFix(ctx, r.Backend, obj.Package)
+}
+
+// FixRecommendation is the resolver for the fixRecommendation field.
+func (r *queryResolver) FixRecommendation(ctx context.Context, pkg model.PkgSpec) (*model.FixRecommendation, error) {
+	return recommendFixForSpec(ctx, r.Backend, pkg)
+}
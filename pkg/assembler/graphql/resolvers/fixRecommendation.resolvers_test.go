@@ -0,0 +1,284 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFixRecommendation(t *testing.T) {
+	pkg := func(name string, versions ...string) *model.Package {
+		var vs []*model.PackageVersion
+		for _, v := range versions {
+			vs = append(vs, &model.PackageVersion{ID: name + "@" + v, Version: v})
+		}
+		return &model.Package{
+			ID:   "pypi",
+			Type: "pypi",
+			Namespaces: []*model.PackageNamespace{{
+				ID: "ns",
+				Names: []*model.PackageName{{
+					ID:       name,
+					Name:     name,
+					Versions: vs,
+				}},
+			}},
+		}
+	}
+	vuln := func(id string) *model.Vulnerability {
+		return &model.Vulnerability{
+			ID:               "osv",
+			Type:             "osv",
+			VulnerabilityIDs: []*model.VulnerabilityID{{ID: id, VulnerabilityID: id}},
+		}
+	}
+	dep := func(parent, versionRange string) *model.IsDependency {
+		return &model.IsDependency{
+			ID:                parent,
+			Package:           pkg(parent, "1.0.0"),
+			DependencyPackage: pkg("tensorflow", "2.10.0"),
+			VersionRange:      versionRange,
+		}
+	}
+	ranges := []*model.VulnerabilityRange{
+		{
+			ID:            "1",
+			Vulnerability: vuln("ghsa-1"),
+			RangeType:     model.VulnerabilityRangeTypeSemver,
+			Introduced:    "2.0.0",
+			Fixed:         "2.11.2",
+		},
+		{
+			ID:            "2",
+			Vulnerability: vuln("ghsa-2"),
+			RangeType:     model.VulnerabilityRangeTypeEcosystem,
+			Fixed:         "2.11.0",
+		},
+		{
+			ID:            "3",
+			Vulnerability: vuln("ghsa-3"),
+			RangeType:     model.VulnerabilityRangeTypeGit,
+			Introduced:    "abc123",
+		},
+	}
+	appDep := dep("app", "~2.10.0")
+	libDep := dep("lib", ">=2.0.0,<3.0.0")
+	app2Dep := &model.IsDependency{
+		ID:                "app2",
+		Package:           pkg("app2", "1.0.0"),
+		DependencyPackage: pkg("lib", "1.0.0"),
+	}
+	tests := []struct {
+		Name       string
+		Package    *model.Package
+		Ranges     []*model.VulnerabilityRange
+		Certified  []*model.CertifyVuln
+		Versions   []*model.Package
+		Dependents map[string][]*model.IsDependency
+		Exp        *model.FixRecommendation
+	}{
+		{
+			Name:     "Not vulnerable",
+			Package:  pkg("tensorflow", "2.12.0"),
+			Ranges:   ranges,
+			Versions: []*model.Package{pkg("tensorflow", "2.10.0", "2.12.0", "3.0.0")},
+			Exp: &model.FixRecommendation{
+				Vulnerabilities: []*model.Vulnerability{},
+				Paths:           []*model.FixPath{},
+			},
+		},
+		{
+			Name:    "Fix along each dependency path",
+			Package: pkg("tensorflow", "2.10.0"),
+			Ranges:  ranges,
+			Certified: []*model.CertifyVuln{
+				{Package: pkg("tensorflow", "2.10.0"), Vulnerability: vuln("ghsa-1")},
+				{Package: pkg("tensorflow", "2.11.2"), Vulnerability: vuln("ghsa-4")},
+			},
+			Versions: []*model.Package{pkg("tensorflow", "1.0.0", "2.10.0", "2.11.0", "2.11.2", "2.12.0", "main")},
+			Dependents: map[string][]*model.IsDependency{
+				"tensorflow@2.10.0": {appDep, libDep},
+				"lib@1.0.0":         {app2Dep},
+			},
+			Exp: &model.FixRecommendation{
+				Vulnerabilities: []*model.Vulnerability{vuln("ghsa-1"), vuln("ghsa-2")},
+				FixedVersion:    "2.12.0",
+				Paths: []*model.FixPath{
+					{Dependencies: []*model.IsDependency{appDep}},
+					{Dependencies: []*model.IsDependency{app2Dep, libDep}, Allowed: true, FixedVersion: "2.12.0"},
+				},
+			},
+		},
+		{
+			Name:    "No fixed version known",
+			Package: pkg("tensorflow", "2.10.0"),
+			Ranges: []*model.VulnerabilityRange{{
+				ID:            "4",
+				Vulnerability: vuln("ghsa-1"),
+				RangeType:     model.VulnerabilityRangeTypeSemver,
+				Introduced:    "2.0.0",
+			}},
+			Versions: []*model.Package{pkg("tensorflow", "2.10.0", "2.11.0")},
+			Exp: &model.FixRecommendation{
+				Vulnerabilities: []*model.Vulnerability{vuln("ghsa-1")},
+				Paths:           []*model.FixPath{},
+			},
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			b.
+				EXPECT().
+				VulnerabilityRange(ctx, gomock.Any()).
+				Return(test.Ranges, nil)
+			b.
+				EXPECT().
+				CertifyVuln(ctx, gomock.Any(), nil).
+				Return(test.Certified, nil)
+			b.
+				EXPECT().
+				Packages(ctx, gomock.Any()).
+				Return(test.Versions, nil)
+			b.
+				EXPECT().
+				IsDependency(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, spec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+					return test.Dependents[*spec.DependencyPackage.ID], nil
+				}).
+				AnyTimes()
+			got, err := r.CertifyVuln().FixRecommendation(ctx, &model.CertifyVuln{Package: test.Package})
+			if err != nil {
+				t.Fatalf("did not expect query error, got: %v", err)
+			}
+			if diff := cmp.Diff(test.Exp, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFixRecommendationQuery(t *testing.T) {
+	pkg := func(versions ...string) *model.Package {
+		var vs []*model.PackageVersion
+		for _, v := range versions {
+			vs = append(vs, &model.PackageVersion{ID: "tensorflow@" + v, Version: v})
+		}
+		return &model.Package{
+			ID:   "pypi",
+			Type: "pypi",
+			Namespaces: []*model.PackageNamespace{{
+				ID: "ns",
+				Names: []*model.PackageName{{
+					ID:       "tensorflow",
+					Name:     "tensorflow",
+					Versions: vs,
+				}},
+			}},
+		}
+	}
+	vuln := &model.Vulnerability{
+		ID:               "osv",
+		Type:             "osv",
+		VulnerabilityIDs: []*model.VulnerabilityID{{ID: "ghsa-1", VulnerabilityID: "ghsa-1"}},
+	}
+	tests := []struct {
+		Name         string
+		Matched      []*model.Package
+		Exp          *model.FixRecommendation
+		ExpQueryErr  bool
+		ExpRecommend bool
+	}{
+		{
+			Name:    "Vulnerable range without certification",
+			Matched: []*model.Package{pkg("2.10.0")},
+			Exp: &model.FixRecommendation{
+				Vulnerabilities: []*model.Vulnerability{vuln},
+				FixedVersion:    "2.11.2",
+				Paths:           []*model.FixPath{},
+			},
+			ExpRecommend: true,
+		},
+		{
+			Name:        "No package version matched",
+			Matched:     []*model.Package{pkg()},
+			ExpQueryErr: true,
+		},
+		{
+			Name:        "Several package versions matched",
+			Matched:     []*model.Package{pkg("2.10.0", "2.12.0")},
+			ExpQueryErr: true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			name := "tensorflow"
+			spec := model.PkgSpec{Name: &name}
+			b.
+				EXPECT().
+				Packages(ctx, &spec).
+				Return(test.Matched, nil)
+			if test.ExpRecommend {
+				b.
+					EXPECT().
+					VulnerabilityRange(ctx, gomock.Any()).
+					Return([]*model.VulnerabilityRange{{
+						ID:            "1",
+						Vulnerability: vuln,
+						RangeType:     model.VulnerabilityRangeTypeSemver,
+						Introduced:    "2.0.0",
+						Fixed:         "2.11.2",
+					}}, nil)
+				b.
+					EXPECT().
+					CertifyVuln(ctx, gomock.Any(), nil).
+					Return(nil, nil)
+				b.
+					EXPECT().
+					Packages(ctx, gomock.Any()).
+					Return([]*model.Package{pkg("2.10.0")}, nil)
+				b.
+					EXPECT().
+					IsDependency(ctx, gomock.Any()).
+					Return(nil, nil)
+			}
+			got, err := r.Query().FixRecommendation(ctx, spec)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.Exp, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
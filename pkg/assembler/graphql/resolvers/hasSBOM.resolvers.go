@@ -6,7 +6,9 @@ package resolvers
 
 import (
 	"context"
+	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/events"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -58,17 +60,31 @@ func (r *mutationResolver) IngestHasSBOMs(ctx context.Context, subjects model.Pa
 }
 
 // HasSbom is the resolver for the HasSBOM field.
-func (r *queryResolver) HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func (r *queryResolver) HasSbom(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time) ([]*model.HasSbom, error) {
 	if err := validatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
 		return nil, gqlerror.Errorf("%v :: %s", "HasSBOM", err)
 	}
-	return r.Backend.HasSBOM(ctx, &hasSBOMSpec)
+	return r.Backend.HasSBOM(ctx, &hasSBOMSpec, asOf)
 }
 
 // HasSBOMList is the resolver for the HasSBOMList field.
-func (r *queryResolver) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+func (r *queryResolver) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, asOf *time.Time, after *string, first *int) (*model.HasSBOMConnection, error) {
 	if err := validatePackageOrArtifactQueryFilter(hasSBOMSpec.Subject); err != nil {
 		return nil, gqlerror.Errorf("%v :: %s", "HasSBOM", err)
 	}
-	return r.Backend.HasSBOMList(ctx, hasSBOMSpec, after, first)
+	return r.Backend.HasSBOMList(ctx, hasSBOMSpec, asOf, after, first)
+}
+
+// HasSBOMIngested is the resolver for the hasSBOMIngested field.
+func (r *subscriptionResolver) HasSBOMIngested(ctx context.Context, subject *model.PackageOrArtifactSpec) (<-chan *model.HasSbom, error) {
+	return subscribe(ctx, r.Events, events.HasSBOMIngested, func(ctx context.Context, id string) (*model.HasSbom, error) {
+		found, err := r.Backend.HasSBOM(ctx, &model.HasSBOMSpec{ID: &id}, nil)
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		if !matchSubject(subject, found[0].Subject) {
+			return nil, nil
+		}
+		return found[0], nil
+	})
 }
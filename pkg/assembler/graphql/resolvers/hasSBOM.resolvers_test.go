@@ -266,9 +266,9 @@ func TestHasSbom(t *testing.T) {
 			}
 			b.
 				EXPECT().
-				HasSBOM(ctx, test.Query).
+				HasSBOM(ctx, test.Query, nil).
 				Times(times)
-			_, err := r.Query().HasSbom(ctx, *test.Query)
+			_, err := r.Query().HasSbom(ctx, *test.Query, nil)
 			if (err != nil) != test.ExpQueryErr {
 				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
 			}
@@ -20,6 +20,33 @@ func (r *queryResolver) Path(ctx context.Context, subject string, target string,
 	return r.Backend.Path(ctx, subject, target, maxPathLength, usingOnly)
 }
 
+// AllPaths is the resolver for the allPaths field.
+func (r *queryResolver) AllPaths(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge, weights []*model.EdgeWeight, first *int) ([]*model.Path, error) {
+	if maxPathLength <= 0 {
+		return nil, gqlerror.Errorf("AllPaths :: maxPathLength argument must be positive, got %d", maxPathLength)
+	}
+	if first != nil && *first <= 0 {
+		return nil, gqlerror.Errorf("AllPaths :: first argument must be positive, got %d", *first)
+	}
+
+	return r.Backend.AllPaths(ctx, subject, target, maxPathLength, usingOnly, weights, first)
+}
+
+// ConstrainedPath is the resolver for the constrainedPath field.
+func (r *queryResolver) ConstrainedPath(ctx context.Context, subject string, target string, segments []*model.PathSegment, maxPathLength int, first *int) ([]*model.Path, error) {
+	if maxPathLength <= 0 {
+		return nil, gqlerror.Errorf("ConstrainedPath :: maxPathLength argument must be positive, got %d", maxPathLength)
+	}
+	if first != nil && *first <= 0 {
+		return nil, gqlerror.Errorf("ConstrainedPath :: first argument must be positive, got %d", *first)
+	}
+	if len(segments) == 0 {
+		return nil, gqlerror.Errorf("ConstrainedPath :: at least one path segment is needed")
+	}
+
+	return r.Backend.ConstrainedPath(ctx, subject, target, segments, maxPathLength, first)
+}
+
 // Neighbors is the resolver for the neighbors field.
 func (r *queryResolver) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
 	return r.Backend.Neighbors(ctx, node, usingOnly)
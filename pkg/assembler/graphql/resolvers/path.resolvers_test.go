@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 	"go.uber.org/mock/gomock"
@@ -88,3 +89,99 @@ func TestPath(t *testing.T) {
 		})
 	}
 }
+
+func TestAllPaths(t *testing.T) {
+	type call struct {
+		maxPathLength int
+		first         *int
+	}
+	tests := []struct {
+		Name         string
+		Call         call
+		ExpIngestErr bool
+	}{
+		{
+			Name:         "Negative maxPathLength",
+			Call:         call{maxPathLength: -1},
+			ExpIngestErr: true,
+		},
+		{
+			Name:         "Zero first",
+			Call:         call{maxPathLength: 10, first: ptrfrom.Int(0)},
+			ExpIngestErr: true,
+		},
+		{
+			Name: "Happy path",
+			Call: call{maxPathLength: 10, first: ptrfrom.Int(3)},
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpIngestErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				AllPaths(ctx, "a", "b", test.Call.maxPathLength, nil, nil, test.Call.first).
+				Return([]*model.Path{}, nil).
+				Times(times)
+			_, err := r.Query().AllPaths(ctx, "a", "b", test.Call.maxPathLength, nil, nil, test.Call.first)
+			if (err != nil) != test.ExpIngestErr {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+			}
+		})
+	}
+}
+
+func TestConstrainedPath(t *testing.T) {
+	segments := []*model.PathSegment{{Edges: []model.Edge{model.EdgeArtifactIsOccurrence}}}
+	tests := []struct {
+		Name          string
+		Segments      []*model.PathSegment
+		MaxPathLength int
+		ExpIngestErr  bool
+	}{
+		{
+			Name:          "Negative maxPathLength",
+			Segments:      segments,
+			MaxPathLength: -1,
+			ExpIngestErr:  true,
+		},
+		{
+			Name:          "No segments",
+			MaxPathLength: 10,
+			ExpIngestErr:  true,
+		},
+		{
+			Name:          "Happy path",
+			Segments:      segments,
+			MaxPathLength: 10,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			times := 1
+			if test.ExpIngestErr {
+				times = 0
+			}
+			b.
+				EXPECT().
+				ConstrainedPath(ctx, "a", "b", test.Segments, test.MaxPathLength, nil).
+				Return([]*model.Path{}, nil).
+				Times(times)
+			_, err := r.Query().ConstrainedPath(ctx, "a", "b", test.Segments, test.MaxPathLength, nil)
+			if (err != nil) != test.ExpIngestErr {
+				t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+			}
+		})
+	}
+}
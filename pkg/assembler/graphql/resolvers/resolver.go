@@ -21,8 +21,11 @@ package resolvers
 
 import (
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/events"
 )
 
 type Resolver struct {
 	Backend backends.Backend
+	// Events feeds the subscriptions, they are disabled when it is nil.
+	Events *events.Bus
 }
@@ -0,0 +1,164 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/tenant"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// subscribe streams the predicates announced on the event bus for the given
+// kind. load fetches the predicate for an event ID and returns nil when the
+// predicate should not be sent to the subscriber.
+func subscribe[T any](ctx context.Context, bus *events.Bus, kind events.Kind, load func(context.Context, string) (*T, error)) (<-chan *T, error) {
+	if bus == nil {
+		return nil, gqlerror.Errorf("subscriptions are not enabled on this server")
+	}
+	logger := logging.FromContext(ctx)
+	caller := tenant.FromContext(ctx)
+	in := bus.Subscribe(ctx, kind)
+	out := make(chan *T, 1)
+	go func() {
+		defer close(out)
+		for e := range in {
+			// events of other tenants are never visible, global ones always are
+			if e.Tenant != "" && caller != "" && e.Tenant != caller {
+				continue
+			}
+			v, err := load(ctx, e.ID)
+			if err != nil {
+				logger.Errorf("failed to load %s %s for subscription: %v", e.Kind, e.ID, err)
+				continue
+			}
+			if v == nil {
+				continue
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// matchSubject reports whether a package or artifact matches spec. A nil or
+// empty spec matches any subject.
+func matchSubject(spec *model.PackageOrArtifactSpec, subject model.PackageOrArtifact) bool {
+	if spec == nil || (spec.Package == nil && spec.Artifact == nil) {
+		return true
+	}
+	switch s := subject.(type) {
+	case *model.Package:
+		return spec.Package != nil && matchPackage(spec.Package, s)
+	case *model.Artifact:
+		return spec.Artifact != nil && matchArtifact(spec.Artifact, s)
+	}
+	return false
+}
+
+// matchPackage reports whether any version (or name, for versionless
+// packages) in the package trie matches spec.
+func matchPackage(spec *model.PkgSpec, p *model.Package) bool {
+	if spec == nil {
+		return true
+	}
+	if p == nil || !matchString(spec.Type, p.Type) {
+		return false
+	}
+	for _, ns := range p.Namespaces {
+		if !matchString(spec.Namespace, ns.Namespace) {
+			continue
+		}
+		for _, n := range ns.Names {
+			if !matchString(spec.Name, n.Name) {
+				continue
+			}
+			versions := n.Versions
+			if len(versions) == 0 {
+				versions = []*model.PackageVersion{nil}
+			}
+			for _, v := range versions {
+				ids := []string{p.ID, ns.ID, n.ID}
+				if v != nil {
+					ids = append(ids, v.ID)
+				}
+				if matchVersion(spec, v) && matchID(spec.ID, ids...) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func matchVersion(spec *model.PkgSpec, v *model.PackageVersion) bool {
+	if v == nil {
+		return spec.Version == nil && spec.Subpath == nil && len(spec.Qualifiers) == 0 &&
+			(spec.MatchOnlyEmptyQualifiers == nil || !*spec.MatchOnlyEmptyQualifiers)
+	}
+	if !matchString(spec.Version, v.Version) || !matchString(spec.Subpath, v.Subpath) {
+		return false
+	}
+	if spec.MatchOnlyEmptyQualifiers != nil && *spec.MatchOnlyEmptyQualifiers {
+		return len(v.Qualifiers) == 0
+	}
+	for _, want := range spec.Qualifiers {
+		found := false
+		for _, q := range v.Qualifiers {
+			if q.Key == want.Key && matchString(want.Value, q.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func matchArtifact(spec *model.ArtifactSpec, a *model.Artifact) bool {
+	if a == nil {
+		return false
+	}
+	return matchID(spec.ID, a.ID) &&
+		(spec.Algorithm == nil || strings.EqualFold(*spec.Algorithm, a.Algorithm)) &&
+		(spec.Digest == nil || strings.EqualFold(*spec.Digest, a.Digest))
+}
+
+func matchString(want *string, got string) bool {
+	return want == nil || *want == got
+}
+
+func matchID(want *string, ids ...string) bool {
+	if want == nil {
+		return true
+	}
+	for _, id := range ids {
+		if id == *want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/tenant"
+	"go.uber.org/mock/gomock"
+)
+
+var subPkg = &model.Package{
+	ID:   "t1",
+	Type: "golang",
+	Namespaces: []*model.PackageNamespace{{
+		ID:        "ns1",
+		Namespace: "github.com/ourorg",
+		Names: []*model.PackageName{{
+			ID:   "n1",
+			Name: "app",
+			Versions: []*model.PackageVersion{{
+				ID:         "v1",
+				Version:    "v1.0.0",
+				Qualifiers: []*model.PackageQualifier{{Key: "arch", Value: "amd64"}},
+			}},
+		}},
+	}},
+}
+
+var subVuln = &model.Vulnerability{
+	ID:               "vt1",
+	Type:             "cve",
+	VulnerabilityIDs: []*model.VulnerabilityID{{ID: "vid1", VulnerabilityID: "cve-2023-1"}},
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func next[T any](t *testing.T, ch <-chan *T) *T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for subscription")
+	}
+	return nil
+}
+
+func none[T any](t *testing.T, ch <-chan *T) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		t.Fatalf("unexpected subscription result: %+v", v)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSubscriptionsDisabled(t *testing.T) {
+	r := resolvers.Resolver{Backend: mocks.NewMockBackend(gomock.NewController(t))}
+	if _, err := r.Subscription().CertifyVulnIngested(context.Background(), nil); err == nil {
+		t.Errorf("expected error when no event bus is configured")
+	}
+}
+
+func TestCertifyVulnIngested(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Spec    *model.PkgSpec
+		Tenant  string
+		Event   events.Event
+		ExpSent bool
+	}{
+		{
+			Name:    "no filter",
+			Event:   events.Event{Kind: events.CertifyVulnIngested, ID: "cv1"},
+			ExpSent: true,
+		},
+		{
+			Name:    "matching name",
+			Spec:    &model.PkgSpec{Type: ptr("golang"), Namespace: ptr("github.com/ourorg"), Name: ptr("app")},
+			Event:   events.Event{Kind: events.CertifyVulnIngested, ID: "cv1"},
+			ExpSent: true,
+		},
+		{
+			Name:    "matching qualifier and version id",
+			Spec:    &model.PkgSpec{ID: ptr("v1"), Qualifiers: []*model.PackageQualifierSpec{{Key: "arch"}}},
+			Event:   events.Event{Kind: events.CertifyVulnIngested, ID: "cv1"},
+			ExpSent: true,
+		},
+		{
+			Name:  "other namespace",
+			Spec:  &model.PkgSpec{Namespace: ptr("github.com/other")},
+			Event: events.Event{Kind: events.CertifyVulnIngested, ID: "cv1"},
+		},
+		{
+			Name:  "only empty qualifiers",
+			Spec:  &model.PkgSpec{MatchOnlyEmptyQualifiers: ptr(true)},
+			Event: events.Event{Kind: events.CertifyVulnIngested, ID: "cv1"},
+		},
+		{
+			Name:    "own tenant",
+			Tenant:  "team-a",
+			Event:   events.Event{Kind: events.CertifyVulnIngested, ID: "cv1", Tenant: "team-a"},
+			ExpSent: true,
+		},
+		{
+			Name:    "global event for tenant",
+			Tenant:  "team-a",
+			Event:   events.Event{Kind: events.CertifyVulnIngested, ID: "cv1"},
+			ExpSent: true,
+		},
+		{
+			Name:   "other tenant",
+			Tenant: "team-b",
+			Event:  events.Event{Kind: events.CertifyVulnIngested, ID: "cv1", Tenant: "team-a"},
+		},
+	}
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if test.Tenant != "" {
+				ctx = tenant.WithTenant(ctx, test.Tenant)
+			}
+			b := mocks.NewMockBackend(ctrl)
+			bus := events.NewBus()
+			r := resolvers.Resolver{Backend: b, Events: bus}
+
+			cv := &model.CertifyVuln{ID: "cv1", Package: subPkg, Vulnerability: subVuln}
+			b.EXPECT().CertifyVuln(gomock.Any(), &model.CertifyVulnSpec{ID: ptr("cv1")}, nil).
+				Return([]*model.CertifyVuln{cv}, nil).AnyTimes()
+
+			ch, err := r.Subscription().CertifyVulnIngested(ctx, test.Spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			bus.Publish(test.Event)
+			if test.ExpSent {
+				if got := next(t, ch); got.ID != "cv1" {
+					t.Errorf("unexpected certifyVuln: %+v", got)
+				}
+			} else {
+				none(t, ch)
+			}
+		})
+	}
+}
+
+func TestHasSBOMIngested(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	bus := events.NewBus()
+	r := resolvers.Resolver{Backend: b, Events: bus}
+
+	art := &model.Artifact{ID: "a1", Algorithm: "sha256", Digest: "abc"}
+	b.EXPECT().HasSBOM(gomock.Any(), &model.HasSBOMSpec{ID: ptr("s1")}, nil).
+		Return([]*model.HasSbom{{ID: "s1", Subject: art}}, nil).AnyTimes()
+	b.EXPECT().HasSBOM(gomock.Any(), &model.HasSBOMSpec{ID: ptr("s2")}, nil).
+		Return([]*model.HasSbom{{ID: "s2", Subject: subPkg}}, nil).AnyTimes()
+
+	ch, err := r.Subscription().HasSBOMIngested(ctx, &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Digest: ptr("ABC")}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bus.Publish(events.Event{Kind: events.HasSBOMIngested, ID: "s2"})
+	bus.Publish(events.Event{Kind: events.HasSBOMIngested, ID: "s1"})
+	if got := next(t, ch); got.ID != "s1" {
+		t.Errorf("expected hasSBOM s1, got %+v", got)
+	}
+	none(t, ch)
+}
+
+func TestVexStatusChanged(t *testing.T) {
+	t2 := t1.Add(time.Hour)
+	t3 := t2.Add(time.Hour)
+	affected := &model.CertifyVEXStatement{ID: "x1", Subject: subPkg, Vulnerability: subVuln, Status: model.VexStatusAffected, KnownSince: t1}
+	stillAffected := &model.CertifyVEXStatement{ID: "x2", Subject: subPkg, Vulnerability: subVuln, Status: model.VexStatusAffected, KnownSince: t2}
+	fixed := &model.CertifyVEXStatement{ID: "x3", Subject: subPkg, Vulnerability: subVuln, Status: model.VexStatusFixed, KnownSince: t3}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	bus := events.NewBus()
+	r := resolvers.Resolver{Backend: b, Events: bus}
+
+	var mu sync.Mutex
+	var stored []*model.CertifyVEXStatement
+	b.EXPECT().CertifyVEXStatement(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+		func(_ context.Context, spec *model.CertifyVEXStatementSpec, _ *time.Time) ([]*model.CertifyVEXStatement, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if spec.ID != nil {
+				for _, s := range stored {
+					if s.ID == *spec.ID {
+						return []*model.CertifyVEXStatement{s}, nil
+					}
+				}
+				return nil, nil
+			}
+			if spec.Subject == nil || spec.Subject.Package == nil || *spec.Subject.Package.ID != "v1" ||
+				spec.Vulnerability == nil || *spec.Vulnerability.ID != "vid1" {
+				t.Errorf("unexpected history query: %+v", spec)
+			}
+			return stored, nil
+		}).AnyTimes()
+
+	ch, err := r.Subscription().VexStatusChanged(ctx, &model.PackageOrArtifactSpec{Package: &model.PkgSpec{Name: ptr("app")}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, s := range []*model.CertifyVEXStatement{affected, stillAffected, fixed} {
+		mu.Lock()
+		stored = append(stored, s)
+		mu.Unlock()
+		bus.Publish(events.Event{Kind: events.VEXStatementIngested, ID: s.ID})
+		switch s {
+		case stillAffected:
+			none(t, ch)
+		default:
+			if got := next(t, ch); got.ID != s.ID {
+				t.Errorf("expected VEX statement %s, got %+v", s.ID, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// CallerTenant is the resolver for the callerTenant field.
+func (r *queryResolver) CallerTenant(ctx context.Context) (string, error) {
+	return tenant.FromContext(ctx), nil
+}
@@ -188,3 +188,10 @@ func validateVulnerabilitySpec(vulnerability model.VulnerabilitySpec) error {
 	}
 	return nil
 }
+
+func validateVulnerabilityRangeInput(vulnerabilityRange model.VulnerabilityRangeInputSpec) error {
+	if vulnerabilityRange.Fixed != "" && vulnerabilityRange.LastAffected != "" {
+		return gqlerror.Errorf("a vulnerability range cannot have both a fixed and a last affected version")
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/misc/depversion"
+)
+
+// lowercaseVulnerabilityRangeSpec returns the filter with its vulnerability
+// type and ID lowercased, as they are ingested.
+func lowercaseVulnerabilityRangeSpec(filter model.VulnerabilityRangeSpec) (*model.VulnerabilityRangeSpec, error) {
+	if filter.Vulnerability == nil {
+		return &filter, nil
+	}
+	if err := validateVulnerabilitySpec(*filter.Vulnerability); err != nil {
+		return nil, err
+	}
+	vuln := *filter.Vulnerability
+	if vuln.Type != nil {
+		lower := strings.ToLower(*vuln.Type)
+		vuln.Type = &lower
+	}
+	if vuln.VulnerabilityID != nil {
+		lower := strings.ToLower(*vuln.VulnerabilityID)
+		vuln.VulnerabilityID = &lower
+	}
+	filter.Vulnerability = &vuln
+	return &filter, nil
+}
+
+// matchVulnerabilityRanges returns the ranges matching the filter that
+// include ingested versions of their package, along with those versions.
+// The versions are compared by depversion, whatever the ecosystem, since
+// the ranges do not record the OSV ecosystem that osvrange.Affects compares
+// the versions of an OSV record by.
+func matchVulnerabilityRanges(ctx context.Context, b backends.Backend, filter *model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRangeMatch, error) {
+	ranges, err := b.VulnerabilityRange(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// the version fields of the package filter select the versions to check
+	versionFilter := model.PkgSpec{}
+	if filter.Package != nil {
+		versionFilter.Version = filter.Package.Version
+		versionFilter.Qualifiers = filter.Package.Qualifiers
+		versionFilter.MatchOnlyEmptyQualifiers = filter.Package.MatchOnlyEmptyQualifiers
+		versionFilter.Subpath = filter.Package.Subpath
+	}
+	// the versions of a package are looked up once for all its ranges
+	versions := map[string][]*model.Package{}
+
+	matches := []*model.VulnerabilityRangeMatch{}
+	for _, vr := range ranges {
+		if vr.RangeType == model.VulnerabilityRangeTypeGit || vr.Package == nil {
+			continue
+		}
+		ns, n := rangePackageName(vr.Package)
+		if n == nil {
+			continue
+		}
+		pkgs, ok := versions[n.ID]
+		if !ok {
+			spec := versionFilter
+			spec.Type = &vr.Package.Type
+			spec.Namespace = &ns.Namespace
+			spec.Name = &n.Name
+			pkgs, err = b.Packages(ctx, &spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query the versions of package %s: %w", n.ID, err)
+			}
+			versions[n.ID] = pkgs
+		}
+
+		constraint := depversion.IntervalConstraint(vr.Introduced, vr.Fixed, vr.LastAffected)
+		for _, p := range pkgs {
+			affected, err := filterPackageVersions(p, constraint)
+			if err != nil {
+				// the bounds of the range cannot be compared
+				break
+			}
+			if affected != nil {
+				matches = append(matches, &model.VulnerabilityRangeMatch{
+					VulnerabilityRange: vr,
+					Package:            affected,
+				})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// rangePackageName returns the package name of the package trie of a range.
+func rangePackageName(p *model.Package) (*model.PackageNamespace, *model.PackageName) {
+	for _, ns := range p.Namespaces {
+		for _, n := range ns.Names {
+			return ns, n
+		}
+	}
+	return nil, nil
+}
+
+// filterPackageVersions returns the package trie pruned to the versions in
+// the version range, or nil if there are none.
+func filterPackageVersions(p *model.Package, versionRange string) (*model.Package, error) {
+	var namespaces []*model.PackageNamespace
+	for _, ns := range p.Namespaces {
+		var names []*model.PackageName
+		for _, n := range ns.Names {
+			var affected []*model.PackageVersion
+			for _, v := range n.Versions {
+				in, err := depversion.DoesRangeInclude([]string{v.Version}, versionRange)
+				if err != nil {
+					return nil, err
+				}
+				if in {
+					affected = append(affected, v)
+				}
+			}
+			if len(affected) > 0 {
+				names = append(names, &model.PackageName{ID: n.ID, Name: n.Name, Versions: affected})
+			}
+		}
+		if len(names) > 0 {
+			namespaces = append(namespaces, &model.PackageNamespace{ID: ns.ID, Namespace: ns.Namespace, Names: names})
+		}
+	}
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+	return &model.Package{ID: p.ID, Type: p.Type, Namespaces: namespaces}, nil
+}
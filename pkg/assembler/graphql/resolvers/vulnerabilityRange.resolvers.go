@@ -0,0 +1,122 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.60
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestVulnerabilityRange is the resolver for the ingestVulnerabilityRange field.
+func (r *mutationResolver) IngestVulnerabilityRange(ctx context.Context, pkg model.IDorPkgInput, vulnerability model.IDorVulnerabilityInput, vulnerabilityRange model.VulnerabilityRangeInputSpec) (string, error) {
+	funcName := "IngestVulnerabilityRange"
+	if err := validateVulnerabilityRangeInput(vulnerabilityRange); err != nil {
+		return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	if vulnerability.VulnerabilityInput != nil {
+		err := validateNoVul(*vulnerability.VulnerabilityInput)
+		if err != nil {
+			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		}
+
+		err = validateVulnerabilityIDInputSpec(*vulnerability.VulnerabilityInput)
+		if err != nil {
+			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
+		}
+		return r.Backend.IngestVulnerabilityRange(ctx, pkg, model.IDorVulnerabilityInput{
+			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
+			VulnerabilityNodeID: vulnerability.VulnerabilityNodeID,
+			VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.VulnerabilityInput.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityInput.VulnerabilityID)},
+		}, vulnerabilityRange)
+	} else {
+		return r.Backend.IngestVulnerabilityRange(ctx, pkg, vulnerability, vulnerabilityRange)
+	}
+}
+
+// IngestVulnerabilityRanges is the resolver for the ingestVulnerabilityRanges field.
+func (r *mutationResolver) IngestVulnerabilityRanges(ctx context.Context, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, vulnerabilityRanges []*model.VulnerabilityRangeInputSpec) ([]string, error) {
+	funcName := "IngestVulnerabilityRanges"
+	ingestedVulnerabilityRangeIDs := []string{}
+	if len(pkgs) != len(vulnerabilities) {
+		return ingestedVulnerabilityRangeIDs, gqlerror.Errorf("%v :: uneven packages and vulnerabilities for ingestion", funcName)
+	}
+	if len(pkgs) != len(vulnerabilityRanges) {
+		return ingestedVulnerabilityRangeIDs, gqlerror.Errorf("%v :: uneven packages and vulnerabilityRanges for ingestion", funcName)
+	}
+	for _, vr := range vulnerabilityRanges {
+		if err := validateVulnerabilityRangeInput(*vr); err != nil {
+			return ingestedVulnerabilityRangeIDs, gqlerror.Errorf("%v ::  %s", funcName, err)
+		}
+	}
+
+	// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
+	var lowercaseVulnList []*model.IDorVulnerabilityInput
+	for _, v := range vulnerabilities {
+		if v.VulnerabilityInput == nil {
+			lowercaseVulnList = append(lowercaseVulnList, v)
+			continue
+		}
+		err := validateNoVul(*v.VulnerabilityInput)
+		if err != nil {
+			return ingestedVulnerabilityRangeIDs, gqlerror.Errorf("%v ::  %s", funcName, err)
+		}
+
+		err = validateVulnerabilityIDInputSpec(*v.VulnerabilityInput)
+		if err != nil {
+			return ingestedVulnerabilityRangeIDs, gqlerror.Errorf("%v ::  %s", funcName, err)
+		}
+
+		lowercaseVulnInput := model.VulnerabilityInputSpec{
+			Type:            strings.ToLower(v.VulnerabilityInput.Type),
+			VulnerabilityID: strings.ToLower(v.VulnerabilityInput.VulnerabilityID),
+		}
+
+		lowercaseVulnList = append(lowercaseVulnList, &model.IDorVulnerabilityInput{
+			VulnerabilityTypeID: v.VulnerabilityTypeID,
+			VulnerabilityNodeID: v.VulnerabilityNodeID,
+			VulnerabilityInput:  &lowercaseVulnInput,
+		})
+	}
+	return r.Backend.IngestVulnerabilityRanges(ctx, pkgs, lowercaseVulnList, vulnerabilityRanges)
+}
+
+// VulnerabilityRange is the resolver for the vulnerabilityRange field.
+func (r *queryResolver) VulnerabilityRange(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRange, error) {
+	funcName := "VulnerabilityRange"
+	filter, err := lowercaseVulnerabilityRangeSpec(vulnerabilityRangeSpec)
+	if err != nil {
+		return []*model.VulnerabilityRange{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	return r.Backend.VulnerabilityRange(ctx, filter)
+}
+
+// VulnerabilityRangeList is the resolver for the vulnerabilityRangeList field.
+func (r *queryResolver) VulnerabilityRangeList(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec, after *string, first *int) (*model.VulnerabilityRangeConnection, error) {
+	funcName := "VulnerabilityRangeList"
+	filter, err := lowercaseVulnerabilityRangeSpec(vulnerabilityRangeSpec)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	return r.Backend.VulnerabilityRangeList(ctx, *filter, after, first)
+}
+
+// VulnerabilityRangeMatches is the resolver for the vulnerabilityRangeMatches field.
+func (r *queryResolver) VulnerabilityRangeMatches(ctx context.Context, vulnerabilityRangeSpec model.VulnerabilityRangeSpec) ([]*model.VulnerabilityRangeMatch, error) {
+	funcName := "VulnerabilityRangeMatches"
+	filter, err := lowercaseVulnerabilityRangeSpec(vulnerabilityRangeSpec)
+	if err != nil {
+		return []*model.VulnerabilityRangeMatch{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	matches, err := matchVulnerabilityRanges(ctx, r.Backend, filter)
+	if err != nil {
+		return []*model.VulnerabilityRangeMatch{}, gqlerror.Errorf("%v ::  %s", funcName, err)
+	}
+	return matches, nil
+}
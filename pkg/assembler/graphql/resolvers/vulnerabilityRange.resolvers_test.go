@@ -0,0 +1,213 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIngestVulnerabilityRange(t *testing.T) {
+	type call struct {
+		Vuln  *model.VulnerabilityInputSpec
+		Range *model.VulnerabilityRangeInputSpec
+	}
+	tests := []struct {
+		Name         string
+		Calls        []call
+		ExpIngestErr bool
+	}{
+		{
+			Name: "Ingest with type novuln",
+			Calls: []call{{
+				Vuln:  &model.VulnerabilityInputSpec{Type: "novuln"},
+				Range: &model.VulnerabilityRangeInputSpec{RangeType: model.VulnerabilityRangeTypeSemver, Fixed: "1.2.0"},
+			}},
+			ExpIngestErr: true,
+		},
+		{
+			Name: "Ingest with fixed and last affected versions",
+			Calls: []call{{
+				Vuln:  testdata.G1,
+				Range: &model.VulnerabilityRangeInputSpec{RangeType: model.VulnerabilityRangeTypeSemver, Fixed: "1.2.0", LastAffected: "1.1.0"},
+			}},
+			ExpIngestErr: true,
+		},
+		{
+			Name: "Happy path",
+			Calls: []call{{
+				Vuln:  testdata.G1,
+				Range: &model.VulnerabilityRangeInputSpec{RangeType: model.VulnerabilityRangeTypeSemver, Introduced: "1.0.0", Fixed: "1.2.0"},
+			}},
+			ExpIngestErr: false,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			for _, o := range test.Calls {
+				times := 1
+				if test.ExpIngestErr {
+					times = 0
+				}
+				b.
+					EXPECT().
+					IngestVulnerabilityRange(ctx, gomock.Any(), gomock.Any(), *o.Range).
+					Return("xyz", nil).
+					Times(times)
+				_, err := r.Mutation().IngestVulnerabilityRange(ctx, model.IDorPkgInput{PackageInput: testdata.P1},
+					model.IDorVulnerabilityInput{VulnerabilityInput: o.Vuln}, *o.Range)
+				if (err != nil) != test.ExpIngestErr {
+					t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+				}
+			}
+		})
+	}
+}
+
+func TestVulnerabilityRangeMatches(t *testing.T) {
+	tensorflow := func(versions ...string) *model.Package {
+		var vs []*model.PackageVersion
+		for _, v := range versions {
+			vs = append(vs, &model.PackageVersion{ID: v, Version: v})
+		}
+		return &model.Package{
+			ID:   "pypi",
+			Type: "pypi",
+			Namespaces: []*model.PackageNamespace{{
+				ID: "ns",
+				Names: []*model.PackageName{{
+					ID:       "tensorflow",
+					Name:     "tensorflow",
+					Versions: vs,
+				}},
+			}},
+		}
+	}
+	semver := &model.VulnerabilityRange{
+		ID:         "1",
+		Package:    tensorflow(),
+		RangeType:  model.VulnerabilityRangeTypeSemver,
+		Introduced: "2.0.0",
+		Fixed:      "2.11.2",
+	}
+	lastAffected := &model.VulnerabilityRange{
+		ID:           "2",
+		Package:      tensorflow(),
+		RangeType:    model.VulnerabilityRangeTypeEcosystem,
+		LastAffected: "1.0.0",
+	}
+	git := &model.VulnerabilityRange{
+		ID:         "3",
+		Package:    tensorflow(),
+		RangeType:  model.VulnerabilityRangeTypeGit,
+		Introduced: "abc123",
+	}
+	none := &model.VulnerabilityRange{
+		ID:         "4",
+		Package:    tensorflow(),
+		RangeType:  model.VulnerabilityRangeTypeSemver,
+		Introduced: "3.0.0",
+	}
+	tests := []struct {
+		Name       string
+		Filter     model.VulnerabilityRangeSpec
+		Ranges     []*model.VulnerabilityRange
+		Versions   []*model.Package
+		ExpVersion *model.PkgSpec
+		ExpMatches []*model.VulnerabilityRangeMatch
+	}{
+		{
+			Name:     "Versions in the ranges",
+			Ranges:   []*model.VulnerabilityRange{semver, lastAffected},
+			Versions: []*model.Package{tensorflow("0.9.0", "1.0.0", "2.11.1", "2.11.2", "main")},
+			ExpMatches: []*model.VulnerabilityRangeMatch{
+				{VulnerabilityRange: semver, Package: tensorflow("2.11.1")},
+				{VulnerabilityRange: lastAffected, Package: tensorflow("0.9.0", "1.0.0")},
+			},
+		},
+		{
+			Name:       "No version in the range",
+			Ranges:     []*model.VulnerabilityRange{none},
+			Versions:   []*model.Package{tensorflow("2.11.1")},
+			ExpMatches: []*model.VulnerabilityRangeMatch{},
+		},
+		{
+			Name:       "Git ranges are not matched",
+			Ranges:     []*model.VulnerabilityRange{git},
+			ExpMatches: []*model.VulnerabilityRangeMatch{},
+		},
+		{
+			Name: "Version filter",
+			Filter: model.VulnerabilityRangeSpec{
+				Package: &model.PkgSpec{Name: ptrfrom.String("tensorflow"), Version: ptrfrom.String("2.11.1")},
+			},
+			Ranges:     []*model.VulnerabilityRange{semver},
+			Versions:   []*model.Package{tensorflow("2.11.1")},
+			ExpVersion: &model.PkgSpec{Version: ptrfrom.String("2.11.1")},
+			ExpMatches: []*model.VulnerabilityRangeMatch{
+				{VulnerabilityRange: semver, Package: tensorflow("2.11.1")},
+			},
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			b.
+				EXPECT().
+				VulnerabilityRange(ctx, gomock.Any()).
+				Return(test.Ranges, nil)
+			times := 1
+			if test.Versions == nil {
+				times = 0
+			}
+			b.
+				EXPECT().
+				Packages(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, spec *model.PkgSpec) ([]*model.Package, error) {
+					if *spec.Name != "tensorflow" {
+						t.Errorf("Packages() queried name %q, want tensorflow", *spec.Name)
+					}
+					if test.ExpVersion != nil && *spec.Version != *test.ExpVersion.Version {
+						t.Errorf("Packages() queried version %q, want %q", *spec.Version, *test.ExpVersion.Version)
+					}
+					return test.Versions, nil
+				}).
+				Times(times)
+			got, err := r.Query().VulnerabilityRangeMatches(ctx, test.Filter)
+			if err != nil {
+				t.Fatalf("did not expect query error, got: %v", err)
+			}
+			if diff := cmp.Diff(test.ExpMatches, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/misc/cpe"
+)
+
+// PurlCpeGuac prefixes the guac purls of the packages identified by a CPE.
+const PurlCpeGuac = "pkg:guac/cpe/"
+
+// CpeToPurl converts a CPE, bound either as a CPE 2.3 formatted string or as
+// a CPE 2.2 URI, into the guac purl of the package it identifies:
+// pkg:guac/cpe/<vendor>/<product>@<version>. A CPE with an ANY or NA version
+// identifies all the versions of the package.
+func CpeToPurl(c string) (string, error) {
+	w, err := cpe.Parse(c)
+	if err != nil {
+		return "", err
+	}
+	return cpe.GuacPurl(w)
+}
+
+// CpeToPkg converts a CPE into the guac package node it identifies, see
+// CpeToPurl.
+func CpeToPkg(c string) (*model.PkgInputSpec, error) {
+	p, err := CpeToPurl(c)
+	if err != nil {
+		return nil, err
+	}
+	return PurlToPkg(p)
+}
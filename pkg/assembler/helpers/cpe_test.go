@@ -0,0 +1,72 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestCpeToPurl(t *testing.T) {
+	testCases := []struct {
+		cpe      string
+		wantErr  bool
+		expected string
+	}{
+		{
+			cpe:      "cpe:2.3:a:alpine-baselayout:alpine_baselayout:3.2.0-r22:*:*:*:*:*:*:*",
+			expected: "pkg:guac/cpe/alpine-baselayout/alpine_baselayout@3.2.0-r22",
+		},
+		{
+			cpe:      "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*",
+			expected: "pkg:guac/cpe/apache/log4j",
+		},
+		{
+			cpe:      `cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*`,
+			expected: "pkg:guac/cpe/microsoft/internet_explorer@8.0.6001",
+		},
+		{
+			cpe:      `cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570\:ab:*:*:*:*:*:*:*`,
+			expected: "pkg:guac/cpe/hp/insight_diagnostics@7.4.0.1570%3Aab",
+		},
+		{
+			cpe:      "cpe:/o:redhat:enterprise_linux:8::baseos",
+			expected: "pkg:guac/cpe/redhat/enterprise_linux@8",
+		},
+		{
+			cpe:      "cpe:/a:Acme:Widget%21",
+			expected: "pkg:guac/cpe/acme/widget%21",
+		},
+		{
+			cpe:     "cpe:2.3:a:*:*:*:*:*:*:*:*:*:*",
+			wantErr: true,
+		},
+		{
+			cpe:     "pkg:npm/lodash",
+			wantErr: true,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.cpe, func(t *testing.T) {
+			got, err := CpeToPurl(tt.cpe)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CpeToPurl() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("CpeToPurl() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bolt is a kv.Store that keeps the data in an embedded bbolt
+// database file, so that the keyvalue backend survives restarts without an
+// external database.
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	bbolt "go.etcd.io/bbolt"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+var json = jsoniter.ConfigFastest
+
+const count = 1000
+
+// openTimeout is how long to wait for the file lock of a database that is
+// used by another process.
+const openTimeout = 5 * time.Second
+
+type store struct {
+	db *bbolt.DB
+}
+
+// GetStore opens, or creates, the bbolt database at path. Each collection is
+// stored as a bucket.
+func GetStore(path string) (kv.Store, error) {
+	db, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &store{db: db}, nil
+}
+
+func open(path string) (*bbolt.DB, error) {
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: openTimeout})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, fmt.Errorf("bbolt database %q is in use by another process", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bbolt database %q: %w", path, err)
+	}
+	return db, nil
+}
+
+func (s *store) Get(_ context.Context, c, k string, v any) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(c))
+		if b == nil {
+			return fmt.Errorf("%w : Collection %q", kv.NotFoundError, c)
+		}
+		bts := b.Get([]byte(k))
+		if bts == nil {
+			return fmt.Errorf("%w : Key %q", kv.NotFoundError, k)
+		}
+		return json.Unmarshal(bts, v)
+	})
+}
+
+func (s *store) Set(ctx context.Context, c, k string, v any) error {
+	b := &kv.Batch{}
+	b.Set(c, k, v)
+	return s.Write(ctx, b)
+}
+
+func (s *store) Delete(ctx context.Context, c, k string) error {
+	b := &kv.Batch{}
+	b.Delete(c, k)
+	return s.Write(ctx, b)
+}
+
+// Write applies the batch in a single bbolt read-write transaction.
+func (s *store) Write(_ context.Context, b *kv.Batch) error {
+	if b.Len() == 0 {
+		return nil
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		for _, op := range b.Ops() {
+			if op.Delete {
+				bkt := tx.Bucket([]byte(op.Collection))
+				if bkt == nil {
+					continue
+				}
+				if err := bkt.Delete([]byte(op.Key)); err != nil {
+					return err
+				}
+				continue
+			}
+			bts, err := json.Marshal(op.Value)
+			if err != nil {
+				return err
+			}
+			bkt, err := tx.CreateBucketIfNotExists([]byte(op.Collection))
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put([]byte(op.Key), bts); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *store) Keys(c string) kv.Scanner {
+	return &scanner{
+		collection: []byte(c),
+		db:         s.db,
+	}
+}
+
+type scanner struct {
+	collection []byte
+	db         *bbolt.DB
+	done       bool
+	last       []byte
+}
+
+// Scan returns up to count keys per call, in key order. Each call uses its own
+// read transaction and continues after the last key returned.
+func (s *scanner) Scan(_ context.Context) ([]string, bool, error) {
+	if s.done {
+		return nil, true, nil
+	}
+	var rv []string
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(s.collection)
+		if b == nil {
+			s.done = true
+			return nil
+		}
+		cur := b.Cursor()
+		var k []byte
+		if s.last == nil {
+			k, _ = cur.First()
+		} else {
+			k, _ = cur.Seek(s.last)
+			if k != nil && string(k) == string(s.last) {
+				k, _ = cur.Next()
+			}
+		}
+		for ; k != nil && len(rv) < count; k, _ = cur.Next() {
+			rv = append(rv, string(k))
+		}
+		if k == nil {
+			s.done = true
+		}
+		if len(rv) > 0 {
+			s.last = []byte(rv[len(rv)-1])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return rv, s.done, nil
+}
+
+// Backup writes a consistent copy of the database at path to w. The database
+// must not be open in another process, such as a running guacgql.
+func Backup(path string, w io.Writer) error {
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	db, err := open(path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return db.View(func(tx *bbolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// Compact rewrites the database at path without its free pages, which bbolt
+// never gives back to the file system on its own. The compacted copy replaces
+// the original only once it has been written completely. The database must
+// not be open in another process, such as a running guacgql.
+func Compact(path string) (before, after int64, err error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	src, err := open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".compact-*")
+	if err != nil {
+		_ = src.Close()
+		return 0, 0, err
+	}
+	tmpPath := tmp.Name()
+	_ = tmp.Close()
+	defer os.Remove(tmpPath)
+
+	dst, err := open(tmpPath)
+	if err != nil {
+		_ = src.Close()
+		return 0, 0, err
+	}
+	err = bbolt.Compact(dst, src, 64*1024*1024)
+	_ = src.Close()
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to compact %q: %w", path, err)
+	}
+	nfi, err := os.Stat(tmpPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return 0, 0, err
+	}
+	return fi.Size(), nfi.Size(), nil
+}
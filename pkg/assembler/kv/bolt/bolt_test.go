@@ -0,0 +1,170 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+type value struct {
+	Name string
+	IDs  []string
+}
+
+func scanAll(ctx context.Context, t *testing.T, s kv.Store, c string) []string {
+	t.Helper()
+	var out []string
+	scn := s.Keys(c)
+	for done := false; !done; {
+		var ks []string
+		var err error
+		ks, done, err = scn.Scan(ctx)
+		if err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+		out = append(out, ks...)
+	}
+	return out
+}
+
+func closeStore(t *testing.T, s kv.Store) {
+	t.Helper()
+	if err := s.(*store).db.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "guac.db")
+	s, err := GetStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v value
+	if err := s.Get(ctx, "c", "missing", &v); !errors.Is(err, kv.NotFoundError) {
+		t.Errorf("Get from missing collection, want NotFoundError, got %v", err)
+	}
+	if got := scanAll(ctx, t, s, "c"); got != nil {
+		t.Errorf("Keys of missing collection = %v", got)
+	}
+
+	// More keys than fit in one Scan.
+	var want []string
+	for i := 0; i < count+10; i++ {
+		k := fmt.Sprintf("k%05d", i)
+		want = append(want, k)
+		if err := s.Set(ctx, "c", k, &value{Name: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := scanAll(ctx, t, s, "c"); !slices.Equal(got, want) {
+		t.Errorf("Keys returned %d keys, want %d", len(got), len(want))
+	}
+
+	b := &kv.Batch{}
+	b.Set("c", "k00000", &value{Name: "changed", IDs: []string{"1"}})
+	b.Delete("c", "k00001")
+	b.Set("other", "x", &value{Name: "x"})
+	if err := s.Write(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Get(ctx, "c", "k00000", &v); err != nil || v.Name != "changed" || !slices.Equal(v.IDs, []string{"1"}) {
+		t.Errorf("Get after Write = %+v, %v", v, err)
+	}
+	if err := s.Get(ctx, "c", "k00001", &v); !errors.Is(err, kv.NotFoundError) {
+		t.Errorf("Get of deleted key, want NotFoundError, got %v", err)
+	}
+	if err := s.Delete(ctx, "nope", "nope"); err != nil {
+		t.Errorf("Delete from missing collection: %v", err)
+	}
+
+	// The data is still there after opening the file again.
+	closeStore(t, s)
+	s, err = GetStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Get(ctx, "other", "x", &v); err != nil || v.Name != "x" {
+		t.Errorf("Get after reopen = %+v, %v", v, err)
+	}
+	closeStore(t, s)
+}
+
+func TestBackupAndCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "guac.db")
+	s, err := GetStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5000; i++ {
+		if err := s.Set(ctx, "c", fmt.Sprintf("k%05d", i), &value{Name: "some value to take up space"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 100; i < 5000; i++ {
+		if err := s.Delete(ctx, "c", fmt.Sprintf("k%05d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	closeStore(t, s)
+
+	backup := filepath.Join(dir, "backup.db")
+	f, err := os.Create(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Backup(path, f); err != nil {
+		t.Fatalf("Backup: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	before, after, err := Compact(path)
+	if err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	if after >= before {
+		t.Errorf("Compact did not shrink the file: %d -> %d bytes", before, after)
+	}
+
+	for _, p := range []string{path, backup} {
+		s, err := GetStore(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := scanAll(ctx, t, s, "c"); len(got) != 100 {
+			t.Errorf("%s has %d keys, want 100", filepath.Base(p), len(got))
+		}
+		closeStore(t, s)
+	}
+
+	if err := Backup(filepath.Join(dir, "missing.db"), f); err == nil {
+		t.Error("expected Backup of a missing database to fail")
+	}
+}
@@ -31,10 +31,53 @@ type Store interface {
 	// Sets a value, creates collection if necessary
 	Set(ctx context.Context, collection, key string, value any) error
 
+	// Removes a value from the store. Deleting a key that does not exist is
+	// not an error.
+	Delete(ctx context.Context, collection, key string) error
+
+	// Applies all the writes in a batch atomically: either all of them are
+	// stored or none are.
+	Write(ctx context.Context, b *Batch) error
+
 	// Create a scanner that will be used to get all the keys in a collection.
 	Keys(collection string) Scanner
 }
 
+// Op is a single write in a Batch. If Delete is set, Value is ignored and
+// the key is removed.
+type Op struct {
+	Collection string
+	Key        string
+	Value      any
+	Delete     bool
+}
+
+// Batch collects writes to be applied together with Store.Write. Writes are
+// applied in the order they were added.
+type Batch struct {
+	ops []Op
+}
+
+// Set adds a write of value to the batch.
+func (b *Batch) Set(collection, key string, value any) {
+	b.ops = append(b.ops, Op{Collection: collection, Key: key, Value: value})
+}
+
+// Delete adds a removal of key to the batch.
+func (b *Batch) Delete(collection, key string) {
+	b.ops = append(b.ops, Op{Collection: collection, Key: key, Delete: true})
+}
+
+// Ops returns the writes in the batch, in order.
+func (b *Batch) Ops() []Op {
+	return b.ops
+}
+
+// Len returns the number of writes in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
 // Error to return (wrap) on Get if value not found
 var NotFoundError = errors.New("Not found")
 
@@ -56,6 +56,22 @@ func (s *store) Set(_ context.Context, c, k string, v any) error {
 	return nil
 }
 
+func (s *store) Delete(_ context.Context, c, k string) error {
+	delete(s.m[c], k)
+	return nil
+}
+
+func (s *store) Write(ctx context.Context, b *kv.Batch) error {
+	for _, op := range b.Ops() {
+		if op.Delete {
+			_ = s.Delete(ctx, op.Collection, op.Key)
+			continue
+		}
+		_ = s.Set(ctx, op.Collection, op.Key, op.Value)
+	}
+	return nil
+}
+
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{
 		collection: c,
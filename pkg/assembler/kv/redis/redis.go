@@ -67,6 +67,39 @@ func (s *store) Set(ctx context.Context, c, k string, v any) error {
 	return s.c.HSet(ctx, c, k, string(b)).Err()
 }
 
+func (s *store) Delete(ctx context.Context, c, k string) error {
+	return s.c.HDel(ctx, c, k).Err()
+}
+
+// Write applies the batch in a MULTI/EXEC transaction.
+func (s *store) Write(ctx context.Context, b *kv.Batch) error {
+	if b.Len() == 0 {
+		return nil
+	}
+	vals := make([]string, b.Len())
+	for i, op := range b.Ops() {
+		if op.Delete {
+			continue
+		}
+		bts, err := json.Marshal(op.Value)
+		if err != nil {
+			return err
+		}
+		vals[i] = string(bts)
+	}
+	_, err := s.c.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, op := range b.Ops() {
+			if op.Delete {
+				pipe.HDel(ctx, op.Collection, op.Key)
+				continue
+			}
+			pipe.HSet(ctx, op.Collection, op.Key, vals[i])
+		}
+		return nil
+	})
+	return err
+}
+
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{
 		collection: c,
@@ -16,29 +16,54 @@
 package tikv
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/guacsec/guac/pkg/assembler/kv"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tikv/client-go/v2/config"
+	tikverr "github.com/tikv/client-go/v2/error"
 	kvti "github.com/tikv/client-go/v2/kv"
 	"github.com/tikv/client-go/v2/rawkv"
+	"github.com/tikv/client-go/v2/txnkv"
+	"github.com/tikv/client-go/v2/txnkv/transaction"
 )
 
 var json = jsoniter.ConfigFastest
 
 const count = 1000
 
+// ErrRawData is returned by GetStore when the cluster still holds data
+// written with the raw TiKV API by earlier versions of GUAC. The
+// transactional API cannot read that data, so it has to be moved with Migrate
+// first.
+var ErrRawData = errors.New("TiKV holds data written with the raw API by an earlier GUAC version")
+
+// store uses the transactional TiKV API so that batches written with Write
+// are applied atomically.
 type store struct {
-	c *rawkv.Client
+	c *txnkv.Client
 }
 
 func GetStore(ctx context.Context, s string) (kv.Store, error) {
 	// TODO(jeffmendoza) add options for security, etc.
-	c, err := rawkv.NewClient(ctx, []string{s}, config.Security{})
+	raw, err := rawkv.NewClient(ctx, []string{s}, config.Security{})
+	if err != nil {
+		return nil, err
+	}
+	found, err := hasRawData(ctx, raw)
+	_ = raw.Close()
+	if err != nil {
+		return nil, fmt.Errorf("checking for raw TiKV data: %w", err)
+	}
+	if found {
+		return nil, ErrRawData
+	}
+	c, err := txnkv.NewClient([]string{s})
 	if err != nil {
 		return nil, err
 	}
@@ -47,12 +72,105 @@ func GetStore(ctx context.Context, s string) (kv.Store, error) {
 	}, nil
 }
 
+// isRawKey reports whether a key found with the raw API was written by the
+// raw API. Keys written by the transactional API are stored memcomparable
+// encoded with a timestamp suffix, which is never valid UTF-8, while GUAC keys
+// always are.
+func isRawKey(k []byte) bool {
+	return utf8.Valid(k) && strings.Contains(string(k), ":")
+}
+
+// hasRawData looks for raw entries in the index collection, which has an
+// entry for every node the keyvalue backend stores.
+func hasRawData(ctx context.Context, raw *rawkv.Client) (bool, error) {
+	start := []byte("index:")
+	end := kvti.PrefixNextKey(start)
+	for {
+		keys, _, err := raw.Scan(ctx, start, end, count, rawkv.ScanKeyOnly())
+		if err != nil {
+			return false, err
+		}
+		for _, k := range keys {
+			if isRawKey(k) {
+				return true, nil
+			}
+		}
+		if len(keys) < count {
+			return false, nil
+		}
+		start = kvti.NextKey(keys[len(keys)-1])
+	}
+}
+
+// Migrate moves all data written with the raw TiKV API by earlier versions of
+// GUAC to the transactional API used now. Entries are copied in batches and
+// removed from the raw keyspace once committed, so an interrupted migration
+// can be run again.
+func Migrate(ctx context.Context, s string) error {
+	raw, err := rawkv.NewClient(ctx, []string{s}, config.Security{})
+	if err != nil {
+		return err
+	}
+	defer raw.Close()
+	c, err := txnkv.NewClient([]string{s})
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	var start []byte
+	for {
+		keys, values, err := raw.Scan(ctx, start, nil, count)
+		if err != nil {
+			return err
+		}
+		var moved [][]byte
+		txn, err := newTxn(c)
+		if err != nil {
+			return err
+		}
+		for i, k := range keys {
+			if !isRawKey(k) {
+				continue
+			}
+			if err := txn.Set(k, values[i]); err != nil {
+				_ = txn.Rollback()
+				return err
+			}
+			moved = append(moved, k)
+		}
+		if err := txn.Commit(ctx); err != nil {
+			return err
+		}
+		if len(moved) > 0 {
+			if err := raw.BatchDelete(ctx, moved); err != nil {
+				return err
+			}
+		}
+		if len(keys) < count {
+			return nil
+		}
+		start = kvti.NextKey(keys[len(keys)-1])
+	}
+}
+
+// newTxn begins a transaction that commits in one phase when all its writes
+// land in a single region, which is the common case for single writes.
+func newTxn(c *txnkv.Client) (*transaction.KVTxn, error) {
+	txn, err := c.Begin()
+	if err != nil {
+		return nil, err
+	}
+	txn.SetEnable1PC(true)
+	return txn, nil
+}
+
+// Get reads from a snapshot of the latest committed data, so that single
+// reads do not need a transaction.
 func (s *store) Get(ctx context.Context, c, k string, v any) error {
 	ck := strings.Join([]string{c, k}, ":")
-	bts, err := s.c.Get(ctx, []byte(ck))
-	// TODO(jeffmendoza), should figure out error type and check it, instead just see if
-	// slice is empty for now.
-	if len(bts) == 0 {
+	bts, err := s.c.GetSnapshot(math.MaxUint64).Get(ctx, []byte(ck))
+	if tikverr.IsErrNotFound(err) || (err == nil && len(bts) == 0) {
 		return kv.NotFoundError
 	}
 	if err != nil {
@@ -62,25 +180,56 @@ func (s *store) Get(ctx context.Context, c, k string, v any) error {
 }
 
 func (s *store) Set(ctx context.Context, c, k string, v any) error {
-	ck := strings.Join([]string{c, k}, ":")
-	bts, err := json.Marshal(v)
+	b := &kv.Batch{}
+	b.Set(c, k, v)
+	return s.Write(ctx, b)
+}
+
+func (s *store) Delete(ctx context.Context, c, k string) error {
+	b := &kv.Batch{}
+	b.Delete(c, k)
+	return s.Write(ctx, b)
+}
+
+// Write applies the batch in a single TiKV transaction.
+func (s *store) Write(ctx context.Context, b *kv.Batch) error {
+	if b.Len() == 0 {
+		return nil
+	}
+	txn, err := newTxn(s.c)
 	if err != nil {
 		return err
 	}
-	return s.c.Put(ctx, []byte(ck), bts)
+	for _, op := range b.Ops() {
+		ck := []byte(strings.Join([]string{op.Collection, op.Key}, ":"))
+		if op.Delete {
+			err = txn.Delete(ck)
+		} else {
+			var bts []byte
+			bts, err = json.Marshal(op.Value)
+			if err == nil {
+				err = txn.Set(ck, bts)
+			}
+		}
+		if err != nil {
+			_ = txn.Rollback()
+			return err
+		}
+	}
+	return txn.Commit(ctx)
 }
 
 func (s *store) Keys(c string) kv.Scanner {
 	return &scanner{
 		c:      s.c,
 		done:   false,
-		curKey: []byte(c),
-		endKey: kvti.PrefixNextKey([]byte(c)),
+		curKey: []byte(c + ":"),
+		endKey: kvti.PrefixNextKey([]byte(c + ":")),
 	}
 }
 
 type scanner struct {
-	c      *rawkv.Client
+	c      *txnkv.Client
 	done   bool
 	curKey []byte
 	endKey []byte
@@ -90,28 +239,30 @@ func (s *scanner) Scan(ctx context.Context) ([]string, bool, error) {
 	if s.done {
 		return nil, true, nil
 	}
-	ks, _, err := s.c.Scan(ctx, s.curKey, s.endKey, count, rawkv.ScanKeyOnly())
+	it, err := s.c.GetSnapshot(math.MaxUint64).Iter(s.curKey, s.endKey)
 	if err != nil {
 		return nil, false, err
 	}
-	if len(ks) < count {
-		s.done = true
-	}
-	if len(ks) == 0 {
-		return nil, true, nil
-	}
-	rv := make([]string, len(ks))
-	var largest []byte
-	for i, k := range ks {
-		if bytes.Compare(k, largest) > 0 {
-			largest = k
-		}
+	defer it.Close()
+	var rv []string
+	var last []byte
+	for it.Valid() && len(rv) < count {
+		k := it.Key()
 		parts := strings.SplitN(string(k), ":", 2)
 		if len(parts) != 2 {
 			return nil, false, fmt.Errorf("Invalid key found in TiKV: %q", string(k))
 		}
-		rv[i] = string(parts[1])
+		rv = append(rv, parts[1])
+		last = k
+		if err := it.Next(); err != nil {
+			return nil, false, err
+		}
+	}
+	if !it.Valid() {
+		s.done = true
+	}
+	if last != nil {
+		s.curKey = kvti.NextKey(last)
 	}
-	s.curKey = kvti.NextKey(largest)
 	return rv, s.done, nil
 }
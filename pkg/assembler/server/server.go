@@ -5,12 +5,20 @@ import (
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/events"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
 )
 
 func GetGraphqlServer(ctx context.Context, backend backends.Backend) *handler.Server {
-	topResolver := resolvers.Resolver{Backend: backend}
+	return GetGraphqlServerWithEvents(ctx, backend, events.NewBus())
+}
+
+// GetGraphqlServerWithEvents returns a server whose ingestions are published
+// to bus, which also feeds the GraphQL subscriptions. Subscriptions are
+// served over WebSocket on the same endpoint as queries.
+func GetGraphqlServerWithEvents(ctx context.Context, backend backends.Backend, bus *events.Bus) *handler.Server {
+	topResolver := resolvers.Resolver{Backend: events.Publishing(backend, bus), Events: bus}
 	config := generated.Config{Resolvers: &topResolver}
 	config.Directives.Filter = resolvers.Filter
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
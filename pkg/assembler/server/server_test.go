@@ -3,7 +3,9 @@ package server
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/client"
 	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
 
 	"github.com/guacsec/guac/internal/testing/stablememmap"
@@ -24,3 +26,59 @@ func TestGetGraphqlServer(t *testing.T) {
 		t.Errorf("Expected GetGraphqlServer to return a non-nil server")
 	}
 }
+
+func TestSubscriptionOverWebsocket(t *testing.T) {
+	ctx := context.Background()
+
+	store := stablememmap.GetStore()
+	backend, err := backends.Get("keyvalue", ctx, store)
+	if err != nil {
+		t.Fatalf("Error getting backend: %v", err)
+	}
+	c := client.New(GetGraphqlServer(ctx, backend))
+
+	sub := c.Websocket(`subscription { hasSBOMIngested(subject: {artifact: {digest: "abc"}}) { id uri } }`)
+	defer sub.Close()
+
+	type result struct {
+		HasSBOMIngested struct {
+			ID  string
+			URI string
+		}
+	}
+	received := make(chan result, 1)
+	go func() {
+		var r result
+		if err := sub.Next(&r); err != nil {
+			t.Errorf("error reading subscription: %v", err)
+			close(received)
+			return
+		}
+		received <- r
+	}()
+
+	var art struct{ IngestArtifact string }
+	c.MustPost(`mutation { ingestArtifact(artifact: {artifactInput: {algorithm: "sha256", digest: "abc"}}) }`, &art)
+
+	// the subscription is registered asynchronously, so ingest until the
+	// first event makes it through
+	var ingested struct{ IngestHasSBOM string }
+	for i := 0; i < 50; i++ {
+		c.MustPost(`mutation {
+			ingestHasSBOM(
+				subject: {artifact: {artifactInput: {algorithm: "sha256", digest: "abc"}}}
+				hasSBOM: {uri: "sbom-uri", algorithm: "sha256", digest: "def", downloadLocation: "", knownSince: "2023-01-01T00:00:00Z", origin: "o", collector: "c", documentRef: ""}
+				includes: {packages: [], artifacts: [], dependencies: [], occurrences: []}
+			)
+		}`, &ingested)
+		select {
+		case r := <-received:
+			if r.HasSBOMIngested.ID != ingested.IngestHasSBOM || r.HasSBOMIngested.URI != "sbom-uri" {
+				t.Errorf("unexpected subscription result: %+v", r)
+			}
+			return
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+	t.Fatal("did not receive subscription result")
+}
@@ -0,0 +1,136 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/misc/cpe"
+)
+
+var ErrCPEComponentTypeMismatch = errors.New("rootComponent type is not []*root_package.PackageNode")
+
+const (
+	CPECollector  = "cpe_dictionary"
+	justification = "cpe dictionary match"
+)
+
+type cpeCertifier struct {
+	dictionary *cpe.Dictionary
+}
+
+// NewCPECertifier returns the certifier linking packages to the CPEs the
+// dictionary matches to their purls. Each package is linked by a PkgEqual to
+// the guac package of each CPE (see helpers.CpeToPurl), and the CPE is
+// recorded as its "cpe" metadata, so that vulnerability data keyed on the
+// CPE reaches the package.
+func NewCPECertifier(dictionary *cpe.Dictionary) certifier.Certifier {
+	return &cpeCertifier{dictionary: dictionary}
+}
+
+// CertifyComponent emits an ingest predicates document for the packages the
+// dictionary matches to CPEs.
+func (c *cpeCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	packageNodes, ok := rootComponent.([]*root_package.PackageNode)
+	if !ok {
+		return ErrCPEComponentTypeMismatch
+	}
+
+	preds, err := c.predicates(packageNodes)
+	if err != nil {
+		return err
+	}
+	if len(preds.PkgEqual) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(preds)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cpe predicates: %w", err)
+	}
+	doc := &processor.Document{
+		Blob:   payload,
+		Type:   processor.DocumentIngestPredicates,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   CPECollector,
+			Source:      CPECollector,
+			DocumentRef: events.GetDocRef(payload),
+		},
+	}
+	if docChannel != nil {
+		docChannel <- doc
+	}
+	return nil
+}
+
+func (c *cpeCertifier) predicates(packageNodes []*root_package.PackageNode) (*assembler.IngestPredicates, error) {
+	preds := &assembler.IngestPredicates{}
+	// the metadata is timestamped by the dictionary so that the same
+	// matches are ingested as the same metadata on each run
+	updated := c.dictionary.Updated()
+	for _, node := range packageNodes {
+		names, err := c.dictionary.CPEs(node.Purl)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) == 0 {
+			continue
+		}
+		pkg, err := helpers.PurlToPkg(node.Purl)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse purl %s: %w", node.Purl, err)
+		}
+		for _, name := range names {
+			cpePurl, err := cpe.GuacPurl(name)
+			if err != nil {
+				return nil, err
+			}
+			cpePkg, err := helpers.PurlToPkg(cpePurl)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse purl %s: %w", cpePurl, err)
+			}
+			preds.PkgEqual = append(preds.PkgEqual, assembler.PkgEqualIngest{
+				Pkg:      pkg,
+				EqualPkg: cpePkg,
+				PkgEqual: &model.PkgEqualInputSpec{
+					Justification: justification,
+				},
+			})
+			preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+				Pkg:          pkg,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				HasMetadata: &model.HasMetadataInputSpec{
+					Key:           "cpe",
+					Value:         name.String(),
+					Timestamp:     updated,
+					Justification: justification,
+				},
+			})
+		}
+	}
+	return preds, nil
+}
@@ -0,0 +1,98 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpe
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/cpe"
+)
+
+func TestCPECertifier(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	dictionary, err := cpe.NewDictionary([]cpe.DictionaryEntry{
+		{CPE: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", Purl: "pkg:maven/org.apache.logging.log4j/log4j-core"},
+		{CPE: "cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*", Purl: "pkg:deb/debian/openssl?distro=debian-11"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCPECertifier(dictionary)
+	if err := c.CertifyComponent(ctx, "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", nil); err != ErrCPEComponentTypeMismatch {
+		t.Errorf("CertifyComponent() = %v, want %v", err, ErrCPEComponentTypeMismatch)
+	}
+
+	docChan := make(chan *processor.Document, 10)
+	packages := []*root_package.PackageNode{
+		{Purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+		{Purl: "pkg:deb/debian/openssl@1.1.1n-0+deb11u4?arch=amd64&distro=debian-11"},
+		{Purl: "pkg:deb/debian/openssl@3.0.11-1?distro=debian-12"},
+		{Purl: "pkg:npm/lodash@4.17.21"},
+	}
+	if err := c.CertifyComponent(ctx, packages, docChan); err != nil {
+		t.Fatalf("CertifyComponent() = %v", err)
+	}
+	close(docChan)
+
+	var docs []*processor.Document
+	for d := range docChan {
+		docs = append(docs, d)
+	}
+	if len(docs) != 1 {
+		t.Fatalf("got %d documents, want 1", len(docs))
+	}
+	if docs[0].Type != processor.DocumentIngestPredicates || docs[0].SourceInformation.Collector != CPECollector {
+		t.Errorf("unexpected document type %s from %s", docs[0].Type, docs[0].SourceInformation.Collector)
+	}
+
+	var preds assembler.IngestPredicates
+	if err := json.Unmarshal(docs[0].Blob, &preds); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, pe := range preds.PkgEqual {
+		got = append(got, pe.Pkg.Name+"@"+*pe.Pkg.Version+" = "+pe.EqualPkg.Type+"/"+*pe.EqualPkg.Namespace+"/"+pe.EqualPkg.Name+"@"+*pe.EqualPkg.Version)
+	}
+	want := []string{
+		"log4j-core@2.14.1 = guac/cpe/apache/log4j@2.14.1",
+		"openssl@1.1.1n-0+deb11u4 = guac/cpe/openssl/openssl@1.1.1n-0+deb11u4",
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("unexpected pkgEquals (-want +got):\n%s", d)
+	}
+	var cpes []string
+	for _, hm := range preds.HasMetadata {
+		cpes = append(cpes, hm.HasMetadata.Key+" "+hm.HasMetadata.Value)
+		if !hm.HasMetadata.Timestamp.Equal(dictionary.Updated()) {
+			t.Errorf("timestamp of %s = %v, want the dictionary update %v", hm.HasMetadata.Value, hm.HasMetadata.Timestamp, dictionary.Updated())
+		}
+	}
+	wantCPEs := []string{
+		"cpe cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+		`cpe cpe:2.3:a:openssl:openssl:1.1.1n-0\+deb11u4:*:*:*:*:*:*:*`,
+	}
+	if d := cmp.Diff(wantCPEs, cpes); d != "" {
+		t.Errorf("unexpected cpe metadata (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,220 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	osv_models "github.com/google/osv-scanner/pkg/models"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/osvrange"
+	"github.com/package-url/packageurl-go"
+)
+
+// offlineDatabase is a downloaded OSV database: OSV records as JSON files,
+// or zip archives of them such as the per-ecosystem all.zip exports, in a
+// directory or on their own.
+type offlineDatabase struct {
+	path string
+	// records are the OSV records by the package keys of their affected
+	// packages.
+	records map[string][]*osv_models.Vulnerability
+	// lastUpdate is the most recent modification of the records.
+	lastUpdate time.Time
+	// modTime is the modification time of the files of the database when
+	// it was loaded.
+	modTime time.Time
+}
+
+// databaseModTime returns the most recent modification time of the files
+// and directories under path.
+func databaseModTime(path string) (time.Time, error) {
+	var modTime time.Time
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+		return nil
+	})
+	return modTime, err
+}
+
+// loadOfflineDatabase reads the OSV records under path.
+func loadOfflineDatabase(ctx context.Context, path string) (*offlineDatabase, error) {
+	db := &offlineDatabase{
+		path:    path,
+		records: map[string][]*osv_models.Vulnerability{},
+	}
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".zip":
+			return db.loadZip(ctx, p)
+		case ".json":
+			f, err := os.Open(p)
+			if err != nil {
+				return fmt.Errorf("unable to open OSV record: %w", err)
+			}
+			defer f.Close()
+			return db.loadRecord(ctx, p, f)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load OSV database %s: %w", path, err)
+	}
+	return db, nil
+}
+
+func (db *offlineDatabase) loadZip(ctx context.Context, path string) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("unable to open OSV database archive: %w", err)
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		if !strings.EqualFold(filepath.Ext(f.Name), ".json") {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("unable to open %s of %s: %w", f.Name, path, err)
+		}
+		err = db.loadRecord(ctx, path+"/"+f.Name, r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *offlineDatabase) loadRecord(ctx context.Context, name string, r io.Reader) error {
+	logger := logging.FromContext(ctx)
+	var record osv_models.Vulnerability
+	if err := json.NewDecoder(r).Decode(&record); err != nil {
+		logger.Warnf("skipping invalid OSV record %s: %v", name, err)
+		return nil
+	}
+	if record.ID == "" || !record.Withdrawn.IsZero() {
+		return nil
+	}
+	if record.Modified.After(db.lastUpdate) {
+		db.lastUpdate = record.Modified
+	}
+	indexed := map[string]bool{}
+	for _, a := range record.Affected {
+		key := affectedKey(a.Package)
+		if key == "" || indexed[key] {
+			continue
+		}
+		indexed[key] = true
+		db.records[key] = append(db.records[key], &record)
+	}
+	return nil
+}
+
+// query returns the vulnerabilities of the purl whose version is affected,
+// and whether the version could be matched against a record of the package.
+// The records the version can't be compared with are skipped, and returned
+// as an error along with the vulnerabilities of the other records.
+func (db *offlineDatabase) query(purl string) ([]osv_models.Vulnerability, bool, error) {
+	p, err := packageurl.FromString(purl)
+	if err != nil || p.Version == "" {
+		return nil, false, nil
+	}
+	key := packageKey(p)
+	var vulns []osv_models.Vulnerability
+	var errs []error
+	known := false
+	for _, record := range db.records[key] {
+		var recordErrs []error
+		affected := false
+		for _, a := range record.Affected {
+			if affectedKey(a.Package) != key {
+				continue
+			}
+			ok, err := osvrange.Affects(a, p.Version)
+			if err != nil {
+				recordErrs = append(recordErrs, err)
+			}
+			if ok {
+				affected = true
+				break
+			}
+		}
+		switch {
+		case affected:
+			vulns = append(vulns, *record)
+		case len(recordErrs) > 0:
+			errs = append(errs, fmt.Errorf("unable to match against %s: %w", record.ID, errors.Join(recordErrs...)))
+			continue
+		}
+		known = true
+	}
+	return vulns, known, errors.Join(errs...)
+}
+
+// packageKey returns the purl without version, qualifiers and subpath, with
+// the release of the distribution packages as distro qualifier. The purls of
+// distribution packages without release never match the records of a
+// release.
+func packageKey(p packageurl.PackageURL) string {
+	return releaseKey(p, osvrange.PurlRelease(p))
+}
+
+// affectedKey returns the package key of an affected package, or "" if its
+// ecosystem is not supported.
+func affectedKey(pkg osv_models.Package) string {
+	purl, err := osvrange.PackageURL(pkg)
+	if err != nil {
+		return ""
+	}
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return ""
+	}
+	return releaseKey(p, osvrange.Release(pkg))
+}
+
+func releaseKey(p packageurl.PackageURL, release string) string {
+	var qualifiers packageurl.Qualifiers
+	if release != "" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{"distro": release})
+	}
+	return packageurl.NewPackageURL(p.Type, p.Namespace, p.Name, "", qualifiers, "").ToString()
+}
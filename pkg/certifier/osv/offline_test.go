@@ -0,0 +1,155 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation/vuln"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestOSVCertifier_OfflineDatabase(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	dir := t.TempDir()
+	f, err := os.Create(filepath.Join(dir, "all.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	records := map[string]string{
+		"GHSA-599f-7c49-w659.json": string(testdata.OSVText4ShellExample),
+		"GHSA-wwww-wwww-wwww.json": `{"id":"GHSA-wwww-wwww-wwww","modified":"2024-01-01T00:00:00Z","withdrawn":"2024-01-01T00:00:00Z",
+			"affected":[{"package":{"ecosystem":"Maven","name":"org.apache.commons:commons-text"},"versions":["1.10.0"]}]}`,
+	}
+	for name, record := range records {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(record)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	// records of other ecosystems, on their own
+	for name, record := range map[string]string{
+		"GO-2024-0001.json": `{"id":"GO-2024-0001","modified":"2024-01-01T00:00:00Z",
+			"affected":[{"package":{"ecosystem":"Go","name":"golang.org/x/net"},"ranges":[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"0.23.0"}]}]}]}`,
+		// a record whose range can't be compared with any version
+		"GO-2024-0003.json": `{"id":"GO-2024-0003","modified":"2024-01-01T00:00:00Z",
+			"affected":[{"package":{"ecosystem":"Go","name":"golang.org/x/net"},"ranges":[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"not a version"}]}]}]}`,
+		"DSA-5532-1.json": `{"id":"DSA-5532-1","modified":"2024-01-01T00:00:00Z",
+			"affected":[{"package":{"ecosystem":"Debian:12","name":"openssl"},"ranges":[{"type":"ECOSYSTEM","events":[{"introduced":"0"},{"fixed":"3.0.11-1~deb12u1"}]}]}]}`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(record), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	o := NewOSVCertificationParser(WithOfflineDatabase(dir))
+	docChan := make(chan *processor.Document, 10)
+	packages := []*root_package.PackageNode{
+		{Purl: "pkg:maven/org.apache.commons/commons-text@1.9"},
+		{Purl: "pkg:maven/org.apache.commons/commons-text@1.10.0"},
+		{Purl: "pkg:golang/golang.org/x/net@v0.20.0"},
+		{Purl: "pkg:golang/golang.org/x/net@v0.23.0"},
+		// a version that can't be compared with the record
+		{Purl: "pkg:golang/golang.org/x/net@latest"},
+		// a package without records
+		{Purl: "pkg:golang/golang.org/x/text@v0.3.0"},
+		{Purl: "pkg:deb/debian/openssl@3.0.9-1?arch=amd64&distro=debian-12.4"},
+		// a package of an other release than the record
+		{Purl: "pkg:deb/debian/openssl@1.1.1w-0+deb11u1?arch=amd64&distro=debian-11"},
+		// a package of an unknown release
+		{Purl: "pkg:deb/debian/openssl@3.0.9-1?arch=amd64"},
+		{Purl: "pkg:guac/pkg/foo@1.0.0"},
+	}
+	if err := o.CertifyComponent(ctx, packages, docChan); err != nil {
+		t.Fatalf("CertifyComponent() = %v", err)
+	}
+	close(docChan)
+	want := map[string][]string{
+		"pkg:maven/org.apache.commons/commons-text@1.9":                {"GHSA-599f-7c49-w659"},
+		"pkg:maven/org.apache.commons/commons-text@1.10.0":             {},
+		"pkg:golang/golang.org/x/net@v0.20.0":                          {"GO-2024-0001"},
+		"pkg:golang/golang.org/x/net@v0.23.0":                          {},
+		"pkg:deb/debian/openssl@3.0.9-1?arch=amd64&distro=debian-12.4": {"DSA-5532-1"},
+	}
+	if diff := cmp.Diff(want, certified(t, dir, docChan)); diff != "" {
+		t.Errorf("certified vulnerabilities (-want +got):\n%s", diff)
+	}
+
+	// the database is reloaded once modified
+	path := filepath.Join(dir, "GO-2024-0002.json")
+	if err := os.WriteFile(path, []byte(`{"id":"GO-2024-0002","modified":"2024-02-01T00:00:00Z",
+		"affected":[{"package":{"ecosystem":"Go","name":"golang.org/x/text"},"ranges":[{"type":"SEMVER","events":[{"introduced":"0"},{"fixed":"0.3.8"}]}]}]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	docChan = make(chan *processor.Document, 10)
+	if err := o.CertifyComponent(ctx, []*root_package.PackageNode{{Purl: "pkg:golang/golang.org/x/text@v0.3.0"}}, docChan); err != nil {
+		t.Fatalf("CertifyComponent() = %v", err)
+	}
+	close(docChan)
+	want = map[string][]string{
+		"pkg:golang/golang.org/x/text@v0.3.0": {"GO-2024-0002"},
+	}
+	if diff := cmp.Diff(want, certified(t, dir, docChan)); diff != "" {
+		t.Errorf("certified vulnerabilities after reload (-want +got):\n%s", diff)
+	}
+}
+
+// certified returns the vulnerabilities certified by the documents, by purl.
+func certified(t *testing.T, dir string, docChan <-chan *processor.Document) map[string][]string {
+	t.Helper()
+	got := map[string][]string{}
+	for d := range docChan {
+		var statement attestation_vuln.VulnerabilityStatement
+		if err := json.Unmarshal(d.Blob, &statement); err != nil {
+			t.Fatal(err)
+		}
+		scanner := statement.Predicate.Scanner
+		if scanner.Database.Uri != "file://"+dir || scanner.Database.LastUpdate == nil {
+			t.Errorf("scanner database = %+v", scanner.Database)
+		}
+		ids := []string{}
+		for _, r := range scanner.Result {
+			ids = append(ids, r.Id)
+			if r.Id == "GHSA-599f-7c49-w659" && len(r.Severity) != 1 {
+				t.Errorf("severity of %s = %v", r.Id, r.Severity)
+			}
+		}
+		got[statement.Subject[0].Uri] = ids
+	}
+	return got
+}
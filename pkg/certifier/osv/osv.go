@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/clients/generated"
@@ -30,6 +31,7 @@ import (
 	"github.com/guacsec/guac/pkg/clients"
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/version"
 
 	osv_models "github.com/google/osv-scanner/pkg/models"
@@ -58,6 +60,11 @@ var ErrOSVComponenetTypeMismatch error = errors.New("rootComponent type is not [
 type osvCertifier struct {
 	osvHTTPClient             *http.Client
 	withVulnerabilityMetadata bool
+	// databasePath is the downloaded OSV database matched instead of
+	// querying osv.dev, loaded on first use and reloaded when it changes.
+	databasePath string
+	databaseMu   sync.Mutex
+	database     *offlineDatabase
 }
 
 type CertifierOpts func(*osvCertifier)
@@ -68,6 +75,17 @@ func WithVulnerabilityMetadata() CertifierOpts {
 	}
 }
 
+// WithOfflineDatabase matches the package versions against a downloaded OSV
+// database instead of querying osv.dev: a directory of OSV records and of
+// zip archives of them, such as the per-ecosystem all.zip exports, or one
+// such file. The records carry their metadata, so the vulnerabilities are
+// always certified with it.
+func WithOfflineDatabase(path string) CertifierOpts {
+	return func(oc *osvCertifier) {
+		oc.databasePath = path
+	}
+}
+
 // NewOSVCertificationParser initializes the OSVCertifier
 func NewOSVCertificationParser(opts ...CertifierOpts) certifier.Certifier {
 	limiter := rate.NewLimiter(rate.Every(rateLimitInterval), rateLimit)
@@ -96,12 +114,43 @@ func (o *osvCertifier) CertifyComponent(ctx context.Context, rootComponent inter
 		purls = append(purls, node.Purl)
 	}
 
+	if o.databasePath != "" {
+		db, err := o.offlineDatabase(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := evaluateOfflineDatabase(ctx, db, purls, docChannel); err != nil {
+			return fmt.Errorf("could not generate document from OSV database: %w", err)
+		}
+		return nil
+	}
+
 	if _, err := EvaluateOSVResponse(ctx, o.osvHTTPClient, purls, docChannel, o.withVulnerabilityMetadata); err != nil {
 		return fmt.Errorf("could not generate document from OSV results: %w", err)
 	}
 	return nil
 }
 
+// offlineDatabase returns the downloaded OSV database, loading it again if
+// it was modified since it was last loaded.
+func (o *osvCertifier) offlineDatabase(ctx context.Context) (*offlineDatabase, error) {
+	o.databaseMu.Lock()
+	defer o.databaseMu.Unlock()
+	modTime, err := databaseModTime(o.databasePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to stat OSV database %s: %w", o.databasePath, err)
+	}
+	if o.database == nil || !o.database.modTime.Equal(modTime) {
+		db, err := loadOfflineDatabase(ctx, o.databasePath)
+		if err != nil {
+			return nil, err
+		}
+		db.modTime = modTime
+		o.database = db
+	}
+	return o.database, nil
+}
+
 // EvaluateOSVResponse takes a list of purls and batch queries OSV for vulnerability information
 func EvaluateOSVResponse(ctx context.Context, client *http.Client, purls []string, docChannel chan<- *processor.Document, withVulnerabilityMetadata bool) ([]*processor.Document, error) {
 	var query osv_scanner.BatchedQuery
@@ -146,15 +195,47 @@ func EvaluateOSVResponse(ctx context.Context, client *http.Client, purls []strin
 			responseMap[purl] = vulns
 		}
 	}
-	return generateDocument(responseMap, docChannel)
+	return generateDocument(responseMap, attestation_vuln.DB{}, docChannel)
+}
+
+// evaluateOfflineDatabase matches a list of purls against the vulnerabilities
+// of a downloaded OSV database. Only the purls of packages with records in the
+// database are certified, the others, and the purls whose version can't be
+// compared with their records, are skipped.
+func evaluateOfflineDatabase(ctx context.Context, db *offlineDatabase, purls []string, docChannel chan<- *processor.Document) ([]*processor.Document, error) {
+	logger := logging.FromContext(ctx)
+	responseMap := map[string][]osv_models.Vulnerability{}
+	matched := map[string]bool{}
+	for _, purl := range purls {
+		// skip any purls that are generated by GUAC as they will not be found in OSV
+		if strings.Contains(purl, "pkg:guac") || matched[purl] {
+			continue
+		}
+		matched[purl] = true
+		vulns, known, err := db.query(purl)
+		if err != nil {
+			logger.Warnf("skipping OSV records of %s: %v", purl, err)
+		}
+		if known {
+			responseMap[purl] = vulns
+		}
+	}
+	lastUpdate := db.lastUpdate
+	database := attestation_vuln.DB{
+		Uri:        "file://" + db.path,
+		LastUpdate: &lastUpdate,
+	}
+	return generateDocument(responseMap, database, docChannel)
 }
 
 // generateDocument generated the processor document for ingestion
-func generateDocument(responseMap map[string][]osv_models.Vulnerability, docChannel chan<- *processor.Document) ([]*processor.Document, error) {
+func generateDocument(responseMap map[string][]osv_models.Vulnerability, database attestation_vuln.DB, docChannel chan<- *processor.Document) ([]*processor.Document, error) {
 	var generatedOSVDocs []*processor.Document
 	for purl, vulns := range responseMap {
 		currentTime := time.Now()
-		payload, err := json.Marshal(createAttestation(purl, vulns, currentTime))
+		attestation := createAttestation(purl, vulns, currentTime)
+		attestation.Predicate.Scanner.Database = database
+		payload, err := json.Marshal(attestation)
 		if err != nil {
 			return nil, fmt.Errorf("unable to marshal attestation: %w", err)
 		}
@@ -39,10 +39,12 @@ func init() {
 	set.Bool("csub-tls", false, "enable tls connection to the server")
 	set.Bool("csub-tls-skip-verify", false, "skip verifying server certificate (for self-signed certificates for example)")
 	set.Bool("use-csub", true, "use collectsub server for datasource")
+	set.String("csub-lease", "10m", "how long guaccollect leases the collectsub entries it collects, so that several collectors share them, not leased if 0")
 
 	set.Int("csub-listen-port", 2782, "port to listen to on collect-sub service")
 	set.String("csub-tls-cert-file", "", "path to the TLS certificate in PEM format for collect-sub service")
 	set.String("csub-tls-key-file", "", "path to the TLS key in PEM format for collect-sub service")
+	set.String("csub-db-path", "", "path to the bbolt database file persisting the collect-sub entries, kept in memory if unset")
 
 	set.String("gql-backend", "keyvalue", "backend used for graphql api server: [keyvalue | arango (experimental) | ent (experimental) | neo4j (unmaintained)]")
 	set.Int("gql-listen-port", 8080, "port used for graphql api server")
@@ -50,6 +52,10 @@ func init() {
 	set.String("gql-tls-key-file", "", "path to the TLS key in PEM format for graphql api server")
 	set.Bool("gql-debug", false, "debug flag which enables the graphQL playground")
 	set.Bool("gql-trace", false, "flag which enables tracing of graphQL requests and responses on the console")
+	set.String("gql-tenant-tokens-file", "", "file of \"<tenant> <token>\" lines; when set, every graphql request must present one of the bearer tokens and is scoped to its tenant. Only supported by the ent backend")
+	set.String("gql-notify-config", "", "YAML file of webhook notification rules; when set, guacgql delivers matching ingestion events to the configured webhooks")
+	set.String("gql-notify-spool-dir", "guac-notify", "directory where pending notification events and deliveries are persisted")
+	set.Bool("gql-tenant-header", false, "scope graphql requests to the tenant named in the X-Guac-Tenant header, rejecting requests without it. Only enable behind a proxy that authenticates callers. Only supported by the ent backend")
 
 	// blob store address
 	set.String("blob-addr", "file:///tmp/blobstore?no_tmp_dir=true", "gocloud connection string for blob store configured via https://gocloud.dev/howto/blob/ (default: filesystem)")
@@ -63,6 +69,8 @@ func init() {
 	// the ingestor will query and ingest OSV for vulnerabilities
 	set.Bool("add-vuln-on-ingest", false, "if enabled, the ingestor will query and ingest OSV for vulnerabilities. Warning: This will increase ingestion times")
 	set.Bool("add-vuln-metadata", false, "if enabled, the osv certifier will add metadata to vulnerabilities from OSV")
+	set.String("osv-offline-db", "", "path to a downloaded OSV database (a directory of OSV records or of ecosystem all.zip exports) matched locally instead of querying osv.dev")
+	set.String("cpe-dictionary", "", "path to the JSON dictionary of cpe and purl entries the cpe certifier matches packages with")
 
 	// the ingestor will query and ingest clearly defined for licenses
 	set.Bool("add-license-on-ingest", false, "if enabled, the ingestor will query and ingest clearly defined for licenses. Warning: This will increase ingestion times")
@@ -75,6 +83,7 @@ func init() {
 	set.String("rest-api-server-port", "8081", "port to serve the REST API from")
 	set.String("rest-api-tls-cert-file", "", "path to the TLS certificate in PEM format for rest api server")
 	set.String("rest-api-tls-key-file", "", "path to the TLS key in PEM format for rest api server")
+	set.String("rest-api-policy-dir", "", "directory of CEL policies evaluated by the policy endpoint, the built-in policies are used if empty")
 	set.Bool("db-direct-connection", false, "[experimental] connect directly to the database that backs the gql API for optimized endpoint implementations")
 
 	set.String("verifier-key-path", "", "path to pem file to verify dsse")
@@ -117,9 +126,36 @@ func init() {
 	set.Bool("is-pkg-version-start", false, "for query path are you inputting a packageVersion to start the search from (if false then packageName)")
 	set.Bool("is-pkg-version-stop", false, "for query path are you inputting a packageVersion to stop the search at (if false then packageName)")
 
+	set.String("policy", "", "directory of CEL policies to evaluate, the built-in policies are used if empty")
+	set.Bool("json", false, "print the policy verdict as JSON")
+	set.String("format", "table", "output format of the diff: table, json or markdown")
+
 	// Google Cloud platform flags
 	set.String("gcp-credentials-path", "", "Path to the Google Cloud service account credentials json file.\nAlternatively you can set GOOGLE_APPLICATION_CREDENTIALS=<path> in your environment.")
 
+	// git flags
+	set.String("git-dir", "", "directory the git repository is cloned to, kept between runs to only collect new commits")
+	set.String("git-globs", "", "comma-separated list of globs of the files to collect, every file if empty")
+	set.Bool("git-history", false, "collect every commit not collected yet in turn instead of the latest version of the files")
+
+	// file watch flags
+	set.Bool("watch", false, "watch the path and collect the files written to it instead of scanning it")
+	set.String("watch-debounce", "2s", "how long a watched file must not be written to before it is collected")
+	set.String("watch-checkpoint", "", "file recording the watched files already collected, so that a restart does not collect them again")
+
+	// rekor flags
+	set.String("rekor-url", "https://rekor.sigstore.dev", "url of the Rekor API, or of a mirror of it, to collect the attestations of")
+	set.String("rekor-dump", "", "Rekor log dump to collect the attestations of instead of the Rekor API")
+	set.String("rekor-subjects", "", "comma-separated list of the subject digests, as algorithm:hex, to collect the attestations of")
+	set.String("rekor-identities", "", "comma-separated list of the signer emails or URIs to collect the attestations of")
+
+	// http-server collector flags
+	set.String("http-server-addr", ":8082", "address the http-server collector listens on")
+	set.String("http-server-token-file", "", "file of the bearer tokens accepted by the http-server collector, one per line, uploads are not authenticated if empty")
+	set.Int64("http-server-max-size", 50<<20, "size limit in bytes of a document uploaded to the http-server collector")
+	set.String("http-server-tls-cert-file", "", "path to the TLS certificate in PEM format for the http-server collector")
+	set.String("http-server-tls-key-file", "", "path to the TLS key in PEM format for the http-server collector")
+
 	// S3 flags
 	set.String("s3-url", "", "url of the s3 endpoint")
 	set.String("s3-path", "", "path to folder containing documents in the s3 bucket")
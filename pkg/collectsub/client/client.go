@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io"
+	"time"
 
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 	"google.golang.org/grpc"
@@ -31,6 +32,11 @@ import (
 type Client interface {
 	AddCollectEntries(ctx context.Context, entries []*pb.CollectEntry) error
 	GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter) ([]*pb.CollectEntry, error)
+	// LeaseCollectEntries returns up to limit entries matching the filters in
+	// priority order, and leases them for the lease duration. Their collection
+	// is expected to be reported with CompleteCollectEntries.
+	LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, limit int64, lease time.Duration) ([]*pb.CollectEntry, error)
+	CompleteCollectEntries(ctx context.Context, results []*pb.CollectResult) error
 	Close()
 }
 
@@ -99,9 +105,22 @@ func (c *client) AddCollectEntries(ctx context.Context, entries []*pb.CollectEnt
 }
 
 func (c *client) GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter) ([]*pb.CollectEntry, error) {
-	res, err := c.client.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{
+	return c.getCollectEntries(ctx, &pb.GetCollectEntriesRequest{
 		Filters: filters,
 	})
+}
+
+func (c *client) LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, limit int64, lease time.Duration) ([]*pb.CollectEntry, error) {
+	return c.getCollectEntries(ctx, &pb.GetCollectEntriesRequest{
+		Filters:      filters,
+		Order:        pb.CollectEntryOrder_ORDER_PRIORITY,
+		Limit:        limit,
+		LeaseSeconds: int64(lease.Seconds()),
+	})
+}
+
+func (c *client) getCollectEntries(ctx context.Context, req *pb.GetCollectEntriesRequest) ([]*pb.CollectEntry, error) {
+	res, err := c.client.GetCollectEntries(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -118,3 +137,16 @@ func (c *client) GetCollectEntries(ctx context.Context, filters []*pb.CollectEnt
 		allEntries = append(allEntries, entries.Entries...)
 	}
 }
+
+func (c *client) CompleteCollectEntries(ctx context.Context, results []*pb.CollectResult) error {
+	res, err := c.client.CompleteCollectEntries(ctx, &pb.CompleteCollectEntriesRequest{
+		Results: results,
+	})
+	if err != nil {
+		return err
+	}
+	if !res.Success {
+		return fmt.Errorf("complete collect entries unsuccessful")
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
@@ -45,5 +46,18 @@ func (c *MockClient) AddCollectEntries(ctx context.Context, entries []*pb.Collec
 }
 
 func (c *MockClient) GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter) ([]*pb.CollectEntry, error) {
-	return c.db.GetCollectEntries(ctx, filters, 0)
+	return c.db.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{Filters: filters})
+}
+
+func (c *MockClient) LeaseCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, limit int64, lease time.Duration) ([]*pb.CollectEntry, error) {
+	return c.db.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{
+		Filters:      filters,
+		Order:        pb.CollectEntryOrder_ORDER_PRIORITY,
+		Limit:        limit,
+		LeaseSeconds: int64(lease.Seconds()),
+	})
+}
+
+func (c *MockClient) CompleteCollectEntries(ctx context.Context, results []*pb.CollectResult) error {
+	return c.db.CompleteCollectEntries(ctx, results)
 }
@@ -93,6 +93,59 @@ func (CollectDataType) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_collectsub_collectsub_collectsub_proto_rawDescGZIP(), []int{0}
 }
 
+type CollectEntryOrder int32
+
+const (
+	// the order of the store
+	CollectEntryOrder_ORDER_UNSPECIFIED CollectEntryOrder = 0
+	// highest priority first, then fewest failures, then least recently
+	// collected
+	CollectEntryOrder_ORDER_PRIORITY CollectEntryOrder = 1
+	// least recently collected first, then highest priority
+	CollectEntryOrder_ORDER_LEAST_RECENTLY_COLLECTED CollectEntryOrder = 2
+)
+
+// Enum value maps for CollectEntryOrder.
+var (
+	CollectEntryOrder_name = map[int32]string{
+		0: "ORDER_UNSPECIFIED",
+		1: "ORDER_PRIORITY",
+		2: "ORDER_LEAST_RECENTLY_COLLECTED",
+	}
+	CollectEntryOrder_value = map[string]int32{
+		"ORDER_UNSPECIFIED":              0,
+		"ORDER_PRIORITY":                 1,
+		"ORDER_LEAST_RECENTLY_COLLECTED": 2,
+	}
+)
+
+func (x CollectEntryOrder) Enum() *CollectEntryOrder {
+	p := new(CollectEntryOrder)
+	*p = x
+	return p
+}
+
+func (x CollectEntryOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectEntryOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_collectsub_collectsub_collectsub_proto_enumTypes[1].Descriptor()
+}
+
+func (CollectEntryOrder) Type() protoreflect.EnumType {
+	return &file_pkg_collectsub_collectsub_collectsub_proto_enumTypes[1]
+}
+
+func (x CollectEntryOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectEntryOrder.Descriptor instead.
+func (CollectEntryOrder) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_collectsub_collectsub_collectsub_proto_rawDescGZIP(), []int{1}
+}
+
 // Generic types
 type CollectEntry struct {
 	state         protoimpl.MessageState
@@ -102,6 +155,15 @@ type CollectEntry struct {
 	Type      CollectDataType `protobuf:"varint,1,opt,name=type,proto3,enum=guacsec.guac.collect_subscriber.schema.CollectDataType" json:"type,omitempty"`
 	Value     string          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	SinceTime int64           `protobuf:"varint,3,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	// entries of higher priority are collected first
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// last_collected_time in unix epoch, 0 if never collected
+	LastCollectedTime int64 `protobuf:"varint,5,opt,name=last_collected_time,json=lastCollectedTime,proto3" json:"last_collected_time,omitempty"`
+	// failure_count is the number of failed collections since the last
+	// successful one
+	FailureCount int32 `protobuf:"varint,6,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// source_document is the document the entry was discovered in
+	SourceDocument string `protobuf:"bytes,7,opt,name=source_document,json=sourceDocument,proto3" json:"source_document,omitempty"`
 }
 
 func (x *CollectEntry) Reset() {
@@ -157,6 +219,34 @@ func (x *CollectEntry) GetSinceTime() int64 {
 	return 0
 }
 
+func (x *CollectEntry) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CollectEntry) GetLastCollectedTime() int64 {
+	if x != nil {
+		return x.LastCollectedTime
+	}
+	return 0
+}
+
+func (x *CollectEntry) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *CollectEntry) GetSourceDocument() string {
+	if x != nil {
+		return x.SourceDocument
+	}
+	return ""
+}
+
 // rpc AddCollectEntries
 type AddCollectEntriesRequest struct {
 	state         protoimpl.MessageState
@@ -315,7 +405,14 @@ type GetCollectEntriesRequest struct {
 
 	Filters []*CollectEntryFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
 	// since_time in unix epoch
-	SinceTime int64 `protobuf:"varint,2,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	SinceTime int64             `protobuf:"varint,2,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	Order     CollectEntryOrder `protobuf:"varint,3,opt,name=order,proto3,enum=guacsec.guac.collect_subscriber.schema.CollectEntryOrder" json:"order,omitempty"`
+	// limit is the maximum number of entries returned, all of them if 0
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// lease_seconds leases the entries returned for that long, they are not
+	// returned to other leasing requests until the lease expires or their
+	// collection is completed
+	LeaseSeconds int64 `protobuf:"varint,5,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
 }
 
 func (x *GetCollectEntriesRequest) Reset() {
@@ -364,6 +461,27 @@ func (x *GetCollectEntriesRequest) GetSinceTime() int64 {
 	return 0
 }
 
+func (x *GetCollectEntriesRequest) GetOrder() CollectEntryOrder {
+	if x != nil {
+		return x.Order
+	}
+	return CollectEntryOrder_ORDER_UNSPECIFIED
+}
+
+func (x *GetCollectEntriesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCollectEntriesRequest) GetLeaseSeconds() int64 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
 type GetCollectEntriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -411,6 +529,164 @@ func (x *GetCollectEntriesResponse) GetEntries() []*CollectEntry {
 	return nil
 }
 
+// rpc CompleteCollectEntries
+type CollectResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    CollectDataType `protobuf:"varint,1,opt,name=type,proto3,enum=guacsec.guac.collect_subscriber.schema.CollectDataType" json:"type,omitempty"`
+	Value   string          `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Success bool            `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *CollectResult) Reset() {
+	*x = CollectResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectResult) ProtoMessage() {}
+
+func (x *CollectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectResult.ProtoReflect.Descriptor instead.
+func (*CollectResult) Descriptor() ([]byte, []int) {
+	return file_pkg_collectsub_collectsub_collectsub_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CollectResult) GetType() CollectDataType {
+	if x != nil {
+		return x.Type
+	}
+	return CollectDataType_DATATYPE_UNKNOWN
+}
+
+func (x *CollectResult) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CollectResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CompleteCollectEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*CollectResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CompleteCollectEntriesRequest) Reset() {
+	*x = CompleteCollectEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteCollectEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCollectEntriesRequest) ProtoMessage() {}
+
+func (x *CompleteCollectEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCollectEntriesRequest.ProtoReflect.Descriptor instead.
+func (*CompleteCollectEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_collectsub_collectsub_collectsub_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompleteCollectEntriesRequest) GetResults() []*CollectResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CompleteCollectEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *CompleteCollectEntriesResponse) Reset() {
+	*x = CompleteCollectEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteCollectEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCollectEntriesResponse) ProtoMessage() {}
+
+func (x *CompleteCollectEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCollectEntriesResponse.ProtoReflect.Descriptor instead.
+func (*CompleteCollectEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_collectsub_collectsub_collectsub_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CompleteCollectEntriesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_pkg_collectsub_collectsub_collectsub_proto protoreflect.FileDescriptor
 
 var file_pkg_collectsub_collectsub_collectsub_proto_rawDesc = []byte{
@@ -419,7 +695,7 @@ var file_pkg_collectsub_collectsub_collectsub_proto_rawDesc = []byte{
 	0x65, 0x63, 0x74, 0x73, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x26, 0x67, 0x75,
 	0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
 	0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x68, 0x65, 0x6d, 0x61, 0x22, 0xaa, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x4b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
 	0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
@@ -428,74 +704,130 @@ var file_pkg_collectsub_collectsub_collectsub_proto_rawDesc = []byte{
 	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63,
 	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67,
-	0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x75, 0x0a, 0x12, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x4b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
+	0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x6a, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
 	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f,
 	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f,
-	0x62, 0x22, 0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54,
-	0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3a, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x2a, 0x96, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x41,
-	0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
-	0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x49, 0x10, 0x02, 0x12, 0x11,
-	0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x52, 0x4c, 0x10,
-	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x49,
-	0x54, 0x48, 0x55, 0x42, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x04, 0x12, 0x19,
-	0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x49, 0x5f, 0x52,
-	0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10, 0x05, 0x32, 0xd2, 0x02, 0x0a, 0x18, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x67,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x35, 0x0a,
+	0x19, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x75, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73,
+	0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x22, 0x9b, 0x02, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x75, 0x61, 0x63,
+	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4f, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x67,
 	0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
 	0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x6b, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63,
+	0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x70, 0x0a, 0x1d, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x1e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x2a, 0x96, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x41, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x49, 0x54, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x49, 0x10,
+	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55,
+	0x52, 0x4c, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10,
+	0x04, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43,
+	0x49, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10, 0x05, 0x2a, 0x62, 0x0a, 0x11,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x4e, 0x54, 0x4c, 0x59, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x32, 0xfc, 0x03, 0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x98, 0x01,
+	0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75,
+	0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e,
+	0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41,
+	0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x40,
 	0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f,
 	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x40, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x41, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0xa7, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x45, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x73, 0x65,
 	0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x67, 0x75, 0x61, 0x63,
-	0x73, 0x65, 0x63, 0x2e, 0x67, 0x75, 0x61, 0x63, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x28,
-	0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x75, 0x61,
-	0x63, 0x73, 0x65, 0x63, 0x2f, 0x67, 0x75, 0x61, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x75, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x75,
+	0x61, 0x63, 0x73, 0x65, 0x63, 0x2f, 0x67, 0x75, 0x61, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x75, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -510,32 +842,41 @@ func file_pkg_collectsub_collectsub_collectsub_proto_rawDescGZIP() []byte {
 	return file_pkg_collectsub_collectsub_collectsub_proto_rawDescData
 }
 
-var file_pkg_collectsub_collectsub_collectsub_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_collectsub_collectsub_collectsub_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pkg_collectsub_collectsub_collectsub_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pkg_collectsub_collectsub_collectsub_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_pkg_collectsub_collectsub_collectsub_proto_goTypes = []interface{}{
-	(CollectDataType)(0),              // 0: guacsec.guac.collect_subscriber.schema.CollectDataType
-	(*CollectEntry)(nil),              // 1: guacsec.guac.collect_subscriber.schema.CollectEntry
-	(*AddCollectEntriesRequest)(nil),  // 2: guacsec.guac.collect_subscriber.schema.AddCollectEntriesRequest
-	(*AddCollectEntriesResponse)(nil), // 3: guacsec.guac.collect_subscriber.schema.AddCollectEntriesResponse
-	(*CollectEntryFilter)(nil),        // 4: guacsec.guac.collect_subscriber.schema.CollectEntryFilter
-	(*GetCollectEntriesRequest)(nil),  // 5: guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest
-	(*GetCollectEntriesResponse)(nil), // 6: guacsec.guac.collect_subscriber.schema.GetCollectEntriesResponse
+	(CollectDataType)(0),                   // 0: guacsec.guac.collect_subscriber.schema.CollectDataType
+	(CollectEntryOrder)(0),                 // 1: guacsec.guac.collect_subscriber.schema.CollectEntryOrder
+	(*CollectEntry)(nil),                   // 2: guacsec.guac.collect_subscriber.schema.CollectEntry
+	(*AddCollectEntriesRequest)(nil),       // 3: guacsec.guac.collect_subscriber.schema.AddCollectEntriesRequest
+	(*AddCollectEntriesResponse)(nil),      // 4: guacsec.guac.collect_subscriber.schema.AddCollectEntriesResponse
+	(*CollectEntryFilter)(nil),             // 5: guacsec.guac.collect_subscriber.schema.CollectEntryFilter
+	(*GetCollectEntriesRequest)(nil),       // 6: guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest
+	(*GetCollectEntriesResponse)(nil),      // 7: guacsec.guac.collect_subscriber.schema.GetCollectEntriesResponse
+	(*CollectResult)(nil),                  // 8: guacsec.guac.collect_subscriber.schema.CollectResult
+	(*CompleteCollectEntriesRequest)(nil),  // 9: guacsec.guac.collect_subscriber.schema.CompleteCollectEntriesRequest
+	(*CompleteCollectEntriesResponse)(nil), // 10: guacsec.guac.collect_subscriber.schema.CompleteCollectEntriesResponse
 }
 var file_pkg_collectsub_collectsub_collectsub_proto_depIdxs = []int32{
-	0, // 0: guacsec.guac.collect_subscriber.schema.CollectEntry.type:type_name -> guacsec.guac.collect_subscriber.schema.CollectDataType
-	1, // 1: guacsec.guac.collect_subscriber.schema.AddCollectEntriesRequest.entries:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntry
-	0, // 2: guacsec.guac.collect_subscriber.schema.CollectEntryFilter.type:type_name -> guacsec.guac.collect_subscriber.schema.CollectDataType
-	4, // 3: guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest.filters:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntryFilter
-	1, // 4: guacsec.guac.collect_subscriber.schema.GetCollectEntriesResponse.entries:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntry
-	2, // 5: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.AddCollectEntries:input_type -> guacsec.guac.collect_subscriber.schema.AddCollectEntriesRequest
-	5, // 6: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.GetCollectEntries:input_type -> guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest
-	3, // 7: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.AddCollectEntries:output_type -> guacsec.guac.collect_subscriber.schema.AddCollectEntriesResponse
-	6, // 8: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.GetCollectEntries:output_type -> guacsec.guac.collect_subscriber.schema.GetCollectEntriesResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: guacsec.guac.collect_subscriber.schema.CollectEntry.type:type_name -> guacsec.guac.collect_subscriber.schema.CollectDataType
+	2,  // 1: guacsec.guac.collect_subscriber.schema.AddCollectEntriesRequest.entries:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntry
+	0,  // 2: guacsec.guac.collect_subscriber.schema.CollectEntryFilter.type:type_name -> guacsec.guac.collect_subscriber.schema.CollectDataType
+	5,  // 3: guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest.filters:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntryFilter
+	1,  // 4: guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest.order:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntryOrder
+	2,  // 5: guacsec.guac.collect_subscriber.schema.GetCollectEntriesResponse.entries:type_name -> guacsec.guac.collect_subscriber.schema.CollectEntry
+	0,  // 6: guacsec.guac.collect_subscriber.schema.CollectResult.type:type_name -> guacsec.guac.collect_subscriber.schema.CollectDataType
+	8,  // 7: guacsec.guac.collect_subscriber.schema.CompleteCollectEntriesRequest.results:type_name -> guacsec.guac.collect_subscriber.schema.CollectResult
+	3,  // 8: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.AddCollectEntries:input_type -> guacsec.guac.collect_subscriber.schema.AddCollectEntriesRequest
+	6,  // 9: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.GetCollectEntries:input_type -> guacsec.guac.collect_subscriber.schema.GetCollectEntriesRequest
+	9,  // 10: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.CompleteCollectEntries:input_type -> guacsec.guac.collect_subscriber.schema.CompleteCollectEntriesRequest
+	4,  // 11: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.AddCollectEntries:output_type -> guacsec.guac.collect_subscriber.schema.AddCollectEntriesResponse
+	7,  // 12: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.GetCollectEntries:output_type -> guacsec.guac.collect_subscriber.schema.GetCollectEntriesResponse
+	10, // 13: guacsec.guac.collect_subscriber.schema.CollectSubscriberService.CompleteCollectEntries:output_type -> guacsec.guac.collect_subscriber.schema.CompleteCollectEntriesResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_pkg_collectsub_collectsub_collectsub_proto_init() }
@@ -616,14 +957,50 @@ func file_pkg_collectsub_collectsub_collectsub_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteCollectEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_collectsub_collectsub_collectsub_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteCollectEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_collectsub_collectsub_collectsub_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,9 @@ const _ = grpc.SupportPackageIsVersion7
 type CollectSubscriberServiceClient interface {
 	AddCollectEntries(ctx context.Context, in *AddCollectEntriesRequest, opts ...grpc.CallOption) (*AddCollectEntriesResponse, error)
 	GetCollectEntries(ctx context.Context, in *GetCollectEntriesRequest, opts ...grpc.CallOption) (CollectSubscriberService_GetCollectEntriesClient, error)
+	// CompleteCollectEntries records the outcome of the collection of entries
+	// and releases their leases
+	CompleteCollectEntries(ctx context.Context, in *CompleteCollectEntriesRequest, opts ...grpc.CallOption) (*CompleteCollectEntriesResponse, error)
 }
 
 type collectSubscriberServiceClient struct {
@@ -75,12 +78,24 @@ func (x *collectSubscriberServiceGetCollectEntriesClient) Recv() (*GetCollectEnt
 	return m, nil
 }
 
+func (c *collectSubscriberServiceClient) CompleteCollectEntries(ctx context.Context, in *CompleteCollectEntriesRequest, opts ...grpc.CallOption) (*CompleteCollectEntriesResponse, error) {
+	out := new(CompleteCollectEntriesResponse)
+	err := c.cc.Invoke(ctx, "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/CompleteCollectEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectSubscriberServiceServer is the server API for CollectSubscriberService service.
 // All implementations must embed UnimplementedCollectSubscriberServiceServer
 // for forward compatibility
 type CollectSubscriberServiceServer interface {
 	AddCollectEntries(context.Context, *AddCollectEntriesRequest) (*AddCollectEntriesResponse, error)
 	GetCollectEntries(*GetCollectEntriesRequest, CollectSubscriberService_GetCollectEntriesServer) error
+	// CompleteCollectEntries records the outcome of the collection of entries
+	// and releases their leases
+	CompleteCollectEntries(context.Context, *CompleteCollectEntriesRequest) (*CompleteCollectEntriesResponse, error)
 	mustEmbedUnimplementedCollectSubscriberServiceServer()
 }
 
@@ -94,6 +109,9 @@ func (UnimplementedCollectSubscriberServiceServer) AddCollectEntries(context.Con
 func (UnimplementedCollectSubscriberServiceServer) GetCollectEntries(*GetCollectEntriesRequest, CollectSubscriberService_GetCollectEntriesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetCollectEntries not implemented")
 }
+func (UnimplementedCollectSubscriberServiceServer) CompleteCollectEntries(context.Context, *CompleteCollectEntriesRequest) (*CompleteCollectEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCollectEntries not implemented")
+}
 func (UnimplementedCollectSubscriberServiceServer) mustEmbedUnimplementedCollectSubscriberServiceServer() {
 }
 
@@ -147,6 +165,24 @@ func (x *collectSubscriberServiceGetCollectEntriesServer) Send(m *GetCollectEntr
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CollectSubscriberService_CompleteCollectEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCollectEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectSubscriberServiceServer).CompleteCollectEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guacsec.guac.collect_subscriber.schema.CollectSubscriberService/CompleteCollectEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectSubscriberServiceServer).CompleteCollectEntries(ctx, req.(*CompleteCollectEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectSubscriberService_ServiceDesc is the grpc.ServiceDesc for CollectSubscriberService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,6 +194,10 @@ var CollectSubscriberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCollectEntries",
 			Handler:    _CollectSubscriberService_AddCollectEntries_Handler,
 		},
+		{
+			MethodName: "CompleteCollectEntries",
+			Handler:    _CollectSubscriberService_CompleteCollectEntries_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
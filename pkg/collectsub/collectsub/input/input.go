@@ -25,19 +25,23 @@ type CollectEntryInput struct {
 	// Type string based on protobuf enum CollectDataType
 	Type  string `json:"type,omitempty"`
 	Value string `json:"value,omitempty"`
+	// Priority orders the collection of the entries, highest first
+	Priority int32 `json:"priority,omitempty"`
 }
 
 func (e *CollectEntryInput) Convert() *pb.CollectEntry {
 	return &pb.CollectEntry{
-		Type:  pb.CollectDataType(pb.CollectDataType_value[e.Type]),
-		Value: e.Value,
+		Type:     pb.CollectDataType(pb.CollectDataType_value[e.Type]),
+		Value:    e.Value,
+		Priority: e.Priority,
 	}
 }
 
 func ConvertCollectEntry(e *pb.CollectEntry) CollectEntryInput {
 	return CollectEntryInput{
-		Type:  pb.CollectDataType_name[int32(e.GetType())],
-		Value: e.GetValue(),
+		Type:     pb.CollectDataType_name[int32(e.GetType())],
+		Value:    e.GetValue(),
+		Priority: e.GetPriority(),
 	}
 }
 
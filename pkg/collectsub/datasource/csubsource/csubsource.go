@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/guacsec/guac/pkg/collectsub/client"
@@ -31,6 +32,21 @@ type csubDataSources struct {
 	c            client.Client
 	lastEntries  *datasource.DataSources
 	pollDuration time.Duration
+	// lease is how long the entries returned are leased for, if not 0
+	lease time.Duration
+
+	mu sync.Mutex
+	// leased holds the types of the leased entries by value
+	leased map[string][]pb.CollectDataType
+}
+
+// collectFilters are the entries collected from the collect subscriber.
+var collectFilters = []*pb.CollectEntryFilter{
+	{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_GIT, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_GITHUB_RELEASE, Glob: "*"},
+	{Type: pb.CollectDataType_DATATYPE_OCI_REGISTRY, Glob: "*"},
 }
 
 // NewFileDataSources creates a datasource which gets its data sources
@@ -44,22 +60,61 @@ func NewCsubDatasource(c client.Client, pollDuration time.Duration) (datasource.
 	}, nil
 }
 
+// NewCsubLeasingDatasource creates a datasource like NewCsubDatasource, which
+// leases the entries it returns for lease, in priority order, so that several
+// collectors share them. The collectors report their collection with
+// datasource.Complete.
+func NewCsubLeasingDatasource(c client.Client, pollDuration time.Duration, lease time.Duration) (datasource.CollectSource, error) {
+	if lease < time.Second {
+		return nil, fmt.Errorf("collect subscriber lease must be at least a second, got %v", lease)
+	}
+	return &csubDataSources{
+		c:            c,
+		pollDuration: pollDuration,
+		lease:        lease,
+		leased:       map[string][]pb.CollectDataType{},
+	}, nil
+}
+
 // GetDataSources returns a data source containing targets for the
 // collector to collect
 func (d *csubDataSources) GetDataSources(ctx context.Context) (*datasource.DataSources, error) {
-	entries, err := d.c.GetCollectEntries(ctx, []*pb.CollectEntryFilter{
-		{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_GIT, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_GITHUB_RELEASE, Glob: "*"},
-		{Type: pb.CollectDataType_DATATYPE_OCI_REGISTRY, Glob: "*"},
-	})
+	if d.lease == 0 {
+		entries, err := d.c.GetCollectEntries(ctx, collectFilters)
+		if err != nil {
+			return nil, err
+		}
+		return entriesToSources(ctx, entries), nil
+	}
+
+	entries, err := d.c.LeaseCollectEntries(ctx, collectFilters, 0, d.lease)
 	if err != nil {
 		return nil, err
 	}
-	ds := entriesToSources(ctx, entries)
+	d.mu.Lock()
+	for _, e := range entries {
+		d.leased[e.Value] = append(d.leased[e.Value], e.Type)
+	}
+	d.mu.Unlock()
+	return entriesToSources(ctx, entries), nil
+}
 
-	return ds, nil
+// Complete reports the collection of the leased entries of sources to the
+// collect subscriber, which releases their leases.
+func (d *csubDataSources) Complete(ctx context.Context, sources []datasource.Source, success bool) error {
+	d.mu.Lock()
+	var results []*pb.CollectResult
+	for _, s := range sources {
+		for _, t := range d.leased[s.Value] {
+			results = append(results, &pb.CollectResult{Type: t, Value: s.Value, Success: success})
+		}
+		delete(d.leased, s.Value)
+	}
+	d.mu.Unlock()
+	if len(results) == 0 {
+		return nil
+	}
+	return d.c.CompleteCollectEntries(ctx, results)
 }
 
 // DataSourcesUpdate will return a channel which will get an element
@@ -163,3 +163,56 @@ func Test_CsubSourceDataSourcesUpdate(t *testing.T) {
 	}
 
 }
+
+func Test_CsubLeasingSource(t *testing.T) {
+	ctx := context.TODO()
+
+	c, err := createSimpleCsubClient(ctx)
+	if err != nil {
+		t.Fatalf("unable to initiliaze simple source client: %v", err)
+	}
+	defer c.Close()
+
+	// two collectors sharing the entries
+	first, err := NewCsubLeasingDatasource(c, time.Second, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to create leasing datasource: %v", err)
+	}
+	second, err := NewCsubLeasingDatasource(c, time.Second, time.Minute)
+	if err != nil {
+		t.Fatalf("unable to create leasing datasource: %v", err)
+	}
+
+	ds, err := first.GetDataSources(ctx)
+	if err != nil {
+		t.Fatalf("unable to get DataSources: %v", err)
+	}
+	if !reflect.DeepEqual(ds, &expectedDataSource) {
+		t.Errorf("unexpected datasource output: expect %v, got %v", &expectedDataSource, ds)
+	}
+	ds, err = second.GetDataSources(ctx)
+	if err != nil {
+		t.Fatalf("unable to get DataSources: %v", err)
+	}
+	if !reflect.DeepEqual(ds, &datasource.DataSources{}) {
+		t.Errorf("leased entries returned again: %v", ds)
+	}
+
+	// the completed entries are released
+	datasource.Complete(ctx, first, []datasource.Source{{Value: "abc"}, {Value: "pkg:npm/foobar@12.3.1"}}, nil)
+	ds, err = second.GetDataSources(ctx)
+	if err != nil {
+		t.Fatalf("unable to get DataSources: %v", err)
+	}
+	want := &datasource.DataSources{
+		OciDataSources:  []datasource.Source{{Value: "abc"}},
+		PurlDataSources: []datasource.Source{{Value: "pkg:npm/foobar@12.3.1"}},
+	}
+	if !reflect.DeepEqual(ds, want) {
+		t.Errorf("unexpected datasource output after completing: expect %v, got %v", want, ds)
+	}
+
+	if _, err := NewCsubLeasingDatasource(c, time.Second, time.Millisecond); err == nil {
+		t.Errorf("expected an error for a lease shorter than a second")
+	}
+}
@@ -15,7 +15,11 @@
 
 package datasource
 
-import "context"
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
 
 // CollectSource provides a way for collector to get collect targets from
 // a data source (e.g. a file, a database, a pubsub queue, etc.)
@@ -34,6 +38,31 @@ type CollectSource interface {
 	DataSourcesUpdate(ctx context.Context) (<-chan error, error)
 }
 
+// LeasingSource is a CollectSource that leases the data sources it returns to
+// the collector, so that several collectors share them. The collector reports
+// their collection with Complete, the sources not reported are returned again
+// once their lease expires.
+type LeasingSource interface {
+	CollectSource
+
+	// Complete records the outcome of the collection of sources and
+	// releases their leases.
+	Complete(ctx context.Context, sources []Source, success bool) error
+}
+
+// Complete reports the collection of sources to src when it leases them,
+// successful if collectErr is nil. A failure to report is only logged, as the
+// leases expire anyway.
+func Complete(ctx context.Context, src CollectSource, sources []Source, collectErr error) {
+	ls, ok := src.(LeasingSource)
+	if !ok || len(sources) == 0 {
+		return
+	}
+	if err := ls.Complete(ctx, sources, collectErr == nil); err != nil {
+		logging.FromContext(ctx).Warnf("unable to complete the collection of data sources: %v", err)
+	}
+}
+
 type DataSources struct {
 	OciDataSources []Source
 	OciRegistryDataSources []Source
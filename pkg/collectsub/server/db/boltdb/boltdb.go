@@ -0,0 +1,223 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package boltdb is a collect subscriber store that keeps the entries in an
+// embedded bbolt database file, so that they survive restarts.
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
+	bbolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// entriesBucket holds the entries by entry key
+	entriesBucket = []byte("entries")
+	// leasesBucket holds the lease expiry times of the leased entries, in
+	// unix nanoseconds, by entry key
+	leasesBucket = []byte("leases")
+)
+
+// openTimeout is how long to wait for the file lock of a database that is
+// used by another process.
+const openTimeout = 5 * time.Second
+
+type boltDb struct {
+	db *bbolt.DB
+}
+
+// NewBoltDb opens, or creates, the bbolt database at path.
+func NewBoltDb(path string) (db.CollectSubscriberDb, error) {
+	bdb, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: openTimeout})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return nil, fmt.Errorf("bbolt database %q is in use by another process", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bbolt database %q: %w", path, err)
+	}
+	err = bdb.Update(func(tx *bbolt.Tx) error {
+		for _, b := range [][]byte{entriesBucket, leasesBucket} {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return fmt.Errorf("failed to create bucket %s: %w", b, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = bdb.Close()
+		return nil, err
+	}
+	return &boltDb{db: bdb}, nil
+}
+
+// Close closes the database file.
+func (b *boltDb) Close() error {
+	return b.db.Close()
+}
+
+func (b *boltDb) AddCollectEntries(ctx context.Context, entries []*pb.CollectEntry) error {
+	var sinceTime = time.Now().Unix()
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(entriesBucket)
+		for _, e := range entries {
+			if e == nil {
+				continue
+			}
+			key := []byte(db.EntryKey(e.GetType(), e.GetValue()))
+			stored, err := getEntry(bucket, key)
+			if err != nil {
+				return err
+			}
+			if stored != nil {
+				db.MergeEntry(stored, e)
+			} else {
+				stored = proto.Clone(e).(*pb.CollectEntry)
+				stored.SinceTime = sinceTime
+			}
+			if err := putEntry(bucket, key, stored); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *boltDb) GetCollectEntries(ctx context.Context, req *pb.GetCollectEntriesRequest) ([]*pb.CollectEntry, error) {
+	var selected []*pb.CollectEntry
+	get := func(tx *bbolt.Tx) error {
+		var entries []*pb.CollectEntry
+		err := tx.Bucket(entriesBucket).ForEach(func(_, v []byte) error {
+			e := &pb.CollectEntry{}
+			if err := proto.Unmarshal(v, e); err != nil {
+				return fmt.Errorf("failed to unmarshal collect entry: %w", err)
+			}
+			entries = append(entries, e)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		leases := tx.Bucket(leasesBucket)
+		selected, err = db.SelectEntries(entries, req, func(e *pb.CollectEntry) bool {
+			v := leases.Get([]byte(db.EntryKey(e.GetType(), e.GetValue())))
+			return len(v) == 8 && now.UnixNano() < int64(binary.BigEndian.Uint64(v))
+		})
+		if err != nil || req.GetLeaseSeconds() <= 0 {
+			return err
+		}
+
+		if err := pruneLeases(leases, now); err != nil {
+			return err
+		}
+		expiry := binary.BigEndian.AppendUint64(nil, uint64(now.Add(time.Duration(req.GetLeaseSeconds())*time.Second).UnixNano()))
+		for _, e := range selected {
+			if err := leases.Put([]byte(db.EntryKey(e.GetType(), e.GetValue())), expiry); err != nil {
+				return fmt.Errorf("failed to lease collect entry: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// only the leasing requests write
+	var err error
+	if req.GetLeaseSeconds() > 0 {
+		err = b.db.Update(get)
+	} else {
+		err = b.db.View(get)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return selected, nil
+}
+
+func (b *boltDb) CompleteCollectEntries(ctx context.Context, results []*pb.CollectResult) error {
+	now := time.Now().Unix()
+	return b.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(entriesBucket)
+		for _, r := range results {
+			key := []byte(db.EntryKey(r.GetType(), r.GetValue()))
+			stored, err := getEntry(bucket, key)
+			if err != nil {
+				return err
+			}
+			if stored != nil {
+				db.CompleteEntry(stored, r.GetSuccess(), now)
+				if err := putEntry(bucket, key, stored); err != nil {
+					return err
+				}
+			}
+			if err := tx.Bucket(leasesBucket).Delete(key); err != nil {
+				return fmt.Errorf("failed to release collect entry lease: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// pruneLeases deletes the leases expired at now, which would otherwise stay
+// for the entries whose collection is never completed.
+func pruneLeases(leases *bbolt.Bucket, now time.Time) error {
+	var expired [][]byte
+	err := leases.ForEach(func(k, v []byte) error {
+		if len(v) != 8 || now.UnixNano() >= int64(binary.BigEndian.Uint64(v)) {
+			expired = append(expired, k)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range expired {
+		if err := leases.Delete(k); err != nil {
+			return fmt.Errorf("failed to prune collect entry lease: %w", err)
+		}
+	}
+	return nil
+}
+
+// getEntry returns the entry stored under key, or nil.
+func getEntry(bucket *bbolt.Bucket, key []byte) (*pb.CollectEntry, error) {
+	v := bucket.Get(key)
+	if v == nil {
+		return nil, nil
+	}
+	e := &pb.CollectEntry{}
+	if err := proto.Unmarshal(v, e); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal collect entry: %w", err)
+	}
+	return e, nil
+}
+
+func putEntry(bucket *bbolt.Bucket, key []byte, e *pb.CollectEntry) error {
+	v, err := proto.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal collect entry: %w", err)
+	}
+	if err := bucket.Put(key, v); err != nil {
+		return fmt.Errorf("failed to store collect entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
+	"go.etcd.io/bbolt"
+)
+
+func Test_LeasePrunesExpiredLeases(t *testing.T) {
+	ctx := context.Background()
+	cdb, err := NewBoltDb(filepath.Join(t.TempDir(), "csub.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := cdb.(*boltDb)
+	defer b.Close()
+
+	// the lease of an entry whose collection was never completed
+	gone := []byte(db.EntryKey(pb.CollectDataType_DATATYPE_OCI, "oci://gone"))
+	err = b.db.Update(func(tx *bbolt.Tx) error {
+		expiry := binary.BigEndian.AppendUint64(nil, uint64(time.Now().Add(-time.Minute).UnixNano()))
+		return tx.Bucket(leasesBucket).Put(gone, expiry)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddCollectEntries(ctx, []*pb.CollectEntry{{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://a"}}); err != nil {
+		t.Fatal(err)
+	}
+	leased, err := b.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{
+		Filters:      []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"}},
+		LeaseSeconds: 60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leased) != 1 {
+		t.Fatalf("leased %v, want oci://a", leased)
+	}
+
+	err = b.db.View(func(tx *bbolt.Tx) error {
+		leases := tx.Bucket(leasesBucket)
+		if leases.Get(gone) != nil {
+			t.Errorf("expired lease of oci://gone was not pruned")
+		}
+		if leases.Get([]byte(db.EntryKey(pb.CollectDataType_DATATYPE_OCI, "oci://a"))) == nil {
+			t.Errorf("lease of oci://a was not stored")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -18,11 +18,14 @@ package db
 import (
 	"context"
 	"fmt"
+	"io"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+	"github.com/guacsec/guac/pkg/collectsub/server/db/boltdb"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/types"
 )
@@ -130,27 +133,182 @@ func Test_SimpleDb_AddGetCollectEntries(t *testing.T) {
 		},
 	}}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ctx := context.TODO()
-			db, err := simpledb.NewSimpleDb()
-			if err != nil {
-				t.Fatal(err)
+	runTests(t, tests)
+}
+
+func Test_PriorityLeaseCollectEntries(t *testing.T) {
+	ociFilter := []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_OCI, Glob: "*"}}
+	lease := func(limit int64) *pb.GetCollectEntriesRequest {
+		return &pb.GetCollectEntriesRequest{Filters: ociFilter, Order: pb.CollectEntryOrder_ORDER_PRIORITY, Limit: limit, LeaseSeconds: 60}
+	}
+	tests := []struct {
+		name  string
+		calls []testCall
+	}{{
+		name: "priority order",
+		calls: []testCall{
+			addFn([]*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://low", Priority: 1},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://high", Priority: 5},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://mid", Priority: 3},
+			}, false),
+			orderedGetFn(&pb.GetCollectEntriesRequest{Filters: ociFilter, Order: pb.CollectEntryOrder_ORDER_PRIORITY, Limit: 2},
+				"oci://high", "oci://mid"),
+			// adding an entry again raises its priority
+			addFn([]*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://low", Priority: 9, SourceDocument: "sbom.json"},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://high", Priority: 0},
+			}, false),
+			orderedGetFn(&pb.GetCollectEntriesRequest{Filters: ociFilter, Order: pb.CollectEntryOrder_ORDER_PRIORITY},
+				"oci://low", "oci://high", "oci://mid"),
+		},
+	}, {
+		name: "lease and complete",
+		calls: []testCall{
+			addFn([]*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://a", Priority: 2},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://b", Priority: 1},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://c"},
+			}, false),
+			orderedGetFn(lease(2), "oci://a", "oci://b"),
+			// leased entries are skipped by other leasing requests only
+			orderedGetFn(lease(2), "oci://c"),
+			orderedGetFn(lease(2)),
+			getFn(ociFilter, false, []*pb.CollectEntry{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://a"},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://b"},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://c"},
+			}),
+			completeFn([]*pb.CollectResult{
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://a", Success: false},
+				{Type: pb.CollectDataType_DATATYPE_OCI, Value: "oci://b", Success: true},
+			}),
+			// completed entries are released, a failure does not lower the
+			// priority of an entry but sorts it after its peers
+			orderedGetFn(lease(0), "oci://a", "oci://b"),
+			func(ctx context.Context, db types.CollectSubscriberDb) error {
+				entries, err := db.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{Filters: ociFilter})
+				if err != nil {
+					return err
+				}
+				for _, e := range entries {
+					switch e.Value {
+					case "oci://a":
+						if e.FailureCount != 1 || e.LastCollectedTime != 0 {
+							return fmt.Errorf("failed entry %v", e)
+						}
+					case "oci://b":
+						if e.FailureCount != 0 || e.LastCollectedTime == 0 {
+							return fmt.Errorf("collected entry %v", e)
+						}
+					}
+				}
+				return nil
+			},
+			orderedGetFn(&pb.GetCollectEntriesRequest{Filters: ociFilter, Order: pb.CollectEntryOrder_ORDER_LEAST_RECENTLY_COLLECTED},
+				"oci://a", "oci://c", "oci://b"),
+		},
+	}}
+
+	runTests(t, tests)
+}
+
+func Test_BoltDb_Persistence(t *testing.T) {
+	ctx := context.TODO()
+	path := filepath.Join(t.TempDir(), "csub.db")
+	db, err := boltdb.NewBoltDb(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := []*pb.CollectEntry{{Type: pb.CollectDataType_DATATYPE_PURL, Value: "pkg:npm/left-pad", Priority: 3, SourceDocument: "sbom.json"}}
+	if err := db.AddCollectEntries(ctx, entries); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.(io.Closer).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = boltdb.NewBoltDb(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.(io.Closer).Close()
+	got, err := db.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{
+		Filters: []*pb.CollectEntryFilter{{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Priority != 3 || got[0].SourceDocument != "sbom.json" || got[0].SinceTime == 0 {
+		t.Errorf("entries after reopening = %v", got)
+	}
+}
+
+// runTests runs the test calls against each store.
+func runTests(t *testing.T, tests []struct {
+	name  string
+	calls []testCall
+}) {
+	stores := map[string]func(t *testing.T) (types.CollectSubscriberDb, error){
+		"simpledb": func(t *testing.T) (types.CollectSubscriberDb, error) {
+			return simpledb.NewSimpleDb()
+		},
+		"boltdb": func(t *testing.T) (types.CollectSubscriberDb, error) {
+			db, err := boltdb.NewBoltDb(filepath.Join(t.TempDir(), "csub.db"))
+			if err == nil {
+				t.Cleanup(func() { _ = db.(io.Closer).Close() })
 			}
-			for _, c := range tt.calls {
-				if err := c(ctx, db); err != nil {
+			return db, err
+		},
+	}
+	for store, newDb := range stores {
+		for _, tt := range tests {
+			t.Run(store+"/"+tt.name, func(t *testing.T) {
+				ctx := context.TODO()
+				db, err := newDb(t)
+				if err != nil {
 					t.Fatal(err)
 				}
-			}
-		})
+				for _, c := range tt.calls {
+					if err := c(ctx, db); err != nil {
+						t.Fatal(err)
+					}
+				}
+			})
+		}
 	}
 }
 
 type testCall func(ctx context.Context, db types.CollectSubscriberDb) error
 
+// orderedGetFn expects the values of the entries returned for the request, in
+// order.
+func orderedGetFn(req *pb.GetCollectEntriesRequest, expect ...string) testCall {
+	return func(ctx context.Context, db types.CollectSubscriberDb) error {
+		entries, err := db.GetCollectEntries(ctx, req)
+		if err != nil {
+			return fmt.Errorf("unexpected err: %v", err)
+		}
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.GetValue())
+		}
+		if diff := cmp.Diff(expect, got, cmpopts.EquateEmpty()); diff != "" {
+			return fmt.Errorf("entries (-want +got):\n%s", diff)
+		}
+		return nil
+	}
+}
+
+func completeFn(results []*pb.CollectResult) testCall {
+	return func(ctx context.Context, db types.CollectSubscriberDb) error {
+		return db.CompleteCollectEntries(ctx, results)
+	}
+}
+
 func getFn(filters []*pb.CollectEntryFilter, expectErr bool, expect []*pb.CollectEntry) testCall {
 	return func(ctx context.Context, db types.CollectSubscriberDb) error {
-		entries, err := db.GetCollectEntries(ctx, filters, 0)
+		entries, err := db.GetCollectEntries(ctx, &pb.GetCollectEntriesRequest{Filters: filters})
 		if err != nil != expectErr {
 			return fmt.Errorf("expected err status %v, got %v", expectErr, err != nil)
 		}
@@ -20,20 +20,23 @@ import (
 	"sync"
 	"time"
 
-	"github.com/gobwas/glob"
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
 	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
+	"google.golang.org/protobuf/proto"
 )
 
 func NewSimpleDb() (db.CollectSubscriberDb, error) {
 	return &simpleDb{
-		lock: &sync.RWMutex{},
+		leases: map[string]time.Time{},
+		lock:   &sync.RWMutex{},
 	}, nil
 }
 
 type simpleDb struct {
 	collectEntries []*pb.CollectEntry
-	lock           *sync.RWMutex
+	// leases are the expiry times of the leased entries, by entry key
+	leases map[string]time.Time
+	lock   *sync.RWMutex
 }
 
 func entryKeyEq(e1, e2 *pb.CollectEntry) bool {
@@ -41,13 +44,13 @@ func entryKeyEq(e1, e2 *pb.CollectEntry) bool {
 		e1.GetType() == e2.GetType()
 }
 
-func (s *simpleDb) containsEntry(e *pb.CollectEntry) bool {
+func (s *simpleDb) findEntry(e *pb.CollectEntry) *pb.CollectEntry {
 	for _, ee := range s.collectEntries {
 		if entryKeyEq(e, ee) {
-			return true
+			return ee
 		}
 	}
-	return false
+	return nil
 }
 
 func (s *simpleDb) AddCollectEntries(ctx context.Context, entries []*pb.CollectEntry) error {
@@ -55,34 +58,59 @@ func (s *simpleDb) AddCollectEntries(ctx context.Context, entries []*pb.CollectE
 	defer s.lock.Unlock()
 	var sinceTime = time.Now().Unix()
 	for _, e := range entries {
-		if e != nil && !s.containsEntry(e) {
-			e.SinceTime = sinceTime
-			s.collectEntries = append(s.collectEntries, e)
+		if e == nil {
+			continue
+		}
+		if stored := s.findEntry(e); stored != nil {
+			db.MergeEntry(stored, e)
+			continue
 		}
+		e = proto.Clone(e).(*pb.CollectEntry)
+		e.SinceTime = sinceTime
+		s.collectEntries = append(s.collectEntries, e)
 	}
 	return nil
 }
 
-func (s *simpleDb) GetCollectEntries(ctx context.Context, filters []*pb.CollectEntryFilter, sinceTime int64) ([]*pb.CollectEntry, error) {
-	s.lock.RLock()
-	defer s.lock.RUnlock()
-	var filterMatchers []glob.Glob
-	for _, f := range filters {
-		filterMatchers = append(filterMatchers, glob.MustCompile(f.Glob))
+func (s *simpleDb) GetCollectEntries(ctx context.Context, req *pb.GetCollectEntriesRequest) ([]*pb.CollectEntry, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	selected, err := db.SelectEntries(s.collectEntries, req, func(e *pb.CollectEntry) bool {
+		return now.Before(s.leases[db.EntryKey(e.GetType(), e.GetValue())])
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	var retList []*pb.CollectEntry
-	for _, e := range s.collectEntries {
-		if e.SinceTime >= sinceTime {
-			for i, f := range filters {
-				matched := filterMatchers[i].Match(e.Value)
-				if e.Type == f.Type && matched {
-					retList = append(retList, e)
-					break
-				}
+	if req.GetLeaseSeconds() > 0 {
+		for key, expiry := range s.leases {
+			if !now.Before(expiry) {
+				delete(s.leases, key)
 			}
 		}
 	}
 
+	retList := make([]*pb.CollectEntry, 0, len(selected))
+	for _, e := range selected {
+		if req.GetLeaseSeconds() > 0 {
+			s.leases[db.EntryKey(e.GetType(), e.GetValue())] = now.Add(time.Duration(req.GetLeaseSeconds()) * time.Second)
+		}
+		retList = append(retList, proto.Clone(e).(*pb.CollectEntry))
+	}
 	return retList, nil
 }
+
+func (s *simpleDb) CompleteCollectEntries(ctx context.Context, results []*pb.CollectResult) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now().Unix()
+	for _, r := range results {
+		key := &pb.CollectEntry{Type: r.GetType(), Value: r.GetValue()}
+		if stored := s.findEntry(key); stored != nil {
+			db.CompleteEntry(stored, r.GetSuccess(), now)
+		}
+		delete(s.leases, db.EntryKey(r.GetType(), r.GetValue()))
+	}
+	return nil
+}
@@ -23,5 +23,6 @@ import (
 
 type CollectSubscriberDb interface {
 	AddCollectEntries(context.Context, []*pb.CollectEntry) error
-	GetCollectEntries(context.Context, []*pb.CollectEntryFilter, int64) ([]*pb.CollectEntry, error)
+	GetCollectEntries(context.Context, *pb.GetCollectEntriesRequest) ([]*pb.CollectEntry, error)
+	CompleteCollectEntries(context.Context, []*pb.CollectResult) error
 }
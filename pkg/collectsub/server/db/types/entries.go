@@ -0,0 +1,101 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/gobwas/glob"
+	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+)
+
+// EntryKey is the key identifying an entry in a store.
+func EntryKey(t pb.CollectDataType, value string) string {
+	return fmt.Sprintf("%d/%s", t, value)
+}
+
+// MergeEntry merges an entry added again into the stored one: it keeps the
+// highest priority and the first source document.
+func MergeEntry(stored, added *pb.CollectEntry) {
+	stored.Priority = max(stored.Priority, added.Priority)
+	if stored.SourceDocument == "" {
+		stored.SourceDocument = added.SourceDocument
+	}
+}
+
+// CompleteEntry records the outcome of the collection of an entry at now, in
+// unix epoch.
+func CompleteEntry(e *pb.CollectEntry, success bool, now int64) {
+	if success {
+		e.LastCollectedTime = now
+		e.FailureCount = 0
+	} else {
+		e.FailureCount++
+	}
+}
+
+// SelectEntries returns the entries matching the request, in the requested
+// order and up to its limit. The entries under an active lease, as reported by
+// leased, are only skipped for the leasing requests.
+func SelectEntries(entries []*pb.CollectEntry, req *pb.GetCollectEntriesRequest, leased func(*pb.CollectEntry) bool) ([]*pb.CollectEntry, error) {
+	var matchers []glob.Glob
+	for _, f := range req.GetFilters() {
+		g, err := glob.Compile(f.GetGlob())
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter glob %q: %w", f.GetGlob(), err)
+		}
+		matchers = append(matchers, g)
+	}
+
+	var selected []*pb.CollectEntry
+	for _, e := range entries {
+		if e.GetSinceTime() < req.GetSinceTime() {
+			continue
+		}
+		if req.GetLeaseSeconds() > 0 && leased(e) {
+			continue
+		}
+		for i, f := range req.GetFilters() {
+			if e.GetType() == f.GetType() && matchers[i].Match(e.GetValue()) {
+				selected = append(selected, e)
+				break
+			}
+		}
+	}
+
+	switch req.GetOrder() {
+	case pb.CollectEntryOrder_ORDER_PRIORITY:
+		slices.SortStableFunc(selected, func(a, b *pb.CollectEntry) int {
+			return cmp.Or(
+				cmp.Compare(b.GetPriority(), a.GetPriority()),
+				cmp.Compare(a.GetFailureCount(), b.GetFailureCount()),
+				cmp.Compare(a.GetLastCollectedTime(), b.GetLastCollectedTime()))
+		})
+	case pb.CollectEntryOrder_ORDER_LEAST_RECENTLY_COLLECTED:
+		slices.SortStableFunc(selected, func(a, b *pb.CollectEntry) int {
+			return cmp.Or(
+				cmp.Compare(a.GetLastCollectedTime(), b.GetLastCollectedTime()),
+				cmp.Compare(b.GetPriority(), a.GetPriority()))
+		})
+	}
+
+	if limit := req.GetLimit(); limit > 0 && int64(len(selected)) > limit {
+		selected = selected[:limit]
+	}
+	return selected, nil
+}
@@ -18,6 +18,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"sync"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/guacsec/guac/pkg/collectsub/collectsub"
 	pb "github.com/guacsec/guac/pkg/collectsub/collectsub"
+	"github.com/guacsec/guac/pkg/collectsub/server/db/boltdb"
 	"github.com/guacsec/guac/pkg/collectsub/server/db/simpledb"
 	db "github.com/guacsec/guac/pkg/collectsub/server/db/types"
 	"github.com/guacsec/guac/pkg/logging"
@@ -47,8 +49,16 @@ type server struct {
 	tlsKeyFile  string
 }
 
-func NewServer(port int, tlsCertFile string, tlsKeyFile string) (*server, error) {
-	db, err := simpledb.NewSimpleDb()
+// NewServer returns a server keeping the entries in the bbolt database at
+// dbPath, or in memory if dbPath is empty.
+func NewServer(port int, tlsCertFile string, tlsKeyFile string, dbPath string) (*server, error) {
+	var db db.CollectSubscriberDb
+	var err error
+	if dbPath != "" {
+		db, err = boltdb.NewBoltDb(dbPath)
+	} else {
+		db, err = simpledb.NewSimpleDb()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +92,7 @@ func (s *server) GetCollectEntries(in *pb.GetCollectEntriesRequest, out collects
 	logger := ctxzap.Extract(ctx).Sugar()
 	logger.Debugf("GetCollectEntries called with filters: %v", in.Filters)
 
-	entries, err := s.Db.GetCollectEntries(ctx, in.Filters, in.SinceTime)
+	entries, err := s.Db.GetCollectEntries(ctx, in)
 	if err != nil {
 		return fmt.Errorf("failed to get collect entries from db: %w", err)
 	}
@@ -98,6 +108,21 @@ func (s *server) GetCollectEntries(in *pb.GetCollectEntriesRequest, out collects
 	return nil
 }
 
+func (s *server) CompleteCollectEntries(ctx context.Context, in *pb.CompleteCollectEntriesRequest) (*pb.CompleteCollectEntriesResponse, error) {
+	logger := ctxzap.Extract(ctx).Sugar()
+	logger.Debugf("CompleteCollectEntries called with results: %v", in.Results)
+
+	err := s.Db.CompleteCollectEntries(ctx, in.Results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete entries in db: %w", err)
+	}
+	logger.Infof("CompleteCollectEntries completed %d entries", len(in.Results))
+
+	return &pb.CompleteCollectEntriesResponse{
+		Success: true,
+	}, nil
+}
+
 func contextPropagationUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -182,5 +207,10 @@ func (s *server) Serve(ctx context.Context) error {
 		gs.Stop()
 	}
 	wg.Wait()
+	if c, ok := s.Db.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			logger.Errorf("failed to close csub db: %v", err)
+		}
+	}
 	return retErr
 }
@@ -0,0 +1,273 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// ClosureEntry is a package version or artifact in the transitive
+// dependencies or dependents of another one, with the number of dependency
+// links between them.
+type ClosureEntry struct {
+	ID    string
+	Depth int
+}
+
+// DependencyClosure returns the package versions and artifacts that subject
+// transitively depends on through IsDependency, the software included in its
+// SBOMs and the materials of its SLSA attestations, treating the nodes linked
+// by IsOccurrence and HashEqual as the same one.
+//
+// It uses the dependencyClosure query, and falls back to walking the graph
+// with one query per link and node when the backend does not implement it.
+func DependencyClosure(ctx context.Context, gqlClient graphql.Client, subject string, maxDepth *int) ([]ClosureEntry, error) {
+	resp, err := model.DependencyClosure(ctx, gqlClient, subject, maxDepth)
+	if err != nil {
+		if !closureUnsupported(err) {
+			return nil, fmt.Errorf("error getting dependency closure: %w", err)
+		}
+		return walkClosure(ctx, gqlClient, subject, maxDepth, false)
+	}
+	out := make([]ClosureEntry, 0, len(resp.DependencyClosure))
+	for _, e := range resp.DependencyClosure {
+		out = append(out, ClosureEntry{ID: e.Id, Depth: e.Depth})
+	}
+	return out, nil
+}
+
+// DependentClosure returns the package versions and artifacts that
+// transitively depend on subject, following the links of DependencyClosure
+// the other way.
+func DependentClosure(ctx context.Context, gqlClient graphql.Client, subject string, maxDepth *int) ([]ClosureEntry, error) {
+	resp, err := model.DependentClosure(ctx, gqlClient, subject, maxDepth)
+	if err != nil {
+		if !closureUnsupported(err) {
+			return nil, fmt.Errorf("error getting dependent closure: %w", err)
+		}
+		return walkClosure(ctx, gqlClient, subject, maxDepth, true)
+	}
+	out := make([]ClosureEntry, 0, len(resp.DependentClosure))
+	for _, e := range resp.DependentClosure {
+		out = append(out, ClosureEntry{ID: e.Id, Depth: e.Depth})
+	}
+	return out, nil
+}
+
+// closureUnsupported reports whether err comes from a backend that does not
+// implement the closure queries, or from a server that predates them.
+func closureUnsupported(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "not implemented") || strings.Contains(msg, "Cannot query field")
+}
+
+// closureWalker finds the links of DependencyClosure and DependentClosure with
+// the GraphQL queries of each predicate.
+type closureWalker struct {
+	ctx        context.Context
+	gqlClient  graphql.Client
+	dependents bool
+	// artifacts tells the artifacts apart from the package versions among
+	// the nodes found so far
+	artifacts map[string]bool
+}
+
+func walkClosure(ctx context.Context, gqlClient graphql.Client, subject string, maxDepth *int, dependents bool) ([]ClosureEntry, error) {
+	w := &closureWalker{
+		ctx:        ctx,
+		gqlClient:  gqlClient,
+		dependents: dependents,
+		artifacts:  map[string]bool{},
+	}
+	node, err := model.Node(ctx, gqlClient, subject)
+	if err != nil {
+		return nil, fmt.Errorf("error getting closure subject: %w", err)
+	}
+	switch node.Node.(type) {
+	case *model.NodeNodeArtifact:
+		w.artifacts[subject] = true
+	case *model.NodeNodePackage:
+	default:
+		return nil, fmt.Errorf("closure subject %s is not a package version or an artifact", subject)
+	}
+
+	found, err := helper.Closure(subject, maxDepth, w.links)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ClosureEntry, 0, len(found))
+	for _, e := range found {
+		out = append(out, ClosureEntry{ID: e.ID, Depth: e.Depth})
+	}
+	return out, nil
+}
+
+func (w *closureWalker) links(id string) ([]helper.ClosureLink, error) {
+	if w.artifacts[id] {
+		return w.artifactLinks(id)
+	}
+	return w.packageLinks(id)
+}
+
+func (w *closureWalker) packageLinks(id string) ([]helper.ClosureLink, error) {
+	var out []helper.ClosureLink
+	pkg := &model.PkgSpec{Id: &id}
+
+	depFilter := model.IsDependencySpec{Package: pkg}
+	if w.dependents {
+		depFilter = model.IsDependencySpec{DependencyPackage: pkg}
+	}
+	deps, err := model.Dependencies(w.ctx, w.gqlClient, depFilter)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dependencies of %s: %w", id, err)
+	}
+	for _, d := range deps.IsDependency {
+		to := d.DependencyPackage.AllPkgTree
+		if w.dependents {
+			to = d.Package.AllPkgTree
+		}
+		out = w.appendPackage(out, to, false)
+	}
+
+	occs, err := model.Occurrences(w.ctx, w.gqlClient, model.IsOccurrenceSpec{
+		Subject: &model.PackageOrSourceSpec{Package: pkg},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting occurrences of %s: %w", id, err)
+	}
+	for _, o := range occs.IsOccurrence {
+		out = w.appendArtifact(out, o.Artifact.Id, true)
+	}
+
+	sbomLinks, err := w.sbomLinks(id, &model.PackageOrArtifactSpec{Package: pkg})
+	if err != nil {
+		return nil, err
+	}
+	return append(out, sbomLinks...), nil
+}
+
+func (w *closureWalker) artifactLinks(id string) ([]helper.ClosureLink, error) {
+	var out []helper.ClosureLink
+	art := &model.ArtifactSpec{Id: &id}
+
+	hashEquals, err := model.HashEquals(w.ctx, w.gqlClient, model.HashEqualSpec{Artifacts: []*model.ArtifactSpec{art}})
+	if err != nil {
+		return nil, fmt.Errorf("error getting hash equals of %s: %w", id, err)
+	}
+	for _, he := range hashEquals.HashEqual {
+		for _, a := range he.Artifacts {
+			if a.Id != id {
+				out = w.appendArtifact(out, a.Id, true)
+			}
+		}
+	}
+
+	occs, err := model.Occurrences(w.ctx, w.gqlClient, model.IsOccurrenceSpec{Artifact: art})
+	if err != nil {
+		return nil, fmt.Errorf("error getting occurrences of %s: %w", id, err)
+	}
+	for _, o := range occs.IsOccurrence {
+		if p, ok := o.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+			out = w.appendPackage(out, p.AllPkgTree, true)
+		}
+	}
+
+	slsaFilter := model.HasSLSASpec{Subject: art}
+	if w.dependents {
+		slsaFilter = model.HasSLSASpec{BuiltFrom: []model.ArtifactSpec{*art}}
+	}
+	slsas, err := model.HasSLSA(w.ctx, w.gqlClient, slsaFilter)
+	if err != nil {
+		return nil, fmt.Errorf("error getting SLSA attestations of %s: %w", id, err)
+	}
+	for _, s := range slsas.HasSLSA {
+		if w.dependents {
+			out = w.appendArtifact(out, s.Subject.Id, false)
+			continue
+		}
+		for _, a := range s.Slsa.BuiltFrom {
+			out = w.appendArtifact(out, a.Id, false)
+		}
+	}
+
+	sbomLinks, err := w.sbomLinks(id, &model.PackageOrArtifactSpec{Artifact: art})
+	if err != nil {
+		return nil, err
+	}
+	return append(out, sbomLinks...), nil
+}
+
+// sbomLinks returns the links to the software included in the SBOMs of node,
+// or to the subjects of the SBOMs including it.
+func (w *closureWalker) sbomLinks(id string, node *model.PackageOrArtifactSpec) ([]helper.ClosureLink, error) {
+	filter := model.HasSBOMSpec{Subject: node}
+	if w.dependents {
+		filter = model.HasSBOMSpec{IncludedSoftware: []model.PackageOrArtifactSpec{*node}}
+	}
+	sboms, err := model.HasSBOMs(w.ctx, w.gqlClient, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error getting SBOMs of %s: %w", id, err)
+	}
+
+	var out []helper.ClosureLink
+	appendSoftware := func(s any) {
+		switch s := s.(type) {
+		case *model.AllHasSBOMTreeSubjectPackage:
+			out = w.appendPackage(out, s.AllPkgTree, false)
+		case *model.AllHasSBOMTreeSubjectArtifact:
+			out = w.appendArtifact(out, s.Id, false)
+		case *model.AllHasSBOMTreeIncludedSoftwarePackage:
+			out = w.appendPackage(out, s.AllPkgTree, false)
+		case *model.AllHasSBOMTreeIncludedSoftwareArtifact:
+			out = w.appendArtifact(out, s.Id, false)
+		}
+	}
+	for _, sbom := range sboms.HasSBOM {
+		if w.dependents {
+			appendSoftware(sbom.Subject)
+			continue
+		}
+		for _, s := range sbom.IncludedSoftware {
+			appendSoftware(s)
+		}
+	}
+	return out, nil
+}
+
+func (w *closureWalker) appendArtifact(links []helper.ClosureLink, id string, equivalent bool) []helper.ClosureLink {
+	w.artifacts[id] = true
+	return append(links, helper.ClosureLink{To: id, Equivalent: equivalent})
+}
+
+// appendPackage appends a link to the package version of pkg, which is left
+// out when the package trie stops at the name.
+func (w *closureWalker) appendPackage(links []helper.ClosureLink, pkg model.AllPkgTree, equivalent bool) []helper.ClosureLink {
+	for _, ns := range pkg.Namespaces {
+		for _, n := range ns.Names {
+			for _, v := range n.Versions {
+				links = append(links, helper.ClosureLink{To: v.Id, Equivalent: equivalent})
+			}
+		}
+	}
+	return links
+}
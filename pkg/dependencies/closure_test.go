@@ -0,0 +1,245 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package dependencies
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/segmentio/ksuid"
+
+	clients "github.com/guacsec/guac/internal/testing/graphqlClients"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	entbackend "github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+)
+
+// noClosureClient fails the closure queries the way a backend that does not
+// implement them does, to exercise the fallback.
+type noClosureClient struct {
+	graphql.Client
+}
+
+func (c noClosureClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if req.OpName == "DependencyClosure" || req.OpName == "DependentClosure" {
+		return fmt.Errorf("input: not implemented: %s", req.OpName)
+	}
+	return c.Client.MakeRequest(ctx, req, resp)
+}
+
+func Test_Closure(t *testing.T) {
+	ctx := context.Background()
+	gqlClient := clients.SetupTest(t)
+
+	// app depends on lib1, which depends on lib2. app occurs as the appbin
+	// artifact, which has the same hash as appbin2. The SBOM of appbin
+	// includes lib3 and libart, and appbin2 is built from base.
+	ids := clients.Ingest(ctx, t, gqlClient, clients.GuacData{
+		Packages:  []string{"pkg:guac/app@1", "pkg:guac/lib1@1", "pkg:guac/lib2@1", "pkg:guac/lib3@1"},
+		Artifacts: []string{"sha256:appbin", "sha256:appbin2", "sha256:libart", "sha256:base"},
+		Builders:  []string{"test-builder"},
+		IsDependencies: []clients.IsDependency{
+			{DependentPkg: "pkg:guac/app@1", DependencyPkg: "pkg:guac/lib1@1"},
+			{DependentPkg: "pkg:guac/lib1@1", DependencyPkg: "pkg:guac/lib2@1"},
+		},
+		IsOccurrences: []clients.IsOccurrence{
+			{Subject: "pkg:guac/app@1", Artifact: "sha256:appbin"},
+		},
+		HashEquals: []clients.HashEqual{
+			{ArtifactA: "sha256:appbin", ArtifactB: "sha256:appbin2"},
+		},
+		HasSboms: []clients.HasSbom{
+			{Subject: "sha256:appbin", IncludedSoftware: []string{"pkg:guac/lib3@1", "sha256:libart"}},
+		},
+		HasSlsas: []clients.HasSlsa{
+			{Subject: "sha256:appbin2", BuiltFrom: []string{"sha256:base"}, BuiltBy: "test-builder"},
+		},
+	})
+	id := func(noun string) string {
+		if pkg, ok := ids.PackageIds[noun]; ok {
+			return pkg
+		}
+		return ids.ArtifactIds[noun]
+	}
+
+	tests := []struct {
+		name       string
+		subject    string
+		maxDepth   *int
+		dependents bool
+		want       map[string]int
+	}{
+		{
+			name:    "dependencies",
+			subject: "pkg:guac/app@1",
+			want: map[string]int{
+				"pkg:guac/lib1@1": 1,
+				"pkg:guac/lib3@1": 1,
+				"sha256:libart":   1,
+				"sha256:base":     1,
+				"pkg:guac/lib2@1": 2,
+			},
+		},
+		{
+			name:     "dependencies up to a depth",
+			subject:  "sha256:appbin2",
+			maxDepth: ptrfrom.Int(1),
+			want: map[string]int{
+				"pkg:guac/lib1@1": 1,
+				"pkg:guac/lib3@1": 1,
+				"sha256:libart":   1,
+				"sha256:base":     1,
+			},
+		},
+		{
+			name:       "dependents",
+			subject:    "pkg:guac/lib2@1",
+			dependents: true,
+			want: map[string]int{
+				"pkg:guac/lib1@1": 1,
+				"pkg:guac/app@1":  2,
+				"sha256:appbin":   2,
+				"sha256:appbin2":  2,
+			},
+		},
+		{
+			name:       "dependents of a material",
+			subject:    "sha256:base",
+			dependents: true,
+			want: map[string]int{
+				"pkg:guac/app@1": 1,
+				"sha256:appbin":  1,
+				"sha256:appbin2": 1,
+			},
+		},
+	}
+	for _, client := range []struct {
+		name string
+		graphql.Client
+	}{
+		{"native", gqlClient},
+		{"fallback", noClosureClient{gqlClient}},
+	} {
+		for _, test := range tests {
+			t.Run(client.name+"/"+test.name, func(t *testing.T) {
+				closure := DependencyClosure
+				if test.dependents {
+					closure = DependentClosure
+				}
+				got, err := closure(ctx, client, id(test.subject), test.maxDepth)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				want := make([]ClosureEntry, 0, len(test.want))
+				for noun, depth := range test.want {
+					want = append(want, ClosureEntry{ID: id(noun), Depth: depth})
+				}
+				less := func(a, b ClosureEntry) bool {
+					return a.Depth < b.Depth || a.Depth == b.Depth && a.ID < b.ID
+				}
+				if diff := cmp.Diff(want, got, cmpopts.SortSlices(less)); diff != "" {
+					t.Errorf("unexpected closure (-want +got):\n%s", diff)
+				}
+			})
+		}
+	}
+}
+
+// Benchmark_DependencyClosure compares the closure query of the backend with
+// the fallback walking the graph over GraphQL, on a dependency tree of 5000
+// package versions.
+func Benchmark_DependencyClosure(b *testing.B) {
+	benchmarkDependencyClosure(b, clients.SetupTest(b))
+}
+
+// Benchmark_EntDependencyClosure is Benchmark_DependencyClosure on the ent
+// backend, in a new database of the Postgres at ENT_TEST_DATABASE_URL.
+func Benchmark_EntDependencyClosure(b *testing.B) {
+	ctx := context.Background()
+	dbURL := os.Getenv("ENT_TEST_DATABASE_URL")
+	if dbURL == "" {
+		dbURL = "postgresql://localhost/guac_test?sslmode=disable"
+	}
+	db, err := sql.Open("postgres", dbURL)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+	ident := ksuid.New().String()
+	if _, err := db.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE \"%v\"", ident)); err != nil {
+		b.Fatal(err)
+	}
+	u, err := url.Parse(dbURL)
+	if err != nil {
+		b.Fatal(err)
+	}
+	u.Path = ident
+	be, err := backends.Get("ent", ctx, &entbackend.BackendOptions{
+		DriverName:  "postgres",
+		Address:     u.String(),
+		AutoMigrate: true,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	benchmarkDependencyClosure(b, clients.SetupTestWithBackend(b, be))
+}
+
+func benchmarkDependencyClosure(b *testing.B, gqlClient graphql.Client) {
+	ctx := context.Background()
+
+	const size = 5000
+	data := clients.GuacData{}
+	for i := 0; i < size; i++ {
+		data.Packages = append(data.Packages, fmt.Sprintf("pkg:guac/p%d@1", i))
+		if i > 0 {
+			data.IsDependencies = append(data.IsDependencies, clients.IsDependency{
+				DependentPkg:  fmt.Sprintf("pkg:guac/p%d@1", (i-1)/2),
+				DependencyPkg: fmt.Sprintf("pkg:guac/p%d@1", i),
+			})
+		}
+	}
+	root := clients.Ingest(ctx, b, gqlClient, data).PackageIds["pkg:guac/p0@1"]
+
+	for _, client := range []struct {
+		name string
+		graphql.Client
+	}{
+		{"native", gqlClient},
+		{"fallback", noClosureClient{gqlClient}},
+	} {
+		b.Run(client.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				got, err := DependencyClosure(ctx, client, root, nil)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(got) != size-1 {
+					b.Fatalf("got %d dependencies, want %d", len(got), size-1)
+				}
+			}
+		})
+	}
+}
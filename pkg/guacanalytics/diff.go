@@ -0,0 +1,368 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/package-url/packageurl-go"
+
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+// ChangeType tells how an entry differs between the two subjects of a diff.
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Removed ChangeType = "removed"
+	Changed ChangeType = "changed"
+)
+
+// DiffFormat is an output format of a diff.
+type DiffFormat string
+
+const (
+	DiffTable    DiffFormat = "table"
+	DiffJSON     DiffFormat = "json"
+	DiffMarkdown DiffFormat = "markdown"
+)
+
+// Diff is the difference between the subgraphs of two package versions or
+// artifacts. Packages are compared by name, so that a version bump of a
+// dependency shows up as a change rather than as an addition and a removal.
+type Diff struct {
+	From            string                `json:"from"`
+	To              string                `json:"to"`
+	Dependencies    []DependencyChange    `json:"dependencies"`
+	Occurrences     []OccurrenceChange    `json:"occurrences"`
+	Licenses        []LicenseChange       `json:"licenses"`
+	Vulnerabilities []VulnerabilityChange `json:"vulnerabilities"`
+}
+
+// DependencyChange is a dependency added, removed, or whose versions
+// changed. Package is the purl of the package name, without version.
+type DependencyChange struct {
+	Change  ChangeType `json:"change"`
+	Package string     `json:"package"`
+	From    []string   `json:"from"`
+	To      []string   `json:"to"`
+}
+
+// OccurrenceChange is an artifact of the subject added or removed.
+type OccurrenceChange struct {
+	Change   ChangeType `json:"change"`
+	Package  string     `json:"package"`
+	Artifact string     `json:"artifact"`
+}
+
+// LicenseChange is a change of the licenses of the subject or of one of its
+// dependencies.
+type LicenseChange struct {
+	Change  ChangeType `json:"change"`
+	Package string     `json:"package"`
+	From    []string   `json:"from"`
+	To      []string   `json:"to"`
+}
+
+// VulnerabilityChange is a vulnerability of the subject or of one of its
+// dependencies added, removed, or whose VEX status changed. The statuses are
+// empty when there is no VEX statement.
+type VulnerabilityChange struct {
+	Change     ChangeType `json:"change"`
+	Package    string     `json:"package"`
+	ID         string     `json:"id"`
+	Score      float64    `json:"score"`
+	FromStatus string     `json:"fromStatus"`
+	ToStatus   string     `json:"toStatus"`
+}
+
+// Empty reports whether both subjects have the same subgraph.
+func (d *Diff) Empty() bool {
+	return len(d.Dependencies) == 0 && len(d.Occurrences) == 0 &&
+		len(d.Licenses) == 0 && len(d.Vulnerabilities) == 0
+}
+
+// DiffSubjects gathers the subgraphs of two package versions or artifacts,
+// like policy evaluation does, and compares them.
+func DiffSubjects(ctx context.Context, gqlClient graphql.Client, from, to policy.Subject) (*Diff, error) {
+	fromFacts, err := policy.Gather(ctx, gqlClient, from)
+	if err != nil {
+		return nil, err
+	}
+	toFacts, err := policy.Gather(ctx, gqlClient, to)
+	if err != nil {
+		return nil, err
+	}
+	return CompareFacts(fromFacts, toFacts), nil
+}
+
+// CompareFacts compares the subgraphs of two subjects.
+func CompareFacts(from, to *policy.Facts) *Diff {
+	d := &Diff{
+		From: from.Subject.String(),
+		To:   to.Subject.String(),
+	}
+
+	fromDeps, toDeps := dependencyVersions(from), dependencyVersions(to)
+	for _, name := range unionKeys(fromDeps, toDeps) {
+		if change, ok := compareSets(fromDeps[name], toDeps[name]); ok {
+			d.Dependencies = append(d.Dependencies, DependencyChange{
+				Change:  change,
+				Package: name,
+				From:    fromDeps[name],
+				To:      toDeps[name],
+			})
+		}
+	}
+
+	fromOccs, toOccs := occurrences(from), occurrences(to)
+	for _, key := range unionKeys(fromOccs, toOccs) {
+		o, inFrom := fromOccs[key]
+		change := Removed
+		if _, inTo := toOccs[key]; inTo {
+			if inFrom {
+				continue
+			}
+			o, change = toOccs[key], Added
+		}
+		o.Change = change
+		d.Occurrences = append(d.Occurrences, o)
+	}
+
+	fromLicenses, toLicenses := licenses(from), licenses(to)
+	for _, name := range unionKeys(fromLicenses, toLicenses) {
+		if change, ok := compareSets(fromLicenses[name], toLicenses[name]); ok {
+			d.Licenses = append(d.Licenses, LicenseChange{
+				Change:  change,
+				Package: name,
+				From:    fromLicenses[name],
+				To:      toLicenses[name],
+			})
+		}
+	}
+
+	fromVulns, toVulns := vulnerabilities(from), vulnerabilities(to)
+	for _, key := range unionKeys(fromVulns, toVulns) {
+		f, inFrom := fromVulns[key]
+		t, inTo := toVulns[key]
+		var v VulnerabilityChange
+		switch {
+		case !inTo:
+			v = f
+			v.Change, v.FromStatus = Removed, f.ToStatus
+			v.ToStatus = ""
+		case !inFrom:
+			v = t
+			v.Change = Added
+		case f.ToStatus != t.ToStatus:
+			v = t
+			v.Change, v.FromStatus = Changed, f.ToStatus
+		default:
+			continue
+		}
+		d.Vulnerabilities = append(d.Vulnerabilities, v)
+	}
+	return d
+}
+
+// packageName returns the purl of the package name and the version of a
+// package version purl. Purls that do not parse are kept as names.
+func packageName(purl string) (string, string) {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return purl, ""
+	}
+	version := p.Version
+	p.Version, p.Qualifiers, p.Subpath = "", nil, ""
+	return p.ToString(), version
+}
+
+// dependencyVersions returns the versions of the dependencies of a subject
+// by package name.
+func dependencyVersions(facts *policy.Facts) map[string][]string {
+	out := map[string][]string{}
+	for _, p := range facts.Packages {
+		if !p.Dependency {
+			continue
+		}
+		name, version := packageName(p.Purl)
+		out[name] = appendUnique(out[name], version)
+	}
+	return out
+}
+
+// occurrences returns the occurrences of a subject keyed by package name and
+// artifact.
+func occurrences(facts *policy.Facts) map[string]OccurrenceChange {
+	out := map[string]OccurrenceChange{}
+	for _, o := range facts.Occurrences {
+		name, _ := packageName(o.Package)
+		out[name+" "+o.Artifact] = OccurrenceChange{Package: o.Package, Artifact: o.Artifact}
+	}
+	return out
+}
+
+// licenses returns the declared and discovered licenses by package name.
+func licenses(facts *policy.Facts) map[string][]string {
+	out := map[string][]string{}
+	for _, l := range facts.Licenses {
+		name, _ := packageName(l.Package)
+		for _, license := range []string{l.Declared, l.Discovered} {
+			if license != "" {
+				out[name] = appendUnique(out[name], license)
+			}
+		}
+	}
+	return out
+}
+
+// vulnerabilities returns the vulnerabilities keyed by package name and
+// vulnerability ID, with the VEX status in ToStatus. When several versions
+// of a package have the same vulnerability, a VEX status wins over none.
+func vulnerabilities(facts *policy.Facts) map[string]VulnerabilityChange {
+	out := map[string]VulnerabilityChange{}
+	for _, v := range facts.Vulnerabilities {
+		name, _ := packageName(v.Package)
+		key := name + " " + v.ID
+		if prev, ok := out[key]; ok && (prev.ToStatus != "" || v.Status == "") {
+			continue
+		}
+		out[key] = VulnerabilityChange{Package: name, ID: v.ID, Score: v.Score, ToStatus: v.Status}
+	}
+	return out
+}
+
+// compareSets compares two sorted sets, and reports whether they differ.
+func compareSets(from, to []string) (ChangeType, bool) {
+	switch {
+	case slices.Equal(from, to):
+		return "", false
+	case len(from) == 0:
+		return Added, true
+	case len(to) == 0:
+		return Removed, true
+	default:
+		return Changed, true
+	}
+}
+
+func appendUnique(set []string, s string) []string {
+	i, found := slices.BinarySearch(set, s)
+	if found {
+		return set
+	}
+	return slices.Insert(set, i, s)
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Rows returns a table row for each change, with the columns kind, change,
+// package, from and to.
+func (d *Diff) Rows() []table.Row {
+	var rows []table.Row
+	for _, c := range d.Dependencies {
+		rows = append(rows, table.Row{"dependency", c.Change, c.Package, strings.Join(c.From, ", "), strings.Join(c.To, ", ")})
+	}
+	for _, c := range d.Occurrences {
+		from, to := c.Artifact, ""
+		if c.Change == Added {
+			from, to = "", c.Artifact
+		}
+		rows = append(rows, table.Row{"occurrence", c.Change, c.Package, from, to})
+	}
+	for _, c := range d.Licenses {
+		rows = append(rows, table.Row{"license", c.Change, c.Package, strings.Join(c.From, ", "), strings.Join(c.To, ", ")})
+	}
+	for _, c := range d.Vulnerabilities {
+		from, to := vulnerabilityState(c.FromStatus), vulnerabilityState(c.ToStatus)
+		switch c.Change {
+		case Added:
+			from = ""
+		case Removed:
+			to = ""
+		}
+		rows = append(rows, table.Row{"vulnerability", c.Change, c.Package, c.ID + " " + from, c.ID + " " + to})
+	}
+	return rows
+}
+
+func vulnerabilityState(status string) string {
+	if status == "" {
+		return "(no VEX)"
+	}
+	return "(" + status + ")"
+}
+
+// Write writes the diff to w in the given format.
+func (d *Diff) Write(w io.Writer, format DiffFormat) error {
+	if format == DiffJSON {
+		out, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error encoding diff: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.AppendHeader(table.Row{"Kind", "Change", "Package", "From", "To"})
+	t.AppendRows(d.Rows())
+	switch format {
+	case DiffTable:
+		if d.Empty() {
+			_, err := fmt.Fprintf(w, "no changes from %s to %s\n", d.From, d.To)
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "changes from %s to %s\n", d.From, d.To); err != nil {
+			return err
+		}
+		t.Render()
+	case DiffMarkdown:
+		if _, err := fmt.Fprintf(w, "### Changes from `%s` to `%s`\n\n", d.From, d.To); err != nil {
+			return err
+		}
+		if d.Empty() {
+			_, err := fmt.Fprintln(w, "No changes.")
+			return err
+		}
+		t.RenderMarkdown()
+	default:
+		return fmt.Errorf("unknown diff format %q, expected table, json or markdown", format)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+func TestCompareFacts(t *testing.T) {
+	from := &policy.Facts{
+		Subject: policy.Subject{Purl: "pkg:guac/app@1.0.0"},
+		Packages: []policy.Package{
+			{Purl: "pkg:guac/app@1.0.0"},
+			{Purl: "pkg:guac/lib@1.0.0", Dependency: true},
+			{Purl: "pkg:guac/old@1.0.0", Dependency: true},
+			{Purl: "pkg:guac/same@1.0.0", Dependency: true},
+		},
+		Occurrences: []policy.Occurrence{{Package: "pkg:guac/app@1.0.0", Artifact: "sha256:app1"}},
+		Licenses: []policy.License{
+			{Package: "pkg:guac/app@1.0.0", Declared: "MIT"},
+			{Package: "pkg:guac/lib@1.0.0", Declared: "MIT"},
+		},
+		Vulnerabilities: []policy.Vulnerability{
+			{Package: "pkg:guac/lib@1.0.0", ID: "ghsa-fixed", Score: 7.5},
+			{Package: "pkg:guac/same@1.0.0", ID: "ghsa-vex", Score: 9.8},
+			{Package: "pkg:guac/same@1.0.0", ID: "ghsa-kept", Score: 5},
+		},
+	}
+	to := &policy.Facts{
+		Subject: policy.Subject{Purl: "pkg:guac/app@2.0.0"},
+		Packages: []policy.Package{
+			{Purl: "pkg:guac/app@2.0.0"},
+			{Purl: "pkg:guac/lib@1.1.0", Dependency: true},
+			{Purl: "pkg:guac/new@1.0.0", Dependency: true},
+			{Purl: "pkg:guac/same@1.0.0", Dependency: true},
+		},
+		Occurrences: []policy.Occurrence{{Package: "pkg:guac/app@2.0.0", Artifact: "sha256:app2"}},
+		Licenses: []policy.License{
+			{Package: "pkg:guac/app@2.0.0", Declared: "MIT"},
+			{Package: "pkg:guac/lib@1.1.0", Declared: "Apache-2.0", Discovered: "MIT"},
+		},
+		Vulnerabilities: []policy.Vulnerability{
+			{Package: "pkg:guac/new@1.0.0", ID: "ghsa-new", Score: 4},
+			{Package: "pkg:guac/same@1.0.0", ID: "ghsa-vex", Score: 9.8, Status: "NOT_AFFECTED"},
+			{Package: "pkg:guac/same@1.0.0", ID: "ghsa-kept", Score: 5},
+		},
+	}
+
+	expected := &Diff{
+		From: "pkg:guac/app@1.0.0",
+		To:   "pkg:guac/app@2.0.0",
+		Dependencies: []DependencyChange{
+			{Change: Changed, Package: "pkg:guac/lib", From: []string{"1.0.0"}, To: []string{"1.1.0"}},
+			{Change: Added, Package: "pkg:guac/new", To: []string{"1.0.0"}},
+			{Change: Removed, Package: "pkg:guac/old", From: []string{"1.0.0"}},
+		},
+		Occurrences: []OccurrenceChange{
+			{Change: Removed, Package: "pkg:guac/app@1.0.0", Artifact: "sha256:app1"},
+			{Change: Added, Package: "pkg:guac/app@2.0.0", Artifact: "sha256:app2"},
+		},
+		Licenses: []LicenseChange{
+			{Change: Changed, Package: "pkg:guac/lib", From: []string{"MIT"}, To: []string{"Apache-2.0", "MIT"}},
+		},
+		Vulnerabilities: []VulnerabilityChange{
+			{Change: Removed, Package: "pkg:guac/lib", ID: "ghsa-fixed", Score: 7.5},
+			{Change: Added, Package: "pkg:guac/new", ID: "ghsa-new", Score: 4},
+			{Change: Changed, Package: "pkg:guac/same", ID: "ghsa-vex", Score: 9.8, ToStatus: "NOT_AFFECTED"},
+		},
+	}
+	got := CompareFacts(from, to)
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", diff)
+	}
+
+	if d := CompareFacts(from, from); !d.Empty() {
+		t.Errorf("expected no changes between the same facts, got %+v", d)
+	}
+}
+
+func TestDiffWrite(t *testing.T) {
+	d := &Diff{
+		From: "pkg:guac/app@1.0.0",
+		To:   "pkg:guac/app@2.0.0",
+		Dependencies: []DependencyChange{
+			{Change: Changed, Package: "pkg:guac/lib", From: []string{"1.0.0"}, To: []string{"1.1.0"}},
+		},
+		Vulnerabilities: []VulnerabilityChange{
+			{Change: Changed, Package: "pkg:guac/lib", ID: "ghsa-vex", ToStatus: "NOT_AFFECTED"},
+		},
+	}
+	tests := []struct {
+		format  DiffFormat
+		want    []string
+		wantErr bool
+	}{
+		{
+			format: DiffTable,
+			want:   []string{"changes from pkg:guac/app@1.0.0 to pkg:guac/app@2.0.0", "pkg:guac/lib", "ghsa-vex (NOT_AFFECTED)"},
+		},
+		{
+			format: DiffMarkdown,
+			want: []string{
+				"### Changes from `pkg:guac/app@1.0.0` to `pkg:guac/app@2.0.0`",
+				"| dependency | changed | pkg:guac/lib | 1.0.0 | 1.1.0 |",
+				"| vulnerability | changed | pkg:guac/lib | ghsa-vex (no VEX) | ghsa-vex (NOT_AFFECTED) |",
+			},
+		},
+		{
+			format: DiffJSON,
+			want:   []string{`"change": "changed"`, `"toStatus": "NOT_AFFECTED"`},
+		},
+		{
+			format:  "yaml",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			err := d.Write(&buf, test.format)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			for _, w := range test.want {
+				if !strings.Contains(buf.String(), w) {
+					t.Errorf("expected output to contain %q, got:\n%s", w, buf.String())
+				}
+			}
+		})
+	}
+}
@@ -113,6 +113,10 @@ func SearchDependentsFromStartPackage(ctx context.Context, gqlClient graphql.Cli
 }
 
 // bfsOfDependents performs a breadth-first search on a graph to find dependencies
+//
+// It walks node by node rather than using dependencies.DependentClosure, as
+// the plan needs the parents, package names and points of contact of the
+// nodes, which the closure does not return.
 func (q *queueValues) bfsOfDependents(ctx context.Context, gqlClient graphql.Client, stopID *string, maxDepth int) error {
 	for len(q.queue) > 0 {
 		q.now = &q.queue[0]
@@ -228,6 +228,8 @@ func SearchForSBOMViaArtifact(ctx context.Context, gqlclient graphql.Client, sea
 // From there is recursively searches through all the dependencies to determine if it contains hasSBOM nodes.
 // It concurrent checks the package version node if it contains vulnerabilities and VEX data.
 // The isPurl parameter is used to know whether the searchString is expected to be a PURL.
+// It walks the SBOMs node by node rather than using dependencies.DependencyClosure, as
+// it reports the path of dependency, vulnerability and VEX nodes to each package.
 func SearchForSBOMViaPkg(ctx context.Context, gqlclient graphql.Client, searchString string, maxLength int, isPurl bool) ([]string, []table.Row, error) {
 	var path []string
 	var tableRows []table.Row
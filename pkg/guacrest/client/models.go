@@ -3,6 +3,13 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package client
 
+// Defines values for ChangeType.
+const (
+	Added   ChangeType = "added"
+	Changed ChangeType = "changed"
+	Removed ChangeType = "removed"
+)
+
 // Defines values for AnalyzeDependenciesParamsSort.
 const (
 	Frequency AnalyzeDependenciesParamsSort = "frequency"
@@ -15,11 +22,37 @@ const (
 	Name   RetrieveDependenciesParamsLinkCondition = "name"
 )
 
+// ChangeType defines model for ChangeType.
+type ChangeType string
+
+// DependencyChange A dependency added, removed, or whose versions changed. Package is the purl of the package name, without version.
+type DependencyChange struct {
+	Change  ChangeType `json:"Change"`
+	From    []string   `json:"From"`
+	Package Purl       `json:"Package"`
+	To      []string   `json:"To"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"Message"`
 }
 
+// LicenseChange defines model for LicenseChange.
+type LicenseChange struct {
+	Change  ChangeType `json:"Change"`
+	From    []string   `json:"From"`
+	Package Purl       `json:"Package"`
+	To      []string   `json:"To"`
+}
+
+// OccurrenceChange defines model for OccurrenceChange.
+type OccurrenceChange struct {
+	Artifact string     `json:"Artifact"`
+	Change   ChangeType `json:"Change"`
+	Package  Purl       `json:"Package"`
+}
+
 // PackageName defines model for PackageName.
 type PackageName struct {
 	DependentCount int  `json:"DependentCount"`
@@ -32,9 +65,27 @@ type PaginationInfo struct {
 	TotalCount *int    `json:"TotalCount,omitempty"`
 }
 
+// PolicyResult defines model for PolicyResult.
+type PolicyResult struct {
+	Description *string  `json:"Description,omitempty"`
+	Pass        bool     `json:"Pass"`
+	Policy      string   `json:"Policy"`
+	Violations  []string `json:"Violations"`
+}
+
 // Purl defines model for Purl.
 type Purl = string
 
+// VulnerabilityChange A vulnerability added, removed, or whose VEX status changed. The statuses are absent when there is no VEX statement.
+type VulnerabilityChange struct {
+	Change     ChangeType `json:"Change"`
+	FromStatus *string    `json:"FromStatus,omitempty"`
+	Id         string     `json:"Id"`
+	Package    Purl       `json:"Package"`
+	Score      float64    `json:"Score"`
+	ToStatus   *string    `json:"ToStatus,omitempty"`
+}
+
 // PaginationSpec defines model for PaginationSpec.
 type PaginationSpec struct {
 	Cursor   *string `json:"Cursor,omitempty"`
@@ -47,12 +98,29 @@ type BadGateway = Error
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
+// GraphDiff defines model for GraphDiff.
+type GraphDiff struct {
+	Dependencies    []DependencyChange    `json:"Dependencies"`
+	From            string                `json:"From"`
+	Licenses        []LicenseChange       `json:"Licenses"`
+	Occurrences     []OccurrenceChange    `json:"Occurrences"`
+	To              string                `json:"To"`
+	Vulnerabilities []VulnerabilityChange `json:"Vulnerabilities"`
+}
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
 // PackageNameList defines model for PackageNameList.
 type PackageNameList = []PackageName
 
+// PolicyVerdict defines model for PolicyVerdict.
+type PolicyVerdict struct {
+	Pass    bool           `json:"Pass"`
+	Results []PolicyResult `json:"Results"`
+	Subject string         `json:"Subject"`
+}
+
 // PurlList defines model for PurlList.
 type PurlList struct {
 	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
@@ -76,6 +144,24 @@ type AnalyzeDependenciesParams struct {
 // AnalyzeDependenciesParamsSort defines parameters for AnalyzeDependencies.
 type AnalyzeDependenciesParamsSort string
 
+// DiffSubjectsParams defines parameters for DiffSubjects.
+type DiffSubjectsParams struct {
+	// From The purl or digest of the subject to compare from.
+	From string `form:"from" json:"from"`
+
+	// To The purl or digest of the subject to compare to.
+	To string `form:"to" json:"to"`
+}
+
+// EvaluatePolicyParams defines parameters for EvaluatePolicy.
+type EvaluatePolicyParams struct {
+	// Purl The purl of the package.
+	Purl *string `form:"purl,omitempty" json:"purl,omitempty"`
+
+	// Digest The digest of the artifact.
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty"`
+}
+
 // RetrieveDependenciesParams defines parameters for RetrieveDependencies.
 type RetrieveDependenciesParams struct {
 	// PaginationSpec The pagination configuration for the query.
@@ -83,7 +169,7 @@ type RetrieveDependenciesParams struct {
 	//   * 'Cursor' is returned by previous calls and specifies what page to return
 	PaginationSpec *PaginationSpec `form:"paginationSpec,omitempty" json:"paginationSpec,omitempty"`
 
-	// LinkCondition Whether links between nouns must be made by digest or if they  can be made just by name (i.e. purl). Specify 'name' to allow using SBOMs that don't provide the digest of the subject. The default is  'digest'. To search by purl, 'name' must be specified.
+	// LinkCondition Whether links between nouns must be made by digest or if they  can be made just by name (i.e. purl). Specify 'name' to allow using SBOMs that don't provide the digest of the subject, and to follow IsDependency links. The default is 'digest'. To search by purl, 'name' must be specified.
 	LinkCondition *RetrieveDependenciesParamsLinkCondition `form:"linkCondition,omitempty" json:"linkCondition,omitempty"`
 
 	// Purl The purl of the dependent package.
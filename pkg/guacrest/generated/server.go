@@ -19,6 +19,12 @@ type ServerInterface interface {
 	// Identify the most important dependencies
 	// (GET /analysis/dependencies)
 	AnalyzeDependencies(w http.ResponseWriter, r *http.Request, params AnalyzeDependenciesParams)
+	// Compare two packages or artifacts
+	// (GET /analysis/diff)
+	DiffSubjects(w http.ResponseWriter, r *http.Request, params DiffSubjectsParams)
+	// Evaluate policies against a package or artifact
+	// (GET /analysis/policy)
+	EvaluatePolicy(w http.ResponseWriter, r *http.Request, params EvaluatePolicyParams)
 	// Health check the server
 	// (GET /healthz)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
@@ -37,6 +43,18 @@ func (_ Unimplemented) AnalyzeDependencies(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Compare two packages or artifacts
+// (GET /analysis/diff)
+func (_ Unimplemented) DiffSubjects(w http.ResponseWriter, r *http.Request, params DiffSubjectsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Evaluate policies against a package or artifact
+// (GET /analysis/policy)
+func (_ Unimplemented) EvaluatePolicy(w http.ResponseWriter, r *http.Request, params EvaluatePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check the server
 // (GET /healthz)
 func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -100,6 +118,90 @@ func (siw *ServerInterfaceWrapper) AnalyzeDependencies(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// DiffSubjects operation middleware
+func (siw *ServerInterfaceWrapper) DiffSubjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffSubjectsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffSubjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EvaluatePolicy operation middleware
+func (siw *ServerInterfaceWrapper) EvaluatePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EvaluatePolicyParams
+
+	// ------------- Optional query parameter "purl" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "purl", r.URL.Query(), &params.Purl)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purl", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "digest" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluatePolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // HealthCheck operation middleware
 func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
@@ -281,6 +383,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/analysis/dependencies", wrapper.AnalyzeDependencies)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analysis/diff", wrapper.DiffSubjects)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analysis/policy", wrapper.EvaluatePolicy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/healthz", wrapper.HealthCheck)
 	})
@@ -295,10 +403,25 @@ type BadGatewayJSONResponse Error
 
 type BadRequestJSONResponse Error
 
+type GraphDiffJSONResponse struct {
+	Dependencies    []DependencyChange    `json:"Dependencies"`
+	From            string                `json:"From"`
+	Licenses        []LicenseChange       `json:"Licenses"`
+	Occurrences     []OccurrenceChange    `json:"Occurrences"`
+	To              string                `json:"To"`
+	Vulnerabilities []VulnerabilityChange `json:"Vulnerabilities"`
+}
+
 type InternalServerErrorJSONResponse Error
 
 type PackageNameListJSONResponse []PackageName
 
+type PolicyVerdictJSONResponse struct {
+	Pass    bool           `json:"Pass"`
+	Results []PolicyResult `json:"Results"`
+	Subject string         `json:"Subject"`
+}
+
 type PurlListJSONResponse struct {
 	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
 	PaginationInfo PaginationInfo `json:"PaginationInfo"`
@@ -351,6 +474,98 @@ func (response AnalyzeDependencies502JSONResponse) VisitAnalyzeDependenciesRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type DiffSubjectsRequestObject struct {
+	Params DiffSubjectsParams
+}
+
+type DiffSubjectsResponseObject interface {
+	VisitDiffSubjectsResponse(w http.ResponseWriter) error
+}
+
+type DiffSubjects200JSONResponse struct{ GraphDiffJSONResponse }
+
+func (response DiffSubjects200JSONResponse) VisitDiffSubjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffSubjects400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DiffSubjects400JSONResponse) VisitDiffSubjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffSubjects500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DiffSubjects500JSONResponse) VisitDiffSubjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffSubjects502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response DiffSubjects502JSONResponse) VisitDiffSubjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicyRequestObject struct {
+	Params EvaluatePolicyParams
+}
+
+type EvaluatePolicyResponseObject interface {
+	VisitEvaluatePolicyResponse(w http.ResponseWriter) error
+}
+
+type EvaluatePolicy200JSONResponse struct{ PolicyVerdictJSONResponse }
+
+func (response EvaluatePolicy200JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EvaluatePolicy400JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response EvaluatePolicy500JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePolicy502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response EvaluatePolicy502JSONResponse) VisitEvaluatePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type HealthCheckRequestObject struct {
 }
 
@@ -418,6 +633,12 @@ type StrictServerInterface interface {
 	// Identify the most important dependencies
 	// (GET /analysis/dependencies)
 	AnalyzeDependencies(ctx context.Context, request AnalyzeDependenciesRequestObject) (AnalyzeDependenciesResponseObject, error)
+	// Compare two packages or artifacts
+	// (GET /analysis/diff)
+	DiffSubjects(ctx context.Context, request DiffSubjectsRequestObject) (DiffSubjectsResponseObject, error)
+	// Evaluate policies against a package or artifact
+	// (GET /analysis/policy)
+	EvaluatePolicy(ctx context.Context, request EvaluatePolicyRequestObject) (EvaluatePolicyResponseObject, error)
 	// Health check the server
 	// (GET /healthz)
 	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
@@ -481,6 +702,58 @@ func (sh *strictHandler) AnalyzeDependencies(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// DiffSubjects operation middleware
+func (sh *strictHandler) DiffSubjects(w http.ResponseWriter, r *http.Request, params DiffSubjectsParams) {
+	var request DiffSubjectsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffSubjects(ctx, request.(DiffSubjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffSubjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffSubjectsResponseObject); ok {
+		if err := validResponse.VisitDiffSubjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EvaluatePolicy operation middleware
+func (sh *strictHandler) EvaluatePolicy(w http.ResponseWriter, r *http.Request, params EvaluatePolicyParams) {
+	var request EvaluatePolicyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EvaluatePolicy(ctx, request.(EvaluatePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EvaluatePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EvaluatePolicyResponseObject); ok {
+		if err := validResponse.VisitEvaluatePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // HealthCheck operation middleware
 func (sh *strictHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	var request HealthCheckRequestObject
@@ -18,27 +18,41 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RX32/bNhD+Vw7cAG+DZgfd9pK3Jm3XAP0R1AH20PaBIc8WG4pUjqQ9tfD/Phwl2ZIr",
-	"r+mAPOxNEnm87767+476IpSvau/QxSDOv4hakqwwIuW3a7k2Tkbj3bJGxV80BkWm5k/iXNyUCPV+Dyjv",
-	"VmadqH1beYJYItwnpGb+wQH8ArNrucal+YwzCDUqszIY8iaXqlsk8CsgDMnGAIQxkUPdGV4mCp5mYA4r",
-	"cNtATbgxPgVQ0toA0unBwdtSRsaHEH1n9cGJQhjGnmGJQjhZoTgX9TjUQgRVYiUzJ+RrpGgwc9IC4afY",
-	"1GwZIhm3FrtC9MENFo2LuEYSu13Rf/K3n1BFseNPhKH2LrQnX0j9p4y4lQ2/Ke8iusiPsq6tURnc4lNg",
-	"5r8M4P1IuBLn4ofFIZOLdjUsnhN5al19nbmAtEECdMonF5FQg3SAbMKpdKiicWvmjjOkZZRwK9UdOs3B",
-	"Xkj9Du8Thvj4aC+kBmqdFRCSKkEGWJGvwLiNtEaDJ6hMCIx3UMK7QlxxZE7aZQ629fDoeHun0HqFbiNX",
-	"iLqTa3wjK3xlvpM5E7EK34I0cCAOJSeJZDMF9ClYEyK3Xd0aArdDgK2JJWfdEGis0Wl0EXKZZFKvE9nv",
-	"xj9uo4O0XLmV/3ZYo91HEB7GTCI7QQn34H0yhFqcvz9GNXDzcbJ/T7KZyIZ8euee0e2Lb8zEawxBrnFC",
-	"UY7A9Ru/hjIqrK89POtTeMkZnFKnQvSW36bwCFU2LI59TGM8TvmYvkvvojSuHQgqy2wn3GRwg1B5yuMG",
-	"wxyuVryLECQhOJ/XCoA3+HdsBRq2xlq4RXDGzrPqjzk57JyU8hsfpT1J124qOiZnIoc7njccrkvWFsLX",
-	"6GRtxLn4bX42P2NcMpYZ0kI6aZtgwqLvOdWBXWOGwfhb/jRXG+/+jM+Ge4vRAH8/nc7DlsXRgN8VUxM+",
-	"eIrgSbfzOeaZn6stdLN5lbXZqWYGv8LNYH2vI1CadYkhDub8Xlf6U4LyhEqSPn2K9Vs+5G2Nbrl8AXuL",
-	"9unkbOcAxLBqIyUcTnh0qeJa3geS5393+KCW90n9eDS6n5ydnWqe/b7FsfjvCvH7Q+wGk3ZXiD8eYjI1",
-	"9bLtkwe5668hWcBSVUlqeKpxtsyqyamofIhgqtpTlC7CqGLZbFGitLH8fLJ8X+b1yxLVnZhm88GTZaLl",
-	"juexZuvurtnde0yAFuNxnC0yUAxtYNCGlUvrVIOOvb4wTmf7SNIFE80GRzz13WRcneI8l/xLGZYXb1/n",
-	"eyw/v1o+5Stuj57FLgW+p7WR7E9rgNBmekJp6mzO61fhrVKJCJ3C/BGu79bP75O0k6dGDyvGrLwLhtud",
-	"G2YjLQ//vhlbLR2n8l2n0I8rRX+VyJIP1ri7ALcYt4gOnE8uQJVCZLGvpEb+LdBmzULhCUzmuAFQ0u13",
-	"fMrbm3zZgZ/MHOd5Yv88h2X+eWhgxkszZkRa67eQ8tWSc8PMywjau1mEmvzGaGyT0flskxpSngxtWjWu",
-	"ZLKRSw5m7b7ZHG48BJSkyvwjk8gWvds+nP5XRs9PahuzcemdNpmlKVFr/fUWE2I2KfqMp4/lcAXsqmB+",
-	"6ieKZ2DxL5056WpM3IOd7eM67e6/yXR/5fu/6XPfhqf0hpVx908AAAD//0J3mDTxDwAA",
+	"H4sIAAAAAAAC/+xZ3Y/buBH/VwZqgW0L1Rtc25d9S/Y2dwvkLot4kRa43ANNjSxmJVIhKe85gf/3Yob6",
+	"oGQpa18uBQL0zZY4H5yZ33zpUyJNVRuN2rvk6lNSCysq9Gj5353YKi28Mnpdo6QnGTppVU2PkqvkvkCo",
+	"+zMgjc7VtrHhX24s+ALhQ4N2v3qnAf4GF3dii2v1ES/A1ShVrtDxId1UG7RgcrDomtI7sOgbqzFrCa8b",
+	"64y9ADW8gc0eaos7ZRoHUpSlA6GziPFjITzph+BNS/VOJ2miSHdWK0kTLSpMrpJ6fNU0cbLASrBNrKnR",
+	"eoVsk6AI/fL7miidt0pvk0OadJeLXirtcYs2ORzS7pHZvEfpkwM9suhqo13g/EJkPwiPj2JP/6TRHrWn",
+	"n6KuSyVZucv3jiz/KVLvzxbz5Cr50+Xgycvw1l3eWGtsEHXsOYd2hxZQS9NojxYzEBqQSMiVGqVXeku2",
+	"Iw9lwgvYCPmAOqPLvhDZG/zQoPNfX9sXIgMbhKXgGlmAcJBbU4HSO1GqDIyFSjlH+kYhfEiTH6yoi+9V",
+	"np+l5djl32ONOkMt2//KY+WeukxPtL8uhN5iMkSAsFbs6f9La6rZSHqlJHZhcZK0lmBZ1GspG2tRyzOY",
+	"DjTLfO/N7AXeNqVGKzaqVP4cq8V0i4Zj4HxolMUsufolWJE1SceuGt86suqxfr/OwvMYM5nKcwwMYYP+",
+	"EVEzOlyz2VKkOUph/tHQA+LDMXhL6NKiXDPgQpR/dcx0QiFIhfYgZSn5ILb4s6jwlToTvSf5MBIw47sj",
+	"RZ9DqZwnu9WBECglO3hUviDbKgtZ61UPnKrYqHemVHL/Fm2mpP8CcN8J56IQ3hhTotAk4U2oRKffmzUK",
+	"VHNQWYeQmMHLJKC7g2lQbtDk1Cg1jZemQo7FAgF3omxCUTY5oJAF1KzrCuj0LtgQauEcOlA5iLLsaPkg",
+	"FVN6u3rHhrlrbHl26Eyt3pXbW52bpyNqdHqiwmnOaWz5ZCaZyInEnGL5KJAbWzrm3ornxoGz2T1z+ZSg",
+	"bioSKbIMs4TUqMyOf0k+l0Uih7R6VFSOWrLnPVjkHph5Ci3vlKrkY2Ecu9wpox20wlbQgpb6K3Z7Y4cQ",
+	"iGCZMixN4zsWK+6oJi1Sr9vnPBLZIyqFvTOP7j6FU6vxqY4PhepU9pPAaG80CE2jqnMcG2nSJ/mxZX5C",
+	"51qdP58CuoNzvMe1/rhB/b/1jxqXIyM9t17lYjYZp7/LgmfZ4+n79frNXS8usIvNqr+mUjk3iqRJR3m2",
+	"pkyYTmXM6zhN8OM8dW20F0qHbCN5pmqnNKtwh1AZy7MluhXcch6yCMIiaMPvUoCf8TcfpjF4VGUJGwSt",
+	"yrmENJyc9fe98aJcNNdh7nZxqZ9xQXTT2TlxqeUIfOc7amVKtqf7AiS1/PvGImI660SKgafa+8+Uol18",
+	"bLkavb35DzgvfBPVIx5Q+Rk69rvYOGoAH4vQcVsuVdr0xFih9n9kNVqz9Nnr32YLfj0rJ66lsXw4N7YS",
+	"PrlKMtNsSgJYyzpsRUKILqrzdDK5pbYiSJvvYxRDVDdlmSamRi1qlVwl/1g9Wz0jcwpfsOBLoUW5d8pd",
+	"ZpOJeIsMAzJ7wHxG/qfTH3EyksUbpl/mDTUcuZxsoA7p3ArKGevB2CwskKKWxbXLo5yXB1ruL+DvcB+9",
+	"74cMKNS2QOejRVQ/dHRcHFlQCpstcynNIzF5XaNer19CTxF+LS6f6AJJ7EZvG4xXUF232F+EF1Qt85lO",
+	"8fDrZLf03bNnS2HZn7ucToaHNPnnKXTRKuiQJv86hWRuJGba704S1+3JuMVuqkrYPY285C2V79kVlXEe",
+	"VFUb64X2MIpYIouCud0OtUE8rVNVTelndsjvA8BYEG3FdsOGio5YLFFQDjM5iI7gKmzUIpUmyfGoU+8S",
+	"Y8qUvaxRf26o63EgXDhUttuOlLei9GQ3Xnp0z5WNMnA/CYSsK8P1eddKwbqCGxofTR7Wb8zBUCYWPDJw",
+	"Uue7qS2GWUjoXt2QncdJ4nuV5+tuV3KUHWbWzTyY2EhA6xniQLq0GrN+qwW85aF7XMbbUYr9IlW8WVLE",
+	"m7PU+F2oHjag3xqee+wtQW0C5LrvnmahfBPWIDjea3QfLQh07S4vLMzElvpTP0J+52Sl68ZfgfIO1i9e",
+	"/zSCcgrrV+vnUFuzQy20xHSoAy6NYDkHx1E341Zw85uQvtyD0dgtFvhcG3NV4zz1vd03j2wOYt29++bv",
+	"RJCNSulSBNPR5GzojCHT54cFGeF08ocjY7xC/NbQ0YdzH8pdxIqhJAxgCVgpUJS++LjYs/3I768LlA/J",
+	"vElPXvhNXTSzoc6Iuv0C2KJOOQg6Tq8bNANJqkUE4VocMEtd6VjqS9VWQ2+Fdsqr3aQSxxAPc8iPwjHK",
+	"CXj0O8AbO+0pRTUOMy75cV3fU/UP41Wh6r4M37phP8EP4e5he/OhEeUsV28gJ52l0U5Rj0vlYidKmoa6",
+	"rDgH+zftKP11++9/F0jTGJRKPwyfRLRptOuzUyUypAaiQ70FxTbeA0ih+xPv+XjoM+AvaoUrTkR/XcGa",
+	"09seLujVBVlElKV5hIY/+JFvyPLCQ2b0hefUqzKcNCJRcU67xiU3zOfWDYvVcJPg+Axz0ZSegvIiMLpY",
+	"wb0Bh8LKgr8/c9PT6rWQjedSGgm5NjpTbMa5Vr9Pekwx0+KnT2Xu4avJ/yCHnyzsqyXzblX/reXxDqdL",
+	"CYlS5+G/AQAA//8e4QL5qCEAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
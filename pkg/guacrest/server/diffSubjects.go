@@ -0,0 +1,124 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/guacanalytics"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/helpers"
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+func (s *DefaultServer) DiffSubjects(ctx context.Context, request gen.DiffSubjectsRequestObject) (gen.DiffSubjectsResponseObject, error) {
+	if request.Params.From == "" || request.Params.To == "" {
+		return diffSubjectsErr(fmt.Errorf("both from and to must be specified")), nil
+	}
+
+	diff, err := guacanalytics.DiffSubjects(ctx, s.gqlClient,
+		diffSubject(request.Params.From), diffSubject(request.Params.To))
+	if err != nil {
+		return diffSubjectsErr(err), nil
+	}
+
+	res := gen.DiffSubjects200JSONResponse{}
+	res.From = diff.From
+	res.To = diff.To
+	res.Dependencies = []gen.DependencyChange{}
+	for _, c := range diff.Dependencies {
+		res.Dependencies = append(res.Dependencies, gen.DependencyChange{
+			Change:  gen.ChangeType(c.Change),
+			Package: c.Package,
+			From:    nonNil(c.From),
+			To:      nonNil(c.To),
+		})
+	}
+	res.Occurrences = []gen.OccurrenceChange{}
+	for _, c := range diff.Occurrences {
+		res.Occurrences = append(res.Occurrences, gen.OccurrenceChange{
+			Change:   gen.ChangeType(c.Change),
+			Package:  c.Package,
+			Artifact: c.Artifact,
+		})
+	}
+	res.Licenses = []gen.LicenseChange{}
+	for _, c := range diff.Licenses {
+		res.Licenses = append(res.Licenses, gen.LicenseChange{
+			Change:  gen.ChangeType(c.Change),
+			Package: c.Package,
+			From:    nonNil(c.From),
+			To:      nonNil(c.To),
+		})
+	}
+	res.Vulnerabilities = []gen.VulnerabilityChange{}
+	for _, c := range diff.Vulnerabilities {
+		v := gen.VulnerabilityChange{
+			Change:  gen.ChangeType(c.Change),
+			Package: c.Package,
+			Id:      c.ID,
+			Score:   c.Score,
+		}
+		if c.FromStatus != "" {
+			v.FromStatus = &c.FromStatus
+		}
+		if c.ToStatus != "" {
+			v.ToStatus = &c.ToStatus
+		}
+		res.Vulnerabilities = append(res.Vulnerabilities, v)
+	}
+	return res, nil
+}
+
+// diffSubject reads a purl, or the digest of an artifact otherwise.
+func diffSubject(s string) policy.Subject {
+	if strings.HasPrefix(s, "pkg:") {
+		return policy.Subject{Purl: s}
+	}
+	return policy.Subject{Digest: s}
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// diffSubjectsErr maps helpers.Err502 and helpers.Err500 to the
+// corresponding OpenAPI response type. Other errors are returned as client
+// errors.
+func diffSubjectsErr(err error) gen.DiffSubjectsResponseObject {
+	switch err {
+	case helpers.Err502:
+		return gen.DiffSubjects502JSONResponse{
+			BadGatewayJSONResponse: gen.BadGatewayJSONResponse{
+				Message: err.Error(),
+			}}
+	case helpers.Err500:
+		return gen.DiffSubjects500JSONResponse{
+			InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
+				Message: err.Error(),
+			}}
+	default:
+		return gen.DiffSubjects400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			}}
+	}
+}
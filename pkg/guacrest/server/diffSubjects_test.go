@@ -0,0 +1,105 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cmp "github.com/google/go-cmp/cmp"
+
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	api "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_DiffSubjects(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, GuacData{
+		Packages: []string{
+			"pkg:guac/app@1", "pkg:guac/app@2",
+			"pkg:guac/lib@1", "pkg:guac/lib@2", "pkg:guac/new@1",
+		},
+		Artifacts:       []string{"sha-app1"},
+		Vulnerabilities: []string{"osv/ghsa-lib"},
+		HasSboms: []HasSbom{
+			{Subject: "pkg:guac/app@1", IncludedSoftware: []string{"pkg:guac/lib@1"}},
+			{Subject: "pkg:guac/app@2", IncludedSoftware: []string{"pkg:guac/lib@2", "pkg:guac/new@1"}},
+		},
+		IsOccurrences: []IsOccurrence{{Subject: "pkg:guac/app@1", Artifact: "sha-app1"}},
+		CertifyVulns: []CertifyVuln{{
+			Package:       "pkg:guac/lib@1",
+			Vulnerability: "osv/ghsa-lib",
+			Metadata:      &gql.ScanMetadataInput{TimeScanned: time.Now()},
+		}},
+	})
+
+	tests := []struct {
+		name     string
+		input    api.DiffSubjectsParams
+		expected api.DiffSubjectsResponseObject
+	}{
+		{
+			name:  "release",
+			input: api.DiffSubjectsParams{From: "pkg:guac/app@1", To: "pkg:guac/app@2"},
+			expected: api.DiffSubjects200JSONResponse{GraphDiffJSONResponse: api.GraphDiffJSONResponse{
+				From: "pkg:guac/app@1",
+				To:   "pkg:guac/app@2",
+				Dependencies: []api.DependencyChange{
+					{Change: api.Changed, Package: "pkg:guac/lib", From: []string{"1"}, To: []string{"2"}},
+					{Change: api.Added, Package: "pkg:guac/new", From: []string{}, To: []string{"1"}},
+				},
+				Occurrences: []api.OccurrenceChange{
+					{Change: api.Removed, Package: "pkg:guac/app@1", Artifact: "sha256:sha-app1"},
+				},
+				Licenses: []api.LicenseChange{},
+				Vulnerabilities: []api.VulnerabilityChange{
+					{Change: api.Removed, Package: "pkg:guac/lib", Id: "ghsa-lib"},
+				},
+			}},
+		},
+		{
+			name:  "missing subject",
+			input: api.DiffSubjectsParams{From: "pkg:guac/app@1"},
+			expected: api.DiffSubjects400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Message: "both from and to must be specified",
+			}},
+		},
+		{
+			name:  "unknown package",
+			input: api.DiffSubjectsParams{From: "pkg:guac/app@1", To: "pkg:guac/app@3"},
+			expected: api.DiffSubjects400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Message: "no packages matched the input purl",
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restApi := server.NewDefaultServer(gqlClient)
+			res, err := restApi.DiffSubjects(ctx, api.DiffSubjectsRequestObject{Params: test.input})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.expected, res); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+func (s *DefaultServer) EvaluatePolicy(ctx context.Context, request gen.EvaluatePolicyRequestObject) (gen.EvaluatePolicyResponseObject, error) {
+	logger := logging.FromContext(ctx)
+
+	var subject policy.Subject
+	switch {
+	case request.Params.Purl != nil && request.Params.Digest != nil:
+		return evaluatePolicyErr(fmt.Errorf("only one of purl and digest can be specified")), nil
+	case request.Params.Purl != nil:
+		subject.Purl = *request.Params.Purl
+	case request.Params.Digest != nil:
+		subject.Digest = *request.Params.Digest
+	default:
+		return evaluatePolicyErr(fmt.Errorf("either purl or digest must be specified")), nil
+	}
+
+	policies := s.policies
+	if policies == nil {
+		var err error
+		if policies, err = policy.Builtin(); err != nil {
+			logger.Errorf("error loading the built-in policies: %v", err)
+			return evaluatePolicyErr(helpers.Err500), nil
+		}
+	}
+
+	facts, err := policy.Gather(ctx, s.gqlClient, subject)
+	if err != nil {
+		return evaluatePolicyErr(err), nil
+	}
+	verdict, err := policy.Evaluate(facts, policies)
+	if err != nil {
+		logger.Errorf("error evaluating policies on %s: %v", subject, err)
+		return evaluatePolicyErr(helpers.Err500), nil
+	}
+
+	res := gen.EvaluatePolicy200JSONResponse{}
+	res.Subject = verdict.Subject
+	res.Pass = verdict.Pass
+	res.Results = []gen.PolicyResult{}
+	for _, r := range verdict.Results {
+		description := r.Description
+		res.Results = append(res.Results, gen.PolicyResult{
+			Policy:      r.Policy,
+			Description: &description,
+			Pass:        r.Pass,
+			Violations:  r.Violations,
+		})
+	}
+	return res, nil
+}
+
+// evaluatePolicyErr maps helpers.Err502 and helpers.Err500 to the
+// corresponding OpenAPI response type. Other errors are returned as client
+// errors.
+func evaluatePolicyErr(err error) gen.EvaluatePolicyResponseObject {
+	switch err {
+	case helpers.Err502:
+		return gen.EvaluatePolicy502JSONResponse{
+			BadGatewayJSONResponse: gen.BadGatewayJSONResponse{
+				Message: err.Error(),
+			}}
+	case helpers.Err500:
+		return gen.EvaluatePolicy500JSONResponse{
+			InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
+				Message: err.Error(),
+			}}
+	default:
+		return gen.EvaluatePolicy400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			}}
+	}
+}
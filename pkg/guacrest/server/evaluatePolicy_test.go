@@ -0,0 +1,117 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	api "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+func Test_EvaluatePolicy(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, GuacData{
+		Packages:  []string{"pkg:guac/foo", "pkg:guac/bar"},
+		Artifacts: []string{"sha-xyz"},
+		HasSboms: []HasSbom{
+			{Subject: "pkg:guac/foo", IncludedSoftware: []string{"pkg:guac/bar"}},
+		},
+	})
+	noBar, err := policy.Parse([]byte(`
+name: no-bar
+description: Must not depend on bar.
+violations: |
+  packages.filter(p, p.dependency && p.purl.startsWith("pkg:guac/bar")).map(p, p.purl + " is banned")
+`))
+	if err != nil {
+		t.Fatalf("error parsing policy: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		policies []*policy.Policy
+		input    api.EvaluatePolicyParams
+		expected api.EvaluatePolicyResponseObject
+	}{
+		{
+			name:     "violation",
+			policies: []*policy.Policy{noBar},
+			input:    api.EvaluatePolicyParams{Purl: ptrfrom.String("pkg:guac/foo")},
+			expected: api.EvaluatePolicy200JSONResponse{PolicyVerdictJSONResponse: api.PolicyVerdictJSONResponse{
+				Subject: "pkg:guac/foo",
+				Results: []api.PolicyResult{{
+					Policy:      "no-bar",
+					Description: ptrfrom.String("Must not depend on bar."),
+					Violations:  []string{"pkg:guac/bar is banned"},
+				}},
+			}},
+		},
+		{
+			name:     "pass",
+			policies: []*policy.Policy{noBar},
+			input:    api.EvaluatePolicyParams{Digest: ptrfrom.String("sha-xyz")},
+			expected: api.EvaluatePolicy200JSONResponse{PolicyVerdictJSONResponse: api.PolicyVerdictJSONResponse{
+				Subject: "sha-xyz",
+				Pass:    true,
+				Results: []api.PolicyResult{{
+					Policy:      "no-bar",
+					Description: ptrfrom.String("Must not depend on bar."),
+					Pass:        true,
+					Violations:  []string{},
+				}},
+			}},
+		},
+		{
+			name:  "no subject",
+			input: api.EvaluatePolicyParams{},
+			expected: api.EvaluatePolicy400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Message: "either purl or digest must be specified",
+			}},
+		},
+		{
+			name:  "unknown package",
+			input: api.EvaluatePolicyParams{Purl: ptrfrom.String("pkg:guac/baz")},
+			expected: api.EvaluatePolicy400JSONResponse{BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Message: "no packages matched the input purl",
+			}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			restApi := server.NewDefaultServer(gqlClient)
+			if test.policies != nil {
+				restApi.SetPolicies(test.policies)
+			}
+			res, err := restApi.EvaluatePolicy(ctx, api.EvaluatePolicyRequestObject{Params: test.input})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.expected, res); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Khan/genqlient/graphql"
 	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	assembler_helpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/dependencies"
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
 	"github.com/guacsec/guac/pkg/guacrest/helpers"
 	"github.com/guacsec/guac/pkg/guacrest/pagination"
@@ -50,29 +51,6 @@ func newByDigest(gqlClient graphql.Client) byDigest {
 	return byDigest{gqlClient: gqlClient}
 }
 
-// byName is a edgeGen that respects all relationships between nouns, whether they
-// are linked by hash or by name. It is useful when SBOMs don't provide the digest
-// of the subject.
-//
-// It implements all edges defined by byDigest, in addition to the following:
-// dependency edges:
-// - package -> sbom -> package
-// - package -> sbom -> artifact
-//
-// equivalence edges:
-// - package -> IsOccurrence -> artifact
-//
-// byName lazily generates edges using calls to the GraphQL server, instead of
-// precomputing the graph.
-type byName struct {
-	gqlClient graphql.Client
-	bd        byDigest
-}
-
-func newByName(gqlClient graphql.Client) byName {
-	return byName{gqlClient: gqlClient, bd: newByDigest(gqlClient)}
-}
-
 /********* The graph traversal *********/
 
 func getTransitiveDependencies(
@@ -153,33 +131,6 @@ func (eg byDigest) getEquivalentNodes(ctx context.Context, v node) ([]node, erro
 	return neighborsTwoHops(ctx, eg.gqlClient, v, edgesToPredicates, edgesFromPredicates)
 }
 
-func (eg byName) getDirectDependencies(ctx context.Context, v node) ([]node, error) {
-	edgesToPredicates := []gql.Edge{
-		gql.EdgePackageHasSbom,
-		gql.EdgeArtifactHasSbom,
-		gql.EdgeArtifactHasSlsa,
-	}
-	edgesFromPredicates := []gql.Edge{
-		gql.EdgeHasSbomIncludedSoftware,
-		gql.EdgeHasSlsaMaterials,
-	}
-	return neighborsTwoHops(ctx, eg.gqlClient, v, edgesToPredicates, edgesFromPredicates)
-}
-
-func (eg byName) getEquivalentNodes(ctx context.Context, v node) ([]node, error) {
-	edgesToPredicates := []gql.Edge{
-		gql.EdgePackageIsOccurrence,
-		gql.EdgeArtifactIsOccurrence,
-		gql.EdgeArtifactHashEqual,
-	}
-	edgesFromPredicates := []gql.Edge{
-		gql.EdgeIsOccurrenceArtifact,
-		gql.EdgeIsOccurrencePackage,
-		gql.EdgeHashEqualArtifact,
-	}
-	return neighborsTwoHops(ctx, eg.gqlClient, v, edgesToPredicates, edgesFromPredicates)
-}
-
 /********* Graphql helper functions *********/
 
 // neighborsTwoHops calls the GraphQL Neighbors endpoint once with edgesToPredicates, and
@@ -305,9 +256,16 @@ func mapPkgNodesToPurls(ctx context.Context, gqlClient graphql.Client,
 			pkgIds = append(pkgIds, node.GetId())
 		}
 	}
+	return mapIdsToPurls(ctx, gqlClient, pkgIds)
+}
+
+// Maps the IDs in the input to purls, ignoring the IDs of nodes that are not
+// package version nodes.
+func mapIdsToPurls(ctx context.Context, gqlClient graphql.Client, ids []string) ([]string, error) {
+	logger := logging.FromContext(ctx)
 
 	// Call Nodes to get the entire package trie for each node
-	gqlNodes, err := gql.Nodes(ctx, gqlClient, pkgIds)
+	gqlNodes, err := gql.Nodes(ctx, gqlClient, ids)
 	if err != nil {
 		logger.Errorf("Nodes query returned err: ", err)
 		return nil, helpers.Err502
@@ -316,7 +274,7 @@ func mapPkgNodesToPurls(ctx context.Context, gqlClient graphql.Client,
 		logger.Errorf("The Nodes query returned a nil result.")
 		return nil, helpers.Err500
 	}
-	if len(gqlNodes.GetNodes()) != len(pkgIds) {
+	if len(gqlNodes.GetNodes()) != len(ids) {
 		logger.Warnf("GQL query \"nodes\" did not return the expected number of results")
 	}
 
@@ -326,13 +284,27 @@ func mapPkgNodesToPurls(ctx context.Context, gqlClient graphql.Client,
 		if v, ok := gqlNode.(*gql.NodesNodesPackage); ok {
 			purl := assembler_helpers.AllPkgTreeToPurl(&v.AllPkgTree)
 			purls = append(purls, purl)
-		} else {
-			logger.Warnf("Nodes query returned an unexpected type: %T", *gqlNode.GetTypename())
 		}
 	}
 	return purls, nil
 }
 
+// Returns the purls of the transitive dependencies of the start node, linked by
+// name or by digest, from the dependency closure of the GraphQL server.
+func getDependencyClosurePurls(ctx context.Context, gqlClient graphql.Client, start node) ([]string, error) {
+	logger := logging.FromContext(ctx)
+	closure, err := dependencies.DependencyClosure(ctx, gqlClient, start.GetId(), nil)
+	if err != nil {
+		logger.Errorf("dependency closure returned err: %v", err)
+		return nil, helpers.Err502
+	}
+	ids := make([]string, 0, len(closure))
+	for _, entry := range closure {
+		ids = append(ids, entry.ID)
+	}
+	return mapIdsToPurls(ctx, gqlClient, ids)
+}
+
 /********* The endpoint handler *********/
 func (s *DefaultServer) RetrieveDependencies(
 	ctx context.Context,
@@ -359,26 +331,24 @@ func (s *DefaultServer) RetrieveDependencies(
 			}}, nil
 	}
 
-	// Select the edgeGen. The default is byDigest
-	var edgeGenerator edgeGen
+	// Links by name follow the dependency closure of the server, which also
+	// covers the links by digest. The default is byDigest, which keeps walking
+	// node by node since the closure would also follow the IsDependency links
+	// by name that linking by digest leaves out.
+	var purls []string
+	var err error
 	cond := request.Params.LinkCondition
-	if cond == nil {
-		edgeGenerator = newByDigest(s.gqlClient)
-	} else if *cond == gen.Name {
-		edgeGenerator = newByName(s.gqlClient)
-	} else if *cond == gen.Digest {
-		edgeGenerator = newByDigest(s.gqlClient)
+	if cond != nil && *cond == gen.Name {
+		purls, err = getDependencyClosurePurls(ctx, s.gqlClient, start)
+	} else if cond == nil || *cond == gen.Digest {
+		var deps []node
+		deps, err = getTransitiveDependencies(ctx, s.gqlClient, start, newByDigest(s.gqlClient))
+		if err == nil {
+			purls, err = mapPkgNodesToPurls(ctx, s.gqlClient, deps)
+		}
 	} else {
-		err := fmt.Errorf("Unrecognized linkCondition: %s", *request.Params.LinkCondition)
-		return handleErr(ctx, err), nil
-	}
-
-	// Compute the result and map to purls
-	deps, err := getTransitiveDependencies(ctx, s.gqlClient, start, edgeGenerator)
-	if err != nil {
-		return handleErr(ctx, err), nil
+		err = fmt.Errorf("Unrecognized linkCondition: %s", *request.Params.LinkCondition)
 	}
-	purls, err := mapPkgNodesToPurls(ctx, s.gqlClient, deps)
 	if err != nil {
 		return handleErr(ctx, err), nil
 	}
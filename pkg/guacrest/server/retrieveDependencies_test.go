@@ -76,6 +76,19 @@ func Test_RetrieveDependencies(t *testing.T) {
 			expectedByName:   []string{"pkg:guac/bar", "pkg:guac/baz"},
 			expectedByDigest: []string{},
 		},
+		{
+			name: "Package -> IsDependency -> package -> IsDependency -> package",
+			data: GuacData{
+				Packages: []string{"pkg:guac/foo", "pkg:guac/bar", "pkg:guac/baz"},
+				IsDependencies: []IsDependency{
+					{DependentPkg: "pkg:guac/foo", DependencyPkg: "pkg:guac/bar"},
+					{DependentPkg: "pkg:guac/bar", DependencyPkg: "pkg:guac/baz"},
+				},
+			},
+			input:            api.RetrieveDependenciesParams{Purl: ptrfrom.String("pkg:guac/foo")},
+			expectedByName:   []string{"pkg:guac/bar", "pkg:guac/baz"},
+			expectedByDigest: []string{},
+		},
 		{
 			name: "Artifact -> SBOM -> package",
 			data: GuacData{
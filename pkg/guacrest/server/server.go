@@ -25,17 +25,25 @@ import (
 	"github.com/guacsec/guac/pkg/dependencies"
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
 	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
 )
 
 // DefaultServer implements the API, backed by the GraphQL Server
 type DefaultServer struct {
 	gqlClient graphql.Client
+	// policies evaluated by the policy endpoint, the built-in ones if nil
+	policies []*policy.Policy
 }
 
 func NewDefaultServer(gqlClient graphql.Client) *DefaultServer {
 	return &DefaultServer{gqlClient: gqlClient}
 }
 
+// SetPolicies replaces the built-in policies evaluated by the policy endpoint.
+func (s *DefaultServer) SetPolicies(policies []*policy.Policy) {
+	s.policies = policies
+}
+
 // Adds the logger to the http request context
 func AddLoggerToCtxMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
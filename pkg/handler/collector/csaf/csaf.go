@@ -0,0 +1,299 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package csaf collects the CSAF documents of a local copy of a CSAF 2.0
+// provider: a directory holding its provider-metadata.json and the documents
+// of its distributions, as ROLIE feeds or as directories listed by an
+// index.txt.
+package csaf
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	CollectorCSAF = "CSAFCollector"
+
+	providerMetadataFile = "provider-metadata.json"
+)
+
+// providerMetadata is the part of the provider-metadata.json of a CSAF
+// provider locating its documents.
+type providerMetadata struct {
+	CanonicalURL  string `json:"canonical_url"`
+	Distributions []struct {
+		DirectoryURL string `json:"directory_url"`
+		Rolie        *struct {
+			Feeds []struct {
+				URL string `json:"url"`
+			} `json:"feeds"`
+		} `json:"rolie"`
+	} `json:"distributions"`
+}
+
+// rolieFeed is the part of a ROLIE feed locating its CSAF documents.
+type rolieFeed struct {
+	Feed struct {
+		Entry []struct {
+			ID      string `json:"id"`
+			Updated string `json:"updated"`
+			Content struct {
+				Src string `json:"src"`
+			} `json:"content"`
+		} `json:"entry"`
+	} `json:"feed"`
+}
+
+type csafCollector struct {
+	dir      string
+	poll     bool
+	interval time.Duration
+	// collected are the versions of the documents already collected by
+	// path: the update time of their feed entry, or else of their file.
+	collected map[string]string
+}
+
+type Opt func(*csafCollector)
+
+func WithPolling(interval time.Duration) Opt {
+	return func(c *csafCollector) {
+		c.poll = true
+		c.interval = interval
+	}
+}
+
+// NewCSAFCollector initializes the collector of the CSAF provider directory
+// dir, holding the provider-metadata.json of the provider.
+func NewCSAFCollector(dir string, opts ...Opt) (*csafCollector, error) {
+	if _, err := os.Stat(filepath.Join(dir, providerMetadataFile)); err != nil {
+		return nil, fmt.Errorf("unable to locate the %s of the provider directory %s: %w", providerMetadataFile, dir, err)
+	}
+	c := &csafCollector{
+		dir:       dir,
+		collected: map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// RetrieveArtifacts collects the CSAF documents of the distributions of the
+// provider. When polling, only the new or updated documents are collected
+// again.
+func (c *csafCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for {
+		if err := c.collectProvider(ctx, docChannel); err != nil {
+			return err
+		}
+		if !c.poll {
+			return nil
+		}
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(c.interval):
+		}
+	}
+}
+
+// Type is the collector type of the collector
+func (c *csafCollector) Type() string {
+	return CollectorCSAF
+}
+
+func (c *csafCollector) collectProvider(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	var pm providerMetadata
+	if err := readJSON(filepath.Join(c.dir, providerMetadataFile), &pm); err != nil {
+		return err
+	}
+	// the documents are located by the URLs they are published at, under
+	// the directory of the canonical URL of the provider metadata
+	base := ""
+	if pm.CanonicalURL != "" {
+		base = pm.CanonicalURL[:strings.LastIndex(pm.CanonicalURL, "/")+1]
+	}
+
+	for _, d := range pm.Distributions {
+		if d.Rolie != nil {
+			for _, f := range d.Rolie.Feeds {
+				feedPath, err := c.localPath(base, f.URL)
+				if err != nil {
+					logger.Warnf("[csaf] skipping feed %s: %v", f.URL, err)
+					continue
+				}
+				if err := c.collectFeed(ctx, base, feedPath, docChannel); err != nil {
+					return err
+				}
+			}
+		}
+		if d.DirectoryURL != "" {
+			dirPath, err := c.localPath(base, strings.TrimSuffix(d.DirectoryURL, "/")+"/")
+			if err != nil {
+				logger.Warnf("[csaf] skipping directory %s: %v", d.DirectoryURL, err)
+				continue
+			}
+			if err := c.collectDirectory(ctx, dirPath, docChannel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// collectFeed collects the documents of the entries of a ROLIE feed.
+func (c *csafCollector) collectFeed(ctx context.Context, base, feedPath string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	var feed rolieFeed
+	if err := readJSON(feedPath, &feed); err != nil {
+		return err
+	}
+	for _, e := range feed.Feed.Entry {
+		if e.Content.Src == "" {
+			continue
+		}
+		docPath, err := c.entryPath(base, feedPath, e.Content.Src)
+		if err != nil {
+			logger.Warnf("[csaf] skipping feed entry %s: %v", e.ID, err)
+			continue
+		}
+		if err := c.collectDocument(ctx, docPath, e.Updated, docChannel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectDirectory collects the documents of a directory distribution, as
+// listed by its index.txt.
+func (c *csafCollector) collectDirectory(ctx context.Context, dirPath string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	index, err := os.ReadFile(filepath.Join(dirPath, "index.txt"))
+	if err != nil {
+		return fmt.Errorf("unable to read the index of the directory %s: %w", dirPath, err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(index))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		// the listed paths are kept within the directory
+		docPath := filepath.Join(dirPath, filepath.FromSlash(path.Clean("/"+line)))
+		info, err := os.Stat(docPath)
+		if err != nil {
+			logger.Warnf("[csaf] skipping document %s: %v", line, err)
+			continue
+		}
+		if err := c.collectDocument(ctx, docPath, info.ModTime().UTC().Format(time.RFC3339Nano), docChannel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectDocument emits the document at docPath unless the version updated
+// was already collected.
+func (c *csafCollector) collectDocument(ctx context.Context, docPath, updated string, docChannel chan<- *processor.Document) error {
+	if v, ok := c.collected[docPath]; ok && v == updated {
+		return nil
+	}
+	blob, err := os.ReadFile(docPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		logging.FromContext(ctx).Warnf("[csaf] skipping missing document %s", docPath)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading file: %s, err: %w", docPath, err)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err() // nolint:wrapcheck
+	case docChannel <- &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentCsaf,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   CollectorCSAF,
+			Source:      fmt.Sprintf("file:///%s", docPath),
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}:
+	}
+	c.collected[docPath] = updated
+	return nil
+}
+
+// localPath returns the path in the provider directory of the URL u, either
+// under the directory base of the provider metadata or relative to it.
+func (c *csafCollector) localPath(base, u string) (string, error) {
+	rel, ok := strings.CutPrefix(u, base)
+	if base == "" || !ok {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return "", fmt.Errorf("unable to parse url: %w", err)
+		}
+		if parsed.IsAbs() {
+			return "", fmt.Errorf("url is not under %s", base)
+		}
+		rel = u
+	}
+	return filepath.Join(c.dir, filepath.FromSlash(path.Clean("/"+rel))), nil
+}
+
+// entryPath returns the path of the document of a feed entry, whose URL is
+// either absolute, located as the feed is, or relative to the feed.
+func (c *csafCollector) entryPath(base, feedPath, src string) (string, error) {
+	parsed, err := url.Parse(src)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse url: %w", err)
+	}
+	if parsed.IsAbs() {
+		return c.localPath(base, src)
+	}
+	return filepath.Join(filepath.Dir(feedPath), filepath.FromSlash(path.Clean("/"+src))), nil
+}
+
+func readJSON(p string, v any) error {
+	blob, err := os.ReadFile(p)
+	if err != nil {
+		return fmt.Errorf("error reading file: %s, err: %w", p, err)
+	}
+	if err := json.Unmarshal(blob, v); err != nil {
+		return fmt.Errorf("unable to decode %s: %w", p, err)
+	}
+	return nil
+}
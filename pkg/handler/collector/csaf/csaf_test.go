@@ -0,0 +1,140 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const providerMetadataJSON = `{
+  "canonical_url": "https://acme.example/.well-known/csaf/provider-metadata.json",
+  "distributions": [
+    {
+      "rolie": {
+        "feeds": [
+          {"tlp_label": "WHITE", "url": "https://acme.example/.well-known/csaf/white/acme-feed-tlp-white.json"},
+          {"tlp_label": "AMBER", "url": "https://elsewhere.example/feed-tlp-amber.json"}
+        ]
+      }
+    },
+    {"directory_url": "https://acme.example/.well-known/csaf/green/"}
+  ],
+  "role": "csaf_trusted_provider"
+}`
+
+func feedJSON(updated string) string {
+	return fmt.Sprintf(`{
+  "feed": {
+    "id": "acme-feed-tlp-white",
+    "entry": [
+      {"id": "ACME-SA-2026-001", "updated": %q, "content": {"type": "application/json", "src": "https://acme.example/.well-known/csaf/white/2026/acme-sa-2026-001.json"}},
+      {"id": "ACME-SA-2026-002", "updated": "2026-02-01T00:00:00Z", "content": {"type": "application/json", "src": "2026/acme-sa-2026-002.json"}}
+    ]
+  }
+}`, updated)
+}
+
+// writeProvider writes a provider directory with a ROLIE feed of two
+// documents and a directory distribution of one, whose index also lists a
+// missing document and one outside of the directory.
+func writeProvider(t *testing.T) string {
+	dir := t.TempDir()
+	files := map[string]string{
+		"provider-metadata.json":               providerMetadataJSON,
+		"white/acme-feed-tlp-white.json":       feedJSON("2026-01-01T00:00:00Z"),
+		"white/2026/acme-sa-2026-001.json":     `{"document": {"tracking": {"id": "ACME-SA-2026-001"}}}`,
+		"white/2026/acme-sa-2026-002.json":     `{"document": {"tracking": {"id": "ACME-SA-2026-002"}}}`,
+		"green/index.txt":                      "2026/acme-sa-2026-003.json\n2026/acme-sa-2026-005.json\n../white/2026/acme-sa-2026-002.json\n",
+		"green/2026/acme-sa-2026-003.json":     `{"document": {"tracking": {"id": "ACME-SA-2026-003"}}}`,
+		"green/2026/acme-sa-2026-004.json":     `{"document": {"tracking": {"id": "ACME-SA-2026-004"}}}`,
+		"elsewhere/feed-tlp-amber.json":        feedJSON("2026-01-01T00:00:00Z"),
+		"white/2026/acme-sa-2026-001.json.asc": "signature",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// collect returns the sources of the documents collected in one pass.
+func collect(t *testing.T, c *csafCollector) []string {
+	ctx := logging.WithLogger(context.Background())
+	docChannel := make(chan *processor.Document, 10)
+	if err := c.RetrieveArtifacts(ctx, docChannel); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChannel)
+	var sources []string
+	for d := range docChannel {
+		if d.Type != processor.DocumentCsaf || d.Format != processor.FormatJSON || d.SourceInformation.Collector != CollectorCSAF {
+			t.Errorf("unexpected document %+v", d)
+		}
+		sources = append(sources, d.SourceInformation.Source)
+	}
+	return sources
+}
+
+func TestCSAFCollector(t *testing.T) {
+	dir := writeProvider(t)
+	c, err := NewCSAFCollector(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := func(name string) string {
+		return fmt.Sprintf("file:///%s", filepath.Join(dir, filepath.FromSlash(name)))
+	}
+
+	want := []string{
+		source("white/2026/acme-sa-2026-001.json"),
+		source("white/2026/acme-sa-2026-002.json"),
+		source("green/2026/acme-sa-2026-003.json"),
+	}
+	if d := cmp.Diff(want, collect(t, c)); d != "" {
+		t.Errorf("collected documents mismatch (-want +got):\n%s", d)
+	}
+
+	// only the updated entries are collected again
+	if got := collect(t, c); len(got) != 0 {
+		t.Errorf("collected unchanged documents %v", got)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "white", "acme-feed-tlp-white.json"), []byte(feedJSON("2026-03-01T00:00:00Z")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	want = []string{source("white/2026/acme-sa-2026-001.json")}
+	if d := cmp.Diff(want, collect(t, c)); d != "" {
+		t.Errorf("collected updated documents mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestNewCSAFCollector(t *testing.T) {
+	if _, err := NewCSAFCollector(t.TempDir()); err == nil {
+		t.Error("NewCSAFCollector() expected an error without provider metadata")
+	}
+}
@@ -127,11 +127,13 @@ func (d *depsCollector) populatePurls(ctx context.Context, docChannel chan<- *pr
 		d.dc.RetrieveVersionsAndProjects(ctx, purlStrings)
 		for _, purl := range purlStrings {
 			if d.checkedPurls[purl] {
+				datasource.Complete(ctx, d.collectDataSource, []datasource.Source{{Value: purl}}, nil)
 				continue
 			}
 			d.checkedPurls[purl] = true
 
 			components, err := d.dc.GetMetadata(ctx, purlStrings)
+			datasource.Complete(ctx, d.collectDataSource, []datasource.Source{{Value: purl}}, err)
 			if err != nil {
 				logger.Errorf("Error collecting depsdev metadata: %s", err)
 				return err
@@ -142,15 +144,18 @@ func (d *depsCollector) populatePurls(ctx context.Context, docChannel chan<- *pr
 	}
 
 	if err := d.dc.RetrieveDependencies(ctx, purlStrings); err != nil {
+		datasource.Complete(ctx, d.collectDataSource, ds.PurlDataSources, err)
 		return fmt.Errorf("failed to get all dependencies: %w", err)
 	}
 	for _, purl := range purlStrings {
 		if d.checkedPurls[purl] {
+			datasource.Complete(ctx, d.collectDataSource, []datasource.Source{{Value: purl}}, nil)
 			continue
 		}
 		d.checkedPurls[purl] = true
 
 		components, err := d.dc.GetDependencies(ctx, []string{purl})
+		datasource.Complete(ctx, d.collectDataSource, []datasource.Source{{Value: purl}}, err)
 		if err != nil {
 			return fmt.Errorf("failed to fetch dependencies: %w", err)
 		}
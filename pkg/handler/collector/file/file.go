@@ -36,14 +36,42 @@ type fileCollector struct {
 	lastChecked time.Time
 	poll        bool
 	interval    time.Duration
+	watch       bool
+	debounce    time.Duration
+	checkpoint  string
 }
 
-func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration) *fileCollector {
-	return &fileCollector{
+type Opt func(*fileCollector)
+
+// WithWatch watches the path for new or changed documents instead of scanning
+// it once or polling it. A file is only collected once it has not been written
+// to for the debounce duration.
+func WithWatch(debounce time.Duration) Opt {
+	return func(f *fileCollector) {
+		f.watch = true
+		f.debounce = debounce
+	}
+}
+
+// WithCheckpoint persists the files collected while watching to the
+// checkpoint file, so that a restarted collector only collects the files
+// written since.
+func WithCheckpoint(checkpoint string) Opt {
+	return func(f *fileCollector) {
+		f.checkpoint = checkpoint
+	}
+}
+
+func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration, opts ...Opt) *fileCollector {
+	f := &fileCollector{
 		path:     path,
 		poll:     poll,
 		interval: interval,
 	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
 }
 
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
@@ -59,6 +87,9 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		}
 		return fmt.Errorf("unknown error on os.Stat for FileCollector path: %w", err)
 	}
+	if f.watch {
+		return f.watchArtifacts(ctx, docChannel)
+	}
 
 	readFunc := func(path string, dirEntry fs.DirEntry, err error) error {
 		// If the context has been canceled it contains an err which we can throw.
@@ -88,18 +119,7 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 			return fmt.Errorf("error reading file: %s, err: %w", path, err)
 		}
 
-		doc := &processor.Document{
-			Blob:   blob,
-			Type:   processor.DocumentUnknown,
-			Format: processor.FormatUnknown,
-			SourceInformation: processor.SourceInformation{
-				Collector:   string(FileCollector),
-				Source:      fmt.Sprintf("file:///%s", path),
-				DocumentRef: events.GetDocRef(blob),
-			},
-		}
-
-		docChannel <- doc
+		docChannel <- newDocument(path, blob)
 
 		return nil
 	}
@@ -123,6 +143,19 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 	return nil
 }
 
+func newDocument(path string, blob []byte) *processor.Document {
+	return &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   string(FileCollector),
+			Source:      fmt.Sprintf("file:///%s", path),
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+}
+
 // Type returns the collector type
 func (f *fileCollector) Type() string {
 	return FileCollector
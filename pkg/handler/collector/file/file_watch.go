@@ -0,0 +1,328 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// minWatchTick bounds how often the pending files are checked.
+const minWatchTick = 10 * time.Millisecond
+
+// watchCheckpoint is persisted to the checkpoint file.
+type watchCheckpoint struct {
+	// Files are the files already collected.
+	Files map[string]fileState `json:"files"`
+	// Documents are the references of the documents already collected. A
+	// document is only collected once, so that renaming a file, or writing it
+	// to a temporary file first, does not collect it again.
+	Documents map[string]bool `json:"documents"`
+}
+
+type fileState struct {
+	ModTime time.Time `json:"modTime"`
+	Size    int64     `json:"size"`
+}
+
+func (s fileState) equal(o fileState) bool {
+	return s.ModTime.Equal(o.ModTime) && s.Size == o.Size
+}
+
+type fileWatcher struct {
+	f          *fileCollector
+	watcher    *fsnotify.Watcher
+	checkpoint string
+	state      watchCheckpoint
+	// pending are the files written to, by the time they were last written.
+	pending map[string]time.Time
+	dirs    map[string]bool
+	dirty   bool
+}
+
+// watchArtifacts collects the files already in the path that were not
+// collected before, then the files written to the path, until the context is
+// canceled.
+func (f *fileCollector) watchArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	w := &fileWatcher{
+		f:       f,
+		pending: map[string]time.Time{},
+		dirs:    map[string]bool{},
+	}
+	if f.checkpoint != "" {
+		checkpoint, err := filepath.Abs(f.checkpoint)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint file: %s, err: %w", f.checkpoint, err)
+		}
+		w.checkpoint = checkpoint
+	}
+	if err := w.load(); err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("unable to create watcher: %w", err)
+	}
+	defer watcher.Close()
+	w.watcher = watcher
+
+	// the directories are watched before being walked, so that no file
+	// written in between is missed
+	if err := w.addTree(f.path); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(max(f.debounce/2, minWatchTick))
+	defer ticker.Stop()
+	for {
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			if err := w.save(); err != nil {
+				return err
+			}
+			return ctx.Err() // nolint:wrapcheck
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if err := w.handle(ctx, event); err != nil {
+				return err
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return fmt.Errorf("error watching path: %s, err: %w", f.path, err)
+		case <-ticker.C:
+			if err := w.flush(ctx, docChannel); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (w *fileWatcher) handle(ctx context.Context, event fsnotify.Event) error {
+	if w.ignored(event.Name) {
+		return nil
+	}
+	switch {
+	case event.Has(fsnotify.Create):
+		info, err := os.Lstat(event.Name)
+		if err != nil {
+			// already removed or renamed again
+			return nil
+		}
+		if info.IsDir() {
+			// a directory renamed in or created with files in it before
+			// being watched
+			return w.addTree(event.Name)
+		}
+		w.pending[event.Name] = time.Now()
+	case event.Has(fsnotify.Write):
+		w.pending[event.Name] = time.Now()
+	case event.Has(fsnotify.Remove), event.Has(fsnotify.Rename):
+		// the new name of a renamed file gets its own create event
+		w.forget(ctx, event.Name)
+	}
+	return nil
+}
+
+// addTree watches the directories under root and marks their files as pending.
+func (w *fileWatcher) addTree(root string) error {
+	err := filepath.WalkDir(root, func(path string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("path: %s is invalid", path)
+		}
+		if dirEntry.IsDir() {
+			if err := w.watcher.Add(path); err != nil {
+				return fmt.Errorf("unable to watch directory: %s, err: %w", path, err)
+			}
+			w.dirs[path] = true
+			return nil
+		}
+		if !w.ignored(path) {
+			w.pending[path] = time.Time{}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error walking path: %s, err: %w", root, err)
+	}
+	return nil
+}
+
+// forget drops a removed or renamed file, or directory and the files under it.
+func (w *fileWatcher) forget(ctx context.Context, path string) {
+	if w.dirs[path] {
+		// fsnotify keeps watching a renamed directory under its old name
+		if err := w.watcher.Remove(path); err != nil && !errors.Is(err, fsnotify.ErrNonExistentWatch) {
+			logging.FromContext(ctx).Warnf("unable to stop watching directory: %s, err: %v", path, err)
+		}
+	}
+	under := func(p string) bool {
+		return p == path || strings.HasPrefix(p, path+string(filepath.Separator))
+	}
+	for dir := range w.dirs {
+		if under(dir) {
+			delete(w.dirs, dir)
+		}
+	}
+	for p := range w.pending {
+		if under(p) {
+			delete(w.pending, p)
+		}
+	}
+	for p := range w.state.Files {
+		if under(p) {
+			delete(w.state.Files, p)
+			w.dirty = true
+		}
+	}
+}
+
+// flush collects the pending files that were not written to for the
+// debounce duration and saves the checkpoint.
+func (w *fileWatcher) flush(ctx context.Context, docChannel chan<- *processor.Document) error {
+	now := time.Now()
+	var ready []string
+	for path, written := range w.pending {
+		if now.Sub(written) >= w.f.debounce {
+			ready = append(ready, path)
+		}
+	}
+	slices.Sort(ready)
+	for _, path := range ready {
+		delete(w.pending, path)
+		if err := w.collect(ctx, path, docChannel); err != nil {
+			return err
+		}
+	}
+	return w.save()
+}
+
+func (w *fileWatcher) collect(ctx context.Context, path string, docChannel chan<- *processor.Document) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unknown error on os.Stat while watching path: %w", err)
+	}
+	if info.IsDir() {
+		return nil
+	}
+	if time.Since(info.ModTime()) < w.f.debounce {
+		// still written to, the events may have been coalesced
+		w.pending[path] = info.ModTime()
+		return nil
+	}
+	state := fileState{ModTime: info.ModTime(), Size: info.Size()}
+	if collected, ok := w.state.Files[path]; ok && collected.equal(state) {
+		return nil
+	}
+
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %s, err: %w", path, err)
+	}
+	w.state.Files[path] = state
+	w.dirty = true
+	ref := events.GetDocRef(blob)
+	if w.state.Documents[ref] {
+		return nil
+	}
+
+	select {
+	case docChannel <- newDocument(path, blob):
+	case <-ctx.Done():
+		return ctx.Err() // nolint:wrapcheck
+	}
+	w.state.Documents[ref] = true
+	return nil
+}
+
+// ignored reports whether path is the checkpoint file, which may be in the
+// watched path.
+func (w *fileWatcher) ignored(path string) bool {
+	if w.checkpoint == "" {
+		return false
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	return abs == w.checkpoint || abs == w.checkpoint+".tmp"
+}
+
+func (w *fileWatcher) load() error {
+	w.state = watchCheckpoint{Files: map[string]fileState{}, Documents: map[string]bool{}}
+	if w.checkpoint == "" {
+		return nil
+	}
+	b, err := os.ReadFile(w.checkpoint)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to read checkpoint file: %w", err)
+	}
+	if err := json.Unmarshal(b, &w.state); err != nil {
+		return fmt.Errorf("unable to parse checkpoint file: %w", err)
+	}
+	if w.state.Files == nil {
+		w.state.Files = map[string]fileState{}
+	}
+	if w.state.Documents == nil {
+		w.state.Documents = map[string]bool{}
+	}
+	return nil
+}
+
+// save writes the checkpoint file, through a temporary file so that it is
+// never left partially written.
+func (w *fileWatcher) save() error {
+	if w.checkpoint == "" || !w.dirty {
+		return nil
+	}
+	b, err := json.Marshal(w.state)
+	if err != nil {
+		return fmt.Errorf("unable to marshal checkpoint: %w", err)
+	}
+	tmp := w.checkpoint + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return fmt.Errorf("unable to write checkpoint file: %w", err)
+	}
+	if err := os.Rename(tmp, w.checkpoint); err != nil {
+		return fmt.Errorf("unable to write checkpoint file: %w", err)
+	}
+	w.dirty = false
+	return nil
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const testDebounce = 100 * time.Millisecond
+
+// watchTest runs a watching file collector until stopped.
+type watchTest struct {
+	t       *testing.T
+	docs    chan *processor.Document
+	cancel  context.CancelFunc
+	errChan chan error
+}
+
+func startWatch(t *testing.T, dir, checkpoint string) *watchTest {
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	w := &watchTest{t: t, docs: make(chan *processor.Document, 10), cancel: cancel, errChan: make(chan error, 1)}
+	f := NewFileCollector(ctx, dir, false, 0, WithWatch(testDebounce), WithCheckpoint(checkpoint))
+	go func() {
+		w.errChan <- f.RetrieveArtifacts(ctx, w.docs)
+	}()
+	t.Cleanup(cancel)
+	return w
+}
+
+func (w *watchTest) stop() {
+	w.cancel()
+	if err := <-w.errChan; !errors.Is(err, context.Canceled) {
+		w.t.Fatalf("RetrieveArtifacts() = %v, want %v", err, context.Canceled)
+	}
+}
+
+// expect waits for the document of the file at path.
+func (w *watchTest) expect(path, contents string) {
+	w.t.Helper()
+	select {
+	case d := <-w.docs:
+		if d.SourceInformation.Source != "file:///"+path || string(d.Blob) != contents {
+			w.t.Errorf("collected %s with %q, want %s with %q", d.SourceInformation.Source, d.Blob, "file:///"+path, contents)
+		}
+	case err := <-w.errChan:
+		w.t.Fatalf("RetrieveArtifacts() = %v", err)
+	case <-time.After(5 * time.Second):
+		w.t.Fatalf("%s was not collected", path)
+	}
+}
+
+// expectNone checks that no document is collected for a while.
+func (w *watchTest) expectNone() {
+	w.t.Helper()
+	select {
+	case d := <-w.docs:
+		w.t.Errorf("collected %s with %q, want none", d.SourceInformation.Source, d.Blob)
+	case <-time.After(5 * testDebounce):
+	}
+}
+
+func writeFile(t *testing.T, path, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_fileCollector_Watch(t *testing.T) {
+	dir := t.TempDir()
+	checkpoint := filepath.Join(dir, ".checkpoint")
+	existing := filepath.Join(dir, "existing.json")
+	writeFile(t, existing, "existing")
+
+	w := startWatch(t, dir, checkpoint)
+	w.expect(existing, "existing")
+
+	// a file in a new directory
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	sbom := filepath.Join(sub, "sbom.json")
+	writeFile(t, sbom, "sbom")
+	w.expect(sbom, "sbom")
+
+	// a file written in several times is collected once complete
+	partial := filepath.Join(dir, "partial.json")
+	f, err := os.Create(partial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"par", "ti", "al"} {
+		if _, err := f.WriteString(s); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(testDebounce / 4)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	w.expect(partial, "partial")
+
+	// renaming a file or a directory does not collect its documents again
+	if err := os.Rename(sbom, filepath.Join(sub, "renamed.json")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(sub, filepath.Join(dir, "moved")); err != nil {
+		t.Fatal(err)
+	}
+	w.expectNone()
+
+	// but changing it does
+	moved := filepath.Join(dir, "moved", "renamed.json")
+	writeFile(t, moved, "sbom v2")
+	w.expect(moved, "sbom v2")
+
+	// a file written through a temporary file is collected under its name
+	vex := filepath.Join(dir, "vex.json")
+	writeFile(t, vex+".tmp", "vex")
+	if err := os.Rename(vex+".tmp", vex); err != nil {
+		t.Fatal(err)
+	}
+	w.expect(vex, "vex")
+	w.stop()
+
+	// a restarted collector only collects the files written since
+	writeFile(t, filepath.Join(dir, "moved", "renamed.json"), "sbom v3")
+	w = startWatch(t, dir, checkpoint)
+	w.expect(moved, "sbom v3")
+	w.expectNone()
+	w.stop()
+}
@@ -17,13 +17,18 @@ package git_collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5"
-	"github.com/guacsec/guac/pkg/handler/collector"
-	"github.com/guacsec/guac/pkg/handler/collector/file"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"go.uber.org/zap"
@@ -31,33 +36,62 @@ import (
 
 const (
 	CollectorGitDocument = "GitCollector"
+
+	// collectedRef is the reference, in the local clone, to the last commit
+	// whose documents were emitted.
+	collectedRef = plumbing.ReferenceName("refs/guac/collected")
 )
 
 // gitDocumentCollector collects documents from a Git repository (GitHub, GitLab, etc.)
 // The collector clones the repository to a local directory or pulls any updates from the repository if it has been cloned previously.
-// It emits each collected document to the collector to be processed.
+// It emits the committed files that match its globs, and remembers the last commit it collected so that later
+// runs only emit the files changed since. With history, it emits the files changed by every commit in turn, oldest
+// first, so that documents such as VEX statements are ingested in the order they were committed.
 // The collector can either run once and grab all the artifacts or keep running and check for new artifacts based on the polling rate.
 type gitDocumentCollector struct {
-	url           string
-	dir           string
-	lastChecked   time.Time
-	poll          bool
-	interval      time.Duration
-	fileCollector collector.Collector
+	url      string
+	dir      string
+	globs    []string
+	history  bool
+	poll     bool
+	interval time.Duration
+	// after waits for the polling interval, it is replaced by tests
+	after func(time.Duration) <-chan time.Time
 }
 
-func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration) *gitDocumentCollector {
-	fileCollector := file.NewFileCollector(ctx, dir, false, time.Second)
+type Opt func(*gitDocumentCollector)
 
-	return &gitDocumentCollector{
-		url:           url,
-		dir:           dir,
-		poll:          poll,
-		interval:      interval,
-		fileCollector: fileCollector,
+// WithGlobs only collects the files matching one of the globs. A glob without
+// a slash matches the file name, otherwise it matches the path from the root
+// of the repository. Every file is collected by default.
+func WithGlobs(globs []string) Opt {
+	return func(g *gitDocumentCollector) {
+		g.globs = globs
 	}
 }
 
+// WithHistory replays the commits not collected yet one by one instead of
+// only collecting the latest version of the files.
+func WithHistory(history bool) Opt {
+	return func(g *gitDocumentCollector) {
+		g.history = history
+	}
+}
+
+func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration, opts ...Opt) *gitDocumentCollector {
+	g := &gitDocumentCollector{
+		url:      url,
+		dir:      dir,
+		poll:     poll,
+		interval: interval,
+		after:    time.After,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
 // document through the channel to be collected and processed by the upstream processor.
 // The function should block until all the artifacts are collected and return a nil error
@@ -73,12 +107,11 @@ func (g *gitDocumentCollector) RetrieveArtifacts(ctx context.Context, docChannel
 			if err != nil {
 				return fmt.Errorf("error creating or pulling git repo: %w", err)
 			}
-			g.lastChecked = time.Now()
 			select {
 			// If the context has been canceled it contains an err which we can throw.
 			case <-ctx.Done():
 				return ctx.Err() // nolint:wrapcheck
-			case <-time.After(g.interval):
+			case <-g.after(g.interval):
 			}
 		}
 	} else {
@@ -86,7 +119,6 @@ func (g *gitDocumentCollector) RetrieveArtifacts(ctx context.Context, docChannel
 		if err != nil {
 			return fmt.Errorf("error creating or pulling git repo: %w", err)
 		}
-		g.lastChecked = time.Now()
 	}
 
 	return nil
@@ -106,24 +138,183 @@ func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.Sug
 		if err != nil {
 			return fmt.Errorf("error cloning repo: %w", err)
 		}
-		err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-		if err != nil {
-			return fmt.Errorf("error retrieving artifacts: %w", err)
-		}
 	} else {
 		err := pullRepo(logger, g.dir)
 		if err != nil && err != git.NoErrAlreadyUpToDate {
 			return fmt.Errorf("error pulling repo: %w", err)
-		} else if err == nil {
-			err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-			if err != nil {
-				return fmt.Errorf("error retrieving artifacts: %w", err)
+		}
+	}
+	return g.collectCommits(ctx, logger, docChannel)
+}
+
+// collectCommits emits the files changed between the last collected commit
+// and HEAD, or every file of HEAD on the first run, and records HEAD as
+// collected.
+func (g *gitDocumentCollector) collectCommits(ctx context.Context, logger *zap.SugaredLogger, docChannel chan<- *processor.Document) error {
+	r, err := git.PlainOpen(g.dir)
+	if err != nil {
+		return fmt.Errorf("error opening repo: %w", err)
+	}
+	headRef, err := r.Head()
+	if err != nil {
+		return fmt.Errorf("error retrieving HEAD: %w", err)
+	}
+	head, err := r.CommitObject(headRef.Hash())
+	if err != nil {
+		return fmt.Errorf("error retrieving commit object: %w", err)
+	}
+
+	var last *object.Commit
+	lastRef, err := r.Reference(collectedRef, true)
+	switch {
+	case errors.Is(err, plumbing.ErrReferenceNotFound):
+	case err != nil:
+		return fmt.Errorf("error retrieving last collected commit: %w", err)
+	default:
+		// the last collected commit is gone if the history was rewritten, in
+		// which case everything is collected again
+		if last, err = r.CommitObject(lastRef.Hash()); err != nil && !errors.Is(err, plumbing.ErrObjectNotFound) {
+			return fmt.Errorf("error retrieving last collected commit: %w", err)
+		}
+	}
+	if last != nil && last.Hash == head.Hash {
+		logger.Debugf("no new commits in %s since %s", g.url, last.Hash)
+		return nil
+	}
+
+	if g.history {
+		commits, err := commitsSince(head, last)
+		if err != nil {
+			return err
+		}
+		if last != nil && (len(commits) == 0 || !hasParent(commits[0], last)) {
+			// the last collected commit is not on the first-parent chain of
+			// HEAD, for instance after merging it from a side branch: replaying
+			// the chain would emit the whole history again, so only the
+			// changes since its tree are emitted
+			logger.Debugf("%s is not a first parent ancestor of %s, collecting the changes since its tree", last.Hash, head.Hash)
+			commits = nil
+			if err := g.collectChanges(ctx, last, head, docChannel); err != nil {
+				return err
+			}
+		}
+		for _, c := range commits {
+			var parent *object.Commit
+			if c.NumParents() > 0 {
+				if parent, err = c.Parent(0); err != nil {
+					return fmt.Errorf("error retrieving parent of %s: %w", c.Hash, err)
+				}
+			}
+			if err := g.collectChanges(ctx, parent, c, docChannel); err != nil {
+				return err
 			}
 		}
+	} else if err := g.collectChanges(ctx, last, head, docChannel); err != nil {
+		return err
+	}
+
+	if err := r.Storer.SetReference(plumbing.NewHashReference(collectedRef, head.Hash)); err != nil {
+		return fmt.Errorf("error recording last collected commit: %w", err)
+	}
+	logger.Debugf("collected %s up to %s", g.url, head.Hash)
+	return nil
+}
+
+// commitsSince returns the commits following the first parents from last,
+// excluded, to head, oldest first. A nil last, or a last that is not on the
+// first-parent chain of head, returns every commit up to the root.
+func commitsSince(head, last *object.Commit) ([]*object.Commit, error) {
+	var commits []*object.Commit
+	for c := head; last == nil || c.Hash != last.Hash; {
+		commits = append(commits, c)
+		if c.NumParents() == 0 {
+			break
+		}
+		parent, err := c.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving parent of %s: %w", c.Hash, err)
+		}
+		c = parent
+	}
+	slices.Reverse(commits)
+	return commits, nil
+}
+
+// hasParent reports whether parent is the first parent of c.
+func hasParent(c, parent *object.Commit) bool {
+	return len(c.ParentHashes) > 0 && c.ParentHashes[0] == parent.Hash
+}
+
+// collectChanges emits the files matching the globs that were added or
+// modified from one commit to the next. A nil from emits every file of to.
+func (g *gitDocumentCollector) collectChanges(ctx context.Context, from, to *object.Commit, docChannel chan<- *processor.Document) error {
+	var fromTree *object.Tree
+	if from != nil {
+		var err error
+		if fromTree, err = from.Tree(); err != nil {
+			return fmt.Errorf("error retrieving tree of %s: %w", from.Hash, err)
+		}
+	}
+	toTree, err := to.Tree()
+	if err != nil {
+		return fmt.Errorf("error retrieving tree of %s: %w", to.Hash, err)
+	}
+	changes, err := object.DiffTreeContext(ctx, fromTree, toTree)
+	if err != nil {
+		return fmt.Errorf("error comparing %s to its parent: %w", to.Hash, err)
+	}
+	for _, change := range changes {
+		// deleted files have no destination
+		name := change.To.Name
+		if name == "" || !g.matches(name) {
+			continue
+		}
+		file, err := toTree.File(name)
+		if err != nil {
+			return fmt.Errorf("error retrieving %s at %s: %w", name, to.Hash, err)
+		}
+		contents, err := file.Contents()
+		if err != nil {
+			return fmt.Errorf("error reading %s at %s: %w", name, to.Hash, err)
+		}
+		blob := []byte(contents)
+		doc := &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentUnknown,
+			Format: processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{
+				Collector:   CollectorGitDocument,
+				Source:      fmt.Sprintf("%s@%s#%s", g.url, to.Hash, name),
+				DocumentRef: events.GetDocRef(blob),
+			},
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		}
 	}
 	return nil
 }
 
+// matches reports whether the file at name, relative to the root of the
+// repository, matches one of the globs.
+func (g *gitDocumentCollector) matches(name string) bool {
+	if len(g.globs) == 0 {
+		return true
+	}
+	for _, glob := range g.globs {
+		subject := name
+		if !strings.Contains(glob, "/") {
+			subject = path.Base(name)
+		}
+		if ok, _ := path.Match(glob, subject); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // Type returns the collector type
 func (g *gitDocumentCollector) Type() string {
 	return CollectorGitDocument
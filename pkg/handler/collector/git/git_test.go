@@ -19,115 +19,258 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
-	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
-func Test_gitCol_RetrieveArtifacts(t *testing.T) {
-	type fields struct {
-		url      string
-		dir      string
-		poll     bool
-		interval time.Duration
-	}
-	tests := []struct {
-		name                   string
-		fields                 fields
-		preCreateDir           bool
-		wantErr                bool
-		numberOfFilesCollected int
-	}{{
-		name: "get repo",
-		fields: fields{
-			url:      "https://github.com/guacsec/git-collector-test",
-			dir:      os.TempDir() + "/guac-data-test",
-			poll:     false,
-			interval: time.Millisecond,
-		},
-		preCreateDir:           false,
-		wantErr:                false,
-		numberOfFilesCollected: 9,
-	}, {
-		name: "if repo exist",
-		fields: fields{
-			url:      "https://github.com/guacsec/git-collector-test",
-			dir:      os.TempDir() + "/guac-data-test",
-			poll:     false,
-			interval: time.Millisecond,
-		},
-		preCreateDir:           true,
-		wantErr:                false,
-		numberOfFilesCollected: 0,
-	}, {
-		name: "get repo poll",
-		fields: fields{
-			url:      "https://github.com/guacsec/git-collector-test",
-			dir:      os.TempDir() + "/guac-data-test",
-			poll:     true,
-			interval: time.Millisecond,
-		},
-		preCreateDir:           false,
-		wantErr:                false,
-		numberOfFilesCollected: 9,
-	}}
-	for _, tt := range tests {
-		ctx := logging.WithLogger(context.Background())
-		logger := logging.FromContext(ctx)
-		t.Run(tt.name, func(t *testing.T) {
-			// in case the file exists from a failed run, delete it
-			os.RemoveAll(tt.fields.dir)
-			g := NewGitDocumentCollector(ctx, tt.fields.url, tt.fields.dir, tt.fields.poll, tt.fields.interval)
-
-			collector.DeregisterDocumentCollector(CollectorGitDocument)
-			if err := collector.RegisterDocumentCollector(g, CollectorGitDocument); err != nil &&
-				!errors.Is(err, collector.ErrCollectorOverwrite) {
-				t.Fatalf("could not register collector: %v", err)
-			}
+// upstream is a local bare repository, pushed to from a working copy.
+type upstream struct {
+	t    *testing.T
+	url  string
+	work *git.Repository
+	dir  string
+}
 
-			if tt.preCreateDir {
-				if err := os.Mkdir(tt.fields.dir, os.ModePerm); err != nil {
-					t.Fatal(err)
-				}
-				err := cloneRepoToDir(logger, tt.fields.url, tt.fields.dir)
-				if err != nil {
-					t.Fatal(err)
-				}
-			}
+func newUpstream(t *testing.T) *upstream {
+	url := filepath.Join(t.TempDir(), "upstream.git")
+	if _, err := git.PlainInit(url, true); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	work, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := work.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{url}}); err != nil {
+		t.Fatal(err)
+	}
+	return &upstream{t: t, url: url, work: work, dir: dir}
+}
 
-			var cancel context.CancelFunc
-			if tt.fields.poll {
-				ctx, cancel = context.WithTimeout(ctx, time.Second)
-				defer cancel()
-			}
+// commit writes the files, removes the ones with empty contents, and pushes
+// the commit. It returns the hash of the commit.
+func (u *upstream) commit(files map[string]string) string {
+	hash := u.record(files)
+	u.push()
+	return hash
+}
 
-			var collectedDocs []*processor.Document
-			em := func(d *processor.Document) error {
-				collectedDocs = append(collectedDocs, d)
-				return nil
-			}
-			eh := func(err error) bool {
-				if (err != nil) != tt.wantErr {
-					t.Errorf("gitCollector.RetrieveArtifacts() = %v, want %v", err, tt.wantErr)
-				}
-				return true
+// record commits the files without pushing them. The commit has the given
+// parents, or HEAD by default.
+func (u *upstream) record(files map[string]string, parents ...plumbing.Hash) string {
+	w, err := u.work.Worktree()
+	if err != nil {
+		u.t.Fatal(err)
+	}
+	for name, contents := range files {
+		if contents == "" {
+			if _, err := w.Remove(name); err != nil {
+				u.t.Fatal(err)
 			}
+			continue
+		}
+		p := filepath.Join(u.dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+			u.t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(contents), 0o644); err != nil {
+			u.t.Fatal(err)
+		}
+		if _, err := w.Add(name); err != nil {
+			u.t.Fatal(err)
+		}
+	}
+	hash, err := w.Commit("update", &git.CommitOptions{
+		Author:  &object.Signature{Name: "guac", Email: "guac@example.com", When: time.Now()},
+		Parents: parents,
+	})
+	if err != nil {
+		u.t.Fatal(err)
+	}
+	return hash.String()
+}
 
-			defer os.RemoveAll(tt.fields.dir) // clean up
-			if err := collector.Collect(ctx, em, eh); err != nil {
-				t.Fatalf("Collector error handler error: %v", err)
-			}
+func (u *upstream) push() {
+	if err := u.work.Push(&git.PushOptions{RemoteName: "origin"}); err != nil {
+		u.t.Fatal(err)
+	}
+}
 
-			if len(collectedDocs) != tt.numberOfFilesCollected {
-				t.Errorf("number of files collected does not match test = %v, want %v", len(collectedDocs), tt.numberOfFilesCollected)
-			}
+// collect runs the collector once and returns the sources and contents of the
+// documents it emitted.
+func collect(t *testing.T, g *gitDocumentCollector) map[string]string {
+	ctx := logging.WithLogger(context.Background())
+	docChan := make(chan *processor.Document, 100)
+	if err := g.RetrieveArtifacts(ctx, docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() = %v", err)
+	}
+	close(docChan)
+	got := map[string]string{}
+	for d := range docChan {
+		if d.SourceInformation.Collector != CollectorGitDocument {
+			t.Errorf("collector = %s, want %s", d.SourceInformation.Collector, CollectorGitDocument)
+		}
+		got[d.SourceInformation.Source] = string(d.Blob)
+	}
+	return got
+}
 
-			if g.Type() != CollectorGitDocument {
-				t.Errorf("g.Type() = %s, want %s", g.Type(), CollectorGitDocument)
-			}
-		})
+func Test_gitCol_RetrieveArtifacts(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	u := newUpstream(t)
+	u.commit(map[string]string{"a.json": "a1", "b.json": "b1", "README.md": "readme"})
+	second := u.commit(map[string]string{"a.json": "a2", "b.json": "", "vex/c.json": "c1"})
+
+	g := NewGitDocumentCollector(ctx, u.url, filepath.Join(t.TempDir(), "clone"), false, time.Millisecond,
+		WithGlobs([]string{"*.json"}))
+	if g.Type() != CollectorGitDocument {
+		t.Errorf("g.Type() = %s, want %s", g.Type(), CollectorGitDocument)
+	}
+
+	// the first run emits the latest version of every matching file
+	want := map[string]string{
+		u.url + "@" + second + "#a.json":     "a2",
+		u.url + "@" + second + "#vex/c.json": "c1",
+	}
+	if diff := cmp.Diff(want, collect(t, g)); diff != "" {
+		t.Errorf("first run (-want +got):\n%s", diff)
+	}
+
+	// later runs only emit the files changed since
+	if got := collect(t, g); len(got) != 0 {
+		t.Errorf("run without new commits emitted %v", got)
+	}
+	third := u.commit(map[string]string{"vex/c.json": "c2", "README.md": "readme2"})
+	want = map[string]string{u.url + "@" + third + "#vex/c.json": "c2"}
+	if diff := cmp.Diff(want, collect(t, g)); diff != "" {
+		t.Errorf("run after a new commit (-want +got):\n%s", diff)
+	}
+
+	// a glob with a slash matches the path from the root
+	g = NewGitDocumentCollector(ctx, u.url, filepath.Join(t.TempDir(), "clone"), false, time.Millisecond,
+		WithGlobs([]string{"vex/*.json"}), WithHistory(true))
+	want = map[string]string{
+		u.url + "@" + second + "#vex/c.json": "c1",
+		u.url + "@" + third + "#vex/c.json":  "c2",
+	}
+	if diff := cmp.Diff(want, collect(t, g)); diff != "" {
+		t.Errorf("history of vex/*.json (-want +got):\n%s", diff)
+	}
+}
+
+func Test_gitCol_History(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	u := newUpstream(t)
+	var commits []string
+	for _, status := range []string{"under_investigation", "affected", "fixed"} {
+		commits = append(commits, u.commit(map[string]string{"vex.json": status}))
+	}
+	commits = append(commits, u.commit(map[string]string{"notes.txt": "unrelated"}))
+
+	docChan := make(chan *processor.Document, 100)
+	g := NewGitDocumentCollector(ctx, u.url, filepath.Join(t.TempDir(), "clone"), false, time.Millisecond,
+		WithGlobs([]string{"*.json"}), WithHistory(true))
+	if err := g.RetrieveArtifacts(ctx, docChan); err != nil {
+		t.Fatal(err)
+	}
+	close(docChan)
+
+	// every version of the file is emitted, oldest first
+	var got []string
+	for d := range docChan {
+		got = append(got, d.SourceInformation.Source+" "+string(d.Blob))
+	}
+	want := []string{
+		u.url + "@" + commits[0] + "#vex.json under_investigation",
+		u.url + "@" + commits[1] + "#vex.json affected",
+		u.url + "@" + commits[2] + "#vex.json fixed",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("history (-want +got):\n%s", diff)
+	}
+}
+
+func Test_gitCol_HistoryOffFirstParentChain(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	u := newUpstream(t)
+	base := u.commit(map[string]string{"a.json": "a1"})
+	u.commit(map[string]string{"b.json": "b1"})
+
+	g := NewGitDocumentCollector(ctx, u.url, filepath.Join(t.TempDir(), "clone"), false, time.Millisecond,
+		WithHistory(true))
+	if got := collect(t, g); len(got) != 2 {
+		t.Fatalf("first run emitted %v", got)
+	}
+
+	// merge the collected commit into a side branch: the collected commit is
+	// the second parent of the merge
+	collected, err := u.work.Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := u.work.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Reset(&git.ResetOptions{Commit: plumbing.NewHash(base), Mode: git.HardReset}); err != nil {
+		t.Fatal(err)
+	}
+	side := u.record(map[string]string{"c.json": "c1"})
+	merge := u.record(map[string]string{"b.json": "b1"}, plumbing.NewHash(side), collected.Hash())
+	u.push()
+
+	// only the changes since the collected commit are emitted, instead of the
+	// whole first-parent history of the merge
+	want := map[string]string{u.url + "@" + merge + "#c.json": "c1"}
+	if diff := cmp.Diff(want, collect(t, g)); diff != "" {
+		t.Errorf("run after the merge (-want +got):\n%s", diff)
+	}
+}
+
+func Test_gitCol_Poll(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	defer cancel()
+	u := newUpstream(t)
+	u.commit(map[string]string{"sbom.json": "sbom"})
+
+	g := NewGitDocumentCollector(ctx, u.url, filepath.Join(t.TempDir(), "clone"), true, time.Hour)
+	// the test ticks the polling interval: a tick is only received once the
+	// collector is done with the previous poll
+	ticks := make(chan time.Time)
+	g.after = func(time.Duration) <-chan time.Time { return ticks }
+
+	docChan := make(chan *processor.Document, 100)
+	errChan := make(chan error, 1)
+	go func() { errChan <- g.RetrieveArtifacts(ctx, docChan) }()
+
+	if d := <-docChan; string(d.Blob) != "sbom" {
+		t.Errorf("first poll emitted %s, want sbom", d.Blob)
+	}
+	// polling an unchanged repository does not emit the file again
+	ticks <- time.Now()
+	ticks <- time.Now()
+	if len(docChan) != 0 {
+		t.Errorf("polling an unchanged repository emitted %d documents", len(docChan))
+	}
+
+	// the next poll emits a new commit
+	u.commit(map[string]string{"sbom.json": "sbom2"})
+	ticks <- time.Now()
+	if d := <-docChan; string(d.Blob) != "sbom2" {
+		t.Errorf("poll after a new commit emitted %s, want sbom2", d.Blob)
+	}
+
+	cancel()
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("RetrieveArtifacts() = %v, want %v", err, context.Canceled)
 	}
 }
@@ -166,7 +166,7 @@ func WithCollectDataSource(collectDataSource datasource.CollectSource) Opt {
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
 func (g *githubCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
 	if g.isRelease {
-		err := g.populateRepoToReleaseTags(ctx)
+		sources, err := g.populateRepoToReleaseTags(ctx)
 		if err != nil {
 			return err
 		}
@@ -174,6 +174,8 @@ func (g *githubCollector) RetrieveArtifacts(ctx context.Context, docChannel chan
 			for repo, tags := range g.repoToReleaseTags {
 				g.fetchAssets(ctx, repo.Owner, repo.Repo, tags, docChannel)
 			}
+			// the failures to fetch a release are only logged
+			datasource.Complete(ctx, g.collectDataSource, sources, nil)
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
@@ -183,6 +185,7 @@ func (g *githubCollector) RetrieveArtifacts(ctx context.Context, docChannel chan
 			for repo, tags := range g.repoToReleaseTags {
 				g.fetchAssets(ctx, repo.Owner, repo.Repo, tags, docChannel)
 			}
+			datasource.Complete(ctx, g.collectDataSource, sources, nil)
 		}
 	} else {
 		if g.poll {
@@ -215,35 +218,42 @@ func (g *githubCollector) Type() string {
 	return GithubCollector
 }
 
-func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) error {
+// populateRepoToReleaseTags adds the releases of the data sources to
+// repoToReleaseTags, and returns the data sources added.
+func (g *githubCollector) populateRepoToReleaseTags(ctx context.Context) ([]datasource.Source, error) {
 	logger := logging.FromContext(ctx)
 	if g.collectDataSource == nil {
-		return nil
+		return nil, nil
 	}
 	ds, err := g.collectDataSource.GetDataSources(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to retrieve datasource: %w", err)
+		return nil, fmt.Errorf("unable to retrieve datasource: %w", err)
 	}
 
+	var sources []datasource.Source
 	for _, grds := range ds.GithubReleaseDataSources {
 		r, t, err := ParseGithubReleaseDataSource(grds)
 		if err != nil {
 			logger.Warnf("unable to parse github datasource: %v", err)
+			datasource.Complete(ctx, g.collectDataSource, []datasource.Source{grds}, err)
 			continue
 		}
 		g.repoToReleaseTags[*r] = append(g.repoToReleaseTags[*r], t)
+		sources = append(sources, grds)
 	}
 
 	for _, gds := range ds.GitDataSources {
 		r, t, err := ParseGitDataSource(gds)
 		if err != nil {
 			logger.Warnf("unable to parse git datasource: %v", err)
+			datasource.Complete(ctx, g.collectDataSource, []datasource.Source{gds}, err)
 			continue
 		}
 		g.repoToReleaseTags[*r] = append(g.repoToReleaseTags[*r], t)
+		sources = append(sources, gds)
 	}
 
-	return nil
+	return sources, nil
 }
 
 func (g *githubCollector) fetchAssets(ctx context.Context, owner string, repo string, tags []TagOrLatest, docChannel chan<- *processor.Document) {
@@ -510,7 +510,7 @@ func Test_githubCollector_populateRepoToReleaseTags(t *testing.T) {
 				assetSuffixes:     tt.fields.assetSuffixes,
 				collectDataSource: tt.fields.collectDataSource,
 			}
-			if err := g.populateRepoToReleaseTags(ctx); (err != nil) != tt.wantErr {
+			if _, err := g.populateRepoToReleaseTags(ctx); (err != nil) != tt.wantErr {
 				t.Errorf("githubCollector.populateRepoToReleaseTags() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			got := tt.fields.repoToReleaseTags
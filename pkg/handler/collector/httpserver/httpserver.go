@@ -0,0 +1,324 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpserver is a push collector: it accepts the documents uploaded
+// to it over HTTP instead of pulling them, and publishes them to the blob
+// store and the emitter like the other collectors of guaccollect.
+package httpserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/guesser"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	HTTPServerCollector = "HTTPServerCollector"
+
+	// DefaultMaxDocumentSize is the default size limit of an uploaded document.
+	DefaultMaxDocumentSize = 50 << 20
+	// maxBatchSize is the most documents a multipart upload can hold.
+	maxBatchSize = 100
+)
+
+// acceptedMediaTypes are the content types of the documents the guesser may
+// recognize, besides the +json and +xml structured syntax suffixes.
+var acceptedMediaTypes = map[string]bool{
+	"application/json":     true,
+	"application/x-ndjson": true,
+	"application/jsonl":    true,
+	"application/xml":      true,
+	"text/xml":             true,
+	"text/plain":           true,
+}
+
+type server struct {
+	ctx             context.Context
+	blobStore       *blob.BlobStore
+	pubsub          *emitter.EmitterPubSub
+	publishToQueue  bool
+	tokens          []string
+	maxDocumentSize int64
+}
+
+type Opt func(*server)
+
+// WithTokens requires the uploads to be authenticated with one of the bearer
+// tokens. Uploads are not authenticated without tokens.
+func WithTokens(tokens []string) Opt {
+	return func(s *server) {
+		s.tokens = tokens
+	}
+}
+
+// WithMaxDocumentSize sets the size limit of an uploaded document.
+func WithMaxDocumentSize(size int64) Opt {
+	return func(s *server) {
+		s.maxDocumentSize = size
+	}
+}
+
+// NewHandler returns the handler of the upload endpoints:
+//
+//	POST /documents      uploads a document, or a batch of documents as
+//	                     multipart/form-data, and returns their tracking IDs
+//	GET  /documents/{id} returns the status of an uploaded document
+func NewHandler(ctx context.Context, blobStore *blob.BlobStore, pubsub *emitter.EmitterPubSub, publishToQueue bool, opts ...Opt) http.Handler {
+	s := &server{
+		ctx:             ctx,
+		blobStore:       blobStore,
+		pubsub:          pubsub,
+		publishToQueue:  publishToQueue,
+		maxDocumentSize: DefaultMaxDocumentSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /documents", s.authenticate(s.postDocuments))
+	mux.HandleFunc("GET /documents/{id}", s.authenticate(s.getDocument))
+	return mux
+}
+
+// upload is a document read from a request.
+type upload struct {
+	source string
+	blob   []byte
+}
+
+// uploadResponse lists the status of the uploaded documents, in order.
+type uploadResponse struct {
+	Documents []collector.StatusRecord `json:"documents"`
+}
+
+func (s *server) authenticate(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if len(s.tokens) == 0 {
+			next(w, r)
+			return
+		}
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if ok {
+			for _, t := range s.tokens {
+				if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+					next(w, r)
+					return
+				}
+			}
+		}
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+	}
+}
+
+func (s *server) postDocuments(w http.ResponseWriter, r *http.Request) {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid content type: %v", err), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var uploads []upload
+	if mediaType == "multipart/form-data" {
+		r.Body = http.MaxBytesReader(w, r.Body, s.maxDocumentSize*maxBatchSize)
+		uploads, err = s.readMultipart(multipart.NewReader(r.Body, params["boundary"]))
+	} else {
+		var u upload
+		u, err = s.readDocument(r.Body, mediaType, r.URL.Query().Get("source"))
+		uploads = []upload{u}
+	}
+	if err != nil {
+		var uploadErr *uploadError
+		if errors.As(err, &uploadErr) {
+			http.Error(w, uploadErr.Error(), uploadErr.code)
+		} else {
+			http.Error(w, fmt.Sprintf("unable to read upload: %v", err), http.StatusBadRequest)
+		}
+		return
+	}
+
+	// the documents are all read before any is published, so that an invalid
+	// batch is rejected as a whole
+	code := http.StatusAccepted
+	var resp uploadResponse
+	for _, u := range uploads {
+		record, err := s.publish(r.Context(), u)
+		if err != nil {
+			logging.FromContext(s.ctx).Errorf("unable to publish %s: %v", u.source, err)
+			code = http.StatusInternalServerError
+		}
+		resp.Documents = append(resp.Documents, record)
+	}
+	writeJSON(w, code, resp)
+}
+
+func (s *server) readMultipart(mr *multipart.Reader) ([]upload, error) {
+	var uploads []upload
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, tooLarge(err)
+		}
+		if len(uploads) == maxBatchSize {
+			return nil, &uploadError{code: http.StatusRequestEntityTooLarge, msg: fmt.Sprintf("a batch holds at most %d documents", maxBatchSize)}
+		}
+		mediaType := "text/plain"
+		if ct := part.Header.Get("Content-Type"); ct != "" {
+			if mediaType, _, err = mime.ParseMediaType(ct); err != nil {
+				return nil, &uploadError{code: http.StatusUnsupportedMediaType, msg: fmt.Sprintf("invalid content type of %s: %v", part.FileName(), err)}
+			}
+		}
+		u, err := s.readDocument(part, mediaType, part.FileName())
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, u)
+	}
+	if len(uploads) == 0 {
+		return nil, &uploadError{code: http.StatusBadRequest, msg: "no document uploaded"}
+	}
+	return uploads, nil
+}
+
+func (s *server) readDocument(r io.Reader, mediaType, source string) (upload, error) {
+	if !acceptedMediaType(mediaType) {
+		return upload{}, &uploadError{code: http.StatusUnsupportedMediaType, msg: fmt.Sprintf("unsupported content type: %s", mediaType)}
+	}
+	blob, err := io.ReadAll(io.LimitReader(r, s.maxDocumentSize+1))
+	if err != nil {
+		return upload{}, tooLarge(err)
+	}
+	if int64(len(blob)) > s.maxDocumentSize {
+		return upload{}, &uploadError{code: http.StatusRequestEntityTooLarge, msg: fmt.Sprintf("a document holds at most %d bytes", s.maxDocumentSize)}
+	}
+	if len(blob) == 0 {
+		return upload{}, &uploadError{code: http.StatusBadRequest, msg: "empty document"}
+	}
+	return upload{source: source, blob: blob}, nil
+}
+
+// publish publishes the recognized documents and quarantines the others.
+func (s *server) publish(ctx context.Context, u upload) (collector.StatusRecord, error) {
+	key := events.GetKey(u.blob)
+	source := u.source
+	if source == "" {
+		source = key
+	}
+	d := &processor.Document{
+		Blob:   u.blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   HTTPServerCollector,
+			Source:      source,
+			DocumentRef: events.GetDocRef(u.blob),
+		},
+	}
+	collector.AddChildLogger(logging.FromContext(s.ctx), d)
+	record := collector.StatusRecord{ID: key, Source: source}
+
+	docType, _, err := guesser.GuessDocument(ctx, d)
+	if err != nil || docType == processor.DocumentUnknown {
+		record.Status = collector.StatusQuarantined
+		record.Error = "unrecognized document"
+		docByte, err := json.Marshal(d)
+		if err != nil {
+			return record, fmt.Errorf("failed marshal of document: %w", err)
+		}
+		if err := s.blobStore.Write(ctx, collector.QuarantinePrefix+key, docByte); err != nil {
+			return record, fmt.Errorf("failed write document to quarantine: %w", err)
+		}
+		return record, collector.WriteStatus(ctx, s.blobStore, record)
+	}
+
+	// the status is written first, as the document may be ingested as soon
+	// as it is published
+	record.Status = collector.StatusProcessed
+	if err := collector.WriteStatus(ctx, s.blobStore, record); err != nil {
+		record.Status = collector.StatusFailed
+		record.Error = err.Error()
+		return record, err
+	}
+	if err := collector.Publish(ctx, d, s.blobStore, s.pubsub, s.publishToQueue); err != nil {
+		record.Status = collector.StatusFailed
+		record.Error = err.Error()
+		if statusErr := collector.WriteStatus(ctx, s.blobStore, record); statusErr != nil {
+			return record, errors.Join(err, statusErr)
+		}
+		return record, err
+	}
+	return record, nil
+}
+
+func (s *server) getDocument(w http.ResponseWriter, r *http.Request) {
+	record, err := collector.ReadStatus(r.Context(), s.blobStore, r.PathValue("id"))
+	if err != nil {
+		logging.FromContext(s.ctx).Errorf("unable to read document status: %v", err)
+		http.Error(w, "unable to read document status", http.StatusInternalServerError)
+		return
+	}
+	if record == nil {
+		http.Error(w, "unknown document", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, record)
+}
+
+func acceptedMediaType(mediaType string) bool {
+	return acceptedMediaTypes[mediaType] || strings.HasSuffix(mediaType, "+json") || strings.HasSuffix(mediaType, "+xml")
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// uploadError is an invalid upload, answered with its status code.
+type uploadError struct {
+	code int
+	msg  string
+}
+
+func (e *uploadError) Error() string {
+	return e.msg
+}
+
+// tooLarge reports the requests over http.MaxBytesReader limit as such.
+func tooLarge(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return &uploadError{code: http.StatusRequestEntityTooLarge, msg: fmt.Sprintf("an upload holds at most %d bytes", maxBytesErr.Limit)}
+	}
+	return err
+}
@@ -0,0 +1,202 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	token = "secret"
+	spdx  = `{"spdxVersion":"SPDX-2.3","SPDXID":"SPDXRef-DOCUMENT","name":"app","documentNamespace":"https://example.com/app"}`
+	other = `{"hello":"world"}`
+)
+
+type part struct {
+	filename, contentType, body string
+}
+
+func multipartBody(t *testing.T, parts ...part) (string, *bytes.Buffer) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	for _, p := range parts {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Disposition", `form-data; name="document"; filename="`+p.filename+`"`)
+		h.Set("Content-Type", p.contentType)
+		w, err := mw.CreatePart(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(p.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return mw.FormDataContentType(), &buf
+}
+
+func Test_Upload(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := NewHandler(ctx, blobStore, nil, false, WithTokens([]string{token}), WithMaxDocumentSize(1024))
+
+	do := func(method, target, contentType, auth string, body *bytes.Buffer) *httptest.ResponseRecorder {
+		if body == nil {
+			body = &bytes.Buffer{}
+		}
+		r := httptest.NewRequest(method, target, body)
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		if auth != "" {
+			r.Header.Set("Authorization", "Bearer "+auth)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+	records := func(t *testing.T, w *httptest.ResponseRecorder) []collector.StatusRecord {
+		var resp uploadResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp.Documents
+	}
+	ignoreTime := cmpopts.IgnoreFields(collector.StatusRecord{}, "Updated")
+	spdxKey, otherKey := events.GetKey([]byte(spdx)), events.GetKey([]byte(other))
+
+	rejected := []struct {
+		name        string
+		contentType string
+		auth        string
+		body        string
+		wantCode    int
+	}{
+		{"no token", "application/json", "", spdx, http.StatusUnauthorized},
+		{"wrong token", "application/json", "guess", spdx, http.StatusUnauthorized},
+		{"content type", "image/png", token, spdx, http.StatusUnsupportedMediaType},
+		{"too large", "application/json", token, strings.Repeat(" ", 1025), http.StatusRequestEntityTooLarge},
+		{"empty", "application/json", token, "", http.StatusBadRequest},
+	}
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := do(http.MethodPost, "/documents", tt.contentType, tt.auth, bytes.NewBufferString(tt.body)); w.Code != tt.wantCode {
+				t.Errorf("POST /documents = %d, want %d", w.Code, tt.wantCode)
+			}
+		})
+	}
+
+	t.Run("single", func(t *testing.T) {
+		w := do(http.MethodPost, "/documents?source=app.spdx.json", "application/spdx+json", token, bytes.NewBufferString(spdx))
+		if w.Code != http.StatusAccepted {
+			t.Fatalf("POST /documents = %d: %s", w.Code, w.Body)
+		}
+		want := []collector.StatusRecord{{ID: spdxKey, Source: "app.spdx.json", Status: collector.StatusProcessed}}
+		if diff := cmp.Diff(want, records(t, w), ignoreTime); diff != "" {
+			t.Errorf("documents (-want +got):\n%s", diff)
+		}
+
+		b, err := blobStore.Read(ctx, spdxKey)
+		if err != nil {
+			t.Fatalf("document not published: %v", err)
+		}
+		var d processor.Document
+		if err := json.Unmarshal(b, &d); err != nil {
+			t.Fatal(err)
+		}
+		if d.SourceInformation.Collector != HTTPServerCollector || string(d.Blob) != spdx {
+			t.Errorf("published %+v", d)
+		}
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		contentType, body := multipartBody(t,
+			part{"app.spdx.json", "application/json", spdx},
+			part{"other.json", "application/json", other})
+		w := do(http.MethodPost, "/documents", contentType, token, body)
+		if w.Code != http.StatusAccepted {
+			t.Fatalf("POST /documents = %d: %s", w.Code, w.Body)
+		}
+		want := []collector.StatusRecord{
+			{ID: spdxKey, Source: "app.spdx.json", Status: collector.StatusProcessed},
+			{ID: otherKey, Source: "other.json", Status: collector.StatusQuarantined, Error: "unrecognized document"},
+		}
+		if diff := cmp.Diff(want, records(t, w), ignoreTime); diff != "" {
+			t.Errorf("documents (-want +got):\n%s", diff)
+		}
+		if _, err := blobStore.Read(ctx, collector.QuarantinePrefix+otherKey); err != nil {
+			t.Errorf("document not quarantined: %v", err)
+		}
+		if _, err := blobStore.Read(ctx, otherKey); err == nil {
+			t.Errorf("quarantined document published")
+		}
+	})
+
+	t.Run("invalid batch", func(t *testing.T) {
+		contentType, body := multipartBody(t,
+			part{"app.spdx.json", "application/json", spdx},
+			part{"app.html", "text/html", other})
+		if w := do(http.MethodPost, "/documents", contentType, token, body); w.Code != http.StatusUnsupportedMediaType {
+			t.Errorf("POST /documents = %d, want %d", w.Code, http.StatusUnsupportedMediaType)
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		if err := collector.UpdateStatus(ctx, blobStore, spdxKey, collector.StatusIngested, nil); err != nil {
+			t.Fatal(err)
+		}
+		w := do(http.MethodGet, "/documents/"+spdxKey, "", token, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET /documents/{id} = %d: %s", w.Code, w.Body)
+		}
+		var got collector.StatusRecord
+		if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		want := collector.StatusRecord{ID: spdxKey, Source: "app.spdx.json", Status: collector.StatusIngested}
+		if diff := cmp.Diff(want, got, ignoreTime); diff != "" {
+			t.Errorf("status (-want +got):\n%s", diff)
+		}
+
+		if w := do(http.MethodGet, "/documents/sha256_unknown", "", token, nil); w.Code != http.StatusNotFound {
+			t.Errorf("GET /documents/{id} = %d, want %d", w.Code, http.StatusNotFound)
+		}
+		if w := do(http.MethodGet, "/documents/"+spdxKey, "", "", nil); w.Code != http.StatusUnauthorized {
+			t.Errorf("GET /documents/{id} = %d, want %d", w.Code, http.StatusUnauthorized)
+		}
+	})
+}
@@ -42,8 +42,10 @@ const (
 
 // OCI artifact types
 const (
-	SpdxJson   = "application/spdx+json"
-	InTotoJson = "application/vnd.in-toto+json"
+	SpdxJson      = "application/spdx+json"
+	InTotoJson    = "application/vnd.in-toto+json"
+	CycloneDxJson = "application/vnd.cyclonedx+json"
+	OpenVexJson   = "application/openvex+json"
 )
 
 // wellKnownOCIArtifactTypes is a map of OCI media types to document type and format
@@ -60,6 +62,14 @@ var wellKnownOCIArtifactTypes = map[string]struct {
 		documentType: processor.DocumentITE6SLSA,
 		formatType:   processor.FormatJSON,
 	},
+	CycloneDxJson: {
+		documentType: processor.DocumentCycloneDX,
+		formatType:   processor.FormatJSON,
+	},
+	OpenVexJson: {
+		documentType: processor.DocumentOpenVEX,
+		formatType:   processor.FormatJSON,
+	},
 }
 
 // wellKnownSuffixes are the well known suffixes for fallback artifacts
@@ -98,7 +108,8 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 
 	if o.poll {
 		for {
-			if err := o.populateRepoRefs(ctx, repoRefs); err != nil {
+			repoSources := map[string][]datasource.Source{}
+			if err := o.populateRepoRefs(ctx, repoRefs, repoSources); err != nil {
 				return fmt.Errorf("unable to populate reporefs: %w", err)
 			}
 			for repo, imageRefs := range repoRefs {
@@ -107,7 +118,9 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 				if len(imageRefs) > 0 {
 					return errors.New("image identifiers (tag or digest) should not be specified when using polling")
 				}
-				if err := o.getRefsAndFetch(ctx, repo, imageRefs, docChannel); err != nil {
+				err := o.getRefsAndFetch(ctx, repo, imageRefs, docChannel)
+				datasource.Complete(ctx, o.collectDataSource, repoSources[repo], err)
+				if err != nil {
 					return err
 				}
 			}
@@ -118,11 +131,14 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 			}
 		}
 	} else {
-		if err := o.populateRepoRefs(ctx, repoRefs); err != nil {
+		repoSources := map[string][]datasource.Source{}
+		if err := o.populateRepoRefs(ctx, repoRefs, repoSources); err != nil {
 			return fmt.Errorf("unable to populate reporefs: %w", err)
 		}
 		for repo, imageRefs := range repoRefs {
-			if err := o.getRefsAndFetch(ctx, repo, imageRefs, docChannel); err != nil {
+			err := o.getRefsAndFetch(ctx, repo, imageRefs, docChannel)
+			datasource.Complete(ctx, o.collectDataSource, repoSources[repo], err)
+			if err != nil {
 				return err
 			}
 		}
@@ -131,7 +147,9 @@ func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 	return nil
 }
 
-func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string][]ref.Ref) error {
+// populateRepoRefs adds the image references of the data sources to repoRefs,
+// and the data sources to repoSources, by repository.
+func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string][]ref.Ref, repoSources map[string][]datasource.Source) error {
 	logger := logging.FromContext(ctx)
 	ds, err := o.collectDataSource.GetDataSources(ctx)
 	if err != nil {
@@ -142,9 +160,11 @@ func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string
 		imageRef, err := ref.New(d.Value)
 		if err != nil {
 			logger.Errorf("unable to parse OCI path: %v", d.Value)
+			datasource.Complete(ctx, o.collectDataSource, []datasource.Source{d}, err)
 			continue
 		}
 		imagePath := fmt.Sprintf("%s/%s", imageRef.Registry, imageRef.Repository)
+		repoSources[imagePath] = append(repoSources[imagePath], d)
 
 		// If an image reference has no identifier (tag or digest), then
 		// it is considered as getting all tags
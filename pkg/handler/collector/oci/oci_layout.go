@@ -0,0 +1,436 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/opencontainers/go-digest"
+	purl "github.com/package-url/packageurl-go"
+	"github.com/regclient/regclient/types/ref"
+)
+
+const (
+	OCILayoutCollector = "OCILayoutCollector"
+)
+
+const (
+	// refNameAnnotation holds the tag, or the full reference, of a manifest
+	// of an OCI layout.
+	refNameAnnotation = "org.opencontainers.image.ref.name"
+	// containerdNameAnnotation holds the full reference of an image saved by
+	// docker save.
+	containerdNameAnnotation = "io.containerd.image.name"
+)
+
+// fallbackTag matches the tags of the referrers fallback (sha256-<hex>) and of
+// the cosign attachments (sha256-<hex>.sbom, sha256-<hex>.att, ...), and not
+// hyphenated tags such as build-42.
+var fallbackTag = regexp.MustCompile(`^(sha256-[a-f0-9]{64}|sha512-[a-f0-9]{128})(\.[a-z]+)?$`)
+
+// layoutDescriptor and layoutManifest hold the fields of OCI and Docker
+// descriptors, manifests and indexes the collector reads.
+type layoutDescriptor struct {
+	MediaType    string            `json:"mediaType"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Digest       string            `json:"digest"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+type layoutManifest struct {
+	MediaType    string             `json:"mediaType"`
+	ArtifactType string             `json:"artifactType,omitempty"`
+	Config       *layoutDescriptor  `json:"config,omitempty"`
+	Layers       []layoutDescriptor `json:"layers,omitempty"`
+	Manifests    []layoutDescriptor `json:"manifests,omitempty"`
+	Subject      *layoutDescriptor  `json:"subject,omitempty"`
+}
+
+// artifactType returns the type of an artifact manifest, which older
+// artifacts only record as the media type of their config.
+func (m *layoutManifest) artifactType() string {
+	if m.ArtifactType != "" || m.Config == nil {
+		return m.ArtifactType
+	}
+	return m.Config.MediaType
+}
+
+type ociLayoutCollector struct {
+	path string
+}
+
+// NewOCILayoutCollector initializes the collector of the images of an OCI
+// image layout, given as a directory or a tarball such as the output of
+// docker save. No registry is contacted.
+func NewOCILayoutCollector(ctx context.Context, path string) *ociLayoutCollector {
+	return &ociLayoutCollector{path: path}
+}
+
+// RetrieveArtifacts emits, for every image of the layout, a document recording
+// that the image occurs as its digest and the digests of its platform
+// manifests, followed by the SBOMs and attestations attached to these digests
+// as referrers or with the cosign fallback tags.
+func (o *ociLayoutCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	info, err := os.Stat(o.path)
+	if err != nil {
+		return fmt.Errorf("unable to read OCI layout %s: %w", o.path, err)
+	}
+	dir := o.path
+	if !info.IsDir() {
+		if dir, err = os.MkdirTemp("", "guac-oci-layout"); err != nil {
+			return fmt.Errorf("unable to create directory to extract %s: %w", o.path, err)
+		}
+		defer os.RemoveAll(dir)
+		if err := extractTar(o.path, dir); err != nil {
+			return err
+		}
+	}
+
+	l, err := openLayout(dir)
+	if err != nil {
+		return fmt.Errorf("unable to open OCI layout %s: %w", o.path, err)
+	}
+	for _, image := range l.images() {
+		if err := o.collectImage(ctx, l, image, docChannel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *ociLayoutCollector) collectImage(ctx context.Context, l *ociLayout, image layoutDescriptor, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	digests := []string{image.Digest}
+	if m := l.manifests[image.Digest]; m != nil {
+		for _, child := range m.Manifests {
+			digests = append(digests, child.Digest)
+		}
+	}
+	logger.Infof("Collecting %s from %s with %d manifests", image.Digest, o.path, len(digests))
+
+	occurrences, err := o.occurrences(image, digests)
+	if err != nil {
+		return err
+	}
+	if err := o.emit(ctx, occurrences, processor.DocumentIngestPredicates, processor.FormatJSON, image.Digest, docChannel); err != nil {
+		return err
+	}
+
+	for _, d := range digests {
+		for _, referrer := range l.referrers(d) {
+			m := l.manifests[referrer.Digest]
+			if m == nil {
+				continue
+			}
+			for i := len(m.Layers) - 1; i >= 0; i-- {
+				blob, err := l.blob(m.Layers[i].Digest)
+				if err != nil {
+					return fmt.Errorf("failed reading layer %d of %s: %w", i, referrer.Digest, err)
+				}
+				docType, docFormat := processor.DocumentUnknown, processor.FormatUnknown
+				if known, ok := wellKnownOCIArtifactTypes[referrer.ArtifactType]; ok {
+					docType, docFormat = known.documentType, known.formatType
+				}
+				if err := o.emit(ctx, blob, docType, docFormat, referrer.Digest, docChannel); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// occurrences returns the ingest predicates document recording that the image,
+// as an oci package of the digest of its manifest or index, occurs as each of
+// the digests.
+func (o *ociLayoutCollector) occurrences(image layoutDescriptor, digests []string) ([]byte, error) {
+	name, qualifiers := o.imageName(image)
+	pkg, err := helpers.PurlToPkg(purl.NewPackageURL(purl.TypeOCI, "", name, image.Digest, qualifiers, "").ToString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create package of %s: %w", image.Digest, err)
+	}
+	var preds assembler.IngestPredicates
+	for _, d := range digests {
+		parsed, err := digest.Parse(d)
+		if err != nil {
+			return nil, fmt.Errorf("invalid digest %s: %w", d, err)
+		}
+		preds.IsOccurrence = append(preds.IsOccurrence, assembler.IsOccurrenceIngest{
+			Pkg:          pkg,
+			Artifact:     &model.ArtifactInputSpec{Algorithm: parsed.Algorithm().String(), Digest: parsed.Encoded()},
+			IsOccurrence: &model.IsOccurrenceInputSpec{Justification: "image in OCI layout"},
+		})
+	}
+	return json.Marshal(preds)
+}
+
+// imageName returns the name of the oci package of an image and its qualifiers
+// from the reference the image was saved with, or from the name of the layout
+// if the image has no reference or only a tag.
+func (o *ociLayoutCollector) imageName(image layoutDescriptor) (string, purl.Qualifiers) {
+	name := strings.TrimSuffix(filepath.Base(o.path), filepath.Ext(o.path))
+	var qualifiers purl.Qualifiers
+
+	refName := image.Annotations[containerdNameAnnotation]
+	if refName == "" {
+		refName = image.Annotations[refNameAnnotation]
+	}
+	if refName == "" {
+		return name, nil
+	}
+	if !strings.ContainsAny(refName, "/:@") {
+		return name, purl.Qualifiers{{Key: "tag", Value: refName}}
+	}
+	r, err := ref.New(refName)
+	if err != nil {
+		return name, nil
+	}
+	qualifiers = append(qualifiers, purl.Qualifier{Key: "repository_url", Value: r.Registry + "/" + r.Repository})
+	if !hasNoTag(r) {
+		qualifiers = append(qualifiers, purl.Qualifier{Key: "tag", Value: r.Tag})
+	}
+	return path.Base(r.Repository), qualifiers
+}
+
+func (o *ociLayoutCollector) emit(ctx context.Context, blob []byte, docType processor.DocumentType, docFormat processor.FormatType, manifestDigest string, docChannel chan<- *processor.Document) error {
+	doc := &processor.Document{
+		Blob:   blob,
+		Type:   docType,
+		Format: docFormat,
+		SourceInformation: processor.SourceInformation{
+			Collector:   OCILayoutCollector,
+			Source:      fmt.Sprintf("%s@%s", o.path, manifestDigest),
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+	select {
+	case docChannel <- doc:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Type is the collector type of the collector
+func (o *ociLayoutCollector) Type() string {
+	return OCILayoutCollector
+}
+
+// ociLayout is an OCI image layout on disk with the manifests reachable from
+// its index.
+type ociLayout struct {
+	dir   string
+	index layoutManifest
+	// manifests holds the manifests and indexes by digest
+	manifests map[string]*layoutManifest
+	// tags holds the digests of the descriptors of index.json by ref name
+	tags map[string]layoutDescriptor
+}
+
+func openLayout(dir string) (*ociLayout, error) {
+	if _, err := os.Stat(filepath.Join(dir, "oci-layout")); err != nil {
+		return nil, fmt.Errorf("not an OCI image layout, docker save only writes one since Docker 25: %w", err)
+	}
+	l := &ociLayout{
+		dir:       dir,
+		manifests: map[string]*layoutManifest{},
+		tags:      map[string]layoutDescriptor{},
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &l.index); err != nil {
+		return nil, fmt.Errorf("invalid index.json: %w", err)
+	}
+	for _, desc := range l.index.Manifests {
+		if name := desc.Annotations[refNameAnnotation]; name != "" {
+			l.tags[name] = desc
+		}
+		if err := l.load(desc); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// load reads the manifest of desc and, for an index, the manifests it lists.
+// Manifests missing from the layout, such as the platforms that were not
+// saved, are skipped.
+func (l *ociLayout) load(desc layoutDescriptor) error {
+	if _, ok := l.manifests[desc.Digest]; ok {
+		return nil
+	}
+	b, err := l.blob(desc.Digest)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var m layoutManifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("invalid manifest %s: %w", desc.Digest, err)
+	}
+	l.manifests[desc.Digest] = &m
+	for _, child := range m.Manifests {
+		if err := l.load(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *ociLayout) blob(d string) ([]byte, error) {
+	parsed, err := digest.Parse(d)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest %s: %w", d, err)
+	}
+	return os.ReadFile(filepath.Join(l.dir, "blobs", parsed.Algorithm().String(), parsed.Encoded()))
+}
+
+// images returns the descriptors of index.json that are images: neither
+// referrers nor tagged with a fallback tag.
+func (l *ociLayout) images() []layoutDescriptor {
+	var images []layoutDescriptor
+	for _, desc := range l.index.Manifests {
+		if fallbackTag.MatchString(desc.Annotations[refNameAnnotation]) {
+			continue
+		}
+		if m := l.manifests[desc.Digest]; m == nil || m.Subject != nil {
+			continue
+		}
+		images = append(images, desc)
+	}
+	return images
+}
+
+// referrers returns the manifests attached to the manifest d, with their
+// artifact type: the manifests of the layout whose subject is d, the
+// manifests listed by the referrers fallback index of d, and the cosign
+// attachments of d, whose type is unknown.
+func (l *ociLayout) referrers(d string) []layoutDescriptor {
+	var referrers []layoutDescriptor
+	seen := map[string]bool{}
+	add := func(desc layoutDescriptor) {
+		if !seen[desc.Digest] {
+			seen[desc.Digest] = true
+			referrers = append(referrers, desc)
+		}
+	}
+
+	for md, m := range l.manifests {
+		if m.Subject != nil && m.Subject.Digest == d {
+			add(layoutDescriptor{Digest: md, ArtifactType: m.artifactType()})
+		}
+	}
+	parsed, err := digest.Parse(d)
+	if err != nil {
+		return referrers
+	}
+	tag := fmt.Sprintf("%v-%v", parsed.Algorithm(), parsed.Encoded())
+	if index, ok := l.tags[tag]; ok && l.manifests[index.Digest] != nil {
+		for _, desc := range l.manifests[index.Digest].Manifests {
+			if desc.ArtifactType == "" && l.manifests[desc.Digest] != nil {
+				desc.ArtifactType = l.manifests[desc.Digest].artifactType()
+			}
+			add(desc)
+		}
+	}
+	for _, suffix := range wellKnownSuffixes {
+		if desc, ok := l.tags[tag+"."+suffix]; ok {
+			add(layoutDescriptor{Digest: desc.Digest, ArtifactType: "unknown"})
+		}
+	}
+	// sort the referrers found in the layout for a deterministic order
+	slices.SortFunc(referrers, func(a, b layoutDescriptor) int { return strings.Compare(a.Digest, b.Digest) })
+	return referrers
+}
+
+// extractTar extracts the tarball, optionally gzip compressed, to dir.
+func extractTar(tarball, dir string) error {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return fmt.Errorf("unable to open %s: %w", tarball, err)
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if magic, err := r.(*bufio.Reader).Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return fmt.Errorf("unable to decompress %s: %w", tarball, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read %s: %w", tarball, err)
+		}
+		name := filepath.FromSlash(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid path %s in %s", hdr.Name, tarball)
+		}
+		target := filepath.Join(dir, name)
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(out, tr)
+			closeErr := out.Close()
+			if err != nil {
+				return fmt.Errorf("unable to extract %s: %w", hdr.Name, err)
+			}
+			if closeErr != nil {
+				return closeErr
+			}
+		}
+	}
+}
@@ -0,0 +1,237 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	ociManifest = "application/vnd.oci.image.manifest.v1+json"
+	ociIndex    = "application/vnd.oci.image.index.v1+json"
+)
+
+// testLayout writes an OCI layout to a directory.
+type testLayout struct {
+	t     *testing.T
+	dir   string
+	index []layoutDescriptor
+}
+
+func newTestLayout(t *testing.T, dir string) *testLayout {
+	if err := os.MkdirAll(filepath.Join(dir, "blobs", "sha256"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "oci-layout"), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return &testLayout{t: t, dir: dir}
+}
+
+func (l *testLayout) blob(b []byte) string {
+	d := digest.FromBytes(b)
+	if err := os.WriteFile(filepath.Join(l.dir, "blobs", "sha256", d.Encoded()), b, 0o644); err != nil {
+		l.t.Fatal(err)
+	}
+	return d.String()
+}
+
+func (l *testLayout) manifest(m layoutManifest) string {
+	b, err := json.Marshal(m)
+	if err != nil {
+		l.t.Fatal(err)
+	}
+	return l.blob(b)
+}
+
+// layer returns the manifest of an artifact of one layer holding contents.
+func (l *testLayout) layer(contents string) []layoutDescriptor {
+	return []layoutDescriptor{{MediaType: "application/octet-stream", Digest: l.blob([]byte(contents))}}
+}
+
+func (l *testLayout) tag(mediaType, d string, annotations map[string]string) {
+	l.index = append(l.index, layoutDescriptor{MediaType: mediaType, Digest: d, Annotations: annotations})
+}
+
+func (l *testLayout) write() {
+	b, err := json.Marshal(layoutManifest{MediaType: ociIndex, Manifests: l.index})
+	if err != nil {
+		l.t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(l.dir, "index.json"), b, 0o644); err != nil {
+		l.t.Fatal(err)
+	}
+}
+
+func fallback(d string) string {
+	return strings.Replace(d, ":", "-", 1)
+}
+
+func Test_ociLayoutCollector_RetrieveArtifacts(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "layout")
+	l := newTestLayout(t, dir)
+
+	config := &layoutDescriptor{MediaType: "application/vnd.oci.image.config.v1+json", Digest: l.blob([]byte("{}"))}
+	platform := l.manifest(layoutManifest{MediaType: ociManifest, Config: config, Layers: l.layer("rootfs")})
+	image := l.manifest(layoutManifest{MediaType: ociIndex, Manifests: []layoutDescriptor{{MediaType: ociManifest, Digest: platform}}})
+	l.tag(ociIndex, image, map[string]string{containerdNameAnnotation: "example.com/team/app:v1"})
+
+	// an SBOM referrer of the platform manifest, listed in index.json
+	sbom := l.manifest(layoutManifest{MediaType: ociManifest, ArtifactType: SpdxJson, Config: config, Layers: l.layer("spdx"),
+		Subject: &layoutDescriptor{MediaType: ociManifest, Digest: platform}})
+	l.tag(ociManifest, sbom, nil)
+	// a VEX referrer of the index, listed in the referrers fallback index
+	vex := l.manifest(layoutManifest{MediaType: ociManifest, ArtifactType: OpenVexJson, Config: config, Layers: l.layer("openvex"),
+		Subject: &layoutDescriptor{MediaType: ociIndex, Digest: image}})
+	referrers := l.manifest(layoutManifest{MediaType: ociIndex, Manifests: []layoutDescriptor{{MediaType: ociManifest, ArtifactType: OpenVexJson, Digest: vex}}})
+	l.tag(ociIndex, referrers, map[string]string{refNameAnnotation: fallback(image)})
+	// a cosign attestation of the index
+	att := l.manifest(layoutManifest{MediaType: ociManifest, Config: config, Layers: l.layer("dsse")})
+	l.tag(ociManifest, att, map[string]string{refNameAnnotation: fallback(image) + ".att"})
+
+	// an image tagged without a reference
+	other := l.manifest(layoutManifest{MediaType: ociManifest, Config: config, Layers: l.layer("other rootfs")})
+	l.tag(ociManifest, other, map[string]string{refNameAnnotation: "v2"})
+	// an image whose hyphenated tag is not a fallback tag
+	build := l.manifest(layoutManifest{MediaType: ociManifest, Config: config, Layers: l.layer("build rootfs")})
+	l.tag(ociManifest, build, map[string]string{refNameAnnotation: "build-42"})
+	l.write()
+
+	tarball := filepath.Join(t.TempDir(), "layout.tar")
+	writeTar(t, dir, tarball)
+
+	type doc struct {
+		Type   processor.DocumentType
+		Source string
+		Blob   string
+	}
+	want := func(path string) []doc {
+		return []doc{
+			{processor.DocumentIngestPredicates, path + "@" + image,
+				"oci example.com/team/app@" + image + " [tag=v1] " + image + " " + platform},
+			{processor.DocumentUnknown, path + "@" + att, "dsse"},
+			{processor.DocumentOpenVEX, path + "@" + vex, "openvex"},
+			{processor.DocumentSPDX, path + "@" + sbom, "spdx"},
+			{processor.DocumentIngestPredicates, path + "@" + other,
+				"oci layout@" + other + " [tag=v2] " + other},
+			{processor.DocumentIngestPredicates, path + "@" + build,
+				"oci layout@" + build + " [tag=build-42] " + build},
+		}
+	}
+	// the referrers of a manifest are emitted in the order of their digests
+	sortReferrers := func(docs []doc) {
+		if docs[1].Source > docs[2].Source {
+			docs[1], docs[2] = docs[2], docs[1]
+		}
+	}
+
+	for _, path := range []string{dir, tarball} {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			ctx := logging.WithLogger(context.Background())
+			docChan := make(chan *processor.Document, 100)
+			c := NewOCILayoutCollector(ctx, path)
+			if err := c.RetrieveArtifacts(ctx, docChan); err != nil {
+				t.Fatalf("RetrieveArtifacts() = %v", err)
+			}
+			close(docChan)
+
+			var got []doc
+			for d := range docChan {
+				if d.SourceInformation.Collector != OCILayoutCollector {
+					t.Errorf("collector = %s, want %s", d.SourceInformation.Collector, OCILayoutCollector)
+				}
+				blob := string(d.Blob)
+				if d.Type == processor.DocumentIngestPredicates {
+					var preds assembler.IngestPredicates
+					if err := json.Unmarshal(d.Blob, &preds); err != nil {
+						t.Fatal(err)
+					}
+					blob = describeOccurrences(preds)
+				}
+				got = append(got, doc{d.Type, d.SourceInformation.Source, blob})
+			}
+			w := want(path)
+			sortReferrers(w)
+			if diff := cmp.Diff(w, got); diff != "" {
+				t.Errorf("documents (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ociLayoutCollector_DockerArchive(t *testing.T) {
+	// docker save before Docker 25 only writes manifest.json
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "manifest.json"), []byte("[]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tarball := filepath.Join(t.TempDir(), "image.tar")
+	writeTar(t, dir, tarball)
+
+	ctx := logging.WithLogger(context.Background())
+	err := NewOCILayoutCollector(ctx, tarball).RetrieveArtifacts(ctx, make(chan *processor.Document, 1))
+	if err == nil || !strings.Contains(err.Error(), "not an OCI image layout") {
+		t.Errorf("RetrieveArtifacts() = %v, want an error for a layout without oci-layout", err)
+	}
+}
+
+// describeOccurrences describes the package, whose namespace is the
+// repository of the image, and the artifacts of the occurrences, which are all
+// of the same package.
+func describeOccurrences(preds assembler.IngestPredicates) string {
+	var desc string
+	for i, o := range preds.IsOccurrence {
+		if i == 0 {
+			var qualifiers []string
+			for _, q := range o.Pkg.Qualifiers {
+				qualifiers = append(qualifiers, q.Key+"="+q.Value)
+			}
+			name := o.Pkg.Name
+			if o.Pkg.Namespace != nil && *o.Pkg.Namespace != "" {
+				name = *o.Pkg.Namespace + "/" + name
+			}
+			desc = fmt.Sprintf("%s %s@%s %v", o.Pkg.Type, name, *o.Pkg.Version, qualifiers)
+		}
+		desc += " " + o.Artifact.Algorithm + ":" + o.Artifact.Digest
+	}
+	return desc
+}
+
+func writeTar(t *testing.T, dir, tarball string) {
+	f, err := os.Create(tarball)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	tw := tar.NewWriter(f)
+	defer tw.Close()
+	if err := tw.AddFS(os.DirFS(dir)); err != nil {
+		t.Fatal(err)
+	}
+}
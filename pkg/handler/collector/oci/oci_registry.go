@@ -97,6 +97,7 @@ func (o *ociRegistryCollector) retrieveRegistryArtifacts(ctx context.Context, do
 		repos, err := o.listRepositories(ctx, rc, registry)
 		if err != nil {
 			logger.Errorf("failed to list repositories for registry %s: %v", registry, err)
+			datasource.Complete(ctx, o.collectDataSource, []datasource.Source{r}, err)
 			continue
 		}
 
@@ -117,7 +118,9 @@ func (o *ociRegistryCollector) retrieveRegistryArtifacts(ctx context.Context, do
 
 		// Create OCI collector for repositories
 		ociCollector := NewOCICollector(ctx, repoDataSource, false, o.interval, o.rcOpts...)
-		if err := ociCollector.RetrieveArtifacts(ctx, docChannel); err != nil {
+		err = ociCollector.RetrieveArtifacts(ctx, docChannel)
+		datasource.Complete(ctx, o.collectDataSource, []datasource.Source{r}, err)
+		if err != nil {
 			logger.Errorf("failed to retrieve artifacts from repository %s: %v", registry, err)
 			continue
 		}
@@ -0,0 +1,244 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+// inTotoPayloadType is the payload type of the envelopes whose entries do not
+// record it.
+const inTotoPayloadType = "application/vnd.in-toto+json"
+
+// logEntry is an entry of the Rekor API, which keys the entries by UUID.
+type logEntry struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+	// Attestation holds the payload of the envelope, when stored by the log.
+	Attestation *struct {
+		Data string `json:"data"`
+	} `json:"attestation,omitempty"`
+}
+
+type entryBody struct {
+	Kind       string          `json:"kind"`
+	APIVersion string          `json:"apiVersion"`
+	Spec       json.RawMessage `json:"spec"`
+}
+
+// payloadHash is the digest of the payload recorded by an entry.
+type payloadHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+type intotoV002Spec struct {
+	Content struct {
+		PayloadHash payloadHash `json:"payloadHash"`
+		Envelope    struct {
+			PayloadType string `json:"payloadType"`
+			Signatures  []struct {
+				// Sig is base64 encoded twice.
+				Sig       string `json:"sig"`
+				PublicKey string `json:"publicKey"`
+			} `json:"signatures"`
+		} `json:"envelope"`
+	} `json:"content"`
+}
+
+type dsseV001Spec struct {
+	PayloadHash payloadHash `json:"payloadHash"`
+	Signatures  []struct {
+		Signature string `json:"signature"`
+		Verifier  string `json:"verifier"`
+	} `json:"signatures"`
+}
+
+// attestation is an in-toto attestation recorded by a log entry.
+type attestation struct {
+	envelope dsse.Envelope
+	// subjects are the digests of the subjects of the statement, as
+	// algorithm:hex.
+	subjects []string
+	// identities are the emails and URIs of the certificates of the signers
+	// whose signature of the envelope verifies.
+	identities []string
+}
+
+// parseEntry returns the attestation of an intoto/0.0.2 or dsse entry, or nil
+// for the other kinds and the entries without the payload. The intoto/0.0.1
+// entries do not record the signatures, so they cannot make an envelope. It
+// fails when the payload does not match the hash recorded by the entry. Only
+// the certificates whose signature of the envelope verifies give identities,
+// the certificates themselves are not verified against a root.
+func parseEntry(e logEntry) (*attestation, error) {
+	b, err := base64.StdEncoding.DecodeString(e.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode entry body: %w", err)
+	}
+	var body entryBody
+	if err := json.Unmarshal(b, &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal entry body: %w", err)
+	}
+	if e.Attestation == nil || e.Attestation.Data == "" {
+		return nil, nil
+	}
+	payload, err := base64.StdEncoding.DecodeString(e.Attestation.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attestation: %w", err)
+	}
+
+	a := &attestation{envelope: dsse.Envelope{
+		PayloadType: inTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []dsse.Signature{},
+	}}
+	var verifiers []string
+	var hash payloadHash
+	switch body.Kind + "/" + body.APIVersion {
+	case "intoto/0.0.2":
+		var spec intotoV002Spec
+		if err := json.Unmarshal(body.Spec, &spec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal intoto entry: %w", err)
+		}
+		hash = spec.Content.PayloadHash
+		if t := spec.Content.Envelope.PayloadType; t != "" {
+			a.envelope.PayloadType = t
+		}
+		for _, s := range spec.Content.Envelope.Signatures {
+			sig, err := base64.StdEncoding.DecodeString(s.Sig)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode signature: %w", err)
+			}
+			a.envelope.Signatures = append(a.envelope.Signatures, dsse.Signature{Sig: string(sig)})
+			verifiers = append(verifiers, s.PublicKey)
+		}
+	case "dsse/0.0.1":
+		var spec dsseV001Spec
+		if err := json.Unmarshal(body.Spec, &spec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal dsse entry: %w", err)
+		}
+		hash = spec.PayloadHash
+		for _, s := range spec.Signatures {
+			a.envelope.Signatures = append(a.envelope.Signatures, dsse.Signature{Sig: s.Signature})
+			verifiers = append(verifiers, s.Verifier)
+		}
+	default:
+		return nil, nil
+	}
+	if err := hash.verify(payload); err != nil {
+		return nil, err
+	}
+
+	var statement struct {
+		Type    string `json:"_type"`
+		Subject []struct {
+			Digest map[string]string `json:"digest"`
+		} `json:"subject"`
+	}
+	if err := json.Unmarshal(payload, &statement); err != nil || statement.Type == "" {
+		// not an in-toto statement
+		return nil, nil
+	}
+	for _, s := range statement.Subject {
+		for alg, digest := range s.Digest {
+			a.subjects = append(a.subjects, strings.ToLower(alg+":"+digest))
+		}
+	}
+	pae := dsse.PAE(a.envelope.PayloadType, payload)
+	for i, v := range verifiers {
+		cert := parseCert(v)
+		if cert == nil || !verifySignature(cert.PublicKey, pae, a.envelope.Signatures[i].Sig) {
+			continue
+		}
+		a.identities = append(a.identities, certIdentities(cert)...)
+	}
+	return a, nil
+}
+
+// verify checks that payload is the one whose hash the entry recorded.
+func (h payloadHash) verify(payload []byte) error {
+	if h.Algorithm != "sha256" {
+		return fmt.Errorf("entry has no sha256 payload hash")
+	}
+	sum := sha256.Sum256(payload)
+	if !strings.EqualFold(h.Value, hex.EncodeToString(sum[:])) {
+		return fmt.Errorf("payload does not match the payload hash %s of the entry", h.Value)
+	}
+	return nil
+}
+
+// parseCert returns the certificate of a base64 encoded PEM verifier, or nil
+// for a public key.
+func parseCert(verifier string) *x509.Certificate {
+	b, err := base64.StdEncoding.DecodeString(verifier)
+	if err != nil {
+		return nil
+	}
+	block, _ := pem.Decode(b)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return cert
+}
+
+// verifySignature checks that sig, base64 encoded, is the signature of msg by
+// the key, hashed with SHA-256 as cosign does for ECDSA and RSA keys.
+func verifySignature(key crypto.PublicKey, msg []byte, sig string) bool {
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256(msg)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, digest[:], raw)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], raw) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, msg, raw)
+	default:
+		return false
+	}
+}
+
+// certIdentities returns the emails and URIs of a certificate.
+func certIdentities(cert *x509.Certificate) []string {
+	identities := cert.EmailAddresses
+	for _, u := range cert.URIs {
+		identities = append(identities, u.String())
+	}
+	return identities
+}
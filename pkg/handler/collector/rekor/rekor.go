@@ -0,0 +1,330 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	CollectorRekor = "RekorCollector"
+
+	// retrieveBatchSize is the most entries the Rekor API retrieves at once.
+	retrieveBatchSize = 10
+)
+
+type rekorCollector struct {
+	url        string
+	dump       string
+	subjects   []string
+	identities []string
+	client     *http.Client
+	poll       bool
+	interval   time.Duration
+	// collected are the UUIDs of the entries already collected.
+	collected map[string]bool
+}
+
+type Opt func(*rekorCollector)
+
+// WithURL collects the entries of the Rekor API, or of a mirror of it, at url.
+func WithURL(url string) Opt {
+	return func(r *rekorCollector) {
+		r.url = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithDump collects the entries of a log dump: a file of the entries as
+// returned by the Rekor API, an object keyed by UUID, one after the other.
+// Neither the signed entry timestamps nor the inclusion proofs of the entries
+// are verified, so the entries are only as trustworthy as the dump.
+func WithDump(path string) Opt {
+	return func(r *rekorCollector) {
+		r.dump = path
+	}
+}
+
+// WithSubjects only collects the attestations of one of the subject digests,
+// as algorithm:hex or as a sha256 hex.
+func WithSubjects(digests []string) Opt {
+	return func(r *rekorCollector) {
+		for _, d := range digests {
+			if !strings.Contains(d, ":") {
+				d = "sha256:" + d
+			}
+			r.subjects = append(r.subjects, strings.ToLower(d))
+		}
+	}
+}
+
+// WithIdentities only collects the attestations signed by a certificate of one
+// of the identities, an email or a URI such as a workflow identity. The
+// signature of the envelope is verified with the certificate, but neither the
+// certificate against the Fulcio root nor the entries of a dump against the
+// log, so the filter is only trustworthy against a live Rekor.
+func WithIdentities(identities []string) Opt {
+	return func(r *rekorCollector) {
+		r.identities = identities
+	}
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(r *rekorCollector) {
+		r.poll = true
+		r.interval = interval
+	}
+}
+
+func WithHTTPClient(client *http.Client) Opt {
+	return func(r *rekorCollector) {
+		r.client = client
+	}
+}
+
+// NewRekorCollector initializes the rekor collector and sets it for polling or
+// one time run
+func NewRekorCollector(opts ...Opt) (*rekorCollector, error) {
+	r := &rekorCollector{
+		client:    http.DefaultClient,
+		collected: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if (r.url == "") == (r.dump == "") {
+		return nil, errors.New("either a rekor url or a log dump must be specified")
+	}
+	// the log can only be searched by subject digest or email
+	if r.url != "" && len(r.subjects) == 0 && len(r.emails()) == 0 {
+		return nil, errors.New("a subject digest or an email identity must be specified to search the log")
+	}
+	return r, nil
+}
+
+// RetrieveArtifacts collects the attestations of the log entries matching the
+// subject digests and identities, as DSSE envelopes.
+func (r *rekorCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	for {
+		var err error
+		if r.dump != "" {
+			err = r.collectDump(ctx, docChannel)
+		} else {
+			err = r.collectLog(ctx, docChannel)
+		}
+		if err != nil {
+			return err
+		}
+		if !r.poll {
+			return nil
+		}
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// Type is the collector type of the collector
+func (r *rekorCollector) Type() string {
+	return CollectorRekor
+}
+
+func (r *rekorCollector) collectDump(ctx context.Context, docChannel chan<- *processor.Document) error {
+	f, err := os.Open(r.dump)
+	if err != nil {
+		return fmt.Errorf("unable to open log dump: %w", err)
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	for {
+		var entries map[string]logEntry
+		if err := dec.Decode(&entries); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("unable to decode log dump %s: %w", r.dump, err)
+		}
+		if err := r.collectEntries(ctx, entries, fmt.Sprintf("file:///%s", r.dump), docChannel); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *rekorCollector) collectLog(ctx context.Context, docChannel chan<- *processor.Document) error {
+	// the subject digests select fewer entries than the signers
+	var queries []map[string]string
+	for _, s := range r.subjects {
+		queries = append(queries, map[string]string{"hash": s})
+	}
+	if len(queries) == 0 {
+		for _, e := range r.emails() {
+			queries = append(queries, map[string]string{"email": e})
+		}
+	}
+
+	var uuids []string
+	for _, q := range queries {
+		var found []string
+		if err := r.post(ctx, "/api/v1/index/retrieve", q, &found); err != nil {
+			return fmt.Errorf("unable to search the log: %w", err)
+		}
+		for _, uuid := range found {
+			if !r.collected[uuid] && !slices.Contains(uuids, uuid) {
+				uuids = append(uuids, uuid)
+			}
+		}
+	}
+
+	for batch := range slices.Chunk(uuids, retrieveBatchSize) {
+		var found []map[string]logEntry
+		if err := r.post(ctx, "/api/v1/log/entries/retrieve", map[string][]string{"entryUUIDs": batch}, &found); err != nil {
+			return fmt.Errorf("unable to retrieve log entries: %w", err)
+		}
+		entries := map[string]logEntry{}
+		for _, f := range found {
+			for uuid, e := range f {
+				entries[uuid] = e
+			}
+		}
+		if err := r.collectEntries(ctx, entries, r.url+"/api/v1/log/entries", docChannel); err != nil {
+			return err
+		}
+		// the index may return the UUIDs without the tree ID the entries
+		// are returned with
+		for _, uuid := range batch {
+			r.collected[uuid] = true
+		}
+	}
+	return nil
+}
+
+// collectEntries emits the attestations of the entries matching the filters,
+// in log order.
+func (r *rekorCollector) collectEntries(ctx context.Context, entries map[string]logEntry, source string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+
+	uuids := make([]string, 0, len(entries))
+	for uuid := range entries {
+		uuids = append(uuids, uuid)
+	}
+	slices.SortFunc(uuids, func(a, b string) int {
+		return cmp.Compare(entries[a].LogIndex, entries[b].LogIndex)
+	})
+
+	for _, uuid := range uuids {
+		if r.collected[uuid] {
+			continue
+		}
+		r.collected[uuid] = true
+		e := entries[uuid]
+		a, err := parseEntry(e)
+		if err != nil {
+			logger.Warnf("unable to parse log entry %d: %v", e.LogIndex, err)
+			continue
+		}
+		if a == nil || !r.matches(a) {
+			continue
+		}
+		blob, err := json.Marshal(a.envelope)
+		if err != nil {
+			return fmt.Errorf("failed to marshal envelope: %w", err)
+		}
+		doc := &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentDSSE,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector:   CollectorRekor,
+				Source:      fmt.Sprintf("%s?logIndex=%d", source, e.LogIndex),
+				DocumentRef: events.GetDocRef(blob),
+			},
+		}
+		select {
+		case docChannel <- doc:
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		}
+	}
+	return nil
+}
+
+func (r *rekorCollector) matches(a *attestation) bool {
+	if len(r.subjects) > 0 && !slices.ContainsFunc(a.subjects, func(s string) bool { return slices.Contains(r.subjects, s) }) {
+		return false
+	}
+	if len(r.identities) > 0 && !slices.ContainsFunc(a.identities, func(i string) bool { return slices.Contains(r.identities, i) }) {
+		return false
+	}
+	return true
+}
+
+// emails returns the identities the log can be searched by.
+func (r *rekorCollector) emails() []string {
+	var emails []string
+	for _, i := range r.identities {
+		if strings.Contains(i, "@") && !strings.Contains(i, "://") {
+			emails = append(emails, i)
+		}
+	}
+	return emails
+}
+
+func (r *rekorCollector) post(ctx context.Context, path string, body, resp any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url+path, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", version.UserAgent)
+	res, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", path, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("request to %s failed with status %s: %s", path, res.Status, bytes.TrimSpace(msg))
+	}
+	if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rekor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+const (
+	appDigest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	libDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	alice     = "alice@example.com"
+	workflow  = "https://github.com/org/app/.github/workflows/release.yml@refs/heads/main"
+)
+
+// testSigner signs envelopes with the key of a certificate of an identity.
+type testSigner struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+	// cert is the base64 encoded PEM certificate.
+	cert string
+}
+
+func newTestSigner(t *testing.T, identity string) *testSigner {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{SerialNumber: big.NewInt(1)}
+	if strings.Contains(identity, "://") {
+		u, err := url.Parse(identity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmpl.URIs = []*url.URL{u}
+	} else {
+		tmpl.EmailAddresses = []string{identity}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSigner{t: t, key: key, cert: base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))}
+}
+
+// sign returns the base64 encoded signature of an in-toto envelope of payload.
+func (s *testSigner) sign(payload []byte) string {
+	sum := sha256.Sum256(dsse.PAE(inTotoPayloadType, payload))
+	sig, err := ecdsa.SignASN1(rand.Reader, s.key, sum[:])
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func statement(predicateType, digest string) []byte {
+	alg, hex, _ := strings.Cut(digest, ":")
+	return []byte(fmt.Sprintf(`{"_type":"https://in-toto.io/Statement/v0.1","predicateType":%q,"subject":[{"name":"app","digest":{%q:%q}}],"predicate":{}}`,
+		predicateType, alg, hex))
+}
+
+// testEntry returns a log entry of kind recording the payload signed with sig.
+func testEntry(t *testing.T, kind string, index int64, payload []byte, sig, verifier string) logEntry {
+	sum := sha256.Sum256(payload)
+	hash := hex.EncodeToString(sum[:])
+	var body string
+	switch kind {
+	case "intoto/0.0.1":
+		body = fmt.Sprintf(`{"kind":"intoto","apiVersion":"0.0.1","spec":{"content":{"payloadHash":{"algorithm":"sha256","value":%q}},"publicKey":%q}}`,
+			hash, verifier)
+	case "intoto/0.0.2":
+		body = fmt.Sprintf(`{"kind":"intoto","apiVersion":"0.0.2","spec":{"content":{"payloadHash":{"algorithm":"sha256","value":%q},"envelope":{"payloadType":"application/vnd.in-toto+json","signatures":[{"sig":%q,"publicKey":%q}]}}}}`,
+			hash, base64.StdEncoding.EncodeToString([]byte(sig)), verifier)
+	case "dsse/0.0.1":
+		body = fmt.Sprintf(`{"kind":"dsse","apiVersion":"0.0.1","spec":{"payloadHash":{"algorithm":"sha256","value":%q},"signatures":[{"signature":%q,"verifier":%q}]}}`,
+			hash, sig, verifier)
+	default:
+		body = `{"kind":"hashedrekord","apiVersion":"0.0.1","spec":{}}`
+	}
+	e := logEntry{Body: base64.StdEncoding.EncodeToString([]byte(body)), LogIndex: index}
+	if payload != nil {
+		e.Attestation = &struct {
+			Data string `json:"data"`
+		}{Data: base64.StdEncoding.EncodeToString(payload)}
+	}
+	return e
+}
+
+// testLog is an in-process stand-in of the Rekor API.
+type testLog struct {
+	entries map[string]logEntry
+	// subjects and emails index the entries
+	subjects map[string][]string
+	emails   map[string][]string
+}
+
+func (l *testLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/api/v1/index/retrieve":
+		var q map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		found := append(l.subjects[q["hash"]], l.emails[q["email"]]...)
+		_ = json.NewEncoder(w).Encode(found)
+	case "/api/v1/log/entries/retrieve":
+		var q struct {
+			EntryUUIDs []string `json:"entryUUIDs"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(q.EntryUUIDs) > retrieveBatchSize {
+			http.Error(w, "too many entries", http.StatusUnprocessableEntity)
+			return
+		}
+		found := []map[string]logEntry{}
+		for _, uuid := range q.EntryUUIDs {
+			found = append(found, map[string]logEntry{uuid: l.entries[uuid]})
+		}
+		_ = json.NewEncoder(w).Encode(found)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func Test_rekorCollector_RetrieveArtifacts(t *testing.T) {
+	aliceSigner, workflowSigner := newTestSigner(t, alice), newTestSigner(t, workflow)
+	aliceCert, workflowCert := aliceSigner.cert, workflowSigner.cert
+	appSBOM := statement("https://spdx.dev/Document", appDigest)
+	appProvenance := statement("https://slsa.dev/provenance/v0.2", appDigest)
+	libProvenance := statement("https://slsa.dev/provenance/v0.2", libDigest)
+	sig1 := aliceSigner.sign(appSBOM)
+	sig2 := workflowSigner.sign(appProvenance)
+	sig3 := workflowSigner.sign(libProvenance)
+	// signed by alice, but recorded with the certificate of the workflow
+	sig8 := aliceSigner.sign(libProvenance)
+
+	// an entry whose stored attestation is not the payload it logged
+	tampered := testEntry(t, "dsse/0.0.1", 7, appSBOM, workflowSigner.sign(appSBOM), workflowCert)
+	tampered.Attestation.Data = base64.StdEncoding.EncodeToString(appProvenance)
+
+	log := &testLog{
+		entries: map[string]logEntry{
+			"uuid-1": testEntry(t, "intoto/0.0.2", 1, appSBOM, sig1, aliceCert),
+			"uuid-2": testEntry(t, "dsse/0.0.1", 2, appProvenance, sig2, workflowCert),
+			"uuid-3": testEntry(t, "dsse/0.0.1", 3, libProvenance, sig3, workflowCert),
+			"uuid-4": testEntry(t, "hashedrekord/0.0.1", 4, nil, "", ""),
+			// an entry whose attestation is not stored
+			"uuid-5": testEntry(t, "intoto/0.0.2", 5, nil, aliceSigner.sign(appSBOM), aliceCert),
+			// an entry without the signatures
+			"uuid-6": testEntry(t, "intoto/0.0.1", 6, appSBOM, "", aliceCert),
+			"uuid-7": tampered,
+			// an entry whose signature is not the one of its certificate
+			"uuid-8": testEntry(t, "dsse/0.0.1", 8, libProvenance, sig8, workflowCert),
+		},
+		subjects: map[string][]string{
+			appDigest: {"uuid-2", "uuid-1", "uuid-5", "uuid-6", "uuid-7"},
+			libDigest: {"uuid-3"},
+		},
+		emails: map[string][]string{
+			alice: {"uuid-1", "uuid-5", "uuid-6"},
+		},
+	}
+	srv := httptest.NewServer(log)
+	defer srv.Close()
+
+	dump := filepath.Join(t.TempDir(), "rekor.jsonl")
+	var lines []string
+	// a dump is exported in log order
+	for _, uuid := range []string{"uuid-1", "uuid-2", "uuid-3", "uuid-4", "uuid-6", "uuid-7", "uuid-8"} {
+		b, err := json.Marshal(map[string]logEntry{uuid: log.entries[uuid]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(b))
+	}
+	if err := os.WriteFile(dump, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	type doc struct {
+		Source    string
+		Statement string
+		Sig       string
+	}
+	api := func(index int, payload []byte, sig string) doc {
+		return doc{fmt.Sprintf("%s/api/v1/log/entries?logIndex=%d", srv.URL, index), string(payload), sig}
+	}
+	fromDump := func(index int, payload []byte, sig string) doc {
+		return doc{fmt.Sprintf("file:///%s?logIndex=%d", dump, index), string(payload), sig}
+	}
+
+	tests := []struct {
+		name string
+		opts []Opt
+		want []doc
+	}{{
+		name: "subject",
+		opts: []Opt{WithURL(srv.URL + "/"), WithSubjects([]string{appDigest})},
+		want: []doc{api(1, appSBOM, sig1), api(2, appProvenance, sig2)},
+	}, {
+		name: "subject and workflow identity",
+		opts: []Opt{WithURL(srv.URL), WithSubjects([]string{strings.TrimPrefix(appDigest, "sha256:")}), WithIdentities([]string{workflow})},
+		want: []doc{api(2, appProvenance, sig2)},
+	}, {
+		name: "email identity",
+		opts: []Opt{WithURL(srv.URL), WithIdentities([]string{alice})},
+		want: []doc{api(1, appSBOM, sig1)},
+	}, {
+		name: "dump",
+		opts: []Opt{WithDump(dump)},
+		want: []doc{fromDump(1, appSBOM, sig1), fromDump(2, appProvenance, sig2), fromDump(3, libProvenance, sig3), fromDump(8, libProvenance, sig8)},
+	}, {
+		name: "dump workflow identity",
+		opts: []Opt{WithDump(dump), WithIdentities([]string{workflow})},
+		want: []doc{fromDump(2, appProvenance, sig2), fromDump(3, libProvenance, sig3)},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logging.WithLogger(context.Background())
+			r, err := NewRekorCollector(tt.opts...)
+			if err != nil {
+				t.Fatalf("NewRekorCollector() = %v", err)
+			}
+			docChan := make(chan *processor.Document, 10)
+			if err := r.RetrieveArtifacts(ctx, docChan); err != nil {
+				t.Fatalf("RetrieveArtifacts() = %v", err)
+			}
+			close(docChan)
+
+			var got []doc
+			for d := range docChan {
+				if d.Type != processor.DocumentDSSE || d.SourceInformation.Collector != CollectorRekor {
+					t.Errorf("document %s of type %s from %s", d.SourceInformation.Source, d.Type, d.SourceInformation.Collector)
+				}
+				var env dsse.Envelope
+				if err := json.Unmarshal(d.Blob, &env); err != nil {
+					t.Fatal(err)
+				}
+				payload, err := base64.StdEncoding.DecodeString(env.Payload)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if env.PayloadType != inTotoPayloadType || len(env.Signatures) != 1 {
+					t.Errorf("envelope %+v", env)
+					continue
+				}
+				got = append(got, doc{d.SourceInformation.Source, string(payload), env.Signatures[0].Sig})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("documents (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_NewRekorCollector(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Opt
+	}{
+		{"no source", []Opt{WithSubjects([]string{appDigest})}},
+		{"url and dump", []Opt{WithURL("https://rekor.example.com"), WithDump("rekor.jsonl"), WithSubjects([]string{appDigest})}},
+		{"unsearchable", []Opt{WithURL("https://rekor.example.com"), WithIdentities([]string{workflow})}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRekorCollector(tt.opts...); err == nil {
+				t.Errorf("NewRekorCollector() = nil, want an error")
+			}
+		})
+	}
+	if r, err := NewRekorCollector(WithDump("rekor.jsonl")); err != nil || r.Type() != CollectorRekor {
+		t.Errorf("NewRekorCollector() = %v, %v", r, err)
+	}
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// DocumentStatus is the state of a document pushed to GUAC, tracked so that
+// the pusher can tell what became of it.
+type DocumentStatus string
+
+const (
+	// StatusProcessed documents were recognized and published for ingestion.
+	StatusProcessed DocumentStatus = "processed"
+	// StatusIngested documents were ingested into the graph.
+	StatusIngested DocumentStatus = "ingested"
+	// StatusFailed documents could not be published or ingested.
+	StatusFailed DocumentStatus = "failed"
+	// StatusQuarantined documents were not recognized, they are kept in the
+	// blob store under the quarantine prefix instead of being published.
+	StatusQuarantined DocumentStatus = "quarantined"
+)
+
+const (
+	statusPrefix = "status/"
+	// QuarantinePrefix is the blob store prefix of the quarantined documents.
+	QuarantinePrefix = "quarantine/"
+)
+
+// StatusRecord is the tracked state of a document, stored in the blob store
+// next to the document.
+type StatusRecord struct {
+	// ID is the blob store key of the document.
+	ID      string         `json:"id"`
+	Source  string         `json:"source"`
+	Status  DocumentStatus `json:"status"`
+	Error   string         `json:"error,omitempty"`
+	Updated time.Time      `json:"updated"`
+}
+
+// WriteStatus records the status of the document stored under record.ID.
+func WriteStatus(ctx context.Context, blobStore *blob.BlobStore, record StatusRecord) error {
+	record.Updated = time.Now().UTC()
+	b, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed marshal of document status: %w", err)
+	}
+	if err := blobStore.Write(ctx, statusPrefix+record.ID, b); err != nil {
+		return fmt.Errorf("failed write document status to blob store: %w", err)
+	}
+	return nil
+}
+
+// ReadStatus returns the status of the document stored under key, or nil if
+// it is not tracked.
+func ReadStatus(ctx context.Context, blobStore *blob.BlobStore, key string) (*StatusRecord, error) {
+	b, err := blobStore.Read(ctx, statusPrefix+key)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed read document status from blob store: %w", err)
+	}
+	var record StatusRecord
+	if err := json.Unmarshal(b, &record); err != nil {
+		return nil, fmt.Errorf("failed unmarshal of document status: %w", err)
+	}
+	return &record, nil
+}
+
+// UpdateStatus updates the status of the document stored under key if it is
+// tracked, as only the pushed documents are.
+func UpdateStatus(ctx context.Context, blobStore *blob.BlobStore, key string, status DocumentStatus, statusErr error) error {
+	record, err := ReadStatus(ctx, blobStore, key)
+	if err != nil || record == nil {
+		return err
+	}
+	record.Status = status
+	record.Error = ""
+	if statusErr != nil {
+		record.Error = statusErr.Error()
+	}
+	return WriteStatus(ctx, blobStore, *record)
+}
@@ -219,6 +219,16 @@ func Test_GuessDocument(t *testing.T) {
 		},
 		expectedType:   processor.DocumentCsaf,
 		expectedFormat: processor.FormatJSON,
+	}, {
+		name: "valid CSAF security advisory",
+		document: &processor.Document{
+			Blob:              testdata.CsafSecurityAdvisory,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectedType:   processor.DocumentCsaf,
+		expectedFormat: processor.FormatJSON,
 	},
 		{
 			name: "valid Extended vex Document",
@@ -230,6 +240,17 @@ func Test_GuessDocument(t *testing.T) {
 			},
 			expectedType:   processor.DocumentExtendedVEX,
 			expectedFormat: processor.FormatJSON,
+		},
+		{
+			name: "valid OSV Document",
+			document: &processor.Document{
+				Blob:              testdata.OSVText4ShellExample,
+				Type:              processor.DocumentUnknown,
+				Format:            processor.FormatUnknown,
+				SourceInformation: processor.SourceInformation{},
+			},
+			expectedType:   processor.DocumentOSV,
+			expectedFormat: processor.FormatJSON,
 		}}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,16 +17,26 @@ package guesser
 
 import (
 	"github.com/guacsec/guac/pkg/handler/processor"
-	"github.com/openvex/go-vex/pkg/csaf"
 )
 
 type csafTypeGuesser struct{}
 
+// csafDocument is the part of a CSAF document the guesser decodes, so that
+// the product identification helpers the go-vex CSAF model does not support,
+// such as hashes, do not fail the guess.
+type csafDocument struct {
+	Document struct {
+		Tracking struct {
+			ID string `json:"id"`
+		} `json:"tracking"`
+	} `json:"document"`
+}
+
 func (_ *csafTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
 	switch format {
 	case processor.FormatJSON:
 		// Decode the BOM
-		var decoded csaf.CSAF
+		var decoded csafDocument
 		err := json.Unmarshal(blob, &decoded)
 		if err == nil && decoded.Document.Tracking.ID != "" {
 			return processor.DocumentCsaf
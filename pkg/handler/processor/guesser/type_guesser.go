@@ -31,6 +31,7 @@ func init() {
 	_ = RegisterDocumentTypeGuesser(&csafTypeGuesser{}, "csaf")
 	_ = RegisterDocumentTypeGuesser(&eVexTypeGuesser{}, "evex")  // Register ExtendedVEX before OpenVEX
 	_ = RegisterDocumentTypeGuesser(&openVexTypeGuesser{}, "openvex")
+	_ = RegisterDocumentTypeGuesser(&osvTypeGuesser{}, "osv")
 }
 
 // DocumentTypeGuesser guesses the document type based on the blob and format given
@@ -35,6 +35,8 @@ func (_ *ite6TypeGuesser) GuessDocumentType(blob []byte, format processor.Format
 		if strings.HasPrefix(statement.Type, "https://in-toto.io/Statement") {
 			if strings.HasPrefix(statement.PredicateType, "https://slsa.dev/provenance") {
 				return processor.DocumentITE6SLSA
+			} else if strings.HasPrefix(statement.PredicateType, "https://slsa.dev/verification_summary") {
+				return processor.DocumentITE6VSA
 			} else if strings.HasPrefix(statement.PredicateType, "https://crev.dev/in-toto-scheme") {
 				return processor.DocumentITE6Generic
 			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/certify/v0.1") {
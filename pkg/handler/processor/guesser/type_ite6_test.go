@@ -43,6 +43,10 @@ func Test_Ite6TypeGuesser(t *testing.T) {
 		name:     "valid SLSA ITE6 Document with different versions",
 		blob:     []byte(`{"_type": "https://in-toto.io/Statement/v1.1", "predicateType": "https://slsa.dev/provenance/v1.0"}`),
 		expected: processor.DocumentITE6SLSA,
+	}, {
+		name:     "valid VSA ITE6 Document",
+		blob:     testdata.ITE6VSAExample,
+		expected: processor.DocumentITE6VSA,
 	}, {
 		name:     "valid CREV ITE6 Document",
 		blob:     testdata.ITE6CREVExample,
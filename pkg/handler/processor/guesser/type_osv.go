@@ -0,0 +1,45 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+type osvTypeGuesser struct{}
+
+// osvRecord holds the fields telling an OSV record apart: the id and modified
+// fields are required, and a record describes affected packages or aliases.
+type osvRecord struct {
+	SchemaVersion string   `json:"schema_version"`
+	ID            string   `json:"id"`
+	Modified      string   `json:"modified"`
+	Aliases       []string `json:"aliases"`
+	Affected      []any    `json:"affected"`
+}
+
+func (_ *osvTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
+	switch format {
+	case processor.FormatJSON:
+		var decoded osvRecord
+		err := json.Unmarshal(blob, &decoded)
+		if err == nil && decoded.ID != "" && decoded.Modified != "" &&
+			(decoded.SchemaVersion != "" || len(decoded.Affected) > 0 || len(decoded.Aliases) > 0) {
+			return processor.DocumentOSV
+		}
+	}
+	return processor.DocumentUnknown
+}
@@ -0,0 +1,57 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"fmt"
+
+	osv_models "github.com/google/osv-scanner/pkg/models"
+	json "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// OSVProcessor processes the raw OSV records, such as the records of the
+// osv.dev database exports.
+type OSVProcessor struct{}
+
+func (p *OSVProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentOSV {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentOSV, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON:
+		var decoded osv_models.Vulnerability
+		if err := json.Unmarshal(d.Blob, &decoded); err != nil {
+			return err
+		}
+		if decoded.ID == "" {
+			return fmt.Errorf("OSV record has no id")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unable to support parsing of OSV document format: %v", d.Format)
+}
+
+func (p *OSVProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentOSV {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentOSV, d.Type)
+	}
+
+	return []*processor.Document{}, nil
+}
@@ -0,0 +1,144 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestOSVProcessor_ValidateSchema(t *testing.T) {
+	type args struct {
+		d *processor.Document
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "OSV record",
+			args: args{
+				d: &processor.Document{
+					Blob:   testdata.OSVText4ShellExample,
+					Type:   processor.DocumentOSV,
+					Format: processor.FormatJSON,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OSV record without id",
+			args: args{
+				d: &processor.Document{
+					Blob:   []byte(`{"modified":"2024-02-16T08:17:13Z","aliases":["CVE-2022-42889"]}`),
+					Type:   processor.DocumentOSV,
+					Format: processor.FormatJSON,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "incorrect type",
+			args: args{
+				d: &processor.Document{
+					Blob:   testdata.OSVText4ShellExample,
+					Type:   processor.DocumentUnknown,
+					Format: processor.FormatJSON,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid OSV record",
+			args: args{
+				d: &processor.Document{
+					Blob:   []byte("invalid"),
+					Type:   processor.DocumentOSV,
+					Format: processor.FormatJSON,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid OSV document format",
+			args: args{
+				d: &processor.Document{
+					Blob:   testdata.OSVText4ShellExample,
+					Type:   processor.DocumentOSV,
+					Format: processor.FormatUnknown,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &OSVProcessor{}
+			if err := p.ValidateSchema(tt.args.d); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOSVProcessor_Unpack(t *testing.T) {
+	type args struct {
+		d *processor.Document
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*processor.Document
+		wantErr bool
+	}{
+		{
+			name: "OSV record",
+			args: args{
+				d: &processor.Document{
+					Type: processor.DocumentOSV,
+				},
+			},
+			want: []*processor.Document{},
+		},
+		{
+			name: "Incorrect type",
+			args: args{
+				d: &processor.Document{
+					Type: processor.DocumentUnknown,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &OSVProcessor{}
+			got, err := p.Unpack(tt.args.d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unpack() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unpack() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
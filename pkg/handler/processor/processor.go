@@ -60,6 +60,7 @@ const (
 	DocumentITE6Generic DocumentType = "ITE6"
 	DocumentITE6Vul     DocumentType = "ITE6VUL"
 	DocumentITE6EOL     DocumentType = "ITE6EOL"
+	DocumentITE6VSA     DocumentType = "ITE6VSA"
 	// ClearlyDefined
 	DocumentITE6ClearlyDefined DocumentType = "ITE6CD"
 	DocumentDSSE               DocumentType = "DSSE"
@@ -72,6 +73,7 @@ const (
 	DocumentOpenVEX            DocumentType = "OPEN_VEX"
 	DocumentExtendedVEX        DocumentType = "EXTENDED_VEX"
 	DocumentIngestPredicates   DocumentType = "INGEST_PREDICATES"
+	DocumentOSV                DocumentType = "OSV"
 	DocumentUnknown            DocumentType = "UNKNOWN"
 )
 
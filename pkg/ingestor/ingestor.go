@@ -66,7 +66,7 @@ func Ingest(
 		return nil, fmt.Errorf("unable to ingest doc tree: %v", err)
 	}
 
-	if err := collectSubEmitFunc(idstrings); err != nil {
+	if err := collectSubEmitFunc(idstrings, d.SourceInformation.Source); err != nil {
 		logger.Infof("unable to create entries in collectsub server, but continuing: %v", err)
 	}
 
@@ -132,6 +132,8 @@ func MergedIngest(
 			predicates[0].VulnMetadata = append(predicates[0].VulnMetadata, preds[i].VulnMetadata...)
 			predicates[0].HasMetadata = append(predicates[0].HasMetadata, preds[i].HasMetadata...)
 			predicates[0].CertifyLegal = append(predicates[0].CertifyLegal, preds[i].CertifyLegal...)
+			predicates[0].CertifyPolicy = append(predicates[0].CertifyPolicy, preds[i].CertifyPolicy...)
+			predicates[0].VulnerabilityRange = append(predicates[0].VulnerabilityRange, preds[i].VulnerabilityRange...)
 			totalPredicates += 1
 			// enough predicates have been collected, worth sending them to GraphQL server
 			if totalPredicates == 5000 {
@@ -147,7 +149,8 @@ func MergedIngest(
 		idstrings = append(idstrings, idstrs...)
 	}
 
-	err := collectSubEmitFunc(idstrings)
+	// the identifiers of merged documents are not traced to their document
+	err := collectSubEmitFunc(idstrings, "")
 	if err != nil {
 		logger.Infof("unable to create entries in collectsub server, but continuing: %v", err)
 	}
@@ -186,10 +189,15 @@ func GetAssembler(
 	return helpers.GetBulkAssembler(ctx, childLogger, gqlclient)
 }
 
-func GetCollectSubEmit(ctx context.Context, csubClient csub_client.Client) func([]*parser_common.IdentifierStrings) error {
-	return func(idstrings []*parser_common.IdentifierStrings) error {
+// GetCollectSubEmit returns a function adding the identifiers found in a
+// document to the collect subscriber, recording the document as their source.
+func GetCollectSubEmit(ctx context.Context, csubClient csub_client.Client) func([]*parser_common.IdentifierStrings, string) error {
+	return func(idstrings []*parser_common.IdentifierStrings, sourceDocument string) error {
 		if csubClient != nil {
 			entries := input.IdentifierStringsSliceToCollectEntries(idstrings)
+			for _, e := range entries {
+				e.SourceDocument = sourceDocument
+			}
 			if len(entries) > 0 {
 				if err := csubClient.AddCollectEntries(ctx, entries); err != nil {
 					return fmt.Errorf("unable to add collect entries: %v", err)
@@ -0,0 +1,41 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// GetCPEPkgEqual links the package to the guac package identified by the
+// given CPE (see helpers.CpeToPurl), so that vulnerability data keyed on the
+// CPE also reaches the package.
+func GetCPEPkgEqual(pkg *model.PkgInputSpec, cpe string, justification string) (*assembler.PkgEqualIngest, error) {
+	cpePkg, err := helpers.CpeToPkg(cpe)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cpe %q: %w", cpe, err)
+	}
+	return &assembler.PkgEqualIngest{
+		Pkg:      pkg,
+		EqualPkg: cpePkg,
+		PkgEqual: &model.PkgEqualInputSpec{
+			Justification: justification,
+		},
+	}, nil
+}
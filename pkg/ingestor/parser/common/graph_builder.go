@@ -81,6 +81,18 @@ func AddMetadata(predicates *assembler.IngestPredicates, foundIdentities []Trust
 		v.CertifyLegal.DocumentRef = srcInfo.DocumentRef
 	}
 
+	for _, v := range predicates.CertifyPolicy {
+		v.CertifyPolicy.Collector = srcInfo.Collector
+		v.CertifyPolicy.Origin = srcInfo.Source
+		v.CertifyPolicy.DocumentRef = srcInfo.DocumentRef
+	}
+
+	for _, v := range predicates.VulnerabilityRange {
+		v.VulnerabilityRange.Collector = srcInfo.Collector
+		v.VulnerabilityRange.Origin = srcInfo.Source
+		v.VulnerabilityRange.DocumentRef = srcInfo.DocumentRef
+	}
+
 	for _, v := range predicates.HashEqual {
 		v.HashEqual.Collector = srcInfo.Collector
 		v.HashEqual.Origin = srcInfo.Source
@@ -0,0 +1,427 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	// categorySecurityAdvisory is the document category of the CSAF
+	// security advisory profile.
+	categorySecurityAdvisory = "csaf_security_advisory"
+
+	// branchVersionRange is the category of the product tree branches
+	// naming a range of versions, in vers or vls.
+	branchVersionRange = "product_version_range"
+
+	// maxRelationshipDepth bounds the product references followed through
+	// the relationships of a product tree.
+	maxRelationshipDepth = 8
+)
+
+// affectedStatuses are the product statuses of a vulnerability for the
+// products it affects. The products under investigation are neither known to
+// be affected nor not to be, so they are left out.
+var affectedStatuses = []string{"known_affected", "first_affected", "last_affected"}
+
+// unaffectedStatuses are the product statuses of a vulnerability for the
+// products it does not affect.
+var unaffectedStatuses = []string{"known_not_affected", "fixed", "first_fixed"}
+
+// advisory holds the parts of a CSAF 2.0 document the go-vex CSAF model
+// leaves out: the document category, the product version ranges, the full
+// product names and groups of the product tree, and the scores of the
+// vulnerabilities.
+type advisory struct {
+	Document struct {
+		Category string `json:"category"`
+		Tracking struct {
+			ID                 string    `json:"id"`
+			CurrentReleaseDate time.Time `json:"current_release_date"`
+		} `json:"tracking"`
+	} `json:"document"`
+	ProductTree struct {
+		Branches         []advisoryBranch  `json:"branches"`
+		FullProductNames []advisoryProduct `json:"full_product_names"`
+		ProductGroups    []struct {
+			GroupID    string   `json:"group_id"`
+			ProductIDs []string `json:"product_ids"`
+		} `json:"product_groups"`
+		Relationships []struct {
+			FullProductName advisoryProduct `json:"full_product_name"`
+			ProductRef      string          `json:"product_reference"`
+		} `json:"relationships"`
+	} `json:"product_tree"`
+	Vulnerabilities []advisoryVulnerability `json:"vulnerabilities"`
+}
+
+type advisoryBranch struct {
+	Category string           `json:"category"`
+	Name     string           `json:"name"`
+	Branches []advisoryBranch `json:"branches"`
+	Product  *advisoryProduct `json:"product"`
+}
+
+type advisoryProduct struct {
+	Name                 string `json:"name"`
+	ID                   string `json:"product_id"`
+	IdentificationHelper struct {
+		CPE  string `json:"cpe"`
+		Purl string `json:"purl"`
+	} `json:"product_identification_helper"`
+}
+
+type advisoryVulnerability struct {
+	CVE string `json:"cve"`
+	IDs []struct {
+		SystemName string `json:"system_name"`
+		Text       string `json:"text"`
+	} `json:"ids"`
+	ProductStatus map[string][]string `json:"product_status"`
+	Remediations  []struct {
+		Category   string   `json:"category"`
+		Details    string   `json:"details"`
+		URL        string   `json:"url"`
+		ProductIDs []string `json:"product_ids"`
+		GroupIDs   []string `json:"group_ids"`
+	} `json:"remediations"`
+	Threats []struct {
+		Category   string   `json:"category"`
+		Details    string   `json:"details"`
+		ProductIDs []string `json:"product_ids"`
+		GroupIDs   []string `json:"group_ids"`
+	} `json:"threats"`
+	Scores []struct {
+		CVSSV2   *advisoryCVSS `json:"cvss_v2"`
+		CVSSV3   *advisoryCVSS `json:"cvss_v3"`
+		Products []string      `json:"products"`
+	} `json:"scores"`
+}
+
+type advisoryCVSS struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+}
+
+// product is a product of the product tree resolved to the package it
+// identifies.
+type product struct {
+	pkg *generated.PkgInputSpec
+	// cpe is the CPE identifying the product, if any.
+	cpe string
+	// versionRange is the range of versions of the package the product
+	// names, if it is a product version range.
+	versionRange string
+}
+
+// indexProducts resolves the products of the product tree of the advisory by
+// product ID. The products without a purl or CPE identification helper are
+// left out.
+func (a *advisory) indexProducts(ctx context.Context) map[string]*product {
+	logger := logging.FromContext(ctx)
+	products := map[string]*product{}
+	add := func(p advisoryProduct, versionRange string) {
+		if p.ID == "" {
+			return
+		}
+		resolved, err := resolveProduct(p, versionRange)
+		if err != nil {
+			logger.Debugf("[csaf] skipping product %s: %v", p.ID, err)
+			return
+		}
+		products[p.ID] = resolved
+	}
+
+	var walk func(branches []advisoryBranch)
+	walk = func(branches []advisoryBranch) {
+		for _, b := range branches {
+			if b.Product != nil {
+				versionRange := ""
+				if b.Category == branchVersionRange {
+					versionRange = b.Name
+				}
+				add(*b.Product, versionRange)
+			}
+			walk(b.Branches)
+		}
+	}
+	walk(a.ProductTree.Branches)
+	for _, p := range a.ProductTree.FullProductNames {
+		add(p, "")
+	}
+
+	// a relationship names a product made of the product it references, a
+	// component of another product, and identifies it through the
+	// component when it has no identification helper of its own
+	refs := map[string]string{}
+	for _, r := range a.ProductTree.Relationships {
+		add(r.FullProductName, "")
+		refs[r.FullProductName.ID] = r.ProductRef
+	}
+	for id := range refs {
+		if products[id] != nil {
+			continue
+		}
+		ref := refs[id]
+		for i := 0; i < maxRelationshipDepth && products[ref] == nil && refs[ref] != ""; i++ {
+			ref = refs[ref]
+		}
+		if p := products[ref]; p != nil {
+			products[id] = p
+		}
+	}
+	return products
+}
+
+// resolveProduct resolves a product to the package identified by its purl,
+// or else its CPE. The package of a product version range is the package
+// without a version.
+func resolveProduct(p advisoryProduct, versionRange string) (*product, error) {
+	var pkg *generated.PkgInputSpec
+	var err error
+	switch {
+	case p.IdentificationHelper.Purl != "":
+		pkg, err = helpers.PurlToPkg(p.IdentificationHelper.Purl)
+	case p.IdentificationHelper.CPE != "":
+		pkg, err = helpers.CpeToPkg(p.IdentificationHelper.CPE)
+	default:
+		return nil, fmt.Errorf("no purl or cpe identification helper")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if versionRange != "" {
+		pkg.Version = nil
+	}
+	return &product{pkg: pkg, cpe: p.IdentificationHelper.CPE, versionRange: versionRange}, nil
+}
+
+// groupProducts returns the IDs of the products of the groups of the
+// advisory.
+func (a *advisory) groupProducts(productIDs, groupIDs []string) []string {
+	ids := slices.Clone(productIDs)
+	for _, g := range a.ProductTree.ProductGroups {
+		if slices.Contains(groupIDs, g.GroupID) {
+			ids = append(ids, g.ProductIDs...)
+		}
+	}
+	return ids
+}
+
+// vulnerabilityID returns the ID of a vulnerability of the advisory: its CVE,
+// or else the first of its IDs.
+func (v *advisoryVulnerability) vulnerabilityID() string {
+	if v.CVE != "" {
+		return v.CVE
+	}
+	for _, id := range v.IDs {
+		if id.Text != "" {
+			return id.Text
+		}
+	}
+	return ""
+}
+
+// getAdvisoryPredicates generates the predicates of a CSAF security
+// advisory:
+//
+// - CertifyVulns between each vulnerability and the versions of the packages
+// it does or does not affect, as the product statuses list them.
+//
+// - VulnerabilityRanges between each vulnerability and the packages of the
+// product version ranges it affects.
+//
+// - VulnerabilityMetadata for each CVSS score of each vulnerability.
+//
+// - HasMetadata of the packages for their CPEs, and for the remediations and
+// threats of the vulnerabilities.
+func (c *csafParser) getAdvisoryPredicates(ctx context.Context) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	rv := &assembler.IngestPredicates{}
+	timestamp := c.advisory.Document.Tracking.CurrentReleaseDate
+	products := c.advisory.indexProducts(ctx)
+
+	cpes := map[string]bool{}
+	addCPE := func(p *product) {
+		if p.cpe == "" || cpes[p.cpe] {
+			return
+		}
+		cpes[p.cpe] = true
+		rv.HasMetadata = append(rv.HasMetadata, assembler.HasMetadataIngest{
+			Pkg:          p.pkg,
+			PkgMatchFlag: pkgMatchFlag(p),
+			HasMetadata: &generated.HasMetadataInputSpec{
+				Key:           "cpe",
+				Value:         p.cpe,
+				Timestamp:     timestamp,
+				Justification: "csaf cpe product identification helper",
+			},
+		})
+	}
+
+	for _, v := range c.advisory.Vulnerabilities {
+		id := v.vulnerabilityID()
+		vuln, err := helpers.CreateVulnInput(id)
+		if err != nil {
+			logger.Warnf("[csaf] skipping vulnerability %q: %v", id, err)
+			continue
+		}
+
+		scores := map[generated.VulnerabilityMetadataInputSpec]bool{}
+		for _, s := range v.Scores {
+			for _, cvss := range []*advisoryCVSS{s.CVSSV2, s.CVSSV3} {
+				if cvss == nil {
+					continue
+				}
+				md := generated.VulnerabilityMetadataInputSpec{
+					ScoreType:  cvssScoreType(cvss),
+					ScoreValue: cvss.BaseScore,
+					Timestamp:  timestamp,
+				}
+				if scores[md] {
+					continue
+				}
+				scores[md] = true
+				rv.VulnMetadata = append(rv.VulnMetadata, assembler.VulnMetadataIngest{
+					Vulnerability: vuln,
+					VulnMetadata:  &md,
+				})
+			}
+		}
+
+		var affected []string
+		for _, status := range append(slices.Clone(affectedStatuses), unaffectedStatuses...) {
+			isAffected := slices.Contains(affectedStatuses, status)
+			for _, pid := range v.ProductStatus[status] {
+				p := products[pid]
+				if p == nil {
+					logger.Warnf("[csaf] unable to locate package for product %s", pid)
+					continue
+				}
+				addCPE(p)
+				if isAffected {
+					affected = append(affected, pid)
+				}
+
+				if p.versionRange != "" {
+					// vulnerability ranges only record affected
+					// versions
+					if !isAffected {
+						continue
+					}
+					for _, vr := range versRanges(p.versionRange) {
+						if vr.introducedExcluded {
+							logger.Warnf("[csaf] skipping range of product %s starting after %s, only inclusive lower bounds are recorded", pid, vr.introduced)
+							continue
+						}
+						rv.VulnerabilityRange = append(rv.VulnerabilityRange, assembler.VulnerabilityRangeIngest{
+							Pkg:           p.pkg,
+							Vulnerability: vuln,
+							VulnerabilityRange: &generated.VulnerabilityRangeInputSpec{
+								RangeType:    vr.rangeType,
+								Introduced:   vr.introduced,
+								Fixed:        vr.fixed,
+								LastAffected: vr.lastAffected,
+							},
+						})
+					}
+					continue
+				}
+				if p.pkg.Version == nil || *p.pkg.Version == "" {
+					logger.Debugf("[csaf] skipping product %s without a version", pid)
+					continue
+				}
+
+				cv := assembler.CertifyVulnIngest{
+					Pkg:           p.pkg,
+					Vulnerability: vuln,
+					VulnData:      &generated.ScanMetadataInput{TimeScanned: timestamp},
+				}
+				if !isAffected {
+					cv.Vulnerability = &generated.VulnerabilityInputSpec{Type: "NoVuln"}
+				}
+				rv.CertifyVuln = append(rv.CertifyVuln, cv)
+			}
+		}
+
+		addMetadata := func(key, value string, productIDs []string) {
+			for _, pid := range productIDs {
+				p := products[pid]
+				if p == nil {
+					continue
+				}
+				rv.HasMetadata = append(rv.HasMetadata, assembler.HasMetadataIngest{
+					Pkg:          p.pkg,
+					PkgMatchFlag: pkgMatchFlag(p),
+					HasMetadata: &generated.HasMetadataInputSpec{
+						Key:           key,
+						Value:         value,
+						Timestamp:     timestamp,
+						Justification: "csaf " + id,
+					},
+				})
+			}
+		}
+		for _, r := range v.Remediations {
+			value := r.Details
+			if r.URL != "" {
+				value = strings.TrimSpace(value + " " + r.URL)
+			}
+			addMetadata("csaf:remediation:"+r.Category, value, c.advisory.groupProducts(r.ProductIDs, r.GroupIDs))
+		}
+		for _, t := range v.Threats {
+			ids := c.advisory.groupProducts(t.ProductIDs, t.GroupIDs)
+			if len(ids) == 0 {
+				// a threat without products applies to all the
+				// affected products
+				ids = affected
+			}
+			addMetadata("csaf:threat:"+t.Category, t.Details, ids)
+		}
+	}
+	return rv
+}
+
+// pkgMatchFlag matches the metadata of a product to the version of its
+// package, or to all the versions of a product version range.
+func pkgMatchFlag(p *product) generated.MatchFlags {
+	if p.versionRange != "" || p.pkg.Version == nil || *p.pkg.Version == "" {
+		return generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions}
+	}
+	return generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion}
+}
+
+// cvssScoreType returns the score type of a CVSS score of the advisory.
+func cvssScoreType(cvss *advisoryCVSS) generated.VulnerabilityScoreType {
+	switch {
+	case cvss.Version == "2.0" || strings.HasPrefix(cvss.VectorString, "AV:"):
+		return generated.VulnerabilityScoreTypeCvssv2
+	case cvss.Version == "3.1" || strings.HasPrefix(cvss.VectorString, "CVSS:3.1/"):
+		return generated.VulnerabilityScoreTypeCvssv31
+	}
+	return generated.VulnerabilityScoreTypeCvssv3
+}
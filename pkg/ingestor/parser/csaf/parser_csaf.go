@@ -59,6 +59,8 @@ type csafParser struct {
 	identifierStrings *common.IdentifierStrings
 
 	csaf *csaf.CSAF
+	// advisory is the document when it is a CSAF security advisory
+	advisory *advisory
 }
 
 type visitedProductRef struct {
@@ -79,12 +81,22 @@ func (c *csafParser) initializeCSAFParser() {
 	c.doc = nil
 	c.identifierStrings = &common.IdentifierStrings{}
 	c.csaf = nil
+	c.advisory = nil
 }
 
 // Parse breaks out the document into the graph components
 func (c *csafParser) Parse(ctx context.Context, doc *processor.Document) error {
 	c.initializeCSAFParser()
 	c.doc = doc
+	var a advisory
+	if err := json.Unmarshal(doc.Blob, &a); err != nil {
+		return fmt.Errorf("failed to parse CSAF: %w", err)
+	}
+	if a.Document.Category == categorySecurityAdvisory {
+		c.advisory = &a
+		return nil
+	}
+
 	err := json.Unmarshal(doc.Blob, &c.csaf)
 	if err != nil {
 		return fmt.Errorf("failed to parse CSAF: %w", err)
@@ -280,10 +292,15 @@ func (c *csafParser) generateVexIngest(ctx context.Context, vulnInput *generated
 }
 
 // GetPredicates generates the VEX and CertifyVuln predicates for the CSAF document.
+// The predicates of a CSAF security advisory are generated by
+// getAdvisoryPredicates instead.
 //
 // It returns a pointer to an assembler.IngestPredicates struct containing the
 // generated VEX and CertifyVuln predicates.
 func (c *csafParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	if c.advisory != nil {
+		return c.getAdvisoryPredicates(ctx)
+	}
 	rv := &assembler.IngestPredicates{}
 	var vis []assembler.VexIngest
 	var cvs []assembler.CertifyVulnIngest
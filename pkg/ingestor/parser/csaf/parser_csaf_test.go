@@ -19,6 +19,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 
@@ -27,6 +28,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -54,6 +57,19 @@ func Test_csafParser(t *testing.T) {
 			CertifyVuln: testdata.CsafCertifyVulnIngest,
 		},
 		wantErr: false,
+	}, {
+		name: "CSAF security advisory",
+		doc: &processor.Document{
+			Blob:   testdata.CsafSecurityAdvisory,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCsaf,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: securityAdvisoryPredicates(t),
+		wantErr:        false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -75,6 +91,84 @@ func Test_csafParser(t *testing.T) {
 	}
 }
 
+// securityAdvisoryPredicates are the predicates of the
+// csaf-security-advisory.json example.
+func securityAdvisoryPredicates(t *testing.T) *assembler.IngestPredicates {
+	mustPkg := func(p string) *generated.PkgInputSpec {
+		pkg, err := helpers.PurlToPkg(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pkg
+	}
+	widget := mustPkg("pkg:npm/%40acme/widget")
+	widget.Version = nil
+	widgetFixed := mustPkg("pkg:npm/%40acme/widget@1.4.2")
+	gateway := mustPkg("pkg:guac/cpe/acme/gateway@3.1")
+
+	released := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	vuln := &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2026-1234"}
+	allVersions := generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions}
+	specificVersion := generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion}
+	metadata := func(pkg *generated.PkgInputSpec, flag generated.MatchFlags, key, value, justification string) assembler.HasMetadataIngest {
+		return assembler.HasMetadataIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: flag,
+			HasMetadata: &generated.HasMetadataInputSpec{
+				Key:           key,
+				Value:         value,
+				Timestamp:     released,
+				Justification: justification,
+			},
+		}
+	}
+
+	return &assembler.IngestPredicates{
+		CertifyVuln: []assembler.CertifyVulnIngest{{
+			Pkg:           gateway,
+			Vulnerability: vuln,
+			VulnData:      &generated.ScanMetadataInput{TimeScanned: released},
+		}, {
+			Pkg:           widgetFixed,
+			Vulnerability: &generated.VulnerabilityInputSpec{Type: "NoVuln"},
+			VulnData:      &generated.ScanMetadataInput{TimeScanned: released},
+		}},
+		VulnerabilityRange: []assembler.VulnerabilityRangeIngest{{
+			Pkg:           widget,
+			Vulnerability: vuln,
+			VulnerabilityRange: &generated.VulnerabilityRangeInputSpec{
+				RangeType:  generated.VulnerabilityRangeTypeSemver,
+				Introduced: "1.0.0",
+				Fixed:      "1.4.2",
+			},
+		}},
+		VulnMetadata: []assembler.VulnMetadataIngest{{
+			Vulnerability: vuln,
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv2,
+				ScoreValue: 7.5,
+				Timestamp:  released,
+			},
+		}, {
+			Vulnerability: vuln,
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
+				ScoreValue: 9.8,
+				Timestamp:  released,
+			},
+		}},
+		HasMetadata: []assembler.HasMetadataIngest{
+			metadata(gateway, specificVersion, "cpe", "cpe:2.3:a:acme:gateway:3.1:*:*:*:*:*:*:*", "csaf cpe product identification helper"),
+			metadata(widget, allVersions, "csaf:remediation:vendor_fix", "Upgrade to Acme Widget 1.4.2. https://acme.example/advisories/ACME-SA-2026-001", "csaf CVE-2026-1234"),
+			metadata(widget, allVersions, "csaf:remediation:workaround", "Disable the plugin loader.", "csaf CVE-2026-1234"),
+			metadata(gateway, specificVersion, "csaf:remediation:workaround", "Disable the plugin loader.", "csaf CVE-2026-1234"),
+			metadata(widget, allVersions, "csaf:threat:impact", "Remote code execution.", "csaf CVE-2026-1234"),
+			metadata(widget, allVersions, "csaf:threat:exploit_status", "No known exploitation.", "csaf CVE-2026-1234"),
+			metadata(gateway, specificVersion, "csaf:threat:exploit_status", "No known exploitation.", "csaf CVE-2026-1234"),
+		},
+	}
+}
+
 func Test_findProductRef(t *testing.T) {
 	defaultTestTree := csaf.ProductBranch{
 		Name: "node1",
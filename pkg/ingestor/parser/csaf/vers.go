@@ -0,0 +1,106 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// versionRange is an interval of affected versions of a product version
+// range.
+type versionRange struct {
+	rangeType    generated.VulnerabilityRangeType
+	introduced   string
+	fixed        string
+	lastAffected string
+	// introducedExcluded is set when the interval starts after the
+	// introduced version, which the vulnerability ranges can't record.
+	introducedExcluded bool
+}
+
+// versRanges converts the name of a product version range, either a vers
+// such as vers:semver/>=1.2.0|<1.2.5 or a vls such as <=4.2, into the
+// intervals of versions it spans. The constraints are expected in the
+// ascending order of their versions, as vers requires; the exclusions (!=)
+// are left out.
+func versRanges(name string) []versionRange {
+	rangeType := generated.VulnerabilityRangeTypeEcosystem
+	constraints := strings.TrimSpace(name)
+	if rest, ok := strings.CutPrefix(constraints, "vers:"); ok {
+		scheme, c, _ := strings.Cut(rest, "/")
+		if scheme == "semver" {
+			rangeType = generated.VulnerabilityRangeTypeSemver
+		}
+		constraints = c
+	}
+	if constraints == "" {
+		return nil
+	}
+	if constraints == "*" {
+		return []versionRange{{rangeType: rangeType, introduced: "0"}}
+	}
+
+	var ranges []versionRange
+	var open *versionRange
+	for _, c := range strings.Split(constraints, "|") {
+		op, version := splitConstraint(c)
+		if version == "" {
+			continue
+		}
+		switch op {
+		case ">=", ">":
+			if open != nil {
+				ranges = append(ranges, *open)
+			}
+			open = &versionRange{rangeType: rangeType, introduced: version, introducedExcluded: op == ">"}
+		case "<", "<=":
+			if open == nil {
+				open = &versionRange{rangeType: rangeType, introduced: "0"}
+			}
+			if op == "<" {
+				open.fixed = version
+			} else {
+				open.lastAffected = version
+			}
+			ranges = append(ranges, *open)
+			open = nil
+		case "=":
+			if open != nil {
+				ranges = append(ranges, *open)
+				open = nil
+			}
+			ranges = append(ranges, versionRange{rangeType: rangeType, introduced: version, lastAffected: version})
+		}
+	}
+	if open != nil {
+		ranges = append(ranges, *open)
+	}
+	return ranges
+}
+
+// splitConstraint splits a vers or vls constraint into its comparator, = if
+// it has none, and its version.
+func splitConstraint(c string) (string, string) {
+	c = strings.TrimSpace(c)
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+		if v, ok := strings.CutPrefix(c, op); ok {
+			return op, strings.TrimSpace(v)
+		}
+	}
+	return "=", c
+}
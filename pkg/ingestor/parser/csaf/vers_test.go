@@ -0,0 +1,68 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func Test_versRanges(t *testing.T) {
+	semver := generated.VulnerabilityRangeTypeSemver
+	ecosystem := generated.VulnerabilityRangeTypeEcosystem
+	tests := []struct {
+		name string
+		want []versionRange
+	}{{
+		name: "vers:semver/>=1.0.0|<1.4.2",
+		want: []versionRange{{rangeType: semver, introduced: "1.0.0", fixed: "1.4.2"}},
+	}, {
+		name: "vers:npm/<1.2.0|>=2.0.0|<=2.3.1|>=3.0.0",
+		want: []versionRange{
+			{rangeType: ecosystem, introduced: "0", fixed: "1.2.0"},
+			{rangeType: ecosystem, introduced: "2.0.0", lastAffected: "2.3.1"},
+			{rangeType: ecosystem, introduced: "3.0.0"},
+		},
+	}, {
+		name: "vers:deb/1.0.0|!=1.0.1|1.0.2",
+		want: []versionRange{
+			{rangeType: ecosystem, introduced: "1.0.0", lastAffected: "1.0.0"},
+			{rangeType: ecosystem, introduced: "1.0.2", lastAffected: "1.0.2"},
+		},
+	}, {
+		name: "vers:semver/>1.0.0|<=1.2.0",
+		want: []versionRange{{rangeType: semver, introduced: "1.0.0", lastAffected: "1.2.0", introducedExcluded: true}},
+	}, {
+		name: "vers:semver/*",
+		want: []versionRange{{rangeType: semver, introduced: "0"}},
+	}, {
+		name: "<= 4.2",
+		want: []versionRange{{rangeType: ecosystem, introduced: "0", lastAffected: "4.2"}},
+	}, {
+		name: "vers:semver/",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := versRanges(tt.name)
+			if d := cmp.Diff(tt.want, got, cmp.AllowUnexported(versionRange{})); d != "" {
+				t.Errorf("versRanges() mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
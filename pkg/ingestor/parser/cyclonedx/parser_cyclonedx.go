@@ -56,6 +56,7 @@ type cyclonedxParser struct {
 	packagePackages   map[string][]*model.PkgInputSpec
 	packageArtifacts  map[string][]*model.ArtifactInputSpec
 	packageLegals     map[string][]*model.CertifyLegalInputSpec
+	packageCPEs       map[string][]string
 	licenseInLine     map[string]string
 	identifierStrings *common.IdentifierStrings
 	cdxBom            *cdx.BOM
@@ -76,6 +77,7 @@ func NewCycloneDXParser() common.DocumentParser {
 		packagePackages:   map[string][]*model.PkgInputSpec{},
 		packageArtifacts:  map[string][]*model.ArtifactInputSpec{},
 		packageLegals:     map[string][]*model.CertifyLegalInputSpec{},
+		packageCPEs:       map[string][]string{},
 		licenseInLine:     map[string]string{},
 		identifierStrings: &common.IdentifierStrings{},
 	}
@@ -87,6 +89,7 @@ func (c *cyclonedxParser) initializeCDXParser() {
 	c.packagePackages = map[string][]*model.PkgInputSpec{}
 	c.packageArtifacts = map[string][]*model.ArtifactInputSpec{}
 	c.packageLegals = map[string][]*model.CertifyLegalInputSpec{}
+	c.packageCPEs = map[string][]string{}
 	c.licenseInLine = map[string]string{}
 	c.identifierStrings = &common.IdentifierStrings{}
 	c.cdxBom = nil
@@ -252,6 +255,9 @@ func traverseComponents(c cyclonedxParser, components *[]cdx.Component) error {
 					c.packageArtifacts[comp.BOMRef] = append(c.packageArtifacts[comp.BOMRef], artifact)
 				}
 			}
+			if comp.CPE != "" {
+				c.packageCPEs[comp.BOMRef] = append(c.packageCPEs[comp.BOMRef], comp.CPE)
+			}
 			// get other component packages
 			if err := c.getLicenseInformation(comp); err != nil {
 				return fmt.Errorf("failed to get license information for component package with error: %w", err)
@@ -439,6 +445,29 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 		}
 	}
 
+	for id, cpes := range c.packageCPEs {
+		for _, cpe := range cpes {
+			for _, pkg := range c.packagePackages[id] {
+				preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+					Pkg:          pkg,
+					PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+					HasMetadata: &model.HasMetadataInputSpec{
+						Key:           "cpe",
+						Value:         cpe,
+						Timestamp:     c.timestamp,
+						Justification: "cdx component cpe",
+					},
+				})
+				pe, err := common.GetCPEPkgEqual(pkg, cpe, "cdx component cpe")
+				if err != nil {
+					logger.Warnf("unable to link cdx package to its cpe: %v", err)
+					continue
+				}
+				preds.PkgEqual = append(preds.PkgEqual, *pe)
+			}
+		}
+	}
+
 	preds.Vex = c.vulnData.vex
 	preds.VulnMetadata = c.vulnData.vulnMetadata
 	preds.CertifyVuln = c.vulnData.certifyVuln
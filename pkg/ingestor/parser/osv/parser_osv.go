@@ -0,0 +1,225 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osv parses the raw OSV records, such as the records of the osv.dev
+// database exports, into:
+//
+// - VulnEquals between the vulnerability and each of its aliases.
+//
+// - VulnerabilityMetadata for each severity score of the vulnerability and of
+// its affected packages.
+//
+// - CertifyVulns between the vulnerability and each version of the affected
+// packages the record enumerates.
+//
+// - VulnerabilityRanges between the vulnerability and each affected package
+// for each interval of the ranges of affected versions.
+package osv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	osv_models "github.com/google/osv-scanner/pkg/models"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation/vuln"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/ingestor/parser/vuln"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/misc/osvrange"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// scannerURI is the scanner recorded by the CertifyVulns of the OSV records.
+const scannerURI = "osv.dev"
+
+type osvParser struct {
+	vulnEquals   []assembler.VulnEqualIngest
+	vulnMetadata []assembler.VulnMetadataIngest
+	certifyVulns []assembler.CertifyVulnIngest
+	vulnRanges   []assembler.VulnerabilityRangeIngest
+}
+
+// NewOSVParser initializes the parser
+func NewOSVParser() common.DocumentParser {
+	return &osvParser{}
+}
+
+// initializeOSVParser clears out all values for the next iteration
+func (c *osvParser) initializeOSVParser() {
+	c.vulnEquals = make([]assembler.VulnEqualIngest, 0)
+	c.vulnMetadata = make([]assembler.VulnMetadataIngest, 0)
+	c.certifyVulns = make([]assembler.CertifyVulnIngest, 0)
+	c.vulnRanges = make([]assembler.VulnerabilityRangeIngest, 0)
+}
+
+// Parse breaks out the document into the graph components
+func (c *osvParser) Parse(ctx context.Context, doc *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	c.initializeOSVParser()
+
+	var record osv_models.Vulnerability
+	if err := json.Unmarshal(doc.Blob, &record); err != nil {
+		return fmt.Errorf("failed to unmarshal OSV record: %w", err)
+	}
+	if !record.Withdrawn.IsZero() {
+		logger.Debugf("skipping withdrawn OSV record %s", record.ID)
+		return nil
+	}
+
+	vulnInput, err := helpers.CreateVulnInput(record.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create vulnerability input: %w", err)
+	}
+
+	for _, alias := range record.Aliases {
+		aliasInput, err := helpers.CreateVulnInput(alias)
+		if err != nil {
+			logger.Warnf("skipping alias %q of %s: %v", alias, record.ID, err)
+			continue
+		}
+		c.vulnEquals = append(c.vulnEquals, assembler.VulnEqualIngest{
+			Vulnerability:      vulnInput,
+			EqualVulnerability: aliasInput,
+			VulnEqual: &generated.VulnEqualInputSpec{
+				Justification: "OSV alias",
+			},
+		})
+	}
+
+	severities := record.Severity
+	for _, a := range record.Affected {
+		severities = append(severities, a.Severity...)
+	}
+	for _, severity := range severities {
+		s := attestation_vuln.Severity{Method: scoreMethod(severity), Score: severity.Score}
+		score, err := vuln.ParseScoreBasedOnMethod(s)
+		if err != nil {
+			logger.Warnf("skipping severity %s of %s: %v", severity.Type, record.ID, err)
+			continue
+		}
+		c.vulnMetadata = append(c.vulnMetadata, assembler.VulnMetadataIngest{
+			Vulnerability: vulnInput,
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreType(s.Method),
+				ScoreValue: score,
+				Timestamp:  record.Modified,
+			},
+		})
+	}
+
+	vulnData := &generated.ScanMetadataInput{
+		TimeScanned: record.Modified,
+		ScannerUri:  scannerURI,
+	}
+	for _, a := range record.Affected {
+		purl, err := osvrange.PackageURL(a.Package)
+		if err != nil {
+			logger.Debugf("skipping affected package %s of %s: %v", a.Package.Name, record.ID, err)
+			continue
+		}
+		for _, version := range a.Versions {
+			pkg, err := helpers.PurlToPkg(purl + "@" + version)
+			if err != nil {
+				logger.Warnf("skipping affected version %s of %s: %v", version, purl, err)
+				continue
+			}
+			c.certifyVulns = append(c.certifyVulns, assembler.CertifyVulnIngest{
+				Pkg:           pkg,
+				Vulnerability: vulnInput,
+				VulnData:      vulnData,
+			})
+		}
+		if len(a.Ranges) == 0 {
+			continue
+		}
+		pkg, err := helpers.PurlToPkg(purl)
+		if err != nil {
+			logger.Warnf("skipping affected ranges of %s: %v", purl, err)
+			continue
+		}
+		for _, r := range a.Ranges {
+			rangeType, ok := rangeTypes[r.Type]
+			if !ok {
+				logger.Debugf("skipping %s range of %s", r.Type, purl)
+				continue
+			}
+			for _, interval := range osvrange.Intervals(r) {
+				c.vulnRanges = append(c.vulnRanges, assembler.VulnerabilityRangeIngest{
+					Pkg:           pkg,
+					Vulnerability: vulnInput,
+					VulnerabilityRange: &generated.VulnerabilityRangeInputSpec{
+						RangeType:    rangeType,
+						Introduced:   interval.Introduced,
+						Fixed:        interval.Fixed,
+						LastAffected: interval.LastAffected,
+					},
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// rangeTypes maps the types of OSV ranges to the types of vulnerability
+// ranges.
+var rangeTypes = map[osv_models.RangeType]generated.VulnerabilityRangeType{
+	osv_models.RangeSemVer:    generated.VulnerabilityRangeTypeSemver,
+	osv_models.RangeEcosystem: generated.VulnerabilityRangeTypeEcosystem,
+	osv_models.RangeGit:       generated.VulnerabilityRangeTypeGit,
+}
+
+// scoreMethod returns the score type of an OSV severity.
+func scoreMethod(severity osv_models.Severity) string {
+	switch severity.Type {
+	case osv_models.SeverityCVSSV2:
+		return string(generated.VulnerabilityScoreTypeCvssv2)
+	case osv_models.SeverityCVSSV3:
+		if strings.HasPrefix(severity.Score, "CVSS:3.1/") {
+			return string(generated.VulnerabilityScoreTypeCvssv31)
+		}
+		return string(generated.VulnerabilityScoreTypeCvssv3)
+	case osv_models.SeverityCVSSV4:
+		return string(generated.VulnerabilityScoreTypeCvssv4)
+	}
+	return string(severity.Type)
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *osvParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+// GetIdentifiers returns no identifiers: the affected packages of a
+// vulnerability database are not worth collecting.
+func (c *osvParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return &common.IdentifierStrings{}, nil
+}
+
+func (c *osvParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		VulnEqual:          c.vulnEquals,
+		VulnMetadata:       c.vulnMetadata,
+		CertifyVuln:        c.certifyVulns,
+		VulnerabilityRange: c.vulnRanges,
+	}
+}
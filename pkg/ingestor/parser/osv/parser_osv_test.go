@@ -0,0 +1,124 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_osvParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	modified := time.Date(2024, 2, 16, 8, 17, 13, 63437000, time.UTC)
+	ghsa := &generated.VulnerabilityInputSpec{Type: "ghsa", VulnerabilityID: "ghsa-599f-7c49-w659"}
+	vulnData := &generated.ScanMetadataInput{TimeScanned: modified, ScannerUri: "osv.dev"}
+	commonsText := func(version string) assembler.CertifyVulnIngest {
+		return assembler.CertifyVulnIngest{
+			Pkg: &generated.PkgInputSpec{
+				Type:      "maven",
+				Namespace: ptr("org.apache.commons"),
+				Name:      "commons-text",
+				Version:   ptr(version),
+				Subpath:   ptr(""),
+			},
+			Vulnerability: ghsa,
+			VulnData:      vulnData,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		blob    []byte
+		want    *assembler.IngestPredicates
+		wantErr bool
+	}{{
+		name: "text4shell",
+		blob: testdata.OSVText4ShellExample,
+		want: &assembler.IngestPredicates{
+			VulnEqual: []assembler.VulnEqualIngest{{
+				Vulnerability:      ghsa,
+				EqualVulnerability: &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2022-42889"},
+				VulnEqual:          &generated.VulnEqualInputSpec{Justification: "OSV alias"},
+			}},
+			VulnMetadata: []assembler.VulnMetadataIngest{{
+				Vulnerability: ghsa,
+				VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+					ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
+					ScoreValue: 9.8,
+					Timestamp:  modified,
+				},
+			}},
+			CertifyVuln: []assembler.CertifyVulnIngest{
+				commonsText("1.5"), commonsText("1.6"), commonsText("1.7"), commonsText("1.8"), commonsText("1.9"),
+			},
+			VulnerabilityRange: []assembler.VulnerabilityRangeIngest{{
+				Pkg: &generated.PkgInputSpec{
+					Type:      "maven",
+					Namespace: ptr("org.apache.commons"),
+					Name:      "commons-text",
+					Version:   ptr(""),
+					Subpath:   ptr(""),
+				},
+				Vulnerability: ghsa,
+				VulnerabilityRange: &generated.VulnerabilityRangeInputSpec{
+					RangeType:  generated.VulnerabilityRangeTypeEcosystem,
+					Introduced: "1.5",
+					Fixed:      "1.10.0",
+				},
+			}},
+		},
+	}, {
+		name: "withdrawn",
+		blob: []byte(`{"id":"GHSA-xxxx-xxxx-xxxx","modified":"2024-01-01T00:00:00Z","withdrawn":"2024-01-01T00:00:00Z","aliases":["CVE-2024-0001"]}`),
+		want: &assembler.IngestPredicates{
+			VulnEqual:          []assembler.VulnEqualIngest{},
+			VulnMetadata:       []assembler.VulnMetadataIngest{},
+			CertifyVuln:        []assembler.CertifyVulnIngest{},
+			VulnerabilityRange: []assembler.VulnerabilityRangeIngest{},
+		},
+	}, {
+		name:    "malformed id",
+		blob:    []byte(`{"id":"malformed","modified":"2024-01-01T00:00:00Z"}`),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewOSVParser()
+			err := p.Parse(ctx, &processor.Document{Blob: tt.blob, Type: processor.DocumentOSV, Format: processor.FormatJSON})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, p.GetPredicates(ctx)); diff != "" {
+				t.Errorf("GetPredicates() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
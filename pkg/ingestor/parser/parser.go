@@ -33,15 +33,18 @@ import (
 	"github.com/guacsec/guac/pkg/ingestor/parser/extended_vex"
 	"github.com/guacsec/guac/pkg/ingestor/parser/opaque"
 	"github.com/guacsec/guac/pkg/ingestor/parser/open_vex"
+	"github.com/guacsec/guac/pkg/ingestor/parser/osv"
 	"github.com/guacsec/guac/pkg/ingestor/parser/scorecard"
 	"github.com/guacsec/guac/pkg/ingestor/parser/slsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
+	"github.com/guacsec/guac/pkg/ingestor/parser/vsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/vuln"
 )
 
 func init() {
 	_ = RegisterDocumentParser(dsse.NewDSSEParser, processor.DocumentDSSE)
 	_ = RegisterDocumentParser(slsa.NewSLSAParser, processor.DocumentITE6SLSA)
+	_ = RegisterDocumentParser(vsa.NewVSAParser, processor.DocumentITE6VSA)
 	_ = RegisterDocumentParser(vuln.NewVulnCertificationParser, processor.DocumentITE6Vul)
 	_ = RegisterDocumentParser(clearlydefined.NewLegalCertificationParser, processor.DocumentITE6ClearlyDefined)
 	_ = RegisterDocumentParser(spdx.NewSpdxParser, processor.DocumentSPDX)
@@ -50,6 +53,7 @@ func init() {
 	_ = RegisterDocumentParser(deps_dev.NewDepsDevParser, processor.DocumentDepsDev)
 	_ = RegisterDocumentParser(csaf.NewCsafParser, processor.DocumentCsaf)
 	_ = RegisterDocumentParser(open_vex.NewOpenVEXParser, processor.DocumentOpenVEX)
+	_ = RegisterDocumentParser(osv.NewOSVParser, processor.DocumentOSV)
 	_ = RegisterDocumentParser(eol.NewEOLCertificationParser, processor.DocumentITE6EOL)
 	_ = RegisterDocumentParser(opaque.NewOpaqueParser, processor.DocumentOpaque)
 	_ = RegisterDocumentParser(extended_vex.NewExtendedVEXParser, processor.DocumentExtendedVEX)
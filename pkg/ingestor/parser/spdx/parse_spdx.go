@@ -423,6 +423,17 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 					}
 					preds.HasMetadata = append(preds.HasMetadata, hasMetadata)
 				}
+				if extRef.RefType != spdx_common.TypeSecurityCPE23Type && extRef.RefType != spdx_common.TypeSecurityCPE22Type {
+					continue
+				}
+				for i := range pkgInputSpecs {
+					pe, err := common.GetCPEPkgEqual(pkgInputSpecs[i], locator, "spdx cpe external reference")
+					if err != nil {
+						logger.Warnf("unable to link spdx package to its cpe: %v", err)
+						continue
+					}
+					preds.PkgEqual = append(preds.PkgEqual, *pe)
+				}
 			}
 		}
 	}
@@ -0,0 +1,213 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+// PredicateVSAv1 is the predicate type of a SLSA Verification Summary
+// Attestation, see https://slsa.dev/spec/v1.0/verification_summary
+const PredicateVSAv1 = "https://slsa.dev/verification_summary/v1"
+
+var ErrVerifierNil = errors.New("VSA verifier is nil")
+var ErrPolicyNil = errors.New("VSA policy is nil")
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// statement is the in-toto statement wrapping a VSA. The predicate is decoded
+// here rather than with the in-toto protobuf types, which declare
+// verifiedLevels as a string instead of a list.
+type statement struct {
+	Type          string              `json:"_type"`
+	Subject       []subject           `json:"subject"`
+	PredicateType string              `json:"predicateType"`
+	Predicate     verificationSummary `json:"predicate"`
+}
+
+type subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type verificationSummary struct {
+	Verifier *struct {
+		ID string `json:"id"`
+	} `json:"verifier"`
+	TimeVerified *time.Time `json:"timeVerified"`
+	ResourceURI  string     `json:"resourceUri"`
+	Policy       *struct {
+		URI    string            `json:"uri"`
+		Digest map[string]string `json:"digest"`
+	} `json:"policy"`
+	VerificationResult string   `json:"verificationResult"`
+	VerifiedLevels     []string `json:"verifiedLevels"`
+}
+
+type vsaParser struct {
+	subjects          []*model.ArtifactInputSpec
+	certifyPolicy     *model.CertifyPolicyInputSpec
+	resourceURI       string
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewVSAParser initializes the vsaParser
+func NewVSAParser() common.DocumentParser {
+	return &vsaParser{
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// initializeVSAParser clears out all values for the next iteration
+func (v *vsaParser) initializeVSAParser() {
+	v.subjects = make([]*model.ArtifactInputSpec, 0)
+	v.certifyPolicy = nil
+	v.resourceURI = ""
+	v.identifierStrings = &common.IdentifierStrings{}
+}
+
+// Parse breaks out the document into the graph components
+func (v *vsaParser) Parse(ctx context.Context, doc *processor.Document) error {
+	v.initializeVSAParser()
+	smt := &statement{}
+	if err := json.Unmarshal(doc.Blob, smt); err != nil {
+		return fmt.Errorf("could not unmarshal VSA statement: %w", err)
+	}
+	if smt.PredicateType != PredicateVSAv1 {
+		return fmt.Errorf("unknown VSA PredicateType: %q", smt.PredicateType)
+	}
+	v.getSubjects(smt)
+	return v.getCertifyPolicy(&smt.Predicate)
+}
+
+func (v *vsaParser) getSubjects(smt *statement) {
+	for _, sub := range smt.Subject {
+		if sub.Name != "" {
+			v.identifierStrings.UnclassifiedStrings = append(v.identifierStrings.UnclassifiedStrings, sub.Name)
+		}
+		for alg, ds := range sub.Digest {
+			v.subjects = append(v.subjects, &model.ArtifactInputSpec{
+				Algorithm: alg,
+				Digest:    ds,
+			})
+		}
+	}
+}
+
+func (v *vsaParser) getCertifyPolicy(pred *verificationSummary) error {
+	if pred.Verifier == nil {
+		return ErrVerifierNil
+	}
+	if pred.Policy == nil {
+		return ErrPolicyNil
+	}
+
+	var result model.PolicyVerificationResult
+	switch strings.ToUpper(pred.VerificationResult) {
+	case string(model.PolicyVerificationResultPassed):
+		result = model.PolicyVerificationResultPassed
+	case string(model.PolicyVerificationResultFailed):
+		result = model.PolicyVerificationResultFailed
+	default:
+		return fmt.Errorf("unknown VSA verificationResult: %q", pred.VerificationResult)
+	}
+
+	// the time is part of the identity of the CertifyPolicy, so a summary
+	// without it must always yield the same time to be ingested only once
+	var timeVerified time.Time
+	if pred.TimeVerified != nil {
+		timeVerified = *pred.TimeVerified
+	}
+
+	levels := pred.VerifiedLevels
+	if levels == nil {
+		levels = []string{}
+	}
+
+	v.certifyPolicy = &model.CertifyPolicyInputSpec{
+		Verifier:       pred.Verifier.ID,
+		PolicyUri:      pred.Policy.URI,
+		PolicyDigest:   policyDigest(pred.Policy.Digest),
+		Result:         result,
+		VerifiedLevels: levels,
+		TimeVerified:   timeVerified,
+	}
+	v.resourceURI = pred.ResourceURI
+	return nil
+}
+
+// policyDigest returns the policy digest in algorithm:digest form. If the
+// policy lists several digests, the first algorithm in sorted order is used
+// so that the same document always yields the same value.
+func policyDigest(digests map[string]string) string {
+	if len(digests) == 0 {
+		return ""
+	}
+	algs := make([]string, 0, len(digests))
+	for alg := range digests {
+		algs = append(algs, alg)
+	}
+	sort.Strings(algs)
+	return algs[0] + ":" + digests[algs[0]]
+}
+
+// GetPredicates gets predicates from the parsed document
+func (v *vsaParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	preds := &assembler.IngestPredicates{}
+	if v.certifyPolicy == nil {
+		return preds
+	}
+	for _, a := range v.subjects {
+		cp := *v.certifyPolicy
+		preds.CertifyPolicy = append(preds.CertifyPolicy, assembler.CertifyPolicyIngest{
+			Artifact:      a,
+			CertifyPolicy: &cp,
+		})
+		if v.resourceURI != "" {
+			preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+				Artifact: a,
+				HasMetadata: &model.HasMetadataInputSpec{
+					Key:           "resourceUri",
+					Value:         v.resourceURI,
+					Timestamp:     cp.TimeVerified,
+					Justification: "slsa verification summary resource",
+				},
+			})
+		}
+	}
+	return preds
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (v *vsaParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (v *vsaParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	common.RemoveDuplicateIdentifiers(v.identifierStrings)
+	return v.identifierStrings, nil
+}
@@ -0,0 +1,202 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestVSAParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tm, _ := time.Parse(time.RFC3339, "2026-05-04T10:12:30Z")
+	tests := []struct {
+		name    string
+		blob    []byte
+		wantCP  []assembler.CertifyPolicyIngest
+		wantHM  []assembler.HasMetadataIngest
+		wantIDs *common.IdentifierStrings
+		wantErr bool
+	}{
+		{
+			name: "passed verification",
+			blob: testdata.ITE6VSAExample,
+			wantCP: []assembler.CertifyPolicyIngest{
+				{
+					Artifact: &model.ArtifactInputSpec{
+						Algorithm: "sha256",
+						Digest:    "b1a7e5c3f2d6a9e8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4",
+					},
+					CertifyPolicy: &model.CertifyPolicyInputSpec{
+						Verifier:       "https://release-gate.example.com/verifier",
+						PolicyUri:      "https://release-gate.example.com/policies/production",
+						PolicyDigest:   "sha256:0f5e2d1c3b4a59687f6e5d4c3b2a1908f7e6d5c4b3a29180f7e6d5c4b3a29180",
+						Result:         model.PolicyVerificationResultPassed,
+						VerifiedLevels: []string{"SLSA_BUILD_LEVEL_3"},
+						TimeVerified:   tm,
+					},
+				},
+			},
+			wantHM: []assembler.HasMetadataIngest{
+				{
+					Artifact: &model.ArtifactInputSpec{
+						Algorithm: "sha256",
+						Digest:    "b1a7e5c3f2d6a9e8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4",
+					},
+					HasMetadata: &model.HasMetadataInputSpec{
+						Key:           "resourceUri",
+						Value:         "oci://registry.example.com/release-gate/app",
+						Timestamp:     tm,
+						Justification: "slsa verification summary resource",
+					},
+				},
+			},
+			wantIDs: &common.IdentifierStrings{
+				UnclassifiedStrings: []string{"registry.example.com/release-gate/app"},
+			},
+		},
+		{
+			name: "failed verification with several digests",
+			blob: []byte(`{
+  "_type": "https://in-toto.io/Statement/v1",
+  "subject": [{"digest": {"sha1": "abc"}}],
+  "predicateType": "https://slsa.dev/verification_summary/v1",
+  "predicate": {
+    "verifier": {"id": "https://verifier.example.com"},
+    "timeVerified": "2026-05-04T10:12:30Z",
+    "policy": {"uri": "https://policy.example.com", "digest": {"sha512": "def", "sha256": "123"}},
+    "verificationResult": "FAILED"
+  }
+}`),
+			wantCP: []assembler.CertifyPolicyIngest{
+				{
+					Artifact: &model.ArtifactInputSpec{
+						Algorithm: "sha1",
+						Digest:    "abc",
+					},
+					CertifyPolicy: &model.CertifyPolicyInputSpec{
+						Verifier:       "https://verifier.example.com",
+						PolicyUri:      "https://policy.example.com",
+						PolicyDigest:   "sha256:123",
+						Result:         model.PolicyVerificationResultFailed,
+						VerifiedLevels: []string{},
+						TimeVerified:   tm,
+					},
+				},
+			},
+			wantIDs: &common.IdentifierStrings{},
+		},
+		{
+			name: "verification without time",
+			blob: []byte(`{
+  "_type": "https://in-toto.io/Statement/v1",
+  "subject": [{"digest": {"sha1": "abc"}}],
+  "predicateType": "https://slsa.dev/verification_summary/v1",
+  "predicate": {
+    "verifier": {"id": "https://verifier.example.com"},
+    "policy": {"uri": "https://policy.example.com"},
+    "verificationResult": "PASSED"
+  }
+}`),
+			wantCP: []assembler.CertifyPolicyIngest{
+				{
+					Artifact: &model.ArtifactInputSpec{
+						Algorithm: "sha1",
+						Digest:    "abc",
+					},
+					CertifyPolicy: &model.CertifyPolicyInputSpec{
+						Verifier:       "https://verifier.example.com",
+						PolicyUri:      "https://policy.example.com",
+						Result:         model.PolicyVerificationResultPassed,
+						VerifiedLevels: []string{},
+					},
+				},
+			},
+			wantIDs: &common.IdentifierStrings{},
+		},
+		{
+			name: "missing verifier",
+			blob: []byte(`{
+  "_type": "https://in-toto.io/Statement/v1",
+  "predicateType": "https://slsa.dev/verification_summary/v1",
+  "predicate": {"policy": {"uri": "https://policy.example.com"}, "verificationResult": "PASSED"}
+}`),
+			wantErr: true,
+		},
+		{
+			name: "unknown result",
+			blob: []byte(`{
+  "_type": "https://in-toto.io/Statement/v1",
+  "predicateType": "https://slsa.dev/verification_summary/v1",
+  "predicate": {
+    "verifier": {"id": "https://verifier.example.com"},
+    "policy": {"uri": "https://policy.example.com"},
+    "verificationResult": "MAYBE"
+  }
+}`),
+			wantErr: true,
+		},
+		{
+			name: "provenance is not a VSA",
+			blob: []byte(`{
+  "_type": "https://in-toto.io/Statement/v1",
+  "predicateType": "https://slsa.dev/provenance/v1",
+  "predicate": {}
+}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewVSAParser()
+			err := p.Parse(ctx, &processor.Document{
+				Blob:   tt.blob,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentITE6VSA,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("vsaParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			preds := p.GetPredicates(ctx)
+			if diff := cmp.Diff(tt.wantCP, preds.CertifyPolicy); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantHM, preds.HasMetadata); diff != "" {
+				t.Errorf("Unexpected metadata. (-want +got):\n%s", diff)
+			}
+			ids, err := p.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("vsaParser.GetIdentifiers() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.wantIDs, ids); diff != "" {
+				t.Errorf("Unexpected identifiers. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
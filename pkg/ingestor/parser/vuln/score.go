@@ -2,6 +2,7 @@ package vuln
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation/vuln"
@@ -11,7 +12,9 @@ import (
 	gocvss40 "github.com/pandatix/go-cvss/40"
 )
 
-func parseScoreBasedOnMethod(severity attestation_vuln.Severity) (float64, error) {
+// ParseScoreBasedOnMethod returns the score of a severity, the base score of
+// the CVSS vectors.
+func ParseScoreBasedOnMethod(severity attestation_vuln.Severity) (float64, error) {
 	score := severity.Score
 	switch severity.Method {
 	// TODO: match for other score types
@@ -22,6 +25,14 @@ func parseScoreBasedOnMethod(severity attestation_vuln.Severity) (float64, error
 		}
 		return vector.BaseScore(), nil
 	case string(generated.VulnerabilityScoreTypeCvssv3):
+		// OSV types the 3.0 and 3.1 vectors alike
+		if strings.HasPrefix(score, "CVSS:3.1/") {
+			vector, err := gocvss31.ParseVector(score)
+			if err != nil {
+				return 0, err
+			}
+			return vector.BaseScore(), nil
+		}
 		vector, err := gocvss30.ParseVector(score)
 		if err != nil {
 			return 0, err
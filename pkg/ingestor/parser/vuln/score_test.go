@@ -0,0 +1,49 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vuln
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation/vuln"
+)
+
+func TestParseScoreBasedOnMethod(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity attestation_vuln.Severity
+		want     float64
+		wantErr  bool
+	}{
+		{"cvss v3.0", attestation_vuln.Severity{Method: string(generated.VulnerabilityScoreTypeCvssv3), Score: "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}, 9.8, false},
+		{"cvss v3.1 typed as v3", attestation_vuln.Severity{Method: string(generated.VulnerabilityScoreTypeCvssv3), Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}, 10, false},
+		{"cvss v3.1", attestation_vuln.Severity{Method: string(generated.VulnerabilityScoreTypeCvssv31), Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"}, 9.8, false},
+		{"number", attestation_vuln.Severity{Method: "OTHER", Score: "7.5"}, 7.5, false},
+		{"invalid", attestation_vuln.Severity{Method: "OTHER", Score: "HIGH"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScoreBasedOnMethod(tt.severity)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseScoreBasedOnMethod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseScoreBasedOnMethod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -155,7 +155,7 @@ func parseVulns(_ context.Context, s *attestation_vuln.VulnerabilityStatement) (
 
 		var severityErrors error
 		for _, severity := range res.Severity {
-			score, err := parseScoreBasedOnMethod(severity)
+			score, err := ParseScoreBasedOnMethod(severity)
 			if err != nil {
 				severityErrors = errors.Join(fmt.Errorf("parsing severity score failed for method %s: %w", severity.Method, err))
 			}
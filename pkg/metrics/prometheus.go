@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -274,6 +275,13 @@ func (rec *statusRecorder) WriteHeader(statusCode int) {
 // MeasureGraphQLResponseDuration creates a middleware that records the response time and status code
 func (pc *prometheusCollector) MeasureGraphQLResponseDuration(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Subscriptions are long lived WebSocket connections without a
+		// request body and need the original writer to hijack the connection
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		start := time.Now()
 
 		// Create a copy of the request body
@@ -0,0 +1,319 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpe parses and matches Common Platform Enumeration (CPE) 2.3 names,
+// bound either as formatted strings or as CPE 2.2 URIs, and relates them to
+// the purls of the packages they identify.
+package cpe
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+const (
+	// Any is the logical value ANY of an attribute, matching any value.
+	Any = "*"
+	// NA is the logical value NA of an attribute, not applicable.
+	NA = "-"
+
+	// PurlNamespace prefixes the namespace of the guac purls of the
+	// packages identified by a CPE.
+	PurlNamespace = "cpe"
+)
+
+// WFN is a well-formed CPE name. Each attribute value is either Any, NA, or
+// a lowercase value as bound in a formatted string: the characters other
+// than alphanumerics, hyphens, periods and underscores are quoted by a
+// backslash, and the unquoted * and ? are wildcards.
+type WFN struct {
+	Part      string
+	Vendor    string
+	Product   string
+	Version   string
+	Update    string
+	Edition   string
+	Language  string
+	SWEdition string
+	TargetSW  string
+	TargetHW  string
+	Other     string
+}
+
+// attributes returns pointers to the attributes of the name, in the order of
+// the formatted string binding.
+func (w *WFN) attributes() []*string {
+	return []*string{&w.Part, &w.Vendor, &w.Product, &w.Version, &w.Update, &w.Edition,
+		&w.Language, &w.SWEdition, &w.TargetSW, &w.TargetHW, &w.Other}
+}
+
+// Parse parses a CPE bound either as a CPE 2.3 formatted string, such as
+// cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*, or as a CPE 2.2 URI, such as
+// cpe:/a:apache:log4j:2.14.1. The missing attributes are Any.
+func Parse(cpe string) (WFN, error) {
+	var w WFN
+	var err error
+	switch {
+	case strings.HasPrefix(strings.ToLower(cpe), "cpe:2.3:"):
+		err = w.parseFormattedString(cpe[len("cpe:2.3:"):])
+	case strings.HasPrefix(strings.ToLower(cpe), "cpe:/"):
+		err = w.parseURI(cpe[len("cpe:/"):])
+	default:
+		err = fmt.Errorf("unknown binding")
+	}
+	if err != nil {
+		return WFN{}, fmt.Errorf("unable to parse cpe %s: %w", cpe, err)
+	}
+	return w, nil
+}
+
+func (w *WFN) parseFormattedString(s string) error {
+	fields := splitFormattedString(s)
+	attrs := w.attributes()
+	if len(fields) != len(attrs) {
+		return fmt.Errorf("expected %d components, found %d", len(attrs), len(fields))
+	}
+	for i, f := range fields {
+		if f == "" {
+			return fmt.Errorf("empty component %d", i+1)
+		}
+		*attrs[i] = normalize(f)
+	}
+	return nil
+}
+
+func (w *WFN) parseURI(s string) error {
+	fields := strings.Split(s, ":")
+	if len(fields) > 7 {
+		return fmt.Errorf("expected at most 7 components, found %d", len(fields))
+	}
+	for _, attr := range w.attributes() {
+		*attr = Any
+	}
+	// the edition of a URI packs the extended attributes of CPE 2.3 as
+	// ~edition~sw_edition~target_sw~target_hw~other
+	if len(fields) > 5 && strings.HasPrefix(fields[5], "~") {
+		packed := strings.Split(fields[5], "~")
+		if len(packed) != 6 {
+			return fmt.Errorf("invalid packed edition %s", fields[5])
+		}
+		fields[5] = packed[1]
+		for i, attr := range []*string{&w.SWEdition, &w.TargetSW, &w.TargetHW, &w.Other} {
+			v, err := decodeURIComponent(packed[i+2])
+			if err != nil {
+				return err
+			}
+			*attr = v
+		}
+	}
+	attrs := []*string{&w.Part, &w.Vendor, &w.Product, &w.Version, &w.Update, &w.Edition, &w.Language}
+	for i, f := range fields {
+		v, err := decodeURIComponent(f)
+		if err != nil {
+			return err
+		}
+		*attrs[i] = v
+	}
+	return nil
+}
+
+// decodeURIComponent decodes a component of a URI binding into the value
+// of its formatted string binding.
+func decodeURIComponent(s string) (string, error) {
+	if s == "" {
+		return Any, nil
+	}
+	if s == NA {
+		return NA, nil
+	}
+	// %01 and %02 are the wildcards ? and *
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "%01"):
+			b.WriteByte('?')
+			i += 2
+		case strings.HasPrefix(s[i:], "%02"):
+			b.WriteByte('*')
+			i += 2
+		case s[i] == '%':
+			if i+2 >= len(s) {
+				return "", fmt.Errorf("invalid escape in %s", s)
+			}
+			c, err := url.PathUnescape(s[i : i+3])
+			if err != nil {
+				return "", fmt.Errorf("invalid escape in %s: %w", s, err)
+			}
+			b.WriteString(quote(c))
+			i += 2
+		default:
+			b.WriteString(quote(s[i : i+1]))
+		}
+	}
+	return strings.ToLower(b.String()), nil
+}
+
+// normalize normalizes a component of a formatted string into its canonical
+// quoting.
+func normalize(s string) string {
+	if s == Any || s == NA {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			b.WriteString(quote(s[i : i+1]))
+		case s[i] == '*' || s[i] == '?':
+			b.WriteByte(s[i])
+		default:
+			b.WriteString(quote(s[i : i+1]))
+		}
+	}
+	return strings.ToLower(b.String())
+}
+
+// quote quotes the characters of s other than alphanumerics, hyphens,
+// periods and underscores.
+func quote(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '.' || r == '_') {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Unquote returns the value of an attribute without its quoting. The
+// wildcards are left as they are.
+func Unquote(v string) string {
+	var b strings.Builder
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' && i+1 < len(v) {
+			i++
+		}
+		b.WriteByte(v[i])
+	}
+	return b.String()
+}
+
+// splitFormattedString splits a formatted string on the colons that are not
+// quoted.
+func splitFormattedString(s string) []string {
+	var fields []string
+	var field strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			field.WriteByte(s[i])
+			field.WriteByte(s[i+1])
+			i++
+		case s[i] == ':':
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteByte(s[i])
+		}
+	}
+	return append(fields, field.String())
+}
+
+// String returns the formatted string binding of the name.
+func (w WFN) String() string {
+	var fields []string
+	for _, attr := range w.attributes() {
+		fields = append(fields, *attr)
+	}
+	return "cpe:2.3:" + strings.Join(fields, ":")
+}
+
+// Matches reports whether the name, as a source name, matches the target
+// name: each attribute of the source is Any, or NA as the target's, or a
+// value matching the target's, the wildcards of the source included. The
+// targets with Any or NA attributes only match the source attributes that
+// are Any.
+func (w WFN) Matches(target WFN) bool {
+	targetAttrs := target.attributes()
+	for i, s := range w.attributes() {
+		if !matchAttribute(*s, *targetAttrs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAttribute(source, target string) bool {
+	switch {
+	case source == Any:
+		return true
+	case source == NA || target == Any || target == NA:
+		return source == target
+	case !hasWildcard(source):
+		return source == target
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for i := 0; i < len(source); i++ {
+		switch {
+		case source[i] == '\\' && i+1 < len(source):
+			i++
+			pattern.WriteString(regexp.QuoteMeta(source[i : i+1]))
+		case source[i] == '*':
+			pattern.WriteString(".*")
+		case source[i] == '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(source[i : i+1]))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String()).MatchString(Unquote(target))
+}
+
+// hasWildcard reports whether the value has an unquoted wildcard.
+func hasWildcard(v string) bool {
+	for i := 0; i < len(v); i++ {
+		switch v[i] {
+		case '\\':
+			i++
+		case '*', '?':
+			return true
+		}
+	}
+	return false
+}
+
+// GuacPurl returns the guac purl of the package the name identifies:
+// pkg:guac/cpe/<vendor>/<product>@<version>. The names with an Any or NA
+// version identify all the versions of the package.
+func GuacPurl(w WFN) (string, error) {
+	for _, v := range []string{w.Vendor, w.Product} {
+		if v == Any || v == NA || hasWildcard(v) {
+			return "", fmt.Errorf("cpe %s does not identify a vendor and product", w)
+		}
+	}
+	version := ""
+	if w.Version != Any && w.Version != NA && !hasWildcard(w.Version) {
+		version = Unquote(w.Version)
+	}
+	return packageurl.NewPackageURL("guac", PurlNamespace+"/"+Unquote(w.Vendor), Unquote(w.Product), version, nil, "").ToString(), nil
+}
@@ -0,0 +1,171 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpe
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		cpe     string
+		want    string
+		wantErr bool
+	}{
+		{cpe: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"},
+		{cpe: `cpe:2.3:a:base-files:base-files:11.1\+deb11u5:*:*:*:*:*:*:*`, want: `cpe:2.3:a:base-files:base-files:11.1\+deb11u5:*:*:*:*:*:*:*`},
+		{cpe: `cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570\:ab:-:*:*:*:*:*:*`, want: `cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570\:ab:-:*:*:*:*:*:*`},
+		{cpe: "cpe:2.3:A:Microsoft:Internet_Explorer:8.*:*:*:*:*:*:*:*", want: "cpe:2.3:a:microsoft:internet_explorer:8.*:*:*:*:*:*:*:*"},
+		{cpe: "cpe:/o:redhat:enterprise_linux:8::baseos", want: "cpe:2.3:o:redhat:enterprise_linux:8:*:baseos:*:*:*:*:*"},
+		{cpe: "cpe:/a:acme:widget%21:1.0:-", want: `cpe:2.3:a:acme:widget\!:1.0:-:*:*:*:*:*:*`},
+		{cpe: "cpe:/a:hp:insight_diagnostics:8.%02::~~online~win2003~x64~", want: "cpe:2.3:a:hp:insight_diagnostics:8.*:*:*:*:online:win2003:x64:*"},
+		{cpe: "cpe:2.3:a:apache:log4j", wantErr: true},
+		{cpe: "cpe:2.3:a:apache::2.14.1:*:*:*:*:*:*:*", wantErr: true},
+		{cpe: "cpe:/a:acme:widget:1.0::~x~y~", wantErr: true},
+		{cpe: "pkg:maven/org.apache.logging.log4j/log4j-core", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cpe, func(t *testing.T) {
+			got, err := Parse(tt.cpe)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		source string
+		target string
+		want   bool
+	}{
+		{source: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: true},
+		{source: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: true},
+		{source: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.15.0:*:*:*:*:*:*:*", want: false},
+		{source: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", want: false},
+		{source: "cpe:2.3:a:apache:log4j:2.1?.*:*:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: true},
+		{source: "cpe:2.3:a:apache:log4j:2.1?.*:*:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.1.1:*:*:*:*:*:*:*", want: false},
+		{source: "cpe:2.3:a:apache:log4j:*:-:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.14.1:-:*:*:*:*:*:*", want: true},
+		{source: "cpe:2.3:a:apache:log4j:*:-:*:*:*:*:*:*", target: "cpe:2.3:a:apache:log4j:2.14.1:rc1:*:*:*:*:*:*", want: false},
+		{source: `cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570\:a?:*:*:*:*:*:*:*`, target: `cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570\:ab:*:*:*:*:*:*:*`, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source+" "+tt.target, func(t *testing.T) {
+			source, err := Parse(tt.source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			target, err := Parse(tt.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := source.Matches(target); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGuacPurl(t *testing.T) {
+	tests := []struct {
+		cpe     string
+		want    string
+		wantErr bool
+	}{
+		{cpe: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: "pkg:guac/cpe/apache/log4j@2.14.1"},
+		{cpe: `cpe:2.3:a:base-files:base-files:11.1\+deb11u5:*:*:*:*:*:*:*`, want: "pkg:guac/cpe/base-files/base-files@11.1%2Bdeb11u5"},
+		{cpe: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", want: "pkg:guac/cpe/apache/log4j"},
+		{cpe: "cpe:2.3:a:apache:log4j:2.*:*:*:*:*:*:*:*", want: "pkg:guac/cpe/apache/log4j"},
+		{cpe: "cpe:2.3:a:apache:*:2.14.1:*:*:*:*:*:*:*", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cpe, func(t *testing.T) {
+			w, err := Parse(tt.cpe)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := GuacPurl(w)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GuacPurl() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GuacPurl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dictionary.json")
+	if err := os.WriteFile(path, []byte(`[
+		{"cpe": "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", "purl": "pkg:maven/org.apache.logging.log4j/log4j-core"},
+		{"cpe": "cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*", "purl": "pkg:deb/debian/openssl?distro=debian-11"},
+		{"cpe": "cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*", "purl": "pkg:deb/debian/libssl1.1?distro=debian-11"},
+		{"cpe": "cpe:2.3:a:haxx:curl:7.88.1:*:*:*:*:*:*:*", "purl": "pkg:generic/curl@7.88.1"}
+	]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	d, err := LoadDictionary(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Updated().Equal(info.ModTime()) {
+		t.Errorf("Updated() = %v, want %v", d.Updated(), info.ModTime())
+	}
+
+	tests := []struct {
+		purl string
+		want []string
+	}{
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", want: []string{"cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*"}},
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-api@2.14.1"},
+		{purl: "pkg:deb/debian/openssl@1.1.1n-0+deb11u4?arch=amd64&distro=debian-11", want: []string{`cpe:2.3:a:openssl:openssl:1.1.1n-0\+deb11u4:*:*:*:*:*:*:*`}},
+		{purl: "pkg:deb/debian/openssl@3.0.11-1~deb12u2?arch=amd64&distro=debian-12"},
+		{purl: "pkg:generic/curl@7.88.1", want: []string{"cpe:2.3:a:haxx:curl:7.88.1:*:*:*:*:*:*:*"}},
+		{purl: "pkg:generic/curl@8.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			names, err := d.CPEs(tt.purl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, n := range names {
+				got = append(got, n.String())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("CPEs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := NewDictionary([]DictionaryEntry{{CPE: "cpe:2.3:a:acme", Purl: "pkg:npm/acme"}}); err == nil {
+		t.Error("NewDictionary() expected an error for an invalid cpe")
+	}
+}
@@ -0,0 +1,136 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpe
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// DictionaryEntry relates the CPEs a CPE name matches to the versions of the
+// package of a purl. A purl without a version relates each version of the
+// package to the CPE name of that version, when the CPE name has an Any
+// version.
+type DictionaryEntry struct {
+	CPE  string `json:"cpe"`
+	Purl string `json:"purl"`
+}
+
+type dictionaryEntry struct {
+	cpe  WFN
+	purl packageurl.PackageURL
+}
+
+// Dictionary is the configurable dictionary matching CPEs to the purls of
+// the packages they identify.
+type Dictionary struct {
+	// entries are the entries by the type, namespace and name of their
+	// purl.
+	entries map[string][]dictionaryEntry
+	updated time.Time
+}
+
+// NewDictionary returns the dictionary of the entries.
+func NewDictionary(entries []DictionaryEntry) (*Dictionary, error) {
+	d := &Dictionary{entries: map[string][]dictionaryEntry{}}
+	for _, e := range entries {
+		w, err := Parse(e.CPE)
+		if err != nil {
+			return nil, err
+		}
+		p, err := packageurl.FromString(e.Purl)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse purl %s: %w", e.Purl, err)
+		}
+		key := entryKey(p)
+		d.entries[key] = append(d.entries[key], dictionaryEntry{cpe: w, purl: p})
+	}
+	return d, nil
+}
+
+// LoadDictionary loads the dictionary of a JSON file holding a list of
+// entries, such as
+//
+//	[{"cpe": "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*", "purl": "pkg:maven/org.apache.logging.log4j/log4j-core"}]
+func LoadDictionary(path string) (*Dictionary, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cpe dictionary: %w", err)
+	}
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cpe dictionary: %w", err)
+	}
+	var entries []DictionaryEntry
+	if err := json.Unmarshal(blob, &entries); err != nil {
+		return nil, fmt.Errorf("unable to decode cpe dictionary %s: %w", path, err)
+	}
+	d, err := NewDictionary(entries)
+	if err != nil {
+		return nil, err
+	}
+	d.updated = info.ModTime().UTC()
+	return d, nil
+}
+
+// Updated returns the modification time of the file the dictionary was
+// loaded from, or the zero time if it was not loaded from a file.
+func (d *Dictionary) Updated() time.Time {
+	return d.updated
+}
+
+// entryKey returns the key of the entries of the package of the purl.
+func entryKey(p packageurl.PackageURL) string {
+	return p.Type + "/" + p.Namespace + "/" + p.Name
+}
+
+// CPEs returns the CPE names of the package of the purl. The names are those
+// of the entries whose purl is the purl without its version, or the purl
+// itself, and whose qualifiers are qualifiers of the purl.
+func (d *Dictionary) CPEs(purl string) ([]WFN, error) {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse purl %s: %w", purl, err)
+	}
+	qualifiers := p.Qualifiers.Map()
+
+	var names []WFN
+	for _, e := range d.entries[entryKey(p)] {
+		if e.purl.Subpath != p.Subpath || (e.purl.Version != "" && e.purl.Version != p.Version) {
+			continue
+		}
+		matches := true
+		for k, v := range e.purl.Qualifiers.Map() {
+			if qualifiers[k] != v {
+				matches = false
+			}
+		}
+		if !matches {
+			continue
+		}
+		name := e.cpe
+		if name.Version == Any && p.Version != "" {
+			name.Version = strings.ToLower(quote(p.Version))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
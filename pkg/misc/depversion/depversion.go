@@ -134,6 +134,52 @@ func DoesRangeInclude(versions []string, versionRange string) (bool, error) {
 	return false, nil
 }
 
+// IntervalConstraint returns the version range, as understood by
+// DoesRangeInclude, of the versions from introduced, included, up to fixed,
+// excluded, or up to lastAffected, included. An empty introduced version starts
+// the interval at the first version, and the interval has no end if neither
+// fixed nor lastAffected is given.
+func IntervalConstraint(introduced, fixed, lastAffected string) string {
+	if introduced == "" {
+		introduced = "0"
+	}
+	switch {
+	case fixed != "":
+		return fmt.Sprintf(">=%s,<%s", introduced, fixed)
+	case lastAffected != "":
+		return fmt.Sprintf(">=%s,<=%s", introduced, lastAffected)
+	}
+	return ">=" + introduced
+}
+
+// CompareVersions compares the versions a and b the way DoesRangeInclude
+// matches them, returning -1, 0 or 1 if a is lower than, equal to or greater
+// than b. It errors if either version cannot be parsed.
+func CompareVersions(a, b string) (int, error) {
+	va, err := comparableVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := comparableVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+func comparableVersion(s string) (*version.Version, error) {
+	vv := ParseVersionValue(s)
+	vstr := vv.Raw
+	if vv.SemVer != nil {
+		vstr = *vv.SemVer
+	}
+	v, err := version.NewVersion(vstr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse version %q: %w", s, err)
+	}
+	return v, nil
+}
+
 type VersionRange struct {
 	Constraint string
 }
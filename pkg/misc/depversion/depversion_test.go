@@ -517,3 +517,53 @@ func Test_DoesRangeInclude(t *testing.T) {
 		})
 	}
 }
+
+func Test_IntervalConstraint(t *testing.T) {
+	testCases := []struct {
+		introduced, fixed, lastAffected string
+		expect                          string
+	}{
+		{introduced: "1.5", fixed: "1.10.0", expect: ">=1.5,<1.10.0"},
+		{introduced: "0", lastAffected: "2.1.0", expect: ">=0,<=2.1.0"},
+		{fixed: "1.2.3", expect: ">=0,<1.2.3"},
+		{introduced: "3.0.0", expect: ">=3.0.0"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.expect, func(t *testing.T) {
+			got := IntervalConstraint(tt.introduced, tt.fixed, tt.lastAffected)
+			if diff := cmp.Diff(tt.expect, got); len(diff) > 0 {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+			if _, err := ParseVersionRange(got); err != nil {
+				t.Errorf("got unparsable range %q: %v", got, err)
+			}
+		})
+	}
+}
+
+func Test_CompareVersions(t *testing.T) {
+	testCases := []struct {
+		a, b    string
+		expect  int
+		wantErr bool
+	}{
+		{a: "1.10.0", b: "1.9.2", expect: 1},
+		{a: "1.5", b: "1.5.0", expect: 0},
+		{a: "v2.0.0-rc1", b: "2.0.0", expect: -1},
+		{a: "1.0.0rc8", b: "1.0.0", expect: -1},
+		{a: "abcdef", b: "1.0.0", wantErr: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("compare %s to %s", tt.a, tt.b), func(t *testing.T) {
+			got, err := CompareVersions(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CompareVersions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.expect, got); len(diff) > 0 {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvrange
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"deps.dev/util/semver"
+	osv_models "github.com/google/osv-scanner/pkg/models"
+)
+
+// comparator compares two versions, as -1, 0 or 1 like strings.Compare, and
+// fails when either of them is not a version it understands.
+type comparator func(a, b string) (int, error)
+
+// semverSystems are the packaging systems whose versions the semver package
+// of deps.dev compares, by OSV ecosystem.
+var semverSystems = map[osv_models.Ecosystem]semver.System{
+	osv_models.EcosystemNPM:       semver.NPM,
+	osv_models.EcosystemPyPI:      semver.PyPI,
+	osv_models.EcosystemRubyGems:  semver.RubyGems,
+	osv_models.EcosystemCratesIO:  semver.Cargo,
+	osv_models.EcosystemPackagist: semver.Composer,
+	osv_models.EcosystemMaven:     semver.Maven,
+	osv_models.EcosystemNuGet:     semver.NuGet,
+	// Hex and Pub follow SemVer
+	osv_models.EcosystemHex: semver.DefaultSystem,
+	osv_models.EcosystemPub: semver.DefaultSystem,
+}
+
+// rangeComparator returns the comparator of the versions of a range of an
+// affected package of ecosystem, the SemVer comparator for the SEMVER ranges
+// of the other ecosystems.
+func rangeComparator(rangeType osv_models.RangeType, ecosystem osv_models.Ecosystem) (comparator, error) {
+	// the distribution ecosystems are suffixed with their release, as in
+	// Debian:11
+	base, _, _ := strings.Cut(string(ecosystem), ":")
+	switch osv_models.Ecosystem(base) {
+	case osv_models.EcosystemGo:
+		return goComparator, nil
+	case osv_models.EcosystemDebian, "Ubuntu":
+		return compareDebian, nil
+	case osv_models.EcosystemAlpine:
+		return compareAlpine, nil
+	}
+	if sys, ok := semverSystems[osv_models.Ecosystem(base)]; ok {
+		return semverComparator(sys), nil
+	}
+	if rangeType == osv_models.RangeSemVer {
+		return semverComparator(semver.DefaultSystem), nil
+	}
+	return nil, fmt.Errorf("unable to compare the versions of OSV ecosystem %q", ecosystem)
+}
+
+func semverComparator(sys semver.System) comparator {
+	return func(a, b string) (int, error) {
+		va, err := sys.Parse(a)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s version %q: %w", sys, a, err)
+		}
+		vb, err := sys.Parse(b)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s version %q: %w", sys, b, err)
+		}
+		return va.Compare(vb), nil
+	}
+}
+
+// goComparator compares Go module versions, which OSV records without their
+// v prefix.
+func goComparator(a, b string) (int, error) {
+	return semverComparator(semver.Go)("v"+strings.TrimPrefix(a, "v"), "v"+strings.TrimPrefix(b, "v"))
+}
+
+// debianVersion is a Debian version, [epoch:]upstream[-revision].
+type debianVersion struct {
+	epoch    int
+	upstream string
+	revision string
+}
+
+func parseDebian(s string) (debianVersion, error) {
+	var v debianVersion
+	rest := s
+	if epoch, after, found := strings.Cut(s, ":"); found {
+		e, err := strconv.Atoi(epoch)
+		if err != nil || e < 0 {
+			return v, fmt.Errorf("invalid epoch of Debian version %q", s)
+		}
+		v.epoch, rest = e, after
+	}
+	v.upstream = rest
+	if i := strings.LastIndex(rest, "-"); i >= 0 {
+		v.upstream, v.revision = rest[:i], rest[i+1:]
+	}
+	if v.upstream == "" || v.upstream[0] < '0' || v.upstream[0] > '9' {
+		return v, fmt.Errorf("invalid Debian version %q", s)
+	}
+	return v, nil
+}
+
+// compareDebian compares Debian versions as dpkg does.
+func compareDebian(a, b string) (int, error) {
+	va, err := parseDebian(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseDebian(b)
+	if err != nil {
+		return 0, err
+	}
+	if c := compareInt(va.epoch, vb.epoch); c != 0 {
+		return c, nil
+	}
+	if c := compareDebianPart(va.upstream, vb.upstream); c != 0 {
+		return c, nil
+	}
+	return compareDebianPart(va.revision, vb.revision), nil
+}
+
+// debianOrder is the weight of a character in the non-digit parts of a
+// Debian version: ~ sorts before anything, even the end of the part, and the
+// letters before the other characters.
+func debianOrder(s string) int {
+	switch {
+	case s == "" || isDigit(s[0]):
+		return 0
+	case s[0] == '~':
+		return -1
+	case s[0] >= 'a' && s[0] <= 'z' || s[0] >= 'A' && s[0] <= 'Z':
+		return int(s[0])
+	default:
+		return int(s[0]) + 256
+	}
+}
+
+// compareDebianPart compares the upstream versions or revisions of Debian
+// versions, as alternating non-digit and digit parts.
+func compareDebianPart(a, b string) int {
+	for a != "" || b != "" {
+		for a != "" && !isDigit(a[0]) || b != "" && !isDigit(b[0]) {
+			if c := compareInt(debianOrder(a), debianOrder(b)); c != 0 {
+				return c
+			}
+			a, b = a[1:], b[1:]
+		}
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		na, nb := digitPrefix(a), digitPrefix(b)
+		if c := compareInt(len(na), len(nb)); c != 0 {
+			return c
+		}
+		if c := strings.Compare(na, nb); c != 0 {
+			return c
+		}
+		a, b = a[len(na):], b[len(nb):]
+	}
+	return 0
+}
+
+// alpineSuffixes are the suffixes of Alpine versions in their order, the
+// ones before "" sort before the version without suffix.
+var alpineSuffixes = []string{"alpha", "beta", "pre", "rc", "", "cvs", "svn", "git", "hg", "p"}
+
+type alpineSuffix struct {
+	order  int
+	number int
+}
+
+// alpineVersion is an Alpine version,
+// number{.number}[letter]{_suffix[number]}[~hash][-rbuild].
+type alpineVersion struct {
+	numbers  []int
+	letter   byte
+	suffixes []alpineSuffix
+	hash     string
+	build    int
+}
+
+func parseAlpine(s string) (alpineVersion, error) {
+	var v alpineVersion
+	invalid := fmt.Errorf("invalid Alpine version %q", s)
+	rest := s
+	if i := strings.LastIndex(rest, "-r"); i >= 0 {
+		build, err := strconv.Atoi(rest[i+2:])
+		if err != nil {
+			return v, invalid
+		}
+		v.build, rest = build, rest[:i]
+	}
+	rest, v.hash, _ = strings.Cut(rest, "~")
+	rest, suffixes, _ := strings.Cut(rest, "_")
+	for i, n := range strings.Split(rest, ".") {
+		last := i == strings.Count(rest, ".")
+		if last && n != "" && !isDigit(n[len(n)-1]) {
+			v.letter, n = n[len(n)-1], n[:len(n)-1]
+			if v.letter < 'a' || v.letter > 'z' {
+				return v, invalid
+			}
+		}
+		number, err := strconv.Atoi(n)
+		if err != nil || n[0] == '+' || n[0] == '-' {
+			return v, invalid
+		}
+		v.numbers = append(v.numbers, number)
+	}
+	if suffixes == "" {
+		return v, nil
+	}
+	for _, suffix := range strings.Split(suffixes, "_") {
+		name := strings.TrimRight(suffix, "0123456789")
+		order := -1
+		for i, known := range alpineSuffixes {
+			if known != "" && known == name {
+				order = i
+			}
+		}
+		if order < 0 {
+			return v, invalid
+		}
+		parsed := alpineSuffix{order: order}
+		if number := suffix[len(name):]; number != "" {
+			parsed.number, _ = strconv.Atoi(number)
+		}
+		v.suffixes = append(v.suffixes, parsed)
+	}
+	return v, nil
+}
+
+// compareAlpine compares Alpine versions as apk does.
+func compareAlpine(a, b string) (int, error) {
+	va, err := parseAlpine(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseAlpine(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < min(len(va.numbers), len(vb.numbers)); i++ {
+		if c := compareInt(va.numbers[i], vb.numbers[i]); c != 0 {
+			return c, nil
+		}
+	}
+	if c := compareInt(len(va.numbers), len(vb.numbers)); c != 0 {
+		return c, nil
+	}
+	if c := compareInt(int(va.letter), int(vb.letter)); c != 0 {
+		return c, nil
+	}
+	// a missing suffix sorts as the version without suffix
+	none := alpineSuffix{order: slices.Index(alpineSuffixes, "")}
+	for i := 0; i < max(len(va.suffixes), len(vb.suffixes)); i++ {
+		sa, sb := none, none
+		if i < len(va.suffixes) {
+			sa = va.suffixes[i]
+		}
+		if i < len(vb.suffixes) {
+			sb = vb.suffixes[i]
+		}
+		if c := compareInt(sa.order, sb.order); c != 0 {
+			return c, nil
+		}
+		if c := compareInt(sa.number, sb.number); c != 0 {
+			return c, nil
+		}
+	}
+	if c := strings.Compare(va.hash, vb.hash); c != 0 {
+		return c, nil
+	}
+	return compareInt(va.build, vb.build), nil
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// digitPrefix returns the leading digits of s.
+func digitPrefix(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i]
+}
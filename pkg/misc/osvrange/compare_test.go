@@ -0,0 +1,81 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvrange
+
+import "testing"
+
+func TestCompareDebian(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.36-9+deb12u3", "2.36-9+deb12u4", -1},
+		{"1:1.2.3-1", "2.0-1", 1},
+		{"1.0~rc1-1", "1.0-1", -1},
+		{"1.0-1", "1.0-1~bpo1", 1},
+		{"1.10", "1.9", 1},
+		{"1.01", "1.1", 0},
+		{"1.0a", "1.0+", -1},
+		{"1.0", "1.0-0", 0},
+		{"0:1.0", "1.0", 0},
+	}
+	for _, tt := range tests {
+		got, err := compareDebian(tt.a, tt.b)
+		if err != nil {
+			t.Errorf("compareDebian(%q, %q) error = %v", tt.a, tt.b, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("compareDebian(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	for _, v := range []string{"a:1.0", "abc", ""} {
+		if _, err := compareDebian(v, "1.0"); err == nil {
+			t.Errorf("compareDebian(%q) did not fail", v)
+		}
+	}
+}
+
+func TestCompareAlpine(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3-r0", "1.2.3-r1", -1},
+		{"1.2.3_rc1-r0", "1.2.3-r0", -1},
+		{"1.2.3_p1-r0", "1.2.3-r0", 1},
+		{"1.2.3a-r0", "1.2.3-r0", 1},
+		{"1.2.10", "1.2.9", 1},
+		{"1.2", "1.2.0", -1},
+		{"1.2_alpha2", "1.2_beta1", -1},
+		{"3.0.8-r0", "3.0.8-r0", 0},
+	}
+	for _, tt := range tests {
+		got, err := compareAlpine(tt.a, tt.b)
+		if err != nil {
+			t.Errorf("compareAlpine(%q, %q) error = %v", tt.a, tt.b, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("compareAlpine(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	for _, v := range []string{"abc", "1.2_foo", "1.2-rx", ""} {
+		if _, err := compareAlpine(v, "1.0"); err == nil {
+			t.Errorf("compareAlpine(%q) did not fail", v)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osvrange maps the affected packages and version ranges of OSV
+// records to purls and to the version constraints of depversion.
+package osvrange
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	osv_models "github.com/google/osv-scanner/pkg/models"
+	"github.com/guacsec/guac/pkg/misc/depversion"
+	"github.com/package-url/packageurl-go"
+)
+
+// purlTypes are the purl types of the OSV ecosystems, the ecosystems with a
+// namespace for all their packages are keyed as type/namespace.
+var purlTypes = map[osv_models.Ecosystem]string{
+	osv_models.EcosystemGo:        packageurl.TypeGolang,
+	osv_models.EcosystemNPM:       packageurl.TypeNPM,
+	osv_models.EcosystemPyPI:      packageurl.TypePyPi,
+	osv_models.EcosystemRubyGems:  packageurl.TypeGem,
+	osv_models.EcosystemCratesIO:  packageurl.TypeCargo,
+	osv_models.EcosystemPackagist: packageurl.TypeComposer,
+	osv_models.EcosystemMaven:     packageurl.TypeMaven,
+	osv_models.EcosystemNuGet:     packageurl.TypeNuget,
+	osv_models.EcosystemHex:       packageurl.TypeHex,
+	osv_models.EcosystemPub:       packageurl.TypePub,
+	osv_models.EcosystemDebian:    packageurl.TypeDebian + "/debian",
+	osv_models.EcosystemAlpine:    packageurl.TypeApk + "/alpine",
+	"Ubuntu":                      packageurl.TypeDebian + "/ubuntu",
+}
+
+// PackageURL returns the purl, without version, of an affected package. The
+// purls of the distribution packages do not hold their release, which Release
+// returns.
+func PackageURL(pkg osv_models.Package) (string, error) {
+	if pkg.Purl != "" {
+		return pkg.Purl, nil
+	}
+	// the distribution ecosystems are suffixed with their release, as in
+	// Debian:11
+	ecosystem, _, _ := strings.Cut(string(pkg.Ecosystem), ":")
+	purlType, ok := purlTypes[osv_models.Ecosystem(ecosystem)]
+	if !ok {
+		return "", fmt.Errorf("unsupported OSV ecosystem %q", pkg.Ecosystem)
+	}
+	purlType, namespace, _ := strings.Cut(purlType, "/")
+
+	name := pkg.Name
+	switch purlType {
+	case packageurl.TypeMaven:
+		if i := strings.LastIndex(name, ":"); i >= 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	case packageurl.TypeGolang, packageurl.TypeNPM, packageurl.TypeComposer:
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	}
+	return packageurl.NewPackageURL(purlType, namespace, name, "", nil, "").ToString(), nil
+}
+
+// debianCodenames are the releases of the Debian codenames used as distro
+// qualifiers.
+var debianCodenames = map[string]string{
+	"stretch":  "9",
+	"buster":   "10",
+	"bullseye": "11",
+	"bookworm": "12",
+	"trixie":   "13",
+}
+
+// Release returns the release of a package of a distribution ecosystem, as
+// the distro qualifier of its purls: debian-11 for Debian:11, ubuntu-22.04
+// for Ubuntu:22.04:LTS and alpine-3.18 for Alpine:v3.18. It returns "" for
+// the other ecosystems and the distribution packages without release.
+func Release(pkg osv_models.Package) string {
+	fields := strings.Split(string(pkg.Ecosystem), ":")
+	purlType, ok := purlTypes[osv_models.Ecosystem(fields[0])]
+	if !ok {
+		return ""
+	}
+	_, namespace, _ := strings.Cut(purlType, "/")
+	// Ubuntu releases are followed, and sometimes preceded, by the flavor, as
+	// in Ubuntu:Pro:18.04:LTS
+	for _, f := range fields[1:] {
+		if r := release(namespace, f); r != "" {
+			return r
+		}
+	}
+	return ""
+}
+
+// PurlRelease returns the release of the distro qualifier of a purl of a
+// distribution package, as returned by Release, or "" if it has none. The
+// qualifier is matched by major release for Debian and by minor release for
+// Ubuntu and Alpine, so debian-12.4, bookworm and alpine-3.18.4 are releases
+// too.
+func PurlRelease(p packageurl.PackageURL) string {
+	if p.Type != packageurl.TypeDebian && p.Type != packageurl.TypeApk {
+		return ""
+	}
+	distro, ok := p.Qualifiers.Map()["distro"]
+	if !ok {
+		return ""
+	}
+	return release(strings.ToLower(p.Namespace), distro)
+}
+
+// release returns the release of a distribution as namespace-release, or ""
+// if s is not one.
+func release(namespace, s string) string {
+	s = strings.TrimPrefix(strings.ToLower(s), namespace+"-")
+	s = strings.TrimPrefix(s, "v")
+	n := 2
+	if namespace == "debian" {
+		if r, ok := debianCodenames[s]; ok {
+			s = r
+		}
+		n = 1
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) < n {
+		return ""
+	}
+	for _, part := range parts[:n] {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return ""
+		}
+	}
+	return namespace + "-" + strings.Join(parts[:n], ".")
+}
+
+// Interval is a range of affected versions or commits, from Introduced,
+// included, up to Fixed, excluded, or up to LastAffected, included. At most one
+// of Fixed and LastAffected is set.
+type Interval struct {
+	Introduced   string
+	Fixed        string
+	LastAffected string
+}
+
+// Intervals returns the intervals of affected versions or commits delimited
+// by the events of a range.
+func Intervals(r osv_models.Range) []Interval {
+	var intervals []Interval
+	var open *Interval
+	for _, e := range r.Events {
+		switch {
+		case e.Introduced != "":
+			// an introduced event without a closing event is affected
+			// onwards
+			if open != nil {
+				intervals = append(intervals, *open)
+			}
+			open = &Interval{Introduced: e.Introduced}
+		case e.Fixed != "" && open != nil:
+			open.Fixed = e.Fixed
+			intervals = append(intervals, *open)
+			open = nil
+		case e.LastAffected != "" && open != nil:
+			open.LastAffected = e.LastAffected
+			intervals = append(intervals, *open)
+			open = nil
+		case e.Limit != "" && open != nil:
+			open.Fixed = e.Limit
+			intervals = append(intervals, *open)
+			open = nil
+		}
+	}
+	if open != nil {
+		intervals = append(intervals, *open)
+	}
+	return intervals
+}
+
+// Constraint returns the version constraint of a SEMVER or ECOSYSTEM range, as
+// understood by depversion, or "" for the other ranges.
+func Constraint(r osv_models.Range) string {
+	if r.Type != osv_models.RangeSemVer && r.Type != osv_models.RangeEcosystem {
+		return ""
+	}
+	var constraints []string
+	for _, i := range Intervals(r) {
+		constraints = append(constraints, depversion.IntervalConstraint(i.Introduced, i.Fixed, i.LastAffected))
+	}
+	return strings.Join(constraints, " || ")
+}
+
+// Affects reports whether version of the package is affected: whether it is
+// listed by the affected package or in one of its SEMVER or ECOSYSTEM ranges,
+// whose versions are compared as in the ecosystem of the package. It fails
+// when version is not listed and can't be compared with the ranges. The
+// release of a distribution package is not checked, the caller only matches
+// the packages of the release of version, see Release.
+//
+// The vulnerability ranges ingested in the graph are matched with depversion
+// instead, as they only keep the purl type of the package and not its OSV
+// ecosystem, whose comparators such as the dpkg and apk ones are used here.
+func Affects(a osv_models.Affected, version string) (bool, error) {
+	if slices.Contains(a.Versions, version) {
+		return true, nil
+	}
+	var errs []error
+	for _, r := range a.Ranges {
+		if r.Type != osv_models.RangeSemVer && r.Type != osv_models.RangeEcosystem {
+			continue
+		}
+		compare, err := rangeComparator(r.Type, a.Package.Ecosystem)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, i := range Intervals(r) {
+			ok, err := i.contains(compare, version)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if ok {
+				return true, nil
+			}
+		}
+	}
+	return false, errors.Join(errs...)
+}
+
+// contains reports whether version is in the interval of versions.
+func (i Interval) contains(compare comparator, version string) (bool, error) {
+	// introduced 0 is the first version of the package
+	if i.Introduced != "" && i.Introduced != "0" {
+		c, err := compare(version, i.Introduced)
+		if err != nil || c < 0 {
+			return false, err
+		}
+	}
+	switch {
+	case i.Fixed != "":
+		c, err := compare(version, i.Fixed)
+		return c < 0, err
+	case i.LastAffected != "":
+		c, err := compare(version, i.LastAffected)
+		return c <= 0, err
+	}
+	// an interval without end affects any valid version
+	_, err := compare(version, version)
+	return err == nil, err
+}
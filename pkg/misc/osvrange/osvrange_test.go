@@ -0,0 +1,251 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvrange
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	osv_models "github.com/google/osv-scanner/pkg/models"
+	"github.com/package-url/packageurl-go"
+)
+
+func TestPackageURL(t *testing.T) {
+	tests := []struct {
+		pkg     osv_models.Package
+		want    string
+		wantErr bool
+	}{
+		{pkg: osv_models.Package{Ecosystem: "Maven", Name: "org.apache.commons:commons-text"}, want: "pkg:maven/org.apache.commons/commons-text"},
+		{pkg: osv_models.Package{Ecosystem: "npm", Name: "@babel/traverse"}, want: "pkg:npm/%40babel/traverse"},
+		{pkg: osv_models.Package{Ecosystem: "npm", Name: "lodash"}, want: "pkg:npm/lodash"},
+		{pkg: osv_models.Package{Ecosystem: "Go", Name: "golang.org/x/net"}, want: "pkg:golang/golang.org/x/net"},
+		{pkg: osv_models.Package{Ecosystem: "Debian:11", Name: "openssl"}, want: "pkg:deb/debian/openssl"},
+		{pkg: osv_models.Package{Ecosystem: "PyPI", Name: "django", Purl: "pkg:pypi/django"}, want: "pkg:pypi/django"},
+		{pkg: osv_models.Package{Ecosystem: "Linux", Name: "Kernel"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pkg.Name, func(t *testing.T) {
+			got, err := PackageURL(tt.pkg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PackageURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PackageURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelease(t *testing.T) {
+	tests := []struct {
+		ecosystem osv_models.Ecosystem
+		want      string
+	}{
+		{ecosystem: "Debian:11", want: "debian-11"},
+		{ecosystem: "Ubuntu:22.04:LTS", want: "ubuntu-22.04"},
+		{ecosystem: "Ubuntu:Pro:18.04:LTS", want: "ubuntu-18.04"},
+		{ecosystem: "Alpine:v3.18", want: "alpine-3.18"},
+		{ecosystem: "Debian", want: ""},
+		{ecosystem: "Go", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.ecosystem), func(t *testing.T) {
+			if got := Release(osv_models.Package{Ecosystem: tt.ecosystem, Name: "foo"}); got != tt.want {
+				t.Errorf("Release() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPurlRelease(t *testing.T) {
+	tests := []struct {
+		purl string
+		want string
+	}{
+		{purl: "pkg:deb/debian/openssl@3.0.11-1~deb12u1?distro=debian-12", want: "debian-12"},
+		{purl: "pkg:deb/debian/openssl@3.0.11-1~deb12u1?distro=debian-12.4", want: "debian-12"},
+		{purl: "pkg:deb/debian/openssl@3.0.11-1~deb12u1?distro=bookworm", want: "debian-12"},
+		{purl: "pkg:deb/ubuntu/openssl@3.0.2-0ubuntu1.10?distro=ubuntu-22.04", want: "ubuntu-22.04"},
+		{purl: "pkg:apk/alpine/musl@1.2.4-r2?distro=alpine-3.18.4", want: "alpine-3.18"},
+		{purl: "pkg:apk/alpine/musl@1.2.4-r2?distro=3.18.4", want: "alpine-3.18"},
+		{purl: "pkg:deb/debian/openssl@3.0.11-1~deb12u1", want: ""},
+		{purl: "pkg:pypi/django@4.2?distro=debian-12", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			p, err := packageurl.FromString(tt.purl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := PurlRelease(p); got != tt.want {
+				t.Errorf("PurlRelease() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	tests := []struct {
+		name string
+		r    osv_models.Range
+		want string
+	}{{
+		name: "fixed",
+		r:    osv_models.Range{Type: osv_models.RangeEcosystem, Events: []osv_models.Event{{Introduced: "1.5"}, {Fixed: "1.10.0"}}},
+		want: ">=1.5,<1.10.0",
+	}, {
+		name: "several ranges",
+		r: osv_models.Range{Type: osv_models.RangeSemVer, Events: []osv_models.Event{
+			{Introduced: "0"}, {Fixed: "1.2.3"}, {Introduced: "2.0.0"}, {LastAffected: "2.1.0"}, {Introduced: "3.0.0"},
+		}},
+		want: ">=0,<1.2.3 || >=2.0.0,<=2.1.0 || >=3.0.0",
+	}, {
+		name: "git",
+		r:    osv_models.Range{Type: osv_models.RangeGit, Events: []osv_models.Event{{Introduced: "0"}, {Fixed: "abc"}}},
+		want: "",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Constraint(tt.r); got != tt.want {
+				t.Errorf("Constraint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervals(t *testing.T) {
+	r := osv_models.Range{Type: osv_models.RangeGit, Events: []osv_models.Event{
+		{Introduced: "0"}, {Fixed: "abc"}, {Introduced: "def"}, {LastAffected: "123"}, {Introduced: "456"}, {Limit: "789"},
+	}}
+	want := []Interval{
+		{Introduced: "0", Fixed: "abc"},
+		{Introduced: "def", LastAffected: "123"},
+		{Introduced: "456", Fixed: "789"},
+	}
+	if diff := cmp.Diff(want, Intervals(r)); diff != "" {
+		t.Errorf("Intervals() (-want +got):\n%s", diff)
+	}
+}
+
+func TestAffects(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected osv_models.Affected
+		version  string
+		want     bool
+		wantErr  bool
+	}{{
+		name: "listed version",
+		affected: osv_models.Affected{
+			Package:  osv_models.Package{Ecosystem: osv_models.EcosystemPyPI, Name: "foo"},
+			Versions: []string{"not a version"},
+		},
+		version: "not a version",
+		want:    true,
+	}, {
+		name: "semver prerelease before fixed",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemNPM, Name: "foo"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeSemVer, Events: []osv_models.Event{{Introduced: "0"}, {Fixed: "1.2.3"}}}},
+		},
+		version: "1.2.0-beta.1",
+		want:    true,
+	}, {
+		name: "semver last affected",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemCratesIO, Name: "foo"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeSemVer, Events: []osv_models.Event{{Introduced: "0"}, {LastAffected: "0.9.2"}}}},
+		},
+		version: "0.9.2",
+		want:    true,
+	}, {
+		name: "maven four components",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemMaven, Name: "com.fasterxml.jackson.core:jackson-databind"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeEcosystem, Events: []osv_models.Event{{Introduced: "2.13.0"}, {Fixed: "2.13.5"}}}},
+		},
+		version: "2.13.4.2",
+		want:    true,
+	}, {
+		name: "maven fixed",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemMaven, Name: "com.fasterxml.jackson.core:jackson-databind"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeEcosystem, Events: []osv_models.Event{{Introduced: "2.13.0"}, {Fixed: "2.13.5"}}}},
+		},
+		version: "2.13.5",
+		want:    false,
+	}, {
+		name: "debian revision",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: "Debian:12", Name: "glibc"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeEcosystem, Events: []osv_models.Event{{Introduced: "0"}, {Fixed: "2.36-9+deb12u4"}}}},
+		},
+		version: "2.36-9+deb12u3",
+		want:    true,
+	}, {
+		name: "debian epoch",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: "Debian:12", Name: "foo"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeEcosystem, Events: []osv_models.Event{{Introduced: "0"}, {Fixed: "2.0-1"}}}},
+		},
+		version: "1:1.2.3-1",
+		want:    false,
+	}, {
+		name: "go without v prefix",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemGo, Name: "golang.org/x/net"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeSemVer, Events: []osv_models.Event{{Introduced: "0"}, {Fixed: "0.23.0"}}}},
+		},
+		version: "v0.20.0",
+		want:    true,
+	}, {
+		name: "invalid version",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemNPM, Name: "foo"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeSemVer, Events: []osv_models.Event{{Introduced: "0"}, {Fixed: "1.2.3"}}}},
+		},
+		version: "abc",
+		wantErr: true,
+	}, {
+		name: "unknown ecosystem",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: "Unknown", Name: "foo"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeEcosystem, Events: []osv_models.Event{{Introduced: "0"}}}},
+		},
+		version: "1.0",
+		wantErr: true,
+	}, {
+		name: "git ranges ignored",
+		affected: osv_models.Affected{
+			Package: osv_models.Package{Ecosystem: osv_models.EcosystemNPM, Name: "foo"},
+			Ranges:  []osv_models.Range{{Type: osv_models.RangeGit, Events: []osv_models.Event{{Introduced: "0"}}}},
+		},
+		version: "1.0.0",
+		want:    false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Affects(tt.affected, tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Affects() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Affects() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
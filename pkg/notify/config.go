@@ -0,0 +1,199 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"gopkg.in/yaml.v3"
+)
+
+// The events rules can be evaluated on.
+const (
+	EventCertifyVuln      = "certifyVuln"
+	EventVEXStatusChanged = "vexStatusChanged"
+	EventCertifyBad       = "certifyBad"
+	EventHasSBOM          = "hasSBOM"
+)
+
+const (
+	defaultMaxAttempts    = 8
+	defaultInitialBackoff = 5 * time.Second
+	defaultMaxBackoff     = 10 * time.Minute
+	defaultTimeout        = 10 * time.Second
+)
+
+// Config is the notifier configuration, usually loaded from YAML:
+//
+//	webhooks:
+//	  - name: security
+//	    url: https://hooks.example.com/guac
+//	    secretEnv: GUAC_SECURITY_WEBHOOK_SECRET
+//	rules:
+//	  - name: critical-ourorg
+//	    event: certifyVuln
+//	    purl: pkg:golang/github.com/ourorg/*
+//	    minScore: 9.0
+//	    webhooks: [security]
+type Config struct {
+	Webhooks []Webhook `yaml:"webhooks"`
+	Rules    []Rule    `yaml:"rules"`
+	// MaxAttempts is the number of delivery attempts before a delivery is
+	// moved to the failed directory of the spool. It also bounds the attempts
+	// to evaluate an event before it is moved to the failed-events directory.
+	MaxAttempts int `yaml:"maxAttempts"`
+	// InitialBackoff is the delay before the first retry. It doubles on each
+	// attempt up to MaxBackoff.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+}
+
+// Webhook is an HTTP endpoint receiving notifications as JSON POST requests.
+type Webhook struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Secret signs the request bodies with HMAC-SHA256. SecretEnv names an
+	// environment variable holding the secret instead.
+	Secret    string        `yaml:"secret"`
+	SecretEnv string        `yaml:"secretEnv"`
+	Timeout   time.Duration `yaml:"timeout"`
+}
+
+// Rule selects the events to deliver to a set of webhooks. All conditions
+// that are set must match.
+type Rule struct {
+	Name string `yaml:"name"`
+	// Event is one of certifyVuln, vexStatusChanged, certifyBad or hasSBOM.
+	Event string `yaml:"event"`
+	// Purl is matched against the purl of the package the event is about.
+	// A '*' matches any sequence of characters, including '/'. Events about
+	// sources and artifacts never match a rule with a purl.
+	Purl string `yaml:"purl"`
+	// MinScore is the minimum vulnerability score (from the vulnerability
+	// metadata) of certifyVuln events.
+	MinScore float64 `yaml:"minScore"`
+	// VexStatus is the status vexStatusChanged events must flip to, e.g.
+	// AFFECTED.
+	VexStatus string `yaml:"vexStatus"`
+	// Tenant restricts the rule to the events of a tenant.
+	Tenant   string   `yaml:"tenant"`
+	Webhooks []string `yaml:"webhooks"`
+
+	purl *regexp.Regexp
+}
+
+// LoadConfig reads and validates a notifier configuration file.
+func LoadConfig(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading notify config: %w", err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing notify config: %w", err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid notify config: %w", err)
+	}
+	return &cfg, nil
+}
+
+func (c *Config) validate() error {
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = defaultInitialBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = defaultMaxBackoff
+	}
+	if c.MaxAttempts < 0 || c.InitialBackoff < 0 || c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("invalid retry policy")
+	}
+
+	webhooks := map[string]bool{}
+	for i := range c.Webhooks {
+		w := &c.Webhooks[i]
+		if w.Name == "" || webhooks[w.Name] {
+			return fmt.Errorf("webhook %d: missing or duplicate name %q", i, w.Name)
+		}
+		webhooks[w.Name] = true
+		u, err := url.Parse(w.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook %s: invalid url %q", w.Name, w.URL)
+		}
+		if w.SecretEnv != "" {
+			w.Secret = os.Getenv(w.SecretEnv)
+			if w.Secret == "" {
+				return fmt.Errorf("webhook %s: environment variable %s is empty", w.Name, w.SecretEnv)
+			}
+		}
+		if w.Timeout == 0 {
+			w.Timeout = defaultTimeout
+		}
+	}
+
+	rules := map[string]bool{}
+	for i, r := range c.Rules {
+		if r.Name == "" || rules[r.Name] {
+			return fmt.Errorf("rule %d: missing or duplicate name %q", i, r.Name)
+		}
+		rules[r.Name] = true
+		switch r.Event {
+		case EventCertifyVuln, EventVEXStatusChanged, EventCertifyBad, EventHasSBOM:
+		default:
+			return fmt.Errorf("rule %s: unknown event %q", r.Name, r.Event)
+		}
+		if r.MinScore != 0 && r.Event != EventCertifyVuln {
+			return fmt.Errorf("rule %s: minScore only applies to %s events", r.Name, EventCertifyVuln)
+		}
+		if r.VexStatus != "" {
+			if r.Event != EventVEXStatusChanged {
+				return fmt.Errorf("rule %s: vexStatus only applies to %s events", r.Name, EventVEXStatusChanged)
+			}
+			if !model.VexStatus(r.VexStatus).IsValid() {
+				return fmt.Errorf("rule %s: invalid vexStatus %q", r.Name, r.VexStatus)
+			}
+		}
+		if r.Purl != "" {
+			c.Rules[i].purl = compileGlob(r.Purl)
+		}
+		if len(r.Webhooks) == 0 {
+			return fmt.Errorf("rule %s: no webhooks", r.Name)
+		}
+		for _, w := range r.Webhooks {
+			if !webhooks[w] {
+				return fmt.Errorf("rule %s: unknown webhook %q", r.Name, w)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) webhook(name string) *Webhook {
+	for i := range c.Webhooks {
+		if c.Webhooks[i].Name == name {
+			return &c.Webhooks[i]
+		}
+	}
+	return nil
+}
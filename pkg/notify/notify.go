@@ -0,0 +1,357 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notify evaluates rules on the predicates published on the
+// assembler event bus and delivers matching notifications to webhooks.
+//
+// Events are persisted in a spool directory as soon as they are received and
+// deliveries are kept there until the webhook accepts them, so that restarts
+// do not lose notifications. Webhooks must be idempotent: a delivery may be
+// sent more than once, with the same X-Guac-Delivery header.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	// SignatureHeader carries "sha256=" followed by the hex encoded
+	// HMAC-SHA256 of the request body, keyed with the webhook secret.
+	SignatureHeader = "X-Guac-Signature-256"
+	// DeliveryHeader carries the ID of the delivery. It is derived from the
+	// event, rule and webhook, so it is the same on every attempt.
+	DeliveryHeader = "X-Guac-Delivery"
+	// RuleHeader carries the name of the rule that matched.
+	RuleHeader = "X-Guac-Rule"
+
+	// pollInterval bounds the time between two passes over the spool, so
+	// that events which failed to evaluate are retried.
+	pollInterval = 30 * time.Second
+)
+
+// Notifier evaluates rules on ingestion events and delivers notifications.
+type Notifier struct {
+	cfg     *Config
+	backend backends.Backend
+	spool   *spool
+	client  *http.Client
+	wake    chan struct{}
+}
+
+// New returns a notifier that reads predicates from backend and persists its
+// state in spoolDir.
+func New(cfg *Config, backend backends.Backend, spoolDir string) (*Notifier, error) {
+	s, err := newSpool(spoolDir)
+	if err != nil {
+		return nil, err
+	}
+	return &Notifier{
+		cfg:     cfg,
+		backend: backend,
+		spool:   s,
+		client:  &http.Client{},
+		wake:    make(chan struct{}, 1),
+	}, nil
+}
+
+// Run processes the events published on bus until ctx is done. Events and
+// deliveries left in the spool by a previous run are processed first.
+func (n *Notifier) Run(ctx context.Context, bus *events.Bus) {
+	logger := logging.FromContext(ctx)
+	// the events are spooled as they are published so that none is dropped
+	// before being persisted
+	bus.Handle(ctx, func(e events.Event) {
+		if err := n.spool.addEvent(e); err != nil {
+			logger.Errorf("notify: failed to persist %s event %s: %v", e.Kind, e.ID, err)
+			return
+		}
+		n.nudge()
+	}, events.CertifyVulnIngested, events.VEXStatementIngested, events.CertifyBadIngested, events.HasSBOMIngested)
+
+	for {
+		next := n.processEvents(ctx)
+		if d := n.dispatch(ctx); !d.IsZero() && (next.IsZero() || d.Before(next)) {
+			next = d
+		}
+
+		wait := pollInterval
+		if !next.IsZero() {
+			wait = min(wait, max(time.Until(next), 0))
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-n.wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+func (n *Notifier) nudge() {
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+}
+
+// processEvents evaluates the rules on the spooled events and turns them into
+// deliveries. Events that cannot be evaluated stay in the spool and are
+// retried with the delivery backoff, until they run out of attempts and are
+// moved to the failed-events directory.
+func (n *Notifier) processEvents(ctx context.Context) time.Time {
+	logger := logging.FromContext(ctx)
+	evs, names, corrupt, err := n.spool.pendingEvents()
+	if err != nil {
+		logger.Errorf("notify: %v", err)
+		return time.Time{}
+	}
+	for _, err := range corrupt {
+		logger.Errorf("notify: %v", err)
+	}
+	var next time.Time
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return time.Time{}
+		}
+		e := evs[name]
+		if e.NextAttempt.After(time.Now()) {
+			if next.IsZero() || e.NextAttempt.Before(next) {
+				next = e.NextAttempt
+			}
+			continue
+		}
+		deliveries, err := n.evaluate(ctx, e.Event)
+		if err != nil {
+			e.Attempts++
+			e.LastError = err.Error()
+			if e.Attempts >= n.cfg.MaxAttempts {
+				logger.Errorf("notify: giving up on %s event %s after %d attempts: %v", e.Kind, e.ID, e.Attempts, err)
+				if err := n.spool.failEvent(name, e); err != nil {
+					logger.Errorf("notify: failed to move event %s to failed-events: %v", e.ID, err)
+				}
+				continue
+			}
+			e.NextAttempt = time.Now().Add(n.backoff(e.Attempts))
+			logger.Errorf("notify: failed to evaluate %s event %s, retrying at %s: %v", e.Kind, e.ID, e.NextAttempt.Format(time.RFC3339), err)
+			if err := n.spool.retryEvent(name, e); err != nil {
+				logger.Errorf("notify: failed to persist event %s: %v", e.ID, err)
+			}
+			if next.IsZero() || e.NextAttempt.Before(next) {
+				next = e.NextAttempt
+			}
+			continue
+		}
+		saved := true
+		for _, d := range deliveries {
+			if err := n.spool.saveDelivery(d); err != nil {
+				logger.Errorf("notify: failed to persist delivery: %v", err)
+				saved = false
+			}
+		}
+		if !saved {
+			continue
+		}
+		if err := n.spool.removeEvent(name); err != nil {
+			logger.Errorf("notify: failed to remove processed event: %v", err)
+		}
+	}
+	return next
+}
+
+// evaluate returns the deliveries for all rules matching an event.
+func (n *Notifier) evaluate(ctx context.Context, e events.Event) ([]*Delivery, error) {
+	f, err := loadFact(ctx, n.backend, e)
+	if err != nil || f == nil {
+		return nil, err
+	}
+	var deliveries []*Delivery
+	for i := range n.cfg.Rules {
+		r := &n.cfg.Rules[i]
+		ok, err := r.matches(ctx, n.backend, e, f)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		// the score is useful to receivers even if the rule does not need it
+		if err := f.loadScore(ctx, n.backend, e.Tenant); err != nil {
+			return nil, err
+		}
+		for _, w := range r.Webhooks {
+			d := &Delivery{ID: deliveryID(e, r.Name, w), Webhook: w, Rule: r.Name, NextAttempt: time.Now()}
+			body, err := json.Marshal(Notification{
+				Delivery:       d.ID,
+				Rule:           r.Name,
+				Event:          f.event,
+				Tenant:         e.Tenant,
+				Timestamp:      time.Now().UTC(),
+				Subject:        f.subject,
+				Vulnerability:  f.vulnerability,
+				Score:          f.score,
+				Status:         f.status,
+				PreviousStatus: f.previousStatus,
+				Node:           f.node,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error encoding notification: %w", err)
+			}
+			d.Body = body
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries, nil
+}
+
+// dispatch sends the deliveries that are due and returns when the next one
+// will be due, or the zero time if none is pending.
+func (n *Notifier) dispatch(ctx context.Context) time.Time {
+	logger := logging.FromContext(ctx)
+	deliveries, corrupt, err := n.spool.pendingDeliveries()
+	if err != nil {
+		logger.Errorf("notify: %v", err)
+		return time.Time{}
+	}
+	for _, err := range corrupt {
+		logger.Errorf("notify: %v", err)
+	}
+	var next time.Time
+	for _, d := range deliveries {
+		if ctx.Err() != nil {
+			return time.Time{}
+		}
+		if d.NextAttempt.After(time.Now()) {
+			if next.IsZero() || d.NextAttempt.Before(next) {
+				next = d.NextAttempt
+			}
+			continue
+		}
+
+		w := n.cfg.webhook(d.Webhook)
+		if w == nil {
+			err = fmt.Errorf("webhook %q is not configured anymore", d.Webhook)
+		} else {
+			err = n.send(ctx, w, d)
+		}
+		if err == nil {
+			if err := n.spool.removeDelivery(d); err != nil {
+				logger.Errorf("notify: failed to remove sent delivery %s: %v", d.ID, err)
+			}
+			continue
+		}
+
+		d.Attempts++
+		d.LastError = err.Error()
+		if w == nil || d.Attempts >= n.cfg.MaxAttempts {
+			logger.Errorf("notify: giving up on delivery %s to %s after %d attempts: %v", d.ID, d.Webhook, d.Attempts, err)
+			if err := n.spool.failDelivery(d); err != nil {
+				logger.Errorf("notify: failed to move delivery %s to failed: %v", d.ID, err)
+			}
+			continue
+		}
+		d.NextAttempt = time.Now().Add(n.backoff(d.Attempts))
+		logger.Warnf("notify: delivery %s to %s failed, retrying at %s: %v", d.ID, d.Webhook, d.NextAttempt.Format(time.RFC3339), err)
+		if err := n.spool.saveDelivery(d); err != nil {
+			logger.Errorf("notify: failed to persist delivery %s: %v", d.ID, err)
+		}
+		if next.IsZero() || d.NextAttempt.Before(next) {
+			next = d.NextAttempt
+		}
+	}
+	return next
+}
+
+func (n *Notifier) backoff(attempts int) time.Duration {
+	b := n.cfg.InitialBackoff
+	for i := 1; i < attempts && b < n.cfg.MaxBackoff; i++ {
+		b *= 2
+	}
+	return min(b, n.cfg.MaxBackoff)
+}
+
+func (n *Notifier) send(ctx context.Context, w *Webhook, d *Delivery) error {
+	ctx, cancel := context.WithTimeout(ctx, w.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "guac-notify/"+version.Version)
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(RuleHeader, d.Rule)
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign([]byte(w.Secret), d.Body))
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the value of the SignatureHeader for body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the SignatureHeader of a received notification. Receivers
+// written in Go can use it to authenticate deliveries.
+func Verify(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// deliveryID identifies the delivery of an event to a webhook for a rule. It
+// does not change when the event is evaluated again, for instance after a
+// crash before the event was removed from the spool.
+func deliveryID(e events.Event, rule, webhook string) string {
+	h := sha256.New()
+	for _, s := range []string{string(e.Kind), e.ID, e.Tenant, rule, webhook} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+func randomID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
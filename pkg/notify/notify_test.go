@@ -0,0 +1,577 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/mocks"
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"go.uber.org/mock/gomock"
+)
+
+const secret = "s3cr3t"
+
+var t1 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func pkg(ns, name string) *model.Package {
+	return &model.Package{
+		ID:   "t-" + name,
+		Type: "golang",
+		Namespaces: []*model.PackageNamespace{{
+			ID:        "ns-" + name,
+			Namespace: ns,
+			Names: []*model.PackageName{{
+				ID:       "n-" + name,
+				Name:     name,
+				Versions: []*model.PackageVersion{{ID: "v-" + name, Version: "v1.0.0"}},
+			}},
+		}},
+	}
+}
+
+func vuln(id string) *model.Vulnerability {
+	return &model.Vulnerability{
+		ID:               "vt-" + id,
+		Type:             "cve",
+		VulnerabilityIDs: []*model.VulnerabilityID{{ID: "vid-" + id, VulnerabilityID: id}},
+	}
+}
+
+// fakeGraph serves the predicates referenced by events from the mock backend.
+type fakeGraph struct {
+	mu    sync.Mutex
+	vulns map[string]*model.CertifyVuln
+	vex   []*model.CertifyVEXStatement
+	bads  map[string]*model.CertifyBad
+	// scores by vulnerability ID node
+	scores map[string]float64
+}
+
+func newFakeGraph(t *testing.T) (*fakeGraph, *mocks.MockBackend) {
+	g := &fakeGraph{
+		vulns:  map[string]*model.CertifyVuln{},
+		bads:   map[string]*model.CertifyBad{},
+		scores: map[string]float64{},
+	}
+	b := mocks.NewMockBackend(gomock.NewController(t))
+	b.EXPECT().CertifyVuln(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+		func(_ context.Context, spec *model.CertifyVulnSpec, _ *time.Time) ([]*model.CertifyVuln, error) {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if cv, ok := g.vulns[*spec.ID]; ok {
+				return []*model.CertifyVuln{cv}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+	b.EXPECT().CertifyBad(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, spec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if *spec.ID == "unavailable" {
+				return nil, errors.New("backend unavailable")
+			}
+			if cb, ok := g.bads[*spec.ID]; ok {
+				return []*model.CertifyBad{cb}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+	b.EXPECT().CertifyVEXStatement(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+		func(_ context.Context, spec *model.CertifyVEXStatementSpec, _ *time.Time) ([]*model.CertifyVEXStatement, error) {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if spec.ID != nil {
+				for _, v := range g.vex {
+					if v.ID == *spec.ID {
+						return []*model.CertifyVEXStatement{v}, nil
+					}
+				}
+				return nil, nil
+			}
+			return g.vex, nil
+		}).AnyTimes()
+	b.EXPECT().VulnerabilityMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, spec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			if s, ok := g.scores[*spec.Vulnerability.ID]; ok {
+				return []*model.VulnerabilityMetadata{{ScoreType: model.VulnerabilityScoreTypeCVSSv3, ScoreValue: s}}, nil
+			}
+			return nil, nil
+		}).AnyTimes()
+	return g, b
+}
+
+// standIn is a local webhook receiver that can be told to fail.
+type standIn struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	received []*http.Request
+	bodies   [][]byte
+	got      chan struct{}
+}
+
+func newStandIn(t *testing.T, failures int) *standIn {
+	s := &standIn{failures: failures, got: make(chan struct{}, 100)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.received = append(s.received, r)
+		s.bodies = append(s.bodies, body)
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		s.got <- struct{}{}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *standIn) wait(t *testing.T) Notification {
+	t.Helper()
+	select {
+	case <-s.got:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for webhook delivery")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, body := s.received[len(s.received)-1], s.bodies[len(s.bodies)-1]
+	if !Verify([]byte(secret), body, r.Header.Get(SignatureHeader)) {
+		t.Errorf("invalid signature %q", r.Header.Get(SignatureHeader))
+	}
+	var n Notification
+	if err := json.Unmarshal(body, &n); err != nil {
+		t.Fatalf("invalid notification body: %v", err)
+	}
+	if r.Header.Get(DeliveryHeader) != n.Delivery || r.Header.Get(RuleHeader) != n.Rule {
+		t.Errorf("headers do not match body: %v", r.Header)
+	}
+	return n
+}
+
+func (s *standIn) none(t *testing.T) {
+	t.Helper()
+	select {
+	case <-s.got:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		t.Fatalf("unexpected delivery: %s", s.bodies[len(s.bodies)-1])
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func testConfig(url string) *Config {
+	cfg := &Config{
+		Webhooks: []Webhook{{Name: "hook", URL: url, Secret: secret}},
+		Rules: []Rule{
+			{Name: "critical-ourorg", Event: EventCertifyVuln, Purl: "pkg:golang/github.com/ourorg/*", MinScore: 9, Webhooks: []string{"hook"}},
+			{Name: "vex-affected", Event: EventVEXStatusChanged, VexStatus: "AFFECTED", Webhooks: []string{"hook"}},
+			{Name: "certify-bad", Event: EventCertifyBad, Webhooks: []string{"hook"}},
+		},
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+	}
+	return cfg
+}
+
+func startNotifier(t *testing.T, cfg *Config, b *mocks.MockBackend, dir string) (*events.Bus, context.CancelFunc) {
+	t.Helper()
+	if err := cfg.validate(); err != nil {
+		t.Fatalf("invalid config: %v", err)
+	}
+	n, err := New(cfg, b, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := events.NewBus()
+	done := make(chan struct{})
+	go func() {
+		n.Run(ctx, bus)
+		close(done)
+	}()
+	stop := func() {
+		cancel()
+		<-done
+	}
+	t.Cleanup(stop)
+	// wait for Run to subscribe
+	time.Sleep(20 * time.Millisecond)
+	return bus, stop
+}
+
+func TestRules(t *testing.T) {
+	g, b := newFakeGraph(t)
+	hook := newStandIn(t, 0)
+	bus, _ := startNotifier(t, testConfig(hook.URL), b, t.TempDir())
+
+	g.mu.Lock()
+	g.vulns["critical"] = &model.CertifyVuln{ID: "critical", Package: pkg("github.com/ourorg", "app"), Vulnerability: vuln("cve-1")}
+	g.vulns["low"] = &model.CertifyVuln{ID: "low", Package: pkg("github.com/ourorg", "app"), Vulnerability: vuln("cve-2")}
+	g.vulns["other"] = &model.CertifyVuln{ID: "other", Package: pkg("github.com/other", "lib"), Vulnerability: vuln("cve-1")}
+	g.vulns["novuln"] = &model.CertifyVuln{ID: "novuln", Package: pkg("github.com/ourorg", "app"), Vulnerability: &model.Vulnerability{Type: "novuln", VulnerabilityIDs: []*model.VulnerabilityID{{ID: "nv", VulnerabilityID: ""}}}}
+	g.scores["vid-cve-1"] = 9.8
+	g.scores["vid-cve-2"] = 4.0
+	g.mu.Unlock()
+
+	for _, id := range []string{"low", "other", "novuln"} {
+		bus.Publish(events.Event{Kind: events.CertifyVulnIngested, ID: id})
+	}
+	hook.none(t)
+
+	bus.Publish(events.Event{Kind: events.CertifyVulnIngested, ID: "critical", Tenant: "team-a"})
+	n := hook.wait(t)
+	if n.Rule != "critical-ourorg" || n.Subject != "pkg:golang/github.com/ourorg/app@v1.0.0" ||
+		n.Vulnerability != "cve-1" || n.Score == nil || *n.Score != 9.8 || n.Tenant != "team-a" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+
+	// VEX statements only notify when the status flips to AFFECTED
+	app := pkg("github.com/ourorg", "app")
+	vexes := []struct {
+		status  model.VexStatus
+		expSent bool
+	}{
+		{model.VexStatusUnderInvestigation, false},
+		{model.VexStatusAffected, true},
+		{model.VexStatusAffected, false},
+		{model.VexStatusFixed, false},
+	}
+	for i, v := range vexes {
+		id := string(rune('a' + i))
+		g.mu.Lock()
+		g.vex = append(g.vex, &model.CertifyVEXStatement{ID: id, Subject: app, Vulnerability: vuln("cve-1"), Status: v.status, KnownSince: t1.Add(time.Duration(i) * time.Hour)})
+		g.mu.Unlock()
+		bus.Publish(events.Event{Kind: events.VEXStatementIngested, ID: id})
+		if v.expSent {
+			n := hook.wait(t)
+			if n.Rule != "vex-affected" || n.Status != "AFFECTED" || n.PreviousStatus != "UNDER_INVESTIGATION" {
+				t.Errorf("unexpected notification: %+v", n)
+			}
+		} else {
+			hook.none(t)
+		}
+	}
+
+	g.mu.Lock()
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: &model.Artifact{ID: "a", Algorithm: "sha256", Digest: "abc"}, Justification: "malware"}
+	g.mu.Unlock()
+	bus.Publish(events.Event{Kind: events.CertifyBadIngested, ID: "bad"})
+	if n := hook.wait(t); n.Rule != "certify-bad" || n.Subject != "sha256:abc" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	g, b := newFakeGraph(t)
+	hook := newStandIn(t, 2)
+	bus, _ := startNotifier(t, testConfig(hook.URL), b, t.TempDir())
+
+	g.mu.Lock()
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: pkg("github.com/ourorg", "app")}
+	g.mu.Unlock()
+	bus.Publish(events.Event{Kind: events.CertifyBadIngested, ID: "bad"})
+
+	n := hook.wait(t)
+	hook.mu.Lock()
+	defer hook.mu.Unlock()
+	if len(hook.received) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(hook.received))
+	}
+	for _, r := range hook.received {
+		if r.Header.Get(DeliveryHeader) != n.Delivery {
+			t.Errorf("retries must reuse the delivery ID, got %s and %s", r.Header.Get(DeliveryHeader), n.Delivery)
+		}
+	}
+}
+
+func TestGiveUp(t *testing.T) {
+	g, b := newFakeGraph(t)
+	hook := newStandIn(t, 100)
+	dir := t.TempDir()
+	cfg := testConfig(hook.URL)
+	cfg.MaxAttempts = 2
+	bus, _ := startNotifier(t, cfg, b, dir)
+
+	g.mu.Lock()
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: pkg("github.com/ourorg", "app")}
+	g.mu.Unlock()
+	bus.Publish(events.Event{Kind: events.CertifyBadIngested, ID: "bad"})
+
+	waitForFiles(t, filepath.Join(dir, failedDir), 1)
+	waitForFiles(t, filepath.Join(dir, deliveriesDir), 0)
+}
+
+func TestRestartKeepsDeliveries(t *testing.T) {
+	g, b := newFakeGraph(t)
+	down := newStandIn(t, 100)
+	dir := t.TempDir()
+	cfg := testConfig(down.URL)
+	cfg.InitialBackoff = time.Hour
+	cfg.MaxBackoff = time.Hour
+	bus, stop := startNotifier(t, cfg, b, dir)
+
+	g.mu.Lock()
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: pkg("github.com/ourorg", "app")}
+	g.mu.Unlock()
+	bus.Publish(events.Event{Kind: events.CertifyBadIngested, ID: "bad"})
+	waitForFiles(t, filepath.Join(dir, deliveriesDir), 1)
+	stop()
+
+	// the webhook moved and the notifier restarts: the pending delivery is
+	// picked up from the spool. Pretend it is due again.
+	up := newStandIn(t, 0)
+	entries, _ := os.ReadDir(filepath.Join(dir, deliveriesDir))
+	path := filepath.Join(dir, deliveriesDir, entries[0].Name())
+	data, _ := os.ReadFile(path)
+	var d Delivery
+	if err := json.Unmarshal(data, &d); err != nil {
+		t.Fatal(err)
+	}
+	d.NextAttempt = time.Now()
+	data, _ = json.Marshal(d)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	startNotifier(t, testConfig(up.URL), b, dir)
+
+	if n := up.wait(t); n.Delivery != d.ID {
+		t.Errorf("expected delivery %s, got %s", d.ID, n.Delivery)
+	}
+	waitForFiles(t, filepath.Join(dir, deliveriesDir), 0)
+}
+
+func TestRestartKeepsEvents(t *testing.T) {
+	g, b := newFakeGraph(t)
+	dir := t.TempDir()
+	s, err := newSpool(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an event received but not evaluated before a crash
+	if err := s.addEvent(events.Event{Kind: events.CertifyBadIngested, ID: "bad"}); err != nil {
+		t.Fatal(err)
+	}
+	g.mu.Lock()
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: pkg("github.com/ourorg", "app")}
+	g.mu.Unlock()
+
+	hook := newStandIn(t, 0)
+	startNotifier(t, testConfig(hook.URL), b, dir)
+	if n := hook.wait(t); n.Rule != "certify-bad" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+	waitForFiles(t, filepath.Join(dir, eventsDir), 0)
+}
+
+func TestEventGiveUp(t *testing.T) {
+	_, b := newFakeGraph(t)
+	hook := newStandIn(t, 0)
+	dir := t.TempDir()
+	cfg := testConfig(hook.URL)
+	cfg.MaxAttempts = 2
+	bus, _ := startNotifier(t, cfg, b, dir)
+
+	// an event that cannot be evaluated is retried, then moved aside
+	bus.Publish(events.Event{Kind: events.CertifyBadIngested, ID: "unavailable"})
+	waitForFiles(t, filepath.Join(dir, failedEventsDir), 1)
+	waitForFiles(t, filepath.Join(dir, eventsDir), 0)
+	hook.none(t)
+}
+
+func TestCorruptSpoolEntries(t *testing.T) {
+	g, b := newFakeGraph(t)
+	dir := t.TempDir()
+	s, err := newSpool(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// partially written entries do not block the others
+	for _, sub := range []string{eventsDir, deliveriesDir} {
+		if err := os.WriteFile(filepath.Join(dir, sub, "0-partial.json"), []byte(`{"Kind":`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.addEvent(events.Event{Kind: events.CertifyBadIngested, ID: "bad"}); err != nil {
+		t.Fatal(err)
+	}
+	g.mu.Lock()
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: pkg("github.com/ourorg", "app")}
+	g.mu.Unlock()
+
+	hook := newStandIn(t, 0)
+	startNotifier(t, testConfig(hook.URL), b, dir)
+	if n := hook.wait(t); n.Rule != "certify-bad" {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+	waitForFiles(t, filepath.Join(dir, corruptDir), 2)
+	waitForFiles(t, filepath.Join(dir, eventsDir), 0)
+}
+
+func TestStableDeliveryID(t *testing.T) {
+	g, b := newFakeGraph(t)
+	g.bads["bad"] = &model.CertifyBad{ID: "bad", Subject: pkg("github.com/ourorg", "app")}
+	cfg := testConfig("http://localhost")
+	if err := cfg.validate(); err != nil {
+		t.Fatal(err)
+	}
+	n, err := New(cfg, b, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// evaluating an event again, after a crash before it was removed from the
+	// spool, gives the same deliveries
+	e := events.Event{Kind: events.CertifyBadIngested, ID: "bad"}
+	first, err := n.evaluate(context.Background(), e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := n.evaluate(context.Background(), e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 1 || len(second) != 1 || first[0].ID != second[0].ID {
+		t.Errorf("deliveries of the same event differ: %v and %v", first, second)
+	}
+	other, err := n.evaluate(context.Background(), events.Event{Kind: events.CertifyBadIngested, ID: "bad", Tenant: "acme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(other) != 1 || other[0].ID == first[0].ID {
+		t.Errorf("deliveries of different events share an ID: %v and %v", first, other)
+	}
+}
+
+func waitForFiles(t *testing.T, dir string, want int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := 0
+		for _, e := range entries {
+			if strings.HasSuffix(e.Name(), ".json") {
+				got++
+			}
+		}
+		if got == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d files in %s, got %d", want, dir, got)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			config: `
+webhooks:
+  - name: security
+    url: https://hooks.example.com/guac
+    secretEnv: NOTIFY_TEST_SECRET
+rules:
+  - name: critical
+    event: certifyVuln
+    purl: "pkg:golang/github.com/ourorg/*"
+    minScore: 9
+    webhooks: [security]
+  - name: affected
+    event: vexStatusChanged
+    vexStatus: AFFECTED
+    webhooks: [security]
+initialBackoff: 1s
+`,
+		},
+		{
+			name: "unknown webhook",
+			config: `
+webhooks: [{name: a, url: "http://localhost"}]
+rules: [{name: r, event: certifyBad, webhooks: [b]}]
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown event",
+			config: `
+webhooks: [{name: a, url: "http://localhost"}]
+rules: [{name: r, event: somethingElse, webhooks: [a]}]
+`,
+			wantErr: true,
+		},
+		{
+			name: "vexStatus on other event",
+			config: `
+webhooks: [{name: a, url: "http://localhost"}]
+rules: [{name: r, event: certifyBad, vexStatus: AFFECTED, webhooks: [a]}]
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid url",
+			config: `
+webhooks: [{name: a, url: "ftp://localhost"}]
+`,
+			wantErr: true,
+		},
+	}
+	t.Setenv("NOTIFY_TEST_SECRET", "from-env")
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := filepath.Join(t.TempDir(), "notify.yaml")
+			if err := os.WriteFile(f, []byte(test.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := LoadConfig(f)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if cfg.Webhooks[0].Secret != "from-env" || cfg.InitialBackoff != time.Second || cfg.MaxAttempts != defaultMaxAttempts {
+				t.Errorf("unexpected config: %+v", cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/events"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/tenant"
+)
+
+// Notification is the JSON body POSTed to webhooks.
+type Notification struct {
+	// Delivery is unique per webhook and can be used to drop duplicates.
+	Delivery  string    `json:"delivery"`
+	Rule      string    `json:"rule"`
+	Event     string    `json:"event"`
+	Tenant    string    `json:"tenant,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	// Subject is the purl of the package, or the algorithm:digest of the
+	// artifact, or the URL of the source the event is about.
+	Subject string `json:"subject"`
+	// Vulnerability is the ID of the vulnerability, if any.
+	Vulnerability string `json:"vulnerability,omitempty"`
+	// Score is the highest score of the vulnerability, if known.
+	Score *float64 `json:"score,omitempty"`
+	// Status and PreviousStatus are set for VEX status changes.
+	Status         string `json:"status,omitempty"`
+	PreviousStatus string `json:"previousStatus,omitempty"`
+	// Node is the GraphQL node that was ingested.
+	Node any `json:"node"`
+}
+
+// fact is what is known about an ingested predicate to evaluate rules.
+type fact struct {
+	event          string
+	node           any
+	subject        string
+	isPackage      bool
+	vulnerability  string
+	vulnID         *string
+	score          *float64
+	scoreLoaded    bool
+	status         string
+	previousStatus string
+	statusChanged  bool
+}
+
+// loadFact fetches the predicate an event refers to. It returns nil if the
+// predicate does not exist (anymore) or is not interesting for rules.
+func loadFact(ctx context.Context, b backends.Backend, e events.Event) (*fact, error) {
+	if e.Tenant != "" {
+		ctx = tenant.WithTenant(ctx, e.Tenant)
+	}
+	switch e.Kind {
+	case events.CertifyVulnIngested:
+		found, err := b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &e.ID}, nil)
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		cv := found[0]
+		f := &fact{event: EventCertifyVuln, node: cv}
+		f.subject, f.isPackage = subjectName(cv.Package)
+		f.setVulnerability(cv.Vulnerability)
+		// scans without findings are not notified
+		if f.vulnerability == "" {
+			return nil, nil
+		}
+		return f, nil
+	case events.VEXStatementIngested:
+		found, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &e.ID}, nil)
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		vex := found[0]
+		f := &fact{event: EventVEXStatusChanged, node: vex, status: string(vex.Status)}
+		f.subject, f.isPackage = subjectName(vex.Subject)
+		f.setVulnerability(vex.Vulnerability)
+		previous, err := events.PreviousVEXStatement(ctx, b, vex)
+		if err != nil {
+			return nil, err
+		}
+		if previous != nil {
+			f.previousStatus = string(previous.Status)
+		}
+		f.statusChanged = previous == nil || previous.Status != vex.Status
+		return f, nil
+	case events.CertifyBadIngested:
+		found, err := b.CertifyBad(ctx, &model.CertifyBadSpec{ID: &e.ID})
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		f := &fact{event: EventCertifyBad, node: found[0]}
+		f.subject, f.isPackage = subjectName(found[0].Subject)
+		return f, nil
+	case events.HasSBOMIngested:
+		found, err := b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &e.ID}, nil)
+		if err != nil || len(found) == 0 {
+			return nil, err
+		}
+		f := &fact{event: EventHasSBOM, node: found[0]}
+		f.subject, f.isPackage = subjectName(found[0].Subject)
+		return f, nil
+	}
+	return nil, nil
+}
+
+func (f *fact) setVulnerability(v *model.Vulnerability) {
+	if v == nil || v.Type == "novuln" || len(v.VulnerabilityIDs) == 0 {
+		return
+	}
+	f.vulnerability = v.VulnerabilityIDs[0].VulnerabilityID
+	f.vulnID = &v.VulnerabilityIDs[0].ID
+}
+
+// loadScore looks up the highest score recorded for the vulnerability.
+func (f *fact) loadScore(ctx context.Context, b backends.Backend, t string) error {
+	if f.scoreLoaded || f.vulnID == nil {
+		return nil
+	}
+	if t != "" {
+		ctx = tenant.WithTenant(ctx, t)
+	}
+	metadata, err := b.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{
+		Vulnerability: &model.VulnerabilitySpec{ID: f.vulnID},
+	})
+	if err != nil {
+		return fmt.Errorf("error loading vulnerability metadata: %w", err)
+	}
+	for _, m := range metadata {
+		if f.score == nil || m.ScoreValue > *f.score {
+			score := m.ScoreValue
+			f.score = &score
+		}
+	}
+	f.scoreLoaded = true
+	return nil
+}
+
+// matches reports whether the rule selects the fact. Vulnerability scores are
+// loaded on demand.
+func (r *Rule) matches(ctx context.Context, b backends.Backend, e events.Event, f *fact) (bool, error) {
+	if r.Event != f.event {
+		return false, nil
+	}
+	if r.Tenant != "" && r.Tenant != e.Tenant {
+		return false, nil
+	}
+	if r.purl != nil && (!f.isPackage || !r.purl.MatchString(f.subject)) {
+		return false, nil
+	}
+	if f.event == EventVEXStatusChanged {
+		if !f.statusChanged || (r.VexStatus != "" && r.VexStatus != f.status) {
+			return false, nil
+		}
+	}
+	if r.MinScore > 0 {
+		if err := f.loadScore(ctx, b, e.Tenant); err != nil {
+			return false, err
+		}
+		if f.score == nil || *f.score < r.MinScore {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// compileGlob turns a pattern where '*' matches any sequence of characters
+// into a regular expression.
+func compileGlob(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// subjectName returns a printable name of a subject and whether it is a
+// package.
+func subjectName(subject any) (string, bool) {
+	switch s := subject.(type) {
+	case *model.Package:
+		return packagePurl(s), true
+	case *model.Artifact:
+		return s.Algorithm + ":" + s.Digest, false
+	case *model.Source:
+		for _, ns := range s.Namespaces {
+			for _, n := range ns.Names {
+				name := s.Type + "+" + ns.Namespace + "/" + n.Name
+				if n.Tag != nil {
+					name += "@" + *n.Tag
+				} else if n.Commit != nil {
+					name += "@" + *n.Commit
+				}
+				return name, false
+			}
+		}
+		return s.Type, false
+	}
+	return "", false
+}
+
+func packagePurl(p *model.Package) string {
+	if p == nil {
+		return ""
+	}
+	for _, ns := range p.Namespaces {
+		for _, n := range ns.Names {
+			if len(n.Versions) == 0 {
+				return helpers.PkgToPurl(p.Type, ns.Namespace, n.Name, "", "", nil)
+			}
+			v := n.Versions[0]
+			if v.Purl != "" {
+				return v.Purl
+			}
+			var qualifiers []string
+			for _, q := range v.Qualifiers {
+				qualifiers = append(qualifiers, q.Key, q.Value)
+			}
+			return helpers.PkgToPurl(p.Type, ns.Namespace, n.Name, v.Version, v.Subpath, qualifiers)
+		}
+	}
+	return "pkg:" + p.Type
+}
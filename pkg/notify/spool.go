@@ -0,0 +1,220 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/events"
+)
+
+const (
+	eventsDir       = "events"
+	deliveriesDir   = "deliveries"
+	failedDir       = "failed"
+	failedEventsDir = "failed-events"
+	corruptDir      = "corrupt"
+)
+
+// spooledEvent is an event waiting to be evaluated.
+type spooledEvent struct {
+	events.Event
+	Attempts    int       `json:"attempts,omitempty"`
+	NextAttempt time.Time `json:"nextAttempt,omitempty"`
+	LastError   string    `json:"lastError,omitempty"`
+}
+
+// Delivery is a notification waiting to be sent to a webhook.
+type Delivery struct {
+	ID          string          `json:"id"`
+	Webhook     string          `json:"webhook"`
+	Rule        string          `json:"rule"`
+	Body        json.RawMessage `json:"body"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt time.Time       `json:"nextAttempt"`
+	LastError   string          `json:"lastError,omitempty"`
+}
+
+// spool persists the events that still have to be evaluated and the
+// deliveries that still have to be sent, so that a restart does not lose
+// them. Each entry is a JSON file, written atomically. Entries that cannot be
+// read are moved to the corrupt directory instead of blocking the others.
+type spool struct {
+	dir string
+}
+
+func newSpool(dir string) (*spool, error) {
+	for _, d := range []string{eventsDir, deliveriesDir, failedDir, failedEventsDir, corruptDir} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0o700); err != nil {
+			return nil, fmt.Errorf("error creating notify spool: %w", err)
+		}
+	}
+	return &spool{dir: dir}, nil
+}
+
+// addEvent stores an event. Names sort in arrival order.
+func (s *spool) addEvent(e events.Event) error {
+	name := fmt.Sprintf("%020d-%s.json", time.Now().UnixNano(), randomID()[:8])
+	return s.write(eventsDir, name, e)
+}
+
+// pendingEvents returns the stored events keyed by file name, and the names
+// in arrival order. Unreadable events are quarantined and returned in
+// corrupt.
+func (s *spool) pendingEvents() (map[string]*spooledEvent, []string, []error, error) {
+	names, err := s.list(eventsDir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	evs := map[string]*spooledEvent{}
+	var pending []string
+	var corrupt []error
+	for _, name := range names {
+		var e spooledEvent
+		if err := s.read(eventsDir, name, &e); err != nil {
+			corrupt = append(corrupt, s.quarantine(eventsDir, name, err))
+			continue
+		}
+		evs[name] = &e
+		pending = append(pending, name)
+	}
+	return evs, pending, corrupt, nil
+}
+
+// retryEvent records a failed evaluation of the event stored at name.
+func (s *spool) retryEvent(name string, e *spooledEvent) error {
+	return s.write(eventsDir, name, e)
+}
+
+// failEvent moves an event that ran out of attempts to the failed-events
+// directory, where it can be inspected and replayed by moving it back.
+func (s *spool) failEvent(name string, e *spooledEvent) error {
+	if err := s.write(failedEventsDir, name, e); err != nil {
+		return err
+	}
+	return s.removeEvent(name)
+}
+
+func (s *spool) removeEvent(name string) error {
+	return os.Remove(filepath.Join(s.dir, eventsDir, name))
+}
+
+func (s *spool) saveDelivery(d *Delivery) error {
+	return s.write(deliveriesDir, d.ID+".json", d)
+}
+
+// pendingDeliveries returns the stored deliveries. Unreadable deliveries are
+// quarantined and returned in corrupt.
+func (s *spool) pendingDeliveries() ([]*Delivery, []error, error) {
+	names, err := s.list(deliveriesDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ds []*Delivery
+	var corrupt []error
+	for _, name := range names {
+		var d Delivery
+		if err := s.read(deliveriesDir, name, &d); err != nil {
+			corrupt = append(corrupt, s.quarantine(deliveriesDir, name, err))
+			continue
+		}
+		ds = append(ds, &d)
+	}
+	return ds, corrupt, nil
+}
+
+func (s *spool) removeDelivery(d *Delivery) error {
+	return os.Remove(filepath.Join(s.dir, deliveriesDir, d.ID+".json"))
+}
+
+// failDelivery moves a delivery that ran out of attempts to the failed
+// directory, where it can be inspected and replayed by moving it back.
+func (s *spool) failDelivery(d *Delivery) error {
+	if err := s.write(failedDir, d.ID+".json", d); err != nil {
+		return err
+	}
+	return s.removeDelivery(d)
+}
+
+// quarantine moves the unreadable entry name of sub to the corrupt
+// directory, and returns the error describing it.
+func (s *spool) quarantine(sub, name string, readErr error) error {
+	to := filepath.Join(s.dir, corruptDir, sub+"-"+name)
+	if err := os.Rename(filepath.Join(s.dir, sub, name), to); err != nil {
+		return fmt.Errorf("%w, and failed to quarantine it: %v", readErr, err)
+	}
+	return fmt.Errorf("%w, moved to %s", readErr, to)
+}
+
+func (s *spool) list(sub string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, sub))
+	if err != nil {
+		return nil, fmt.Errorf("error listing notify spool: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *spool) read(sub, name string, v any) error {
+	data, err := os.ReadFile(filepath.Join(s.dir, sub, name))
+	if err != nil {
+		return fmt.Errorf("error reading notify spool entry: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding notify spool entry %s: %w", name, err)
+	}
+	return nil
+}
+
+func (s *spool) write(sub, name string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error encoding notify spool entry: %w", err)
+	}
+	dir := filepath.Join(s.dir, sub)
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("error writing notify spool entry: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing notify spool entry: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing notify spool entry: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error writing notify spool entry: %w", err)
+	}
+	if err := os.Rename(f.Name(), filepath.Join(dir, name)); err != nil {
+		return fmt.Errorf("error writing notify spool entry: %w", err)
+	}
+	return nil
+}
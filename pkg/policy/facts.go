@@ -0,0 +1,586 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	assembler_helpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/guacrest/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Facts is the subgraph of a subject that policies are evaluated against.
+// Each field is available to the policy expressions as a variable of the
+// same name as its JSON key.
+type Facts struct {
+	Subject Subject `json:"subject"`
+	// Packages are the subject and its dependencies, identified by purl.
+	Packages     []Package    `json:"packages"`
+	Dependencies []Dependency `json:"dependencies"`
+	SBOMs        []SBOM       `json:"sboms"`
+	// Occurrences link the subject packages to the subject artifacts.
+	Occurrences     []Occurrence    `json:"occurrences"`
+	SLSA            []SLSA          `json:"slsa"`
+	Verifications   []Verification  `json:"verifications"`
+	Scorecards      []Scorecard     `json:"scorecards"`
+	Licenses        []License       `json:"licenses"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+	VEX             []VEX           `json:"vex"`
+}
+
+// Subject is the package or artifact policies are evaluated for.
+type Subject struct {
+	Purl   string `json:"purl"`
+	Digest string `json:"digest"`
+}
+
+func (s Subject) String() string {
+	if s.Purl != "" {
+		return s.Purl
+	}
+	return s.Digest
+}
+
+type Package struct {
+	Purl string `json:"purl"`
+	// Dependency is false for the subject itself.
+	Dependency bool `json:"dependency"`
+}
+
+type Dependency struct {
+	Package    string `json:"package"`
+	Dependency string `json:"dependency"`
+	Type       string `json:"type"`
+}
+
+type Occurrence struct {
+	Package  string `json:"package"`
+	Artifact string `json:"artifact"`
+}
+
+type SBOM struct {
+	URI        string    `json:"uri"`
+	Origin     string    `json:"origin"`
+	Collector  string    `json:"collector"`
+	KnownSince time.Time `json:"knownSince"`
+}
+
+type SLSA struct {
+	Subject   string `json:"subject"`
+	Builder   string `json:"builder"`
+	BuildType string `json:"buildType"`
+	Version   string `json:"version"`
+	// Level is the highest SLSA build level a passed verification of the
+	// subject vouches for, or 0 if none does. GUAC does not verify the
+	// provenance itself.
+	Level int `json:"level"`
+}
+
+// Verification is the result of verifying a subject artifact against a
+// policy, such as a SLSA verification summary.
+type Verification struct {
+	Subject  string `json:"subject"`
+	Verifier string `json:"verifier"`
+	Policy   string `json:"policy"`
+	// Result is PASSED or FAILED.
+	Result string   `json:"result"`
+	Levels []string `json:"levels"`
+	// BuildLevel is the highest SLSA_BUILD_LEVEL_<n> of the levels, or 0.
+	BuildLevel   int       `json:"buildLevel"`
+	TimeVerified time.Time `json:"timeVerified"`
+}
+
+type Scorecard struct {
+	Source string         `json:"source"`
+	Score  float64        `json:"score"`
+	Checks map[string]int `json:"checks"`
+}
+
+type License struct {
+	Package    string `json:"package"`
+	Declared   string `json:"declared"`
+	Discovered string `json:"discovered"`
+	// Names are the names of the declared and discovered licenses.
+	Names []string `json:"names"`
+}
+
+type Vulnerability struct {
+	Package string `json:"package"`
+	ID      string `json:"id"`
+	// Score is the highest score of the vulnerability metadata, or 0 if
+	// unknown.
+	Score float64 `json:"score"`
+	// Status is the status of the latest VEX statement about the package or
+	// the subject for this vulnerability, or empty if there is none.
+	Status string `json:"status"`
+}
+
+type VEX struct {
+	Subject       string    `json:"subject"`
+	Vulnerability string    `json:"vulnerability"`
+	Status        string    `json:"status"`
+	Justification string    `json:"justification"`
+	KnownSince    time.Time `json:"knownSince"`
+}
+
+// pkgNode is a package version of the subgraph.
+type pkgNode struct {
+	id   string
+	purl string
+}
+
+// gatherer collects the facts about a subject from the GraphQL server.
+type gatherer struct {
+	client graphql.Client
+	facts  *Facts
+
+	packages  map[string]bool // by purl
+	artifacts []string        // ids of the subject artifacts
+	scores    map[string]float64
+}
+
+// Gather collects the subgraph of the package identified by purl or of the
+// artifact identified by digest. The dependencies are read from the SBOMs
+// of the subject and from the IsDependency nodes of the subject package;
+// artifacts are linked to packages by IsOccurrence.
+func Gather(ctx context.Context, client graphql.Client, subject Subject) (*Facts, error) {
+	g := &gatherer{
+		client:   client,
+		facts:    &Facts{Subject: subject},
+		packages: map[string]bool{},
+		scores:   map[string]float64{},
+	}
+
+	var roots []pkgNode
+	switch {
+	case subject.Purl != "":
+		pkg, err := helpers.FindPackageWithPurl(ctx, client, subject.Purl)
+		if err != nil {
+			return nil, err
+		}
+		purl := pkg.Purl
+		if purl == "" {
+			purl = subject.Purl
+		}
+		roots = append(roots, pkgNode{id: pkg.Id, purl: purl})
+		occurrences, err := gql.Occurrences(ctx, client, gql.IsOccurrenceSpec{
+			Subject: &gql.PackageOrSourceSpec{Package: &gql.PkgSpec{Id: &pkg.Id}},
+		})
+		if err != nil {
+			return nil, queryErr(ctx, "occurrences", err)
+		}
+		for _, o := range occurrences.IsOccurrence {
+			g.artifacts = append(g.artifacts, o.Artifact.Id)
+			g.facts.Occurrences = append(g.facts.Occurrences, Occurrence{
+				Package:  purl,
+				Artifact: o.Artifact.Algorithm + ":" + o.Artifact.Digest,
+			})
+		}
+	case subject.Digest != "":
+		digest := subject.Digest
+		if _, d, ok := strings.Cut(digest, ":"); ok {
+			digest = d
+		}
+		artifact, err := helpers.FindArtifactWithDigest(ctx, client, digest)
+		if err != nil {
+			return nil, err
+		}
+		g.artifacts = append(g.artifacts, artifact.Id)
+		occurrences, err := gql.Occurrences(ctx, client, gql.IsOccurrenceSpec{
+			Artifact: &gql.ArtifactSpec{Id: &artifact.Id},
+		})
+		if err != nil {
+			return nil, queryErr(ctx, "occurrences", err)
+		}
+		for _, o := range occurrences.IsOccurrence {
+			if p, ok := o.Subject.(*gql.AllIsOccurrencesTreeSubjectPackage); ok {
+				for _, v := range versionNodes(p.AllPkgTree) {
+					roots = append(roots, v)
+					g.facts.Occurrences = append(g.facts.Occurrences, Occurrence{
+						Package:  v.purl,
+						Artifact: artifact.Algorithm + ":" + artifact.Digest,
+					})
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("either a purl or a digest must be specified")
+	}
+
+	for _, r := range roots {
+		g.addPackage(r, false)
+	}
+	deps, err := g.gatherDependencies(ctx, roots)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.gatherSLSA(ctx); err != nil {
+		return nil, err
+	}
+	if err := g.gatherScorecards(ctx, roots); err != nil {
+		return nil, err
+	}
+	if err := g.gatherLicenses(ctx, append(append([]pkgNode{}, roots...), deps...)); err != nil {
+		return nil, err
+	}
+	if err := g.gatherVulnerabilities(ctx, roots, deps); err != nil {
+		return nil, err
+	}
+	return g.facts, nil
+}
+
+func (g *gatherer) addPackage(p pkgNode, dependency bool) bool {
+	if g.packages[p.purl] {
+		return false
+	}
+	g.packages[p.purl] = true
+	g.facts.Packages = append(g.facts.Packages, Package{Purl: p.purl, Dependency: dependency})
+	return true
+}
+
+// gatherDependencies reads the SBOMs and IsDependency nodes of the roots and
+// returns the dependency package versions.
+func (g *gatherer) gatherDependencies(ctx context.Context, roots []pkgNode) ([]pkgNode, error) {
+	var deps []pkgNode
+	add := func(tree gql.AllPkgTree) {
+		for _, v := range versionNodes(tree) {
+			if g.addPackage(v, true) {
+				deps = append(deps, v)
+			}
+		}
+	}
+	addDependency := func(d gql.AllIsDependencyTree) {
+		g.facts.Dependencies = append(g.facts.Dependencies, Dependency{
+			Package:    assembler_helpers.AllPkgTreeToPurl(&d.Package.AllPkgTree),
+			Dependency: assembler_helpers.AllPkgTreeToPurl(&d.DependencyPackage.AllPkgTree),
+			Type:       string(d.DependencyType),
+		})
+		add(d.DependencyPackage.AllPkgTree)
+	}
+
+	var subjects []gql.PackageOrArtifactSpec
+	for _, r := range roots {
+		subjects = append(subjects, gql.PackageOrArtifactSpec{Package: &gql.PkgSpec{Id: &r.id}})
+	}
+	for _, id := range g.artifacts {
+		subjects = append(subjects, gql.PackageOrArtifactSpec{Artifact: &gql.ArtifactSpec{Id: &id}})
+	}
+	for i := range subjects {
+		sboms, err := gql.HasSBOMs(ctx, g.client, gql.HasSBOMSpec{Subject: &subjects[i]})
+		if err != nil {
+			return nil, queryErr(ctx, "SBOMs", err)
+		}
+		for _, sbom := range sboms.HasSBOM {
+			g.facts.SBOMs = append(g.facts.SBOMs, SBOM{
+				URI:        sbom.Uri,
+				Origin:     sbom.Origin,
+				Collector:  sbom.Collector,
+				KnownSince: sbom.KnownSince,
+			})
+			for _, software := range sbom.IncludedSoftware {
+				if p, ok := software.(*gql.AllHasSBOMTreeIncludedSoftwarePackage); ok {
+					add(p.AllPkgTree)
+				}
+			}
+			for _, d := range sbom.IncludedDependencies {
+				addDependency(d.AllIsDependencyTree)
+			}
+		}
+	}
+
+	for _, r := range roots {
+		direct, err := gql.Dependencies(ctx, g.client, gql.IsDependencySpec{Package: &gql.PkgSpec{Id: &r.id}})
+		if err != nil {
+			return nil, queryErr(ctx, "dependencies", err)
+		}
+		for _, d := range direct.IsDependency {
+			addDependency(d.AllIsDependencyTree)
+		}
+	}
+	return deps, nil
+}
+
+func (g *gatherer) gatherSLSA(ctx context.Context) error {
+	for _, id := range g.artifacts {
+		verifications, err := gql.CertifyPolicy(ctx, g.client, gql.CertifyPolicySpec{Subject: &gql.ArtifactSpec{Id: &id}})
+		if err != nil {
+			return queryErr(ctx, "policy verifications", err)
+		}
+		level := 0
+		for _, v := range verifications.CertifyPolicy {
+			buildLevel := slsaBuildLevel(v.VerifiedLevels)
+			g.facts.Verifications = append(g.facts.Verifications, Verification{
+				Subject:      v.Subject.Algorithm + ":" + v.Subject.Digest,
+				Verifier:     v.Verifier,
+				Policy:       v.PolicyUri,
+				Result:       string(v.Result),
+				Levels:       v.VerifiedLevels,
+				BuildLevel:   buildLevel,
+				TimeVerified: v.TimeVerified,
+			})
+			if v.Result == gql.PolicyVerificationResultPassed {
+				level = max(level, buildLevel)
+			}
+		}
+
+		slsas, err := gql.HasSLSA(ctx, g.client, gql.HasSLSASpec{Subject: &gql.ArtifactSpec{Id: &id}})
+		if err != nil {
+			return queryErr(ctx, "SLSA attestations", err)
+		}
+		for _, s := range slsas.HasSLSA {
+			g.facts.SLSA = append(g.facts.SLSA, SLSA{
+				Subject:   s.Subject.Algorithm + ":" + s.Subject.Digest,
+				Builder:   s.Slsa.BuiltBy.Uri,
+				BuildType: s.Slsa.BuildType,
+				Version:   s.Slsa.SlsaVersion,
+				Level:     level,
+			})
+		}
+	}
+	return nil
+}
+
+// slsaBuildLevel returns the highest SLSA_BUILD_LEVEL_<n> of the verified
+// levels, or 0.
+func slsaBuildLevel(levels []string) int {
+	level := 0
+	for _, l := range levels {
+		if n, ok := strings.CutPrefix(l, "SLSA_BUILD_LEVEL_"); ok {
+			if n, err := strconv.Atoi(n); err == nil {
+				level = max(level, n)
+			}
+		}
+	}
+	return level
+}
+
+// gatherScorecards reads the scorecards of the sources of the roots.
+func (g *gatherer) gatherScorecards(ctx context.Context, roots []pkgNode) error {
+	seen := map[string]bool{}
+	for _, r := range roots {
+		filter, err := assembler_helpers.PurlToPkgFilter(r.purl)
+		if err != nil {
+			return err
+		}
+		// sources are usually attached to the package name
+		filter.Version, filter.Qualifiers, filter.Subpath, filter.MatchOnlyEmptyQualifiers = nil, nil, nil, nil
+		sources, err := gql.HasSourceAt(ctx, g.client, gql.HasSourceAtSpec{Package: &filter})
+		if err != nil {
+			return queryErr(ctx, "sources", err)
+		}
+		for _, s := range sources.HasSourceAt {
+			for _, ns := range s.Source.Namespaces {
+				for _, n := range ns.Names {
+					if seen[n.Id] {
+						continue
+					}
+					seen[n.Id] = true
+					scorecards, err := gql.Scorecards(ctx, g.client, gql.CertifyScorecardSpec{
+						Source: &gql.SourceSpec{Id: &n.Id},
+					})
+					if err != nil {
+						return queryErr(ctx, "scorecards", err)
+					}
+					for _, sc := range scorecards.Scorecards {
+						checks := map[string]int{}
+						for _, c := range sc.Scorecard.Checks {
+							checks[c.Check] = c.Score
+						}
+						g.facts.Scorecards = append(g.facts.Scorecards, Scorecard{
+							Source: s.Source.Type + "+" + ns.Namespace + "/" + n.Name,
+							Score:  sc.Scorecard.AggregateScore,
+							Checks: checks,
+						})
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (g *gatherer) gatherLicenses(ctx context.Context, pkgs []pkgNode) error {
+	for _, p := range pkgs {
+		legals, err := gql.CertifyLegal(ctx, g.client, gql.CertifyLegalSpec{
+			Subject: &gql.PackageOrSourceSpec{Package: &gql.PkgSpec{Id: &p.id}},
+		})
+		if err != nil {
+			return queryErr(ctx, "licenses", err)
+		}
+		for _, l := range legals.CertifyLegal {
+			var names []string
+			for _, d := range l.DeclaredLicenses {
+				names = append(names, d.Name)
+			}
+			for _, d := range l.DiscoveredLicenses {
+				names = append(names, d.Name)
+			}
+			g.facts.Licenses = append(g.facts.Licenses, License{
+				Package:    p.purl,
+				Declared:   l.DeclaredLicense,
+				Discovered: l.DiscoveredLicense,
+				Names:      names,
+			})
+		}
+	}
+	return nil
+}
+
+// gatherVulnerabilities reads the vulnerabilities of the packages, with
+// their scores and the status of the VEX statements about each package. VEX
+// statements about the subject apply to all the packages.
+func (g *gatherer) gatherVulnerabilities(ctx context.Context, roots, deps []pkgNode) error {
+	statements := map[string][]VEX{}
+	var subjectVEX []VEX
+	for _, id := range g.artifacts {
+		s, err := g.vexStatements(ctx, gql.PackageOrArtifactSpec{Artifact: &gql.ArtifactSpec{Id: &id}})
+		if err != nil {
+			return err
+		}
+		subjectVEX = append(subjectVEX, s...)
+	}
+	for _, p := range append(append([]pkgNode{}, roots...), deps...) {
+		s, err := g.vexStatements(ctx, gql.PackageOrArtifactSpec{Package: &gql.PkgSpec{Id: &p.id}})
+		if err != nil {
+			return err
+		}
+		statements[p.id] = s
+	}
+	for _, r := range roots {
+		subjectVEX = append(subjectVEX, statements[r.id]...)
+	}
+
+	for _, p := range append(append([]pkgNode{}, roots...), deps...) {
+		status := latestStatus(append(append([]VEX{}, subjectVEX...), statements[p.id]...))
+		vulns, err := gql.CertifyVuln(ctx, g.client, gql.CertifyVulnSpec{Package: &gql.PkgSpec{Id: &p.id}})
+		if err != nil {
+			return queryErr(ctx, "vulnerabilities", err)
+		}
+		for _, v := range vulns.CertifyVuln {
+			if v.Vulnerability.Type == "novuln" {
+				continue
+			}
+			for _, id := range v.Vulnerability.VulnerabilityIDs {
+				score, err := g.score(ctx, id.Id)
+				if err != nil {
+					return err
+				}
+				g.facts.Vulnerabilities = append(g.facts.Vulnerabilities, Vulnerability{
+					Package: p.purl,
+					ID:      id.VulnerabilityID,
+					Score:   score,
+					Status:  status[id.VulnerabilityID],
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func (g *gatherer) vexStatements(ctx context.Context, subject gql.PackageOrArtifactSpec) ([]VEX, error) {
+	resp, err := gql.VEXStatements(ctx, g.client, gql.CertifyVEXStatementSpec{Subject: &subject})
+	if err != nil {
+		return nil, queryErr(ctx, "VEX statements", err)
+	}
+	var statements []VEX
+	for _, s := range resp.CertifyVEXStatement {
+		name := ""
+		switch subj := s.Subject.(type) {
+		case *gql.AllCertifyVEXStatementSubjectPackage:
+			name = assembler_helpers.AllPkgTreeToPurl(&subj.AllPkgTree)
+		case *gql.AllCertifyVEXStatementSubjectArtifact:
+			name = subj.Algorithm + ":" + subj.Digest
+		}
+		for _, id := range s.Vulnerability.VulnerabilityIDs {
+			v := VEX{
+				Subject:       name,
+				Vulnerability: id.VulnerabilityID,
+				Status:        string(s.Status),
+				Justification: string(s.VexJustification),
+				KnownSince:    s.KnownSince,
+			}
+			statements = append(statements, v)
+			g.facts.VEX = append(g.facts.VEX, v)
+		}
+	}
+	return statements, nil
+}
+
+// latestStatus returns the status of the latest statement for each
+// vulnerability.
+func latestStatus(statements []VEX) map[string]string {
+	sort.SliceStable(statements, func(i, j int) bool {
+		return statements[i].KnownSince.Before(statements[j].KnownSince)
+	})
+	status := map[string]string{}
+	for _, s := range statements {
+		status[s.Vulnerability] = s.Status
+	}
+	return status
+}
+
+// score returns the highest score of a vulnerability ID node.
+func (g *gatherer) score(ctx context.Context, id string) (float64, error) {
+	if s, ok := g.scores[id]; ok {
+		return s, nil
+	}
+	resp, err := gql.VulnerabilityMetadata(ctx, g.client, gql.VulnerabilityMetadataSpec{
+		Vulnerability: &gql.VulnerabilitySpec{Id: &id},
+	})
+	if err != nil {
+		return 0, queryErr(ctx, "vulnerability metadata", err)
+	}
+	var score float64
+	for _, m := range resp.VulnerabilityMetadata {
+		score = max(score, m.ScoreValue)
+	}
+	g.scores[id] = score
+	return score, nil
+}
+
+// queryErr logs a failed query and returns helpers.Err502, like the
+// helpers used to find the subject.
+func queryErr(ctx context.Context, what string, err error) error {
+	logging.FromContext(ctx).Errorf("error querying %s: %v", what, err)
+	return helpers.Err502
+}
+
+func versionNodes(tree gql.AllPkgTree) []pkgNode {
+	var nodes []pkgNode
+	for _, ns := range tree.Namespaces {
+		for _, n := range ns.Names {
+			for _, v := range n.Versions {
+				var qualifiers []string
+				for _, q := range v.Qualifiers {
+					qualifiers = append(qualifiers, q.Key, q.Value)
+				}
+				nodes = append(nodes, pkgNode{
+					id:   v.Id,
+					purl: assembler_helpers.PkgToPurl(tree.Type, ns.Namespace, n.Name, v.Version, v.Subpath, qualifiers),
+				})
+			}
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,254 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy evaluates CEL policies against the subgraph of a package or
+// artifact: its SBOM dependencies, SLSA provenance and verification summaries,
+// scorecards, licenses, vulnerabilities and VEX statements.
+//
+// A policy is a YAML document with a CEL expression computing the list of
+// violations, as strings. An expression may also return a bool, false being
+// a single violation described by the policy description:
+//
+//	name: no-gpl
+//	description: Dependencies must not be GPL licensed.
+//	violations: |
+//	  licenses.filter(l, l.names.exists(n, n.startsWith("GPL")))
+//	    .map(l, l.package + " is licensed under " + l.declared)
+//
+// The variables available to expressions are the fields of Facts.
+package policy
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed builtin/*.yaml
+var builtin embed.FS
+
+// Policy is a named CEL expression computing violations.
+type Policy struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description"`
+	Violations  string `yaml:"violations" json:"violations"`
+
+	program cel.Program
+}
+
+// Verdict is the outcome of evaluating policies for a subject. It passes if
+// all policies pass.
+type Verdict struct {
+	Subject string   `json:"subject"`
+	Pass    bool     `json:"pass"`
+	Results []Result `json:"results"`
+}
+
+// Result is the outcome of a single policy.
+type Result struct {
+	Policy      string   `json:"policy"`
+	Description string   `json:"description"`
+	Pass        bool     `json:"pass"`
+	Violations  []string `json:"violations"`
+}
+
+var variables = []string{
+	"subject",
+	"packages",
+	"dependencies",
+	"sboms",
+	"occurrences",
+	"slsa",
+	"verifications",
+	"scorecards",
+	"licenses",
+	"vulnerabilities",
+	"vex",
+}
+
+var env *cel.Env
+
+func init() {
+	opts := []cel.EnvOption{cel.CrossTypeNumericComparisons(true)}
+	for _, v := range variables {
+		opts = append(opts, cel.Variable(v, cel.DynType))
+	}
+	var err error
+	env, err = cel.NewEnv(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("error creating CEL environment: %v", err))
+	}
+}
+
+// Builtin returns the example policies shipped with GUAC.
+func Builtin() ([]*Policy, error) {
+	return load(builtin, "builtin")
+}
+
+// LoadDir reads the policies from the *.yaml and *.yml files of a
+// directory.
+func LoadDir(dir string) ([]*Policy, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("error reading policy directory: %w", err)
+	}
+	return load(os.DirFS(dir), ".")
+}
+
+func load(fsys fs.FS, dir string) ([]*Policy, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy directory: %w", err)
+	}
+	var policies []*Policy
+	names := map[string]bool{}
+	for _, e := range entries {
+		ext := path.Ext(e.Name())
+		if e.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading policy %s: %w", e.Name(), err)
+		}
+		p, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", e.Name(), err)
+		}
+		if names[p.Name] {
+			return nil, fmt.Errorf("policy %s: duplicate name %q", e.Name(), p.Name)
+		}
+		names[p.Name] = true
+		policies = append(policies, p)
+	}
+	if len(policies) == 0 {
+		return nil, fmt.Errorf("no policies found")
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+	return policies, nil
+}
+
+// Parse parses and compiles a YAML policy.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("error parsing policy: %w", err)
+	}
+	if p.Name == "" {
+		return nil, fmt.Errorf("missing policy name")
+	}
+	if strings.TrimSpace(p.Violations) == "" {
+		return nil, fmt.Errorf("policy %s: missing violations expression", p.Name)
+	}
+	ast, iss := env.Compile(p.Violations)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("policy %s: %w", p.Name, iss.Err())
+	}
+	switch t := ast.OutputType(); {
+	case t.IsExactType(types.BoolType), t.IsExactType(types.DynType), t.IsAssignableType(cel.ListType(cel.StringType)):
+	default:
+		return nil, fmt.Errorf("policy %s: expression must return a bool or a list of strings, not %s", p.Name, t)
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("policy %s: %w", p.Name, err)
+	}
+	p.program = prg
+	return &p, nil
+}
+
+// Eval gathers the facts about subject and evaluates the policies on them.
+func Eval(ctx context.Context, client graphql.Client, subject Subject, policies []*Policy) (*Verdict, error) {
+	facts, err := Gather(ctx, client, subject)
+	if err != nil {
+		return nil, err
+	}
+	return Evaluate(facts, policies)
+}
+
+// Evaluate evaluates the policies on facts.
+func Evaluate(facts *Facts, policies []*Policy) (*Verdict, error) {
+	input, err := activation(facts)
+	if err != nil {
+		return nil, err
+	}
+	verdict := &Verdict{Subject: facts.Subject.String(), Pass: true}
+	for _, p := range policies {
+		violations, err := p.eval(input)
+		if err != nil {
+			return nil, err
+		}
+		verdict.Results = append(verdict.Results, Result{
+			Policy:      p.Name,
+			Description: p.Description,
+			Pass:        len(violations) == 0,
+			Violations:  violations,
+		})
+		verdict.Pass = verdict.Pass && len(violations) == 0
+	}
+	return verdict, nil
+}
+
+func (p *Policy) eval(input map[string]any) ([]string, error) {
+	out, _, err := p.program.Eval(input)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating policy %s: %w", p.Name, err)
+	}
+	if b, ok := out.Value().(bool); ok {
+		if b {
+			return []string{}, nil
+		}
+		description := strings.TrimSpace(p.Description)
+		if description == "" {
+			description = "policy " + p.Name + " is not satisfied"
+		}
+		return []string{description}, nil
+	}
+	native, err := out.ConvertToNative(reflect.TypeOf([]string{}))
+	if err != nil {
+		return nil, fmt.Errorf("policy %s: expression must return a bool or a list of strings, not %s", p.Name, out.Type())
+	}
+	return native.([]string), nil
+}
+
+// activation returns the variables of the expressions. The facts go through
+// JSON so that expressions use the same field names as the REST API.
+func activation(facts *Facts) (map[string]any, error) {
+	data, err := json.Marshal(facts)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding facts: %w", err)
+	}
+	var input map[string]any
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("error encoding facts: %w", err)
+	}
+	for _, v := range variables {
+		if input[v] == nil {
+			input[v] = []any{}
+		}
+	}
+	return input, nil
+}
@@ -0,0 +1,329 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	gql "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/policy"
+)
+
+func builtin(t *testing.T) []*policy.Policy {
+	policies, err := policy.Builtin()
+	if err != nil {
+		t.Fatalf("error loading builtin policies: %v", err)
+	}
+	return policies
+}
+
+func violations(v *policy.Verdict) map[string][]string {
+	res := map[string][]string{}
+	for _, r := range v.Results {
+		if r.Pass != (len(r.Violations) == 0) {
+			return nil
+		}
+		res[r.Policy] = r.Violations
+	}
+	return res
+}
+
+func TestEvaluate(t *testing.T) {
+	app := "pkg:golang/example.com/app@v1.0.0"
+	lib := "pkg:golang/example.com/lib@v1.2.0"
+	tests := []struct {
+		name     string
+		facts    policy.Facts
+		pass     bool
+		expected map[string][]string
+	}{
+		{
+			name:  "no evidence",
+			facts: policy.Facts{Subject: policy.Subject{Purl: app}},
+			expected: map[string][]string{
+				"no-affected-critical-vulns": {},
+				"slsa-level-2":               {app + " has no verified SLSA build level 2 or higher"},
+			},
+		},
+		{
+			name: "compliant",
+			facts: policy.Facts{
+				Subject: policy.Subject{Digest: "sha256:abc"},
+				SLSA:    []policy.SLSA{{Subject: "sha256:abc", Builder: "https://github.com/actions/runner", Level: 2}},
+				Verifications: []policy.Verification{
+					{Subject: "sha256:abc", Result: "PASSED", Levels: []string{"SLSA_BUILD_LEVEL_2"}, BuildLevel: 2},
+				},
+				Vulnerabilities: []policy.Vulnerability{
+					{Package: lib, ID: "ghsa-1", Score: 9.8, Status: "NOT_AFFECTED"},
+					{Package: lib, ID: "ghsa-2", Score: 5},
+				},
+			},
+			pass: true,
+			expected: map[string][]string{
+				"no-affected-critical-vulns": {},
+				"slsa-level-2":               {},
+			},
+		},
+		{
+			name: "affected critical vulnerabilities",
+			facts: policy.Facts{
+				Subject: policy.Subject{Purl: app},
+				Verifications: []policy.Verification{
+					{Result: "FAILED", BuildLevel: 3},
+					{Result: "PASSED", BuildLevel: 2},
+				},
+				Vulnerabilities: []policy.Vulnerability{
+					{Package: lib, ID: "ghsa-1", Score: 9.8},
+					{Package: lib, ID: "ghsa-2", Score: 9, Status: "AFFECTED"},
+					{Package: lib, ID: "ghsa-3", Score: 9, Status: "FIXED"},
+				},
+			},
+			expected: map[string][]string{
+				"no-affected-critical-vulns": {
+					lib + " is affected by critical vulnerability ghsa-1",
+					lib + " is affected by critical vulnerability ghsa-2",
+				},
+				"slsa-level-2": {},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verdict, err := policy.Evaluate(&test.facts, builtin(t))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if verdict.Pass != test.pass {
+				t.Errorf("expected pass to be %v, got %v", test.pass, verdict.Pass)
+			}
+			if diff := cmp.Diff(test.expected, violations(verdict)); diff != "" {
+				t.Errorf("unexpected violations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{
+			name: "bool expression",
+			files: map[string]string{
+				"scorecard.yaml": "name: scorecard\nviolations: scorecards.all(s, s.score >= 5)\n",
+				"README.md":      "not a policy",
+			},
+		},
+		{
+			name:    "empty directory",
+			wantErr: true,
+		},
+		{
+			name:    "syntax error",
+			files:   map[string]string{"bad.yaml": "name: bad\nviolations: vulnerabilities.filter(\n"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown variable",
+			files:   map[string]string{"bad.yaml": "name: bad\nviolations: provenance.size() > 0\n"},
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			files:   map[string]string{"bad.yaml": "name: bad\nviolations: 42\n"},
+			wantErr: true,
+		},
+		{
+			name: "duplicate names",
+			files: map[string]string{
+				"a.yaml": "name: a\nviolations: 'true'\n",
+				"b.yml":  "name: a\nviolations: 'true'\n",
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := policy.LoadDir(dir)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBoolPolicy(t *testing.T) {
+	p, err := policy.Parse([]byte("name: scorecard\ndescription: Sources must score at least 5.\nviolations: scorecards.all(s, s.score >= 5)\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	facts := &policy.Facts{Scorecards: []policy.Scorecard{{Source: "git+github.com/example/app", Score: 4.2}}}
+	verdict, err := policy.Evaluate(facts, []*policy.Policy{p})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]string{"scorecard": {"Sources must score at least 5."}}
+	if diff := cmp.Diff(expected, violations(verdict)); diff != "" {
+		t.Errorf("unexpected violations (-want +got):\n%s", diff)
+	}
+}
+
+func TestEval(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	gqlClient := SetupTest(t)
+	scan := &gql.ScanMetadataInput{TimeScanned: time.Now()}
+	ids := Ingest(ctx, t, gqlClient, GuacData{
+		Packages:        []string{"pkg:guac/app@1.0.0", "pkg:guac/lib@1.0.0", "pkg:guac/other@2.0.0"},
+		Artifacts:       []string{"sha-app", "sha-src"},
+		Builders:        []string{"builder"},
+		Vulnerabilities: []string{"osv/ghsa-crit", "osv/ghsa-low"},
+		HasSboms: []HasSbom{{
+			Subject:          "pkg:guac/app@1.0.0",
+			IncludedSoftware: []string{"pkg:guac/lib@1.0.0", "pkg:guac/other@2.0.0"},
+		}},
+		IsOccurrences: []IsOccurrence{{Subject: "pkg:guac/app@1.0.0", Artifact: "sha-app"}},
+		HasSlsas:      []HasSlsa{{Subject: "sha-app", BuiltFrom: []string{"sha-src"}, BuiltBy: "builder"}},
+		CertifyVulns: []CertifyVuln{
+			{Package: "pkg:guac/lib@1.0.0", Vulnerability: "osv/ghsa-crit", Metadata: scan},
+			{Package: "pkg:guac/other@2.0.0", Vulnerability: "osv/ghsa-low", Metadata: scan},
+		},
+	})
+	for vuln, score := range map[string]float64{"osv/ghsa-crit": 9.8, "osv/ghsa-low": 3.1} {
+		id := ids.VulnerabilityIds[vuln]
+		_, err := gql.IngestVulnHasMetadata(ctx, gqlClient, gql.IDorVulnerabilityInput{VulnerabilityNodeID: &id},
+			gql.VulnerabilityMetadataInputSpec{ScoreType: gql.VulnerabilityScoreTypeCvssv3, ScoreValue: score, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("error ingesting vulnerability metadata: %v", err)
+		}
+	}
+
+	facts, err := policy.Gather(ctx, gqlClient, policy.Subject{Digest: "sha256:sha-app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedPackages := []policy.Package{
+		{Purl: "pkg:guac/app@1.0.0"},
+		{Purl: "pkg:guac/lib@1.0.0", Dependency: true},
+		{Purl: "pkg:guac/other@2.0.0", Dependency: true},
+	}
+	if diff := cmp.Diff(expectedPackages, facts.Packages); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+	expectedOccurrences := []policy.Occurrence{{Package: "pkg:guac/app@1.0.0", Artifact: "sha256:sha-app"}}
+	if diff := cmp.Diff(expectedOccurrences, facts.Occurrences); diff != "" {
+		t.Errorf("unexpected occurrences (-want +got):\n%s", diff)
+	}
+	expectedVulns := []policy.Vulnerability{
+		{Package: "pkg:guac/lib@1.0.0", ID: "ghsa-crit", Score: 9.8},
+		{Package: "pkg:guac/other@2.0.0", ID: "ghsa-low", Score: 3.1},
+	}
+	if diff := cmp.Diff(expectedVulns, facts.Vulnerabilities); diff != "" {
+		t.Errorf("unexpected vulnerabilities (-want +got):\n%s", diff)
+	}
+	// the provenance alone does not vouch for a build level
+	if len(facts.SLSA) != 1 || facts.SLSA[0].Level != 0 {
+		t.Errorf("expected an unverified SLSA attestation, got %v", facts.SLSA)
+	}
+
+	verdict, err := policy.Evaluate(facts, builtin(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]string{
+		"no-affected-critical-vulns": {"pkg:guac/lib@1.0.0 is affected by critical vulnerability ghsa-crit"},
+		"slsa-level-2":               {"sha256:sha-app has no verified SLSA build level 2 or higher"},
+	}
+	if verdict.Pass {
+		t.Errorf("expected the verdict to fail")
+	}
+	if diff := cmp.Diff(expected, violations(verdict)); diff != "" {
+		t.Errorf("unexpected violations (-want +got):\n%s", diff)
+	}
+
+	// a VEX statement on the subject clears the vulnerability of the
+	// dependency
+	artifact := ids.ArtifactIds["sha-app"]
+	vuln := ids.VulnerabilityIds["osv/ghsa-crit"]
+	_, err = gql.IngestCertifyVexArtifact(ctx, gqlClient, gql.IDorArtifactInput{ArtifactID: &artifact},
+		gql.IDorVulnerabilityInput{VulnerabilityNodeID: &vuln},
+		gql.VexStatementInputSpec{
+			Status:           gql.VexStatusNotAffected,
+			VexJustification: gql.VexJustificationVulnerableCodeNotInExecutePath,
+			KnownSince:       time.Now(),
+		})
+	if err != nil {
+		t.Fatalf("error ingesting VEX statement: %v", err)
+	}
+	verdict, err = policy.Eval(ctx, gqlClient, policy.Subject{Purl: "pkg:guac/app@1.0.0"}, builtin(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = map[string][]string{
+		"no-affected-critical-vulns": {},
+		"slsa-level-2":               {"pkg:guac/app@1.0.0 has no verified SLSA build level 2 or higher"},
+	}
+	if diff := cmp.Diff(expected, violations(verdict), cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected violations (-want +got):\n%s", diff)
+	}
+
+	// a passed verification summary vouches for the build level
+	_, err = gql.IngestCertifyPolicyArtifact(ctx, gqlClient, gql.IDorArtifactInput{ArtifactID: &artifact},
+		gql.CertifyPolicyInputSpec{
+			Verifier:       "https://example.com/verifier",
+			PolicyUri:      "https://example.com/policy",
+			Result:         gql.PolicyVerificationResultPassed,
+			VerifiedLevels: []string{"SLSA_BUILD_LEVEL_2"},
+			TimeVerified:   time.Now(),
+		})
+	if err != nil {
+		t.Fatalf("error ingesting policy verification: %v", err)
+	}
+	facts, err = policy.Gather(ctx, gqlClient, policy.Subject{Purl: "pkg:guac/app@1.0.0"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(facts.SLSA) != 1 || facts.SLSA[0].Level != 2 {
+		t.Errorf("expected a level 2 SLSA attestation, got %v", facts.SLSA)
+	}
+	verdict, err = policy.Evaluate(facts, builtin(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = map[string][]string{
+		"no-affected-critical-vulns": {},
+		"slsa-level-2":               {},
+	}
+	if diff := cmp.Diff(expected, violations(verdict), cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected violations (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,151 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant carries the tenant of a caller through a request so that
+// backends can scope the predicates they store and return.
+//
+// Nouns (packages, sources, artifacts, builders, licenses and
+// vulnerabilities) are always global. Predicates ingested with a tenant are
+// only visible to that tenant, while predicates ingested without one are
+// visible to everyone.
+package tenant
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Header is the HTTP header used to name the tenant of a request. Collectors
+// and ingestors can set it through the header file passed with --header-file.
+const Header = "X-Guac-Tenant"
+
+type tenantKey struct{}
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// WithTenant returns a copy of ctx that carries the given tenant.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// FromContext returns the tenant carried by ctx, or the empty string when the
+// caller is not scoped to a tenant.
+func FromContext(ctx context.Context) string {
+	if t, ok := ctx.Value(tenantKey{}).(string); ok {
+		return t
+	}
+	return ""
+}
+
+// Validate checks that a tenant name is usable as a partition key.
+func Validate(tenant string) error {
+	if !validName.MatchString(tenant) {
+		return fmt.Errorf("invalid tenant name %q", tenant)
+	}
+	return nil
+}
+
+// Resolver derives the tenant of an incoming request. It returns the empty
+// string when the request is not scoped to a tenant.
+type Resolver func(r *http.Request) (string, error)
+
+// HeaderResolver trusts the tenant named in the Header of the request. It
+// should only be used when the server sits behind a proxy that authenticates
+// callers and sets the header. Requests without the header are rejected, as
+// they would otherwise see the predicates of every tenant.
+func HeaderResolver() Resolver {
+	return func(r *http.Request) (string, error) {
+		t := r.Header.Get(Header)
+		if t == "" {
+			return "", fmt.Errorf("missing %s header", Header)
+		}
+		return t, Validate(t)
+	}
+}
+
+// TokenResolver maps bearer tokens to tenants. Requests without a known
+// token are rejected.
+func TokenResolver(tokens map[string]string) Resolver {
+	return func(r *http.Request) (string, error) {
+		auth := r.Header.Get("Authorization")
+		token, ok := strings.CutPrefix(auth, "Bearer ")
+		if !ok {
+			return "", fmt.Errorf("missing bearer token")
+		}
+		t, ok := tokens[strings.TrimSpace(token)]
+		if !ok {
+			return "", fmt.Errorf("unknown bearer token")
+		}
+		return t, nil
+	}
+}
+
+// LoadTokens reads a token file for TokenResolver. Each non-empty line that
+// does not start with '#' holds a tenant name and a token separated by
+// whitespace.
+func LoadTokens(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error opening tenant token file: %w", err)
+	}
+	defer f.Close()
+
+	tokens := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("tenant token file line %d: expected \"<tenant> <token>\"", line)
+		}
+		if err := Validate(fields[0]); err != nil {
+			return nil, fmt.Errorf("tenant token file line %d: %w", line, err)
+		}
+		if _, dup := tokens[fields[1]]; dup {
+			return nil, fmt.Errorf("tenant token file line %d: duplicate token", line)
+		}
+		tokens[fields[1]] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading tenant token file: %w", err)
+	}
+	return tokens, nil
+}
+
+// Middleware stores the tenant resolved for each request in its context.
+// Requests for which the resolver fails are rejected with 401.
+func Middleware(resolve Resolver, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t, err := resolve(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if t != "" {
+			r = r.WithContext(WithTenant(r.Context(), t))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
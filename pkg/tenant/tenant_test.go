@@ -0,0 +1,126 @@
+//
+// Copyright 2026 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	tokens := map[string]string{"s3cr3t": "team-a"}
+	tests := []struct {
+		name       string
+		resolver   Resolver
+		headers    map[string]string
+		wantStatus int
+		wantTenant string
+	}{
+		{
+			name:       "header resolver rejects missing header",
+			resolver:   HeaderResolver(),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "header resolver uses header",
+			resolver:   HeaderResolver(),
+			headers:    map[string]string{Header: "team-b"},
+			wantStatus: http.StatusOK,
+			wantTenant: "team-b",
+		},
+		{
+			name:       "header resolver rejects invalid name",
+			resolver:   HeaderResolver(),
+			headers:    map[string]string{Header: "team b/../"},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token resolver maps token",
+			resolver:   TokenResolver(tokens),
+			headers:    map[string]string{"Authorization": "Bearer s3cr3t", Header: "team-b"},
+			wantStatus: http.StatusOK,
+			wantTenant: "team-a",
+		},
+		{
+			name:       "token resolver rejects unknown token",
+			resolver:   TokenResolver(tokens),
+			headers:    map[string]string{"Authorization": "Bearer nope"},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token resolver rejects missing token",
+			resolver:   TokenResolver(tokens),
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got string
+			h := Middleware(test.resolver, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = FromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/query", nil)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != test.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, test.wantStatus)
+			}
+			if got != test.wantTenant {
+				t.Errorf("tenant = %q, want %q", got, test.wantTenant)
+			}
+		})
+	}
+}
+
+func TestLoadTokens(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good")
+	if err := os.WriteFile(good, []byte("# tenants\nteam-a tokenA\n\nteam-b  tokenB\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := LoadTokens(good)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tokens["tokenA"] != "team-a" || tokens["tokenB"] != "team-b" || len(tokens) != 2 {
+		t.Errorf("unexpected tokens: %v", tokens)
+	}
+
+	dup := filepath.Join(dir, "dup")
+	if err := os.WriteFile(dup, []byte("team-a token\nteam-b token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTokens(dup); err == nil {
+		t.Errorf("expected error for duplicate token")
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	if got := FromContext(ctx); got != "" {
+		t.Errorf("FromContext() = %q, want empty", got)
+	}
+	if got := FromContext(WithTenant(ctx, "team-a")); got != "team-a" {
+		t.Errorf("FromContext() = %q, want team-a", got)
+	}
+}